@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
@@ -88,8 +89,9 @@ type BreachConfig struct {
 	Notifier chainntnfs.ChainNotifier
 
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network. The passed label is persisted along
+	// with the transaction.
+	PublishTransaction func(*wire.MsgTx, *channeldb.TxLabel) error
 
 	// ContractBreaches is a channel where the breachArbiter will receive
 	// notifications in the event of a contract breach being observed. A
@@ -106,6 +108,11 @@ type BreachConfig struct {
 	// breached channels. This is used in conjunction with DB to recover
 	// from crashes, restarts, or other failures.
 	Store RetributionStore
+
+	// NotifyClosedChannel allows the breach arbiter to notify outside
+	// sub-systems that a breached channel has been fully resolved, once
+	// all revoked outputs have been swept.
+	NotifyClosedChannel func(*channeldb.ChannelCloseSummary)
 }
 
 // breachArbiter is a special subsystem which is responsible for watching and
@@ -528,7 +535,11 @@ justiceTxBroadcast:
 
 	// We'll now attempt to broadcast the transaction which finalized the
 	// channel's retribution against the cheating counter party.
-	err = b.cfg.PublishTransaction(finalTx)
+	err = b.cfg.PublishTransaction(finalTx, &channeldb.TxLabel{
+		Category: channeldb.TxCategoryBreachRemedy,
+		Label: fmt.Sprintf("Justice tx for breach of ChannelPoint(%v)",
+			breachInfo.chanPoint),
+	})
 	if err != nil {
 		brarLog.Errorf("unable to broadcast justice tx: %v", err)
 
@@ -609,6 +620,15 @@ justiceTxBroadcast:
 			return
 		}
 
+		closeSummary, err := b.cfg.DB.FetchClosedChannel(
+			&breachInfo.chanPoint,
+		)
+		if err != nil {
+			brarLog.Errorf("unable to fetch close summary: %v", err)
+		} else {
+			b.cfg.NotifyClosedChannel(closeSummary)
+		}
+
 		// Justice has been carried out; we can safely delete the
 		// retribution info from the database.
 		err = b.cfg.Store.Remove(&breachInfo.chanPoint)
@@ -826,8 +846,13 @@ func (bo *breachedOutput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
 
 // BlocksToMaturity returns the relative timelock, as a number of blocks, that
 // must be built on top of the confirmation height before the output can be
-// spent.
+// spent. Only our output on a commitment of a channel using anchor outputs
+// carries such a delay.
 func (bo *breachedOutput) BlocksToMaturity() uint32 {
+	if bo.witnessType == lnwallet.CommitmentToRemoteConfirmed {
+		return 1
+	}
+
 	return 0
 }
 
@@ -870,11 +895,25 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	// First, record the breach information for the local channel point if
 	// it is not considered dust, which is signaled by a non-nil sign
 	// descriptor. Here we use CommitmentNoDelay since this output belongs
-	// to us and has no time-based constraints on spending.
+	// to us and has no time-based constraints on spending. If the output
+	// pays to our static remote key, its sign descriptor won't carry a
+	// tweak, and we'll use the tweakless variant instead. For channels
+	// using anchor outputs, the output is a P2WSH output that can only be
+	// spent once the commitment has confirmed.
 	if breachInfo.LocalOutputSignDesc != nil {
+		signDesc := breachInfo.LocalOutputSignDesc
+		witnessType := lnwallet.CommitmentNoDelay
+		switch {
+		case txscript.IsPayToWitnessScriptHash(signDesc.Output.PkScript):
+			witnessType = lnwallet.CommitmentToRemoteConfirmed
+
+		case signDesc.SingleTweak == nil:
+			witnessType = lnwallet.CommitSpendNoDelayTweakless
+		}
+
 		localOutput := makeBreachedOutput(
 			&breachInfo.LocalOutpoint,
-			lnwallet.CommitmentNoDelay,
+			witnessType,
 			// No second level script as this is a commitment
 			// output.
 			nil,
@@ -970,9 +1009,14 @@ func (b *breachArbiter) createJusticeTx(
 		// type is unrecognized, we will omit it from the transaction.
 		var witnessWeight int
 		switch input.WitnessType() {
-		case lnwallet.CommitmentNoDelay:
+		case lnwallet.CommitmentNoDelay,
+			lnwallet.CommitSpendNoDelayTweakless:
+
 			witnessWeight = lnwallet.P2WKHWitnessSize
 
+		case lnwallet.CommitmentToRemoteConfirmed:
+			witnessWeight = lnwallet.ToRemoteConfirmedWitnessSize
+
 		case lnwallet.CommitmentRevoke:
 			witnessWeight = lnwallet.ToLocalPenaltyWitnessSize
 
@@ -1047,6 +1091,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	for _, input := range inputs {
 		txn.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.BlocksToMaturity(),
 		})
 	}
 
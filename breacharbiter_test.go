@@ -1184,7 +1184,9 @@ func TestBreachSecondLevelTransfer(t *testing.T) {
 
 	// Make PublishTransaction always return ErrDoubleSpend to begin with.
 	publErr = lnwallet.ErrDoubleSpend
-	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx,
+		_ *channeldb.TxLabel) error {
+
 		publTx <- tx
 		return publErr
 	}
@@ -1344,15 +1346,16 @@ func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
 	// Assemble our test arbiter.
 	notifier := makeMockSpendNotifier()
 	ba := newBreachArbiter(&BreachConfig{
-		CloseLink:          func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
-		DB:                 db,
-		Estimator:          &lnwallet.StaticFeeEstimator{FeePerKW: 12500},
-		GenSweepScript:     func() ([]byte, error) { return nil, nil },
-		ContractBreaches:   contractBreaches,
-		Signer:             signer,
-		Notifier:           notifier,
-		PublishTransaction: func(_ *wire.MsgTx) error { return nil },
-		Store:              store,
+		CloseLink:           func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
+		DB:                  db,
+		Estimator:           &lnwallet.StaticFeeEstimator{FeePerKW: 12500},
+		GenSweepScript:      func() ([]byte, error) { return nil, nil },
+		ContractBreaches:    contractBreaches,
+		Signer:              signer,
+		Notifier:            notifier,
+		PublishTransaction:  func(_ *wire.MsgTx, _ *channeldb.TxLabel) error { return nil },
+		Store:               store,
+		NotifyClosedChannel: func(*channeldb.ChannelCloseSummary) {},
 	})
 
 	if err := ba.Start(); err != nil {
@@ -1469,7 +1472,7 @@ func createInitChannels(revocationWindow int) (*lnwallet.LightningChannel, *lnwa
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, channeldb.SingleFunder)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/chainview"
 )
@@ -138,6 +139,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 
 	cc := &chainControl{}
 
+	var staticFeePerKW lnwallet.SatPerKWeight
 	switch registeredChains.PrimaryChain() {
 	case bitcoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
@@ -146,9 +148,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Bitcoin.FeeRate,
 			TimeLockDelta: cfg.Bitcoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.StaticFeeEstimator{
-			FeePerKW: defaultBitcoinStaticFeePerKW,
-		}
+		staticFeePerKW = defaultBitcoinStaticFeePerKW
 	case litecoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
 			MinHTLC:       cfg.Litecoin.MinHTLC,
@@ -156,13 +156,14 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Litecoin.FeeRate,
 			TimeLockDelta: cfg.Litecoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.StaticFeeEstimator{
-			FeePerKW: defaultLitecoinStaticFeePerKW,
-		}
+		staticFeePerKW = defaultLitecoinStaticFeePerKW
 	default:
 		return nil, nil, fmt.Errorf("Default routing policy for "+
 			"chain %v is unknown", registeredChains.PrimaryChain())
 	}
+	cc.feeEstimator = lnwallet.StaticFeeEstimator{
+		FeePerKW: staticFeePerKW,
+	}
 
 	walletConfig := &btcwallet.Config{
 		PrivatePass:    privateWalletPw,
@@ -174,6 +175,11 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		FeeEstimator:   cc.feeEstimator,
 		CoinType:       activeNetParams.CoinType,
 		Wallet:         wallet,
+
+		// With a remote signer, our wallet must not hold any private
+		// keys.
+		WatchOnly:          cfg.RemoteSigner.Active,
+		ConvertToWatchOnly: cfg.RemoteSigner.ConvertWallet,
 	}
 
 	var (
@@ -362,9 +368,6 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		} else if cfg.Litecoin.Active {
 			ltndLog.Infof("Initializing litecoind backed fee estimator")
 
@@ -379,9 +382,6 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		}
 	case "btcd", "ltcd":
 		// Otherwise, we'll be speaking directly via RPC to a node.
@@ -484,21 +484,92 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		}
 	default:
 		return nil, nil, fmt.Errorf("unknown node type: %s",
 			homeChainConfig.Node)
 	}
 
+	// If a web API is configured as an additional fee source, we'll
+	// combine its estimates with those of our backend, if any, into a
+	// composite estimator that guards against any single source
+	// misbehaving. The static fee rate of the chain is used as a last
+	// resort if all sources fail. Without additional sources, the backend
+	// estimator is used as is.
+	feeCfg := cfg.FeeEstimator
+	switch {
+	case feeCfg.WebAPIURL != "":
+		ltndLog.Infof("Using fee estimates of web API at %v",
+			feeCfg.WebAPIURL)
+
+		var feeSources []lnwallet.FeeSource
+		if source, ok := cc.feeEstimator.(lnwallet.FeeSource); ok {
+			feeSources = append(feeSources, source)
+		}
+		feeSources = append(feeSources, lnwallet.NewWebAPIFeeSource(
+			feeCfg.WebAPIURL,
+		))
+
+		cc.feeEstimator, err = lnwallet.NewCompositeFeeEstimator(
+			lnwallet.CompositeFeeEstimatorConfig{
+				Sources:          feeSources,
+				FallbackFeePerKW: staticFeePerKW,
+				MinFeePerKW:      lnwallet.SatPerKWeight(feeCfg.MinFeeRate),
+				MaxFeePerKW:      lnwallet.SatPerKWeight(feeCfg.MaxFeeRate),
+				CacheDuration:    feeCfg.CacheDuration,
+				SmoothingWindow:  feeCfg.SmoothingWindow,
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case feeCfg.MinFeeRate != 0 || feeCfg.MaxFeeRate != 0:
+		ltndLog.Warnf("Ignoring feeestimator.minfeerate and " +
+			"feeestimator.maxfeerate, as they only apply along " +
+			"with feeestimator.webapiurl")
+	}
+	if err := cc.feeEstimator.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	// If a remote signer is configured, then all signing and key
+	// derivation will be delegated to it, rather than being carried out
+	// with the keys of the local wallet, which is watch-only.
+	var remoteSigner *remotesigner.Client
+	if cfg.RemoteSigner.Active {
+		ltndLog.Infof("Using remote signer at %v",
+			cfg.RemoteSigner.RPCHost)
+
+		remoteSigner, err = remotesigner.New(&remotesigner.Config{
+			RPCHost:      cfg.RemoteSigner.RPCHost,
+			TLSCertPath:  cfg.RemoteSigner.TLSCertPath,
+			MacaroonPath: cfg.RemoteSigner.MacaroonPath,
+			Timeout:      cfg.RemoteSigner.Timeout,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		walletConfig.RemoteSigner = remoteSigner
+
+		chainCleanUp := cleanUp
+		cleanUp = func() {
+			remoteSigner.Close()
+			if chainCleanUp != nil {
+				chainCleanUp()
+			}
+		}
+	}
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
 		return nil, nil, err
 	}
 
+	// A watch-only wallet passes all signing requests on to the remote
+	// signer, so it can be used as our signer either way.
 	cc.msgSigner = wc
 	cc.signer = wc
 	cc.chainIO = wc
@@ -509,9 +580,13 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		channelConstraints = defaultLtcChannelConstraints
 	}
 
-	keyRing := keychain.NewBtcWalletKeyRing(
+	var keyRing keychain.SecretKeyRing = keychain.NewBtcWalletKeyRing(
 		wc.InternalWallet(), activeNetParams.CoinType,
 	)
+	if remoteSigner != nil {
+		cc.msgSigner = remoteSigner
+		keyRing = remoteSigner
+	}
 
 	// Create, and start the lnwallet, which handles the core payment
 	// channel logic, and exposes control via proxy state machines.
@@ -0,0 +1,85 @@
+package chanacceptor
+
+import (
+	"sort"
+	"sync"
+)
+
+// ChainedAcceptor is a ChannelAcceptor that only accepts a channel if all of
+// the acceptors it holds accept it. Acceptors can be added and removed at any
+// time, and if none are held, all channels are accepted.
+type ChainedAcceptor struct {
+	mtx       sync.RWMutex
+	acceptors map[uint64]ChannelAcceptor
+	nextID    uint64
+}
+
+// NewChainedAcceptor creates a new ChainedAcceptor without any acceptors.
+func NewChainedAcceptor() *ChainedAcceptor {
+	return &ChainedAcceptor{
+		acceptors: make(map[uint64]ChannelAcceptor),
+	}
+}
+
+// AddAcceptor adds the passed acceptor to the chain, returning an ID that can
+// be used to remove it again.
+func (c *ChainedAcceptor) AddAcceptor(acceptor ChannelAcceptor) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	id := c.nextID
+	c.nextID++
+	c.acceptors[id] = acceptor
+
+	return id
+}
+
+// RemoveAcceptor removes the acceptor with the given ID from the chain.
+func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.acceptors, id)
+}
+
+// Accept consults all acceptors of the chain in the order they were added,
+// and returns the first error encountered, if any. The channel params
+// returned by the acceptors are merged, with params set by later acceptors
+// taking precedence.
+//
+// NOTE: This method is part of the ChannelAcceptor interface.
+func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) (*ChannelParams,
+	error) {
+
+	// We'll take a snapshot of the acceptors, so that they can be added
+	// or removed while we wait for a decision.
+	c.mtx.RLock()
+	ids := make([]uint64, 0, len(c.acceptors))
+	for id := range c.acceptors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	acceptors := make([]ChannelAcceptor, 0, len(ids))
+	for _, id := range ids {
+		acceptors = append(acceptors, c.acceptors[id])
+	}
+	c.mtx.RUnlock()
+
+	params := &ChannelParams{}
+	for _, acceptor := range acceptors {
+		acceptorParams, err := acceptor.Accept(req)
+		if err != nil {
+			return nil, err
+		}
+
+		params.merge(acceptorParams)
+	}
+
+	return params, nil
+}
+
+// A compile-time assertion to ensure that ChainedAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -0,0 +1,283 @@
+package chanacceptor
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// mockAcceptor is a ChannelAcceptor that returns fixed params and error.
+type mockAcceptor struct {
+	params *ChannelParams
+	err    error
+	calls  int
+}
+
+func (m *mockAcceptor) Accept(req *ChannelAcceptRequest) (*ChannelParams,
+	error) {
+
+	m.calls++
+	return m.params, m.err
+}
+
+// TestChainedAcceptor tests that the ChainedAcceptor only accepts a channel
+// if all of its acceptors do.
+func TestChainedAcceptor(t *testing.T) {
+	t.Parallel()
+
+	req := &ChannelAcceptRequest{
+		OpenChanMsg: &lnwire.OpenChannel{},
+	}
+
+	// Without any acceptors, all channels should be accepted.
+	chained := NewChainedAcceptor()
+	params, err := chained.Accept(req)
+	if err != nil {
+		t.Fatalf("expected channel to be accepted, got: %v", err)
+	}
+	if !reflect.DeepEqual(*params, ChannelParams{}) {
+		t.Fatalf("expected default params, got: %v", spew.Sdump(params))
+	}
+
+	accepting := &mockAcceptor{
+		params: &ChannelParams{
+			MaxAcceptedHTLCs: 10,
+			CsvDelay:         100,
+		},
+	}
+	overriding := &mockAcceptor{
+		params: &ChannelParams{
+			CsvDelay:        200,
+			DustLimit:       1000,
+			UpfrontShutdown: lnwire.DeliveryAddress{0x00, 0x14},
+			ZeroConf:        true,
+		},
+	}
+	rejecting := &mockAcceptor{err: &RejectError{Reason: "no thanks"}}
+
+	chained.AddAcceptor(accepting)
+	chained.AddAcceptor(overriding)
+	rejectID := chained.AddAcceptor(rejecting)
+
+	_, err = chained.Accept(req)
+	if err == nil || err.Error() != "no thanks" {
+		t.Fatalf("expected channel to be rejected, got: %v", err)
+	}
+
+	// Once the rejecting acceptor is removed, the channel should be
+	// accepted again, with the params of both remaining acceptors merged.
+	chained.RemoveAcceptor(rejectID)
+	params, err = chained.Accept(req)
+	if err != nil {
+		t.Fatalf("expected channel to be accepted, got: %v", err)
+	}
+	expectedParams := ChannelParams{
+		MaxAcceptedHTLCs: 10,
+		CsvDelay:         200,
+		DustLimit:        1000,
+		UpfrontShutdown:  lnwire.DeliveryAddress{0x00, 0x14},
+		ZeroConf:         true,
+	}
+	if !reflect.DeepEqual(*params, expectedParams) {
+		t.Fatalf("expected params %v, got %v",
+			spew.Sdump(expectedParams), spew.Sdump(params))
+	}
+	if accepting.calls != 2 || rejecting.calls != 1 {
+		t.Fatalf("unexpected number of calls: %v, %v", accepting.calls,
+			rejecting.calls)
+	}
+}
+
+// TestRPCAcceptor tests that the RPCAcceptor forwards requests to the RPC
+// client and returns its decisions, timing out if no decision is made.
+func TestRPCAcceptor(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan *ChannelAcceptRequest, 1)
+	send := func(req *ChannelAcceptRequest) error {
+		requests <- req
+		return nil
+	}
+	quit := make(chan struct{})
+	acceptor := NewRPCAcceptor(send, time.Second, quit)
+
+	// decide accepts the request with the given pending channel ID in the
+	// background, and returns the decision.
+	decide := func(pendingChanID [32]byte, accept bool, reason string,
+		params *ChannelParams) (*ChannelParams, error) {
+
+		type result struct {
+			params *ChannelParams
+			err    error
+		}
+		resultChan := make(chan result, 1)
+		go func() {
+			params, err := acceptor.Accept(&ChannelAcceptRequest{
+				OpenChanMsg: &lnwire.OpenChannel{
+					PendingChannelID: pendingChanID,
+				},
+			})
+			resultChan <- result{params, err}
+		}()
+
+		select {
+		case <-requests:
+		case <-time.After(time.Second):
+			t.Fatalf("request not sent")
+		}
+
+		err := acceptor.HandleResponse(
+			pendingChanID, accept, reason, params,
+		)
+		if err != nil {
+			t.Fatalf("unable to handle response: %v", err)
+		}
+
+		select {
+		case res := <-resultChan:
+			return res.params, res.err
+		case <-time.After(time.Second):
+			t.Fatalf("no decision returned")
+		}
+		return nil, nil
+	}
+
+	chanParams := &ChannelParams{MinHtlc: 5000}
+	params, err := decide([32]byte{1}, true, "", chanParams)
+	if err != nil {
+		t.Fatalf("expected channel to be accepted, got: %v", err)
+	}
+	if params != chanParams {
+		t.Fatalf("expected params %v, got %v", spew.Sdump(chanParams),
+			spew.Sdump(params))
+	}
+
+	_, err = decide([32]byte{2}, false, "too small", nil)
+	rejectErr, ok := err.(*RejectError)
+	if !ok || rejectErr.Reason != "too small" {
+		t.Fatalf("expected channel to be rejected, got: %v", err)
+	}
+
+	// Responses for unknown requests should be refused.
+	err = acceptor.HandleResponse([32]byte{3}, true, "", nil)
+	if err == nil {
+		t.Fatalf("expected response for unknown request to fail")
+	}
+
+	// If the client exits before deciding, the request should fail.
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := acceptor.Accept(&ChannelAcceptRequest{
+			OpenChanMsg: &lnwire.OpenChannel{},
+		})
+		errChan <- err
+	}()
+	<-requests
+	close(quit)
+
+	select {
+	case err := <-errChan:
+		if err != ErrAcceptorExiting {
+			t.Fatalf("expected ErrAcceptorExiting, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("request not failed")
+	}
+
+	// Finally, a client that doesn't decide in time should cause the
+	// request to fail.
+	acceptor = NewRPCAcceptor(send, 10*time.Millisecond, make(chan struct{}))
+	_, err = acceptor.Accept(&ChannelAcceptRequest{
+		OpenChanMsg: &lnwire.OpenChannel{},
+	})
+	if err != ErrAcceptorTimeout {
+		t.Fatalf("expected ErrAcceptorTimeout, got: %v", err)
+	}
+}
+
+// TestRPCAcceptorConcurrent tests that concurrent requests are delivered to
+// the RPC client one at a time, and that each receives its own decision.
+func TestRPCAcceptorConcurrent(t *testing.T) {
+	t.Parallel()
+
+	const numRequests = 20
+
+	var (
+		inFlight   int32
+		concurrent int32
+	)
+	requests := make(chan *ChannelAcceptRequest, numRequests)
+	send := func(req *ChannelAcceptRequest) error {
+		if atomic.AddInt32(&inFlight, 1) > 1 {
+			atomic.StoreInt32(&concurrent, 1)
+		}
+		defer atomic.AddInt32(&inFlight, -1)
+
+		// Linger a bit, so overlapping sends are caught.
+		time.Sleep(time.Millisecond)
+
+		requests <- req
+		return nil
+	}
+	quit := make(chan struct{})
+	defer close(quit)
+	acceptor := NewRPCAcceptor(send, 5*time.Second, quit)
+
+	// The client accepts each request, echoing its pending channel ID in
+	// the params so we can check every request gets its own decision.
+	go func() {
+		for i := 0; i < numRequests; i++ {
+			var req *ChannelAcceptRequest
+			select {
+			case req = <-requests:
+			case <-quit:
+				return
+			}
+
+			pendingChanID := req.OpenChanMsg.PendingChannelID
+			err := acceptor.HandleResponse(
+				pendingChanID, true, "", &ChannelParams{
+					MinHtlc: lnwire.MilliSatoshi(
+						pendingChanID[0],
+					),
+				},
+			)
+			if err != nil {
+				t.Errorf("unable to handle response: %v", err)
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < numRequests; i++ {
+		wg.Add(1)
+		go func(i byte) {
+			defer wg.Done()
+
+			params, err := acceptor.Accept(&ChannelAcceptRequest{
+				OpenChanMsg: &lnwire.OpenChannel{
+					PendingChannelID: [32]byte{i},
+				},
+			})
+			if err != nil {
+				t.Errorf("expected channel %v to be accepted, "+
+					"got: %v", i, err)
+				return
+			}
+			if params.MinHtlc != lnwire.MilliSatoshi(i) {
+				t.Errorf("expected decision for channel %v, "+
+					"got %v", i, params.MinHtlc)
+			}
+		}(byte(i + 1))
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&concurrent) != 0 {
+		t.Fatalf("requests were sent concurrently")
+	}
+}
@@ -0,0 +1,122 @@
+package chanacceptor
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptRequest is a request to accept a channel that a remote peer
+// would like to open with us.
+type ChannelAcceptRequest struct {
+	// Node is the public key of the peer attempting to open the channel.
+	Node *btcec.PublicKey
+
+	// OpenChanMsg is the OpenChannel message sent by the peer, holding
+	// all the parameters of the proposed channel.
+	OpenChanMsg *lnwire.OpenChannel
+}
+
+// RejectError is returned by a ChannelAcceptor that rejects a channel. Its
+// reason is sent to the peer that attempted to open the channel.
+type RejectError struct {
+	// Reason is a human readable explanation of the rejection. It may be
+	// empty.
+	Reason string
+}
+
+// Error returns the reason of the rejection.
+//
+// NOTE: This method is part of the error interface.
+func (e *RejectError) Error() string {
+	if e.Reason == "" {
+		return "channel rejected"
+	}
+
+	return e.Reason
+}
+
+// ChannelParams holds the channel limits an acceptor wants to apply to an
+// inbound channel it accepts. Any zero value leaves the corresponding limit
+// at its default.
+type ChannelParams struct {
+	// MaxValueInFlight is the maximum amount of coins in millisatoshi we
+	// allow to be pending within the channel at the same time.
+	MaxValueInFlight lnwire.MilliSatoshi
+
+	// MaxAcceptedHTLCs is the maximum number of HTLCs the remote party
+	// may offer us.
+	MaxAcceptedHTLCs uint16
+
+	// ChanReserve is the channel reserve the remote party is required to
+	// maintain.
+	ChanReserve btcutil.Amount
+
+	// DustLimit is the dust limit of our version of the commitment
+	// transaction.
+	DustLimit btcutil.Amount
+
+	// MinHtlc is the smallest HTLC we'll accept from the remote party.
+	MinHtlc lnwire.MilliSatoshi
+
+	// CsvDelay is the CSV delay we'll require the remote party to use
+	// for its funds in case of a unilateral close.
+	CsvDelay uint16
+
+	// MaxCsvDelay is the largest CSV delay we'll accept the remote party
+	// requiring for our funds in case of a unilateral close.
+	MaxCsvDelay uint16
+
+	// UpfrontShutdown is the script we'll commit to paying our funds to
+	// upon a cooperative close of the channel.
+	UpfrontShutdown lnwire.DeliveryAddress
+
+	// ZeroConf indicates that the channel may be used before its funding
+	// transaction has confirmed, as we trust the initiator not to double
+	// spend it.
+	ZeroConf bool
+}
+
+// merge overrides the params with all non-zero values of other.
+func (p *ChannelParams) merge(other *ChannelParams) {
+	if other == nil {
+		return
+	}
+
+	if other.MaxValueInFlight != 0 {
+		p.MaxValueInFlight = other.MaxValueInFlight
+	}
+	if other.MaxAcceptedHTLCs != 0 {
+		p.MaxAcceptedHTLCs = other.MaxAcceptedHTLCs
+	}
+	if other.ChanReserve != 0 {
+		p.ChanReserve = other.ChanReserve
+	}
+	if other.DustLimit != 0 {
+		p.DustLimit = other.DustLimit
+	}
+	if other.MinHtlc != 0 {
+		p.MinHtlc = other.MinHtlc
+	}
+	if other.CsvDelay != 0 {
+		p.CsvDelay = other.CsvDelay
+	}
+	if other.MaxCsvDelay != 0 {
+		p.MaxCsvDelay = other.MaxCsvDelay
+	}
+	if len(other.UpfrontShutdown) != 0 {
+		p.UpfrontShutdown = other.UpfrontShutdown
+	}
+	if other.ZeroConf {
+		p.ZeroConf = true
+	}
+}
+
+// ChannelAcceptor decides whether an inbound channel may be opened.
+type ChannelAcceptor interface {
+	// Accept returns the channel params to apply if the channel described
+	// by the request may be opened, which may be nil if the defaults
+	// should be used. Otherwise, an error is returned, which is a
+	// *RejectError if the channel was explicitly rejected.
+	Accept(req *ChannelAcceptRequest) (*ChannelParams, error)
+}
@@ -0,0 +1,140 @@
+package chanacceptor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrAcceptorTimeout is returned when an RPC client fails to decide
+	// on a channel within the configured timeout.
+	ErrAcceptorTimeout = errors.New("timed out waiting for channel " +
+		"acceptor")
+
+	// ErrAcceptorExiting is returned when the RPC client disconnects
+	// before deciding on a channel.
+	ErrAcceptorExiting = errors.New("channel acceptor exiting")
+)
+
+// acceptorDecision is the decision of the RPC client on a single request.
+type acceptorDecision struct {
+	params *ChannelParams
+	err    error
+}
+
+// RPCAcceptor is a ChannelAcceptor that forwards each request to an RPC
+// client, and waits for it to decide whether the channel is accepted.
+type RPCAcceptor struct {
+	// send delivers a request to the RPC client. As requests for
+	// different channels are accepted concurrently, calls are serialized
+	// by sendMtx, so send doesn't need to be safe for concurrent use.
+	sendMtx sync.Mutex
+	send    func(*ChannelAcceptRequest) error
+
+	// timeout is the maximum amount of time to wait for the RPC client
+	// to decide on a request.
+	timeout time.Duration
+
+	// pending maps the pending channel ID of each request awaiting a
+	// decision to the channel its decision is delivered on.
+	pendingMtx sync.Mutex
+	pending    map[[32]byte]chan *acceptorDecision
+
+	quit chan struct{}
+}
+
+// NewRPCAcceptor creates a new RPCAcceptor that delivers requests to the RPC
+// client using the passed closure, and waits at most timeout for a decision.
+// Any pending or future requests are failed once quit is closed.
+func NewRPCAcceptor(send func(*ChannelAcceptRequest) error,
+	timeout time.Duration, quit chan struct{}) *RPCAcceptor {
+
+	return &RPCAcceptor{
+		send:    send,
+		timeout: timeout,
+		pending: make(map[[32]byte]chan *acceptorDecision),
+		quit:    quit,
+	}
+}
+
+// Accept forwards the request to the RPC client and waits for its decision. It
+// is safe to call Accept concurrently for different pending channels.
+//
+// NOTE: This method is part of the ChannelAcceptor interface.
+func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) (*ChannelParams,
+	error) {
+
+	pendingChanID := req.OpenChanMsg.PendingChannelID
+
+	r.pendingMtx.Lock()
+	if _, ok := r.pending[pendingChanID]; ok {
+		r.pendingMtx.Unlock()
+		return nil, fmt.Errorf("request for pending channel %x already "+
+			"in flight", pendingChanID[:])
+	}
+	decision := make(chan *acceptorDecision, 1)
+	r.pending[pendingChanID] = decision
+	r.pendingMtx.Unlock()
+
+	defer func() {
+		r.pendingMtx.Lock()
+		delete(r.pending, pendingChanID)
+		r.pendingMtx.Unlock()
+	}()
+
+	r.sendMtx.Lock()
+	err := r.send(req)
+	r.sendMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case d := <-decision:
+		return d.params, d.err
+
+	case <-time.After(r.timeout):
+		return nil, ErrAcceptorTimeout
+
+	case <-r.quit:
+		return nil, ErrAcceptorExiting
+	}
+}
+
+// HandleResponse delivers the RPC client's decision on the request for the
+// given pending channel. If the channel is rejected, the optional reason is
+// sent to the peer. Otherwise, the optional params are applied to the
+// channel.
+func (r *RPCAcceptor) HandleResponse(pendingChanID [32]byte, accept bool,
+	reason string, params *ChannelParams) error {
+
+	r.pendingMtx.Lock()
+	decision, ok := r.pending[pendingChanID]
+	r.pendingMtx.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no request for pending channel %x",
+			pendingChanID[:])
+	}
+
+	d := &acceptorDecision{params: params}
+	if !accept {
+		d.params = nil
+		d.err = &RejectError{Reason: reason}
+	}
+
+	// The channel is buffered, and only a single decision is read from
+	// it, so we'll drop any duplicate responses.
+	select {
+	case decision <- d:
+	default:
+	}
+
+	return nil
+}
+
+// A compile-time assertion to ensure that RPCAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*RPCAcceptor)(nil)
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -25,6 +27,12 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// ErrUpfrontShutdownScriptMismatch is returned when the remote party
+	// attempts to close a channel to a script other than the upfront
+	// shutdown script it committed to when opening the channel.
+	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does " +
+		"not match upfront shutdown script")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -75,13 +83,18 @@ type chanCloseCfg struct {
 	// further HTLC's should be routed through the channel.
 	unregisterChannel func(lnwire.ChannelID)
 
-	// broadcastTx broadcasts the passed transaction to the network.
-	broadcastTx func(*wire.MsgTx) error
+	// broadcastTx broadcasts the passed transaction to the network,
+	// persisting the passed label along with it.
+	broadcastTx func(*wire.MsgTx, *channeldb.TxLabel) error
 
 	// disableChannel disables a channel, resulting in it not being able to
 	// forward payments.
 	disableChannel func(wire.OutPoint) error
 
+	// notifyClosing notifies outside sub-systems that the channel has
+	// started to close, once the closing transaction has been broadcast.
+	notifyClosing func(*channeldb.ChannelCloseSummary)
+
 	// quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	quit chan struct{}
@@ -190,6 +203,22 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 	}
 }
 
+// matchUpfrontShutdown returns ErrUpfrontShutdownScriptMismatch if the remote
+// party committed to an upfront shutdown script when opening the channel, and
+// the passed delivery script doesn't match it.
+func (c *channelCloser) matchUpfrontShutdown(script lnwire.DeliveryAddress) error {
+	upfrontScript := c.cfg.channel.State().RemoteShutdownScript
+	if len(upfrontScript) == 0 || bytes.Equal(upfrontScript, script) {
+		return nil
+	}
+
+	peerLog.Warnf("ChannelPoint(%v): remote party attempted to close to "+
+		"%x, but committed to upfront shutdown script %x", c.chanPoint,
+		[]byte(script), []byte(upfrontScript))
+
+	return ErrUpfrontShutdownScriptMismatch
+}
+
 // initChanShutdown begins the shutdown process by un-registering the channel,
 // and creating a valid shutdown message to our target delivery address.
 func (c *channelCloser) initChanShutdown() (*lnwire.Shutdown, error) {
@@ -262,6 +291,37 @@ func (c *channelCloser) CloseRequest() *htlcswitch.ChanClose {
 	return c.closeReq
 }
 
+// closingSummary returns the pending close summary of the channel reported
+// once the passed closing transaction has been broadcast. Its close height
+// remains unset until the transaction confirms.
+func (c *channelCloser) closingSummary(
+	closeTx *wire.MsgTx) *channeldb.ChannelCloseSummary {
+
+	chanState := c.cfg.channel.State()
+
+	// Our settled balance is the output paying to our delivery script, if
+	// it isn't dust.
+	var settledBalance btcutil.Amount
+	for _, txOut := range closeTx.TxOut {
+		if bytes.Equal(txOut.PkScript, c.localDeliveryScript) {
+			settledBalance = btcutil.Amount(txOut.Value)
+			break
+		}
+	}
+
+	return &channeldb.ChannelCloseSummary{
+		ChanPoint:      c.chanPoint,
+		ChainHash:      chanState.ChainHash,
+		ClosingTXID:    closeTx.TxHash(),
+		RemotePub:      chanState.IdentityPub,
+		Capacity:       chanState.Capacity,
+		SettledBalance: settledBalance,
+		CloseType:      channeldb.CooperativeClose,
+		ShortChanID:    chanState.ShortChanID(),
+		IsPending:      true,
+	}
+}
+
 // ProcessCloseMsg attempts to process the next message in the closing series.
 // This method will update the state accordingly and return two primary values:
 // the next set of messages to be sent, and a bool indicating if the fee
@@ -283,8 +343,12 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 		}
 
 		// Next, we'll note the other party's preference for their
-		// delivery address. We'll use this when we craft the closure
-		// transaction.
+		// delivery address, after ensuring it matches the script they
+		// may have committed to. We'll use this when we craft the
+		// closure transaction.
+		if err := c.matchUpfrontShutdown(shutDownMsg.Address); err != nil {
+			return nil, false, err
+		}
 		c.remoteDeliveryScript = shutDownMsg.Address
 
 		// We'll generate a shutdown message of our own to send across
@@ -333,7 +397,11 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 		}
 
 		// Now that we know this is a valid shutdown message, we'll
-		// record their preferred delivery closing script.
+		// record their preferred delivery closing script, after
+		// ensuring it matches the script they may have committed to.
+		if err := c.matchUpfrontShutdown(shutDownMsg.Address); err != nil {
+			return nil, false, err
+		}
 		c.remoteDeliveryScript = shutDownMsg.Address
 
 		// At this point, we can now start the fee negotiation state,
@@ -433,12 +501,18 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			newLogClosure(func() string {
 				return spew.Sdump(closeTx)
 			}))
-		if err := c.cfg.broadcastTx(closeTx); err != nil {
+		closeLabel := &channeldb.TxLabel{
+			Category: channeldb.TxCategoryCooperativeClose,
+			Label: fmt.Sprintf("Cooperative close of "+
+				"ChannelPoint(%v)", c.chanPoint),
+		}
+		if err := c.cfg.broadcastTx(closeTx, closeLabel); err != nil {
 			return nil, false, err
 		}
 		if c.cfg.channel.MarkCommitmentBroadcasted(); err != nil {
 			return nil, false, err
 		}
+		c.cfg.notifyClosing(c.closingSummary(closeTx))
 
 		// We'll attempt to disable the channel in the background to
 		// avoid blocking due to sending the update message to all
@@ -0,0 +1,112 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// chanStatusOverrideBucket is the name of the bucket that stores the
+	// status the user manually set for a channel, keyed by the channel's
+	// funding outpoint.
+	chanStatusOverrideBucket = []byte("chan-status-overrides")
+)
+
+// ChanStatusOverride is the status the user manually set for a channel,
+// overriding the status that would otherwise be advertised for it based on
+// whether the channel is active.
+type ChanStatusOverride uint8
+
+const (
+	// ChanStatusAuto indicates that no status was set by the user, so the
+	// channel is enabled and disabled automatically.
+	ChanStatusAuto ChanStatusOverride = 0
+
+	// ChanStatusEnabled indicates that the user enabled the channel, so
+	// it remains enabled even while it's inactive.
+	ChanStatusEnabled ChanStatusOverride = 1
+
+	// ChanStatusDisabled indicates that the user disabled the channel, so
+	// it remains disabled even while it's active.
+	ChanStatusDisabled ChanStatusOverride = 2
+)
+
+// String returns a human readable name for the status override.
+func (s ChanStatusOverride) String() string {
+	switch s {
+	case ChanStatusAuto:
+		return "Auto"
+	case ChanStatusEnabled:
+		return "Enabled"
+	case ChanStatusDisabled:
+		return "Disabled"
+	default:
+		return fmt.Sprintf("ChanStatusOverride(%v)", uint8(s))
+	}
+}
+
+// PutChanStatusOverride stores the status the user set for the channel with
+// the given funding outpoint. Setting ChanStatusAuto removes any status stored
+// for the channel.
+func (d *DB) PutChanStatusOverride(chanPoint wire.OutPoint,
+	status ChanStatusOverride) error {
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, &chanPoint); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		overrides, err := tx.CreateBucketIfNotExists(
+			chanStatusOverrideBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		if status == ChanStatusAuto {
+			return overrides.Delete(b.Bytes())
+		}
+
+		return overrides.Put(b.Bytes(), []byte{byte(status)})
+	})
+}
+
+// FetchChanStatusOverrides returns the statuses the user set for channels,
+// keyed by their funding outpoint. Channels without an override aren't
+// included.
+func (d *DB) FetchChanStatusOverrides() (map[wire.OutPoint]ChanStatusOverride,
+	error) {
+
+	statuses := make(map[wire.OutPoint]ChanStatusOverride)
+	err := d.View(func(tx *bolt.Tx) error {
+		overrides := tx.Bucket(chanStatusOverrideBucket)
+		if overrides == nil {
+			return nil
+		}
+
+		return overrides.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			if len(v) != 1 {
+				return fmt.Errorf("invalid status override "+
+					"for ChannelPoint(%v)", chanPoint)
+			}
+
+			statuses[chanPoint] = ChanStatusOverride(v[0])
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,67 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestChanStatusOverrides tests that channel status overrides can be stored,
+// replaced and removed.
+func TestChanStatusOverrides(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	assertOverrides := func(expected map[wire.OutPoint]ChanStatusOverride) {
+		t.Helper()
+
+		overrides, err := cdb.FetchChanStatusOverrides()
+		if err != nil {
+			t.Fatalf("unable to fetch overrides: %v", err)
+		}
+		if !reflect.DeepEqual(overrides, expected) {
+			t.Fatalf("expected overrides %v, got %v", expected,
+				overrides)
+		}
+	}
+
+	// Before anything is stored, no overrides should be found.
+	assertOverrides(map[wire.OutPoint]ChanStatusOverride{})
+
+	chanPoint1 := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 0}
+
+	err = cdb.PutChanStatusOverride(chanPoint1, ChanStatusDisabled)
+	if err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	err = cdb.PutChanStatusOverride(chanPoint2, ChanStatusEnabled)
+	if err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	assertOverrides(map[wire.OutPoint]ChanStatusOverride{
+		chanPoint1: ChanStatusDisabled,
+		chanPoint2: ChanStatusEnabled,
+	})
+
+	// Storing a new status should replace the old one, while setting the
+	// status back to auto should remove it.
+	err = cdb.PutChanStatusOverride(chanPoint1, ChanStatusEnabled)
+	if err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	err = cdb.PutChanStatusOverride(chanPoint2, ChanStatusAuto)
+	if err != nil {
+		t.Fatalf("unable to remove override: %v", err)
+	}
+	assertOverrides(map[wire.OutPoint]ChanStatusOverride{
+		chanPoint1: ChanStatusEnabled,
+	})
+}
@@ -65,6 +65,28 @@ var (
 	// TODO(roasbeef): rename to commit chain?
 	commitDiffKey = []byte("commit-diff-key")
 
+	// localUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the upfront
+	// shutdown script we committed to when opening the channel, if any.
+	localUpfrontShutdownKey = []byte("local-upfront-shutdown-key")
+
+	// remoteUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the upfront
+	// shutdown script the remote party committed to when opening the
+	// channel, if any.
+	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
+
+	// realShortChanIDKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the short channel ID
+	// of a zero-conf channel's funding output once its funding
+	// transaction has confirmed.
+	realShortChanIDKey = []byte("real-short-chan-id-key")
+
+	// peerAliasKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the alias short
+	// channel ID the remote peer knows a zero-conf channel by.
+	peerAliasKey = []byte("peer-alias-key")
+
 	// revocationLogBucket is dedicated for storing the necessary delta
 	// state between channel updates required to re-construct a past state
 	// in order to punish a counterparty attempting a non-cooperative
@@ -102,8 +124,8 @@ var (
 	ErrNoCommitPoint = fmt.Errorf("no commit point found")
 )
 
-// ChannelType is an enum-like type that describes one of several possible
-// channel types. Each open channel is associated with a particular type as the
+// ChannelType is a bit field that describes one of several possible channel
+// types. Each open channel is associated with a particular type as the
 // channel type may determine how higher level operations are conducted such as
 // fee negotiation, channel closing, the format of HTLCs, etc.
 // TODO(roasbeef): split up per-chain?
@@ -115,14 +137,51 @@ const (
 
 	// SingleFunder represents a channel wherein one party solely funds the
 	// entire capacity of the channel.
-	SingleFunder = 0
+	SingleFunder ChannelType = 0
 
 	// DualFunder represents a channel wherein both parties contribute
 	// funds towards the total capacity of the channel. The channel may be
 	// funded symmetrically or asymmetrically.
-	DualFunder = 1
+	DualFunder ChannelType = 1 << 0
+
+	// StaticRemoteKeyBit is set for channels whose commitments pay the
+	// to_remote output directly to the untweaked payment base point of
+	// the party that doesn't own the commitment. This allows that party
+	// to sweep their funds from a remote force close without knowing the
+	// commitment point of the broadcast state.
+	StaticRemoteKeyBit ChannelType = 1 << 1
+
+	// AnchorOutputsBit is set for channels whose commitments carry two
+	// small anchor outputs, one for each party, allowing either party to
+	// bump the fee of a broadcast commitment through CPFP. All other
+	// outputs of these commitments can only be spent once the commitment
+	// has confirmed. Channels with this bit set always use a static remote
+	// key as well.
+	AnchorOutputsBit ChannelType = 1 << 2
 )
 
+// IsSingleFunder returns true if the channel type if one of the known single
+// funder variants.
+func (c ChannelType) IsSingleFunder() bool {
+	return c&DualFunder == 0
+}
+
+// IsDualFunder returns true if the ChannelType has the DualFunder bit set.
+func (c ChannelType) IsDualFunder() bool {
+	return c&DualFunder == DualFunder
+}
+
+// HasStaticRemoteKey returns true if the channel's commitments use a static
+// remote key for the to_remote output.
+func (c ChannelType) HasStaticRemoteKey() bool {
+	return c&StaticRemoteKeyBit == StaticRemoteKeyBit
+}
+
+// HasAnchors returns true if the channel's commitments use anchor outputs.
+func (c ChannelType) HasAnchors() bool {
+	return c&AnchorOutputsBit == AnchorOutputsBit
+}
+
 // ChannelConstraints represents a set of constraints meant to allow a node to
 // limit their exposure, enact flow control and ensure that all HTLCs are
 // economically relevant. This struct will be mirrored for both sides of the
@@ -355,8 +414,22 @@ type OpenChannel struct {
 	// ShortChannelID encodes the exact location in the chain in which the
 	// channel was initially confirmed. This includes: the block height,
 	// transaction index, and the output within the target transaction.
+	//
+	// NOTE: For zero-conf channels, this is an alias that's assigned once
+	// the funding flow completes, and kept for the lifetime of the
+	// channel, as it identifies the channel's forwarding state.
 	ShortChannelID lnwire.ShortChannelID
 
+	// RealShortChannelID is the location in the chain of the funding
+	// output of a zero-conf channel. It's only set once the funding
+	// transaction has confirmed, and reset if it's reorged out again.
+	RealShortChannelID lnwire.ShortChannelID
+
+	// PeerAlias is the alias short channel ID the remote peer knows a
+	// zero-conf channel by, and which it may hand out in route hints. It's
+	// set once the peer's FundingLocked message was received.
+	PeerAlias lnwire.ShortChannelID
+
 	// IsPending indicates whether a channel's funding transaction has been
 	// confirmed.
 	IsPending bool
@@ -406,6 +479,16 @@ type OpenChannel struct {
 	// RemoteChanCfg is the channel configuration for the remote node.
 	RemoteChanCfg ChannelConfig
 
+	// LocalShutdownScript is the script we committed to paying our funds
+	// to upon a cooperative close of the channel. If empty, we may pay
+	// our funds to any script.
+	LocalShutdownScript lnwire.DeliveryAddress
+
+	// RemoteShutdownScript is the script the remote party committed to
+	// paying its funds to upon a cooperative close of the channel. If
+	// empty, the remote party may pay its funds to any script.
+	RemoteShutdownScript lnwire.DeliveryAddress
+
 	// LocalCommitment is the current local commitment state for the local
 	// party. This is stored distinct from the state of the remote party
 	// as there are certain asymmetric parameters which affect the
@@ -450,8 +533,10 @@ type OpenChannel struct {
 	// outpoint. Upon restarts, this txn will be rebroadcast if the channel
 	// is found to be pending.
 	//
-	// NOTE: This value will only be populated for single-funder channels
-	// for which we are the initiator.
+	// NOTE: This value will only be populated for channels we initiated,
+	// and for dual-funder channels. In the latter case the responder only
+	// holds its own input witnesses until the initiator's have been
+	// received.
 	FundingTxn *wire.MsgTx
 
 	// TODO(roasbeef): eww
@@ -480,6 +565,39 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// IsZeroConf returns true if the channel could be used before its funding
+// transaction confirmed.
+func (c *OpenChannel) IsZeroConf() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.NumConfsRequired == 0
+}
+
+// RealShortChanID returns the short channel ID pointing to the funding output
+// of this channel within the chain. For zero-conf channels, whose
+// ShortChannelID is an alias, this is zero until the funding transaction has
+// confirmed.
+func (c *OpenChannel) RealShortChanID() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ShortChannelID.IsAlias() {
+		return c.RealShortChannelID
+	}
+
+	return c.ShortChannelID
+}
+
+// PeerAliasShortChanID returns the alias short channel ID the remote peer
+// knows this zero-conf channel by, or zero if it hasn't told us about one.
+func (c *OpenChannel) PeerAliasShortChanID() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.PeerAlias
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -494,7 +612,7 @@ func (c *OpenChannel) RefreshShortChanID() error {
 	c.Lock()
 	defer c.Unlock()
 
-	var sid lnwire.ShortChannelID
+	var sid, realSid lnwire.ShortChannelID
 	err := c.Db.View(func(tx *bolt.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
@@ -509,6 +627,7 @@ func (c *OpenChannel) RefreshShortChanID() error {
 		}
 
 		sid = channel.ShortChannelID
+		realSid = channel.RealShortChannelID
 
 		return nil
 	})
@@ -517,6 +636,7 @@ func (c *OpenChannel) RefreshShortChanID() error {
 	}
 
 	c.ShortChannelID = sid
+	c.RealShortChannelID = realSid
 	c.Packager = NewChannelPackager(sid)
 
 	return nil
@@ -641,6 +761,103 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// UpdateFundingTxn replaces the stored funding transaction of a pending
+// channel. The responder of a dual funder channel uses this to store the
+// funding transaction once the initiator's input witnesses are known.
+func (c *OpenChannel) UpdateFundingTxn(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.FundingTxn = fundingTx
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	c.FundingTxn = fundingTx
+
+	return nil
+}
+
+// MarkRealShortChanID records the location of the funding output of a
+// zero-conf channel within the chain, once its funding transaction has
+// confirmed. A zero short channel ID marks the funding transaction as
+// unconfirmed again, which is the case if it got reorged out.
+func (c *OpenChannel) MarkRealShortChanID(realLoc lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if realLoc == (lnwire.ShortChannelID{}) {
+			return chanBucket.Delete(realShortChanIDKey)
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, realLoc); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(realShortChanIDKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.RealShortChannelID = realLoc
+
+	return nil
+}
+
+// MarkPeerAlias records the alias short channel ID the remote peer knows this
+// zero-conf channel by, so HTLCs addressed to it can be forwarded over the
+// channel.
+func (c *OpenChannel) MarkPeerAlias(alias lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, alias); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(peerAliasKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.PeerAlias = alias
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -1911,6 +2128,13 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 			return err
 		}
 
+		// A forwarding policy the user set manually no longer applies
+		// once the channel is gone.
+		err = deleteFeePolicyOverride(tx, chanPointBuf.Bytes())
+		if err != nil {
+			return err
+		}
+
 		// Finally, create a summary of this channel in the closed
 		// channel bucket for this node.
 		return putChannelCloseSummary(
@@ -2230,8 +2454,10 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For channels that we initiated, write the funding txn. As both
+	// parties contribute inputs to a dual funder channel, the responder
+	// stores it as well so it's able to rebroadcast it.
+	if channel.IsInitiator || channel.ChanType.IsDualFunder() {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2244,7 +2470,57 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return chanBucket.Put(chanInfoKey, w.Bytes())
+	if err := chanBucket.Put(chanInfoKey, w.Bytes()); err != nil {
+		return err
+	}
+
+	// The upfront shutdown scripts are optional, so they're only written
+	// if they were committed to when opening the channel.
+	if len(channel.LocalShutdownScript) != 0 {
+		err := chanBucket.Put(
+			localUpfrontShutdownKey, channel.LocalShutdownScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if len(channel.RemoteShutdownScript) != 0 {
+		err := chanBucket.Put(
+			remoteUpfrontShutdownKey, channel.RemoteShutdownScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The real short channel ID is only known for zero-conf channels
+	// whose funding transaction has confirmed.
+	if channel.RealShortChannelID != (lnwire.ShortChannelID{}) {
+		var b bytes.Buffer
+		err := WriteElement(&b, channel.RealShortChannelID)
+		if err != nil {
+			return err
+		}
+
+		err = chanBucket.Put(realShortChanIDKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Likewise, the peer's alias is only known for zero-conf channels.
+	if channel.PeerAlias != (lnwire.ShortChannelID{}) {
+		var b bytes.Buffer
+		if err := WriteElement(&b, channel.PeerAlias); err != nil {
+			return err
+		}
+
+		if err := chanBucket.Put(peerAliasKey, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func serializeChanCommit(w io.Writer, c *ChannelCommitment) error {
@@ -2340,8 +2616,9 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For channels that we initiated, or that both parties funded, read
+	// the funding txn.
+	if channel.IsInitiator || channel.ChanType.IsDualFunder() {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2354,6 +2631,35 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
+	// Finally, we'll read the upfront shutdown scripts if they were
+	// committed to. As the values returned by bolt are only valid during
+	// the transaction, we'll copy them.
+	if script := chanBucket.Get(localUpfrontShutdownKey); script != nil {
+		channel.LocalShutdownScript = append(
+			lnwire.DeliveryAddress(nil), script...,
+		)
+	}
+	if script := chanBucket.Get(remoteUpfrontShutdownKey); script != nil {
+		channel.RemoteShutdownScript = append(
+			lnwire.DeliveryAddress(nil), script...,
+		)
+	}
+
+	if realSid := chanBucket.Get(realShortChanIDKey); realSid != nil {
+		err := ReadElement(
+			bytes.NewReader(realSid), &channel.RealShortChannelID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if alias := chanBucket.Get(peerAliasKey); alias != nil {
+		err := ReadElement(bytes.NewReader(alias), &channel.PeerAlias)
+		if err != nil {
+			return err
+		}
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return nil
@@ -2456,6 +2762,13 @@ func deleteOpenChannel(chanBucket *bolt.Bucket, chanPointBytes []byte) error {
 		return err
 	}
 
+	if err := chanBucket.Delete(localUpfrontShutdownKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(remoteUpfrontShutdownKey); err != nil {
+		return err
+	}
+
 	if diff := chanBucket.Get(commitDiffKey); diff != nil {
 		return chanBucket.Delete(commitDiffKey)
 	}
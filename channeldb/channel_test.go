@@ -215,6 +215,12 @@ func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 		RemoteChanCfg:     remoteCfg,
 		TotalMSatSent:     8,
 		TotalMSatReceived: 2,
+		LocalShutdownScript: lnwire.DeliveryAddress(
+			bytes.Repeat([]byte{2}, 22),
+		),
+		RemoteShutdownScript: lnwire.DeliveryAddress(
+			bytes.Repeat([]byte{3}, 34),
+		),
 		LocalCommitment: ChannelCommitment{
 			CommitHeight:  0,
 			LocalBalance:  lnwire.MilliSatoshi(9000),
@@ -953,3 +959,178 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestMarkRealShortChanID tests that the real short channel ID of a zero-conf
+// channel is persisted alongside its alias, and that it can be reset in case
+// the funding transaction is reorged out.
+func TestMarkRealShortChanID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// First create a zero-conf test channel.
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	state.NumConfsRequired = 0
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// The channel is marked as open using an alias right away.
+	alias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkAsOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	if !state.IsZeroConf() {
+		t.Fatalf("expected channel to be zero-conf")
+	}
+	if state.RealShortChanID() != (lnwire.ShortChannelID{}) {
+		t.Fatalf("expected no real short_chan_id, got %v",
+			state.RealShortChanID())
+	}
+
+	fetchChannel := func() *OpenChannel {
+		openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+		if err != nil {
+			t.Fatalf("unable to fetch open channels: %v", err)
+		}
+		if len(openChannels) != 1 {
+			t.Fatalf("expected 1 open channel, got %v",
+				len(openChannels))
+		}
+
+		return openChannels[0]
+	}
+
+	// Once the funding transaction confirms, its location is recorded,
+	// while the channel is still identified by its alias.
+	realLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkRealShortChanID(realLoc); err != nil {
+		t.Fatalf("unable to mark real short_chan_id: %v", err)
+	}
+
+	channel := fetchChannel()
+	if channel.RealShortChanID() != realLoc {
+		t.Fatalf("expected real short_chan_id %v, got %v", realLoc,
+			channel.RealShortChanID())
+	}
+	if channel.ShortChanID() != alias {
+		t.Fatalf("expected short_chan_id %v, got %v", alias,
+			channel.ShortChanID())
+	}
+	if channel.Packager.(*ChannelPackager).source != alias {
+		t.Fatalf("channel packager source should remain the alias: "+
+			"want %v, got %v", alias,
+			channel.Packager.(*ChannelPackager).source)
+	}
+
+	// Should the funding transaction be reorged out, the real short
+	// channel ID is reset.
+	if err := state.MarkRealShortChanID(lnwire.ShortChannelID{}); err != nil {
+		t.Fatalf("unable to reset real short_chan_id: %v", err)
+	}
+	if err := channel.RefreshShortChanID(); err != nil {
+		t.Fatalf("unable to refresh short_chan_id: %v", err)
+	}
+	if channel.RealShortChanID() != (lnwire.ShortChannelID{}) {
+		t.Fatalf("expected real short_chan_id to be reset, got %v",
+			channel.RealShortChanID())
+	}
+
+	// The alias the peer knows the channel by is persisted as well.
+	peerAlias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight + 1,
+		TxIndex:     20,
+		TxPosition:  25,
+	}
+	if err := state.MarkPeerAlias(peerAlias); err != nil {
+		t.Fatalf("unable to mark peer alias: %v", err)
+	}
+
+	channel = fetchChannel()
+	if channel.PeerAliasShortChanID() != peerAlias {
+		t.Fatalf("expected peer alias %v, got %v", peerAlias,
+			channel.PeerAliasShortChanID())
+	}
+	if channel.ShortChanID() != alias {
+		t.Fatalf("expected short_chan_id %v, got %v", alias,
+			channel.ShortChanID())
+	}
+}
+
+// TestUpdateFundingTxn tests that the responder of a dual funder channel
+// persists the funding transaction, and that it can be replaced once the
+// initiator's input witnesses are known.
+func TestUpdateFundingTxn(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	state.ChanType = DualFunder
+	state.IsInitiator = false
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	fetchFundingTxn := func() *wire.MsgTx {
+		pendingChannels, err := cdb.FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(pendingChannels) != 1 {
+			t.Fatalf("expected 1 pending channel, got %v",
+				len(pendingChannels))
+		}
+
+		return pendingChannels[0].FundingTxn
+	}
+
+	if !reflect.DeepEqual(fetchFundingTxn(), testTx) {
+		t.Fatalf("funding txn not persisted for dual funder responder")
+	}
+
+	signedTx := testTx.Copy()
+	signedTx.TxIn[0].Witness = wire.TxWitness{[]byte("sig"), []byte("key")}
+	if err := state.UpdateFundingTxn(signedTx); err != nil {
+		t.Fatalf("unable to update funding txn: %v", err)
+	}
+
+	if !reflect.DeepEqual(fetchFundingTxn(), signedTx) {
+		t.Fatalf("updated funding txn not persisted")
+	}
+	if state.FundingTxn != signedTx {
+		t.Fatalf("funding txn not updated in memory")
+	}
+}
@@ -88,6 +88,12 @@ var (
 			number:    7,
 			migration: migrateOptionalChannelCloseSummaryFields,
 		},
+		{
+			// The DB version that added the forwarding stats
+			// index, aggregating the forwarding log per period.
+			number:    8,
+			migration: migrateForwardingStatsIndex,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
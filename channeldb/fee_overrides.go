@@ -0,0 +1,101 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// feePolicyOverrideBucket is the name of the bucket that stores the
+	// funding outpoints of the channels whose forwarding policy was set
+	// manually by the user.
+	feePolicyOverrideBucket = []byte("fee-policy-overrides")
+)
+
+// PutFeePolicyOverrides records whether the forwarding policies of the
+// channels with the given funding outpoints were set manually by the user. If
+// no channels are given, the record is updated for all open channels.
+// Channels with a manual policy are left alone by the automatic fee policy
+// manager. Passing false removes the record, handing the channels back to the
+// manager.
+func (d *DB) PutFeePolicyOverrides(manual bool,
+	chanPoints ...wire.OutPoint) error {
+
+	if len(chanPoints) == 0 {
+		openChannels, err := d.FetchAllOpenChannels()
+		if err != nil {
+			return err
+		}
+		for _, channel := range openChannels {
+			chanPoints = append(chanPoints, channel.FundingOutpoint)
+		}
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		overrides, err := tx.CreateBucketIfNotExists(
+			feePolicyOverrideBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, chanPoint := range chanPoints {
+			var b bytes.Buffer
+			if err := writeOutpoint(&b, &chanPoint); err != nil {
+				return err
+			}
+
+			if !manual {
+				err = overrides.Delete(b.Bytes())
+			} else {
+				err = overrides.Put(b.Bytes(), []byte{1})
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// deleteFeePolicyOverride removes the record of a manual forwarding policy
+// for the channel with the given serialized funding outpoint, if any.
+func deleteFeePolicyOverride(tx *bolt.Tx, chanPoint []byte) error {
+	overrides := tx.Bucket(feePolicyOverrideBucket)
+	if overrides == nil {
+		return nil
+	}
+
+	return overrides.Delete(chanPoint)
+}
+
+// FetchFeePolicyOverrides returns the funding outpoints of all channels whose
+// forwarding policy was set manually by the user.
+func (d *DB) FetchFeePolicyOverrides() (map[wire.OutPoint]struct{}, error) {
+	chanPoints := make(map[wire.OutPoint]struct{})
+	err := d.View(func(tx *bolt.Tx) error {
+		overrides := tx.Bucket(feePolicyOverrideBucket)
+		if overrides == nil {
+			return nil
+		}
+
+		return overrides.ForEach(func(k, _ []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			chanPoints[chanPoint] = struct{}{}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanPoints, nil
+}
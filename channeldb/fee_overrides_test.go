@@ -0,0 +1,122 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// assertFeePolicyOverrides asserts that the database holds the expected fee
+// policy overrides.
+func assertFeePolicyOverrides(t *testing.T, cdb *DB,
+	expected map[wire.OutPoint]struct{}) {
+
+	t.Helper()
+
+	overrides, err := cdb.FetchFeePolicyOverrides()
+	if err != nil {
+		t.Fatalf("unable to fetch overrides: %v", err)
+	}
+	if !reflect.DeepEqual(overrides, expected) {
+		t.Fatalf("expected overrides %v, got %v", expected, overrides)
+	}
+}
+
+// TestFeePolicyOverrides tests that manual fee policy overrides can be stored
+// and removed.
+func TestFeePolicyOverrides(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Before anything is stored, no overrides should be found.
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{})
+
+	chanPoint1 := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 0}
+
+	if err := cdb.PutFeePolicyOverrides(true, chanPoint1); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	if err := cdb.PutFeePolicyOverrides(true, chanPoint2); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{
+		chanPoint1: {},
+		chanPoint2: {},
+	})
+
+	// Storing an override twice is harmless, while clearing it should
+	// remove it.
+	if err := cdb.PutFeePolicyOverrides(true, chanPoint1); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	if err := cdb.PutFeePolicyOverrides(false, chanPoint2); err != nil {
+		t.Fatalf("unable to remove override: %v", err)
+	}
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{
+		chanPoint1: {},
+	})
+}
+
+// TestFeePolicyOverridesOpenChannels tests that overrides without target
+// channels apply to all open channels, and that the override of a channel is
+// removed once it's closed.
+func TestFeePolicyOverridesOpenChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := state.FullSync(); err != nil {
+		t.Fatalf("unable to save channel state: %v", err)
+	}
+	chanPoint := state.FundingOutpoint
+
+	// An override for all channels should only cover the open channel.
+	if err := cdb.PutFeePolicyOverrides(true); err != nil {
+		t.Fatalf("unable to store overrides: %v", err)
+	}
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{
+		chanPoint: {},
+	})
+
+	// Clearing the overrides of all channels hands the channel back.
+	if err := cdb.PutFeePolicyOverrides(false); err != nil {
+		t.Fatalf("unable to remove overrides: %v", err)
+	}
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{})
+
+	// Finally, closing a channel should remove its override, leaving those
+	// of other channels in place.
+	otherChanPoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	err = cdb.PutFeePolicyOverrides(true, chanPoint, otherChanPoint)
+	if err != nil {
+		t.Fatalf("unable to store overrides: %v", err)
+	}
+
+	err = state.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: chanPoint,
+		RemotePub: state.IdentityPub,
+		CloseType: CooperativeClose,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertFeePolicyOverrides(t, cdb, map[wire.OutPoint]struct{}{
+		otherChanPoint: {},
+	})
+}
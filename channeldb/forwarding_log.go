@@ -100,7 +100,9 @@ func decodeForwardingEvent(r io.Reader, f *ForwardingEvent) error {
 
 // AddForwardingEvents adds a series of forwarding events to the database.
 // Before inserting, the set of events will be sorted according to their
-// timestamp. This ensures that all writes to disk are sequential.
+// timestamp. This ensures that all writes to disk are sequential. Events
+// that share a timestamp with an already stored event are shifted forward by
+// a nanosecond until their timestamp is unique, as it's used as their key.
 func (f *ForwardingLog) AddForwardingEvents(events []ForwardingEvent) error {
 	// Before we create the database transaction, we'll ensure that the set
 	// of forwarding events are properly sorted according to their
@@ -121,7 +123,16 @@ func (f *ForwardingLog) AddForwardingEvents(events []ForwardingEvent) error {
 			return err
 		}
 
-		// With the bucket obtained, we can now begin to write out the
+		// We'll also fetch the bucket that indexes the aggregated
+		// stats of the log, so we can keep it up to date.
+		statsBucket, err := tx.CreateBucketIfNotExists(
+			forwardingStatsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		// With the buckets obtained, we can now begin to write out the
 		// series of events.
 		for _, event := range events {
 			var eventBytes [forwardingEventSize]byte
@@ -133,6 +144,17 @@ func (f *ForwardingLog) AddForwardingEvents(events []ForwardingEvent) error {
 				timestamp[:], uint64(event.Timestamp.UnixNano()),
 			)
 
+			// The timestamp is the key of the event, so if another
+			// event was already stored at the same nanosecond,
+			// we'd overwrite it while still counting both in the
+			// stats. To avoid that, we shift the event forward a
+			// nanosecond at a time until we find a free slot.
+			for logBucket.Get(timestamp[:]) != nil {
+				nextNano := event.Timestamp.UnixNano() + 1
+				event.Timestamp = time.Unix(0, nextNano)
+				byteOrder.PutUint64(timestamp[:], uint64(nextNano))
+			}
+
 			// With the key encoded, we'll then encode the event
 			// into our buffer, then write it out to disk.
 			err := encodeForwardingEvent(eventBuf, &event)
@@ -143,6 +165,13 @@ func (f *ForwardingLog) AddForwardingEvents(events []ForwardingEvent) error {
 			if err != nil {
 				return err
 			}
+
+			// Finally, we'll add the event to the stats of its
+			// period.
+			err = addForwardingStats(statsBucket, &event)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
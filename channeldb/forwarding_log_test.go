@@ -263,3 +263,95 @@ func TestForwardingLogQueryLimit(t *testing.T) {
 			timeSlice.LastIndexOffset)
 	}
 }
+
+// TestForwardingLogTimestampCollision tests that events sharing the same
+// timestamp don't overwrite each other, and that each of them is counted
+// exactly once in the aggregated stats.
+func TestForwardingLogTimestampCollision(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := ForwardingLog{
+		db: db,
+	}
+
+	timestamp := time.Unix(0, 0).Add(1000 * time.Hour)
+	chanA := lnwire.NewShortChanIDFromInt(1)
+	chanB := lnwire.NewShortChanIDFromInt(2)
+
+	newEvent := func(amt lnwire.MilliSatoshi) ForwardingEvent {
+		return ForwardingEvent{
+			Timestamp:      timestamp,
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          amt + 10,
+			AmtOut:         amt,
+		}
+	}
+
+	// We'll add two events with the same timestamp within a single batch,
+	// followed by two more with that timestamp in a second batch, so both
+	// collisions within a transaction and with already stored events are
+	// covered.
+	events := []ForwardingEvent{newEvent(1000), newEvent(2000)}
+	if err := log.AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+	events = []ForwardingEvent{newEvent(3000), newEvent(4000)}
+	if err := log.AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+
+	// All four events should have been stored, each shifted a nanosecond
+	// after the prior one. As events with equal timestamps may be sorted
+	// in any order within a batch, we only check that every amount is
+	// present once.
+	timeSlice, err := log.Query(ForwardingEventQuery{
+		StartTime:    timestamp,
+		EndTime:      timestamp.Add(time.Second),
+		NumMaxEvents: 1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to query for events: %v", err)
+	}
+	if len(timeSlice.ForwardingEvents) != 4 {
+		t.Fatalf("wrong number of events: expected %v, got %v", 4,
+			len(timeSlice.ForwardingEvents))
+	}
+	amts := make(map[lnwire.MilliSatoshi]struct{})
+	for i, event := range timeSlice.ForwardingEvents {
+		expectedTime := timestamp.Add(time.Duration(i))
+		if !event.Timestamp.Equal(expectedTime) {
+			t.Fatalf("wrong timestamp for event %v: expected %v, "+
+				"got %v", i, expectedTime, event.Timestamp)
+		}
+		amts[event.AmtOut] = struct{}{}
+	}
+	for _, amt := range []lnwire.MilliSatoshi{1000, 2000, 3000, 4000} {
+		if _, ok := amts[amt]; !ok {
+			t.Fatalf("event with amount %v not found", amt)
+		}
+	}
+
+	// The stats should count every stored event exactly once.
+	stats, err := log.QueryStats(ForwardingStatsQuery{
+		StartTime: timestamp,
+		EndTime:   timestamp.Add(time.Second),
+	})
+	if err != nil {
+		t.Fatalf("unable to query stats: %v", err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for a single channel pair, got %v",
+			spew.Sdump(stats))
+	}
+	if stats[0].NumForwards != 4 || stats[0].AmtOut != 10000 ||
+		stats[0].AmtIn != 10040 {
+
+		t.Fatalf("wrong stats: %v", spew.Sdump(stats[0]))
+	}
+}
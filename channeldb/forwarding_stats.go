@@ -0,0 +1,243 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// forwardingStatsBucket is the bucket that we'll use to store the
+	// forwarding stats index. The index aggregates the forwarding log per
+	// period of ForwardingStatsResolution, and per pair of incoming and
+	// outgoing channels. Each key within the bucket is the start of the
+	// period (in nano seconds since the unix epoch) followed by the
+	// incoming and outgoing chan ID, and the value the aggregated stats of
+	// the forwards between the channels within the period.
+	forwardingStatsBucket = []byte("circuit-fwd-stats")
+)
+
+const (
+	// ForwardingStatsResolution is the period the forwarding stats index
+	// aggregates forwarding events over. Stats can only be queried for
+	// whole periods.
+	ForwardingStatsResolution = time.Hour
+
+	// forwardingStatsKeySize is the size of a key in the forwarding stats
+	// index. The breakdown is as follows:
+	//
+	//  * 8 byte period start || 8 byte incoming chan ID || 8 byte outgoing
+	//    chan ID
+	forwardingStatsKeySize = 24
+
+	// forwardingStatsSize is the size of a value in the forwarding stats
+	// index. The breakdown is as follows:
+	//
+	//  * 8 byte num forwards || 8 byte value in || 8 byte value out
+	forwardingStatsSize = 24
+)
+
+// ForwardingStats holds the aggregated forwarding events between a pair of
+// incoming and outgoing channels within a time bucket.
+type ForwardingStats struct {
+	// BucketStart is the start of the time bucket the stats apply to.
+	BucketStart time.Time
+
+	// IncomingChanID is the incoming channel ID of the forwards.
+	IncomingChanID lnwire.ShortChannelID
+
+	// OutgoingChanID is the outgoing channel ID of the forwards.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// NumForwards is the number of forwards.
+	NumForwards uint64
+
+	// AmtIn is the total amount of the incoming HTLCs.
+	AmtIn lnwire.MilliSatoshi
+
+	// AmtOut is the total amount of the outgoing HTLCs.
+	AmtOut lnwire.MilliSatoshi
+}
+
+// Fees returns the total fees earned by the forwards.
+func (s *ForwardingStats) Fees() lnwire.MilliSatoshi {
+	return s.AmtIn - s.AmtOut
+}
+
+// ForwardingStatsQuery represents a query for the aggregated stats of the
+// forwarding log over a particular time slice.
+type ForwardingStatsQuery struct {
+	// StartTime is the start time of the time slice. It's rounded down to
+	// a multiple of ForwardingStatsResolution.
+	StartTime time.Time
+
+	// EndTime is the end time of the time slice. The period of
+	// ForwardingStatsResolution the end time falls in is included as a
+	// whole.
+	EndTime time.Time
+
+	// BucketSize is the size of the time buckets the stats are grouped
+	// in, starting at the start time. It must be a multiple of
+	// ForwardingStatsResolution. A bucket size of zero groups all stats
+	// into a single bucket.
+	BucketSize time.Duration
+}
+
+// forwardingStatsKey returns the key of the forwarding stats index that the
+// given forwarding event is aggregated under.
+func forwardingStatsKey(event *ForwardingEvent) [forwardingStatsKeySize]byte {
+	var key [forwardingStatsKeySize]byte
+
+	periodStart := event.Timestamp.Truncate(ForwardingStatsResolution)
+	byteOrder.PutUint64(key[:8], uint64(periodStart.UnixNano()))
+	byteOrder.PutUint64(key[8:16], event.IncomingChanID.ToUint64())
+	byteOrder.PutUint64(key[16:], event.OutgoingChanID.ToUint64())
+
+	return key
+}
+
+// addForwardingStats adds the given forwarding event to the aggregated stats
+// of its period and channels within the forwarding stats index.
+func addForwardingStats(statsBucket *bolt.Bucket,
+	event *ForwardingEvent) error {
+
+	key := forwardingStatsKey(event)
+
+	var numForwards, amtIn, amtOut uint64
+	if stats := statsBucket.Get(key[:]); stats != nil {
+		if len(stats) != forwardingStatsSize {
+			return fmt.Errorf("invalid forwarding stats size: %v",
+				len(stats))
+		}
+
+		numForwards = byteOrder.Uint64(stats[:8])
+		amtIn = byteOrder.Uint64(stats[8:16])
+		amtOut = byteOrder.Uint64(stats[16:])
+	}
+
+	var stats [forwardingStatsSize]byte
+	byteOrder.PutUint64(stats[:8], numForwards+1)
+	byteOrder.PutUint64(stats[8:16], amtIn+uint64(event.AmtIn))
+	byteOrder.PutUint64(stats[16:], amtOut+uint64(event.AmtOut))
+
+	return statsBucket.Put(key[:], stats[:])
+}
+
+// QueryStats returns the aggregated stats of the forwarding events within
+// the time slice of the query, grouped per time bucket and pair of incoming
+// and outgoing channels. Rather than scanning the forwarding log itself, the
+// stats are read from an index that aggregates the log per period of
+// ForwardingStatsResolution. The stats are sorted by their bucket start, and
+// then by their channel IDs.
+func (f *ForwardingLog) QueryStats(q ForwardingStatsQuery) ([]ForwardingStats,
+	error) {
+
+	if q.BucketSize < 0 || q.BucketSize%ForwardingStatsResolution != 0 {
+		return nil, fmt.Errorf("bucket size must be a multiple of %v",
+			ForwardingStatsResolution)
+	}
+
+	startTime := q.StartTime.Truncate(ForwardingStatsResolution)
+
+	type statsKey struct {
+		bucket   int64
+		incoming uint64
+		outgoing uint64
+	}
+	aggregates := make(map[statsKey]*ForwardingStats)
+
+	err := f.db.View(func(tx *bolt.Tx) error {
+		// If the bucket wasn't found, then there aren't any forwards
+		// to aggregate.
+		statsBucket := tx.Bucket(forwardingStatsBucket)
+		if statsBucket == nil {
+			return nil
+		}
+
+		var startKey, endKey [8]byte
+		byteOrder.PutUint64(startKey[:], uint64(startTime.UnixNano()))
+		byteOrder.PutUint64(endKey[:], uint64(q.EndTime.UnixNano()))
+
+		cursor := statsBucket.Cursor()
+		k, v := cursor.Seek(startKey[:])
+		for ; k != nil; k, v = cursor.Next() {
+			if bytes.Compare(k[:8], endKey[:]) > 0 {
+				return nil
+			}
+
+			if len(k) != forwardingStatsKeySize ||
+				len(v) != forwardingStatsSize {
+
+				return fmt.Errorf("invalid forwarding stats " +
+					"entry")
+			}
+
+			// Determine the time bucket the period of this entry
+			// falls in.
+			periodStart := int64(byteOrder.Uint64(k[:8]))
+			bucket := startTime.UnixNano()
+			if q.BucketSize != 0 {
+				offset := periodStart - bucket
+				bucket += offset - offset%int64(q.BucketSize)
+			}
+
+			key := statsKey{
+				bucket:   bucket,
+				incoming: byteOrder.Uint64(k[8:16]),
+				outgoing: byteOrder.Uint64(k[16:]),
+			}
+			stats, ok := aggregates[key]
+			if !ok {
+				incoming := lnwire.NewShortChanIDFromInt(
+					key.incoming,
+				)
+				outgoing := lnwire.NewShortChanIDFromInt(
+					key.outgoing,
+				)
+				stats = &ForwardingStats{
+					BucketStart:    time.Unix(0, bucket),
+					IncomingChanID: incoming,
+					OutgoingChanID: outgoing,
+				}
+				aggregates[key] = stats
+			}
+
+			amtIn := byteOrder.Uint64(v[8:16])
+			amtOut := byteOrder.Uint64(v[16:])
+			stats.NumForwards += byteOrder.Uint64(v[:8])
+			stats.AmtIn += lnwire.MilliSatoshi(amtIn)
+			stats.AmtOut += lnwire.MilliSatoshi(amtOut)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]ForwardingStats, 0, len(aggregates))
+	for _, stats := range aggregates {
+		resp = append(resp, *stats)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		a, b := resp[i], resp[j]
+		switch {
+		case !a.BucketStart.Equal(b.BucketStart):
+			return a.BucketStart.Before(b.BucketStart)
+
+		case a.IncomingChanID != b.IncomingChanID:
+			return a.IncomingChanID.ToUint64() <
+				b.IncomingChanID.ToUint64()
+
+		default:
+			return a.OutgoingChanID.ToUint64() <
+				b.OutgoingChanID.ToUint64()
+		}
+	})
+
+	return resp, nil
+}
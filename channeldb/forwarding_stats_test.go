@@ -0,0 +1,167 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestForwardingLogQueryStats tests that the aggregated stats of the
+// forwarding log are properly grouped per time bucket and channel pair.
+func TestForwardingLogQueryStats(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := ForwardingLog{
+		db: db,
+	}
+
+	chanA := lnwire.NewShortChanIDFromInt(1)
+	chanB := lnwire.NewShortChanIDFromInt(2)
+	chanC := lnwire.NewShortChanIDFromInt(3)
+
+	// We'll add forwards over a period of three hours, the last one
+	// falling outside of the queried time slice.
+	startTime := time.Unix(0, 0).Add(1000 * time.Hour)
+	events := []ForwardingEvent{
+		{
+			Timestamp:      startTime.Add(time.Minute),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          1010,
+			AmtOut:         1000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Minute * 30),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          2020,
+			AmtOut:         2000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Minute * 90),
+			IncomingChanID: chanC,
+			OutgoingChanID: chanB,
+			AmtIn:          5050,
+			AmtOut:         5000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Minute * 100),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          3030,
+			AmtOut:         3000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Minute * 150),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanC,
+			AmtIn:          4040,
+			AmtOut:         4000,
+		},
+	}
+	if err := log.AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+
+	assertStats := func(q ForwardingStatsQuery, expected []ForwardingStats) {
+		t.Helper()
+
+		stats, err := log.QueryStats(q)
+		if err != nil {
+			t.Fatalf("unable to query stats: %v", err)
+		}
+		for i := range stats {
+			// Compare the times by value rather than location.
+			stats[i].BucketStart = time.Unix(
+				0, stats[i].BucketStart.UnixNano(),
+			)
+		}
+		if !reflect.DeepEqual(stats, expected) {
+			t.Fatalf("expected stats %v, got %v",
+				spew.Sdump(expected), spew.Sdump(stats))
+		}
+	}
+
+	// With hourly buckets, the forwards of each hour should be grouped
+	// per channel pair.
+	secondHour := startTime.Add(time.Hour)
+	assertStats(ForwardingStatsQuery{
+		StartTime:  startTime.Add(time.Minute * 5),
+		EndTime:    startTime.Add(time.Minute * 110),
+		BucketSize: time.Hour,
+	}, []ForwardingStats{
+		{
+			BucketStart:    startTime,
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			NumForwards:    2,
+			AmtIn:          3030,
+			AmtOut:         3000,
+		},
+		{
+			BucketStart:    secondHour,
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			NumForwards:    1,
+			AmtIn:          3030,
+			AmtOut:         3000,
+		},
+		{
+			BucketStart:    secondHour,
+			IncomingChanID: chanC,
+			OutgoingChanID: chanB,
+			NumForwards:    1,
+			AmtIn:          5050,
+			AmtOut:         5000,
+		},
+	})
+
+	// Without a bucket size, all forwards should fall into a single
+	// bucket.
+	assertStats(ForwardingStatsQuery{
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 110),
+	}, []ForwardingStats{
+		{
+			BucketStart:    startTime,
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			NumForwards:    3,
+			AmtIn:          6060,
+			AmtOut:         6000,
+		},
+		{
+			BucketStart:    startTime,
+			IncomingChanID: chanC,
+			OutgoingChanID: chanB,
+			NumForwards:    1,
+			AmtIn:          5050,
+			AmtOut:         5000,
+		},
+	})
+
+	// A time slice without any forwards should return no stats.
+	assertStats(ForwardingStatsQuery{
+		StartTime: startTime.Add(time.Hour * 5),
+		EndTime:   startTime.Add(time.Hour * 6),
+	}, []ForwardingStats{})
+
+	// Bucket sizes that aren't a multiple of the resolution of the index
+	// should be rejected.
+	_, err = log.QueryStats(ForwardingStatsQuery{
+		StartTime:  startTime,
+		EndTime:    startTime.Add(time.Hour),
+		BucketSize: time.Minute,
+	})
+	if err == nil {
+		t.Fatalf("expected invalid bucket size to be rejected")
+	}
+}
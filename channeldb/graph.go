@@ -2426,6 +2426,11 @@ type ChannelEdgePolicy struct {
 	// in millisatoshi.
 	MinHTLC lnwire.MilliSatoshi
 
+	// MaxHTLC is the largest value HTLC this node will accept, expressed
+	// in millisatoshi. It's only set if the ChanUpdateOptionMaxHtlc flag
+	// is set.
+	MaxHTLC lnwire.MilliSatoshi
+
 	// FeeBaseMSat is the base HTLC fee that will be charged for forwarding
 	// ANY HTLC, expressed in mSAT's.
 	FeeBaseMSat lnwire.MilliSatoshi
@@ -3191,10 +3196,24 @@ func putChanEdgePolicy(edges, nodes *bolt.Bucket, edge *ChannelEdgePolicy,
 		return err
 	}
 
+	// If the max_htlc field is present, we'll write it at the start of
+	// the opaque data, where it's found by older versions that aren't
+	// aware of the field.
+	var opaqueBuf bytes.Buffer
+	if edge.Flags.HasMaxHtlc() {
+		err := binary.Write(&opaqueBuf, byteOrder, uint64(edge.MaxHTLC))
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(edge.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
 		return ErrTooManyExtraOpaqueBytes(len(edge.ExtraOpaqueData))
 	}
-	if err := wire.WriteVarBytes(&b, 0, edge.ExtraOpaqueData); err != nil {
+	if _, err := opaqueBuf.Write(edge.ExtraOpaqueData); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(&b, 0, opaqueBuf.Bytes()); err != nil {
 		return err
 	}
 
@@ -3394,6 +3413,14 @@ func deserializeChanEdgePolicy(r io.Reader,
 		return nil, err
 	}
 
+	// If the max_htlc field is present, it's stored at the start of the
+	// opaque data, so we'll split it off from the rest.
+	if edge.Flags.HasMaxHtlc() && len(edge.ExtraOpaqueData) >= 8 {
+		opaque := edge.ExtraOpaqueData
+		edge.MaxHTLC = lnwire.MilliSatoshi(byteOrder.Uint64(opaque[:8]))
+		edge.ExtraOpaqueData = opaque[8:]
+	}
+
 	edge.Node = &node
 	return edge, nil
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/coreos/bbolt"
 )
@@ -610,3 +611,46 @@ func migrateOptionalChannelCloseSummaryFields(tx *bolt.Tx) error {
 
 	return nil
 }
+
+// migrateForwardingStatsIndex populates the forwarding stats index with the
+// events that were written to the forwarding log before the index existed.
+func migrateForwardingStatsIndex(tx *bolt.Tx) error {
+	logBucket := tx.Bucket(forwardingLogBucket)
+	if logBucket == nil {
+		return nil
+	}
+
+	statsBucket, err := tx.CreateBucketIfNotExists(forwardingStatsBucket)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Populating forwarding stats index...")
+	err = logBucket.ForEach(func(timestamp, events []byte) error {
+		readBuf := bytes.NewReader(events)
+		for readBuf.Len() != 0 {
+			var event ForwardingEvent
+			err := decodeForwardingEvent(readBuf, &event)
+			if err != nil {
+				return err
+			}
+			event.Timestamp = time.Unix(
+				0, int64(byteOrder.Uint64(timestamp)),
+			)
+
+			err = addForwardingStats(statsBucket, &event)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to index forwarding log: %v", err)
+	}
+
+	log.Info("Migration to forwarding stats index complete!")
+
+	return nil
+}
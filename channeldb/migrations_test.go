@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 // TestPaymentStatusesMigration checks that already completed payments will have
@@ -468,3 +470,74 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			false)
 	}
 }
+
+// TestMigrateForwardingStatsIndex checks that the forwarding stats index is
+// populated with the events that are already in the forwarding log.
+func TestMigrateForwardingStatsIndex(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(0, 0).Add(1000 * time.Hour)
+	events := []ForwardingEvent{
+		{
+			Timestamp:      timestamp,
+			IncomingChanID: lnwire.NewShortChanIDFromInt(1),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(2),
+			AmtIn:          1010,
+			AmtOut:         1000,
+		},
+		{
+			Timestamp:      timestamp.Add(time.Minute),
+			IncomingChanID: lnwire.NewShortChanIDFromInt(1),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(2),
+			AmtIn:          2020,
+			AmtOut:         2000,
+		},
+	}
+
+	beforeMigrationFunc := func(d *DB) {
+		err := d.ForwardingLog().AddForwardingEvents(events)
+		if err != nil {
+			t.Fatalf("unable to add events: %v", err)
+		}
+
+		// Remove the index, as it wouldn't exist before the
+		// migration.
+		err = d.Update(func(tx *bolt.Tx) error {
+			return tx.DeleteBucket(forwardingStatsBucket)
+		})
+		if err != nil {
+			t.Fatalf("unable to delete stats index: %v", err)
+		}
+	}
+
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration wasn't applied")
+		}
+
+		stats, err := d.ForwardingLog().QueryStats(ForwardingStatsQuery{
+			StartTime: timestamp,
+			EndTime:   timestamp.Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("unable to query stats: %v", err)
+		}
+		if len(stats) != 1 {
+			t.Fatalf("expected 1 stats entry, got %v", len(stats))
+		}
+		if stats[0].NumForwards != 2 || stats[0].Fees() != 30 {
+			t.Fatalf("unexpected stats: %v", spew.Sdump(stats[0]))
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migrateForwardingStatsIndex,
+		false)
+}
@@ -0,0 +1,238 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// txLabelBucket is the name of the bucket that stores the label and
+	// category of each on-chain transaction created by lnd, keyed by
+	// txid.
+	txLabelBucket = []byte("tx-labels")
+
+	// ErrTxLabelNotFound is returned when no label is stored for a
+	// transaction.
+	ErrTxLabelNotFound = fmt.Errorf("transaction label not found")
+
+	// ErrTxLabelTooLong is returned when attempting to store a label that
+	// exceeds MaxTxLabelLength.
+	ErrTxLabelTooLong = fmt.Errorf("transaction label exceeds %v bytes",
+		MaxTxLabelLength)
+)
+
+// MaxTxLabelLength is the maximum length of a transaction label in bytes.
+const MaxTxLabelLength = 500
+
+// TxCategory describes the purpose of an on-chain transaction created by
+// lnd.
+type TxCategory uint8
+
+const (
+	// TxCategoryUnknown is the category of transactions whose purpose
+	// isn't known, such as transactions that pay to the wallet from the
+	// outside, or transactions created before categories were recorded.
+	TxCategoryUnknown TxCategory = 0
+
+	// TxCategorySend is the category of transactions that send coins to
+	// an address on behalf of the user.
+	TxCategorySend TxCategory = 1
+
+	// TxCategoryChannelFunding is the category of transactions that fund
+	// a channel.
+	TxCategoryChannelFunding TxCategory = 2
+
+	// TxCategoryCooperativeClose is the category of transactions that
+	// cooperatively close a channel.
+	TxCategoryCooperativeClose TxCategory = 3
+
+	// TxCategoryBreachRemedy is the category of justice transactions that
+	// sweep the funds of a channel whose counterparty broadcast a revoked
+	// state.
+	TxCategoryBreachRemedy TxCategory = 4
+
+	// TxCategorySweep is the category of transactions that sweep our
+	// commitment and HTLC outputs back into the wallet after a channel was
+	// force closed.
+	TxCategorySweep TxCategory = 5
+
+	// TxCategoryFundingCancel is the category of transactions that double
+	// spend the inputs of a channel funding transaction back to the
+	// wallet, cancelling the channel.
+	TxCategoryFundingCancel TxCategory = 6
+)
+
+// String returns a human readable name for the category.
+func (c TxCategory) String() string {
+	switch c {
+	case TxCategoryUnknown:
+		return "Unknown"
+	case TxCategorySend:
+		return "Send"
+	case TxCategoryChannelFunding:
+		return "ChannelFunding"
+	case TxCategoryCooperativeClose:
+		return "CooperativeClose"
+	case TxCategoryBreachRemedy:
+		return "BreachRemedy"
+	case TxCategorySweep:
+		return "Sweep"
+	case TxCategoryFundingCancel:
+		return "FundingCancel"
+	default:
+		return fmt.Sprintf("TxCategory(%v)", uint8(c))
+	}
+}
+
+// TxLabel is the persisted description of an on-chain transaction.
+type TxLabel struct {
+	// Category describes the purpose of the transaction.
+	Category TxCategory
+
+	// Label is a free form description of the transaction, either set by
+	// the subsystem that created it, or by the user.
+	Label string
+}
+
+// serializeTxLabel writes the passed label to w.
+func serializeTxLabel(w io.Writer, label *TxLabel) error {
+	if _, err := w.Write([]byte{byte(label.Category)}); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, []byte(label.Label))
+}
+
+// deserializeTxLabel reads a label from r.
+func deserializeTxLabel(r io.Reader) (*TxLabel, error) {
+	var category [1]byte
+	if _, err := io.ReadFull(r, category[:]); err != nil {
+		return nil, err
+	}
+
+	label, err := wire.ReadVarBytes(
+		r, 0, MaxTxLabelLength, "tx label",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxLabel{
+		Category: TxCategory(category[0]),
+		Label:    string(label),
+	}, nil
+}
+
+// PutTxLabel stores the passed label for the transaction with the given txid,
+// replacing any label previously stored for it.
+func (d *DB) PutTxLabel(txid chainhash.Hash, label *TxLabel) error {
+	if len(label.Label) > MaxTxLabelLength {
+		return ErrTxLabelTooLong
+	}
+
+	var b bytes.Buffer
+	if err := serializeTxLabel(&b, label); err != nil {
+		return err
+	}
+
+	return d.Batch(func(tx *bolt.Tx) error {
+		labels, err := tx.CreateBucketIfNotExists(txLabelBucket)
+		if err != nil {
+			return err
+		}
+
+		return labels.Put(txid[:], b.Bytes())
+	})
+}
+
+// SetTxLabel replaces the free form label of the transaction with the given
+// txid, preserving its category. If nothing is stored for the transaction
+// yet, then it's recorded with TxCategoryUnknown.
+func (d *DB) SetTxLabel(txid chainhash.Hash, label string) error {
+	if len(label) > MaxTxLabelLength {
+		return ErrTxLabelTooLong
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		labels, err := tx.CreateBucketIfNotExists(txLabelBucket)
+		if err != nil {
+			return err
+		}
+
+		txLabel := &TxLabel{}
+		if v := labels.Get(txid[:]); v != nil {
+			txLabel, err = deserializeTxLabel(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+		}
+		txLabel.Label = label
+
+		var b bytes.Buffer
+		if err := serializeTxLabel(&b, txLabel); err != nil {
+			return err
+		}
+
+		return labels.Put(txid[:], b.Bytes())
+	})
+}
+
+// FetchTxLabel returns the label stored for the transaction with the given
+// txid. If none is found, ErrTxLabelNotFound is returned.
+func (d *DB) FetchTxLabel(txid chainhash.Hash) (*TxLabel, error) {
+	var label *TxLabel
+	err := d.View(func(tx *bolt.Tx) error {
+		labels := tx.Bucket(txLabelBucket)
+		if labels == nil {
+			return ErrTxLabelNotFound
+		}
+
+		v := labels.Get(txid[:])
+		if v == nil {
+			return ErrTxLabelNotFound
+		}
+
+		var err error
+		label, err = deserializeTxLabel(bytes.NewReader(v))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+// FetchTxLabels returns all stored transaction labels, keyed by txid.
+func (d *DB) FetchTxLabels() (map[chainhash.Hash]*TxLabel, error) {
+	labelIndex := make(map[chainhash.Hash]*TxLabel)
+	err := d.View(func(tx *bolt.Tx) error {
+		labels := tx.Bucket(txLabelBucket)
+		if labels == nil {
+			return nil
+		}
+
+		return labels.ForEach(func(k, v []byte) error {
+			var txid chainhash.Hash
+			copy(txid[:], k)
+
+			label, err := deserializeTxLabel(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			labelIndex[txid] = label
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labelIndex, nil
+}
@@ -0,0 +1,89 @@
+package channeldb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestTxLabels tests that transaction labels can be stored, updated and
+// retrieved.
+func TestTxLabels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	fundingTxid := chainhash.Hash{1}
+	userTxid := chainhash.Hash{2}
+
+	// Before anything is stored, no labels should be found.
+	if _, err := cdb.FetchTxLabel(fundingTxid); err != ErrTxLabelNotFound {
+		t.Fatalf("expected ErrTxLabelNotFound, got %v", err)
+	}
+	labels, err := cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("expected no labels, got %v", len(labels))
+	}
+
+	fundingLabel := &TxLabel{
+		Category: TxCategoryChannelFunding,
+		Label:    "funding",
+	}
+	if err := cdb.PutTxLabel(fundingTxid, fundingLabel); err != nil {
+		t.Fatalf("unable to store label: %v", err)
+	}
+
+	dbLabel, err := cdb.FetchTxLabel(fundingTxid)
+	if err != nil {
+		t.Fatalf("unable to fetch label: %v", err)
+	}
+	if !reflect.DeepEqual(dbLabel, fundingLabel) {
+		t.Fatalf("label mismatch: expected %v, got %v", fundingLabel,
+			dbLabel)
+	}
+
+	// Updating the free form label should preserve the category.
+	if err := cdb.SetTxLabel(fundingTxid, "renamed"); err != nil {
+		t.Fatalf("unable to set label: %v", err)
+	}
+
+	// Labelling a transaction we know nothing about should record it as
+	// uncategorized.
+	if err := cdb.SetTxLabel(userTxid, "rent"); err != nil {
+		t.Fatalf("unable to set label: %v", err)
+	}
+
+	labels, err = cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	expected := map[chainhash.Hash]*TxLabel{
+		fundingTxid: {
+			Category: TxCategoryChannelFunding,
+			Label:    "renamed",
+		},
+		userTxid: {
+			Category: TxCategoryUnknown,
+			Label:    "rent",
+		},
+	}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("labels mismatch: expected %v, got %v", expected,
+			labels)
+	}
+
+	// Finally, labels exceeding the maximum length should be rejected.
+	longLabel := strings.Repeat("a", MaxTxLabelLength+1)
+	if err := cdb.SetTxLabel(userTxid, longLabel); err != ErrTxLabelTooLong {
+		t.Fatalf("expected ErrTxLabelTooLong, got %v", err)
+	}
+}
@@ -0,0 +1,352 @@
+package channelnotifier
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrChannelNotifierExiting is returned when a client attempts to subscribe
+// to channel events while the ChannelNotifier is shutting down.
+var ErrChannelNotifierExiting = errors.New("channel notifier exiting")
+
+// PendingOpenChannelEvent represents a new event where a new channel has
+// entered a pending open state: its funding transaction has been negotiated
+// but not yet confirmed.
+type PendingOpenChannelEvent struct {
+	// ChannelPoint is the funding outpoint of the pending channel.
+	ChannelPoint *wire.OutPoint
+
+	// PendingChannel is the state of the pending channel.
+	PendingChannel *channeldb.OpenChannel
+}
+
+// OpenChannelEvent represents a new event where a channel goes from pending
+// open to open.
+type OpenChannelEvent struct {
+	// Channel is the channel that has become open.
+	Channel *channeldb.OpenChannel
+}
+
+// ActiveChannelEvent represents a new event where a channel becomes active,
+// meaning its link has been added to the switch and is eligible to forward
+// payments.
+type ActiveChannelEvent struct {
+	// ChannelPoint is the funding outpoint of the channel that is now
+	// active.
+	ChannelPoint *wire.OutPoint
+}
+
+// InactiveChannelEvent represents a new event where a channel becomes
+// inactive, meaning its link has been removed from the switch.
+type InactiveChannelEvent struct {
+	// ChannelPoint is the funding outpoint of the channel that is now
+	// inactive.
+	ChannelPoint *wire.OutPoint
+}
+
+// ClosingChannelEvent represents a new event where a channel has started to
+// close. For closes we initiate, and for cooperative closes, this happens as
+// soon as the closing transaction is broadcast. Closes initiated by the remote
+// party are only known once their closing transaction is detected on-chain.
+type ClosingChannelEvent struct {
+	// CloseSummary is the pending close summary of the channel. For closes
+	// that haven't been detected on-chain yet, its close height is unset.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
+// ClosedChannelEvent represents a new event where a channel has been fully
+// resolved on-chain, and no further action is needed for it.
+type ClosedChannelEvent struct {
+	// CloseSummary is the final close summary of the channel.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
+// ChannelEventClient represents an intent to receive notifications from the
+// ChannelNotifier regarding changes to the state of our channels. The Updates
+// channel will be sent upon with each new event in the order they occurred.
+type ChannelEventClient struct {
+	// Updates is a receive only channel that new channel events will be
+	// sent over. Each item will be one of PendingOpenChannelEvent,
+	// OpenChannelEvent, ActiveChannelEvent, InactiveChannelEvent,
+	// ClosingChannelEvent or ClosedChannelEvent.
+	Updates <-chan interface{}
+
+	// Cancel is a function closure that should be executed when the client
+	// wishes to cancel their notification intent. Doing so allows the
+	// ChannelNotifier to free up resources.
+	Cancel func()
+}
+
+// channelEventClient is the internal state the ChannelNotifier keeps for each
+// registered client.
+type channelEventClient struct {
+	// ntfnQueue buffers all events for this client, such that a slow
+	// client never blocks the sub-system dispatching the event.
+	ntfnQueue *queue.ConcurrentQueue
+
+	// updates is the channel that events are proxied to from the queue.
+	updates chan interface{}
+
+	cancelled uint32 // To be used atomically.
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// ChannelNotifier is a sub-system that dispatches notifications about changes
+// in the state of our channels to all registered clients. Other sub-systems
+// such as the funding manager, the switch and the chain arbitrator report
+// events to it, while clients such as the RPC server consume them.
+type ChannelNotifier struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// ntfnClientCounter is an atomically incremented counter used to
+	// assign a unique ID to each new client.
+	ntfnClientCounter uint64
+
+	clientMtx sync.RWMutex
+	clients   map[uint64]*channelEventClient
+
+	// stateMtx guards the sets of active and closing channels, and
+	// serializes the dispatch of events derived from them. As several
+	// sub-systems report the same state change, these sets are used to
+	// only dispatch the first report, and to dispatch the events of a
+	// channel in a consistent order.
+	stateMtx     sync.Mutex
+	activeChans  map[wire.OutPoint]struct{}
+	closingChans map[wire.OutPoint]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new ChannelNotifier.
+func New() *ChannelNotifier {
+	return &ChannelNotifier{
+		clients:      make(map[uint64]*channelEventClient),
+		activeChans:  make(map[wire.OutPoint]struct{}),
+		closingChans: make(map[wire.OutPoint]struct{}),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the ChannelNotifier.
+func (c *ChannelNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals the ChannelNotifier for a graceful shutdown, and waits for all
+// client goroutines to exit.
+func (c *ChannelNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	close(c.quit)
+	c.wg.Wait()
+
+	c.clientMtx.Lock()
+	for clientID, client := range c.clients {
+		client.ntfnQueue.Stop()
+		delete(c.clients, clientID)
+	}
+	c.clientMtx.Unlock()
+
+	return nil
+}
+
+// SubscribeChannelEvents returns a new ChannelEventClient which can be used by
+// the caller to receive notifications whenever the state of one of our
+// channels changes.
+func (c *ChannelNotifier) SubscribeChannelEvents() (*ChannelEventClient,
+	error) {
+
+	select {
+	case <-c.quit:
+		return nil, ErrChannelNotifierExiting
+	default:
+	}
+
+	clientID := atomic.AddUint64(&c.ntfnClientCounter, 1)
+
+	client := &channelEventClient{
+		ntfnQueue:  queue.NewConcurrentQueue(20),
+		updates:    make(chan interface{}),
+		cancelChan: make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	// Before registering the client, we'll launch a goroutine that will
+	// proxy all events appended to the end of the queue to the channel the
+	// caller will feed off of.
+	c.wg.Add(1)
+	client.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer client.wg.Done()
+
+		for {
+			select {
+			case event := <-client.ntfnQueue.ChanOut():
+				select {
+				case client.updates <- event:
+				case <-client.cancelChan:
+					return
+				case <-c.quit:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+
+			case <-c.quit:
+				return
+			}
+		}
+	}()
+
+	c.clientMtx.Lock()
+	c.clients[clientID] = client
+	c.clientMtx.Unlock()
+
+	return &ChannelEventClient{
+		Updates: client.updates,
+		Cancel: func() {
+			c.cancelClient(clientID)
+		},
+	}, nil
+}
+
+// cancelClient unregisters the client with the given ID, freeing any
+// resources allocated to it.
+func (c *ChannelNotifier) cancelClient(clientID uint64) {
+	c.clientMtx.Lock()
+	client, ok := c.clients[clientID]
+	delete(c.clients, clientID)
+	c.clientMtx.Unlock()
+
+	if !ok || !atomic.CompareAndSwapUint32(&client.cancelled, 0, 1) {
+		return
+	}
+
+	close(client.cancelChan)
+	client.wg.Wait()
+	client.ntfnQueue.Stop()
+}
+
+// notifyClients dispatches the passed event to all registered clients.
+func (c *ChannelNotifier) notifyClients(event interface{}) {
+	c.clientMtx.RLock()
+	defer c.clientMtx.RUnlock()
+
+	for _, client := range c.clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-client.cancelChan:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// NotifyPendingOpenChannelEvent notifies the ChannelNotifier's subscribers
+// that a new channel is pending open.
+func (c *ChannelNotifier) NotifyPendingOpenChannelEvent(chanPoint wire.OutPoint,
+	pendingChan *channeldb.OpenChannel) {
+
+	c.notifyClients(PendingOpenChannelEvent{
+		ChannelPoint:   &chanPoint,
+		PendingChannel: pendingChan,
+	})
+}
+
+// NotifyOpenChannelEvent notifies the ChannelNotifier's subscribers that a
+// channel has gone from pending open to open.
+func (c *ChannelNotifier) NotifyOpenChannelEvent(
+	channel *channeldb.OpenChannel) {
+
+	c.notifyClients(OpenChannelEvent{Channel: channel})
+}
+
+// NotifyActiveChannelEvent notifies the ChannelNotifier's subscribers that a
+// channel has become active. Channels that are already active or closing are
+// ignored.
+func (c *ChannelNotifier) NotifyActiveChannelEvent(chanPoint wire.OutPoint) {
+	c.stateMtx.Lock()
+	defer c.stateMtx.Unlock()
+
+	if _, ok := c.activeChans[chanPoint]; ok {
+		return
+	}
+	if _, ok := c.closingChans[chanPoint]; ok {
+		return
+	}
+	c.activeChans[chanPoint] = struct{}{}
+
+	c.notifyClients(ActiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyInactiveChannelEvent notifies the ChannelNotifier's subscribers that a
+// channel has become inactive. Channels that aren't active are ignored.
+func (c *ChannelNotifier) NotifyInactiveChannelEvent(chanPoint wire.OutPoint) {
+	c.stateMtx.Lock()
+	defer c.stateMtx.Unlock()
+
+	c.deactivate(chanPoint)
+}
+
+// deactivate dispatches an InactiveChannelEvent if the channel is active.
+//
+// NOTE: This method MUST be called with the stateMtx held.
+func (c *ChannelNotifier) deactivate(chanPoint wire.OutPoint) {
+	if _, ok := c.activeChans[chanPoint]; !ok {
+		return
+	}
+	delete(c.activeChans, chanPoint)
+
+	c.notifyClients(InactiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyClosingChannelEvent notifies the ChannelNotifier's subscribers that a
+// channel has started to close. If the channel is still active, it's reported
+// inactive first. Channels that are already closing are ignored, such that a
+// close we initiated isn't reported again once it's detected on-chain.
+func (c *ChannelNotifier) NotifyClosingChannelEvent(
+	summary *channeldb.ChannelCloseSummary) {
+
+	c.stateMtx.Lock()
+	defer c.stateMtx.Unlock()
+
+	if _, ok := c.closingChans[summary.ChanPoint]; ok {
+		return
+	}
+	c.deactivate(summary.ChanPoint)
+	c.closingChans[summary.ChanPoint] = struct{}{}
+
+	c.notifyClients(ClosingChannelEvent{CloseSummary: summary})
+}
+
+// NotifyClosedChannelEvent notifies the ChannelNotifier's subscribers that a
+// channel has been fully resolved.
+func (c *ChannelNotifier) NotifyClosedChannelEvent(
+	summary *channeldb.ChannelCloseSummary) {
+
+	c.stateMtx.Lock()
+	defer c.stateMtx.Unlock()
+
+	c.deactivate(summary.ChanPoint)
+	delete(c.closingChans, summary.ChanPoint)
+
+	c.notifyClients(ClosedChannelEvent{CloseSummary: summary})
+}
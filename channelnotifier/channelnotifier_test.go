@@ -0,0 +1,209 @@
+package channelnotifier
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// receiveEvent waits for the next event sent to the given client.
+func receiveEvent(t *testing.T, client *ChannelEventClient) interface{} {
+	select {
+	case event := <-client.Updates:
+		return event
+	case <-time.After(time.Second * 5):
+		t.Fatalf("no channel event received")
+	}
+
+	return nil
+}
+
+// TestChannelNotifierDispatch ensures that all events reported to the
+// ChannelNotifier are delivered, in order, to every registered client.
+func TestChannelNotifierDispatch(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	clientA, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer clientA.Cancel()
+
+	clientB, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer clientB.Cancel()
+
+	chanPoint := wire.OutPoint{Index: 1}
+	channel := &channeldb.OpenChannel{FundingOutpoint: chanPoint}
+	summary := &channeldb.ChannelCloseSummary{ChanPoint: chanPoint}
+
+	// Report one of each event before any of them are consumed, ensuring
+	// that a slow client never blocks the reporting sub-systems.
+	notifier.NotifyPendingOpenChannelEvent(chanPoint, channel)
+	notifier.NotifyOpenChannelEvent(channel)
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyInactiveChannelEvent(chanPoint)
+	notifier.NotifyClosingChannelEvent(summary)
+	notifier.NotifyClosedChannelEvent(summary)
+
+	expectedEvents := []interface{}{
+		PendingOpenChannelEvent{
+			ChannelPoint:   &chanPoint,
+			PendingChannel: channel,
+		},
+		OpenChannelEvent{Channel: channel},
+		ActiveChannelEvent{ChannelPoint: &chanPoint},
+		InactiveChannelEvent{ChannelPoint: &chanPoint},
+		ClosingChannelEvent{CloseSummary: summary},
+		ClosedChannelEvent{CloseSummary: summary},
+	}
+
+	for _, client := range []*ChannelEventClient{clientA, clientB} {
+		for i, expected := range expectedEvents {
+			event := receiveEvent(t, client)
+			if !reflect.DeepEqual(event, expected) {
+				t.Fatalf("event #%d: expected %#v, got %#v",
+					i, expected, event)
+			}
+		}
+	}
+}
+
+// TestChannelNotifierCancel ensures that a cancelled client no longer
+// receives events, while other clients are unaffected.
+func TestChannelNotifierCancel(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	clientA, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	clientB, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer clientB.Cancel()
+
+	// Cancelling a client more than once should be a no-op.
+	clientA.Cancel()
+	clientA.Cancel()
+
+	chanPoint := wire.OutPoint{Index: 2}
+	notifier.NotifyActiveChannelEvent(chanPoint)
+
+	event := receiveEvent(t, clientB)
+	expected := ActiveChannelEvent{ChannelPoint: &chanPoint}
+	if !reflect.DeepEqual(event, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, event)
+	}
+
+	select {
+	case event := <-clientA.Updates:
+		t.Fatalf("cancelled client received event: %#v", event)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+// TestChannelNotifierStopped ensures that clients can't subscribe once the
+// ChannelNotifier has been stopped, and that reporting events doesn't block.
+func TestChannelNotifierStopped(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+
+	client, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	if err := notifier.Stop(); err != nil {
+		t.Fatalf("unable to stop notifier: %v", err)
+	}
+
+	notifier.NotifyActiveChannelEvent(wire.OutPoint{})
+	client.Cancel()
+
+	_, err = notifier.SubscribeChannelEvents()
+	if err != ErrChannelNotifierExiting {
+		t.Fatalf("expected ErrChannelNotifierExiting, got %v", err)
+	}
+}
+
+// TestChannelNotifierOrdering ensures that the events of a channel reported by
+// several sub-systems are dispatched once each, and that a channel closing
+// while active is reported inactive before it's reported closing.
+func TestChannelNotifierOrdering(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	client, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer client.Cancel()
+
+	chanPoint := wire.OutPoint{Index: 3}
+	otherChanPoint := wire.OutPoint{Index: 4}
+	summary := &channeldb.ChannelCloseSummary{ChanPoint: chanPoint}
+
+	// A channel reported active twice is only dispatched once, while a
+	// channel that was never active isn't reported inactive.
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyInactiveChannelEvent(otherChanPoint)
+
+	// We then initiate the close of the active channel. It must be
+	// reported inactive first. Once the close is detected on-chain, and
+	// the link is removed, nothing new is to be reported, nor may the
+	// closing channel become active again.
+	notifier.NotifyClosingChannelEvent(summary)
+	notifier.NotifyClosingChannelEvent(summary)
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyInactiveChannelEvent(chanPoint)
+	notifier.NotifyClosedChannelEvent(summary)
+
+	expectedEvents := []interface{}{
+		ActiveChannelEvent{ChannelPoint: &chanPoint},
+		InactiveChannelEvent{ChannelPoint: &chanPoint},
+		ClosingChannelEvent{CloseSummary: summary},
+		ClosedChannelEvent{CloseSummary: summary},
+	}
+	for i, expected := range expectedEvents {
+		event := receiveEvent(t, client)
+		if !reflect.DeepEqual(event, expected) {
+			t.Fatalf("event #%d: expected %#v, got %#v", i,
+				expected, event)
+		}
+	}
+
+	select {
+	case event := <-client.Updates:
+		t.Fatalf("unexpected event: %#v", event)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
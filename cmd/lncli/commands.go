@@ -15,6 +15,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/golang/protobuf/jsonpb"
@@ -178,6 +179,10 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the transaction",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -232,6 +237,7 @@ func sendCoins(ctx *cli.Context) error {
 		Amount:     amt,
 		TargetConf: int32(ctx.Int64("conf_target")),
 		SatPerByte: ctx.Int64("sat_per_byte"),
+		Label:      ctx.String("label"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -246,7 +252,7 @@ var sendManyCommand = cli.Command{
 	Name:      "sendmany",
 	Category:  "On-chain",
 	Usage:     "Send bitcoin on-chain to multiple addresses.",
-	ArgsUsage: "send-json-string [--conf_target=N] [--sat_per_byte=P]",
+	ArgsUsage: "send-json-string [--conf_target=N] [--sat_per_byte=P] [--label=L]",
 	Description: `
 	Create and broadcast a transaction paying the specified amount(s) to the passed address(es).
 
@@ -266,6 +272,10 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
 				"used when crafting the transaction",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the transaction",
+		},
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -291,6 +301,7 @@ func sendMany(ctx *cli.Context) error {
 		AddrToAmount: amountToAddr,
 		TargetConf:   int32(ctx.Int64("conf_target")),
 		SatPerByte:   ctx.Int64("sat_per_byte"),
+		Label:        ctx.String("label"),
 	})
 	if err != nil {
 		return err
@@ -469,6 +480,44 @@ var openChannelCommand = cli.Command{
 				"not set, we will scale the value according to the " +
 				"channel size",
 		},
+		cli.Uint64Flag{
+			Name: "max_value_in_flight_msat",
+			Usage: "(optional) the maximum value in msat that may " +
+				"be pending within the channel at any given " +
+				"time. If this is not set, we will scale the " +
+				"value according to the channel size",
+		},
+		cli.Uint64Flag{
+			Name: "max_accepted_htlcs",
+			Usage: "(optional) the maximum number of HTLCs the " +
+				"remote node may offer us at any given time",
+		},
+		cli.Int64Flag{
+			Name: "remote_chan_reserve_sat",
+			Usage: "(optional) the minimum balance in satoshis we " +
+				"will require the remote node to keep within " +
+				"the channel. If this is not set, we will scale " +
+				"the value according to the channel size",
+		},
+		cli.Int64Flag{
+			Name: "dust_limit_sat",
+			Usage: "(optional) the dust limit in satoshis of our " +
+				"commitment transaction, below which outputs " +
+				"are trimmed from it",
+		},
+		cli.Uint64Flag{
+			Name: "max_remote_csv_delay",
+			Usage: "(optional) the largest number of blocks we " +
+				"will accept the remote node requiring us to " +
+				"wait before accessing our funds in case of " +
+				"unilateral close",
+		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address to commit to delivering " +
+				"our funds to upon cooperative channel " +
+				"closing, which can't be changed afterwards",
+		},
 		cli.Uint64Flag{
 			Name: "min_confs",
 			Usage: "(optional) the minimum number of confirmations " +
@@ -476,6 +525,12 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.BoolFlag{
+			Name: "dual_fund",
+			Usage: "invite the remote node to contribute funds of " +
+				"its own to the channel, up to the local " +
+				"funding amount",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -496,11 +551,17 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.OpenChannelRequest{
-		TargetConf:     int32(ctx.Int64("conf_target")),
-		SatPerByte:     ctx.Int64("sat_per_byte"),
-		MinHtlcMsat:    ctx.Int64("min_htlc_msat"),
-		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
-		MinConfs:       int32(ctx.Uint64("min_confs")),
+		TargetConf:           int32(ctx.Int64("conf_target")),
+		SatPerByte:           ctx.Int64("sat_per_byte"),
+		MinHtlcMsat:          ctx.Int64("min_htlc_msat"),
+		RemoteCsvDelay:       uint32(ctx.Uint64("remote_csv_delay")),
+		MaxValueInFlightMsat: ctx.Uint64("max_value_in_flight_msat"),
+		MaxAcceptedHtlcs:     uint32(ctx.Uint64("max_accepted_htlcs")),
+		RemoteChanReserveSat: ctx.Int64("remote_chan_reserve_sat"),
+		DustLimitSat:         ctx.Int64("dust_limit_sat"),
+		MaxRemoteCsvDelay:    uint32(ctx.Uint64("max_remote_csv_delay")),
+		CloseAddress:         ctx.String("close_address"),
+		MinConfs:             int32(ctx.Uint64("min_confs")),
 	}
 
 	switch {
@@ -568,6 +629,7 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.DualFund = ctx.Bool("dual_fund")
 
 	stream, err := client.OpenChannel(ctxb, req)
 	if err != nil {
@@ -695,6 +757,13 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to deliver funds " +
+				"upon cooperative channel closing, must match " +
+				"the close address committed to when opening " +
+				"the channel, if any",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -716,10 +785,11 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1612,6 +1682,104 @@ func pendingChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var cancelPendingChannelCommand = cli.Command{
+	Name:     "cancelpendingchannel",
+	Category: "Channels",
+	Usage:    "Cancel the funding flow of a pending channel.",
+	Description: `
+	Cancels the funding flow of a pending channel.
+
+	A reservation whose funding transaction hasn't been published yet is
+	identified by its pending_chan_id, as listed by the pendingchannels
+	command. It's torn down, and the coins it locked are released.
+
+	A pending channel we initiated whose funding transaction was already
+	published is identified by its channel point. The wallet's inputs to
+	the funding transaction are double spent back to the wallet at a
+	higher fee, and the channel is forgotten once that transaction
+	confirmed.`,
+	ArgsUsage: "[--pending_chan_id=P] [funding_txid [output_index]]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "pending_chan_id",
+			Usage: "the hex encoded pending channel ID of a " +
+				"reservation",
+		},
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction double spending the funding inputs " +
+				"should confirm in, will be used for fee " +
+				"estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction double spending the funding " +
+				"inputs",
+		},
+	},
+	Action: actionDecorator(cancelPendingChannel),
+}
+
+func cancelPendingChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "cancelpendingchannel")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	req := &lnrpc.CancelPendingChannelRequest{
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+
+	if ctx.IsSet("pending_chan_id") {
+		pendingChanID, err := hex.DecodeString(
+			ctx.String("pending_chan_id"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode pending_chan_id: "+
+				"%v", err)
+		}
+		req.PendingChanId = pendingChanID
+	} else {
+		channelPoint, err := parseChannelPoint(ctx)
+		if err != nil {
+			return err
+		}
+		req.ChannelPoint = channelPoint
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelPendingChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listChannelsCommand = cli.Command{
 	Name:     "listchannels",
 	Category: "Channels",
@@ -2847,11 +3015,47 @@ func decodePayReq(ctx *cli.Context) error {
 }
 
 var listChainTxnsCommand = cli.Command{
-	Name:        "listchaintxns",
-	Category:    "On-chain",
-	Usage:       "List transactions from the wallet.",
-	Description: "List all transactions an address of the wallet was involved in.",
-	Action:      actionDecorator(listChainTxns),
+	Name:     "listchaintxns",
+	Category: "On-chain",
+	Usage:    "List transactions from the wallet.",
+	Description: `
+	List all transactions an address of the wallet was involved in.
+
+	The transactions can be filtered by category, block height range and
+	label. The valid categories are: unknown, send, channel_funding,
+	cooperative_close, breach_remedy, sweep and funding_cancel.`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "category",
+			Usage: "(optional) only list transactions of this " +
+				"category, can be specified multiple times",
+		},
+		cli.Int64Flag{
+			Name: "start_height",
+			Usage: "(optional) only list transactions confirmed " +
+				"at or above this height",
+		},
+		cli.Int64Flag{
+			Name: "end_height",
+			Usage: "(optional) only list transactions confirmed " +
+				"at or below this height",
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) only list transactions with this label",
+		},
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "(optional) the number of matching transactions " +
+				"to skip",
+		},
+		cli.Uint64Flag{
+			Name: "max_transactions",
+			Usage: "(optional) the maximum number of transactions " +
+				"to list",
+		},
+	},
+	Action: actionDecorator(listChainTxns),
 }
 
 func listChainTxns(ctx *cli.Context) error {
@@ -2859,8 +3063,210 @@ func listChainTxns(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.GetTransactions(ctxb, &lnrpc.GetTransactionsRequest{})
+	req := &lnrpc.GetTransactionsRequest{
+		StartHeight:     int32(ctx.Int64("start_height")),
+		EndHeight:       int32(ctx.Int64("end_height")),
+		Label:           ctx.String("label"),
+		IndexOffset:     uint32(ctx.Uint64("index_offset")),
+		MaxTransactions: uint32(ctx.Uint64("max_transactions")),
+	}
+	for _, category := range ctx.StringSlice("category") {
+		value, ok := lnrpc.TransactionCategory_value[strings.ToUpper(category)]
+		if !ok {
+			return fmt.Errorf("unknown transaction category: %v",
+				category)
+		}
+
+		req.Categories = append(
+			req.Categories, lnrpc.TransactionCategory(value),
+		)
+	}
+
+	resp, err := client.GetTransactions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var labelTxCommand = cli.Command{
+	Name:      "labeltx",
+	Category:  "On-chain",
+	Usage:     "Set the label of an on-chain transaction.",
+	ArgsUsage: "txid label",
+	Description: `
+	Sets the label of the transaction with the given txid, replacing any
+	previously set label.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "txid",
+			Usage: "the hash of the transaction to label",
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "the new label of the transaction",
+		},
+	},
+	Action: actionDecorator(labelTx),
+}
+
+func labelTx(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		args  = ctx.Args()
+		txid  string
+		label string
+	)
+	switch {
+	case ctx.IsSet("txid"):
+		txid = ctx.String("txid")
+	case args.Present():
+		txid = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("txid argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("label"):
+		label = ctx.String("label")
+	case args.Present():
+		label = args.First()
+	default:
+		return fmt.Errorf("label argument missing")
+	}
+
+	req := &lnrpc.LabelTransactionRequest{
+		Txid:  txid,
+		Label: label,
+	}
+	resp, err := client.LabelTransaction(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getRecoveryInfoCommand = cli.Command{
+	Name:     "getrecoveryinfo",
+	Category: "On-chain",
+	Usage:    "Display information about an ongoing wallet recovery.",
+	Description: `
+	Returns the progress of the wallet in recovering its funds, either after
+	being restored from a seed with a non-zero recovery window, or after a
+	rescan was requested using the rescan command.`,
+	Action: actionDecorator(getRecoveryInfo),
+}
+
+func getRecoveryInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetRecoveryInfoRequest{}
+	resp, err := client.GetRecoveryInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rescanCommand = cli.Command{
+	Name:      "rescan",
+	Category:  "On-chain",
+	Usage:     "Rescan the chain for transactions relevant to the wallet.",
+	ArgsUsage: "from_height",
+	Description: `
+	Replays the chain starting at from_height, looking for transactions
+	that pay to or spend from the wallet, without recreating it. The
+	rescan is carried out in the background, its progress and outcome can
+	be followed using the getrecoveryinfo command.
+
+	While the rescan is in progress, the wallet is rewound to from_height.
+	It reports itself as not synced to the chain, and refuses to select
+	coins to send or to fund channels with until the rescan completes.
+
+	If the lookahead flag is set, then that many additional addresses are
+	derived and watched for each branch of the wallet's accounts, which
+	allows funds sent to addresses that were never handed out by this node
+	to be found.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "from_height",
+			Usage: "the height of the block to start the rescan from",
+		},
+		cli.Uint64Flag{
+			Name: "lookahead",
+			Usage: "the number of additional addresses to derive " +
+				"for each branch of the wallet's accounts",
+		},
+	},
+	Action: actionDecorator(rescan),
+}
+
+func rescan(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		args       = ctx.Args()
+		fromHeight int64
+		err        error
+	)
+	switch {
+	case ctx.IsSet("from_height"):
+		fromHeight = ctx.Int64("from_height")
+	case args.Present():
+		fromHeight, err = strconv.ParseInt(args.First(), 10, 32)
+		if err != nil {
+			return fmt.Errorf("unable to decode from_height: %v",
+				err)
+		}
+	default:
+		return fmt.Errorf("from_height argument missing")
+	}
+
+	req := &lnrpc.RescanRequest{
+		FromHeight: int32(fromHeight),
+		Lookahead:  uint32(ctx.Uint64("lookahead")),
+	}
+	resp, err := client.Rescan(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeEstimatorHealthCommand = cli.Command{
+	Name:     "feeestimatorhealth",
+	Category: "On-chain",
+	Usage:    "Display the health of the on-chain fee estimator.",
+	Description: `
+	Returns the health of each source used to estimate on-chain fees, the
+	bounds applied to their estimates, and the number of times all sources
+	failed and a fall back fee rate had to be used.`,
+	Action: actionDecorator(feeEstimatorHealth),
+}
+
+func feeEstimatorHealth(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
 
+	req := &lnrpc.FeeEstimatorHealthRequest{}
+	resp, err := client.FeeEstimatorHealth(ctxb, req)
 	if err != nil {
 		return err
 	}
@@ -3035,7 +3441,12 @@ var updateChannelPolicyCommand = cli.Command{
 	Updates the channel policy for all channels, or just a particular channel
 	identified by its channel point. The update will be committed, and
 	broadcast to the rest of the network within the next batch.
-	Channel points are encoded as: funding_txid:output_index`,
+	Channel points are encoded as: funding_txid:output_index
+
+	If the fee policy manager is active, the fees of the updated channels
+	are no longer adjusted automatically. The --auto_fees flag hands them
+	back to the manager, in which case no other policy arguments are
+	needed.`,
 	Flags: []cli.Flag{
 		cli.Int64Flag{
 			Name: "base_fee_msat",
@@ -3054,12 +3465,28 @@ var updateChannelPolicyCommand = cli.Command{
 			Usage: "the CLTV delta that will be applied to all " +
 				"forwarded HTLCs",
 		},
+		cli.Uint64Flag{
+			Name: "max_htlc_msat",
+			Usage: "if set, the max HTLC size in milli-satoshis " +
+				"that will be forwarded, capped at the " +
+				"capacity of each channel",
+		},
+		cli.BoolFlag{
+			Name: "clear_max_htlc",
+			Usage: "remove the max HTLC size, so HTLCs up to the " +
+				"capacity of each channel are forwarded",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
 				"updated, if nil the policies for all channels " +
 				"will be updated. Takes the form of: txid:output_index",
 		},
+		cli.BoolFlag{
+			Name: "auto_fees",
+			Usage: "hand the fees of the channel(s) back to the " +
+				"fee policy manager",
+		},
 	},
 	Action: actionDecorator(updateChannelPolicy),
 }
@@ -3077,46 +3504,55 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	)
 	args := ctx.Args()
 
-	switch {
-	case ctx.IsSet("base_fee_msat"):
-		baseFee = ctx.Int64("base_fee_msat")
-	case args.Present():
-		baseFee, err = strconv.ParseInt(args.First(), 10, 64)
-		if err != nil {
-			return fmt.Errorf("unable to decode base_fee_msat: %v", err)
+	// Handing the fees back to the fee policy manager only requires the
+	// target channel.
+	autoFees := ctx.Bool("auto_fees")
+	if !autoFees {
+		switch {
+		case ctx.IsSet("base_fee_msat"):
+			baseFee = ctx.Int64("base_fee_msat")
+		case args.Present():
+			baseFee, err = strconv.ParseInt(args.First(), 10, 64)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"base_fee_msat: %v", err)
+			}
+			args = args.Tail()
+		default:
+			return fmt.Errorf("base_fee_msat argument missing")
 		}
-		args = args.Tail()
-	default:
-		return fmt.Errorf("base_fee_msat argument missing")
-	}
 
-	switch {
-	case ctx.IsSet("fee_rate"):
-		feeRate = ctx.Float64("fee_rate")
-	case args.Present():
-		feeRate, err = strconv.ParseFloat(args.First(), 64)
-		if err != nil {
-			return fmt.Errorf("unable to decode fee_rate: %v", err)
+		switch {
+		case ctx.IsSet("fee_rate"):
+			feeRate = ctx.Float64("fee_rate")
+		case args.Present():
+			feeRate, err = strconv.ParseFloat(args.First(), 64)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"fee_rate: %v", err)
+			}
+
+			args = args.Tail()
+		default:
+			return fmt.Errorf("fee_rate argument missing")
 		}
 
-		args = args.Tail()
-	default:
-		return fmt.Errorf("fee_rate argument missing")
-	}
+		switch {
+		case ctx.IsSet("time_lock_delta"):
+			timeLockDelta = ctx.Int64("time_lock_delta")
+		case args.Present():
+			timeLockDelta, err = strconv.ParseInt(
+				args.First(), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"time_lock_delta: %v", err)
+			}
 
-	switch {
-	case ctx.IsSet("time_lock_delta"):
-		timeLockDelta = ctx.Int64("time_lock_delta")
-	case args.Present():
-		timeLockDelta, err = strconv.ParseInt(args.First(), 10, 64)
-		if err != nil {
-			return fmt.Errorf("unable to decode time_lock_delta: %v",
-				err)
+			args = args.Tail()
+		default:
+			return fmt.Errorf("time_lock_delta argument missing")
 		}
-
-		args = args.Tail()
-	default:
-		return fmt.Errorf("time_lock_delta argument missing")
 	}
 
 	var (
@@ -3155,6 +3591,9 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		BaseFeeMsat:   baseFee,
 		FeeRate:       feeRate,
 		TimeLockDelta: uint32(timeLockDelta),
+		MaxHtlcMsat:   ctx.Uint64("max_htlc_msat"),
+		AutoFees:      autoFees,
+		ClearMaxHtlc:  ctx.Bool("clear_max_htlc"),
 	}
 
 	if chanPoint != nil {
@@ -3176,17 +3615,117 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
-var forwardingHistoryCommand = cli.Command{
-	Name:      "fwdinghistory",
-	Category:  "Payments",
-	Usage:     "Query the history of all forwarded HTLCs.",
-	ArgsUsage: "start_time [end_time] [index_offset] [max_events]",
+var updateChanStatusCommand = cli.Command{
+	Name:      "updatechanstatus",
+	Category:  "Channels",
+	Usage:     "Manually enable or disable a channel.",
+	ArgsUsage: "channel_point action",
 	Description: `
-	Query the HTLC switch's internal forwarding log for all completed
-	payment circuits (HTLCs) over a particular time range (--start_time and
-	--end_time). The start and end times are meant to be expressed in
-	seconds since the Unix epoch. If a start and end time aren't provided,
-	then events over the past 24 hours are queried for.
+	Manually enables or disables forwarding over a particular channel
+	identified by its channel point. A disabled channel no longer accepts
+	new forwards, and is advertised as disabled to the rest of the
+	network. The status persists across restarts until the action "auto"
+	is used, which hands control of the channel's status back to lnd.
+	Channel points are encoded as: funding_txid:output_index`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel whose status should be updated. " +
+				"Takes the form of: txid:output_index",
+		},
+		cli.StringFlag{
+			Name: "action",
+			Usage: "the status to set for the channel, one of " +
+				"'enable', 'disable' or 'auto'",
+		},
+	},
+	Action: actionDecorator(updateChanStatus),
+}
+
+func updateChanStatus(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		chanPointStr string
+		actionStr    string
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+	case args.Present():
+		chanPointStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("chan_point argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("action"):
+		actionStr = ctx.String("action")
+	case args.Present():
+		actionStr = args.First()
+	default:
+		return fmt.Errorf("action argument missing")
+	}
+
+	split := strings.Split(chanPointStr, ":")
+	if len(split) != 2 {
+		return fmt.Errorf("expecting chan_point to be in format of: " +
+			"txid:index")
+	}
+
+	index, err := strconv.ParseInt(split[1], 10, 32)
+	if err != nil {
+		return fmt.Errorf("unable to decode output index: %v", err)
+	}
+
+	var action lnrpc.UpdateChanStatusRequest_ChanStatusAction
+	switch actionStr {
+	case "enable":
+		action = lnrpc.UpdateChanStatusRequest_ENABLE
+	case "disable":
+		action = lnrpc.UpdateChanStatusRequest_DISABLE
+	case "auto":
+		action = lnrpc.UpdateChanStatusRequest_AUTO
+	default:
+		return fmt.Errorf("invalid action %q, expected one of "+
+			"'enable', 'disable' or 'auto'", actionStr)
+	}
+
+	req := &lnrpc.UpdateChanStatusRequest{
+		ChanPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+				FundingTxidStr: split[0],
+			},
+			OutputIndex: uint32(index),
+		},
+		Action: action,
+	}
+
+	resp, err := client.UpdateChanStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var forwardingHistoryCommand = cli.Command{
+	Name:      "fwdinghistory",
+	Category:  "Payments",
+	Usage:     "Query the history of all forwarded HTLCs.",
+	ArgsUsage: "start_time [end_time] [index_offset] [max_events]",
+	Description: `
+	Query the HTLC switch's internal forwarding log for all completed
+	payment circuits (HTLCs) over a particular time range (--start_time and
+	--end_time). The start and end times are meant to be expressed in
+	seconds since the Unix epoch. If a start and end time aren't provided,
+	then events over the past 24 hours are queried for.
 
 	The max number of events returned is 50k. The default number is 100,
 	callers can use the --max_events param to modify this value.
@@ -3290,3 +3829,479 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var forwardingStatsCommand = cli.Command{
+	Name:      "fwdingstats",
+	Category:  "Payments",
+	Usage:     "Query aggregated stats of all forwarded HTLCs.",
+	ArgsUsage: "[start_time] [end_time]",
+	Description: `
+	Query the fees earned, the volume and the number of forwards over a
+	particular time range (--start_time and --end_time), grouped by
+	outgoing channel, incoming channel or peer (--group_by). The start and
+	end times are meant to be expressed in seconds since the Unix epoch. If
+	a start and end time aren't provided, then the stats over the past 24
+	hours are queried for.
+
+	The stats can be split into time buckets using the --bucket_size
+	param, which must be a multiple of an hour, e.g. 24h for daily stats.
+	The stats are kept per hour, so the time range is extended to whole
+	hours.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "the starting time for the query, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "the end time for the query, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.DurationFlag{
+			Name: "bucket_size",
+			Usage: "the size of the time buckets the stats are " +
+				"split into, if unset all stats are returned " +
+				"in a single bucket",
+		},
+		cli.StringFlag{
+			Name: "group_by",
+			Usage: "how forwards are grouped, one of " +
+				"'outgoing_channel', 'incoming_channel' or " +
+				"'peer'",
+			Value: "outgoing_channel",
+		},
+	},
+	Action: actionDecorator(forwardingStats),
+}
+
+func forwardingStats(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		startTime, endTime uint64
+		err                error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("start_time"):
+		startTime = ctx.Uint64("start_time")
+	case args.Present():
+		startTime, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode start_time %v", err)
+		}
+		args = args.Tail()
+	}
+
+	switch {
+	case ctx.IsSet("end_time"):
+		endTime = ctx.Uint64("end_time")
+	case args.Present():
+		endTime, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode end_time: %v", err)
+		}
+	}
+
+	var groupBy lnrpc.ForwardingStatsRequest_GroupBy
+	switch ctx.String("group_by") {
+	case "outgoing_channel":
+		groupBy = lnrpc.ForwardingStatsRequest_OUTGOING_CHANNEL
+	case "incoming_channel":
+		groupBy = lnrpc.ForwardingStatsRequest_INCOMING_CHANNEL
+	case "peer":
+		groupBy = lnrpc.ForwardingStatsRequest_PEER
+	default:
+		return fmt.Errorf("invalid group_by %q, expected one of "+
+			"'outgoing_channel', 'incoming_channel' or 'peer'",
+			ctx.String("group_by"))
+	}
+
+	req := &lnrpc.ForwardingStatsRequest{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		BucketSize: uint64(ctx.Duration("bucket_size").Seconds()),
+		GroupBy:    groupBy,
+	}
+	resp, err := client.ForwardingStats(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var forwardingLimitsCommand = cli.Command{
+	Name:     "fwdlimits",
+	Category: "Payments",
+	Usage:    "Display the forwarding limits and their current usage.",
+	Description: `
+	Returns the limits on the HTLCs a single incoming channel or peer may
+	have in flight through our node, along with the pending HTLCs and the
+	number of forwards admitted, rejected and rate limited for each
+	incoming channel and peer.`,
+	Action: actionDecorator(forwardingLimits),
+}
+
+func forwardingLimits(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ForwardingLimitsRequest{}
+	resp, err := client.ForwardingLimits(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateForwardingLimitsCommand = cli.Command{
+	Name:     "updatefwdlimits",
+	Category: "Payments",
+	Usage:    "Adjust the forwarding limits.",
+	Description: `
+	Updates the limits on the HTLCs a single incoming channel or peer may
+	have in flight through our node. Only the limits that are specified are
+	changed, and a value of zero disables a limit.
+
+	Forwards that exceed the limits are failed back in the "fail" mode. In
+	the "queue" mode, they're held until they can be admitted. Queued forwards
+	are failed back after --queue_timeout, or if the queue of the incoming
+	peer already holds --max_queued forwards.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "max_chan_htlcs",
+			Usage: "the maximum number of forwards in flight from " +
+				"a single incoming channel",
+		},
+		cli.Uint64Flag{
+			Name: "max_chan_amt_msat",
+			Usage: "the maximum value of the forwards in flight " +
+				"from a single incoming channel",
+		},
+		cli.Uint64Flag{
+			Name: "max_peer_htlcs",
+			Usage: "the maximum number of forwards in flight from " +
+				"a single incoming peer",
+		},
+		cli.Uint64Flag{
+			Name: "max_peer_amt_msat",
+			Usage: "the maximum value of the forwards in flight " +
+				"from a single incoming peer",
+		},
+		cli.Float64Flag{
+			Name: "peer_rate",
+			Usage: "the number of new forwards per second a single " +
+				"peer may offer on average",
+		},
+		cli.Uint64Flag{
+			Name: "peer_burst",
+			Usage: "the number of new forwards a single peer may " +
+				"offer at once",
+		},
+		cli.StringFlag{
+			Name: "mode",
+			Usage: "whether forwards that exceed the limits are " +
+				"failed back (fail) or queued (queue)",
+		},
+		cli.DurationFlag{
+			Name: "queue_timeout",
+			Usage: "the maximum amount of time a forward is " +
+				"queued for (e.g. 5s)",
+		},
+		cli.Uint64Flag{
+			Name: "max_queued",
+			Usage: "the maximum number of forwards queued for a " +
+				"single incoming peer",
+		},
+	},
+	Action: actionDecorator(updateForwardingLimits),
+}
+
+func updateForwardingLimits(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "updatefwdlimits")
+	}
+
+	// We'll start from the current limits, so that only the limits
+	// specified by the caller are changed.
+	current, err := client.ForwardingLimits(
+		ctxb, &lnrpc.ForwardingLimitsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	limits := current.Limits
+
+	if ctx.IsSet("max_chan_htlcs") {
+		limits.MaxChanHtlcs = uint32(ctx.Uint64("max_chan_htlcs"))
+	}
+	if ctx.IsSet("max_chan_amt_msat") {
+		limits.MaxChanAmtMsat = ctx.Uint64("max_chan_amt_msat")
+	}
+	if ctx.IsSet("max_peer_htlcs") {
+		limits.MaxPeerHtlcs = uint32(ctx.Uint64("max_peer_htlcs"))
+	}
+	if ctx.IsSet("max_peer_amt_msat") {
+		limits.MaxPeerAmtMsat = ctx.Uint64("max_peer_amt_msat")
+	}
+	if ctx.IsSet("peer_rate") {
+		limits.PeerRate = ctx.Float64("peer_rate")
+	}
+	if ctx.IsSet("peer_burst") {
+		limits.PeerBurst = uint32(ctx.Uint64("peer_burst"))
+	}
+	if ctx.IsSet("mode") {
+		switch ctx.String("mode") {
+		case "fail":
+			limits.Mode = lnrpc.ForwardLimits_FAIL
+		case "queue":
+			limits.Mode = lnrpc.ForwardLimits_QUEUE
+		default:
+			return fmt.Errorf("unknown limit mode %v",
+				ctx.String("mode"))
+		}
+	}
+	if ctx.IsSet("queue_timeout") {
+		timeout := ctx.Duration("queue_timeout")
+		limits.QueueTimeoutMs = uint64(timeout / time.Millisecond)
+	}
+	if ctx.IsSet("max_queued") {
+		limits.MaxQueued = uint32(ctx.Uint64("max_queued"))
+	}
+
+	req := &lnrpc.UpdateForwardingLimitsRequest{
+		Limits: limits,
+	}
+	resp, err := client.UpdateForwardingLimits(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listCircuitsCommand = cli.Command{
+	Name:     "listcircuits",
+	Category: "Payments",
+	Usage:    "List the payment circuits held by the switch.",
+	Description: `
+	Lists the circuits of the HTLCs forwarded through our node that haven't
+	been resolved yet. Circuits with a keystone are open, and wait for their
+	outgoing HTLC to be settled or failed. Circuits without a keystone are
+	half-added, as their HTLC hasn't been locked into the outgoing channel
+	yet.
+
+	If --chan_id is set, only the circuits with that incoming or outgoing
+	channel are listed.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "chan_id",
+			Usage: "only list the circuits through this channel",
+		},
+	},
+	Action: actionDecorator(listCircuits),
+}
+
+func listCircuits(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListCircuitsRequest{
+		ChanId: ctx.Uint64("chan_id"),
+	}
+	resp, err := client.ListCircuits(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listForwardingPackagesCommand = cli.Command{
+	Name:     "listfwdpkgs",
+	Category: "Payments",
+	Usage:    "List the forwarding packages that aren't fully acked.",
+	Description: `
+	Lists the forwarding packages of each channel that haven't been fully
+	acked yet, along with the number of their adds that have been
+	forwarded and acked, and the number of their settles and fails that
+	have been acked.
+
+	If --chan_id is set, only the forwarding packages of that channel are
+	listed.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "chan_id",
+			Usage: "only list the forwarding packages of this " +
+				"channel",
+		},
+	},
+	Action: actionDecorator(listForwardingPackages),
+}
+
+func listForwardingPackages(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListForwardingPackagesRequest{
+		ChanId: ctx.Uint64("chan_id"),
+	}
+	resp, err := client.ListForwardingPackages(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var failStuckHtlcCommand = cli.Command{
+	Name:      "failstuckhtlc",
+	Category:  "Payments",
+	Usage:     "Fail back a stuck incoming HTLC.",
+	ArgsUsage: "chan_id htlc_id",
+	Description: `
+	Fails back the incoming HTLC identified by its channel (--chan_id) and
+	index within that channel (--htlc_id), when its circuit is stuck after
+	the outgoing channel has been closed.
+
+	This is only allowed once the outgoing channel has been fully resolved
+	on-chain, so the outgoing HTLC can't be settled anymore, and if the
+	preimage of the HTLC isn't known. The HTLC is failed back with a
+	permanent channel failure.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "chan_id",
+			Usage: "the short channel id of the incoming channel",
+		},
+		cli.Uint64Flag{
+			Name: "htlc_id",
+			Usage: "the index of the htlc within the incoming " +
+				"channel",
+		},
+	},
+	Action: actionDecorator(failStuckHtlc),
+}
+
+func failStuckHtlc(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		chanID, htlcID uint64
+		err            error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("chan_id"):
+		chanID = ctx.Uint64("chan_id")
+	case args.Present():
+		chanID, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode chan_id: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("chan_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("htlc_id"):
+		htlcID = ctx.Uint64("htlc_id")
+	case args.Present():
+		htlcID, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode htlc_id: %v", err)
+		}
+	default:
+		return fmt.Errorf("htlc_id argument missing")
+	}
+
+	req := &lnrpc.FailStuckHtlcRequest{
+		IncomingKey: &lnrpc.CircuitKey{
+			ChanId: chanID,
+			HtlcId: htlcID,
+		},
+	}
+	resp, err := client.FailStuckHtlc(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var replayLogInfoCommand = cli.Command{
+	Name:     "replayloginfo",
+	Category: "Payments",
+	Usage:    "Display the size of the sphinx replay log.",
+	Description: `
+	Returns the number of entries in the sphinx replay log, which records
+	the onion packets processed by our node to reject replays, along with
+	the lowest CLTV among them and the size of the log on disk. Entries are
+	removed once their CLTV has expired.`,
+	Action: actionDecorator(replayLogInfo),
+}
+
+func replayLogInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ReplayLogInfoRequest{}
+	resp, err := client.ReplayLogInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var compactReplayLogCommand = cli.Command{
+	Name:     "compactreplaylog",
+	Category: "Payments",
+	Usage:    "Reclaim the disk space freed in the sphinx replay log.",
+	Description: `
+	Rewrites the database of the sphinx replay log to reclaim the disk
+	space freed by expired entries, which is otherwise never returned to
+	the file system. Onion packets can't be processed while the log is
+	being compacted, so forwards are delayed until it completes.`,
+	Action: actionDecorator(compactReplayLog),
+}
+
+func compactReplayLog(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.CompactReplayLogRequest{}
+	resp, err := client.CompactReplayLog(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -267,6 +267,7 @@ func main() {
 		channelBalanceCommand,
 		getInfoCommand,
 		pendingChannelsCommand,
+		cancelPendingChannelCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
 		sendToRouteCommand,
@@ -284,12 +285,25 @@ func main() {
 		debugLevelCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
+		labelTxCommand,
+		getRecoveryInfoCommand,
+		rescanCommand,
+		feeEstimatorHealthCommand,
 		stopCommand,
 		signMessageCommand,
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		updateChanStatusCommand,
 		forwardingHistoryCommand,
+		forwardingStatsCommand,
+		forwardingLimitsCommand,
+		updateForwardingLimitsCommand,
+		listCircuitsCommand,
+		listForwardingPackagesCommand,
+		failStuckHtlcCommand,
+		replayLogInfoCommand,
+		compactReplayLogCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
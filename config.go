@@ -5,6 +5,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -19,11 +20,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/tor"
@@ -39,6 +44,8 @@ const (
 	defaultAdminMacFilename    = "admin.macaroon"
 	defaultReadMacFilename     = "readonly.macaroon"
 	defaultInvoiceMacFilename  = "invoice.macaroon"
+	defaultSignerMacFilename   = "signer.macaroon"
+	defaultRemoteSignerMacFile = "remotesigner.macaroon"
 	defaultLogLevel            = "info"
 	defaultLogDirname          = "logs"
 	defaultLogFilename         = "lnd.log"
@@ -50,6 +57,23 @@ const (
 	defaultNoSeedBackup        = false
 	defaultTrickleDelay        = 30 * 1000
 	defaultInactiveChanTimeout = 20 * time.Minute
+	defaultAcceptorTimeout     = 15 * time.Second
+	defaultInterceptorTimeout  = time.Minute
+	defaultLimitPeerBurst      = 10
+	defaultLimitQueueTimeout   = 5 * time.Second
+	defaultLimitMaxQueued      = 50
+	defaultFeePolicyInterval   = 10 * time.Minute
+	defaultFeeMinUpdateInt     = time.Hour
+	defaultFeeMaxUpdates       = 10
+	defaultFeeChangeThreshold  = 0.1
+	defaultFeeVolumeWindow     = 24 * time.Hour
+	defaultFeeMinMultiplier    = 0.5
+	defaultFeeMaxMultiplier    = 2
+	defaultFeeVolumeWeight     = 1
+	defaultFeeMinFeeRate       = 1
+	defaultBatchSize           = 10
+	defaultBatchInterval       = 50 * time.Millisecond
+	defaultReplayLogBackend    = "bolt"
 	defaultMaxLogFiles         = 3
 	defaultMaxLogFileSize      = 10
 
@@ -159,6 +183,81 @@ type torConfig struct {
 	PrivateKeyPath  string `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
 }
 
+type remoteSignerConfig struct {
+	Active        bool          `long:"active" description:"Delegate all signing and key derivation to a remote lnd instance holding the wallet seed"`
+	RPCHost       string        `long:"rpchost" description:"The host:port of the remote signer's RPC server"`
+	TLSCertPath   string        `long:"tlscertpath" description:"Path to the TLS certificate of the remote signer's RPC server"`
+	MacaroonPath  string        `long:"macaroonpath" description:"Path to the macaroon used to authenticate with the remote signer"`
+	Timeout       time.Duration `long:"timeout" description:"The maximum amount of time to wait for the remote signer to answer a single request. Valid time units are {ms, s, m}"`
+	ConvertWallet bool          `long:"convertwallet" description:"Irreversibly remove all private keys from the wallet, turning it into a watch-only wallet. Only use this on a copy of the remote signer's wallet"`
+}
+
+type feeEstimatorConfig struct {
+	WebAPIURL       string        `long:"webapiurl" description:"The URL of a web API serving fee estimates as JSON of the form {\"fee_by_block_target\": {\"<target>\": <sat/kvB>}}. Its estimates are combined with those of the chain backend, if any"`
+	MinFeeRate      int64         `long:"minfeerate" description:"The lowest fee rate in sat/kw that will be used for on-chain transactions when a web API is configured. Fee rates below 253 sat/kw are always raised to 253 sat/kw"`
+	MaxFeeRate      int64         `long:"maxfeerate" description:"The highest fee rate in sat/kw that will be used for on-chain transactions when a web API is configured. 0 disables the ceiling"`
+	CacheDuration   time.Duration `long:"cacheduration" description:"How long a fee estimate is reused before the fee sources are queried again when a web API is configured. Valid time units are {s, m, h}"`
+	SmoothingWindow int           `long:"smoothingwindow" description:"The number of consecutive estimates for a confirmation target that the median is taken of, to damp sudden spikes when a web API is configured"`
+}
+
+type forwardLimitsConfig struct {
+	MaxChannelHtlcs  uint32        `long:"maxchanhtlcs" description:"The maximum number of forwards that may be in flight from a single incoming channel. 0 disables the limit"`
+	MaxChannelAmount uint64        `long:"maxchanamtmsat" description:"The maximum total value in msat of the forwards that may be in flight from a single incoming channel. 0 disables the limit"`
+	MaxPeerHtlcs     uint32        `long:"maxpeerhtlcs" description:"The maximum number of forwards that may be in flight from all channels with a single peer. 0 disables the limit"`
+	MaxPeerAmount    uint64        `long:"maxpeeramtmsat" description:"The maximum total value in msat of the forwards that may be in flight from all channels with a single peer. 0 disables the limit"`
+	PeerRate         float64       `long:"peerrate" description:"The number of new forwards per second a single peer may offer on average. 0 disables the rate limit"`
+	PeerBurst        uint32        `long:"peerburst" description:"The number of new forwards a single peer may offer at once, above the peer rate"`
+	Queue            bool          `long:"queue" description:"Queue forwards that exceed the limits until they can be admitted, rather than failing them back"`
+	QueueTimeout     time.Duration `long:"queuetimeout" description:"How long a forward may be queued before it's failed back. Valid time units are {s, m, h}"`
+	MaxQueued        uint32        `long:"maxqueued" description:"The maximum number of forwards that may be queued for a single peer. 0 disables the limit"`
+}
+
+type feePolicyConfig struct {
+	Active            bool          `long:"active" description:"Automatically adjust the fee rates of our channels to their local balance and recent forwarding volume"`
+	Interval          time.Duration `long:"interval" description:"How often the fees of all channels are re-evaluated. Valid time units are {s, m, h}"`
+	MinUpdateInterval time.Duration `long:"minupdateinterval" description:"The minimum amount of time between two fee updates for the same channel. Valid time units are {s, m, h}"`
+	MaxUpdates        uint32        `long:"maxupdates" description:"The maximum number of channel updates broadcast per evaluation. 0 disables the limit"`
+	ChangeThreshold   float64       `long:"changethreshold" description:"The minimum relative change of a channel's fee rate that is broadcast"`
+	VolumeWindow      time.Duration `long:"volumewindow" description:"The period of forwarding history the volume of each channel is computed over. Valid time units are {s, m, h}"`
+	MinMultiplier     float64       `long:"minmultiplier" description:"The multiplier applied to the configured fee rate for channels whose capacity is all on our side"`
+	MaxMultiplier     float64       `long:"maxmultiplier" description:"The multiplier applied to the configured fee rate for channels whose capacity is all on the remote side"`
+	VolumeWeight      float64       `long:"volumeweight" description:"The fraction the fee rate of a channel is raised by once it forwarded its capacity within the volume window"`
+	MinFeeRate        uint32        `long:"minfeerate" description:"The lowest fee rate, in millionths, that will be advertised"`
+	MaxFeeRate        uint32        `long:"maxfeerate" description:"The highest fee rate, in millionths, that will be advertised. 0 disables the ceiling"`
+}
+
+type batchingConfig struct {
+	BatchSize        uint32        `long:"batchsize" description:"The maximum number of new HTLCs batched into a single commitment"`
+	MinInterval      time.Duration `long:"mininterval" description:"The shortest interval updates are batched for before a new commitment is signed. Valid time units are {ms, s}"`
+	MaxInterval      time.Duration `long:"maxinterval" description:"The longest interval updates are batched for before a new commitment is signed. If greater than mininterval, the interval of each channel is tuned to its load within these bounds. Valid time units are {ms, s}"`
+	LogCommitTimeout time.Duration `long:"logcommittimeout" description:"How long a channel waits before committing updates that leave the commitment chains out of sync. Valid time units are {ms, s}"`
+}
+
+type sphinxReplayConfig struct {
+	Backend string `long:"backend" description:"The backend of the sphinx replay log. 'bolt' reads and writes every entry directly from and to disk, while 'hybrid' keeps all entries in memory and combines concurrent writes into a single disk transaction. Both use the same database file" choice:"bolt" choice:"hybrid"`
+}
+
+// forwardingLimits returns the switch's forwarding limits described by the
+// config.
+func (c *forwardLimitsConfig) forwardingLimits() htlcswitch.ForwardingLimits {
+	mode := htlcswitch.LimitModeFail
+	if c.Queue {
+		mode = htlcswitch.LimitModeQueue
+	}
+
+	return htlcswitch.ForwardingLimits{
+		MaxChannelHtlcs:  c.MaxChannelHtlcs,
+		MaxChannelAmount: lnwire.MilliSatoshi(c.MaxChannelAmount),
+		MaxPeerHtlcs:     c.MaxPeerHtlcs,
+		MaxPeerAmount:    lnwire.MilliSatoshi(c.MaxPeerAmount),
+		PeerRate:         c.PeerRate,
+		PeerBurst:        c.PeerBurst,
+		Mode:             mode,
+		QueueTimeout:     c.QueueTimeout,
+		MaxQueued:        c.MaxQueued,
+	}
+}
+
 // config defines the configuration options for lnd.
 //
 // See loadConfig for further details regarding the configuration
@@ -166,20 +265,22 @@ type torConfig struct {
 type config struct {
 	ShowVersion bool `short:"V" long:"version" description:"Display version information and exit"`
 
-	LndDir         string `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
-	ConfigFile     string `long:"C" long:"configfile" description:"Path to configuration file"`
-	DataDir        string `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
-	TLSCertPath    string `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
-	TLSKeyPath     string `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
-	TLSExtraIP     string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
-	TLSExtraDomain string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
-	NoMacaroons    bool   `long:"no-macaroons" description:"Disable macaroon authentication"`
-	AdminMacPath   string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
-	ReadMacPath    string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	InvoiceMacPath string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	LogDir         string `long:"logdir" description:"Directory to log output."`
-	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
-	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
+	LndDir              string `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
+	ConfigFile          string `long:"C" long:"configfile" description:"Path to configuration file"`
+	DataDir             string `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
+	TLSCertPath         string `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
+	TLSKeyPath          string `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
+	TLSExtraIP          string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
+	TLSExtraDomain      string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
+	NoMacaroons         bool   `long:"no-macaroons" description:"Disable macaroon authentication"`
+	AdminMacPath        string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
+	ReadMacPath         string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	InvoiceMacPath      string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	SignerMacPath       string `long:"signermacaroonpath" description:"Path to write the signer-only macaroon for lnd's Signer RPC service if it doesn't exist"`
+	RemoteSignerMacPath string `long:"remotesignermacaroonpath" description:"Path to write the macaroon that allows a watch-only node to use this node as its remote signer if it doesn't exist"`
+	LogDir              string `long:"logdir" description:"Directory to log output."`
+	MaxLogFiles         int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
+	MaxLogFileSize      int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
@@ -220,6 +321,18 @@ type config struct {
 
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
+	RemoteSigner *remoteSignerConfig `group:"remotesigner" namespace:"remotesigner"`
+
+	FeeEstimator *feeEstimatorConfig `group:"feeestimator" namespace:"feeestimator"`
+
+	ForwardLimits *forwardLimitsConfig `group:"forwardlimits" namespace:"forwardlimits"`
+
+	FeePolicy *feePolicyConfig `group:"feepolicy" namespace:"feepolicy"`
+
+	Batching *batchingConfig `group:"batching" namespace:"batching"`
+
+	SphinxReplay *sphinxReplayConfig `group:"sphinxreplay" namespace:"sphinxreplay"`
+
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
 
 	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
@@ -232,11 +345,26 @@ type config struct {
 	Alias       string `long:"alias" description:"The node alias. Used as a moniker by peers and intelligence services"`
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
+	MaxChanSize int64  `long:"maxchansize" description:"The largest channel size (in satoshis) that we should open or accept. Channels above the soft-limit of 16777215 satoshis require wumbo-channels to be set. Defaults to the soft-limit, or to 10 BTC if wumbo-channels is set"`
+
+	WumboChannels bool `long:"wumbo-channels" description:"If set, lnd will signal support for large (wumbo) channels, and open and accept channels above the soft-limit on channel size with peers that support them"`
 
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	MaxDualFundAmt int64 `long:"maxdualfundamt" description:"The maximum amount (in satoshis) we'll contribute to a channel opened by a remote peer that invites us to dual fund it. If 0, we never contribute to inbound channels"`
+
+	AcceptorTimeout time.Duration `long:"acceptortimeout" description:"How long to wait for the clients of the ChannelAcceptor RPC to decide on an inbound channel before rejecting it. Valid time units are {s, m, h}"`
+
+	InterceptorTimeout time.Duration `long:"interceptortimeout" description:"How long to hold an HTLC for the client of the HtlcInterceptor RPC to decide on before failing it back. Valid time units are {s, m, h}"`
+
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex encoded public key of a trusted peer we'll use channels with before their funding transaction has confirmed. Can be specified multiple times"`
+
+	// zeroConfPeers is the set of parsed ZeroConfPeers, keyed by their
+	// compressed public key.
+	zeroConfPeers map[[33]byte]struct{}
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -306,11 +434,45 @@ func loadConfig() (*config, error) {
 		Alias:               defaultAlias,
 		Color:               defaultColor,
 		MinChanSize:         int64(minChanFundingSize),
+		AcceptorTimeout:     defaultAcceptorTimeout,
+		InterceptorTimeout:  defaultInterceptorTimeout,
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
 			Control: defaultTorControl,
 		},
+		RemoteSigner: &remoteSignerConfig{
+			Timeout: remotesigner.DefaultTimeout,
+		},
+		FeeEstimator: &feeEstimatorConfig{
+			CacheDuration:   lnwallet.DefaultFeeCacheDuration,
+			SmoothingWindow: lnwallet.DefaultFeeSmoothingWindow,
+		},
+		ForwardLimits: &forwardLimitsConfig{
+			PeerBurst:    defaultLimitPeerBurst,
+			QueueTimeout: defaultLimitQueueTimeout,
+			MaxQueued:    defaultLimitMaxQueued,
+		},
+		FeePolicy: &feePolicyConfig{
+			Interval:          defaultFeePolicyInterval,
+			MinUpdateInterval: defaultFeeMinUpdateInt,
+			MaxUpdates:        defaultFeeMaxUpdates,
+			ChangeThreshold:   defaultFeeChangeThreshold,
+			VolumeWindow:      defaultFeeVolumeWindow,
+			MinMultiplier:     defaultFeeMinMultiplier,
+			MaxMultiplier:     defaultFeeMaxMultiplier,
+			VolumeWeight:      defaultFeeVolumeWeight,
+			MinFeeRate:        defaultFeeMinFeeRate,
+		},
+		Batching: &batchingConfig{
+			BatchSize:        defaultBatchSize,
+			MinInterval:      defaultBatchInterval,
+			MaxInterval:      defaultBatchInterval,
+			LogCommitTimeout: htlcswitch.DefaultLogCommitTimeout,
+		},
+		SphinxReplay: &sphinxReplayConfig{
+			Backend: defaultReplayLogBackend,
+		},
 		net: &tor.ClearNet{},
 	}
 
@@ -402,12 +564,20 @@ func loadConfig() (*config, error) {
 	cfg.AdminMacPath = cleanAndExpandPath(cfg.AdminMacPath)
 	cfg.ReadMacPath = cleanAndExpandPath(cfg.ReadMacPath)
 	cfg.InvoiceMacPath = cleanAndExpandPath(cfg.InvoiceMacPath)
+	cfg.SignerMacPath = cleanAndExpandPath(cfg.SignerMacPath)
+	cfg.RemoteSignerMacPath = cleanAndExpandPath(cfg.RemoteSignerMacPath)
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.BtcdMode.Dir = cleanAndExpandPath(cfg.BtcdMode.Dir)
 	cfg.LtcdMode.Dir = cleanAndExpandPath(cfg.LtcdMode.Dir)
 	cfg.BitcoindMode.Dir = cleanAndExpandPath(cfg.BitcoindMode.Dir)
 	cfg.LitecoindMode.Dir = cleanAndExpandPath(cfg.LitecoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
+	cfg.RemoteSigner.TLSCertPath = cleanAndExpandPath(
+		cfg.RemoteSigner.TLSCertPath,
+	)
+	cfg.RemoteSigner.MacaroonPath = cleanAndExpandPath(
+		cfg.RemoteSigner.MacaroonPath,
+	)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -529,6 +699,105 @@ func loadConfig() (*config, error) {
 			"listening is disabled")
 	}
 
+	// Ensure that we have everything needed to reach the remote signer if
+	// one is to be used.
+	if cfg.RemoteSigner.Active {
+		switch {
+		case cfg.RemoteSigner.RPCHost == "":
+			return nil, errors.New("remotesigner.rpchost must be " +
+				"set when using a remote signer")
+		case cfg.RemoteSigner.TLSCertPath == "":
+			return nil, errors.New("remotesigner.tlscertpath " +
+				"must be set when using a remote signer")
+		case cfg.RemoteSigner.Timeout <= 0:
+			return nil, errors.New("remotesigner.timeout must " +
+				"be positive")
+		}
+	}
+
+	// Stripping the private keys of the wallet only makes sense if they'll
+	// be held by a remote signer.
+	if cfg.RemoteSigner.ConvertWallet && !cfg.RemoteSigner.Active {
+		return nil, errors.New("remotesigner.convertwallet requires " +
+			"remotesigner.active")
+	}
+
+	if cfg.AcceptorTimeout <= 0 {
+		return nil, errors.New("acceptortimeout must be positive")
+	}
+
+	if cfg.InterceptorTimeout <= 0 {
+		return nil, errors.New("interceptortimeout must be positive")
+	}
+
+	if cfg.MaxDualFundAmt < 0 {
+		return nil, errors.New("maxdualfundamt must not be negative")
+	}
+
+	// Ensure that the fee estimator bounds are sane.
+	switch {
+	case cfg.FeeEstimator.MinFeeRate < 0:
+		return nil, errors.New("feeestimator.minfeerate must not be " +
+			"negative")
+	case cfg.FeeEstimator.MaxFeeRate < 0:
+		return nil, errors.New("feeestimator.maxfeerate must not be " +
+			"negative")
+	case cfg.FeeEstimator.MaxFeeRate != 0 &&
+		cfg.FeeEstimator.MaxFeeRate < cfg.FeeEstimator.MinFeeRate:
+		return nil, errors.New("feeestimator.maxfeerate must not be " +
+			"below feeestimator.minfeerate")
+	}
+
+	// Ensure that the forwarding limits are sane.
+	switch {
+	case cfg.ForwardLimits.PeerRate < 0:
+		return nil, errors.New("forwardlimits.peerrate must not be " +
+			"negative")
+	case cfg.ForwardLimits.QueueTimeout <= 0:
+		return nil, errors.New("forwardlimits.queuetimeout must be " +
+			"positive")
+	}
+
+	// Ensure that the fee policy parameters are sane.
+	switch {
+	case cfg.FeePolicy.Interval <= 0:
+		return nil, errors.New("feepolicy.interval must be positive")
+	case cfg.FeePolicy.ChangeThreshold < 0:
+		return nil, errors.New("feepolicy.changethreshold must not be " +
+			"negative")
+	case cfg.FeePolicy.VolumeWindow < 0:
+		return nil, errors.New("feepolicy.volumewindow must not be " +
+			"negative")
+	case cfg.FeePolicy.MinMultiplier <= 0 ||
+		cfg.FeePolicy.MinMultiplier > 1 ||
+		cfg.FeePolicy.MaxMultiplier < 1:
+		return nil, errors.New("feepolicy multipliers must satisfy " +
+			"0 < minmultiplier <= 1 <= maxmultiplier")
+	case cfg.FeePolicy.VolumeWeight < 0:
+		return nil, errors.New("feepolicy.volumeweight must not be " +
+			"negative")
+	case cfg.FeePolicy.MinFeeRate == 0:
+		return nil, errors.New("feepolicy.minfeerate must be positive")
+	case cfg.FeePolicy.MaxFeeRate != 0 &&
+		cfg.FeePolicy.MaxFeeRate < cfg.FeePolicy.MinFeeRate:
+		return nil, errors.New("feepolicy.maxfeerate must not be " +
+			"below feepolicy.minfeerate")
+	}
+
+	// Ensure that the batching parameters are sane.
+	switch {
+	case cfg.Batching.BatchSize == 0:
+		return nil, errors.New("batching.batchsize must be positive")
+	case cfg.Batching.MinInterval <= 0:
+		return nil, errors.New("batching.mininterval must be positive")
+	case cfg.Batching.MaxInterval < cfg.Batching.MinInterval:
+		return nil, errors.New("batching.maxinterval must not be " +
+			"below batching.mininterval")
+	case cfg.Batching.LogCommitTimeout <= 0:
+		return nil, errors.New("batching.logcommittimeout must be " +
+			"positive")
+	}
+
 	// Determine the active chain configuration and its parameters.
 	switch {
 	// At this moment, multiple active chains are not supported.
@@ -636,6 +905,7 @@ func loadConfig() (*config, error) {
 		// primary chain.
 		registeredChains.RegisterPrimaryChain(litecoinChain)
 		maxFundingAmount = maxLtcFundingAmount
+		maxWumboFundingAmount = maxLtcFundingAmountWumbo
 		maxPaymentMSat = maxLtcPaymentMSat
 
 	case cfg.Bitcoin.Active:
@@ -774,6 +1044,48 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Now that the active chain is known, we'll default the maximum
+	// channel size to the limit that applies to it, and ensure that only
+	// nodes supporting wumbo channels go beyond the soft-limit.
+	switch {
+	case cfg.MaxChanSize < 0:
+		return nil, errors.New("maxchansize must not be negative")
+
+	case cfg.MaxChanSize == 0 && cfg.WumboChannels:
+		cfg.MaxChanSize = int64(maxWumboFundingAmount)
+
+	case cfg.MaxChanSize == 0:
+		cfg.MaxChanSize = int64(maxFundingAmount)
+
+	case cfg.MaxChanSize > int64(maxFundingAmount) && !cfg.WumboChannels:
+		return nil, fmt.Errorf("maxchansize must not exceed %v "+
+			"unless wumbo-channels is set", int64(maxFundingAmount))
+	}
+	if cfg.MaxChanSize < cfg.MinChanSize {
+		return nil, errors.New("maxchansize must not be below " +
+			"minchansize")
+	}
+
+	// Parse the public keys of the peers we'll allow zero-conf channels
+	// with.
+	cfg.zeroConfPeers = make(map[[33]byte]struct{})
+	for _, peer := range cfg.ZeroConfPeers {
+		keyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zeroconfpeer %v: %v",
+				peer, err)
+		}
+		pubKey, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid zeroconfpeer %v: %v",
+				peer, err)
+		}
+
+		var key [33]byte
+		copy(key[:], pubKey.SerializeCompressed())
+		cfg.zeroConfPeers[key] = struct{}{}
+	}
+
 	// Validate profile port number.
 	if cfg.Profile != "" {
 		profilePort, err := strconv.Atoi(cfg.Profile)
@@ -812,6 +1124,16 @@ func loadConfig() (*config, error) {
 			networkDir, defaultInvoiceMacFilename,
 		)
 	}
+	if cfg.SignerMacPath == "" {
+		cfg.SignerMacPath = filepath.Join(
+			networkDir, defaultSignerMacFilename,
+		)
+	}
+	if cfg.RemoteSignerMacPath == "" {
+		cfg.RemoteSignerMacPath = filepath.Join(
+			networkDir, defaultRemoteSignerMacFile,
+		)
+	}
 
 	// Append the network type to the log directory so it is "namespaced"
 	// per network in the same fashion as the data directory.
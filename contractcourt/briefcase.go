@@ -194,6 +194,11 @@ const (
 	// sweeping out direct commitment output form the remote party's
 	// commitment transaction.
 	resolverUnilateralSweep = 4
+
+	// resolverAnchor is the type of resolver that's tasked with bumping
+	// the fee of our broadcast commitment transaction using its anchor
+	// output, if it's at risk of not confirming in time.
+	resolverAnchor = 5
 )
 
 // resolverIDLen is the size of the resolver ID key. This is 36 bytes as we get
@@ -361,6 +366,8 @@ func (b *boltArbitratorLog) writeResolver(contractBucket *bolt.Bucket,
 		rType = resolverIncomingContest
 	case *commitSweepResolver:
 		rType = resolverUnilateralSweep
+	case *anchorResolver:
+		rType = resolverAnchor
 	}
 	if _, err := buf.Write([]byte{byte(rType)}); err != nil {
 		return err
@@ -495,6 +502,14 @@ func (b *boltArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 
 				res = sweepRes
 
+			case resolverAnchor:
+				anchorRes := &anchorResolver{}
+				if err := anchorRes.Decode(resReader); err != nil {
+					return err
+				}
+
+				res = anchorRes
+
 			default:
 				return fmt.Errorf("unknown resolver type: %v", resType)
 			}
@@ -1051,3 +1066,50 @@ func decodeCommitResolution(r io.Reader,
 
 	return binary.Read(r, endian, &c.MaturityDelay)
 }
+
+func encodeAnchorResolution(w io.Writer,
+	a *lnwallet.AnchorResolution) error {
+
+	if _, err := w.Write(a.CommitAnchor.Hash[:]); err != nil {
+		return err
+	}
+	err := binary.Write(w, endian, a.CommitAnchor.Index)
+	if err != nil {
+		return err
+	}
+
+	err = lnwallet.WriteSignDescriptor(w, &a.AnchorSignDescriptor)
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, endian, a.CommitWeight); err != nil {
+		return err
+	}
+
+	return binary.Write(w, endian, a.CommitFee)
+}
+
+func decodeAnchorResolution(r io.Reader,
+	a *lnwallet.AnchorResolution) error {
+
+	_, err := io.ReadFull(r, a.CommitAnchor.Hash[:])
+	if err != nil {
+		return err
+	}
+	err = binary.Read(r, endian, &a.CommitAnchor.Index)
+	if err != nil {
+		return err
+	}
+
+	err = lnwallet.ReadSignDescriptor(r, &a.AnchorSignDescriptor)
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Read(r, endian, &a.CommitWeight); err != nil {
+		return err
+	}
+
+	return binary.Read(r, endian, &a.CommitFee)
+}
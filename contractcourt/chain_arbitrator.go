@@ -66,10 +66,11 @@ type ChainArbitratorConfig struct {
 	// NOTE: This SHOULD return a p2wkh script.
 	NewSweepAddr func() ([]byte, error)
 
-	// PublishTx reliably broadcasts a transaction to the network. Once
-	// this function exits without an error, then they transaction MUST
-	// continually be rebroadcast if needed.
-	PublishTx func(*wire.MsgTx) error
+	// PublishTx reliably broadcasts a transaction to the network, after
+	// recording the passed label for it. Once this function exits without
+	// an error, then they transaction MUST continually be rebroadcast if
+	// needed.
+	PublishTx func(*wire.MsgTx, *channeldb.TxLabel) error
 
 	// DeliverResolutionMsg is a function that will append an outgoing
 	// message to the "out box" for a ChannelLink. This is used to cancel
@@ -134,6 +135,17 @@ type ChainArbitratorConfig struct {
 
 	// Sweeper allows resolvers to sweep their final outputs.
 	Sweeper *sweep.UtxoSweeper
+
+	// NotifyClosingChannel allows the ChainArbitrator to notify outside
+	// sub-systems that a channel has started to close, either because we
+	// force closed it, or because its closing transaction has been
+	// detected on-chain.
+	NotifyClosingChannel func(*channeldb.ChannelCloseSummary)
+
+	// NotifyClosedChannel allows the ChainArbitrator to notify outside
+	// sub-systems that all contracts of a closed channel have been fully
+	// resolved.
+	NotifyClosedChannel func(*channeldb.ChannelCloseSummary)
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -248,13 +260,39 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 				log.Errorf("unable to mark link inactive: %v", err)
 			}
 
-			return chanMachine.ForceClose()
+			closeSummary, err := chanMachine.ForceClose()
+			if err != nil {
+				return nil, err
+			}
+
+			// We've now initiated the close, so we'll notify
+			// outside sub-systems right away, rather than once
+			// the commitment is detected on-chain.
+			c.cfg.NotifyClosingChannel(&channeldb.ChannelCloseSummary{
+				ChanPoint:   chanPoint,
+				ChainHash:   channel.ChainHash,
+				ClosingTXID: closeSummary.CloseTx.TxHash(),
+				RemotePub:   channel.IdentityPub,
+				Capacity:    channel.Capacity,
+				CloseType:   channeldb.LocalForceClose,
+				ShortChanID: channel.ShortChanID(),
+				IsPending:   true,
+			})
+
+			return closeSummary, nil
 		},
 		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
-		MarkChannelClosed:         channel.CloseChannel,
-		IsPendingClose:            false,
-		ChainArbitratorConfig:     c.cfg,
-		ChainEvents:               chanEvents,
+		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
+			if err := channel.CloseChannel(summary); err != nil {
+				return err
+			}
+
+			c.cfg.NotifyClosingChannel(summary)
+			return nil
+		},
+		IsPendingClose:        false,
+		ChainArbitratorConfig: c.cfg,
+		ChainEvents:           chanEvents,
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -297,6 +335,16 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 		return err
 	}
 
+	// With the channel fully closed, we'll notify any outside sub-systems
+	// using its final close summary.
+	closeSummary, err := c.chanSource.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		log.Errorf("ChainArbitrator: unable to fetch close summary "+
+			"of ChannelPoint(%v): %v", chanPoint, err)
+	} else {
+		c.cfg.NotifyClosedChannel(closeSummary)
+	}
+
 	if arbLog != nil {
 		// Once this has been marked as resolved, we'll wipe the log
 		// that the channel arbitrator was using to store its
@@ -359,6 +407,7 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				notifyClosing: c.cfg.NotifyClosingChannel,
 			},
 		)
 		if err != nil {
@@ -679,6 +728,7 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			notifyClosing: c.cfg.NotifyClosingChannel,
 		},
 	)
 	if err != nil {
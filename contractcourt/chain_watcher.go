@@ -101,6 +101,10 @@ type chainWatcherConfig struct {
 	// the channel as pending close in the database.
 	contractBreach func(*lnwallet.BreachRetribution) error
 
+	// notifyClosing is a method that will be called by the watcher once it
+	// has marked a breached channel as pending close in the database.
+	notifyClosing func(*channeldb.ChannelCloseSummary)
+
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
@@ -183,8 +187,11 @@ func (c *chainWatcher) Start() error {
 
 	// As a height hint, we'll try to use the opening height, but if the
 	// channel isn't yet open, then we'll use the height it was broadcast
-	// at.
-	heightHint := c.cfg.chanState.ShortChanID().BlockHeight
+	// at. Zero-conf channels are identified by an alias whose height lies
+	// far beyond the chain tip, so we'll use the location of their funding
+	// output instead, as otherwise a spend that confirmed while we were
+	// offline would never be detected.
+	heightHint := c.cfg.chanState.RealShortChanID().BlockHeight
 	if heightHint == 0 {
 		heightHint = chanState.FundingBroadcastHeight
 	}
@@ -411,47 +418,40 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 				"state #%v!!! Attempting recovery...",
 				broadcastStateNum, remoteStateNum)
 
-			// If we are lucky, the remote peer sent us the correct
-			// commitment point during channel sync, such that we
-			// can sweep our funds. If we cannot find the commit
-			// point, there's not much we can do other than wait
-			// for us to retrieve it. We will attempt to retrieve
-			// it from the peer each time we connect to it.
-			// TODO(halseth): actively initiate re-connection to
-			// the peer?
+			// If the channel uses the static remote key format,
+			// our output on the remote commitment pays directly
+			// to our untweaked payment basepoint, so we don't
+			// need the commitment point to sweep it. Any valid
+			// point will do for deriving the remaining keys.
 			var commitPoint *btcec.PublicKey
-			backoff := minCommitPointPollTimeout
-			for {
-				commitPoint, err = c.cfg.chanState.DataLossCommitPoint()
-				if err == nil {
-					break
-				}
-
-				log.Errorf("Unable to retrieve commitment "+
-					"point for channel(%v) with lost "+
-					"state: %v. Retrying in %v.",
-					c.cfg.chanState.FundingOutpoint,
-					err, backoff)
-
-				select {
-				// Wait before retrying, with an exponential
-				// backoff.
-				case <-time.After(backoff):
-					backoff = 2 * backoff
-					if backoff > maxCommitPointPollTimeout {
-						backoff = maxCommitPointPollTimeout
-					}
-
-				case <-c.quit:
+			if c.cfg.chanState.ChanType.HasStaticRemoteKey() {
+				log.Infof("Channel(%v) uses static remote "+
+					"key, sweeping our funds without "+
+					"waiting for the commitment point",
+					c.cfg.chanState.FundingOutpoint)
+
+				commitPoint = c.cfg.chanState.RemoteCurrentRevocation
+			} else {
+				// If we are lucky, the remote peer sent us
+				// the correct commitment point during channel
+				// sync, such that we can sweep our funds. If
+				// we cannot find the commit point, there's not
+				// much we can do other than wait for us to
+				// retrieve it. We will attempt to retrieve it
+				// from the peer each time we connect to it.
+				// TODO(halseth): actively initiate
+				// re-connection to the peer?
+				commitPoint = c.waitForCommitmentPoint()
+				if commitPoint == nil {
 					return
 				}
-			}
 
-			log.Infof("Recovered commit point(%x) for "+
-				"channel(%v)! Now attempting to use it to "+
-				"sweep our funds...",
-				commitPoint.SerializeCompressed(),
-				c.cfg.chanState.FundingOutpoint)
+				log.Infof("Recovered commit point(%x) for "+
+					"channel(%v)! Now attempting to use it "+
+					"to sweep our funds...",
+					commitPoint.SerializeCompressed(),
+					c.cfg.chanState.FundingOutpoint)
+			}
 
 			// Since we don't have the commitment stored for this
 			// state, we'll just pass an empty commitment. Note
@@ -496,6 +496,36 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
+// waitForCommitmentPoint polls the database for the commitment point the
+// remote party sent us during channel sync after we lost state, backing off
+// exponentially between attempts. If the chainWatcher is signalled to exit
+// before the point is found, nil is returned.
+func (c *chainWatcher) waitForCommitmentPoint() *btcec.PublicKey {
+	backoff := minCommitPointPollTimeout
+	for {
+		commitPoint, err := c.cfg.chanState.DataLossCommitPoint()
+		if err == nil {
+			return commitPoint
+		}
+
+		log.Errorf("Unable to retrieve commitment point for "+
+			"channel(%v) with lost state: %v. Retrying in %v.",
+			c.cfg.chanState.FundingOutpoint, err, backoff)
+
+		select {
+		// Wait before retrying, with an exponential backoff.
+		case <-time.After(backoff):
+			backoff = 2 * backoff
+			if backoff > maxCommitPointPollTimeout {
+				backoff = maxCommitPointPollTimeout
+			}
+
+		case <-c.quit:
+			return nil
+		}
+	}
+}
+
 // toSelfAmount takes a transaction and returns the sum of all outputs that pay
 // to a script that the wallet controls. If no outputs pay to us, then we
 // return zero. This is possible as our output may have been trimmed due to
@@ -812,6 +842,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 	if err := c.cfg.chanState.CloseChannel(&closeSummary); err != nil {
 		return err
 	}
+	c.cfg.notifyClosing(&closeSummary)
 
 	log.Infof("Breached channel=%v marked pending-closed",
 		c.cfg.chanState.FundingOutpoint)
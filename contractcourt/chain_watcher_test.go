@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -213,3 +214,128 @@ func TestChainWatcherRemoteUnilateralClosePendingCommit(t *testing.T) {
 		t.Fatalf("unable to find alice's commit resolution")
 	}
 }
+
+// historicalSpendNotifier is a mock notifier that mimics the historical
+// rescan of the chain notifier: a spend that confirmed while we were offline
+// is only dispatched if the height hint it was registered with isn't beyond
+// the height the spend confirmed at.
+type historicalSpendNotifier struct {
+	mockNotifier
+
+	spend *chainntnfs.SpendDetail
+}
+
+func (m *historicalSpendNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	_ []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	if heightHint <= uint32(m.spend.SpendingHeight) {
+		spendChan <- m.spend
+	}
+
+	return &chainntnfs.SpendEvent{
+		Spend:  spendChan,
+		Cancel: func() {},
+	}, nil
+}
+
+// TestChainWatcherZeroConfOfflineBreach tests that the chain watcher of a
+// zero-conf channel, which is identified by an alias, detects a breach that
+// confirmed while we were offline once it's started again.
+func TestChainWatcherZeroConfOfflineBreach(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Turn Alice's channel into a zero-conf one, whose funding transaction
+	// has confirmed at height 100 since.
+	aliceState := aliceChannel.State()
+	aliceState.ShortChannelID = lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight + 10,
+		TxIndex:     1,
+	}
+	aliceState.FundingBroadcastHeight = 99
+	err = aliceState.MarkRealShortChanID(lnwire.ShortChannelID{
+		BlockHeight: 100,
+		TxIndex:     1,
+	})
+	if err != nil {
+		t.Fatalf("unable to mark real short chan id: %v", err)
+	}
+
+	// We'll hold on to Bob's initial commitment, which will be revoked
+	// after the state transition below.
+	bobRevokedCommit := bobChannel.State().LocalCommitment.CommitTx
+
+	htlc := &lnwire.UpdateAddHTLC{
+		PaymentHash: sha256.Sum256(bytes.Repeat([]byte{1}, 32)),
+		Amount:      lnwire.NewMSatFromSatoshis(20000),
+		Expiry:      uint32(5),
+	}
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv add htlc: %v", err)
+	}
+
+	aliceSig, aliceHtlcSigs, err := aliceChannel.SignNextCommitment()
+	if err != nil {
+		t.Fatalf("alice unable to sign commitment: %v", err)
+	}
+	err = bobChannel.ReceiveNewCommitment(aliceSig, aliceHtlcSigs)
+	if err != nil {
+		t.Fatalf("bob unable to receive commitment: %v", err)
+	}
+	bobRevocation, _, err := bobChannel.RevokeCurrentCommitment()
+	if err != nil {
+		t.Fatalf("bob unable to revoke commitment: %v", err)
+	}
+	if _, _, _, err := aliceChannel.ReceiveRevocation(bobRevocation); err != nil {
+		t.Fatalf("alice unable to receive revocation: %v", err)
+	}
+
+	// While we were offline, Bob broadcast his revoked commitment, which
+	// confirmed a few blocks after the funding transaction.
+	bobTxHash := bobRevokedCommit.TxHash()
+	aliceNotifier := &historicalSpendNotifier{
+		spend: &chainntnfs.SpendDetail{
+			SpenderTxHash:  &bobTxHash,
+			SpendingTx:     bobRevokedCommit,
+			SpendingHeight: 105,
+		},
+	}
+
+	breaches := make(chan *lnwallet.BreachRetribution, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceState,
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+		contractBreach: func(r *lnwallet.BreachRetribution) error {
+			breaches <- r
+			return nil
+		},
+		notifyClosing: func(*channeldb.ChannelCloseSummary) {},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	select {
+	case retribution := <-breaches:
+		if retribution.BreachTransaction.TxHash() != bobTxHash {
+			t.Fatalf("breach retribution for wrong tx: %v",
+				retribution.BreachTransaction.TxHash())
+		}
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach confirmed while offline wasn't detected")
+	}
+}
@@ -1,6 +1,7 @@
 package contractcourt
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -295,6 +296,26 @@ func (c *ChannelArbitrator) Start() error {
 		}
 	}
 
+	// If we've broadcast our commitment transaction, but it hasn't
+	// confirmed yet, we'll relaunch any contract resolvers overseeing its
+	// confirmation.
+	if c.state == StateCommitmentBroadcasted {
+		unresolvedContracts, err = c.log.FetchUnresolvedContracts()
+		if err != nil {
+			c.cfg.BlockEpochs.Cancel()
+			return err
+		}
+
+		log.Infof("ChannelArbitrator(%v): relaunching %v contract "+
+			"resolvers", c.cfg.ChanPoint, len(unresolvedContracts))
+
+		c.activeResolvers = unresolvedContracts
+		for _, contract := range unresolvedContracts {
+			c.wg.Add(1)
+			go c.resolveContract(contract)
+		}
+	}
+
 	// We'll now attempt to advance our state forward based on the current
 	// on-chain state, and our set of active contracts.
 	startingState := c.state
@@ -562,7 +583,12 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 
 		// At this point, we'll now broadcast the commitment
 		// transaction itself.
-		if err := c.cfg.PublishTx(closeTx); err != nil {
+		err = c.cfg.PublishTx(closeTx, &channeldb.TxLabel{
+			Category: channeldb.TxCategoryUnknown,
+			Label: fmt.Sprintf("Force close of ChannelPoint(%v)",
+				c.cfg.ChanPoint),
+		})
+		if err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to broadcast "+
 				"close tx: %v", c.cfg.ChanPoint, err)
 			if err != lnwallet.ErrDoubleSpend {
@@ -576,6 +602,18 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 				c.cfg.ChanPoint, err)
 		}
 
+		// If the channel uses anchor outputs, we'll launch a resolver
+		// that makes sure the commitment confirms before any of its
+		// HTLCs expire, bumping its fee using our anchor if needed.
+		if closeSummary.AnchorResolution != nil {
+			err := c.launchAnchorResolver(closeSummary, triggerHeight)
+			if err != nil {
+				log.Errorf("ChannelArbitrator(%v): unable to "+
+					"launch anchor resolver: %v",
+					c.cfg.ChanPoint, err)
+			}
+		}
+
 		// We go to the StateCommitmentBroadcasted state, where we'll
 		// be waiting for the commitment to be confirmed.
 		nextState = StateCommitmentBroadcasted
@@ -694,8 +732,10 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 		}
 
 		// Finally, we'll launch all the required contract resolvers.
-		// Once they're all resolved, we're no longer needed.
-		c.activeResolvers = htlcResolvers
+		// Once they're all resolved, we're no longer needed. Any
+		// resolvers launched while the commitment was unconfirmed are
+		// kept active.
+		c.activeResolvers = append(c.activeResolvers, htlcResolvers...)
 		for _, contract := range htlcResolvers {
 			c.wg.Add(1)
 			go c.resolveContract(contract)
@@ -1037,6 +1077,57 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 	return actionMap
 }
 
+// launchAnchorResolver creates a resolver overseeing the confirmation of our
+// broadcast commitment transaction using its anchor output, inserts it into
+// the log, and launches it.
+func (c *ChannelArbitrator) launchAnchorResolver(
+	closeSummary *lnwallet.LocalForceCloseSummary, height uint32) error {
+
+	// The output script of the funding output can be derived from the
+	// witness script of the signed commitment spending it.
+	witness := closeSummary.CloseTx.TxIn[0].Witness
+	if len(witness) == 0 {
+		return fmt.Errorf("commitment transaction is unsigned")
+	}
+	fundingPkScript, err := lnwallet.WitnessScriptHash(
+		witness[len(witness)-1],
+	)
+	if err != nil {
+		return err
+	}
+
+	// The commitment must confirm before the earliest expiry of any of
+	// its HTLCs.
+	var deadline uint32
+	for _, htlc := range closeSummary.ChanSnapshot.Htlcs {
+		if deadline == 0 || htlc.RefundTimeout < deadline {
+			deadline = htlc.RefundTimeout
+		}
+	}
+
+	resKit := ResolverKit{
+		ChannelArbitratorConfig: c.cfg,
+		Checkpoint: func(res ContractResolver) error {
+			return c.log.InsertUnresolvedContracts(res)
+		},
+		Quit: make(chan struct{}),
+	}
+	resolver := newAnchorResolver(
+		*closeSummary.AnchorResolution, fundingPkScript, deadline,
+		height, c.cfg.ChanPoint, resKit,
+	)
+
+	if err := c.log.InsertUnresolvedContracts(resolver); err != nil {
+		return err
+	}
+
+	c.activeResolvers = append(c.activeResolvers, resolver)
+	c.wg.Add(1)
+	go c.resolveContract(resolver)
+
+	return nil
+}
+
 // prepContractResolutions is called either int he case that we decide we need
 // to go to chain, or the remote party goes to chain. Given a set of actions we
 // need to take for each HTLC, this method will return a set of contract
@@ -1624,6 +1715,14 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			log.Infof("ChannelArbitrator(%v): a contract has been "+
 				"fully resolved!", c.cfg.ChanPoint)
 
+			// Contracts may be resolved before the commitment
+			// transaction confirms, in which case the HTLC
+			// resolvers haven't been launched yet, so we can't be
+			// done.
+			if c.state != StateWaitingFullResolution {
+				continue
+			}
+
 			numUnresolved, err := c.log.FetchUnresolvedContracts()
 			if err != nil {
 				log.Errorf("unable to query resolved "+
@@ -10,9 +10,11 @@ import (
 
 	"github.com/lightningnetwork/lnd/sweep"
 
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -462,6 +464,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 				&h.htlcResolution.ClaimOutpoint,
 				&h.htlcResolution.SweepSignDesc,
 				h.htlcResolution.Preimage[:],
+				h.htlcResolution.CsvDelay,
 			)
 
 			// With the input created, we can now generate the full
@@ -493,7 +496,11 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		// Regardless of whether an existing transaction was found or newly
 		// constructed, we'll broadcast the sweep transaction to the
 		// network.
-		err := h.PublishTx(h.sweepTx)
+		err := h.PublishTx(h.sweepTx, &channeldb.TxLabel{
+			Category: channeldb.TxCategorySweep,
+			Label: fmt.Sprintf("HTLC success sweep for "+
+				"ChannelPoint(%v)", h.ChanPoint),
+		})
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Infof("%T(%x): unable to publish tx: %v",
 				h, h.payHash[:], err)
@@ -537,7 +544,11 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// the claiming process.
 	//
 	// TODO(roasbeef): after changing sighashes send to tx bundler
-	err := h.PublishTx(h.htlcResolution.SignedSuccessTx)
+	err := h.PublishTx(h.htlcResolution.SignedSuccessTx, &channeldb.TxLabel{
+		Category: channeldb.TxCategorySweep,
+		Label: fmt.Sprintf("HTLC success for ChannelPoint(%v)",
+			h.ChanPoint),
+	})
 	if err != nil && err != lnwallet.ErrDoubleSpend {
 		return nil, err
 	}
@@ -1249,12 +1260,34 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		// As we haven't already generated the sweeping transaction,
 		// we'll now craft an input with all the information required
 		// to create a fully valid sweeping transaction to recover
-		// these coins.
-		input := sweep.MakeBaseInput(
-			&c.commitResolution.SelfOutPoint,
-			lnwallet.CommitmentNoDelay,
-			&c.commitResolution.SelfOutputSignDesc,
-		)
+		// these coins. If the output pays to our static remote key,
+		// its sign descriptor won't carry a tweak. If the channel uses
+		// anchor outputs, the output is a P2WSH output that can only
+		// be spent after a one block CSV delay.
+		signDesc := &c.commitResolution.SelfOutputSignDesc
+		var input sweep.Input
+		switch {
+		case txscript.IsPayToWitnessScriptHash(signDesc.Output.PkScript):
+			csvInput := sweep.MakeCsvInput(
+				&c.commitResolution.SelfOutPoint,
+				lnwallet.CommitmentToRemoteConfirmed, signDesc, 1,
+			)
+			input = &csvInput
+
+		case signDesc.SingleTweak == nil:
+			baseInput := sweep.MakeBaseInput(
+				&c.commitResolution.SelfOutPoint,
+				lnwallet.CommitSpendNoDelayTweakless, signDesc,
+			)
+			input = &baseInput
+
+		default:
+			baseInput := sweep.MakeBaseInput(
+				&c.commitResolution.SelfOutPoint,
+				lnwallet.CommitmentNoDelay, signDesc,
+			)
+			input = &baseInput
+		}
 
 		// With out input constructed, we'll now request that the
 		// sweeper construct a valid sweeping transaction for this
@@ -1264,7 +1297,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		// zero. Will be taken care of once sweeper implementation is
 		// complete.
 		c.sweepTx, err = c.Sweeper.CreateSweepTx(
-			[]sweep.Input{&input}, sweepConfTarget, 0,
+			[]sweep.Input{input}, sweepConfTarget, 0,
 		)
 		if err != nil {
 			return nil, err
@@ -1283,7 +1316,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		// With the sweep transaction checkpointed, we'll now publish
 		// the transaction. Upon restart, the resolver will immediately
 		// take the case below since the sweep tx is checkpointed.
-		err := c.PublishTx(c.sweepTx)
+		err := c.PublishTx(c.sweepTx, c.sweepTxLabel())
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Errorf("%T(%v): unable to publish sweep tx: %v",
 				c, c.chanPoint, err)
@@ -1295,7 +1328,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	// to ensure it confirms. The resolver will enter this case after
 	// checkpointing in the case above, ensuring we reliably on restarts.
 	case c.sweepTx != nil && !isLocalCommitTx:
-		err := c.PublishTx(c.sweepTx)
+		err := c.PublishTx(c.sweepTx, c.sweepTxLabel())
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Errorf("%T(%v): unable to publish sweep tx: %v",
 				c, c.chanPoint, err)
@@ -1376,6 +1409,16 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	return nil, c.Checkpoint(c)
 }
 
+// sweepTxLabel returns the label recorded for the transaction sweeping the
+// commitment output.
+func (c *commitSweepResolver) sweepTxLabel() *channeldb.TxLabel {
+	return &channeldb.TxLabel{
+		Category: channeldb.TxCategorySweep,
+		Label: fmt.Sprintf("Commitment sweep for ChannelPoint(%v)",
+			c.chanPoint),
+	}
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -1477,3 +1520,349 @@ func (c *commitSweepResolver) AttachResolverKit(r ResolverKit) {
 // A compile time assertion to ensure commitSweepResolver meets the
 // ContractResolver interface.
 var _ ContractResolver = (*commitSweepResolver)(nil)
+
+// anchorResolver is a ContractResolver that's tasked with making sure our
+// broadcast commitment transaction confirms before any of its HTLCs expire,
+// for channels using anchor outputs. If the commitment hasn't confirmed once
+// the earliest HTLC expiry draws near, we'll spend our anchor output in a
+// child transaction that bumps the fee rate of the commitment using CPFP. The
+// contract is considered resolved once the funding output has been spent by
+// a confirmed commitment transaction.
+type anchorResolver struct {
+	// anchorResolution contains all data required to spend our anchor
+	// output of the commitment transaction.
+	anchorResolution lnwallet.AnchorResolution
+
+	// fundingPkScript is the output script of the funding output, which
+	// we'll watch for a confirmed spend.
+	fundingPkScript []byte
+
+	// deadline is the height at which the commitment transaction must be
+	// confirmed, as an HTLC on it expires. If zero, there are no HTLCs on
+	// the commitment, so its confirmation isn't urgent.
+	deadline uint32
+
+	// resolved reflects if the contract has been fully resolved or not.
+	resolved bool
+
+	// broadcastHeight is the height that the original contract was
+	// broadcast to the main-chain at. We'll use this value to bound any
+	// historical queries to the chain for spends/confirmations.
+	broadcastHeight uint32
+
+	// chanPoint is the channel point of the original contract.
+	chanPoint wire.OutPoint
+
+	// cpfpTx is the fully signed child transaction spending our anchor
+	// output, if we've had to bump the fee of the commitment.
+	cpfpTx *wire.MsgTx
+
+	ResolverKit
+}
+
+// newAnchorResolver creates a new anchorResolver for the passed anchor
+// resolution of a commitment transaction spending the funding output with the
+// passed output script.
+func newAnchorResolver(anchorRes lnwallet.AnchorResolution,
+	fundingPkScript []byte, deadline, broadcastHeight uint32,
+	chanPoint wire.OutPoint, resCfg ResolverKit) *anchorResolver {
+
+	return &anchorResolver{
+		anchorResolution: anchorRes,
+		fundingPkScript:  fundingPkScript,
+		deadline:         deadline,
+		broadcastHeight:  broadcastHeight,
+		chanPoint:        chanPoint,
+		ResolverKit:      resCfg,
+	}
+}
+
+// ResolverKey returns an identifier which should be globally unique for this
+// particular resolver within the chain the original contract resides within.
+func (c *anchorResolver) ResolverKey() []byte {
+	key := newResolverID(c.anchorResolution.CommitAnchor)
+	return key[:]
+}
+
+// Resolve instructs the contract resolver to resolve the output on-chain. Once
+// the output has been *fully* resolved, the function should return immediately
+// with a nil ContractResolver value for the first return value.  In the case
+// that the contract requires further resolution, then another resolve is
+// returned.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (c *anchorResolver) Resolve() (ContractResolver, error) {
+	// If we're already resolved, then we can exit early.
+	if c.resolved {
+		return nil, nil
+	}
+
+	// If we created a child transaction before a restart, the wallet
+	// outputs it spends are no longer locked, so we'll lock them again to
+	// make sure they're still available to replace it.
+	if c.cpfpTx != nil {
+		c.Sweeper.LockCPFPTx(c.cpfpTx)
+	}
+
+	// We'll watch for a confirmed spend of the funding output. This may
+	// be our commitment, or any other transaction closing the channel, in
+	// which case the anchor is no longer of any use to us.
+	spendNtfn, err := c.Notifier.RegisterSpendNtfn(
+		&c.chanPoint, c.fundingPkScript, c.broadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blockEpochs, err := c.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer blockEpochs.Cancel()
+
+	// Before waiting for new blocks, we'll check whether the deadline is
+	// already near at the current height.
+	_, currentHeight, err := c.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.maybeBumpFee(uint32(currentHeight)); err != nil {
+		log.Errorf("%T(%v): unable to bump commitment fee: %v", c,
+			c.chanPoint, err)
+	}
+
+	for {
+		select {
+
+		// A new block has arrived, we'll check whether we need to
+		// bump the fee of the commitment in order to have it confirm
+		// before the deadline.
+		case newBlock, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+
+			err := c.maybeBumpFee(uint32(newBlock.Height))
+			if err != nil {
+				log.Errorf("%T(%v): unable to bump commitment "+
+					"fee: %v", c, c.chanPoint, err)
+			}
+
+		// The funding output has been spent by a confirmed
+		// transaction, so there's no need to bump the fee any longer.
+		case commitSpend, ok := <-spendNtfn.Spend:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+
+			log.Infof("%T(%v): funding output spent by txid=%v, "+
+				"anchor no longer needed", c, c.chanPoint,
+				commitSpend.SpenderTxHash)
+
+			// The wallet outputs locked for the child
+			// transaction can be used elsewhere again.
+			if c.cpfpTx != nil {
+				c.Sweeper.ReleaseCPFPTx(c.cpfpTx)
+			}
+
+			c.resolved = true
+			return nil, c.Checkpoint(c)
+
+		case <-c.Quit:
+			return nil, fmt.Errorf("resolver cancelled")
+		}
+	}
+}
+
+// maybeBumpFee publishes a child transaction spending our anchor output if the
+// commitment transaction is at risk of not confirming before the deadline. As
+// the fee estimate changes with each new block, we'll replace the child with
+// one paying a higher fee if needed.
+func (c *anchorResolver) maybeBumpFee(height uint32) error {
+	// If there's no deadline, or it isn't near yet, there's nothing to do
+	// but republishing the child transaction if we already created one.
+	if c.deadline == 0 || height+c.BroadcastDelta < c.deadline {
+		if c.cpfpTx == nil {
+			return nil
+		}
+
+		err := c.PublishTx(c.cpfpTx, c.cpfpTxLabel())
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			return err
+		}
+
+		return nil
+	}
+
+	// We'll aim to have the package confirm before the deadline, or in
+	// the next block if it has passed already.
+	confTarget := uint32(1)
+	if c.deadline > height {
+		confTarget = c.deadline - height
+	}
+
+	log.Infof("%T(%v): commitment not confirmed at height=%v with "+
+		"deadline=%v, bumping fee using anchor %v", c, c.chanPoint,
+		height, c.deadline, c.anchorResolution.CommitAnchor)
+
+	input := sweep.MakeBaseInput(
+		&c.anchorResolution.CommitAnchor, lnwallet.CommitmentAnchor,
+		&c.anchorResolution.AnchorSignDescriptor,
+	)
+	cpfpTx, err := c.Sweeper.CreateCPFPTx(
+		&input, c.anchorResolution.CommitWeight,
+		c.anchorResolution.CommitFee, confTarget, c.cpfpTx,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If the child transaction was created or replaced, we'll checkpoint
+	// our state before publishing it, so we'll republish it upon restart.
+	if cpfpTx != c.cpfpTx {
+		c.cpfpTx = cpfpTx
+		if err := c.Checkpoint(c); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("%T(%v): publishing cpfp tx=%v", c, c.chanPoint,
+		newLogClosure(func() string {
+			return spew.Sdump(c.cpfpTx)
+		}))
+
+	err = c.PublishTx(c.cpfpTx, c.cpfpTxLabel())
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return err
+	}
+
+	return nil
+}
+
+// cpfpTxLabel returns the label recorded for the child transaction spending
+// our anchor output.
+func (c *anchorResolver) cpfpTxLabel() *channeldb.TxLabel {
+	return &channeldb.TxLabel{
+		Category: channeldb.TxCategorySweep,
+		Label: fmt.Sprintf("Anchor CPFP for ChannelPoint(%v)",
+			c.chanPoint),
+	}
+}
+
+// Stop signals the resolver to cancel any current resolution processes, and
+// suspend.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *anchorResolver) Stop() {
+	close(c.Quit)
+}
+
+// IsResolved returns true if the stored state in the resolve is fully
+// resolved. In this case the target output can be forgotten.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *anchorResolver) IsResolved() bool {
+	return c.resolved
+}
+
+// Encode writes an encoded version of the ContractResolver into the passed
+// Writer.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *anchorResolver) Encode(w io.Writer) error {
+	if err := encodeAnchorResolution(w, &c.anchorResolution); err != nil {
+		return err
+	}
+
+	err := wire.WriteVarBytes(w, 0, c.fundingPkScript)
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, c.deadline); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, c.resolved); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, c.broadcastHeight); err != nil {
+		return err
+	}
+	if _, err := w.Write(c.chanPoint.Hash[:]); err != nil {
+		return err
+	}
+	err = binary.Write(w, endian, c.chanPoint.Index)
+	if err != nil {
+		return err
+	}
+
+	if c.cpfpTx != nil {
+		return c.cpfpTx.Serialize(w)
+	}
+
+	return nil
+}
+
+// Decode attempts to decode an encoded ContractResolver from the passed Reader
+// instance, returning an active ContractResolver instance.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *anchorResolver) Decode(r io.Reader) error {
+	if err := decodeAnchorResolution(r, &c.anchorResolution); err != nil {
+		return err
+	}
+
+	fundingPkScript, err := wire.ReadVarBytes(r, 0, 80, "fundingPkScript")
+	if err != nil {
+		return err
+	}
+	c.fundingPkScript = fundingPkScript
+
+	if err := binary.Read(r, endian, &c.deadline); err != nil {
+		return err
+	}
+	if err := binary.Read(r, endian, &c.resolved); err != nil {
+		return err
+	}
+	if err := binary.Read(r, endian, &c.broadcastHeight); err != nil {
+		return err
+	}
+	_, err = io.ReadFull(r, c.chanPoint.Hash[:])
+	if err != nil {
+		return err
+	}
+	err = binary.Read(r, endian, &c.chanPoint.Index)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if len(txBytes) == 0 {
+		return nil
+	}
+
+	txReader := bytes.NewReader(txBytes)
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(txReader); err != nil {
+		return err
+	}
+
+	c.cpfpTx = tx
+	return nil
+}
+
+// AttachResolverKit should be called once a resolved is successfully decoded
+// from its stored format. This struct delivers a generic tool kit that
+// resolvers need to complete their duty.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *anchorResolver) AttachResolverKit(r ResolverKit) {
+	c.ResolverKit = r
+}
+
+// A compile time assertion to ensure anchorResolver meets the
+// ContractResolver interface.
+var _ ContractResolver = (*anchorResolver)(nil)
@@ -1 +1,444 @@
 package contractcourt
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// mockHeightChainIO is a BlockChainIO whose best height is set by the test.
+type mockHeightChainIO struct {
+	mockChainIO
+
+	height int32
+}
+
+func (m *mockHeightChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return nil, m.height, nil
+}
+
+// mockCPFPSigner is a Signer that returns dummy signatures, used to sign CPFP
+// transactions.
+type mockCPFPSigner struct{}
+
+func (m *mockCPFPSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	return bytes.Repeat([]byte{0x30}, 71), nil
+}
+
+func (m *mockCPFPSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{
+		Witness: wire.TxWitness{
+			bytes.Repeat([]byte{0x30}, 72),
+			bytes.Repeat([]byte{0x02}, 33),
+		},
+	}, nil
+}
+
+// anchorResolverTestContext holds a resolver bumping the fee of a commitment
+// transaction along with the mocks it interacts with.
+type anchorResolverTestContext struct {
+	t *testing.T
+
+	resolver  *anchorResolver
+	notifier  *mockNotifier
+	chainIO   *mockHeightChainIO
+	estimator *lnwallet.StaticFeeEstimator
+
+	// walletUtxo is the only output of the wallet, and locked is set
+	// while it's locked.
+	walletUtxo *lnwallet.Utxo
+	lockMtx    sync.Mutex
+	locked     bool
+
+	published   chan *wire.MsgTx
+	checkpoints chan []byte
+	resolveErr  chan error
+}
+
+func newAnchorResolverTestContext(t *testing.T,
+	deadline uint32) *anchorResolverTestContext {
+
+	ctx := &anchorResolverTestContext{
+		t: t,
+		notifier: &mockNotifier{
+			epochChan: make(chan *chainntnfs.BlockEpoch),
+			spendChan: make(chan *chainntnfs.SpendDetail),
+		},
+		chainIO:   &mockHeightChainIO{height: 100},
+		estimator: &lnwallet.StaticFeeEstimator{FeePerKW: 2000},
+		walletUtxo: &lnwallet.Utxo{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       100000,
+			PkScript: append([]byte{0x00, 0x14}, bytes.Repeat(
+				[]byte{0x01}, 20,
+			)...),
+			OutPoint: wire.OutPoint{Hash: [32]byte{1}},
+		},
+		published:   make(chan *wire.MsgTx, 10),
+		checkpoints: make(chan []byte, 10),
+		resolveErr:  make(chan error, 1),
+	}
+
+	anchorSignDesc := testSignDesc
+	anchorSignDesc.Output = &wire.TxOut{Value: 330}
+	anchorRes := lnwallet.AnchorResolution{
+		CommitAnchor: wire.OutPoint{
+			Hash:  [32]byte{2},
+			Index: 1,
+		},
+		AnchorSignDescriptor: anchorSignDesc,
+		CommitWeight:         1000,
+		CommitFee:            500,
+	}
+
+	ctx.resolver = newAnchorResolver(
+		anchorRes, []byte{0x00, 0x20}, deadline, 90, testChanPoint1,
+		ctx.resolverKit(),
+	)
+
+	return ctx
+}
+
+// resolverKit returns a fresh resolver kit using the mocks of the context.
+func (ctx *anchorResolverTestContext) resolverKit() ResolverKit {
+	sweeper := sweep.New(&sweep.UtxoSweeperConfig{
+		GenSweepScript: func() ([]byte, error) {
+			return ctx.walletUtxo.PkScript, nil
+		},
+		Estimator: ctx.estimator,
+		Signer:    &mockCPFPSigner{},
+		ListUnspentWitness: func(int32, int32) ([]*lnwallet.Utxo,
+			error) {
+
+			ctx.lockMtx.Lock()
+			defer ctx.lockMtx.Unlock()
+
+			if ctx.locked {
+				return nil, nil
+			}
+			return []*lnwallet.Utxo{ctx.walletUtxo}, nil
+		},
+		LockOutpoint: func(wire.OutPoint) {
+			ctx.lockMtx.Lock()
+			ctx.locked = true
+			ctx.lockMtx.Unlock()
+		},
+		UnlockOutpoint: func(wire.OutPoint) {
+			ctx.lockMtx.Lock()
+			ctx.locked = false
+			ctx.lockMtx.Unlock()
+		},
+		FetchInputInfo: func(prevOut *wire.OutPoint) (*wire.TxOut,
+			error) {
+
+			if *prevOut != ctx.walletUtxo.OutPoint {
+				return nil, fmt.Errorf("unknown output")
+			}
+			return &wire.TxOut{
+				PkScript: ctx.walletUtxo.PkScript,
+				Value:    int64(ctx.walletUtxo.Value),
+			}, nil
+		},
+	})
+
+	return ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				BroadcastDelta: 5,
+				Notifier:       ctx.notifier,
+				ChainIO:        ctx.chainIO,
+				Sweeper:        sweeper,
+				PublishTx: func(tx *wire.MsgTx,
+					_ *channeldb.TxLabel) error {
+
+					ctx.published <- tx
+					return nil
+				},
+			},
+		},
+		Checkpoint: func(res ContractResolver) error {
+			var b bytes.Buffer
+			if err := res.Encode(&b); err != nil {
+				return err
+			}
+			ctx.checkpoints <- b.Bytes()
+			return nil
+		},
+		Quit: make(chan struct{}),
+	}
+}
+
+// resolve runs the resolver in the background.
+func (ctx *anchorResolverTestContext) resolve() {
+	go func() {
+		_, err := ctx.resolver.Resolve()
+		ctx.resolveErr <- err
+	}()
+}
+
+// notifyBlock delivers a new block to the resolver. As the block epochs are
+// unbuffered, the resolver has finished processing the previous block once
+// this returns, while the new block may still be processed.
+func (ctx *anchorResolverTestContext) notifyBlock(height int32) {
+	ctx.t.Helper()
+
+	select {
+	case ctx.notifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: height,
+	}:
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("block %v not consumed", height)
+	}
+}
+
+// assertPublished asserts that a transaction was published, and returns it.
+func (ctx *anchorResolverTestContext) assertPublished() *wire.MsgTx {
+	ctx.t.Helper()
+
+	select {
+	case tx := <-ctx.published:
+		return tx
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("no tx published")
+	}
+
+	return nil
+}
+
+// assertNothingPublished asserts that no transaction was published.
+func (ctx *anchorResolverTestContext) assertNothingPublished() {
+	ctx.t.Helper()
+
+	select {
+	case tx := <-ctx.published:
+		ctx.t.Fatalf("unexpected tx %v published", tx.TxHash())
+	default:
+	}
+}
+
+// assertCheckpoint asserts that the resolver checkpointed its state, and
+// returns the encoded state.
+func (ctx *anchorResolverTestContext) assertCheckpoint() []byte {
+	ctx.t.Helper()
+
+	select {
+	case state := <-ctx.checkpoints:
+		return state
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("no checkpoint")
+	}
+
+	return nil
+}
+
+// assertLocked asserts whether the wallet output is locked.
+func (ctx *anchorResolverTestContext) assertLocked(locked bool) {
+	ctx.t.Helper()
+
+	ctx.lockMtx.Lock()
+	defer ctx.lockMtx.Unlock()
+
+	if ctx.locked != locked {
+		ctx.t.Fatalf("expected wallet output locked=%v", locked)
+	}
+}
+
+// spendFunding notifies the resolver of the confirmed spend of the funding
+// output, and asserts that it's resolved.
+func (ctx *anchorResolverTestContext) spendFunding() {
+	ctx.t.Helper()
+
+	select {
+	case ctx.notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &chainhash.Hash{3},
+	}:
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("spend not consumed")
+	}
+
+	select {
+	case err := <-ctx.resolveErr:
+		if err != nil {
+			ctx.t.Fatalf("unable to resolve: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("resolver didn't exit")
+	}
+
+	if !ctx.resolver.IsResolved() {
+		ctx.t.Fatalf("expected resolver to be resolved")
+	}
+
+	state := ctx.assertCheckpoint()
+	decoded := &anchorResolver{}
+	if err := decoded.Decode(bytes.NewReader(state)); err != nil {
+		ctx.t.Fatalf("unable to decode resolver: %v", err)
+	}
+	if !decoded.IsResolved() {
+		ctx.t.Fatalf("expected checkpoint to be resolved")
+	}
+}
+
+// cpfpFee returns the fee paid by the passed CPFP transaction.
+func (ctx *anchorResolverTestContext) cpfpFee(tx *wire.MsgTx) btcutil.Amount {
+	totalIn := btcutil.Amount(330)
+	totalIn += btcutil.Amount(len(tx.TxIn)-1) * ctx.walletUtxo.Value
+
+	var totalOut btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+
+	return totalIn - totalOut
+}
+
+// TestAnchorResolverNoDeadline tests that the resolver doesn't bump the fee of
+// a commitment transaction without HTLCs, and is resolved once the funding
+// output is spent.
+func TestAnchorResolverNoDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx := newAnchorResolverTestContext(t, 0)
+	ctx.resolve()
+
+	for height := int32(101); height < 200; height++ {
+		ctx.notifyBlock(height)
+	}
+	ctx.spendFunding()
+
+	ctx.assertNothingPublished()
+	ctx.assertLocked(false)
+}
+
+// TestAnchorResolverBumpFee tests that the resolver bumps the fee of the
+// commitment transaction once its deadline draws near, replaces the child
+// transaction as the fee estimate rises, and releases the wallet output once
+// the funding output is spent.
+func TestAnchorResolverBumpFee(t *testing.T) {
+	t.Parallel()
+
+	// The commitment must confirm by height 110, so the resolver will
+	// start bumping its fee at height 105.
+	ctx := newAnchorResolverTestContext(t, 110)
+	ctx.resolve()
+
+	// Once block 105 has been delivered, all earlier blocks have been
+	// processed without publishing anything.
+	for height := int32(101); height <= 105; height++ {
+		ctx.notifyBlock(height)
+	}
+	ctx.assertNothingPublished()
+
+	// As block 105 is processed, the child transaction is created,
+	// checkpointed and published. Publishing is the last thing the
+	// resolver does for a block, so it's idle once we've received the
+	// transaction.
+	cpfpTx := ctx.assertPublished()
+	if cpfpTx.TxIn[0].PreviousOutPoint !=
+		ctx.resolver.anchorResolution.CommitAnchor {
+
+		t.Fatalf("expected cpfp tx to spend the anchor")
+	}
+	ctx.assertCheckpoint()
+	ctx.assertLocked(true)
+
+	// At the same fee rate, the child is only republished, without being
+	// checkpointed again.
+	ctx.notifyBlock(106)
+	if tx := ctx.assertPublished(); tx.TxHash() != cpfpTx.TxHash() {
+		t.Fatalf("expected cpfp tx to be republished")
+	}
+	select {
+	case <-ctx.checkpoints:
+		t.Fatalf("unexpected checkpoint")
+	default:
+	}
+
+	// Once the fee rate rises, the child is replaced by one paying a
+	// higher fee.
+	ctx.estimator.FeePerKW = 20000
+	ctx.notifyBlock(107)
+	replacement := ctx.assertPublished()
+	if replacement.TxHash() == cpfpTx.TxHash() {
+		t.Fatalf("expected cpfp tx to be replaced")
+	}
+	if ctx.cpfpFee(replacement) <= ctx.cpfpFee(cpfpTx) {
+		t.Fatalf("expected replacement to pay a higher fee")
+	}
+	ctx.assertCheckpoint()
+
+	// Once the commitment confirms, the wallet output is released.
+	ctx.spendFunding()
+	ctx.assertLocked(false)
+}
+
+// TestAnchorResolverRestart tests that a resolver restored from its checkpoint
+// republishes its child transaction, and locks the wallet outputs it spends
+// again.
+func TestAnchorResolverRestart(t *testing.T) {
+	t.Parallel()
+
+	ctx := newAnchorResolverTestContext(t, 110)
+
+	// We'll start the resolver at a height close to the deadline, so it
+	// creates the child transaction right away.
+	ctx.chainIO.height = 106
+	ctx.resolve()
+	cpfpTx := ctx.assertPublished()
+	state := ctx.assertCheckpoint()
+
+	// We'll now stop the resolver, and simulate a restart, which clears
+	// the lock of the wallet output.
+	ctx.resolver.Stop()
+	select {
+	case err := <-ctx.resolveErr:
+		if err == nil {
+			t.Fatalf("expected resolver to be cancelled")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("resolver didn't exit")
+	}
+	ctx.lockMtx.Lock()
+	ctx.locked = false
+	ctx.lockMtx.Unlock()
+
+	restored := &anchorResolver{}
+	if err := restored.Decode(bytes.NewReader(state)); err != nil {
+		t.Fatalf("unable to decode resolver: %v", err)
+	}
+	restored.AttachResolverKit(ctx.resolverKit())
+	if restored.cpfpTx == nil ||
+		restored.cpfpTx.TxHash() != cpfpTx.TxHash() {
+
+		t.Fatalf("expected cpfp tx to be restored")
+	}
+	if restored.deadline != 110 || restored.chanPoint != testChanPoint1 {
+		t.Fatalf("expected resolver state to be restored")
+	}
+	ctx.resolver = restored
+
+	// Upon restart, the same child is republished, and the wallet output
+	// is locked again.
+	ctx.resolve()
+	if tx := ctx.assertPublished(); tx.TxHash() != cpfpTx.TxHash() {
+		t.Fatalf("expected cpfp tx to be republished")
+	}
+	ctx.assertLocked(true)
+
+	ctx.spendFunding()
+	ctx.assertLocked(false)
+}
@@ -1424,11 +1424,10 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 
 		// Now that we know we should update this channel, we'll update
 		// its set of policies.
-		edge.FeeBaseMSat = policyUpdate.newSchema.BaseFee
-		edge.FeeProportionalMillionths = lnwire.MilliSatoshi(
-			policyUpdate.newSchema.FeeRate,
-		)
-		edge.TimeLockDelta = uint16(policyUpdate.newSchema.TimeLockDelta)
+		err := applyChanPolicy(policyUpdate.newSchema, info, edge)
+		if err != nil {
+			return err
+		}
 
 		edgesToUpdate = append(edgesToUpdate, edgeWithInfo{
 			info: info,
@@ -1466,6 +1465,44 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 	return chanUpdates, nil
 }
 
+// applyChanPolicy applies the given policy to our edge of the channel
+// described by info.
+func applyChanPolicy(newSchema routing.ChannelPolicy,
+	info *channeldb.ChannelEdgeInfo, edge *channeldb.ChannelEdgePolicy) error {
+
+	edge.FeeBaseMSat = newSchema.BaseFee
+	edge.FeeProportionalMillionths = lnwire.MilliSatoshi(
+		newSchema.FeeRate,
+	)
+	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
+
+	switch {
+	// If the maximum HTLC is to be removed, we'll stop advertising it.
+	case newSchema.ClearMaxHTLC:
+		edge.Flags &^= lnwire.ChanUpdateOptionMaxHtlc
+		edge.MaxHTLC = 0
+
+	// If a new maximum HTLC was set, we'll advertise it, capped at the
+	// capacity of the channel.
+	case newSchema.MaxHTLC != 0:
+		maxHtlc := newSchema.MaxHTLC
+		capacity := lnwire.NewMSatFromSatoshis(info.Capacity)
+		if maxHtlc > capacity {
+			maxHtlc = capacity
+		}
+		if maxHtlc < edge.MinHTLC {
+			return fmt.Errorf("max htlc of %v is below the min "+
+				"htlc of %v for ChannelPoint(%v)", maxHtlc,
+				edge.MinHTLC, info.ChannelPoint)
+		}
+
+		edge.Flags |= lnwire.ChanUpdateOptionMaxHtlc
+		edge.MaxHTLC = maxHtlc
+	}
+
+	return nil
+}
+
 // processRejectedEdge examines a rejected edge to see if we can extract any
 // new announcements from it.  An edge will get rejected if we already added
 // the same edge without AuthProof to the graph. If the received announcement
@@ -1994,6 +2031,18 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			pubKey, _ = chanInfo.NodeKey2()
 		}
 
+		// Ensure that the optional fields of the update are consistent
+		// with the channel it applies to.
+		err = routing.ValidateChannelUpdateFields(chanInfo.Capacity, msg)
+		if err != nil {
+			rErr := fmt.Errorf("invalid channel update for "+
+				"short_chan_id=%v: %v", shortChanID, err)
+
+			log.Error(rErr)
+			nMsg.err <- rErr
+			return nil
+		}
+
 		// Validate the channel announcement with the expected public
 		// key, In the case of an invalid channel , we'll return an
 		// error to the caller and exit early.
@@ -2014,6 +2063,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			Flags:                     msg.Flags,
 			TimeLockDelta:             msg.TimeLockDelta,
 			MinHTLC:                   msg.HtlcMinimumMsat,
+			MaxHTLC:                   msg.HtlcMaximumMsat,
 			FeeBaseMSat:               lnwire.MilliSatoshi(msg.BaseFee),
 			FeeProportionalMillionths: lnwire.MilliSatoshi(msg.FeeRate),
 			ExtraOpaqueData:           msg.ExtraOpaqueData,
@@ -2522,6 +2572,7 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 		Flags:           edge.Flags,
 		TimeLockDelta:   edge.TimeLockDelta,
 		HtlcMinimumMsat: edge.MinHTLC,
+		HtlcMaximumMsat: edge.MaxHTLC,
 		BaseFee:         uint32(edge.FeeBaseMSat),
 		FeeRate:         uint32(edge.FeeProportionalMillionths),
 		ExtraOpaqueData: edge.ExtraOpaqueData,
@@ -2546,8 +2597,13 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 		return nil, nil, err
 	}
 
-	// To ensure that our signature is valid, we'll verify it ourself
-	// before committing it to the slice returned.
+	// To ensure that our update is valid, we'll verify its fields and
+	// signature ourself before committing it to the slice returned.
+	err = routing.ValidateChannelUpdateFields(info.Capacity, chanUpdate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generated invalid channel "+
+			"update: %v", err)
+	}
 	err = routing.ValidateChannelUpdateAnn(d.selfKey, chanUpdate)
 	if err != nil {
 		return nil, nil, fmt.Errorf("generated invalid channel "+
@@ -17,6 +17,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -2638,6 +2639,77 @@ func TestExtraDataNodeAnnouncementValidation(t *testing.T) {
 	}
 }
 
+// TestApplyChanPolicy tests that a policy update is applied to our edge of a
+// channel, with the maximum HTLC capped at the channel's capacity, kept if
+// none is given, and removed if requested.
+func TestApplyChanPolicy(t *testing.T) {
+	t.Parallel()
+
+	const capacity = btcutil.Amount(1000000)
+	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
+	info := &channeldb.ChannelEdgeInfo{
+		Capacity: capacity,
+	}
+	edge := &channeldb.ChannelEdgePolicy{
+		MinHTLC: 1000,
+	}
+
+	policy := routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: 10,
+			FeeRate: 100,
+		},
+		TimeLockDelta: 40,
+		MaxHTLC:       capacityMSat * 2,
+	}
+
+	assertEdge := func(maxHtlc lnwire.MilliSatoshi) {
+		t.Helper()
+
+		if edge.FeeBaseMSat != policy.BaseFee ||
+			edge.FeeProportionalMillionths != 100 ||
+			edge.TimeLockDelta != 40 {
+
+			t.Fatalf("policy not applied: %v", spew.Sdump(edge))
+		}
+		if edge.Flags.HasMaxHtlc() != (maxHtlc != 0) {
+			t.Fatalf("expected max htlc option to be %v",
+				maxHtlc != 0)
+		}
+		if edge.MaxHTLC != maxHtlc {
+			t.Fatalf("expected max htlc %v, got %v", maxHtlc,
+				edge.MaxHTLC)
+		}
+	}
+
+	// A maximum HTLC above the capacity should be capped.
+	if err := applyChanPolicy(policy, info, edge); err != nil {
+		t.Fatalf("unable to apply policy: %v", err)
+	}
+	assertEdge(capacityMSat)
+
+	// Without a maximum HTLC, the current one should be kept.
+	policy.MaxHTLC = 0
+	if err := applyChanPolicy(policy, info, edge); err != nil {
+		t.Fatalf("unable to apply policy: %v", err)
+	}
+	assertEdge(capacityMSat)
+
+	// A maximum HTLC below the minimum should be refused.
+	policy.MaxHTLC = edge.MinHTLC - 1
+	if err := applyChanPolicy(policy, info, edge); err == nil {
+		t.Fatalf("expected max htlc below min htlc to be refused")
+	}
+
+	// Finally, clearing the maximum HTLC should stop advertising it.
+	policy.MaxHTLC = 0
+	policy.ClearMaxHTLC = true
+	if err := applyChanPolicy(policy, info, edge); err != nil {
+		t.Fatalf("unable to apply policy: %v", err)
+	}
+	assertEdge(0)
+}
+
 // mockPeer implements the lnpeer.Peer interface and is used to test the
 // gossiper's interaction with peers.
 type mockPeer struct {
@@ -2673,6 +2745,9 @@ func (p *mockPeer) PubKey() [33]byte {
 	return pubkey
 }
 func (p *mockPeer) Address() net.Addr { return nil }
+func (p *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
 func (p *mockPeer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
@@ -0,0 +1,100 @@
+package feepolicy
+
+import (
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// BalanceStrategy is a Strategy that scales the fee rate of a channel with
+// its local balance and recent forwarding volume. Channels that are depleted
+// on our side charge more, so that the liquidity left is saved for those
+// willing to pay for it, while channels that hold most of their capacity on
+// our side charge less to attract payments that restore their balance. Busy
+// channels are charged more on top of that. The base fee of each channel is
+// left as is.
+type BalanceStrategy struct {
+	// FeeRate is the fee rate, in millionths, charged by a channel with
+	// an even balance and no recent forwards.
+	FeeRate uint32
+
+	// MinMultiplier is the multiplier applied to FeeRate for a channel
+	// whose capacity is all on our side.
+	MinMultiplier float64
+
+	// MaxMultiplier is the multiplier applied to FeeRate for a channel
+	// whose capacity is all on the remote side.
+	MaxMultiplier float64
+
+	// VolumeWeight determines how much the fee rate rises with the
+	// recent forwarding volume of a channel. A channel that forwarded at
+	// least its capacity has its fee rate raised by this fraction.
+	VolumeWeight float64
+
+	// MinFeeRate is the lowest fee rate that will be advertised.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest fee rate that will be advertised. A value
+	// of zero disables the ceiling.
+	MaxFeeRate uint32
+}
+
+// A compile-time check to ensure BalanceStrategy meets the Strategy
+// interface.
+var _ Strategy = (*BalanceStrategy)(nil)
+
+// FeeSchema returns the fee schema that should be advertised for the channel
+// in the given state.
+//
+// NOTE: This is part of the Strategy interface.
+func (b *BalanceStrategy) FeeSchema(state *ChannelState) routing.FeeSchema {
+	capacity := lnwire.NewMSatFromSatoshis(state.Capacity)
+	if capacity == 0 {
+		return routing.FeeSchema{
+			BaseFee: state.FeeSchema.BaseFee,
+			FeeRate: b.clamp(float64(b.FeeRate)),
+		}
+	}
+
+	// The balance multiplier goes from MaxMultiplier for an empty local
+	// balance, through one at an even balance, to MinMultiplier for a full
+	// local balance.
+	ratio := math.Min(float64(state.LocalBalance)/float64(capacity), 1)
+
+	var multiplier float64
+	if ratio < 0.5 {
+		multiplier = b.MaxMultiplier - (b.MaxMultiplier-1)*ratio*2
+	} else {
+		multiplier = 1 - (1-b.MinMultiplier)*(ratio-0.5)*2
+	}
+
+	// On top of that, the fee rate rises with the amount forwarded over
+	// the channel relative to its capacity.
+	volume := math.Min(float64(state.Volume)/float64(capacity), 1)
+	multiplier *= 1 + b.VolumeWeight*volume
+
+	return routing.FeeSchema{
+		BaseFee: state.FeeSchema.BaseFee,
+		FeeRate: b.clamp(float64(b.FeeRate) * multiplier),
+	}
+}
+
+// clamp rounds the given fee rate, and bounds it by the minimum and maximum
+// fee rates of the strategy.
+func (b *BalanceStrategy) clamp(feeRate float64) uint32 {
+	feeRate = math.Round(feeRate)
+
+	switch {
+	case feeRate < float64(b.MinFeeRate):
+		return b.MinFeeRate
+
+	case b.MaxFeeRate != 0 && feeRate > float64(b.MaxFeeRate):
+		return b.MaxFeeRate
+
+	case feeRate > math.MaxUint32:
+		return math.MaxUint32
+	}
+
+	return uint32(feeRate)
+}
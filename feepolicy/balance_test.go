@@ -0,0 +1,109 @@
+package feepolicy
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// TestBalanceStrategy asserts that the fee rate proposed by the balance
+// strategy scales with the local balance and forwarding volume of a channel,
+// and is kept within its bounds.
+func TestBalanceStrategy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		capacity = btcutil.Amount(1000000)
+		baseFee  = lnwire.MilliSatoshi(1234)
+	)
+	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
+
+	strategy := &BalanceStrategy{
+		FeeRate:       100,
+		MinMultiplier: 0.5,
+		MaxMultiplier: 2,
+		VolumeWeight:  1,
+		MinFeeRate:    1,
+		MaxFeeRate:    300,
+	}
+
+	tests := []struct {
+		name         string
+		localBalance lnwire.MilliSatoshi
+		volume       lnwire.MilliSatoshi
+		feeRate      uint32
+	}{
+		{
+			name:         "even balance",
+			localBalance: capacityMSat / 2,
+			feeRate:      100,
+		},
+		{
+			name:         "depleted",
+			localBalance: 0,
+			feeRate:      200,
+		},
+		{
+			name:         "quarter local",
+			localBalance: capacityMSat / 4,
+			feeRate:      150,
+		},
+		{
+			name:         "full",
+			localBalance: capacityMSat,
+			feeRate:      50,
+		},
+		{
+			name:         "half volume",
+			localBalance: capacityMSat / 2,
+			volume:       capacityMSat / 2,
+			feeRate:      150,
+		},
+		{
+			name:         "volume capped",
+			localBalance: capacityMSat / 2,
+			volume:       capacityMSat * 3,
+			feeRate:      200,
+		},
+		{
+			name:         "max fee rate",
+			localBalance: 0,
+			volume:       capacityMSat,
+			feeRate:      300,
+		},
+	}
+
+	for _, test := range tests {
+		schema := strategy.FeeSchema(&ChannelState{
+			Capacity:     capacity,
+			LocalBalance: test.localBalance,
+			Volume:       test.volume,
+			FeeSchema: routing.FeeSchema{
+				BaseFee: baseFee,
+				FeeRate: 1,
+			},
+		})
+
+		// The base fee of the channel should be kept.
+		if schema.BaseFee != baseFee {
+			t.Fatalf("%v: expected base fee %v, got %v", test.name,
+				baseFee, schema.BaseFee)
+		}
+		if schema.FeeRate != test.feeRate {
+			t.Fatalf("%v: expected fee rate %v, got %v", test.name,
+				test.feeRate, schema.FeeRate)
+		}
+	}
+
+	// The minimum fee rate should be respected as well.
+	strategy.MinFeeRate = 75
+	schema := strategy.FeeSchema(&ChannelState{
+		Capacity:     capacity,
+		LocalBalance: capacityMSat,
+	})
+	if schema.FeeRate != 75 {
+		t.Fatalf("expected fee rate 75, got %v", schema.FeeRate)
+	}
+}
@@ -0,0 +1,45 @@
+package feepolicy
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// ChannelState is a snapshot of one of our channels, holding everything a
+// Strategy may base the fees of the channel on.
+type ChannelState struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ShortChanID is the short channel ID of the channel.
+	ShortChanID lnwire.ShortChannelID
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance within the channel, which is the amount
+	// we're able to forward over it.
+	LocalBalance lnwire.MilliSatoshi
+
+	// Volume is the total amount forwarded out over the channel within
+	// the volume window of the Manager.
+	Volume lnwire.MilliSatoshi
+
+	// FeeSchema is the fee schema currently advertised for the channel.
+	FeeSchema routing.FeeSchema
+
+	// ManualPolicy indicates that the user set the forwarding policy of
+	// the channel manually, so the Manager must leave its fees alone.
+	ManualPolicy bool
+}
+
+// Strategy determines the fees that should be charged for forwarding payments
+// over a channel. Strategies are consulted periodically by the Manager, which
+// takes care of advertising any changes to the network.
+type Strategy interface {
+	// FeeSchema returns the fee schema that should be advertised for the
+	// channel in the given state.
+	FeeSchema(state *ChannelState) routing.FeeSchema
+}
@@ -0,0 +1,287 @@
+package feepolicy
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// Config houses the dependencies and parameters of the Manager.
+type Config struct {
+	// Strategy determines the fees advertised for each channel.
+	Strategy Strategy
+
+	// FetchChannels returns the current state of all channels whose fees
+	// should be managed. The Volume of the returned channels is filled in
+	// by the Manager.
+	FetchChannels func() ([]*ChannelState, error)
+
+	// QueryForwards queries the forwarding log for the forwards settled
+	// within a time slice.
+	QueryForwards func(channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// ApplyFeeSchema advertises the given fee schema for the channel with
+	// the given funding outpoint, and applies it to its link.
+	ApplyFeeSchema func(wire.OutPoint, routing.FeeSchema) error
+
+	// Ticker signals the Manager to re-evaluate the fees of all channels.
+	Ticker ticker.Ticker
+
+	// VolumeWindow is the period of forwarding history the volume of
+	// each channel is computed over.
+	VolumeWindow time.Duration
+
+	// MinUpdateInterval is the minimum amount of time between two fee
+	// updates for the same channel.
+	MinUpdateInterval time.Duration
+
+	// MaxUpdates is the maximum number of channels whose fees are updated
+	// in a single evaluation. Channels with the largest fee changes are
+	// updated first. A value of zero disables the limit.
+	MaxUpdates uint32
+
+	// ChangeThreshold is the minimum relative change of the fee rate of
+	// a channel that warrants a new update.
+	ChangeThreshold float64
+}
+
+// pendingUpdate is a fee schema the strategy proposed for a channel.
+type pendingUpdate struct {
+	chanPoint wire.OutPoint
+	schema    routing.FeeSchema
+	change    float64
+}
+
+// Manager periodically consults a Strategy for the fees of each channel, and
+// advertises the fees that changed. To avoid flooding the network with
+// ChannelUpdates, only significant changes are advertised, each channel is
+// updated at most once per MinUpdateInterval, and the number of updates sent
+// at once is capped.
+type Manager struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	// lastUpdate records the time the fees of each channel were last
+	// updated by the Manager.
+	lastUpdate map[wire.OutPoint]time.Time
+
+	now func() time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new fee policy manager from the given config.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:        cfg,
+		lastUpdate: make(map[wire.OutPoint]time.Time),
+		now:        time.Now,
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that periodically evaluates the fees of all
+// channels.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapUint32(&m.started, 0, 1) {
+		return nil
+	}
+
+	log.Info("Fee policy manager starting")
+
+	m.cfg.Ticker.Resume()
+
+	m.wg.Add(1)
+	go m.policyHandler()
+
+	return nil
+}
+
+// Stop signals the Manager for a graceful shutdown.
+func (m *Manager) Stop() error {
+	if !atomic.CompareAndSwapUint32(&m.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("Fee policy manager shutting down")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	return nil
+}
+
+// policyHandler evaluates the fees of all channels on each tick.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) policyHandler() {
+	defer m.wg.Done()
+	defer m.cfg.Ticker.Stop()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			if err := m.evaluate(); err != nil {
+				log.Errorf("Unable to evaluate channel fees: "+
+					"%v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// evaluate consults the strategy for the fees of each channel, and applies
+// the significant changes allowed by the rate limits.
+func (m *Manager) evaluate() error {
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	volumes, err := m.forwardingVolumes(now)
+	if err != nil {
+		return err
+	}
+
+	var updates []*pendingUpdate
+	for _, channel := range channels {
+		// Policies set manually by the user take precedence over the
+		// strategy.
+		if channel.ManualPolicy {
+			continue
+		}
+
+		channel.Volume = volumes[channel.ShortChanID]
+
+		schema := m.cfg.Strategy.FeeSchema(channel)
+		change := feeChange(channel.FeeSchema, schema)
+		if change == 0 || change < m.cfg.ChangeThreshold {
+			continue
+		}
+
+		// Channels that were updated recently must wait until the
+		// next evaluation after their update interval has passed.
+		lastUpdate, ok := m.lastUpdate[channel.ChanPoint]
+		if ok && now.Sub(lastUpdate) < m.cfg.MinUpdateInterval {
+			log.Debugf("Deferring fee update of ChannelPoint(%v), "+
+				"last updated at %v", channel.ChanPoint,
+				lastUpdate)
+			continue
+		}
+
+		updates = append(updates, &pendingUpdate{
+			chanPoint: channel.ChanPoint,
+			schema:    schema,
+			change:    change,
+		})
+	}
+
+	// If there are more updates than we may send at once, we'll send
+	// those with the largest changes first, leaving the rest for later
+	// evaluations.
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].change > updates[j].change
+	})
+	if m.cfg.MaxUpdates != 0 && uint32(len(updates)) > m.cfg.MaxUpdates {
+		updates = updates[:m.cfg.MaxUpdates]
+	}
+
+	for _, update := range updates {
+		log.Infof("Updating fees of ChannelPoint(%v) to base_fee=%v, "+
+			"fee_rate=%v", update.chanPoint, update.schema.BaseFee,
+			update.schema.FeeRate)
+
+		err := m.cfg.ApplyFeeSchema(update.chanPoint, update.schema)
+		if err != nil {
+			log.Errorf("Unable to update fees of "+
+				"ChannelPoint(%v): %v", update.chanPoint, err)
+			continue
+		}
+
+		m.lastUpdate[update.chanPoint] = now
+	}
+
+	// Forget about channels that have been closed in the meantime.
+	open := make(map[wire.OutPoint]struct{}, len(channels))
+	for _, channel := range channels {
+		open[channel.ChanPoint] = struct{}{}
+	}
+	for chanPoint := range m.lastUpdate {
+		if _, ok := open[chanPoint]; !ok {
+			delete(m.lastUpdate, chanPoint)
+		}
+	}
+
+	return nil
+}
+
+// forwardingVolumes returns the total amount forwarded out over each channel
+// within the volume window ending at the given time.
+func (m *Manager) forwardingVolumes(
+	now time.Time) (map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	if m.cfg.VolumeWindow == 0 {
+		return volumes, nil
+	}
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-m.cfg.VolumeWindow),
+		EndTime:      now,
+		NumMaxEvents: channeldb.MaxResponseEvents,
+	}
+	for {
+		timeSlice, err := m.cfg.QueryForwards(query)
+		switch {
+		case err == channeldb.ErrNoForwardingEvents:
+			return volumes, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volumes[event.OutgoingChanID] += event.AmtOut
+		}
+
+		// Once a query returns less than a full response, we've
+		// reached the end of the time slice.
+		if uint32(len(timeSlice.ForwardingEvents)) < query.NumMaxEvents {
+			return volumes, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// feeChange returns the relative change from the old to the new fee schema.
+// A change of the base fee always counts as a full change.
+func feeChange(prev, next routing.FeeSchema) float64 {
+	switch {
+	case prev.BaseFee != next.BaseFee:
+		return 1
+
+	case prev.FeeRate == next.FeeRate:
+		return 0
+
+	case prev.FeeRate == 0:
+		return 1
+	}
+
+	return math.Abs(float64(next.FeeRate)-float64(prev.FeeRate)) /
+		float64(prev.FeeRate)
+}
@@ -0,0 +1,287 @@
+package feepolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockStrategy proposes a preset fee rate for each channel, recording the
+// states it was consulted with.
+type mockStrategy struct {
+	feeRates map[wire.OutPoint]uint32
+	states   map[wire.OutPoint]ChannelState
+}
+
+// FeeSchema returns the preset fee rate of the channel.
+//
+// NOTE: This is part of the Strategy interface.
+func (m *mockStrategy) FeeSchema(state *ChannelState) routing.FeeSchema {
+	m.states[state.ChanPoint] = *state
+
+	return routing.FeeSchema{
+		BaseFee: state.FeeSchema.BaseFee,
+		FeeRate: m.feeRates[state.ChanPoint],
+	}
+}
+
+// managerTestCtx holds a Manager along with the channels it manages and the
+// fee updates it applied.
+type managerTestCtx struct {
+	t        *testing.T
+	mgr      *Manager
+	strategy *mockStrategy
+	channels []*ChannelState
+	forwards []channeldb.ForwardingEvent
+	applied  chan *pendingUpdate
+	now      time.Time
+}
+
+// newManagerTestCtx creates a Manager for the given number of channels, all
+// charging a fee rate of 100 and proposed to keep it.
+func newManagerTestCtx(t *testing.T, numChannels int) *managerTestCtx {
+	ctx := &managerTestCtx{
+		t: t,
+		strategy: &mockStrategy{
+			feeRates: make(map[wire.OutPoint]uint32),
+			states:   make(map[wire.OutPoint]ChannelState),
+		},
+		applied: make(chan *pendingUpdate, numChannels),
+		now:     time.Unix(1000000, 0),
+	}
+
+	for i := 0; i < numChannels; i++ {
+		chanPoint := wire.OutPoint{Index: uint32(i)}
+		ctx.channels = append(ctx.channels, &ChannelState{
+			ChanPoint:   chanPoint,
+			ShortChanID: lnwire.NewShortChanIDFromInt(uint64(i)),
+			Capacity:    100000,
+			FeeSchema: routing.FeeSchema{
+				BaseFee: 1000,
+				FeeRate: 100,
+			},
+		})
+		ctx.strategy.feeRates[chanPoint] = 100
+	}
+
+	ctx.mgr = NewManager(&Config{
+		Strategy: ctx.strategy,
+		FetchChannels: func() ([]*ChannelState, error) {
+			// Hand out copies, like the server would.
+			channels := make([]*ChannelState, len(ctx.channels))
+			for i, channel := range ctx.channels {
+				c := *channel
+				channels[i] = &c
+			}
+			return channels, nil
+		},
+		QueryForwards: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			resp := channeldb.ForwardingLogTimeSlice{
+				ForwardingEventQuery: q,
+			}
+			for _, event := range ctx.forwards {
+				if event.Timestamp.Before(q.StartTime) ||
+					event.Timestamp.After(q.EndTime) {
+					continue
+				}
+				resp.ForwardingEvents = append(
+					resp.ForwardingEvents, event,
+				)
+			}
+			return resp, nil
+		},
+		ApplyFeeSchema: func(op wire.OutPoint,
+			schema routing.FeeSchema) error {
+
+			// Advertised fees are picked up by the next
+			// evaluation.
+			ctx.channels[op.Index].FeeSchema = schema
+			ctx.applied <- &pendingUpdate{
+				chanPoint: op,
+				schema:    schema,
+			}
+			return nil
+		},
+		Ticker:            ticker.MockNew(time.Hour),
+		VolumeWindow:      time.Hour,
+		MinUpdateInterval: time.Hour,
+		MaxUpdates:        2,
+		ChangeThreshold:   0.1,
+	})
+	ctx.mgr.now = func() time.Time {
+		return ctx.now
+	}
+
+	return ctx
+}
+
+// evaluate runs an evaluation of the manager, and asserts that the fee rates
+// of exactly the given channels were updated.
+func (c *managerTestCtx) evaluate(expected map[uint32]uint32) {
+	c.t.Helper()
+
+	if err := c.mgr.evaluate(); err != nil {
+		c.t.Fatalf("unable to evaluate: %v", err)
+	}
+
+	for range expected {
+		select {
+		case update := <-c.applied:
+			feeRate, ok := expected[update.chanPoint.Index]
+			if !ok {
+				c.t.Fatalf("unexpected update of channel %v",
+					update.chanPoint.Index)
+			}
+			if update.schema.FeeRate != feeRate {
+				c.t.Fatalf("expected fee rate %v, got %v",
+					feeRate, update.schema.FeeRate)
+			}
+		default:
+			c.t.Fatalf("expected %v updates", len(expected))
+		}
+	}
+
+	select {
+	case update := <-c.applied:
+		c.t.Fatalf("unexpected update of channel %v",
+			update.chanPoint.Index)
+	default:
+	}
+}
+
+// TestManagerRateLimits asserts that the manager only applies significant fee
+// changes, at most MaxUpdates at once, and at most once per MinUpdateInterval
+// for each channel.
+func TestManagerRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestCtx(t, 4)
+
+	// Without any changes proposed, nothing should be updated.
+	ctx.evaluate(nil)
+
+	// Changes below the threshold are ignored, while only the two largest
+	// of the remaining changes are applied.
+	ctx.strategy.feeRates[ctx.channels[0].ChanPoint] = 105
+	ctx.strategy.feeRates[ctx.channels[1].ChanPoint] = 150
+	ctx.strategy.feeRates[ctx.channels[2].ChanPoint] = 300
+	ctx.strategy.feeRates[ctx.channels[3].ChanPoint] = 40
+	ctx.evaluate(map[uint32]uint32{2: 300, 3: 40})
+
+	// The next evaluation picks up the remaining significant change.
+	ctx.evaluate(map[uint32]uint32{1: 150})
+
+	// Channels that were just updated must wait for their update
+	// interval to pass.
+	ctx.strategy.feeRates[ctx.channels[2].ChanPoint] = 100
+	ctx.now = ctx.now.Add(30 * time.Minute)
+	ctx.evaluate(nil)
+
+	ctx.now = ctx.now.Add(30 * time.Minute)
+	ctx.evaluate(map[uint32]uint32{2: 100})
+}
+
+// TestManagerVolume asserts that the manager passes the forwarding volume of
+// each channel within the volume window to the strategy.
+func TestManagerVolume(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestCtx(t, 2)
+
+	chanID0 := ctx.channels[0].ShortChanID
+	chanID1 := ctx.channels[1].ShortChanID
+	ctx.forwards = []channeldb.ForwardingEvent{
+		{
+			Timestamp:      ctx.now.Add(-2 * time.Hour),
+			OutgoingChanID: chanID0,
+			AmtOut:         5000,
+		},
+		{
+			Timestamp:      ctx.now.Add(-time.Minute),
+			IncomingChanID: chanID1,
+			OutgoingChanID: chanID0,
+			AmtOut:         1000,
+		},
+		{
+			Timestamp:      ctx.now.Add(-time.Second),
+			IncomingChanID: chanID0,
+			OutgoingChanID: chanID0,
+			AmtOut:         2000,
+		},
+	}
+	ctx.evaluate(nil)
+
+	volume0 := ctx.strategy.states[ctx.channels[0].ChanPoint].Volume
+	if volume0 != 3000 {
+		t.Fatalf("expected volume 3000, got %v", volume0)
+	}
+	volume1 := ctx.strategy.states[ctx.channels[1].ChanPoint].Volume
+	if volume1 != 0 {
+		t.Fatalf("expected volume 0, got %v", volume1)
+	}
+}
+
+// TestManagerManualPolicy asserts that the manager leaves the fees of channels
+// whose policy was set manually by the user alone.
+func TestManagerManualPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestCtx(t, 2)
+
+	// The user sets the policy of the first channel manually, after
+	// which the strategy proposes new fee rates for both channels.
+	ctx.channels[0].FeeSchema.FeeRate = 500
+	ctx.channels[0].ManualPolicy = true
+	ctx.strategy.feeRates[ctx.channels[0].ChanPoint] = 200
+	ctx.strategy.feeRates[ctx.channels[1].ChanPoint] = 200
+
+	// Only the managed channel should be updated, while the manual policy
+	// survives the evaluation.
+	ctx.evaluate(map[uint32]uint32{1: 200})
+	if ctx.channels[0].FeeSchema.FeeRate != 500 {
+		t.Fatalf("expected manual fee rate 500, got %v",
+			ctx.channels[0].FeeSchema.FeeRate)
+	}
+
+	// Once handed back to the manager, the channel is updated again.
+	ctx.channels[0].ManualPolicy = false
+	ctx.evaluate(map[uint32]uint32{0: 200})
+}
+
+// TestManagerTicker asserts that the manager evaluates the fees of all
+// channels on each tick.
+func TestManagerTicker(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestCtx(t, 1)
+	ctx.strategy.feeRates[ctx.channels[0].ChanPoint] = 200
+
+	if err := ctx.mgr.Start(); err != nil {
+		t.Fatalf("unable to start manager: %v", err)
+	}
+	defer ctx.mgr.Stop()
+
+	mockTicker := ctx.mgr.cfg.Ticker.(*ticker.Mock)
+	select {
+	case mockTicker.Force <- time.Now():
+	case <-time.After(time.Second):
+		t.Fatalf("ticker not resumed")
+	}
+
+	select {
+	case update := <-ctx.applied:
+		if update.schema.FeeRate != 200 {
+			t.Fatalf("expected fee rate 200, got %v",
+				update.schema.FeeRate)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("fees not updated")
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
 	"fmt"
 	"sync"
@@ -10,12 +11,14 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -51,6 +54,11 @@ const (
 	// the channel. 288 blocks is ~48 hrs
 	maxWaitNumBlocksFundingConf = 288
 
+	// zeroConfSafeDepth is the number of confirmations the funding
+	// transaction of a zero-conf channel needs before we consider its real
+	// short channel ID final, and add the channel to the router graph.
+	zeroConfSafeDepth = 6
+
 	// minChanFundingSize is the smallest channel that we'll allow to be
 	// created over the RPC interface.
 	minChanFundingSize = btcutil.Amount(20000)
@@ -66,6 +74,16 @@ const (
 	// currently accepted on the Litecoin chain within the Lightning
 	// Protocol.
 	maxLtcFundingAmount = maxBtcFundingAmount * btcToLtcConversionRate
+
+	// maxBtcFundingAmountWumbo is the default maximum channel size on the
+	// Bitcoin chain for channels with peers that signal support for large
+	// (wumbo) channels.
+	maxBtcFundingAmountWumbo = btcutil.Amount(1000000000)
+
+	// maxLtcFundingAmountWumbo is the default maximum channel size on the
+	// Litecoin chain for channels with peers that signal support for large
+	// (wumbo) channels.
+	maxLtcFundingAmountWumbo = maxBtcFundingAmountWumbo * btcToLtcConversionRate
 )
 
 var (
@@ -77,14 +95,24 @@ var (
 	// At the moment, this value depends on which chain is active. It is set
 	// to the value under the Bitcoin chain as default.
 	//
-	// TODO(roasbeef): add command line param to modify
+	// Channels above this limit are only possible with peers that signal
+	// support for large (wumbo) channels.
 	maxFundingAmount = maxBtcFundingAmount
 
+	// maxWumboFundingAmount is the default maximum channel size for
+	// channels with peers that signal support for large (wumbo) channels.
+	// Like maxFundingAmount, it depends on which chain is active.
+	maxWumboFundingAmount = maxBtcFundingAmountWumbo
+
 	// ErrFundingManagerShuttingDown is an error returned when attempting to
 	// process a funding request/message but the funding manager has already
 	// been signaled to shut down.
 	ErrFundingManagerShuttingDown = errors.New("funding manager shutting " +
 		"down")
+
+	// ErrFundingCanceled is the error a funding flow is failed with when
+	// it was canceled on request of the user.
+	ErrFundingCanceled = errors.New("funding canceled by user")
 )
 
 // reservationWithCtx encapsulates a pending channel reservation. This wrapper
@@ -103,8 +131,26 @@ type reservationWithCtx struct {
 	chanAmt btcutil.Amount
 
 	// Constraints we require for the remote.
-	remoteCsvDelay uint16
-	remoteMinHtlc  lnwire.MilliSatoshi
+	remoteCsvDelay    uint16
+	remoteMinHtlc     lnwire.MilliSatoshi
+	remoteMaxValue    lnwire.MilliSatoshi
+	remoteMaxHtlcs    uint16
+	remoteChanReserve btcutil.Amount
+
+	// dualFund is true if this reservation is for a dual funded channel.
+	// For the initiator, this means the remote party was invited to
+	// contribute funds, for the responder, that we contribute funds of
+	// our own.
+	dualFund bool
+
+	// remoteFunding is the contribution of the remote party to the
+	// funding transaction of a dual funded channel.
+	remoteFunding *lnwire.FundingContribution
+
+	// remoteInputScripts are the input scripts for the inputs the
+	// responder contributed to the funding transaction of a dual funded
+	// channel. These are only set for the initiator.
+	remoteInputScripts []*lnwallet.InputScript
 
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
@@ -157,6 +203,16 @@ type fundingOpenMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingAcceptorMsg carries the decision of the channel acceptor for an
+// inbound channel back to the reservationCoordinator, which consults the
+// acceptor in a separate goroutine so that a slow or external acceptor
+// doesn't stall every other funding flow.
+type fundingAcceptorMsg struct {
+	fmsg   *fundingOpenMsg
+	params *chanacceptor.ChannelParams
+	err    error
+}
+
 // fundingAcceptMsg couples an lnwire.AcceptChannel message with the peer who
 // sent the message. This allows the funding manager to queue a response
 // directly to the peer, progressing the funding workflow.
@@ -181,6 +237,22 @@ type fundingSignedMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingContributionMsg couples an lnwire.FundingContribution message with the
+// peer who sent the message. This allows the funding manager to queue a
+// response directly to the peer, progressing the funding workflow.
+type fundingContributionMsg struct {
+	msg  *lnwire.FundingContribution
+	peer lnpeer.Peer
+}
+
+// fundingInputSigsMsg couples an lnwire.FundingInputSigs message with the peer
+// who sent the message. This allows the funding manager to queue a response
+// directly to the peer, progressing the funding workflow.
+type fundingInputSigsMsg struct {
+	msg  *lnwire.FundingInputSigs
+	peer lnpeer.Peer
+}
+
 // fundingLockedMsg couples an lnwire.FundingLocked message with the peer who
 // sent the message. This allows the funding manager to finalize the funding
 // process and announce the existence of the new channel.
@@ -227,8 +299,9 @@ type fundingConfig struct {
 	Wallet *lnwallet.LightningWallet
 
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network. The passed label is persisted along
+	// with the transaction.
+	PublishTransaction func(*wire.MsgTx, *channeldb.TxLabel) error
 
 	// FeeEstimator calculates appropriate fee rates based on historical
 	// transaction information.
@@ -319,6 +392,17 @@ type fundingConfig struct {
 	// sub-systems.
 	ReportShortChanID func(wire.OutPoint) error
 
+	// NotifyPendingOpenChannelEvent informs outside sub-systems that a
+	// channel has entered the pending open state: the funding flow is
+	// complete and we're now waiting for the funding transaction to
+	// confirm.
+	NotifyPendingOpenChannelEvent func(wire.OutPoint, *channeldb.OpenChannel)
+
+	// NotifyOpenChannelEvent informs outside sub-systems that a channel
+	// has gone from pending open to open, as its funding transaction has
+	// confirmed.
+	NotifyOpenChannelEvent func(*channeldb.OpenChannel)
+
 	// ZombieSweeperInterval is the periodic time interval in which the
 	// zombie sweeper is run.
 	ZombieSweeperInterval time.Duration
@@ -332,6 +416,25 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// MaxDualFundAmt is the maximum amount we'll contribute to a channel
+	// opened by a remote peer that invites us to dual fund it. If zero,
+	// we won't contribute to any inbound channels.
+	MaxDualFundAmt btcutil.Amount
+
+	// MaxChanSize is the largest channel we'll open or accept. Channels
+	// above the soft-limit maxFundingAmount are only possible with peers
+	// that signal support for large (wumbo) channels.
+	MaxChanSize btcutil.Amount
+
+	// ChannelAcceptor is consulted for each inbound channel that passed
+	// our static checks, and decides whether it's accepted.
+	ChannelAcceptor chanacceptor.ChannelAcceptor
+
+	// IsZeroConfPeer returns true if the given peer is trusted to open
+	// zero-conf channels with us, meaning they can be used before their
+	// funding transaction has confirmed.
+	IsZeroConfPeer func(*btcec.PublicKey) bool
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -376,6 +479,14 @@ type fundingManager struct {
 	// goroutine safe.
 	resMtx sync.RWMutex
 
+	// pendingAcceptances counts, per peer, the inbound channels that are
+	// awaiting a decision of the channel acceptor. They're counted
+	// towards the peer's pending channels so that the limit can't be
+	// bypassed while the acceptor is being consulted.
+	//
+	// NOTE: This map MUST only be accessed by the reservationCoordinator.
+	pendingAcceptances map[serializedPubKey]int
+
 	// fundingMsgs is a channel which receives wrapped wire messages
 	// related to funding workflow from outside peers.
 	fundingMsgs chan interface{}
@@ -401,6 +512,19 @@ type fundingManager struct {
 	handleFundingLockedMtx      sync.RWMutex
 	handleFundingLockedBarriers map[lnwire.ChannelID]struct{}
 
+	// fundingCancels tracks the pending channels whose funding inputs are
+	// currently being double spent on request of the user, so concurrent
+	// requests don't create competing transactions.
+	fundingCancelMtx sync.Mutex
+	fundingCancels   map[wire.OutPoint]struct{}
+
+	// fundingWaits maps the channel point of each pending channel whose
+	// funding transaction we're waiting to confirm to a channel that's
+	// closed to stop waiting, e.g. once the funding inputs have been
+	// double spent.
+	fundingWaitMtx sync.Mutex
+	fundingWaits   map[wire.OutPoint]chan struct{}
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -434,6 +558,11 @@ var (
 	// of being opened.
 	channelOpeningStateBucket = []byte("channelOpeningState")
 
+	// fundingCancelBucket is the database bucket used to store the
+	// transactions double spending the funding inputs of pending channels
+	// the user canceled, until they've confirmed.
+	fundingCancelBucket = []byte("fundingCancel")
+
 	// ErrChannelNotFound is an error returned when a channel is not known
 	// to us. In this case of the fundingManager, this error is returned
 	// when the channel in question is not considered being in an opening
@@ -449,11 +578,14 @@ func newFundingManager(cfg fundingConfig) (*fundingManager, error) {
 		chanIDKey:                   cfg.TempChanIDSeed,
 		activeReservations:          make(map[serializedPubKey]pendingChannels),
 		signedReservations:          make(map[lnwire.ChannelID][32]byte),
+		pendingAcceptances:          make(map[serializedPubKey]int),
 		newChanBarriers:             make(map[lnwire.ChannelID]chan struct{}),
 		fundingMsgs:                 make(chan interface{}, msgBufferSize),
 		fundingRequests:             make(chan *initFundingMsg, msgBufferSize),
 		localDiscoverySignals:       make(map[lnwire.ChannelID]chan struct{}),
 		handleFundingLockedBarriers: make(map[lnwire.ChannelID]struct{}),
+		fundingCancels:              make(map[wire.OutPoint]struct{}),
+		fundingWaits:                make(map[wire.OutPoint]chan struct{}),
 		queries:                     make(chan interface{}, 1),
 		quit:                        make(chan struct{}),
 	}, nil
@@ -493,16 +625,48 @@ func (f *fundingManager) Start() error {
 
 		f.localDiscoverySignals[chanID] = make(chan struct{})
 
+		// We'll register the wait for the funding transaction before
+		// resuming to wait for any cancel transaction, such that its
+		// confirmation can't go unnoticed by the funding flow.
+		cancelChan := f.registerFundingWait(channel.FundingOutpoint)
+
 		// Rebroadcast the funding transaction for any pending channel
 		// that we initiated. If this operation fails due to a reported
 		// double spend, we treat this as an indicator that we have
 		// already broadcast this transaction. Otherwise, we simply log
 		// the error as there isn't anything we can currently do to
 		// recover.
-		if channel.ChanType == channeldb.SingleFunder &&
-			channel.IsInitiator {
+		//
+		// If the user canceled the channel, we'll instead rebroadcast
+		// the transaction double spending the funding inputs, and
+		// resume waiting for it to confirm.
+		cancelTx, err := f.fetchFundingCancelTx(&channel.FundingOutpoint)
+		switch {
+		case err != nil:
+			return err
+
+		case cancelTx != nil:
+			err := f.cfg.PublishTransaction(
+				cancelTx,
+				fundingCancelTxLabel(channel.FundingOutpoint),
+			)
+			if err != nil && err != lnwallet.ErrDoubleSpend {
+				fndgLog.Errorf("Unable to rebroadcast funding "+
+					"cancel tx for ChannelPoint(%v): %v",
+					channel.FundingOutpoint, err)
+			}
 
-			err := f.cfg.PublishTransaction(channel.FundingTxn)
+			f.wg.Add(1)
+			go f.waitForFundingCancel(channel, cancelTx)
+
+		// As the responder of a dual funded channel contributed inputs
+		// as well, it rebroadcasts the funding transaction once it
+		// learned the initiator's input scripts.
+		case channel.IsInitiator || dualFundingTxSigned(channel):
+			err := f.cfg.PublishTransaction(
+				channel.FundingTxn,
+				fundingTxLabel(channel.FundingOutpoint),
+			)
 			if err != nil && err != lnwallet.ErrDoubleSpend {
 				fndgLog.Errorf("Unable to rebroadcast funding "+
 					"tx for ChannelPoint(%v): %v",
@@ -514,7 +678,9 @@ func (f *fundingManager) Start() error {
 		timeoutChan := make(chan struct{})
 
 		go func(ch *channeldb.OpenChannel) {
-			go f.waitForFundingWithTimeout(ch, confChan, timeoutChan)
+			go f.waitForFundingWithTimeout(
+				ch, cancelChan, confChan, timeoutChan,
+			)
 
 			select {
 			case <-timeoutChan:
@@ -522,20 +688,7 @@ func (f *fundingManager) Start() error {
 				// mined since the channel was initiated reaches
 				// maxWaitNumBlocksFundingConf and we are not the channel
 				// initiator.
-				localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
-				closeInfo := &channeldb.ChannelCloseSummary{
-					ChainHash:               ch.ChainHash,
-					ChanPoint:               ch.FundingOutpoint,
-					RemotePub:               ch.IdentityPub,
-					Capacity:                ch.Capacity,
-					SettledBalance:          localBalance,
-					CloseType:               channeldb.FundingCanceled,
-					RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
-					RemoteNextRevocation:    ch.RemoteNextRevocation,
-					LocalChanConfig:         ch.LocalChanCfg,
-				}
-
-				if err := ch.CloseChannel(closeInfo); err != nil {
+				if err := closeCanceledChannel(ch); err != nil {
 					fndgLog.Errorf("Failed closing channel "+
 						"%v: %v", ch.FundingOutpoint, err)
 				}
@@ -652,6 +805,20 @@ func (f *fundingManager) Start() error {
 			go func(dbChan *channeldb.OpenChannel) {
 				defer f.wg.Done()
 
+				// A zero-conf channel is only added to the
+				// router graph once its funding transaction
+				// confirmed.
+				if shortChanID.IsAlias() {
+					shortChanID, err = f.waitForRealShortChanID(
+						dbChan,
+					)
+					if err != nil {
+						fndgLog.Errorf("failed waiting for "+
+							"real short chan id: %v", err)
+						return
+					}
+				}
+
 				err = f.addToRouterGraph(dbChan, shortChanID)
 				if err != nil {
 					fndgLog.Errorf("failed adding to "+
@@ -811,6 +978,387 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 	delete(f.activeReservations, nodePub)
 }
 
+// pendingReservation describes a reservation whose funding transaction hasn't
+// been published yet.
+type pendingReservation struct {
+	identityPub   *btcec.PublicKey
+	pendingChanID [32]byte
+	capacity      btcutil.Amount
+}
+
+// PendingReservations returns all reservations whose funding transaction
+// hasn't been published yet.
+func (f *fundingManager) PendingReservations() []*pendingReservation {
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	var reservations []*pendingReservation
+	for _, pendingReservations := range f.activeReservations {
+		for pendingChanID, resCtx := range pendingReservations {
+			reservations = append(reservations, &pendingReservation{
+				identityPub:   resCtx.peer.IdentityKey(),
+				pendingChanID: pendingChanID,
+				capacity:      resCtx.chanAmt,
+			})
+		}
+	}
+
+	return reservations
+}
+
+// cancelPendingChanReq is a request to cancel the funding flow of a pending
+// channel, identified either by its pending channel ID or its channel point.
+type cancelPendingChanReq struct {
+	pendingChanID [32]byte
+	chanPoint     *wire.OutPoint
+	feeRate       lnwallet.SatPerKWeight
+
+	resp chan *chainhash.Hash
+	err  chan error
+}
+
+// CancelPendingChannel cancels the funding flow of a pending channel. If no
+// channel point is passed, the reservation with the given pending channel ID
+// is canceled, which unlocks its coins. Otherwise, the wallet's inputs to the
+// funding transaction of the pending channel are double spent at the given
+// fee rate, and the hash of that transaction is returned. The channel is
+// forgotten once it confirmed.
+func (f *fundingManager) CancelPendingChannel(pendingChanID [32]byte,
+	chanPoint *wire.OutPoint,
+	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+
+	req := &cancelPendingChanReq{
+		pendingChanID: pendingChanID,
+		chanPoint:     chanPoint,
+		feeRate:       feeRate,
+		resp:          make(chan *chainhash.Hash, 1),
+		err:           make(chan error, 1),
+	}
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return nil, ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case txid := <-req.resp:
+		return txid, nil
+	case err := <-req.err:
+		return nil, err
+	case <-f.quit:
+		return nil, ErrFundingManagerShuttingDown
+	}
+}
+
+// handleCancelPendingChannel handles a request to cancel the funding flow of a
+// pending channel.
+func (f *fundingManager) handleCancelPendingChannel(msg *cancelPendingChanReq) {
+	if msg.chanPoint == nil {
+		if err := f.cancelReservation(msg.pendingChanID); err != nil {
+			msg.err <- err
+			return
+		}
+
+		msg.resp <- nil
+		return
+	}
+
+	// Creating and publishing the transaction double spending the funding
+	// inputs involves the wallet and the backend, so we'll do so outside
+	// of the reservationCoordinator to not stall other funding flows.
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		txid, err := f.cancelPublishedFunding(msg.chanPoint, msg.feeRate)
+		if err != nil {
+			msg.err <- err
+			return
+		}
+
+		msg.resp <- txid
+	}()
+}
+
+// cancelReservation fails the funding flow of the reservation with the given
+// pending channel ID, whose funding transaction hasn't been published yet.
+func (f *fundingManager) cancelReservation(pendingChanID [32]byte) error {
+	var resCtx *reservationWithCtx
+	f.resMtx.RLock()
+	for _, pendingReservations := range f.activeReservations {
+		if ctx, ok := pendingReservations[pendingChanID]; ok {
+			resCtx = ctx
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+
+	if resCtx == nil {
+		return fmt.Errorf("unable to find reservation for "+
+			"pendingID(%x)", pendingChanID[:])
+	}
+
+	fndgLog.Infof("Canceling funding flow for pendingID(%x) on user "+
+		"request", pendingChanID[:])
+
+	f.failFundingFlow(resCtx.peer, pendingChanID, ErrFundingCanceled)
+
+	return nil
+}
+
+// cancelPublishedFunding double spends the wallet's inputs to the published
+// funding transaction of the pending channel with the given channel point,
+// and returns the hash of the transaction doing so. Once it confirmed, the
+// channel is forgotten.
+func (f *fundingManager) cancelPublishedFunding(chanPoint *wire.OutPoint,
+	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+
+	f.fundingCancelMtx.Lock()
+	if _, ok := f.fundingCancels[*chanPoint]; ok {
+		f.fundingCancelMtx.Unlock()
+		return nil, fmt.Errorf("pending channel %v is already being "+
+			"canceled", chanPoint)
+	}
+	f.fundingCancels[*chanPoint] = struct{}{}
+	f.fundingCancelMtx.Unlock()
+
+	defer func() {
+		f.fundingCancelMtx.Lock()
+		delete(f.fundingCancels, *chanPoint)
+		f.fundingCancelMtx.Unlock()
+	}()
+
+	pendingChannels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var channel *channeldb.OpenChannel
+	for _, pendingChan := range pendingChannels {
+		if pendingChan.FundingOutpoint == *chanPoint {
+			channel = pendingChan
+			break
+		}
+	}
+	if channel == nil {
+		return nil, fmt.Errorf("unable to find pending channel %v",
+			chanPoint)
+	}
+
+	// Only the initiator knows the funding transaction, so there's nothing
+	// we could double spend for channels opened by the remote party.
+	if !channel.IsInitiator || channel.FundingTxn == nil {
+		return nil, fmt.Errorf("pending channel %v wasn't initiated "+
+			"by us", chanPoint)
+	}
+
+	cancelTx, err := f.cfg.Wallet.CreateFundingCancelTx(
+		channel.FundingTxn, feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create funding cancel tx: %v",
+			err)
+	}
+
+	// We'll persist the transaction before publishing it, such that we
+	// can resume waiting for it to confirm after a restart.
+	if err := f.saveFundingCancelTx(chanPoint, cancelTx); err != nil {
+		return nil, err
+	}
+
+	txid := cancelTx.TxHash()
+	fndgLog.Infof("Canceling ChannelPoint(%v) on user request by double "+
+		"spending its funding inputs in tx %v", chanPoint, txid)
+
+	err = f.cfg.PublishTransaction(cancelTx, fundingCancelTxLabel(*chanPoint))
+	if err != nil {
+		if err := f.deleteFundingCancelTx(chanPoint); err != nil {
+			fndgLog.Errorf("Unable to delete funding cancel tx: %v",
+				err)
+		}
+
+		return nil, fmt.Errorf("unable to publish funding cancel "+
+			"tx: %v", err)
+	}
+
+	f.wg.Add(1)
+	go f.waitForFundingCancel(channel, cancelTx)
+
+	return &txid, nil
+}
+
+// waitForFundingCancel waits for the transaction double spending the funding
+// inputs of the passed pending channel to confirm, after which the channel is
+// forgotten.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) waitForFundingCancel(channel *channeldb.OpenChannel,
+	cancelTx *wire.MsgTx) {
+
+	defer f.wg.Done()
+
+	chanPoint := channel.FundingOutpoint
+	txid := cancelTx.TxHash()
+	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, cancelTx.TxOut[0].PkScript, 1,
+		channel.FundingBroadcastHeight,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to register for confirmation of "+
+			"funding cancel tx %v: %v", txid, err)
+		return
+	}
+
+	fndgLog.Infof("Waiting for funding cancel tx %v of ChannelPoint(%v) "+
+		"to confirm", txid, chanPoint)
+
+	select {
+	case _, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return
+		}
+	case <-f.quit:
+		return
+	}
+
+	fndgLog.Infof("Funding cancel tx %v confirmed, forgetting "+
+		"ChannelPoint(%v)", txid, chanPoint)
+
+	// The funding transaction can no longer confirm, so we'll stop
+	// waiting for it.
+	f.stopFundingWait(chanPoint)
+
+	if err := closeCanceledChannel(channel); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v", chanPoint, err)
+		return
+	}
+
+	if err := f.deleteFundingCancelTx(&chanPoint); err != nil {
+		fndgLog.Errorf("Unable to delete funding cancel tx: %v", err)
+	}
+
+	// Now that the funding transaction can no longer confirm, we'll let
+	// the peer know, so it can forget the channel as well.
+	peerChan := make(chan lnpeer.Peer, 1)
+	f.cfg.NotifyWhenOnline(channel.IdentityPub, peerChan)
+
+	var peer lnpeer.Peer
+	select {
+	case peer = <-peerChan:
+	case <-f.quit:
+		return
+	}
+
+	err = peer.SendMessage(false, &lnwire.Error{
+		ChanID: lnwire.NewChanIDFromOutPoint(&chanPoint),
+		Data:   lnwire.ErrorData(ErrFundingCanceled.Error()),
+	})
+	if err != nil {
+		fndgLog.Errorf("Unable to notify peer(%x) of canceled "+
+			"ChannelPoint(%v): %v",
+			channel.IdentityPub.SerializeCompressed(), chanPoint, err)
+	}
+}
+
+// fetchPendingChannel returns the pending channel with the given peer and
+// channel ID, whose funding transaction was published but hasn't confirmed
+// yet.
+func (f *fundingManager) fetchPendingChannel(peerKey *btcec.PublicKey,
+	chanID lnwire.ChannelID) (*channeldb.OpenChannel, error) {
+
+	pendingChannels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range pendingChannels {
+		if !channel.IdentityPub.IsEqual(peerKey) {
+			continue
+		}
+
+		pendingChanID := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		if pendingChanID == chanID {
+			return channel, nil
+		}
+	}
+
+	return nil, ErrChannelNotFound
+}
+
+// IsPendingOpenChannel returns a boolean indicating whether the channel
+// identified by the given channel ID and peer is pending, meaning its funding
+// transaction was published but hasn't confirmed yet.
+func (f *fundingManager) IsPendingOpenChannel(chanID lnwire.ChannelID,
+	peerKey *btcec.PublicKey) bool {
+
+	_, err := f.fetchPendingChannel(peerKey, chanID)
+	return err == nil
+}
+
+// handlePendingChannelError handles an error the remote peer sent for a
+// pending channel whose funding transaction was published, which is what the
+// initiator does once it canceled the channel by double spending the funding
+// inputs. As we can't verify that the funding transaction can no longer
+// confirm, we'll only forget the channel if we didn't initiate it, and don't
+// have any funds at stake in it. Otherwise, we'll keep waiting for the funding
+// transaction as before. False is returned if there's no such channel.
+func (f *fundingManager) handlePendingChannelError(
+	fmsg *fundingErrorMsg) bool {
+
+	channel, err := f.fetchPendingChannel(fmsg.peerKey, fmsg.err.ChanID)
+	if err != nil {
+		return false
+	}
+
+	chanPoint := channel.FundingOutpoint
+	peerKey := fmsg.peerKey.SerializeCompressed()
+	switch {
+	case channel.IsInitiator:
+		fndgLog.Warnf("Peer(%x) failed pending ChannelPoint(%v) we "+
+			"initiated: %v", peerKey, chanPoint,
+			string(fmsg.err.Data))
+
+	case channel.LocalCommitment.LocalBalance != 0:
+		fndgLog.Warnf("Peer(%x) failed pending ChannelPoint(%v): %v, "+
+			"waiting for funding tx as we have funds at stake",
+			peerKey, chanPoint, string(fmsg.err.Data))
+
+	default:
+		fndgLog.Infof("Peer(%x) failed pending ChannelPoint(%v): %v, "+
+			"forgetting channel", peerKey, chanPoint,
+			string(fmsg.err.Data))
+
+		if err := closeCanceledChannel(channel); err != nil {
+			fndgLog.Errorf("Failed closing channel %v: %v",
+				chanPoint, err)
+		}
+	}
+
+	return true
+}
+
+// closeCanceledChannel marks the pending channel as closed within the
+// database, recording that its funding flow was canceled.
+func closeCanceledChannel(ch *channeldb.OpenChannel) error {
+	localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChainHash:               ch.ChainHash,
+		ChanPoint:               ch.FundingOutpoint,
+		RemotePub:               ch.IdentityPub,
+		Capacity:                ch.Capacity,
+		SettledBalance:          localBalance,
+		CloseType:               channeldb.FundingCanceled,
+		RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
+		RemoteNextRevocation:    ch.RemoteNextRevocation,
+		LocalChanConfig:         ch.LocalChanCfg,
+	}
+
+	return ch.CloseChannel(closeInfo)
+}
+
 // failFundingFlow will fail the active funding flow with the target peer,
 // identified by its unique temporary channel ID. This method will send an
 // error to the remote peer, and also remove the reservation from our set of
@@ -844,6 +1392,11 @@ func (f *fundingManager) failFundingFlow(peer lnpeer.Peer, tempChanID [32]byte,
 	case lnwallet.ReservationError:
 		msg = lnwire.ErrorData(e.Error())
 
+	// Let the channel acceptor's reason for rejecting the channel be sent
+	// to the remote.
+	case *chanacceptor.RejectError:
+		msg = lnwire.ErrorData(e.Error())
+
 	// Send the status code.
 	case lnwire.ErrorCode:
 		msg = lnwire.ErrorData{byte(e)}
@@ -882,10 +1435,16 @@ func (f *fundingManager) reservationCoordinator() {
 			switch fmsg := msg.(type) {
 			case *fundingOpenMsg:
 				f.handleFundingOpen(fmsg)
+			case *fundingAcceptorMsg:
+				f.handleAcceptorDecision(fmsg)
 			case *fundingAcceptMsg:
 				f.handleFundingAccept(fmsg)
+			case *fundingContributionMsg:
+				f.handleFundingContribution(fmsg)
 			case *fundingCreatedMsg:
 				f.handleFundingCreated(fmsg)
+			case *fundingInputSigsMsg:
+				f.handleFundingInputSigs(fmsg)
 			case *fundingSignedMsg:
 				f.handleFundingSigned(fmsg)
 			case *fundingLockedMsg:
@@ -904,6 +1463,8 @@ func (f *fundingManager) reservationCoordinator() {
 			switch msg := req.(type) {
 			case *pendingChansReq:
 				f.handlePendingChannels(msg)
+			case *cancelPendingChanReq:
+				f.handleCancelPendingChannel(msg)
 			}
 		case <-f.quit:
 			return
@@ -950,9 +1511,9 @@ func (f *fundingManager) processFundingOpen(msg *lnwire.OpenChannel,
 	}
 }
 
-// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
-// then responds to the source peer with an accept channel message progressing
-// the funding workflow.
+// handleFundingOpen checks whether an inbound channel is acceptable to us, and
+// then consults the channel acceptor, whose decision is handled by
+// handleAcceptorDecision.
 //
 // TODO(roasbeef): add error chan to all, let channelManager handle
 // error+propagate
@@ -973,6 +1534,8 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	numPending := len(f.activeReservations[peerIDKey])
 	f.resMtx.RUnlock()
 
+	numPending += f.pendingAcceptances[peerIDKey]
+
 	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
 	if err != nil {
 		f.failFundingFlow(
@@ -1013,8 +1576,8 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	// We'll reject any request to create a channel that's above the
-	// current soft-limit for channel size.
-	if msg.FundingAmount > maxFundingAmount {
+	// maximum channel size we're willing to have with this peer.
+	if msg.FundingAmount > f.maxChanSize(fmsg.peer) {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
@@ -1032,6 +1595,14 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If the initiator committed to an upfront shutdown script, we'll
+	// make sure it's one we're able to cooperatively close the channel
+	// to.
+	if err := validateShutdownScript(msg.UpfrontShutdownScript); err != nil {
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+
 	// If request specifies non-zero push amount and 'rejectpush' is set,
 	// signal an error.
 	if cfg.RejectPush && msg.PushAmount > 0 {
@@ -1041,6 +1612,56 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Finally, we'll ask our channel acceptor, which may be driven by
+	// external business logic, whether the channel should be accepted.
+	// As it may take a while to decide, it's consulted in its own
+	// goroutine, and the funding flow resumes once its decision has been
+	// handed back to the reservationCoordinator.
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node:        peerPubKey,
+		OpenChanMsg: msg,
+	}
+	f.pendingAcceptances[peerIDKey]++
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		params, err := f.cfg.ChannelAcceptor.Accept(chanReq)
+
+		select {
+		case f.fundingMsgs <- &fundingAcceptorMsg{fmsg, params, err}:
+		case <-f.quit:
+		}
+	}()
+}
+
+// handleAcceptorDecision resumes the funding flow of an inbound channel once
+// the channel acceptor has made its decision. If the channel was accepted, a
+// reservation is created within the wallet, and we respond to the source peer
+// with an accept channel message progressing the funding workflow.
+func (f *fundingManager) handleAcceptorDecision(amsg *fundingAcceptorMsg) {
+	fmsg := amsg.fmsg
+	peerPubKey := fmsg.peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	msg := fmsg.msg
+	amt := msg.FundingAmount
+
+	f.pendingAcceptances[peerIDKey]--
+	if f.pendingAcceptances[peerIDKey] <= 0 {
+		delete(f.pendingAcceptances, peerIDKey)
+	}
+
+	acceptorParams, err := amsg.params, amsg.err
+	if err != nil {
+		fndgLog.Infof("Channel acceptor rejected fundingRequest("+
+			"pendingId=%x) from peer(%x): %v", msg.PendingChannelID,
+			peerPubKey.SerializeCompressed(), err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1048,38 +1669,113 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 
 	// Attempt to initialize a reservation within the wallet. If the wallet
 	// has insufficient resources to create the channel, then the
-	// reservation attempt may be rejected. Note that since we're on the
-	// responding side of a single funder workflow, we don't commit any
-	// funds to the channel ourselves.
+	// reservation attempt may be rejected.
 	chainHash := chainhash.Hash(msg.ChainHash)
-	req := &lnwallet.InitFundingReserveMsg{
-		ChainHash:       &chainHash,
-		NodeID:          fmsg.peer.IdentityKey(),
-		NodeAddr:        fmsg.peer.Address(),
-		FundingAmount:   0,
-		Capacity:        amt,
-		CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
-		FundingFeePerKw: 0,
-		PushMSat:        msg.PushAmount,
-		Flags:           msg.ChannelFlags,
-		MinConfs:        1,
-	}
-
-	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
-	if err != nil {
-		fndgLog.Errorf("Unable to initialize reservation: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+	initReservation := func(ourAmt btcutil.Amount,
+		fundingFeePerKw lnwallet.SatPerKWeight) (
+		*lnwallet.ChannelReservation, error) {
+
+		return f.cfg.Wallet.InitChannelReservation(
+			&lnwallet.InitFundingReserveMsg{
+				ChainHash:       &chainHash,
+				NodeID:          fmsg.peer.IdentityKey(),
+				NodeAddr:        fmsg.peer.Address(),
+				FundingAmount:   ourAmt,
+				Capacity:        amt + ourAmt,
+				CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
+				FundingFeePerKw: fundingFeePerKw,
+				PushMSat:        msg.PushAmount,
+				Flags:           msg.ChannelFlags,
+				MinConfs:        1,
+				DualFund:        ourAmt != 0,
+				StaticRemoteKey: staticRemoteKeySupported(fmsg.peer),
+				Anchors:         anchorsSupported(fmsg.peer),
+			},
+		)
 	}
 
-	// As we're the responder, we get to specify the number of
+	// If the initiator invited us to dual fund the channel, we'll try to
+	// contribute funds of our own. Should we be unable to do so, we fall
+	// back to the single funder workflow, in which we don't commit any
+	// funds to the channel ourselves.
+	var (
+		reservation *lnwallet.ChannelReservation
+		ourAmt      = f.dualFundAmt(msg, fmsg.peer)
+	)
+	if ourAmt != 0 {
+		feePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
+		if err == nil {
+			reservation, err = initReservation(ourAmt, feePerKw)
+		}
+		if err != nil {
+			fndgLog.Warnf("Unable to contribute %v to pendingID(%x), "+
+				"falling back to single funding: %v", ourAmt,
+				msg.PendingChannelID, err)
+			ourAmt = 0
+		}
+	}
+	if reservation == nil {
+		reservation, err = initReservation(0, 0)
+		if err != nil {
+			fndgLog.Errorf("Unable to initialize reservation: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+	}
+	capacity := amt + ourAmt
+
+	// As we're the responder, we get to specify the number of
 	// confirmations that we require before both of us consider the channel
 	// open. We'll use out mapping to derive the proper number of
 	// confirmations based on the amount of the channel, and also if any
 	// funds are being pushed to us.
+	//
+	// Channels with trusted peers, or those the channel acceptor decided
+	// to trust, don't require any confirmations at all.
+	if acceptorParams == nil {
+		acceptorParams = &chanacceptor.ChannelParams{}
+	}
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if f.cfg.IsZeroConfPeer(peerPubKey) || acceptorParams.ZeroConf {
+		fndgLog.Infof("Accepting pendingId=%x from peer(%x) as zero-conf "+
+			"channel", msg.PendingChannelID,
+			peerPubKey.SerializeCompressed())
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
+	// The channel acceptor may have chosen the dust limit of our
+	// commitment transaction, and the largest CSV delay we'll accept for
+	// it, which need to be known before committing to the initiator's
+	// constraints.
+	if acceptorParams.DustLimit != 0 {
+		err := reservation.SetOurDustLimit(acceptorParams.DustLimit)
+		if err != nil {
+			fndgLog.Errorf("Unacceptable dust limit: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+	}
+	if acceptorParams.MaxCsvDelay != 0 {
+		reservation.SetMaxCsvDelay(acceptorParams.MaxCsvDelay)
+	}
+
+	// The channel acceptor may also have chosen a script to commit to
+	// paying our funds to upon a cooperative close, which the initiator
+	// must be able to enforce.
+	if len(acceptorParams.UpfrontShutdown) != 0 {
+		err := f.validateUpfrontShutdown(
+			fmsg.peer, acceptorParams.UpfrontShutdown,
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to commit to upfront shutdown "+
+				"script: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+		reservation.SetOurUpfrontShutdown(acceptorParams.UpfrontShutdown)
+	}
+
 	// We'll also validate and apply all the constraints the initiating
 	// party is attempting to dictate for our commitment transaction.
 	err = reservation.CommitConstraints(
@@ -1096,12 +1792,41 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		"amt=%v, push_amt=%v", numConfsReq, fmsg.msg.PendingChannelID,
 		amt, msg.PushAmount)
 
-	// Generate our required constraints for the remote party.
-	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
-	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, msg.DustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(amt)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(amt)
+	// Generate our required constraints for the remote party, using the
+	// ones chosen by the channel acceptor if set.
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	if acceptorParams.CsvDelay != 0 {
+		remoteCsvDelay = acceptorParams.CsvDelay
+	}
+	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, msg.DustLimit)
+	if acceptorParams.ChanReserve != 0 {
+		chanReserve = acceptorParams.ChanReserve
+	}
+	maxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	if acceptorParams.MaxValueInFlight != 0 {
+		maxValue = acceptorParams.MaxValueInFlight
+	}
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	if acceptorParams.MaxAcceptedHTLCs != 0 {
+		maxHtlcs = acceptorParams.MaxAcceptedHTLCs
+	}
 	minHtlc := f.cfg.DefaultRoutingPolicy.MinHTLC
+	if acceptorParams.MinHtlc != 0 {
+		minHtlc = acceptorParams.MinHtlc
+	}
+
+	// Before sending them to the initiator, we'll make sure the
+	// constraints are sound, as it would otherwise reject them.
+	err = f.validateRemoteConstraints(
+		reservation, msg.DustLimit, maxHtlcs, maxValue, minHtlc,
+		chanReserve,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unacceptable channel limits for "+
+			"pendingId(%x): %v", msg.PendingChannelID, err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
 
 	// Once the reservation has been created successfully, we add it to
 	// this peer's map of pending reservations to track this particular
@@ -1111,12 +1836,16 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		f.activeReservations[peerIDKey] = make(pendingChannels)
 	}
 	resCtx := &reservationWithCtx{
-		reservation:    reservation,
-		chanAmt:        amt,
-		remoteCsvDelay: remoteCsvDelay,
-		remoteMinHtlc:  minHtlc,
-		err:            make(chan error, 1),
-		peer:           fmsg.peer,
+		reservation:       reservation,
+		chanAmt:           capacity,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteMaxValue:    maxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		remoteChanReserve: chanReserve,
+		dualFund:          ourAmt != 0,
+		err:               make(chan error, 1),
+		peer:              fmsg.peer,
 	}
 	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
 	f.resMtx.Unlock()
@@ -1129,6 +1858,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	remoteContribution := &lnwallet.ChannelContribution{
 		FundingAmount:        amt,
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1171,28 +1901,338 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-	}
-	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+	}
+
+	// If we contribute funds to the channel, the initiator needs to learn
+	// about our inputs and change before it receives our acceptance.
+	replies := []lnwire.Message{&fundingAccept}
+	if resCtx.dualFund {
+		fndgLog.Infof("Contributing %v to pendingID(%x)", ourAmt,
+			msg.PendingChannelID)
+
+		fundingContribution := newFundingContribution(
+			msg.PendingChannelID, ourAmt, ourContribution,
+		)
+		replies = []lnwire.Message{fundingContribution, &fundingAccept}
+	}
+	if err := fmsg.peer.SendMessage(false, replies...); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
 		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
 		return
 	}
 }
 
+// validateRemoteConstraints verifies the constraints we're about to require
+// the remote party to adhere to. Besides the checks performed by the
+// reservation, the channel reserve must not be below the remote party's dust
+// limit, as the reserve would otherwise be trimmed from our commitment.
+func (f *fundingManager) validateRemoteConstraints(
+	reservation *lnwallet.ChannelReservation, remoteDustLimit btcutil.Amount,
+	maxHtlcs uint16, maxValue, minHtlc lnwire.MilliSatoshi,
+	chanReserve btcutil.Amount) error {
+
+	if chanReserve < remoteDustLimit {
+		return lnwallet.ErrChanReserveTooSmall(
+			chanReserve, remoteDustLimit,
+		)
+	}
+
+	return reservation.ValidateTheirConstraints(
+		maxHtlcs, maxValue, minHtlc, chanReserve,
+	)
+}
+
+// validateUpfrontShutdown verifies that we're able to commit to paying our
+// funds to the passed script upon a cooperative close of a channel with the
+// passed peer. The peer must signal that it enforces upfront shutdown scripts,
+// as the commitment would otherwise be meaningless.
+func (f *fundingManager) validateUpfrontShutdown(peer lnpeer.Peer,
+	script lnwire.DeliveryAddress) error {
+
+	features := peer.RemoteLocalFeatures()
+	if !features.HasFeature(lnwire.UpfrontShutdownScriptOptional) {
+		return fmt.Errorf("peer %x does not support upfront shutdown "+
+			"scripts", peer.IdentityKey().SerializeCompressed())
+	}
+
+	return validateShutdownScript(script)
+}
+
+// staticRemoteKeySupported returns true if the passed peer signals support for
+// the static remote key commitment format. As we always signal support
+// ourselves, the format will then be used for any channel with the peer.
+func staticRemoteKeySupported(peer lnpeer.Peer) bool {
+	features := peer.RemoteLocalFeatures()
+	return features.HasFeature(lnwire.StaticRemoteKeyOptional)
+}
+
+// anchorsSupported returns true if the passed peer signals support for the
+// anchor output commitment format. As we always signal support ourselves, the
+// format will then be used for any channel with the peer.
+func anchorsSupported(peer lnpeer.Peer) bool {
+	features := peer.RemoteLocalFeatures()
+	return features.HasFeature(lnwire.AnchorsOptional)
+}
+
+// validateShutdownScript returns an error if the passed script isn't one of
+// the standard script types a cooperative close may pay to, being p2pkh,
+// p2sh, p2wpkh or p2wsh. An empty script is valid, as it signals that no
+// script was committed to.
+func validateShutdownScript(script lnwire.DeliveryAddress) error {
+	if len(script) == 0 {
+		return nil
+	}
+
+	switch txscript.GetScriptClass(script) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+		return nil
+
+	default:
+		return fmt.Errorf("invalid shutdown script %x", []byte(script))
+	}
+}
+
+// maxChanSize returns the largest channel we're willing to have with the
+// passed peer. Unless the peer signals support for large (wumbo) channels,
+// this is capped at the soft-limit for channel size.
+func (f *fundingManager) maxChanSize(peer lnpeer.Peer) btcutil.Amount {
+	maxSize := f.cfg.MaxChanSize
+	if maxSize > maxFundingAmount &&
+		!peer.RemoteLocalFeatures().HasFeature(lnwire.WumboChannelsOptional) {
+
+		maxSize = maxFundingAmount
+	}
+
+	return maxSize
+}
+
+// dualFundAmt returns the amount we'll contribute to the channel proposed by
+// the passed OpenChannel message. We only contribute if we were invited to
+// dual fund the channel, matching the initiator's amount up to our configured
+// maximum, and without exceeding the maximum channel size with the peer.
+func (f *fundingManager) dualFundAmt(msg *lnwire.OpenChannel,
+	peer lnpeer.Peer) btcutil.Amount {
+
+	if !msg.DualFund {
+		return 0
+	}
+
+	amt := msg.FundingAmount
+	if amt > f.cfg.MaxDualFundAmt {
+		amt = f.cfg.MaxDualFundAmt
+	}
+	maxSize := f.maxChanSize(peer)
+	if msg.FundingAmount+amt > maxSize {
+		amt = maxSize - msg.FundingAmount
+	}
+	if amt < 0 {
+		return 0
+	}
+
+	return amt
+}
+
+// newFundingContribution creates the FundingContribution message presenting
+// the inputs and change outputs of the passed contribution to the remote
+// party of a dual funded channel.
+func newFundingContribution(pendingChanID [32]byte, amt btcutil.Amount,
+	contribution *lnwallet.ChannelContribution) *lnwire.FundingContribution {
+
+	msg := &lnwire.FundingContribution{
+		PendingChannelID: pendingChanID,
+		FundingAmount:    amt,
+	}
+	for i, txIn := range contribution.Inputs {
+		prevOut := contribution.PrevOutputs[i]
+		msg.Inputs = append(msg.Inputs, lnwire.FundingInput{
+			OutPoint: txIn.PreviousOutPoint,
+			Value:    btcutil.Amount(prevOut.Value),
+			PkScript: prevOut.PkScript,
+		})
+	}
+	for _, txOut := range contribution.ChangeOutputs {
+		msg.ChangeOutputs = append(msg.ChangeOutputs, lnwire.FundingOutput{
+			Value:    btcutil.Amount(txOut.Value),
+			PkScript: txOut.PkScript,
+		})
+	}
+
+	return msg
+}
+
+// addRemoteFunding adds the inputs and change outputs the remote party
+// presented in the passed FundingContribution message to its channel
+// contribution. An error is returned if the contribution is unsound, or if it
+// doesn't pay for its inputs and change at the passed fee rate. If
+// payFundingOutput is set, the remote party is the initiator, so it must pay
+// for the funding output as well.
+func addRemoteFunding(msg *lnwire.FundingContribution,
+	feeRate lnwallet.SatPerKWeight, payFundingOutput bool,
+	contribution *lnwallet.ChannelContribution) error {
+
+	if len(msg.Inputs) == 0 {
+		return fmt.Errorf("remote funding contribution has no inputs")
+	}
+
+	var totalIn, totalOut btcutil.Amount
+	for _, input := range msg.Inputs {
+		// As the funding transaction is only signed once the inputs
+		// of both parties are known, its txid must not change due to
+		// the signatures for the inputs, so we only allow native
+		// witness inputs.
+		if !txscript.IsPayToWitnessPubKeyHash(input.PkScript) {
+			return fmt.Errorf("remote funding input %v is not a "+
+				"native witness input", input.OutPoint)
+		}
+		if input.Value <= 0 {
+			return fmt.Errorf("remote funding input %v has invalid "+
+				"value %v", input.OutPoint, input.Value)
+		}
+		totalIn += input.Value
+
+		// The remote party uses the same sequence for its inputs as
+		// we do, so we'll both arrive at the same funding transaction.
+		txIn := wire.NewTxIn(&input.OutPoint, nil, nil)
+		txIn.Sequence = lnwallet.FundingTxInSequence
+		contribution.Inputs = append(contribution.Inputs, txIn)
+		contribution.PrevOutputs = append(
+			contribution.PrevOutputs, &wire.TxOut{
+				Value:    int64(input.Value),
+				PkScript: input.PkScript,
+			},
+		)
+	}
+	for _, output := range msg.ChangeOutputs {
+		if output.Value <= 0 {
+			return fmt.Errorf("remote change output has invalid "+
+				"value %v", output.Value)
+		}
+		totalOut += output.Value
+
+		contribution.ChangeOutputs = append(
+			contribution.ChangeOutputs, &wire.TxOut{
+				Value:    int64(output.Value),
+				PkScript: output.PkScript,
+			},
+		)
+	}
+
+	// The remote party's inputs must pay for its funding amount, its
+	// change, and its share of the funding transaction fee.
+	if totalIn < msg.FundingAmount+totalOut {
+		return fmt.Errorf("remote funding inputs of %v don't cover "+
+			"funding amount of %v and change of %v", totalIn,
+			msg.FundingAmount, totalOut)
+	}
+
+	// Each party pays for the weight of its own inputs and change, while
+	// the initiator also pays for the funding output. We'll estimate the
+	// weight the same way our own coin selection does, assuming P2WKH
+	// change outputs.
+	var weightEstimate lnwallet.TxWeightEstimator
+	for range msg.Inputs {
+		weightEstimate.AddP2WKHInput()
+	}
+	for range msg.ChangeOutputs {
+		weightEstimate.AddP2WKHOutput()
+	}
+	if payFundingOutput {
+		weightEstimate.AddP2WSHOutput()
+	}
+
+	fee := totalIn - msg.FundingAmount - totalOut
+	requiredFee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	if fee < requiredFee {
+		return fmt.Errorf("remote funding fee of %v is below required "+
+			"fee of %v at %v", fee, requiredFee, feeRate)
+	}
+
+	return nil
+}
+
+// minRemoteFundingFeeRate returns the fee rate the remote party's
+// contribution to a dual funded channel must at least pay for. As the remote
+// party estimates the fee rate on its own, we only require half of our own
+// estimate to tolerate estimators that disagree.
+func (f *fundingManager) minRemoteFundingFeeRate() (lnwallet.SatPerKWeight,
+	error) {
+
+	feePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		return 0, err
+	}
+
+	feePerKw /= 2
+	if feePerKw < lnwallet.FeePerKwFloor {
+		feePerKw = lnwallet.FeePerKwFloor
+	}
+
+	return feePerKw, nil
+}
+
+// processFundingContribution sends a message to the fundingManager allowing it
+// to record the funding contribution of the remote party to a dual funded
+// channel.
+func (f *fundingManager) processFundingContribution(
+	msg *lnwire.FundingContribution, peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingContributionMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingContribution records the funding contribution of the remote
+// party to a dual funded channel. The contribution is processed once the next
+// message of the funding workflow arrives.
+func (f *fundingManager) handleFundingContribution(fmsg *fundingContributionMsg) {
+	peerKey := fmsg.peer.IdentityKey()
+	pendingChanID := fmsg.msg.PendingChannelID
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the fundingContributionMsg has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	if !resCtx.dualFund || resCtx.remoteFunding != nil {
+		err := fmt.Errorf("unexpected funding contribution for "+
+			"pendingID(%x)", pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	fndgLog.Infof("Recv'd funding contribution of %v with %v inputs for "+
+		"pendingID(%x)", fmsg.msg.FundingAmount, len(fmsg.msg.Inputs),
+		pendingChanID[:])
+
+	resCtx.remoteFunding = fmsg.msg
+}
+
 // processFundingAccept sends a message to the fundingManager allowing it to
 // continue the second phase of a funding workflow with the target peer.
 func (f *fundingManager) processFundingAccept(msg *lnwire.AcceptChannel,
@@ -1225,6 +2265,29 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 
 	fndgLog.Infof("Recv'd fundingResponse for pendingID(%x)", pendingChanID[:])
 
+	// If the responder committed to an upfront shutdown script, we'll
+	// make sure it's one we're able to cooperatively close the channel
+	// to.
+	if err := validateShutdownScript(msg.UpfrontShutdownScript); err != nil {
+		fndgLog.Warnf("Invalid upfront shutdown script: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// A responder that doesn't require any confirmations wants to use the
+	// channel before its funding transaction confirmed. We only go along
+	// with that if we trust the peer, or if we're the only one funding the
+	// channel, as the funding transaction can't be double spent then.
+	if msg.MinAcceptDepth == 0 && resCtx.remoteFunding != nil &&
+		!f.cfg.IsZeroConfPeer(peerKey) {
+
+		err := fmt.Errorf("zero-conf channel with untrusted peer "+
+			"%x not allowed", peerKey.SerializeCompressed())
+		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -1239,12 +2302,19 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
-	// As they've accepted our channel constraints, we'll regenerate them
-	// here so we can properly commit their accepted constraints to the
-	// reservation.
-	chanReserve := f.cfg.RequiredRemoteChanReserve(resCtx.chanAmt, msg.DustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(resCtx.chanAmt)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(resCtx.chanAmt)
+	// As they've accepted our channel constraints, we'll commit them to
+	// the reservation. The channel reserve we required must not be below
+	// their dust limit, as it would otherwise be trimmed from their
+	// commitment.
+	chanReserve := resCtx.remoteChanReserve
+	maxValue := resCtx.remoteMaxValue
+	maxHtlcs := resCtx.remoteMaxHtlcs
+	if chanReserve < msg.DustLimit {
+		err := lnwallet.ErrChanReserveTooSmall(chanReserve, msg.DustLimit)
+		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
 
 	// The remote node has responded with their portion of the channel
 	// contribution. At this point, we can process their contribution which
@@ -1252,6 +2322,7 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	// the funding transaction.
 	remoteContribution := &lnwallet.ChannelContribution{
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1278,6 +2349,24 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
+
+	// If the remote party contributed funds of its own to the channel,
+	// we'll add them to the reservation along with its inputs and change.
+	// Otherwise, the channel remains single funded, and we won't accept
+	// any contributions from here on.
+	remoteFunding := resCtx.remoteFunding
+	if remoteFunding != nil {
+		err := f.acceptRemoteFunding(resCtx, remoteContribution)
+		if err != nil {
+			fndgLog.Errorf("Unable to accept funding contribution "+
+				"from %v: %v", peerKey, err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+	} else {
+		resCtx.dualFund = false
+	}
+
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
@@ -1328,13 +2417,71 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
 		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
+
+	// For a dual funded channel, the remote party also needs to learn
+	// about our inputs and change in order to construct the funding
+	// transaction itself.
+	replies := []lnwire.Message{fundingCreated}
+	if remoteFunding != nil {
+		ourContribution := resCtx.reservation.OurContribution()
+		fundingContribution := newFundingContribution(
+			pendingChanID, ourContribution.FundingAmount,
+			ourContribution,
+		)
+		replies = []lnwire.Message{fundingContribution, fundingCreated}
+	}
+	if err := fmsg.peer.SendMessage(false, replies...); err != nil {
 		fndgLog.Errorf("Unable to send funding complete message: %v", err)
 		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
 		return
 	}
 }
 
+// acceptRemoteFunding adds the funds the remote party contributed to the
+// channel of the passed reservation, and records its inputs and change within
+// the passed contribution. This is called by the initiator of a dual funded
+// channel.
+func (f *fundingManager) acceptRemoteFunding(resCtx *reservationWithCtx,
+	remoteContribution *lnwallet.ChannelContribution) error {
+
+	remoteFunding := resCtx.remoteFunding
+	if remoteFunding.FundingAmount <= 0 {
+		return fmt.Errorf("invalid remote funding amount: %v",
+			remoteFunding.FundingAmount)
+	}
+	capacity := resCtx.chanAmt + remoteFunding.FundingAmount
+	if capacity > f.maxChanSize(resCtx.peer) {
+		return lnwire.ErrChanTooLarge
+	}
+
+	feeRate, err := f.minRemoteFundingFeeRate()
+	if err != nil {
+		return err
+	}
+	err = addRemoteFunding(
+		remoteFunding, feeRate, false, remoteContribution,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = resCtx.reservation.AcceptRemoteFunding(
+		remoteFunding.FundingAmount,
+	)
+	if err != nil {
+		return err
+	}
+
+	// From now on, the reservation covers the funds of both parties. As
+	// the pending reservations are also queried over RPC, we'll hold the
+	// reservation mutex while doing so.
+	f.resMtx.Lock()
+	resCtx.chanAmt = capacity
+	f.resMtx.Unlock()
+
+	return nil
+}
+
 // processFundingCreated queues a funding complete message coupled with the
 // source peer to the fundingManager.
 func (f *fundingManager) processFundingCreated(msg *lnwire.FundingCreated,
@@ -1377,11 +2524,19 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// CompleteReservationSingle will also mark the channel as 'IsPending'
 	// in the database.
 	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
-	completeChan, err := resCtx.reservation.CompleteReservationSingle(
-		&fundingOut, commitSig)
+	var completeChan *channeldb.OpenChannel
+	if resCtx.dualFund {
+		completeChan, err = f.completeDualFunding(
+			resCtx, &fundingOut, commitSig,
+		)
+	} else {
+		completeChan, err = resCtx.reservation.CompleteReservationSingle(
+			&fundingOut, commitSig,
+		)
+	}
 	if err != nil {
 		// TODO(roasbeef): better error logging: peerID, channelID, etc.
-		fndgLog.Errorf("unable to complete single reservation: %v", err)
+		fndgLog.Errorf("unable to complete reservation: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		return
 	}
@@ -1394,20 +2549,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// we use this convenience method to delete the pending OpenChannel
 	// from the database.
 	deleteFromDatabase := func() {
-		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChanPoint:               completeChan.FundingOutpoint,
-			ChainHash:               completeChan.ChainHash,
-			RemotePub:               completeChan.IdentityPub,
-			CloseType:               channeldb.FundingCanceled,
-			Capacity:                completeChan.Capacity,
-			SettledBalance:          localBalance,
-			RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
-			RemoteNextRevocation:    completeChan.RemoteNextRevocation,
-			LocalChanConfig:         completeChan.LocalChanCfg,
-		}
-
-		if err := completeChan.CloseChannel(closeInfo); err != nil {
+		if err := closeCanceledChannel(completeChan); err != nil {
 			fndgLog.Errorf("Failed closing channel %v: %v",
 				completeChan.FundingOutpoint, err)
 		}
@@ -1441,7 +2583,16 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 		ChanID:    channelID,
 		CommitSig: ourCommitSig,
 	}
-	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
+
+	// If we contributed to the funding transaction, the initiator also
+	// needs our input scripts before it can broadcast it.
+	replies := []lnwire.Message{fundingSigned}
+	if resCtx.dualFund {
+		inputScripts, _ := resCtx.reservation.OurSignatures()
+		fundingInputSigs := newFundingInputSigs(channelID, inputScripts)
+		replies = []lnwire.Message{fundingInputSigs, fundingSigned}
+	}
+	if err := fmsg.peer.SendMessage(false, replies...); err != nil {
 		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		deleteFromDatabase()
@@ -1456,6 +2607,9 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			"arbitration: %v", fundingOut, err)
 	}
 
+	// Inform outside sub-systems that the channel is now pending open.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingOut, completeChan)
+
 	// Create an entry in the local discovery map so we can ensure that we
 	// process the channel confirmation fully before we receive a funding
 	// locked message.
@@ -1479,13 +2633,15 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// completely forget about this channel if we haven't seen the funding
 	// transaction in 288 blocks (~ 48 hrs), by canceling the reservation
 	// and canceling the wait for the funding confirmation.
+	cancelChan := f.registerFundingWait(completeChan.FundingOutpoint)
+
 	f.wg.Add(1)
 	go func() {
 		defer f.wg.Done()
 		confChan := make(chan *lnwire.ShortChannelID)
 		timeoutChan := make(chan struct{})
-		go f.waitForFundingWithTimeout(completeChan, confChan,
-			timeoutChan)
+		go f.waitForFundingWithTimeout(completeChan, cancelChan,
+			confChan, timeoutChan)
 
 		var shortChanID *lnwire.ShortChannelID
 		var ok bool
@@ -1521,7 +2677,210 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 				"confirmation: %v", err)
 			return
 		}
-	}()
+	}()
+}
+
+// completeDualFunding completes the reservation of a dual funded channel we're
+// the responder of. Using the initiator's funding contribution, we construct
+// the funding transaction, sign our inputs to it, and verify the initiator's
+// signature for our version of the commitment transaction.
+func (f *fundingManager) completeDualFunding(resCtx *reservationWithCtx,
+	fundingOut *wire.OutPoint, commitSig []byte) (*channeldb.OpenChannel,
+	error) {
+
+	if resCtx.remoteFunding == nil {
+		return nil, fmt.Errorf("initiator didn't present its funding " +
+			"contribution")
+	}
+
+	// We already recorded the initiator's channel config when processing
+	// its OpenChannel message, so we'll just add its inputs and change.
+	remoteContribution := *resCtx.reservation.TheirContribution()
+	remoteContribution.Inputs = nil
+	remoteContribution.PrevOutputs = nil
+	remoteContribution.ChangeOutputs = nil
+	feeRate, err := f.minRemoteFundingFeeRate()
+	if err != nil {
+		return nil, err
+	}
+	err = addRemoteFunding(
+		resCtx.remoteFunding, feeRate, true, &remoteContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resCtx.reservation.ProcessContribution(&remoteContribution)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both of us should have arrived at the very same funding
+	// transaction.
+	ourFundingOut := resCtx.reservation.FundingOutpoint()
+	if *ourFundingOut != *fundingOut {
+		return nil, fmt.Errorf("funding outpoint mismatch: expected "+
+			"%v, initiator presented %v", ourFundingOut, fundingOut)
+	}
+
+	return resCtx.reservation.CompleteReservation(nil, commitSig)
+}
+
+// processFundingInputSigs sends a message to the fundingManager allowing it to
+// record the responder's input scripts for the funding transaction of a dual
+// funded channel.
+func (f *fundingManager) processFundingInputSigs(msg *lnwire.FundingInputSigs,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingInputSigsMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// newFundingInputSigs creates the FundingInputSigs message presenting our
+// input scripts for the funding transaction of a dual funded channel to the
+// remote party.
+func newFundingInputSigs(chanID lnwire.ChannelID,
+	inputScripts []*lnwallet.InputScript) *lnwire.FundingInputSigs {
+
+	msg := &lnwire.FundingInputSigs{
+		ChanID: chanID,
+	}
+	for _, inputScript := range inputScripts {
+		msg.InputScripts = append(msg.InputScripts, lnwire.InputScript{
+			ScriptSig: inputScript.ScriptSig,
+			Witness:   inputScript.Witness,
+		})
+	}
+
+	return msg
+}
+
+// dualFundingTxSigned returns true if the passed channel is a dual funded
+// channel for which the funding transaction we stored carries the input
+// scripts of both parties.
+func dualFundingTxSigned(channel *channeldb.OpenChannel) bool {
+	if !channel.ChanType.IsDualFunder() || channel.FundingTxn == nil {
+		return false
+	}
+
+	for _, txIn := range channel.FundingTxn.TxIn {
+		if len(txIn.Witness) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// handleFundingInputSigs records the responder's input scripts for the funding
+// transaction of a dual funded channel. They're verified once the responder's
+// FundingSigned message arrives. If we're the responder instead, the message
+// carries the initiator's input scripts, which it sends once it broadcast the
+// funding transaction.
+func (f *fundingManager) handleFundingInputSigs(fmsg *fundingInputSigsMsg) {
+	f.resMtx.RLock()
+	pendingChanID, ok := f.signedReservations[fmsg.msg.ChanID]
+	f.resMtx.RUnlock()
+	if !ok {
+		f.handleInitiatorInputSigs(fmsg)
+		return
+	}
+
+	peerKey := fmsg.peer.IdentityKey()
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Unable to find reservation (peerID:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	if resCtx.remoteFunding == nil || resCtx.remoteInputScripts != nil {
+		err := fmt.Errorf("unexpected funding input scripts for "+
+			"pendingID(%x)", pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	inputScripts := make(
+		[]*lnwallet.InputScript, 0, len(fmsg.msg.InputScripts),
+	)
+	for _, inputScript := range fmsg.msg.InputScripts {
+		inputScripts = append(inputScripts, &lnwallet.InputScript{
+			ScriptSig: inputScript.ScriptSig,
+			Witness:   inputScript.Witness,
+		})
+	}
+	resCtx.remoteInputScripts = inputScripts
+}
+
+// handleInitiatorInputSigs adds the initiator's input scripts to the funding
+// transaction of a dual funded channel for which we're the responder. The
+// fully signed funding transaction is then broadcast and persisted, so we're
+// able to rebroadcast it on startup.
+func (f *fundingManager) handleInitiatorInputSigs(fmsg *fundingInputSigsMsg) {
+	peerKey := fmsg.peer.IdentityKey()
+	channel, err := f.fetchPendingChannel(peerKey, fmsg.msg.ChanID)
+	if err != nil {
+		fndgLog.Warnf("Unable to find pending channel for "+
+			"chan_id=%x: %v", fmsg.msg.ChanID, err)
+		return
+	}
+
+	if channel.IsInitiator || !channel.ChanType.IsDualFunder() ||
+		dualFundingTxSigned(channel) {
+
+		fndgLog.Warnf("Unexpected funding input scripts for "+
+			"ChannelPoint(%v)", channel.FundingOutpoint)
+		return
+	}
+
+	// Our own inputs are already signed, so the initiator's input scripts
+	// belong to the remaining inputs, in the order of the funding
+	// transaction.
+	fundingTx := channel.FundingTxn.Copy()
+	inputScripts := fmsg.msg.InputScripts
+	for _, txIn := range fundingTx.TxIn {
+		if len(txIn.Witness) != 0 {
+			continue
+		}
+		if len(inputScripts) == 0 {
+			fndgLog.Warnf("Missing funding input scripts for "+
+				"ChannelPoint(%v)", channel.FundingOutpoint)
+			return
+		}
+
+		txIn.SignatureScript = inputScripts[0].ScriptSig
+		txIn.Witness = inputScripts[0].Witness
+		inputScripts = inputScripts[1:]
+	}
+	if len(inputScripts) != 0 {
+		fndgLog.Warnf("Received %v excess funding input scripts for "+
+			"ChannelPoint(%v)", len(inputScripts),
+			channel.FundingOutpoint)
+		return
+	}
+
+	// We'll only persist the funding transaction if the backend accepts
+	// it. As the initiator already broadcast it, a double spend error
+	// means that it's known to the network already.
+	err = f.cfg.PublishTransaction(
+		fundingTx, fundingTxLabel(channel.FundingOutpoint),
+	)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		fndgLog.Errorf("Unable to broadcast funding tx for "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+		return
+	}
+
+	if err := channel.UpdateFundingTxn(fundingTx); err != nil {
+		fndgLog.Errorf("Unable to persist funding tx for "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+	}
 }
 
 // processFundingSigned sends a single funding sign complete message along with
@@ -1536,6 +2895,26 @@ func (f *fundingManager) processFundingSigned(msg *lnwire.FundingSigned,
 	}
 }
 
+// fundingTxLabel returns the label we persist for the funding transaction of
+// the channel with the passed channel point.
+func fundingTxLabel(chanPoint wire.OutPoint) *channeldb.TxLabel {
+	return &channeldb.TxLabel{
+		Category: channeldb.TxCategoryChannelFunding,
+		Label:    fmt.Sprintf("Funding of ChannelPoint(%v)", chanPoint),
+	}
+}
+
+// fundingCancelTxLabel returns the label we persist for the transaction
+// double spending the funding inputs of the channel with the passed channel
+// point.
+func fundingCancelTxLabel(chanPoint wire.OutPoint) *channeldb.TxLabel {
+	return &channeldb.TxLabel{
+		Category: channeldb.TxCategoryFundingCancel,
+		Label: fmt.Sprintf("Funding cancel of ChannelPoint(%v)",
+			chanPoint),
+	}
+}
+
 // handleFundingSigned processes the final message received in a single funder
 // workflow. Once this message is processed, the funding transaction is
 // broadcast. Once the funding transaction reaches a sufficient number of
@@ -1579,9 +2958,25 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// The remote peer has responded with a signature for our commitment
 	// transaction. We'll verify the signature for validity, then commit
 	// the state to disk as we can now open the channel.
+	// If the responder contributed to the funding transaction, we'll
+	// also need its input scripts before we can broadcast it.
+	var remoteInputScripts []*lnwallet.InputScript
+	if resCtx.remoteFunding != nil {
+		remoteInputScripts = resCtx.remoteInputScripts
+		numInputs := len(resCtx.remoteFunding.Inputs)
+		if len(remoteInputScripts) != numInputs {
+			err := fmt.Errorf("expected %v funding input scripts "+
+				"from responder, got %v", numInputs,
+				len(remoteInputScripts))
+			fndgLog.Errorf(err.Error())
+			f.failFundingFlow(fmsg.peer, pendingChanID, err)
+			return
+		}
+	}
+
 	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
 	completeChan, err := resCtx.reservation.CompleteReservation(
-		nil, commitSig,
+		remoteInputScripts, commitSig,
 	)
 	if err != nil {
 		fndgLog.Errorf("Unable to complete reservation sign "+
@@ -1599,7 +2994,9 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-	err = f.cfg.PublishTransaction(fundingTx)
+	err = f.cfg.PublishTransaction(
+		fundingTx, fundingTxLabel(completeChan.FundingOutpoint),
+	)
 	if err != nil {
 		fndgLog.Errorf("Unable to broadcast funding tx for "+
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
@@ -1611,6 +3008,18 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// delete from the DB?
 	}
 
+	// If the responder contributed to the funding transaction, we'll
+	// send it our input scripts, so it's able to rebroadcast the funding
+	// transaction as well.
+	if resCtx.remoteFunding != nil {
+		inputScripts, _ := resCtx.reservation.OurSignatures()
+		fundingInputSigs := newFundingInputSigs(permChanID, inputScripts)
+		if err := fmsg.peer.SendMessage(false, fundingInputSigs); err != nil {
+			fndgLog.Errorf("Unable to send funding input scripts "+
+				"for ChannelPoint(%v): %v", fundingPoint, err)
+		}
+	}
+
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
 	// watch for any on-chin actions before the channel has fully
@@ -1620,6 +3029,9 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 			"arbitration: %v", fundingPoint, err)
 	}
 
+	// Inform outside sub-systems that the channel is now pending open.
+	f.cfg.NotifyPendingOpenChannelEvent(*fundingPoint, completeChan)
+
 	fndgLog.Infof("Finalizing pendingID(%x) over ChannelPoint(%v), "+
 		"waiting for channel open on-chain", pendingChanID[:],
 		fundingPoint)
@@ -1645,11 +3057,12 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 
 	// At this point we have broadcast the funding transaction and done all
 	// necessary processing.
+	cancelChan := f.registerFundingWait(completeChan.FundingOutpoint)
+
 	f.wg.Add(1)
 	go func() {
 		defer f.wg.Done()
 		confChan := make(chan *lnwire.ShortChannelID)
-		cancelChan := make(chan struct{})
 
 		// In case the fundingManager is stopped at some point during
 		// the remaining part of the opening process, we must wait for
@@ -1699,33 +3112,57 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		fndgLog.Debugf("FundingLocked for channel with ShortChanID "+
 			"%v sent", shortChanID.ToUint64())
 
-		err = f.addToRouterGraph(completeChan, shortChanID)
-		if err != nil {
-			fndgLog.Errorf("failed adding to router graph: %v", err)
-			return
-		}
-		fndgLog.Debugf("Channel with ShortChanID %v added to "+
-			"router graph", shortChanID.ToUint64())
-
 		// Give the caller a final update notifying them that
 		// the channel is now open.
 		// TODO(roasbeef): only notify after recv of funding locked?
-		upd := &lnrpc.OpenStatusUpdate{
-			Update: &lnrpc.OpenStatusUpdate_ChanOpen{
-				ChanOpen: &lnrpc.ChannelOpenUpdate{
-					ChannelPoint: &lnrpc.ChannelPoint{
-						FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
-							FundingTxidBytes: fundingPoint.Hash[:],
+		notifyChanOpen := func() bool {
+			upd := &lnrpc.OpenStatusUpdate{
+				Update: &lnrpc.OpenStatusUpdate_ChanOpen{
+					ChanOpen: &lnrpc.ChannelOpenUpdate{
+						ChannelPoint: &lnrpc.ChannelPoint{
+							FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+								FundingTxidBytes: fundingPoint.Hash[:],
+							},
+							OutputIndex: fundingPoint.Index,
 						},
-						OutputIndex: fundingPoint.Index,
 					},
 				},
-			},
+			}
+
+			select {
+			case resCtx.updates <- upd:
+				return true
+			case <-f.quit:
+				return false
+			}
 		}
 
-		select {
-		case resCtx.updates <- upd:
-		case <-f.quit:
+		// A zero-conf channel is usable as soon as fundingLocked was
+		// sent, so the caller is notified right away. It's only added
+		// to the router graph once its funding transaction confirmed.
+		zeroConf := shortChanID.IsAlias()
+		if zeroConf {
+			if !notifyChanOpen() {
+				return
+			}
+
+			shortChanID, err = f.waitForRealShortChanID(completeChan)
+			if err != nil {
+				fndgLog.Errorf("failed waiting for real short "+
+					"chan id: %v", err)
+				return
+			}
+		}
+
+		err = f.addToRouterGraph(completeChan, shortChanID)
+		if err != nil {
+			fndgLog.Errorf("failed adding to router graph: %v", err)
+			return
+		}
+		fndgLog.Debugf("Channel with ShortChanID %v added to "+
+			"router graph", shortChanID.ToUint64())
+
+		if !zeroConf && !notifyChanOpen() {
 			return
 		}
 
@@ -1741,11 +3178,12 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 // waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation that
 // will cancel the wait for confirmation if we are not the channel initiator and
 // the maxWaitNumBlocksFundingConf has passed from bestHeight.
-// In the case of timeout, the timeoutChan will be closed. In case of error,
-// confChan will be closed. In case of success, a *lnwire.ShortChannelID will be
-// passed to confChan.
+// In the case of timeout, the timeoutChan will be closed. In case of error, or
+// if the wait is canceled through cancelChan, confChan will be closed. In case
+// of success, a *lnwire.ShortChannelID will be passed to confChan.
 func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenChannel,
-	confChan chan<- *lnwire.ShortChannelID, timeoutChan chan<- struct{}) {
+	cancelChan <-chan struct{}, confChan chan<- *lnwire.ShortChannelID,
+	timeoutChan chan<- struct{}) {
 
 	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
 	if err != nil {
@@ -1758,7 +3196,6 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 	defer epochClient.Cancel()
 
 	waitingConfChan := make(chan *lnwire.ShortChannelID)
-	cancelChan := make(chan struct{})
 
 	// Add this goroutine to wait group so we can be sure that it is
 	// properly stopped before the funding manager can be shut down.
@@ -1788,7 +3225,7 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 
 				// Cancel the waitForFundingConfirmation
 				// goroutine.
-				close(cancelChan)
+				f.stopFundingWait(completeChan.FundingOutpoint)
 
 				// Notify the caller of the timeout.
 				close(timeoutChan)
@@ -1820,6 +3257,37 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 	}
 }
 
+// registerFundingWait registers that we're waiting for the funding
+// transaction of the pending channel with the given channel point to confirm,
+// and returns the channel that's closed once we should stop waiting.
+func (f *fundingManager) registerFundingWait(
+	chanPoint wire.OutPoint) <-chan struct{} {
+
+	f.fundingWaitMtx.Lock()
+	defer f.fundingWaitMtx.Unlock()
+
+	cancelChan, ok := f.fundingWaits[chanPoint]
+	if !ok {
+		cancelChan = make(chan struct{})
+		f.fundingWaits[chanPoint] = cancelChan
+	}
+
+	return cancelChan
+}
+
+// stopFundingWait stops waiting for the funding transaction of the pending
+// channel with the given channel point to confirm. It's a no-op if we aren't
+// waiting for it.
+func (f *fundingManager) stopFundingWait(chanPoint wire.OutPoint) {
+	f.fundingWaitMtx.Lock()
+	defer f.fundingWaitMtx.Unlock()
+
+	if cancelChan, ok := f.fundingWaits[chanPoint]; ok {
+		close(cancelChan)
+		delete(f.fundingWaits, chanPoint)
+	}
+}
+
 // makeFundingScript re-creates the funding script for the funding transaction
 // of the target channel.
 func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
@@ -1839,12 +3307,33 @@ func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
 // function of waitForFundingConfirmation is to wait for blockchain
 // confirmation, and then to notify the other systems that must be notified
 // when a channel has become active for lightning transactions.
-// The wait can be canceled by closing the cancelChan. In case of success,
-// a *lnwire.ShortChannelID will be passed to confChan.
+// The wait can be canceled by closing the cancelChan, which must have been
+// obtained from registerFundingWait. In case of success, a
+// *lnwire.ShortChannelID will be passed to confChan.
+//
+// NOTE: Zero-conf channels don't wait for any confirmation, and are marked as
+// open right away under an alias short channel ID instead.
 func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.OpenChannel,
 	cancelChan <-chan struct{}, confChan chan<- *lnwire.ShortChannelID) {
 
 	defer close(confChan)
+	defer f.stopFundingWait(completeChan.FundingOutpoint)
+
+	if completeChan.IsZeroConf() {
+		alias, err := newAliasShortChanID()
+		if err != nil {
+			fndgLog.Errorf("unable to create alias short chan id "+
+				"for ChannelPoint(%v): %v",
+				completeChan.FundingOutpoint, err)
+			return
+		}
+
+		fndgLog.Infof("Zero-conf ChannelPoint(%v) is now active under "+
+			"alias %v", completeChan.FundingOutpoint, alias)
+
+		f.markChannelOpen(completeChan, alias, confChan)
+		return
+	}
 
 	// Register with the ChainNotifier for a notification once the funding
 	// transaction reaches `numConfs` confirmations.
@@ -1909,6 +3398,19 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 		TxPosition:  uint16(fundingPoint.Index),
 	}
 
+	f.markChannelOpen(completeChan, shortChanID, confChan)
+}
+
+// markChannelOpen marks the channel as open under the given short channel ID
+// within the database, and notifies the rest of the daemon about it. Once
+// done, the short channel ID is passed to confChan.
+func (f *fundingManager) markChannelOpen(completeChan *channeldb.OpenChannel,
+	shortChanID lnwire.ShortChannelID,
+	confChan chan<- *lnwire.ShortChannelID) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
 	// Now that the channel has been fully confirmed, we'll mark it as open
 	// within the database.
 	if err := completeChan.MarkAsOpen(shortChanID); err != nil {
@@ -1917,6 +3419,9 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 		return
 	}
 
+	// Inform outside sub-systems that the channel is now open.
+	f.cfg.NotifyOpenChannelEvent(completeChan)
+
 	// TODO(roasbeef): ideally persistent state update for chan above
 	// should be abstracted
 
@@ -1928,7 +3433,7 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	// TODO(halseth): make the two db transactions (MarkChannelAsOpen and
 	// saveChannelOpeningState) atomic by doing them in the same transaction.
 	// Needed to be properly fault-tolerant.
-	err = f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
+	err := f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
 		&shortChanID)
 	if err != nil {
 		fndgLog.Errorf("error setting channel state to markedOpen: %v",
@@ -1961,10 +3466,158 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	f.localDiscoveryMtx.Unlock()
 }
 
+// newAliasShortChanID returns a random short channel ID from the range
+// reserved for aliases, which zero-conf channels are known by until their
+// funding transaction has confirmed.
+func newAliasShortChanID() (lnwire.ShortChannelID, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	aliasRange := lnwire.AliasEndBlockHeight - lnwire.AliasStartBlockHeight
+	height := binary.BigEndian.Uint32(b[:4]) % aliasRange
+
+	return lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight + height,
+		TxIndex:     binary.BigEndian.Uint32(b[4:]) & 0xffffff,
+		TxPosition:  binary.BigEndian.Uint16(b[6:]),
+	}, nil
+}
+
+// waitForRealShortChanID waits for the funding transaction of a zero-conf
+// channel to confirm. Each time it does, the real short channel ID is stored
+// alongside the channel's alias and reported to the switch, so the channel
+// can also be used under it. Should the funding transaction be reorged out,
+// the real short channel ID is cleared again until it re-confirms. Once the
+// funding transaction reached zeroConfSafeDepth confirmations, the final real
+// short channel ID is returned.
+func (f *fundingManager) waitForRealShortChanID(
+	completeChan *channeldb.OpenChannel) (*lnwire.ShortChannelID, error) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	txid := fundingPoint.Hash
+	fundingScript, err := makeFundingScript(completeChan)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create funding script for "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	// We'll track the first confirmation to learn the real short channel
+	// ID as soon as possible, and a deeper one after which we no longer
+	// expect it to change.
+	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, fundingScript, 1, completeChan.FundingBroadcastHeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for confirmation "+
+			"of ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	safeConfNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, fundingScript, zeroConfSafeDepth,
+		completeChan.FundingBroadcastHeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for confirmation "+
+			"of ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	fndgLog.Infof("Waiting for funding tx (%v) of zero-conf channel to "+
+		"reach %v confirmations", txid, zeroConfSafeDepth)
+
+	for {
+		var realScid lnwire.ShortChannelID
+		select {
+		case confDetails, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return nil, ErrFundingManagerShuttingDown
+			}
+			realScid = lnwire.ShortChannelID{
+				BlockHeight: confDetails.BlockHeight,
+				TxIndex:     confDetails.TxIndex,
+				TxPosition:  uint16(fundingPoint.Index),
+			}
+
+			fndgLog.Infof("Funding tx of zero-conf ChannelPoint(%v) "+
+				"confirmed, short chan id is %v", fundingPoint,
+				realScid)
+
+		case depth, ok := <-confNtfn.NegativeConf:
+			if !ok {
+				return nil, ErrFundingManagerShuttingDown
+			}
+
+			fndgLog.Warnf("Funding tx of zero-conf ChannelPoint(%v) "+
+				"reorged out by %v blocks, waiting for it to "+
+				"confirm again", fundingPoint, depth)
+
+		case <-safeConfNtfn.NegativeConf:
+			continue
+
+		case confDetails, ok := <-safeConfNtfn.Confirmed:
+			if !ok {
+				return nil, ErrFundingManagerShuttingDown
+			}
+			realScid = lnwire.ShortChannelID{
+				BlockHeight: confDetails.BlockHeight,
+				TxIndex:     confDetails.TxIndex,
+				TxPosition:  uint16(fundingPoint.Index),
+			}
+
+			err := f.reportRealShortChanID(completeChan, realScid)
+			if err != nil {
+				return nil, err
+			}
+
+			fndgLog.Infof("Funding tx of zero-conf ChannelPoint(%v) "+
+				"reached %v confirmations", fundingPoint,
+				zeroConfSafeDepth)
+
+			return &realScid, nil
+
+		case <-f.quit:
+			return nil, ErrFundingManagerShuttingDown
+		}
+
+		// Either the funding transaction confirmed, or it was reorged
+		// out and the real short channel ID must be cleared.
+		err := f.reportRealShortChanID(completeChan, realScid)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// reportRealShortChanID stores the real short channel ID of a zero-conf
+// channel, and instructs the switch to pick it up.
+func (f *fundingManager) reportRealShortChanID(
+	completeChan *channeldb.OpenChannel,
+	realScid lnwire.ShortChannelID) error {
+
+	if completeChan.RealShortChanID() == realScid {
+		return nil
+	}
+
+	if err := completeChan.MarkRealShortChanID(realScid); err != nil {
+		return fmt.Errorf("unable to store real short chan id: %v",
+			err)
+	}
+
+	err := f.cfg.ReportShortChanID(completeChan.FundingOutpoint)
+	if err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	return nil
+}
+
 // handleFundingConfirmation is a wrapper method for creating a new
 // lnwallet.LightningChannel object, calling sendFundingLocked,
 // addToRouterGraph, and annAfterSixConfs. This is called after the funding
-// transaction is confirmed.
+// transaction is confirmed, or right away for zero-conf channels, in which
+// case the channel is only added to the router graph once its funding
+// transaction confirmed.
 func (f *fundingManager) handleFundingConfirmation(peer lnpeer.Peer,
 	completeChan *channeldb.OpenChannel,
 	shortChanID *lnwire.ShortChannelID) error {
@@ -1986,6 +3639,12 @@ func (f *fundingManager) handleFundingConfirmation(peer lnpeer.Peer,
 	if err != nil {
 		return fmt.Errorf("failed sending fundingLocked: %v", err)
 	}
+	if shortChanID.IsAlias() {
+		shortChanID, err = f.waitForRealShortChanID(completeChan)
+		if err != nil {
+			return err
+		}
+	}
 	err = f.addToRouterGraph(completeChan, shortChanID)
 	if err != nil {
 		return fmt.Errorf("failed adding to router graph: %v", err)
@@ -2019,6 +3678,13 @@ func (f *fundingManager) sendFundingLocked(peer lnpeer.Peer,
 	}
 	fundingLockedMsg := lnwire.NewFundingLocked(chanID, nextRevocation)
 
+	// Zero-conf channels are known by an alias until their funding
+	// transaction has confirmed, which we'll share with the peer so it
+	// forwards HTLCs addressed to it over the channel.
+	if shortChanID.IsAlias() {
+		fundingLockedMsg.AliasScid = *shortChanID
+	}
+
 	// If the peer has disconnected before we reach this point, we will need
 	// to wait for him to come back online before sending the fundingLocked
 	// message. This is special for fundingLocked, since failing to send any
@@ -2093,7 +3759,7 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 		f.cfg.IDKey, completeChan.IdentityPub,
 		completeChan.LocalChanCfg.MultiSigKey.PubKey,
 		completeChan.RemoteChanCfg.MultiSigKey.PubKey, *shortChanID,
-		chanID, fwdMinHTLC,
+		chanID, completeChan.Capacity, fwdMinHTLC,
 	)
 	if err != nil {
 		return fmt.Errorf("error generating channel "+
@@ -2264,7 +3930,7 @@ func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 			f.cfg.IDKey, completeChan.IdentityPub,
 			completeChan.LocalChanCfg.MultiSigKey.PubKey,
 			completeChan.RemoteChanCfg.MultiSigKey.PubKey,
-			*shortChanID, chanID, fwdMinHTLC,
+			*shortChanID, chanID, completeChan.Capacity, fwdMinHTLC,
 		)
 		if err != nil {
 			return fmt.Errorf("channel announcement failed: %v", err)
@@ -2381,6 +4047,20 @@ func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
 		return
 	}
 
+	// If this is a zero-conf channel, the peer will have told us the alias
+	// it knows the channel by, which we'll need to store before the link
+	// is created, so HTLCs addressed to it can be forwarded.
+	peerAlias := fmsg.msg.AliasScid
+	if channel.IsZeroConf() && peerAlias.IsAlias() {
+		if err := channel.MarkPeerAlias(peerAlias); err != nil {
+			fndgLog.Errorf("unable to store peer alias: %v", err)
+			return
+		}
+
+		fndgLog.Debugf("Peer knows zero-conf ChannelID(%v) by alias %v",
+			chanID, peerAlias)
+	}
+
 	// Launch a defer so we _ensure_ that the channel barrier is properly
 	// closed even if the target peer is not longer online at this point.
 	defer func() {
@@ -2431,6 +4111,7 @@ type chanAnnouncement struct {
 func (f *fundingManager) newChanAnnouncement(localPubKey, remotePubKey,
 	localFundingKey, remoteFundingKey *btcec.PublicKey,
 	shortChanID lnwire.ShortChannelID, chanID lnwire.ChannelID,
+	capacity btcutil.Amount,
 	fwdMinHTLC lnwire.MilliSatoshi) (*chanAnnouncement, error) {
 
 	chainHash := *f.cfg.Wallet.Cfg.NetParams.GenesisHash
@@ -2444,6 +4125,12 @@ func (f *fundingManager) newChanAnnouncement(localPubKey, remotePubKey,
 		ChainHash:      chainHash,
 	}
 
+	// Channels above the soft-limit for channel size are marked as such,
+	// as nodes only accept them into their graph if they are.
+	if capacity > maxFundingAmount {
+		chanAnn.Features.Set(lnwire.WumboChannelsOptional)
+	}
+
 	// The chanFlags field indicates which directed edge of the channel is
 	// being updated within the ChannelUpdateAnnouncement announcement
 	// below. A value of zero means it's the edge of the "first" node and 1
@@ -2567,7 +4254,8 @@ func (f *fundingManager) newChanAnnouncement(localPubKey, remotePubKey,
 // finish, either successfully or with an error.
 func (f *fundingManager) announceChannel(localIDKey, remoteIDKey, localFundingKey,
 	remoteFundingKey *btcec.PublicKey, shortChanID lnwire.ShortChannelID,
-	chanID lnwire.ChannelID, fwdMinHTLC lnwire.MilliSatoshi) error {
+	chanID lnwire.ChannelID, capacity btcutil.Amount,
+	fwdMinHTLC lnwire.MilliSatoshi) error {
 
 	// First, we'll create the batch of announcements to be sent upon
 	// initial channel creation. This includes the channel announcement
@@ -2575,7 +4263,7 @@ func (f *fundingManager) announceChannel(localIDKey, remoteIDKey, localFundingKe
 	// proof needed to fully authenticate the channel.
 	ann, err := f.newChanAnnouncement(localIDKey, remoteIDKey,
 		localFundingKey, remoteFundingKey, shortChanID, chanID,
-		fwdMinHTLC,
+		capacity, fwdMinHTLC,
 	)
 	if err != nil {
 		fndgLog.Errorf("can't generate channel announcement: %v", err)
@@ -2674,6 +4362,15 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		localAmt, msg.pushAmt, capacity, msg.chainHash,
 		peerKey.SerializeCompressed(), ourDustLimit, msg.minConfs)
 
+	// We'll only open channels above the soft-limit for channel size with
+	// peers that signal support for such large channels.
+	if maxSize := f.maxChanSize(msg.peer); capacity > maxSize {
+		msg.err <- fmt.Errorf("channel capacity of %v exceeds the "+
+			"maximum channel size of %v with peer %x", capacity,
+			maxSize, peerKey.SerializeCompressed())
+		return
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -2706,6 +4403,9 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		DualFund:        msg.dualFund,
+		StaticRemoteKey: staticRemoteKeySupported(msg.peer),
+		Anchors:         anchorsSupported(msg.peer),
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2733,6 +4433,61 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		minHtlc = f.cfg.DefaultRoutingPolicy.MinHTLC
 	}
 
+	// If a dust limit for our commitment transaction was specified, we'll
+	// apply it to the reservation, and also base the channel reserve we
+	// require on it.
+	if msg.dustLimit != 0 {
+		if err := reservation.SetOurDustLimit(msg.dustLimit); err != nil {
+			f.cancelInitFunding(reservation, msg.err, err)
+			return
+		}
+		ourDustLimit = msg.dustLimit
+	}
+	if msg.maxRemoteCsvDelay != 0 {
+		reservation.SetMaxCsvDelay(msg.maxRemoteCsvDelay)
+	}
+
+	// If a close address was specified, we'll commit to paying our funds
+	// to it upon a cooperative close, which the remote party must be able
+	// to enforce.
+	if len(msg.shutdownScript) != 0 {
+		err := f.validateUpfrontShutdown(msg.peer, msg.shutdownScript)
+		if err != nil {
+			f.cancelInitFunding(reservation, msg.err, err)
+			return
+		}
+		reservation.SetOurUpfrontShutdown(msg.shutdownScript)
+	}
+
+	// Finally, we'll use the current value of the channels and our default
+	// policy to determine of required commitment constraints for the
+	// remote party, unless they were specified in the open channel
+	// request.
+	chanReserve := msg.remoteChanReserve
+	if chanReserve == 0 {
+		chanReserve = f.cfg.RequiredRemoteChanReserve(
+			capacity, ourDustLimit,
+		)
+	}
+	maxValue := msg.maxValueInFlight
+	if maxValue == 0 {
+		maxValue = f.cfg.RequiredRemoteMaxValue(capacity)
+	}
+	maxHtlcs := msg.maxHtlcs
+	if maxHtlcs == 0 {
+		maxHtlcs = f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	}
+
+	// We'll make sure the constraints are sound before proposing them, as
+	// the remote party would otherwise reject the channel.
+	err = reservation.ValidateTheirConstraints(
+		maxHtlcs, maxValue, minHtlc, chanReserve,
+	)
+	if err != nil {
+		f.cancelInitFunding(reservation, msg.err, err)
+		return
+	}
+
 	// If a pending channel map for this peer isn't already created, then
 	// we create one, ultimately allowing us to track this pending
 	// reservation within the target peer.
@@ -2743,13 +4498,17 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 
 	resCtx := &reservationWithCtx{
-		chanAmt:        capacity,
-		remoteCsvDelay: remoteCsvDelay,
-		remoteMinHtlc:  minHtlc,
-		reservation:    reservation,
-		peer:           msg.peer,
-		updates:        msg.updates,
-		err:            msg.err,
+		chanAmt:           capacity,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteMaxValue:    maxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		remoteChanReserve: chanReserve,
+		dualFund:          msg.dualFund,
+		reservation:       reservation,
+		peer:              msg.peer,
+		updates:           msg.updates,
+		err:               msg.err,
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
@@ -2761,35 +4520,30 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	// request to the remote peer, kicking off the funding workflow.
 	ourContribution := reservation.OurContribution()
 
-	// Finally, we'll use the current value of the channels and our default
-	// policy to determine of required commitment constraints for the
-	// remote party.
-	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, ourDustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(capacity)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
-
 	fndgLog.Infof("Starting funding workflow with %v for pendingID(%x)",
 		msg.peer.Address(), chanID)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloWeight:     uint32(commitFeePerKw),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloWeight:      uint32(commitFeePerKw),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		DualFund:              msg.dualFund,
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
@@ -2807,6 +4561,22 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 }
 
+// cancelInitFunding cancels a reservation created for an outbound channel
+// before the funding workflow was started, and reports the passed error to
+// the caller.
+func (f *fundingManager) cancelInitFunding(
+	reservation *lnwallet.ChannelReservation, errChan chan error,
+	err error) {
+
+	fndgLog.Errorf("Unable to initiate funding workflow: %v", err)
+
+	if err := reservation.Cancel(); err != nil {
+		fndgLog.Errorf("unable to cancel reservation: %v", err)
+	}
+
+	errChan <- err
+}
+
 // waitUntilChannelOpen is designed to prevent other lnd subsystems from
 // sending new update messages to a channel before the channel is fully
 // opened.
@@ -2860,6 +4630,12 @@ func (f *fundingManager) handleErrorMsg(fmsg *fundingErrorMsg) {
 	// exit early as this was an unwarranted error.
 	resCtx, err := f.cancelReservationCtx(fmsg.peerKey, chanID)
 	if err != nil {
+		// The error may also be for a pending channel whose funding
+		// transaction was already published.
+		if f.handlePendingChannelError(fmsg) {
+			return
+		}
+
 		fndgLog.Warnf("Received error for non-existent funding "+
 			"flow: %v (%v)", err, spew.Sdump(protocolErr))
 		return
@@ -3104,3 +4880,79 @@ func (f *fundingManager) deleteChannelOpeningState(chanPoint *wire.OutPoint) err
 		return bucket.Delete(outpointBytes.Bytes())
 	})
 }
+
+// saveFundingCancelTx persists the transaction double spending the funding
+// inputs of the pending channel with the given chanPoint.
+func (f *fundingManager) saveFundingCancelTx(chanPoint *wire.OutPoint,
+	cancelTx *wire.MsgTx) error {
+
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(fundingCancelBucket)
+		if err != nil {
+			return err
+		}
+
+		var outpointBytes bytes.Buffer
+		if err := writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		var txBytes bytes.Buffer
+		if err := cancelTx.Serialize(&txBytes); err != nil {
+			return err
+		}
+
+		return bucket.Put(outpointBytes.Bytes(), txBytes.Bytes())
+	})
+}
+
+// fetchFundingCancelTx fetches the transaction double spending the funding
+// inputs of the pending channel with the given chanPoint. If the channel
+// wasn't canceled, nil is returned.
+func (f *fundingManager) fetchFundingCancelTx(chanPoint *wire.OutPoint) (
+	*wire.MsgTx, error) {
+
+	var cancelTx *wire.MsgTx
+	err := f.cfg.Wallet.Cfg.Database.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(fundingCancelBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		var outpointBytes bytes.Buffer
+		if err := writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		txBytes := bucket.Get(outpointBytes.Bytes())
+		if txBytes == nil {
+			return nil
+		}
+
+		cancelTx = &wire.MsgTx{}
+		return cancelTx.Deserialize(bytes.NewReader(txBytes))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelTx, nil
+}
+
+// deleteFundingCancelTx removes the transaction double spending the funding
+// inputs of the pending channel with the given chanPoint from the database.
+func (f *fundingManager) deleteFundingCancelTx(chanPoint *wire.OutPoint) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(fundingCancelBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		var outpointBytes bytes.Buffer
+		if err := writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		return bucket.Delete(outpointBytes.Bytes())
+	})
+}
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -11,16 +12,20 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -95,11 +100,48 @@ type mockNotifier struct {
 	oneConfChannel chan *chainntnfs.TxConfirmation
 	sixConfChannel chan *chainntnfs.TxConfirmation
 	epochChan      chan *chainntnfs.BlockEpoch
+
+	// txConfChannels holds the confirmation channels of transactions
+	// whose confirmations are dispatched separately from the ones above.
+	txConfMtx      sync.Mutex
+	txConfChannels map[chainhash.Hash]chan *chainntnfs.TxConfirmation
+}
+
+// txConfChannel returns the channel confirmations of the given transaction
+// registered from now on are dispatched on.
+func (m *mockNotifier) txConfChannel(
+	txid chainhash.Hash) chan *chainntnfs.TxConfirmation {
+
+	m.txConfMtx.Lock()
+	defer m.txConfMtx.Unlock()
+
+	if m.txConfChannels == nil {
+		m.txConfChannels = make(
+			map[chainhash.Hash]chan *chainntnfs.TxConfirmation,
+		)
+	}
+
+	confChan, ok := m.txConfChannels[txid]
+	if !ok {
+		confChan = make(chan *chainntnfs.TxConfirmation)
+		m.txConfChannels[txid] = confChan
+	}
+
+	return confChan
 }
 
 func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	m.txConfMtx.Lock()
+	confChan, ok := m.txConfChannels[*txid]
+	m.txConfMtx.Unlock()
+	if ok {
+		return &chainntnfs.ConfirmationEvent{
+			Confirmed: confChan,
+		}, nil
+	}
+
 	if numConfs == 6 {
 		return &chainntnfs.ConfirmationEvent{
 			Confirmed: m.sixConfChannel,
@@ -145,6 +187,7 @@ type testNode struct {
 	mockNotifier    *mockNotifier
 	testDir         string
 	shutdownChannel chan struct{}
+	localFeatures   *lnwire.RawFeatureVector
 
 	remotePeer  *testNode
 	sendMessage func(lnwire.Message) error
@@ -164,14 +207,23 @@ func (n *testNode) PubKey() [33]byte {
 	return newSerializedKey(n.addr.IdentityKey)
 }
 
-func (n *testNode) SendMessage(_ bool, msg ...lnwire.Message) error {
-	return n.sendMessage(msg[0])
+func (n *testNode) SendMessage(_ bool, msgs ...lnwire.Message) error {
+	for _, msg := range msgs {
+		if err := n.sendMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (n *testNode) WipeChannel(_ *wire.OutPoint) error {
 	return nil
 }
 
+func (n *testNode) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(n.localFeatures, lnwire.LocalFeatures)
+}
+
 func (n *testNode) QuitSignal() <-chan struct{} {
 	return n.shutdownChannel
 }
@@ -245,10 +297,10 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	shutdownChan := make(chan struct{})
 
 	wc := &mockWalletController{
-		rootKey: alicePrivKey,
+		rootKey: privKey,
 	}
 	signer := &mockSigner{
-		key: alicePrivKey,
+		key: privKey,
 	}
 	bio := &mockChainIO{}
 
@@ -259,7 +311,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	}
 
 	keyRing := &mockSecretKeyRing{
-		rootKey: alicePrivKey,
+		rootKey: privKey,
 	}
 
 	lnw, err := createTestWallet(
@@ -345,12 +397,23 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		NotifyPendingOpenChannelEvent: func(wire.OutPoint,
+			*channeldb.OpenChannel) {
+		},
+		NotifyOpenChannelEvent: func(*channeldb.OpenChannel) {},
+		PublishTransaction: func(txn *wire.MsgTx,
+			_ *channeldb.TxLabel) error {
+
 			publTxChan <- txn
 			return nil
 		},
 		ZombieSweeperInterval: 1 * time.Hour,
+		MaxChanSize:           maxFundingAmount,
 		ReservationTimeout:    1 * time.Nanosecond,
+		ChannelAcceptor:       chanacceptor.NewChainedAcceptor(),
+		IsZeroConfPeer: func(*btcec.PublicKey) bool {
+			return false
+		},
 	})
 	if err != nil {
 		t.Fatalf("failed creating fundingManager: %v", err)
@@ -369,6 +432,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		mockNotifier:    chainNotifier,
 		testDir:         tempTestDir,
 		shutdownChannel: shutdownChan,
+		localFeatures:   lnwire.NewRawFeatureVector(),
 		addr:            addr,
 	}
 
@@ -430,12 +494,19 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			FeeRate:       1000,
 			TimeLockDelta: 10,
 		},
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		PublishTransaction: func(txn *wire.MsgTx,
+			_ *channeldb.TxLabel) error {
+
 			publishChan <- txn
 			return nil
 		},
-		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
-		ReservationTimeout:    oldCfg.ReservationTimeout,
+		NotifyPendingOpenChannelEvent: oldCfg.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        oldCfg.NotifyOpenChannelEvent,
+		ZombieSweeperInterval:         oldCfg.ZombieSweeperInterval,
+		ReservationTimeout:            oldCfg.ReservationTimeout,
+		MaxChanSize:                   oldCfg.MaxChanSize,
+		ChannelAcceptor:               oldCfg.ChannelAcceptor,
+		IsZeroConfPeer:                oldCfg.IsZeroConfPeer,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -670,10 +741,16 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
+	case "FundingContribution":
+		sentMsg, ok = msg.(*lnwire.FundingContribution)
 	case "FundingCreated":
 		sentMsg, ok = msg.(*lnwire.FundingCreated)
+	case "FundingInputSigs":
+		sentMsg, ok = msg.(*lnwire.FundingInputSigs)
 	case "FundingSigned":
 		sentMsg, ok = msg.(*lnwire.FundingSigned)
 	case "FundingLocked":
@@ -2478,17 +2555,27 @@ func TestFundingManagerMaxPendingChannels(t *testing.T) {
 	).(*lnwire.AcceptChannel)
 }
 
-// TestFundingManagerRejectPush checks behaviour of 'rejectpush'
-// option, namely that non-zero incoming push amounts are disabled.
-func TestFundingManagerRejectPush(t *testing.T) {
-	// Enable 'rejectpush' option and initialize funding managers.
+// rejectingAcceptor is a ChannelAcceptor that rejects all channels with a
+// fixed reason.
+type rejectingAcceptor struct {
+	reason string
+}
+
+func (r *rejectingAcceptor) Accept(req *chanacceptor.ChannelAcceptRequest) (
+	*chanacceptor.ChannelParams, error) {
+
+	return nil, &chanacceptor.RejectError{Reason: r.reason}
+}
+
+// TestFundingManagerChannelAcceptor checks that a channel rejected by the
+// channel acceptor is failed, and that the reason for the rejection is sent
+// to the peer.
+func TestFundingManagerChannelAcceptor(t *testing.T) {
 	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
-	rejectPush := cfg.RejectPush
-	cfg.RejectPush = true
-	defer func() {
-		tearDownFundingManagers(t, alice, bob)
-		cfg.RejectPush = rejectPush
-	}()
+	defer tearDownFundingManagers(t, alice, bob)
+
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(&rejectingAcceptor{reason: "no kyc"})
 
 	// Create a funding request and start the workflow.
 	updateChan := make(chan *lnrpc.OpenStatusUpdate)
@@ -2497,7 +2584,7 @@ func TestFundingManagerRejectPush(t *testing.T) {
 		targetPubkey:    bob.privKey.PubKey(),
 		chainHash:       *activeNetParams.GenesisHash,
 		localFundingAmt: 500000,
-		pushAmt:         lnwire.NewMSatFromSatoshis(10),
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
 		private:         true,
 		updates:         updateChan,
 		err:             errChan,
@@ -2517,23 +2604,1420 @@ func TestFundingManagerRejectPush(t *testing.T) {
 
 	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
 	if !ok {
-		errorMsg, gotError := aliceMsg.(*lnwire.Error)
-		if gotError {
-			t.Fatalf("expected OpenChannel to be sent "+
-				"from bob, instead got error: %v",
-				lnwire.ErrorCode(errorMsg.Data[0]))
-		}
-		t.Fatalf("expected OpenChannel to be sent from "+
-			"alice, instead got %T", aliceMsg)
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
 	}
 
 	// Let Bob handle the init message.
 	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
 
-	// Assert Bob responded with an ErrNonZeroPushAmount error.
+	// Bob should respond with the acceptor's reason for the rejection.
 	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
-	if "Non-zero push amounts are disabled" != string(err.Data) {
-		t.Fatalf("expected ErrNonZeroPushAmount error, got \"%v\"",
+	if string(err.Data) != "no kyc" {
+		t.Fatalf("expected rejection reason to be sent, got \"%v\"",
 			string(err.Data))
 	}
+
+	// Bob shouldn't have any reservation for the channel.
+	assertNumPendingReservations(t, bob, alice.privKey.PubKey(), 0)
+}
+
+// paramsAcceptor is a ChannelAcceptor that accepts all channels with fixed
+// channel params.
+type paramsAcceptor struct {
+	params *chanacceptor.ChannelParams
+}
+
+func (p *paramsAcceptor) Accept(req *chanacceptor.ChannelAcceptRequest) (
+	*chanacceptor.ChannelParams, error) {
+
+	return p.params, nil
+}
+
+// blockingAcceptor is a ChannelAcceptor that accepts all channels, but only
+// once it has been released.
+type blockingAcceptor struct {
+	requests chan struct{}
+	release  chan struct{}
+}
+
+func (b *blockingAcceptor) Accept(req *chanacceptor.ChannelAcceptRequest) (
+	*chanacceptor.ChannelParams, error) {
+
+	b.requests <- struct{}{}
+	<-b.release
+
+	return nil, nil
+}
+
+// TestFundingManagerSlowChannelAcceptor checks that the funding manager keeps
+// serving other requests while the channel acceptor is deciding on an inbound
+// channel, and resumes the funding flow once it has decided.
+func TestFundingManagerSlowChannelAcceptor(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	acceptor := &blockingAcceptor{
+		requests: make(chan struct{}, 1),
+		release:  make(chan struct{}),
+	}
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(acceptor)
+
+	// Create a funding request and start the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	// Let Bob handle the init message, and wait for his channel acceptor
+	// to be consulted.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	select {
+	case <-acceptor.requests:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel acceptor not consulted")
+	}
+
+	// While the acceptor is making up its mind, Bob's funding manager
+	// should still be able to serve queries.
+	done := make(chan error, 1)
+	go func() {
+		_, err := bob.fundingMgr.PendingChannels()
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unable to query pending channels: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		close(acceptor.release)
+		t.Fatalf("funding manager blocked by channel acceptor")
+	}
+
+	// Bob shouldn't have created a reservation before the acceptor
+	// decided.
+	assertNumPendingReservations(t, bob, alice.privKey.PubKey(), 0)
+
+	// Once the acceptor accepts the channel, Bob should respond with
+	// AcceptChannel.
+	close(acceptor.release)
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+	assertNumPendingReservations(t, bob, alice.privKey.PubKey(), 1)
+}
+
+// TestFundingManagerChannelLimits checks that the channel limits set in the
+// open channel request, and those returned by the channel acceptor, are
+// negotiated with the remote party, and committed to both reservations.
+func TestFundingManagerChannelLimits(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob's channel acceptor will accept the channel with custom limits.
+	bobParams := &chanacceptor.ChannelParams{
+		MaxValueInFlight: 2000000000,
+		MaxAcceptedHTLCs: 50,
+		ChanReserve:      70000,
+		DustLimit:        2000,
+		MinHtlc:          1000,
+		CsvDelay:         144,
+	}
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(&paramsAcceptor{params: bobParams})
+
+	// Create a funding request with Alice's custom limits and start the
+	// workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:      bob.privKey.PubKey(),
+		chainHash:         *activeNetParams.GenesisHash,
+		localFundingAmt:   5000000,
+		pushAmt:           lnwire.NewMSatFromSatoshis(0),
+		private:           true,
+		maxValueInFlight:  1000000000,
+		maxHtlcs:          100,
+		remoteChanReserve: 60000,
+		dustLimit:         1000,
+		updates:           updateChan,
+		err:               errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob, holding her
+	// custom limits.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	aliceConstraints := channeldb.ChannelConstraints{
+		DustLimit:        openChannelReq.DustLimit,
+		ChanReserve:      openChannelReq.ChannelReserve,
+		MaxPendingAmount: openChannelReq.MaxValueInFlight,
+		MaxAcceptedHtlcs: openChannelReq.MaxAcceptedHTLCs,
+		MinHTLC:          5,
+	}
+	expectedAlice := channeldb.ChannelConstraints{
+		DustLimit:        1000,
+		ChanReserve:      60000,
+		MaxPendingAmount: 1000000000,
+		MaxAcceptedHtlcs: 100,
+		MinHTLC:          5,
+	}
+	if aliceConstraints != expectedAlice {
+		t.Fatalf("expected OpenChannel limits %v, got %v",
+			spew.Sdump(expectedAlice), spew.Sdump(aliceConstraints))
+	}
+
+	chanID := openChannelReq.PendingChannelID
+
+	// Let Bob handle the init message. He should answer with the limits
+	// chosen by his channel acceptor.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	bobConstraints := channeldb.ChannelConstraints{
+		DustLimit:        acceptChannelResponse.DustLimit,
+		ChanReserve:      acceptChannelResponse.ChannelReserve,
+		MaxPendingAmount: acceptChannelResponse.MaxValueInFlight,
+		MaxAcceptedHtlcs: acceptChannelResponse.MaxAcceptedHTLCs,
+		MinHTLC:          acceptChannelResponse.HtlcMinimum,
+	}
+	expectedBob := channeldb.ChannelConstraints{
+		DustLimit:        2000,
+		ChanReserve:      70000,
+		MaxPendingAmount: 2000000000,
+		MaxAcceptedHtlcs: 50,
+		MinHTLC:          1000,
+	}
+	if bobConstraints != expectedBob {
+		t.Fatalf("expected AcceptChannel limits %v, got %v",
+			spew.Sdump(expectedBob), spew.Sdump(bobConstraints))
+	}
+	if acceptChannelResponse.CsvDelay != 144 {
+		t.Fatalf("expected AcceptChannel to have CSV delay %v, got %v",
+			144, acceptChannelResponse.CsvDelay)
+	}
+
+	// Forward the response to Alice.
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	_ = assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	// assertConstraints checks that the limits each party required from
+	// the other were committed to the reservation. The limits of a
+	// party's contribution are the ones required by its counterparty,
+	// while the dust limit is the party's own.
+	assertConstraints := func(node, peer *testNode,
+		ours, theirs channeldb.ChannelConstraints) {
+
+		resCtx, err := node.fundingMgr.getReservationCtx(
+			peer.privKey.PubKey(), chanID,
+		)
+		if err != nil {
+			t.Fatalf("unable to find ctx: %v", err)
+		}
+
+		ourConstraints := resCtx.reservation.OurContribution().ChannelConstraints
+		if ourConstraints != ours {
+			t.Fatalf("expected our constraints %v, got %v",
+				spew.Sdump(ours), spew.Sdump(ourConstraints))
+		}
+
+		theirConstraints := resCtx.reservation.TheirContribution().ChannelConstraints
+		if theirConstraints != theirs {
+			t.Fatalf("expected their constraints %v, got %v",
+				spew.Sdump(theirs), spew.Sdump(theirConstraints))
+		}
+	}
+
+	// The constraints Alice's commitments must satisfy are the ones
+	// required by Bob, along with her own dust limit.
+	aliceCommit := expectedBob
+	aliceCommit.DustLimit = expectedAlice.DustLimit
+	bobCommit := expectedAlice
+	bobCommit.DustLimit = expectedBob.DustLimit
+
+	assertConstraints(alice, bob, aliceCommit, bobCommit)
+	assertConstraints(bob, alice, bobCommit, aliceCommit)
+}
+
+// TestFundingManagerInvalidChannelLimits checks that channels with unsound
+// channel limits are refused, both by the initiator before proposing them,
+// and by the responder when configured by its channel acceptor.
+func TestFundingManagerInvalidChannelLimits(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Alice attempts to open a channel allowing Bob to offer too few
+	// HTLCs, which should fail without contacting Bob.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         true,
+		maxHtlcs:        2,
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	select {
+	case err := <-initReq.err:
+		if _, ok := err.(lnwallet.ReservationError); !ok {
+			t.Fatalf("expected ReservationError, got: %v", err)
+		}
+	case msg := <-alice.msgChan:
+		t.Fatalf("expected funding workflow to fail, got %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow not failed")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	// Bob's channel acceptor will only accept CSV delays up to 100
+	// blocks, so Alice requiring a larger one should fail the channel.
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(&paramsAcceptor{
+		params: &chanacceptor.ChannelParams{MaxCsvDelay: 100},
+	})
+
+	initReq = &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         true,
+		remoteCsvDelay:  200,
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	_ = assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// TestFundingManagerUpfrontShutdown checks that the upfront shutdown scripts
+// specified in the open channel request, and returned by the channel acceptor,
+// are committed to within the funding messages and both reservations.
+func TestFundingManagerUpfrontShutdown(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We'll use p2wpkh scripts as the upfront shutdown scripts.
+	aliceScript := lnwire.DeliveryAddress(
+		append([]byte{0x00, 0x14}, bytes.Repeat([]byte{1}, 20)...),
+	)
+	bobScript := lnwire.DeliveryAddress(
+		append([]byte{0x00, 0x14}, bytes.Repeat([]byte{2}, 20)...),
+	)
+
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(&paramsAcceptor{
+		params: &chanacceptor.ChannelParams{
+			UpfrontShutdown: bobScript,
+		},
+	})
+
+	newInitReq := func() *openChanReq {
+		return &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			pushAmt:         lnwire.NewMSatFromSatoshis(0),
+			private:         true,
+			shutdownScript:  aliceScript,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+	}
+
+	// As Bob doesn't signal support for upfront shutdown scripts yet,
+	// Alice shouldn't be able to commit to one.
+	initReq := newInitReq()
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	select {
+	case err := <-initReq.err:
+		if err == nil {
+			t.Fatalf("expected funding workflow to fail")
+		}
+	case msg := <-alice.msgChan:
+		t.Fatalf("expected funding workflow to fail, got %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow not failed")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	// Once both signal support, the scripts should be exchanged.
+	alice.localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	bob.localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+
+	initReq = newInitReq()
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, aliceScript) {
+		t.Fatalf("expected OpenChannel to have upfront shutdown "+
+			"script %x, got %x", aliceScript,
+			openChannelReq.UpfrontShutdownScript)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if !bytes.Equal(acceptChannelResponse.UpfrontShutdownScript, bobScript) {
+		t.Fatalf("expected AcceptChannel to have upfront shutdown "+
+			"script %x, got %x", bobScript,
+			acceptChannelResponse.UpfrontShutdownScript)
+	}
+
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	_ = assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	// Both reservations should hold the scripts the parties committed
+	// to.
+	chanID := openChannelReq.PendingChannelID
+	assertScripts := func(node, peer *testNode,
+		ours, theirs lnwire.DeliveryAddress) {
+
+		resCtx, err := node.fundingMgr.getReservationCtx(
+			peer.privKey.PubKey(), chanID,
+		)
+		if err != nil {
+			t.Fatalf("unable to find ctx: %v", err)
+		}
+
+		ourScript := resCtx.reservation.OurContribution().UpfrontShutdown
+		if !bytes.Equal(ourScript, ours) {
+			t.Fatalf("expected our upfront shutdown script %x, "+
+				"got %x", ours, ourScript)
+		}
+
+		theirScript := resCtx.reservation.TheirContribution().UpfrontShutdown
+		if !bytes.Equal(theirScript, theirs) {
+			t.Fatalf("expected their upfront shutdown script %x, "+
+				"got %x", theirs, theirScript)
+		}
+	}
+
+	assertScripts(alice, bob, aliceScript, bobScript)
+	assertScripts(bob, alice, bobScript, aliceScript)
+}
+
+// TestFundingManagerStaticRemoteKey checks that the static remote key
+// commitment format is used once both peers signal support for it, and that
+// the legacy format is used otherwise.
+func TestFundingManagerStaticRemoteKey(t *testing.T) {
+	testCases := []struct {
+		name      string
+		supported bool
+	}{
+		{
+			name: "not supported",
+		},
+		{
+			name:      "supported",
+			supported: true,
+		},
+	}
+
+	for _, test := range testCases {
+		alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+
+		if test.supported {
+			alice.localFeatures.Set(lnwire.StaticRemoteKeyOptional)
+			bob.localFeatures.Set(lnwire.StaticRemoteKeyOptional)
+		}
+
+		// Run through the process of opening the channel, up until
+		// the funding transaction is broadcasted.
+		updateChan := make(chan *lnrpc.OpenStatusUpdate)
+		openChannel(t, alice, bob, 500000, 0, 1, updateChan, true)
+
+		// Both parties should agree on the commitment format used.
+		for _, node := range []*testNode{alice, bob} {
+			channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+				FetchPendingChannels()
+			if err != nil {
+				t.Fatalf("%v: unable to fetch pending "+
+					"channels: %v", test.name, err)
+			}
+			if len(channels) != 1 {
+				t.Fatalf("%v: expected 1 pending channel, "+
+					"got %v", test.name, len(channels))
+			}
+
+			chanType := channels[0].ChanType
+			if chanType.HasStaticRemoteKey() != test.supported {
+				t.Fatalf("%v: expected static remote key=%v, "+
+					"got channel type %v", test.name,
+					test.supported, chanType)
+			}
+		}
+
+		tearDownFundingManagers(t, alice, bob)
+	}
+}
+
+// TestFundingManagerRejectPush checks behaviour of 'rejectpush'
+// option, namely that non-zero incoming push amounts are disabled.
+func TestFundingManagerRejectPush(t *testing.T) {
+	// Enable 'rejectpush' option and initialize funding managers.
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	rejectPush := cfg.RejectPush
+	cfg.RejectPush = true
+	defer func() {
+		tearDownFundingManagers(t, alice, bob)
+		cfg.RejectPush = rejectPush
+	}()
+
+	// Create a funding request and start the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(10),
+		private:         true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		errorMsg, gotError := aliceMsg.(*lnwire.Error)
+		if gotError {
+			t.Fatalf("expected OpenChannel to be sent "+
+				"from bob, instead got error: %v",
+				lnwire.ErrorCode(errorMsg.Data[0]))
+		}
+		t.Fatalf("expected OpenChannel to be sent from "+
+			"alice, instead got %T", aliceMsg)
+	}
+
+	// Let Bob handle the init message.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	// Assert Bob responded with an ErrNonZeroPushAmount error.
+	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if "Non-zero push amounts are disabled" != string(err.Data) {
+		t.Fatalf("expected ErrNonZeroPushAmount error, got \"%v\"",
+			string(err.Data))
+	}
+}
+
+// TestFundingManagerDualFunding checks that both parties can contribute funds
+// to a channel, and that the resulting funding transaction is fully signed,
+// with each party paying for its own inputs.
+func TestFundingManagerDualFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob is willing to contribute up to 300k satoshis to channels opened
+	// by his peers.
+	const (
+		aliceAmt = btcutil.Amount(500000)
+		bobAmt   = btcutil.Amount(300000)
+	)
+	bob.fundingMgr.cfg.MaxDualFundAmt = bobAmt
+
+	// Alice opens a channel, inviting Bob to dual fund it.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: aliceAmt,
+		fundingFeePerKw: 62500,
+		dualFund:        true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+	if !openChannelReq.DualFund {
+		t.Fatalf("expected alice to invite bob to dual fund")
+	}
+
+	// Bob should present his contribution before accepting the channel.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	bobContribution := assertFundingMsgSent(
+		t, bob.msgChan, "FundingContribution",
+	).(*lnwire.FundingContribution)
+	if bobContribution.FundingAmount != bobAmt {
+		t.Fatalf("expected bob to contribute %v, got %v", bobAmt,
+			bobContribution.FundingAmount)
+	}
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	// Alice in turn presents her contribution along with the funding
+	// outpoint.
+	alice.fundingMgr.processFundingContribution(bobContribution, bob)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	aliceContribution := assertFundingMsgSent(
+		t, alice.msgChan, "FundingContribution",
+	).(*lnwire.FundingContribution)
+	if aliceContribution.FundingAmount != aliceAmt {
+		t.Fatalf("expected alice to contribute %v, got %v", aliceAmt,
+			aliceContribution.FundingAmount)
+	}
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	// Bob should arrive at the same funding transaction, and send over
+	// the scripts for his inputs along with his commitment signature.
+	bob.fundingMgr.processFundingContribution(aliceContribution, alice)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingInputSigs := assertFundingMsgSent(
+		t, bob.msgChan, "FundingInputSigs",
+	).(*lnwire.FundingInputSigs)
+	if len(fundingInputSigs.InputScripts) != len(bobContribution.Inputs) {
+		t.Fatalf("expected %v input scripts, got %v",
+			len(bobContribution.Inputs),
+			len(fundingInputSigs.InputScripts))
+	}
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingInputSigs(fundingInputSigs, bob)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	var fundingTx *wire.MsgTx
+	select {
+	case fundingTx = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	// The funding transaction should spend the inputs of both parties, and
+	// every input should be validly signed.
+	prevOuts := make(map[wire.OutPoint]lnwire.FundingInput)
+	for _, contribution := range []*lnwire.FundingContribution{
+		aliceContribution, bobContribution,
+	} {
+		for _, input := range contribution.Inputs {
+			prevOuts[input.OutPoint] = input
+		}
+	}
+	if len(fundingTx.TxIn) != len(prevOuts) {
+		t.Fatalf("expected %v funding inputs, got %v", len(prevOuts),
+			len(fundingTx.TxIn))
+	}
+	hashCache := txscript.NewTxSigHashes(fundingTx)
+	for i, txIn := range fundingTx.TxIn {
+		prevOut, ok := prevOuts[txIn.PreviousOutPoint]
+		if !ok {
+			t.Fatalf("unknown funding input %v",
+				txIn.PreviousOutPoint)
+		}
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, fundingTx, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			int64(prevOut.Value),
+		)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Fatalf("invalid funding input %v: %v",
+				txIn.PreviousOutPoint, err)
+		}
+	}
+
+	// Each party should pay for its own inputs, while Alice additionally
+	// pays for the funding output, and thus pays the larger fee.
+	fee := func(c *lnwire.FundingContribution) btcutil.Amount {
+		amt := -c.FundingAmount
+		for _, input := range c.Inputs {
+			amt += input.Value
+		}
+		for _, output := range c.ChangeOutputs {
+			amt -= output.Value
+		}
+		return amt
+	}
+	aliceFee, bobFee := fee(aliceContribution), fee(bobContribution)
+	if bobFee <= 0 || aliceFee <= bobFee {
+		t.Fatalf("unexpected fee split: alice pays %v, bob pays %v",
+			aliceFee, bobFee)
+	}
+
+	// Once the funding transaction is broadcast, Alice sends over the
+	// scripts for her inputs, which allows Bob to broadcast it as well.
+	aliceInputSigs := assertFundingMsgSent(
+		t, alice.msgChan, "FundingInputSigs",
+	).(*lnwire.FundingInputSigs)
+	if len(aliceInputSigs.InputScripts) != len(aliceContribution.Inputs) {
+		t.Fatalf("expected %v input scripts, got %v",
+			len(aliceContribution.Inputs),
+			len(aliceInputSigs.InputScripts))
+	}
+	bob.fundingMgr.processFundingInputSigs(aliceInputSigs, alice)
+
+	select {
+	case bobFundingTx := <-bob.publTxChan:
+		if bobFundingTx.WitnessHash() != fundingTx.WitnessHash() {
+			t.Fatalf("bob published a different funding tx: %v "+
+				"vs %v", spew.Sdump(bobFundingTx),
+				spew.Sdump(fundingTx))
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("bob did not publish funding tx")
+	}
+
+	// Finally, both parties should have stored the dual funded channel
+	// with their respective balances.
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+
+	for _, node := range []*testNode{alice, bob} {
+		channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+			FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 pending channel, got %v",
+				len(channels))
+		}
+
+		channel := channels[0]
+		if channel.ChanType != channeldb.DualFunder {
+			t.Fatalf("expected dual funder channel, got %v",
+				channel.ChanType)
+		}
+		if channel.Capacity != aliceAmt+bobAmt {
+			t.Fatalf("expected capacity of %v, got %v",
+				aliceAmt+bobAmt, channel.Capacity)
+		}
+
+		// Bob's balance is exactly the amount he contributed, as
+		// Alice pays the commitment fee as the initiator.
+		bobBalance := channel.LocalCommitment.RemoteBalance
+		if node == bob {
+			bobBalance = channel.LocalCommitment.LocalBalance
+		}
+		if bobBalance != lnwire.NewMSatFromSatoshis(bobAmt) {
+			t.Fatalf("expected bob's balance to be %v, got %v",
+				bobAmt, bobBalance.ToSatoshis())
+		}
+	}
+
+	// Both parties should have persisted the fully signed funding
+	// transaction, so they can rebroadcast it on startup. Bob only does so
+	// once he broadcast it himself.
+	for _, node := range []*testNode{alice, bob} {
+		var storedTx *wire.MsgTx
+		for i := 0; i < testPollNumTries; i++ {
+			if i > 0 {
+				time.Sleep(testPollSleepMs * time.Millisecond)
+			}
+			channels, err := node.fundingMgr.cfg.Wallet.Cfg.
+				Database.FetchPendingChannels()
+			if err != nil {
+				t.Fatalf("unable to fetch pending channels: %v",
+					err)
+			}
+
+			storedTx = channels[0].FundingTxn
+			if storedTx.WitnessHash() == fundingTx.WitnessHash() {
+				break
+			}
+		}
+		if storedTx.WitnessHash() != fundingTx.WitnessHash() {
+			t.Fatalf("expected funding tx %v to be stored, got %v",
+				spew.Sdump(fundingTx), spew.Sdump(storedTx))
+		}
+	}
+}
+
+// TestAddRemoteFundingFee checks that the remote party's contribution to a
+// dual funded channel is rejected if it doesn't pay for its inputs and change,
+// and for the funding output if it's the initiator.
+func TestAddRemoteFundingFee(t *testing.T) {
+	t.Parallel()
+
+	pkScript := append([]byte{txscript.OP_0, txscript.OP_DATA_20},
+		bytes.Repeat([]byte{0x01}, 20)...)
+	msg := &lnwire.FundingContribution{
+		FundingAmount: 500000,
+		Inputs: []lnwire.FundingInput{{
+			Value:    1000000,
+			PkScript: pkScript,
+		}},
+		ChangeOutputs: []lnwire.FundingOutput{{
+			Value:    499000,
+			PkScript: pkScript,
+		}},
+	}
+
+	// The contribution pays a fee of 1000 satoshis, which suffices at
+	// the fee floor, but not at a fee rate of 2000 sat/kw once the
+	// initiator also pays for the funding output.
+	tests := []struct {
+		feeRate          lnwallet.SatPerKWeight
+		payFundingOutput bool
+		valid            bool
+	}{
+		{lnwallet.FeePerKwFloor, false, true},
+		{lnwallet.FeePerKwFloor, true, true},
+		{2000, false, true},
+		{2000, true, false},
+		{10000, false, false},
+	}
+	for i, test := range tests {
+		err := addRemoteFunding(
+			msg, test.feeRate, test.payFundingOutput,
+			&lnwallet.ChannelContribution{},
+		)
+		if test.valid && err != nil {
+			t.Fatalf("test #%d: unexpected error: %v", i, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("test #%d: expected contribution to be "+
+				"rejected", i)
+		}
+	}
+}
+
+// TestFundingManagerWumboChannels checks that channels above the soft-limit on
+// channel size can only be opened with peers that signal support for them.
+func TestFundingManagerWumboChannels(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Both Alice and Bob are configured to accept wumbo channels.
+	alice.fundingMgr.cfg.MaxChanSize = maxWumboFundingAmount
+	bob.fundingMgr.cfg.MaxChanSize = maxWumboFundingAmount
+
+	wumboAmt := maxFundingAmount + 1000000
+
+	initWumboFunding := func() *openChanReq {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: wumboAmt,
+			fundingFeePerKw: 62500,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		return initReq
+	}
+
+	// As Bob doesn't signal support for wumbo channels yet, Alice should
+	// refuse to open a wumbo channel with him.
+	initReq := initWumboFunding()
+	select {
+	case <-initReq.err:
+	case msg := <-alice.msgChan:
+		t.Fatalf("expected alice to refuse the channel, instead "+
+			"she sent %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not refuse the channel")
+	}
+
+	// Once Bob signals support, Alice will propose the channel. Bob
+	// however should reject it, as Alice doesn't signal support herself.
+	bob.localFeatures.Set(lnwire.WumboChannelsOptional)
+	initReq = initWumboFunding()
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	errMsg := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if lnwire.ErrorCode(errMsg.Data[0]) != lnwire.ErrChanTooLarge {
+		t.Fatalf("expected ErrChanTooLarge, got %v",
+			lnwire.ErrorCode(errMsg.Data[0]))
+	}
+
+	// Upon receiving the error, Alice should cancel her reservation.
+	alice.fundingMgr.processFundingError(errMsg, bobPubKey)
+	select {
+	case <-initReq.err:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not fail the funding workflow")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	// Finally, with both of them signaling support, the wumbo channel can
+	// be opened.
+	alice.localFeatures.Set(lnwire.WumboChannelsOptional)
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	openChannel(t, alice, bob, wumboAmt, 0, 1, updateChan, true)
+
+	channels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if len(channels) != 1 || channels[0].Capacity != wumboAmt {
+		t.Fatalf("expected a single pending channel of %v", wumboAmt)
+	}
+}
+
+// assertRealShortChanID asserts that the zero-conf channel of the given node
+// is known by an alias, and has the expected real short channel ID.
+func assertRealShortChanID(t *testing.T, node *testNode,
+	expected lnwire.ShortChannelID) {
+
+	var realScid lnwire.ShortChannelID
+	for i := 0; i < testPollNumTries; i++ {
+		// If this is not the first try, sleep before retrying.
+		if i > 0 {
+			time.Sleep(testPollSleepMs * time.Millisecond)
+		}
+		channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+			FetchAllChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 channel, got %v", len(channels))
+		}
+
+		channel := channels[0]
+		if !channel.IsZeroConf() {
+			t.Fatalf("expected zero-conf channel")
+		}
+		if !channel.ShortChanID().IsAlias() {
+			t.Fatalf("expected alias short chan id, got %v",
+				channel.ShortChanID())
+		}
+
+		realScid = channel.RealShortChanID()
+		if realScid == expected {
+			return
+		}
+	}
+
+	t.Fatalf("expected real short chan id %v, got %v", expected, realScid)
+}
+
+// assertPeerAlias asserts that the zero-conf channel of the given node has
+// stored the expected alias its peer knows the channel by.
+func assertPeerAlias(t *testing.T, node *testNode,
+	expected lnwire.ShortChannelID) {
+
+	channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+
+	peerAlias := channels[0].PeerAliasShortChanID()
+	if peerAlias != expected {
+		t.Fatalf("expected peer alias %v, got %v", expected, peerAlias)
+	}
+}
+
+// TestFundingManagerZeroConf checks that a channel the responder's channel
+// acceptor decided to trust is usable before its funding transaction
+// confirmed, and is only added to the router graph once it did.
+func TestFundingManagerZeroConf(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	chanAcceptor := bob.fundingMgr.cfg.ChannelAcceptor.(*chanacceptor.ChainedAcceptor)
+	chanAcceptor.AddAcceptor(&paramsAcceptor{
+		params: &chanacceptor.ChannelParams{ZeroConf: true},
+	})
+
+	// We will consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		false)
+
+	// Without the funding transaction being mined, both will send
+	// fundingLocked right away.
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+
+	// Both share the alias they know the channel by.
+	if !fundingLockedAlice.AliasScid.IsAlias() {
+		t.Fatalf("expected alice to send alias, got %v",
+			fundingLockedAlice.AliasScid)
+	}
+	if !fundingLockedBob.AliasScid.IsAlias() {
+		t.Fatalf("expected bob to send alias, got %v",
+			fundingLockedBob.AliasScid)
+	}
+
+	// Alice is notified about the channel being open without waiting for
+	// the funding transaction.
+	waitForOpenUpdate(t, updateChan)
+
+	// Exchange the fundingLocked messages, which hands the channel off to
+	// the peers under its alias.
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+
+	assertHandleFundingLocked(t, alice, bob)
+	assertRealShortChanID(t, alice, lnwire.ShortChannelID{})
+	assertRealShortChanID(t, bob, lnwire.ShortChannelID{})
+
+	// Each of them stored the alias the other one knows the channel by.
+	assertPeerAlias(t, alice, fundingLockedBob.AliasScid)
+	assertPeerAlias(t, bob, fundingLockedAlice.AliasScid)
+
+	// The channel must not have been added to the router graph yet.
+	select {
+	case ann := <-alice.announceChan:
+		t.Fatalf("unexpectedly got channel announcement message: %v", ann)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	// Once the funding transaction is mined, the real short channel ID is
+	// stored alongside the alias.
+	confDetails := &chainntnfs.TxConfirmation{
+		BlockHeight: 500,
+		TxIndex:     3,
+	}
+	realScid := lnwire.ShortChannelID{
+		BlockHeight: 500,
+		TxIndex:     3,
+		TxPosition:  uint16(fundingOutPoint.Index),
+	}
+	alice.mockNotifier.oneConfChannel <- confDetails
+	bob.mockNotifier.oneConfChannel <- confDetails
+
+	assertRealShortChanID(t, alice, realScid)
+	assertRealShortChanID(t, bob, realScid)
+
+	// After six confirmations, the channel is added to the router graph
+	// under its real short channel ID.
+	alice.mockNotifier.sixConfChannel <- confDetails
+	bob.mockNotifier.sixConfChannel <- confDetails
+
+	assertChannelAnnouncements(t, alice, bob)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+
+	// As this is a private channel, only the node announcements are sent
+	// once the channel is final.
+	for _, node := range []*testNode{alice, bob} {
+		select {
+		case msg := <-node.msgChan:
+			if _, ok := msg.(*lnwire.NodeAnnouncement); !ok {
+				t.Fatalf("expected to receive node " +
+					"announcement")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected to receive node announcement")
+		}
+	}
+
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+}
+
+// TestFundingManagerCancelReservation checks that a reservation whose funding
+// transaction hasn't been published yet can be canceled, failing the funding
+// flow with the remote party.
+func TestFundingManagerCancelReservation(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Create a funding request and start the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         false,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob.
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	pendingChanID := openChannelReq.PendingChannelID
+
+	// The reservation should be reported as pending.
+	reservations := alice.fundingMgr.PendingReservations()
+	if len(reservations) != 1 {
+		t.Fatalf("expected 1 pending reservation, got %v",
+			len(reservations))
+	}
+	if reservations[0].pendingChanID != pendingChanID {
+		t.Fatalf("expected pending chan id %x, got %x", pendingChanID,
+			reservations[0].pendingChanID)
+	}
+
+	// Canceling an unknown reservation should fail.
+	_, err := alice.fundingMgr.CancelPendingChannel([32]byte{1}, nil, 0)
+	if err == nil {
+		t.Fatalf("expected canceling unknown reservation to fail")
+	}
+
+	// Cancel the reservation. Since the funding flow is failed with Bob
+	// before the call returns, we do this in a goroutine.
+	cancelErr := make(chan error, 1)
+	go func() {
+		txid, err := alice.fundingMgr.CancelPendingChannel(
+			pendingChanID, nil, 0,
+		)
+		if err == nil && txid != nil {
+			err = fmt.Errorf("expected no transaction to be "+
+				"needed, got %v", txid)
+		}
+		cancelErr <- err
+	}()
+
+	// The funding flow should be failed with Bob, and the caller notified.
+	assertErrorSent(t, alice.msgChan)
+
+	select {
+	case err := <-cancelErr:
+		if err != nil {
+			t.Fatalf("unable to cancel reservation: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("cancel request not handled")
+	}
+
+	select {
+	case err := <-errChan:
+		if err != ErrFundingCanceled {
+			t.Fatalf("expected ErrFundingCanceled, got %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("caller not notified about canceled funding flow")
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// TestFundingManagerCancelPublishedFunding checks that canceling a pending
+// channel whose funding transaction was published double spends the funding
+// inputs, and that the double spend is rebroadcast after a restart.
+func TestFundingManagerCancelPublishedFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		true)
+
+	pendingChannels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if len(pendingChannels) != 1 {
+		t.Fatalf("expected 1 pending channel, got %v",
+			len(pendingChannels))
+	}
+	fundingTx := pendingChannels[0].FundingTxn
+
+	// The funding transaction must signal replaceability, as the double
+	// spend would never make it into the mempool otherwise.
+	for i, txIn := range fundingTx.TxIn {
+		if txIn.Sequence != lnwallet.FundingTxInSequence {
+			t.Fatalf("expected funding input %v to signal "+
+				"replaceability, got sequence %x", i,
+				txIn.Sequence)
+		}
+	}
+
+	// Bob didn't initiate the channel, so he can't cancel it.
+	_, err = bob.fundingMgr.CancelPendingChannel(
+		[32]byte{}, fundingOutPoint, lnwallet.FeePerKwFloor,
+	)
+	if err == nil {
+		t.Fatalf("expected responder to be unable to cancel channel")
+	}
+
+	txid, err := alice.fundingMgr.CancelPendingChannel(
+		[32]byte{}, fundingOutPoint, lnwallet.FeePerKwFloor,
+	)
+	if err != nil {
+		t.Fatalf("unable to cancel pending channel: %v", err)
+	}
+
+	// Alice should have published a transaction double spending the
+	// funding inputs, paying a higher fee than the funding transaction.
+	var cancelTx *wire.MsgTx
+	select {
+	case cancelTx = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding cancel tx")
+	}
+	if cancelTx.TxHash() != *txid {
+		t.Fatalf("expected published tx %v, got %v", txid,
+			cancelTx.TxHash())
+	}
+	if len(cancelTx.TxIn) != len(fundingTx.TxIn) {
+		t.Fatalf("expected %v inputs, got %v", len(fundingTx.TxIn),
+			len(cancelTx.TxIn))
+	}
+	for i, txIn := range cancelTx.TxIn {
+		prevOut := fundingTx.TxIn[i].PreviousOutPoint
+		if txIn.PreviousOutPoint != prevOut {
+			t.Fatalf("expected input %v to spend %v, got %v", i,
+				prevOut, txIn.PreviousOutPoint)
+		}
+	}
+
+	var fundingOut, cancelOut int64
+	for _, txOut := range fundingTx.TxOut {
+		fundingOut += txOut.Value
+	}
+	for _, txOut := range cancelTx.TxOut {
+		cancelOut += txOut.Value
+	}
+	if cancelOut >= fundingOut {
+		t.Fatalf("expected cancel tx to pay a higher fee than the " +
+			"funding tx")
+	}
+
+	// The channel remains pending until the double spend confirmed.
+	assertNumPendingChannelsRemains(t, alice, 1)
+
+	// After a restart, Alice should rebroadcast the double spend rather
+	// than the funding transaction.
+	recreateAliceFundingManager(t, alice)
+
+	select {
+	case tx := <-alice.publTxChan:
+		if tx.TxHash() != *txid {
+			t.Fatalf("expected rebroadcast of %v, got %v", txid,
+				tx.TxHash())
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not rebroadcast funding cancel tx")
+	}
+}
+
+// TestFundingManagerFundingCancelConfirmed checks that once the transaction
+// canceling a pending channel confirmed, the channel is forgotten, the peer is
+// notified, and we stop waiting for the funding transaction to confirm.
+func TestFundingManagerFundingCancelConfirmed(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted, and cancel it.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		true)
+
+	txid, err := alice.fundingMgr.CancelPendingChannel(
+		[32]byte{}, fundingOutPoint, lnwallet.FeePerKwFloor,
+	)
+	if err != nil {
+		t.Fatalf("unable to cancel pending channel: %v", err)
+	}
+
+	select {
+	case <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding cancel tx")
+	}
+
+	// We'll dispatch the confirmations of the funding and cancel
+	// transactions separately, and restart Alice so she registers for
+	// them again.
+	fundingConfChan := alice.mockNotifier.txConfChannel(
+		fundingOutPoint.Hash,
+	)
+	cancelConfChan := alice.mockNotifier.txConfChannel(*txid)
+
+	recreateAliceFundingManager(t, alice)
+
+	select {
+	case <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not rebroadcast funding cancel tx")
+	}
+
+	// Once the cancel tx confirms, Alice should forget the channel and
+	// let Bob know.
+	select {
+	case cancelConfChan <- &chainntnfs.TxConfirmation{}:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice isn't waiting for the funding cancel tx")
+	}
+
+	assertNumPendingChannelsBecomes(t, alice, 0)
+
+	select {
+	case msg := <-alice.msgChan:
+		errMsg, ok := msg.(*lnwire.Error)
+		if !ok {
+			t.Fatalf("expected lnwire.Error, got %T", msg)
+		}
+		if errMsg.ChanID != lnwire.NewChanIDFromOutPoint(fundingOutPoint) {
+			t.Fatalf("expected error for channel %v, got %v",
+				fundingOutPoint, errMsg.ChanID)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not notify bob of the canceled channel")
+	}
+
+	// Nothing should be waiting for the funding transaction to confirm
+	// anymore.
+	select {
+	case fundingConfChan <- &chainntnfs.TxConfirmation{}:
+		t.Fatalf("alice is still waiting for the funding tx")
+	case <-time.After(time.Second):
+	}
+}
+
+// TestFundingManagerPendingChannelCanceledByPeer checks that the responder of
+// a pending channel whose funding transaction was published forgets it once
+// the initiator tells it the channel was canceled, while the initiator
+// ignores such an error from the responder.
+func TestFundingManagerPendingChannelCanceledByPeer(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		true)
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	alicePub := alice.privKey.PubKey()
+
+	if !bob.fundingMgr.IsPendingOpenChannel(chanID, alicePub) {
+		t.Fatalf("expected channel to be pending for bob")
+	}
+
+	canceledErr := &lnwire.Error{
+		ChanID: chanID,
+		Data:   lnwire.ErrorData(ErrFundingCanceled.Error()),
+	}
+
+	// Alice initiated the channel, so an error from Bob doesn't make her
+	// forget it.
+	alice.fundingMgr.processFundingError(canceledErr, bob.privKey.PubKey())
+	assertNumPendingChannelsRemains(t, alice, 1)
+
+	// Bob doesn't have any funds at stake in the channel, so he forgets it
+	// once Alice tells him she canceled it.
+	bob.fundingMgr.processFundingError(canceledErr, alicePub)
+	assertNumPendingChannelsBecomes(t, bob, 0)
+
+	if bob.fundingMgr.IsPendingOpenChannel(chanID, alicePub) {
+		t.Fatalf("expected channel to no longer be pending for bob")
+	}
 }
@@ -0,0 +1,210 @@
+package htlcswitch
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// batchIntervalGrowth is the factor the batch interval of a link is
+	// multiplied with after waiting for the interval coalesced several
+	// updates into a single commitment.
+	batchIntervalGrowth = 1.25
+
+	// latencyEWMAWeight is the weight of a new sample in the moving
+	// averages of the commit and revocation latencies of a link.
+	latencyEWMAWeight = 0.125
+)
+
+// CommitStats holds the metrics a link keeps about the commitments it signs,
+// and the interval it batches updates for before signing.
+type CommitStats struct {
+	// BatchInterval is the current interval the link waits for updates to
+	// be batched before signing a new commitment.
+	BatchInterval time.Duration
+
+	// PendingUpdates is the number of updates that haven't been included
+	// in a commitment yet.
+	PendingUpdates uint32
+
+	// NumCommits is the number of commitments signed by the link.
+	NumCommits uint64
+
+	// NumBatchedUpdates is the number of updates included in the
+	// commitments signed by the link.
+	NumBatchedUpdates uint64
+
+	// AvgCommitLatency is the moving average of the time between the
+	// first update of a batch and the commitment including it being
+	// signed.
+	AvgCommitLatency time.Duration
+
+	// AvgRevocationLatency is the moving average of the time between
+	// sending a commitment to the remote peer and receiving its
+	// revocation of the prior state.
+	AvgRevocationLatency time.Duration
+}
+
+// batcher decides when a link flushes its batch of pending updates into a
+// new commitment, and keeps the commit metrics of the link. If the maximum
+// batch interval exceeds the minimum, the batch interval is tuned to the load
+// of the link: it shrinks while batches only hold a single update, as waiting
+// for them only adds latency, and grows while waiting coalesces several
+// updates, saving signatures.
+//
+// All methods but commitStats must be called from the link's htlcManager
+// goroutine.
+type batcher struct {
+	minInterval time.Duration
+	maxInterval time.Duration
+	batchSize   uint32
+
+	// batchStart is the time the first pending update of the current batch
+	// was added, or the zero time if the batch is empty.
+	batchStart time.Time
+
+	// commitsSent holds the times at which the commitments that haven't
+	// been revoked by the remote peer yet were sent, oldest first.
+	commitsSent []time.Time
+
+	mu    sync.Mutex
+	stats CommitStats
+}
+
+// newBatcher creates a batcher tuning the batch interval between the given
+// bounds. If the maximum interval is not greater than the minimum, the batch
+// is flushed on every tick of the link's batch ticker.
+func newBatcher(minInterval, maxInterval time.Duration,
+	batchSize uint32) *batcher {
+
+	if maxInterval < minInterval {
+		maxInterval = minInterval
+	}
+
+	return &batcher{
+		minInterval: minInterval,
+		maxInterval: maxInterval,
+		batchSize:   batchSize,
+		stats: CommitStats{
+			BatchInterval: minInterval,
+		},
+	}
+}
+
+// adaptive returns whether the batch interval is tuned to the load.
+func (b *batcher) adaptive() bool {
+	return b.maxInterval > b.minInterval
+}
+
+// updateAdded records that an update was added to the batch, which now holds
+// the given number of pending updates.
+func (b *batcher) updateAdded(now time.Time, pending uint32) {
+	if b.batchStart.IsZero() {
+		b.batchStart = now
+	}
+
+	b.mu.Lock()
+	b.stats.PendingUpdates = pending
+	b.mu.Unlock()
+}
+
+// shouldFlush returns whether the batch should be flushed on a tick of the
+// batch ticker.
+func (b *batcher) shouldFlush(now time.Time) bool {
+	if !b.adaptive() || b.batchStart.IsZero() {
+		return true
+	}
+
+	b.mu.Lock()
+	interval := b.stats.BatchInterval
+	b.mu.Unlock()
+
+	return now.Sub(b.batchStart) >= interval
+}
+
+// commitSent records that a commitment including the given number of batched
+// updates was sent to the remote peer, and tunes the batch interval.
+func (b *batcher) commitSent(now time.Time, numUpdates uint32) {
+	b.commitsSent = append(b.commitsSent, now)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.stats.NumCommits++
+	b.stats.NumBatchedUpdates += uint64(numUpdates)
+	b.stats.PendingUpdates = 0
+
+	// Commitments signed in reply to the remote peer don't flush a batch,
+	// so there's nothing to tune.
+	if b.batchStart.IsZero() {
+		return
+	}
+	waited := now.Sub(b.batchStart)
+	b.batchStart = time.Time{}
+
+	b.stats.AvgCommitLatency = updateEWMA(
+		b.stats.AvgCommitLatency, waited,
+		b.stats.AvgCommitLatency == 0,
+	)
+
+	// We only tune the interval if the batch was flushed because the
+	// interval passed. Batches flushed early because they were full, or
+	// because they held a settle or fail, tell us nothing about it.
+	if !b.adaptive() || numUpdates >= b.batchSize ||
+		waited < b.stats.BatchInterval {
+
+		return
+	}
+
+	interval := b.stats.BatchInterval
+	if numUpdates <= 1 {
+		interval /= 2
+	} else {
+		interval = time.Duration(float64(interval) * batchIntervalGrowth)
+	}
+
+	switch {
+	case interval < b.minInterval:
+		interval = b.minInterval
+	case interval > b.maxInterval:
+		interval = b.maxInterval
+	}
+	b.stats.BatchInterval = interval
+}
+
+// revocationReceived records that the remote peer revoked its prior state,
+// acknowledging the oldest commitment we sent that wasn't revoked yet.
+func (b *batcher) revocationReceived(now time.Time) {
+	// Revocations of commitments we sent before the link was started
+	// can't be matched.
+	if len(b.commitsSent) == 0 {
+		return
+	}
+	sent := b.commitsSent[0]
+	b.commitsSent = b.commitsSent[1:]
+
+	b.mu.Lock()
+	b.stats.AvgRevocationLatency = updateEWMA(
+		b.stats.AvgRevocationLatency, now.Sub(sent),
+		b.stats.AvgRevocationLatency == 0,
+	)
+	b.mu.Unlock()
+}
+
+// commitStats returns a snapshot of the commit metrics of the link.
+func (b *batcher) commitStats() CommitStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.stats
+}
+
+// updateEWMA adds a sample to an exponentially weighted moving average. The
+// first sample initializes the average.
+func updateEWMA(avg, sample time.Duration, first bool) time.Duration {
+	if first {
+		return sample
+	}
+
+	return avg + time.Duration(latencyEWMAWeight*float64(sample-avg))
+}
@@ -0,0 +1,148 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+)
+
+// flushBatch adds the given number of updates to the batcher at start, and
+// signs a commitment including them once the given time has passed.
+func flushBatch(b *batcher, start time.Time, numUpdates uint32,
+	waited time.Duration) {
+
+	for i := uint32(1); i <= numUpdates; i++ {
+		b.updateAdded(start, i)
+	}
+	b.commitSent(start.Add(waited), numUpdates)
+}
+
+// assertBatchInterval asserts the current batch interval of the batcher.
+func assertBatchInterval(t *testing.T, b *batcher, exp time.Duration) {
+	t.Helper()
+
+	if interval := b.commitStats().BatchInterval; interval != exp {
+		t.Fatalf("expected batch interval %v, got %v", exp, interval)
+	}
+}
+
+// TestBatcherFixedInterval checks that a batcher without an interval range
+// flushes the batch on every tick, and never changes its interval.
+func TestBatcherFixedInterval(t *testing.T) {
+	t.Parallel()
+
+	b := newBatcher(50*time.Millisecond, 0, 10)
+	now := time.Now()
+
+	b.updateAdded(now, 1)
+	if !b.shouldFlush(now) {
+		t.Fatalf("expected batch to be flushed")
+	}
+
+	flushBatch(b, now, 3, 50*time.Millisecond)
+	assertBatchInterval(t, b, 50*time.Millisecond)
+}
+
+// TestBatcherTuning checks that the batch interval shrinks while batches only
+// hold a single update, grows while batches coalesce several updates, and
+// stays within its bounds.
+func TestBatcherTuning(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minInterval = 10 * time.Millisecond
+		maxInterval = 20 * time.Millisecond
+	)
+	b := newBatcher(minInterval, maxInterval, 10)
+	now := time.Now()
+
+	// The batch isn't flushed before the interval has passed.
+	b.updateAdded(now, 1)
+	if b.shouldFlush(now.Add(minInterval / 2)) {
+		t.Fatalf("expected batch not to be flushed")
+	}
+	if !b.shouldFlush(now.Add(minInterval)) {
+		t.Fatalf("expected batch to be flushed")
+	}
+	b.commitSent(now.Add(minInterval), 1)
+
+	// Batches with a single update never shrink the interval below the
+	// minimum.
+	assertBatchInterval(t, b, minInterval)
+
+	// Batches coalescing several updates grow the interval up to the
+	// maximum.
+	flushBatch(b, now, 2, minInterval)
+	assertBatchInterval(t, b, minInterval*5/4)
+
+	for i := 0; i < 5; i++ {
+		interval := b.commitStats().BatchInterval
+		flushBatch(b, now, 2, interval)
+	}
+	assertBatchInterval(t, b, maxInterval)
+
+	// Full batches, and batches flushed before the interval passed,
+	// leave the interval unchanged.
+	flushBatch(b, now, 10, time.Millisecond)
+	assertBatchInterval(t, b, maxInterval)
+	flushBatch(b, now, 1, time.Millisecond)
+	assertBatchInterval(t, b, maxInterval)
+
+	// A batch with a single update that waited for the full interval
+	// halves it.
+	flushBatch(b, now, 1, maxInterval)
+	assertBatchInterval(t, b, maxInterval/2)
+
+	stats := b.commitStats()
+	if stats.NumCommits != 10 {
+		t.Fatalf("expected 10 commits, got %v", stats.NumCommits)
+	}
+	if stats.PendingUpdates != 0 {
+		t.Fatalf("expected no pending updates, got %v",
+			stats.PendingUpdates)
+	}
+}
+
+// TestBatcherLatencies checks that the batcher tracks the commit and
+// revocation latencies of a link.
+func TestBatcherLatencies(t *testing.T) {
+	t.Parallel()
+
+	b := newBatcher(10*time.Millisecond, 10*time.Millisecond, 10)
+	now := time.Now()
+
+	// Revocations that can't be matched to a commitment are ignored.
+	b.revocationReceived(now)
+
+	// The first samples initialize the averages.
+	flushBatch(b, now, 2, 8*time.Millisecond)
+	b.revocationReceived(now.Add(108 * time.Millisecond))
+
+	stats := b.commitStats()
+	if stats.AvgCommitLatency != 8*time.Millisecond {
+		t.Fatalf("expected commit latency of 8ms, got %v",
+			stats.AvgCommitLatency)
+	}
+	if stats.AvgRevocationLatency != 100*time.Millisecond {
+		t.Fatalf("expected revocation latency of 100ms, got %v",
+			stats.AvgRevocationLatency)
+	}
+
+	// A commitment signed in reply to the remote peer doesn't affect the
+	// commit latency, but its revocation is tracked.
+	b.commitSent(now, 0)
+	b.revocationReceived(now.Add(20 * time.Millisecond))
+
+	stats = b.commitStats()
+	if stats.AvgCommitLatency != 8*time.Millisecond {
+		t.Fatalf("expected commit latency of 8ms, got %v",
+			stats.AvgCommitLatency)
+	}
+	if stats.AvgRevocationLatency != 90*time.Millisecond {
+		t.Fatalf("expected revocation latency of 90ms, got %v",
+			stats.AvgRevocationLatency)
+	}
+	if stats.NumCommits != 2 || stats.NumBatchedUpdates != 2 {
+		t.Fatalf("unexpected commit counts: %v commits, %v updates",
+			stats.NumCommits, stats.NumBatchedUpdates)
+	}
+}
@@ -3,6 +3,7 @@ package htlcswitch
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/coreos/bbolt"
@@ -122,6 +123,10 @@ type CircuitMap interface {
 	// NumOpen returns the number of circuits with HTLCs that have been
 	// forwarded via an outgoing link.
 	NumOpen() int
+
+	// ListCircuits returns a snapshot of all circuits added by
+	// CommitCircuits, including those whose keystone has not been set.
+	ListCircuits() []*PaymentCircuit
 }
 
 var (
@@ -943,3 +948,32 @@ func (cm *circuitMap) NumOpen() int {
 
 	return len(cm.opened)
 }
+
+// ListCircuits returns a copy of every circuit known to the circuit map. This
+// includes half-added circuits, whose outgoing HTLC has not yet been assigned
+// a keystone, as well as open circuits that are waiting for a settle/fail
+// response from the outgoing link. The circuits are sorted by their incoming
+// circuit key.
+func (cm *circuitMap) ListCircuits() []*PaymentCircuit {
+	cm.mtx.RLock()
+	circuits := make([]*PaymentCircuit, 0, len(cm.pending))
+	for _, circuit := range cm.pending {
+		c := *circuit
+		if circuit.Outgoing != nil {
+			outKey := *circuit.Outgoing
+			c.Outgoing = &outKey
+		}
+		circuits = append(circuits, &c)
+	}
+	cm.mtx.RUnlock()
+
+	sort.Slice(circuits, func(i, j int) bool {
+		a, b := circuits[i].Incoming, circuits[j].Incoming
+		if a.ChanID != b.ChanID {
+			return a.ChanID.ToUint64() < b.ChanID.ToUint64()
+		}
+		return a.HtlcID < b.HtlcID
+	})
+
+	return circuits
+}
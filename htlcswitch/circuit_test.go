@@ -1404,3 +1404,82 @@ func TestCircuitMapDeleteOpenCircuit(t *testing.T) {
 			circuit2, nil)
 	}
 }
+
+// TestCircuitMapListCircuits checks that ListCircuits returns both half-added
+// and open circuits, and that the listing survives a restart.
+func TestCircuitMapListCircuits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chan1 = lnwire.NewShortChanIDFromInt(1)
+		chan2 = lnwire.NewShortChanIDFromInt(2)
+	)
+
+	cfg, circuitMap := newCircuitMap(t)
+
+	if circuits := circuitMap.ListCircuits(); len(circuits) != 0 {
+		t.Fatalf("expected no circuits, got %v", len(circuits))
+	}
+
+	circuit1 := &htlcswitch.PaymentCircuit{
+		Incoming: htlcswitch.CircuitKey{
+			ChanID: chan2,
+			HtlcID: 2,
+		},
+		PaymentHash:    hash1,
+		ErrorEncrypter: testExtracter,
+	}
+	circuit2 := &htlcswitch.PaymentCircuit{
+		Incoming: htlcswitch.CircuitKey{
+			ChanID: chan1,
+			HtlcID: 1,
+		},
+		PaymentHash:    hash2,
+		ErrorEncrypter: testExtracter,
+	}
+	_, err := circuitMap.CommitCircuits(circuit1, circuit2)
+	if err != nil {
+		t.Fatalf("failed to commit circuits: %v", err)
+	}
+
+	// Only open the second circuit, leaving the first one half-added.
+	keystone := htlcswitch.Keystone{
+		InKey: circuit2.Incoming,
+		OutKey: htlcswitch.CircuitKey{
+			ChanID: chan2,
+			HtlcID: 0,
+		},
+	}
+	if err := circuitMap.OpenCircuits(keystone); err != nil {
+		t.Fatalf("failed to open circuits: %v", err)
+	}
+	circuit2.Outgoing = &keystone.OutKey
+
+	assertCircuits := func(cm htlcswitch.CircuitMap) {
+		t.Helper()
+
+		// The circuits are expected to be sorted by their incoming
+		// key.
+		circuits := cm.ListCircuits()
+		if len(circuits) != 2 {
+			t.Fatalf("expected 2 circuits, got %v", len(circuits))
+		}
+		if !equalIgnoreLFD(circuits[0], circuit2) {
+			t.Fatalf("unexpected circuit: got %v, want %v",
+				circuits[0], circuit2)
+		}
+		if !equalIgnoreLFD(circuits[1], circuit1) {
+			t.Fatalf("unexpected circuit: got %v, want %v",
+				circuits[1], circuit1)
+		}
+		if !circuits[0].HasKeystone() || circuits[1].HasKeystone() {
+			t.Fatalf("unexpected keystones in listed circuits")
+		}
+	}
+
+	assertCircuits(circuitMap)
+
+	_, circuitMap = restartCircuitMap(t, cfg)
+
+	assertCircuits(circuitMap)
+}
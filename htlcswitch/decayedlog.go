@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"sync/atomic"
 
@@ -14,6 +15,12 @@ import (
 )
 
 const (
+	// compactTxMaxSize is the number of key and value bytes copied within
+	// a single transaction when compacting the log's database, bounding
+	// the memory held by the pending transaction. It matches the default
+	// of bbolt's compact command.
+	compactTxMaxSize = 65536
+
 	// defaultDbDirectory is the default directory where our decayed log
 	// will store our (sharedHash, CLTV) key-value pairs.
 	defaultDbDirectory = "sharedhashes"
@@ -42,9 +49,14 @@ var (
 	// ErrDecayedLogCorrupted signals that the anticipated bucketing
 	// structure has diverged since initialization.
 	ErrDecayedLogCorrupted = errors.New("decayed log structure corrupted")
+
+	// ErrDecayedLogUnavailable signals that the log's database couldn't be
+	// reopened after compacting it, so the log can no longer be used.
+	ErrDecayedLogUnavailable = errors.New("decayed log unavailable after " +
+		"failed compaction")
 )
 
-// DecayedLog implements the PersistLog interface. It stores the first
+// DecayedLog implements the ReplayLog interface. It stores the first
 // HashPrefixSize bytes of a sha256-hashed shared secret along with a node's
 // CLTV value. It is a decaying log meaning there will be a garbage collector
 // to collect entries which are expired according to their stored CLTV value
@@ -56,7 +68,14 @@ type DecayedLog struct {
 
 	dbPath string
 
-	db *bolt.DB
+	// dbMtx guards the database handle, which is replaced when the log is
+	// compacted. The handle is nil if reopening the database failed.
+	dbMtx sync.RWMutex
+	db    *bolt.DB
+
+	// compactMtx ensures only a single compaction writes the copy of the
+	// database at a time.
+	compactMtx sync.Mutex
 
 	notifier chainntnfs.ChainNotifier
 
@@ -146,11 +165,56 @@ func (d *DecayedLog) Stop() error {
 	d.wg.Wait()
 
 	// Close boltdb.
-	d.db.Close()
+	d.dbMtx.Lock()
+	if d.db != nil {
+		d.db.Close()
+		d.db = nil
+	}
+	d.dbMtx.Unlock()
 
 	return nil
 }
 
+// view executes the given function within a read-only transaction of the
+// log's database.
+func (d *DecayedLog) view(f func(*bolt.Tx) error) error {
+	d.dbMtx.RLock()
+	defer d.dbMtx.RUnlock()
+
+	if d.db == nil {
+		return ErrDecayedLogUnavailable
+	}
+
+	return d.db.View(f)
+}
+
+// update executes the given function within a read-write transaction of the
+// log's database.
+func (d *DecayedLog) update(f func(*bolt.Tx) error) error {
+	d.dbMtx.RLock()
+	defer d.dbMtx.RUnlock()
+
+	if d.db == nil {
+		return ErrDecayedLogUnavailable
+	}
+
+	return d.db.Update(f)
+}
+
+// batch executes the given function within a read-write transaction of the
+// log's database, which may be combined with concurrent calls. As such, the
+// function may be executed multiple times.
+func (d *DecayedLog) batch(f func(*bolt.Tx) error) error {
+	d.dbMtx.RLock()
+	defer d.dbMtx.RUnlock()
+
+	if d.db == nil {
+		return ErrDecayedLogUnavailable
+	}
+
+	return d.db.Batch(f)
+}
+
 // garbageCollector deletes entries from sharedHashBucket whose expiry height
 // has already past. This function MUST be run as a goroutine.
 func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
@@ -196,7 +260,7 @@ func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
 func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 	var numExpiredHashes uint32
 
-	err := d.db.Batch(func(tx *bolt.Tx) error {
+	err := d.batch(func(tx *bolt.Tx) error {
 		numExpiredHashes = 0
 
 		// Grab the shared hash bucket
@@ -246,7 +310,7 @@ func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 // Delete removes a <shared secret hash, CLTV> key-pair from the
 // sharedHashBucket.
 func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
-	return d.db.Batch(func(tx *bolt.Tx) error {
+	return d.batch(func(tx *bolt.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
@@ -261,7 +325,7 @@ func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
 func (d *DecayedLog) Get(hash *sphinx.HashPrefix) (uint32, error) {
 	var value uint32
 
-	err := d.db.View(func(tx *bolt.Tx) error {
+	err := d.view(func(tx *bolt.Tx) error {
 		// Grab the shared hash bucket which stores the mapping from
 		// truncated sha-256 hashes of shared secrets to CLTV's.
 		sharedHashes := tx.Bucket(sharedHashBucket)
@@ -294,7 +358,7 @@ func (d *DecayedLog) Put(hash *sphinx.HashPrefix, cltv uint32) error {
 	var scratch [4]byte
 	binary.BigEndian.PutUint32(scratch[:], cltv)
 
-	return d.db.Batch(func(tx *bolt.Tx) error {
+	return d.batch(func(tx *bolt.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
@@ -327,7 +391,7 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 	// to generate the complete replay set. If this batch was previously
 	// processed, the replay set will be deserialized from disk.
 	var replays *sphinx.ReplaySet
-	if err := d.db.Batch(func(tx *bolt.Tx) error {
+	if err := d.batch(func(tx *bolt.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
@@ -352,10 +416,6 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 			return replays.Decode(bytes.NewReader(replayBytes))
 		}
 
-		// The CLTV will be stored into scratch and then stored into the
-		// sharedHashBucket.
-		var scratch [4]byte
-
 		replays = sphinx.NewReplaySet()
 		err := b.ForEach(func(seqNum uint16, hashPrefix *sphinx.HashPrefix, cltv uint32) error {
 			// Retrieve the bytes which represents the CLTV
@@ -366,7 +426,9 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 			}
 
 			// Serialize the cltv value and write an entry keyed by
-			// the hash prefix.
+			// the hash prefix. Bolt references the value until the
+			// txn is committed, so each entry needs its own buffer.
+			var scratch [4]byte
 			binary.BigEndian.PutUint32(scratch[:], cltv)
 			return sharedHashes.Put(hashPrefix[:], scratch[:])
 		})
@@ -400,6 +462,316 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 	return replays, nil
 }
 
-// A compile time check to see if DecayedLog adheres to the PersistLog
+// Stats returns the number of entries in the log, the lowest CLTV among them
+// and the size of the log's database file.
+func (d *DecayedLog) Stats() (*ReplayLogStats, error) {
+	stats := &ReplayLogStats{}
+	err := d.view(func(tx *bolt.Tx) error {
+		sharedHashes := tx.Bucket(sharedHashBucket)
+		if sharedHashes == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		batchReplayBkt := tx.Bucket(batchReplayBucket)
+		if batchReplayBkt == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		err := sharedHashes.ForEach(func(k, v []byte) error {
+			cltv := binary.BigEndian.Uint32(v)
+			if stats.NumEntries == 0 || cltv < stats.OldestCLTV {
+				stats.OldestCLTV = cltv
+			}
+			stats.NumEntries++
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		stats.NumBatches = uint64(batchReplayBkt.Stats().KeyN)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(d.dbPath)
+	if err != nil {
+		return nil, err
+	}
+	stats.DiskUsage = info.Size()
+
+	return stats, nil
+}
+
+// Compact rewrites the log's database into a new file, as bolt never returns
+// the space freed by expired entries to the file system. It returns the size
+// of the database file before and after compaction. The log is copied from a
+// read snapshot, so it remains available while the copy is made, and is only
+// locked to catch up with the writes made in the meantime and to swap in the
+// new file. If its database can't be reopened afterwards, the log is marked
+// unavailable and all further operations fail with ErrDecayedLogUnavailable.
+func (d *DecayedLog) Compact() (int64, int64, error) {
+	d.compactMtx.Lock()
+	defer d.compactMtx.Unlock()
+
+	compactPath := d.dbPath + ".compact"
+	src, before, snapshotID, err := d.copyDB(compactPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	d.dbMtx.Lock()
+	defer d.dbMtx.Unlock()
+
+	// If the log was stopped while we were copying it, there's nothing
+	// left to swap.
+	if d.db != src {
+		os.Remove(compactPath)
+		return 0, 0, ErrDecayedLogUnavailable
+	}
+
+	// Any writes committed after our snapshot are missing from the copy,
+	// so we'll apply them now. With the lock held, no new ones can come
+	// in until the copy has replaced the current database.
+	var currentID int
+	err = d.db.View(func(tx *bolt.Tx) error {
+		currentID = tx.ID()
+		return nil
+	})
+	if err != nil {
+		os.Remove(compactPath)
+		return 0, 0, err
+	}
+	if currentID != snapshotID {
+		if err := syncBoltDB(d.db, compactPath); err != nil {
+			os.Remove(compactPath)
+			return 0, 0, err
+		}
+	}
+
+	// With the copy complete, we'll replace the current database with it.
+	// Bolt locks the file it has open, so the current handle must be
+	// closed first. From here on, the handle is only restored once the
+	// database at our path is reopened.
+	closeErr := d.db.Close()
+	d.db = nil
+	if closeErr != nil {
+		os.Remove(compactPath)
+		return 0, 0, fmt.Errorf("unable to close boltdb: %v", closeErr)
+	}
+
+	// If the replacement fails, we'll reopen the current database.
+	renameErr := os.Rename(compactPath, d.dbPath)
+	if renameErr != nil {
+		os.Remove(compactPath)
+	}
+	db, err := bolt.Open(d.dbPath, dbPermissions, nil)
+	if err != nil {
+		log.Errorf("Unable to reopen sphinx replay log, it's "+
+			"unavailable until restart: %v", err)
+		return 0, 0, fmt.Errorf("unable to reopen boltdb: %v", err)
+	}
+	d.db = db
+	if renameErr != nil {
+		return 0, 0, renameErr
+	}
+
+	after, err := os.Stat(d.dbPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	log.Infof("Compacted sphinx replay log from %v to %v bytes",
+		before.Size(), after.Size())
+
+	return before.Size(), after.Size(), nil
+}
+
+// copyDB copies the log's current database to compactPath while holding a
+// read lock, so the handle isn't swapped out from under the copy while other
+// operations carry on. It returns the copied handle, the info of its file and
+// the ID of the snapshot the copy was made from.
+func (d *DecayedLog) copyDB(compactPath string) (*bolt.DB, os.FileInfo,
+	int, error) {
+
+	d.dbMtx.RLock()
+	defer d.dbMtx.RUnlock()
+
+	if d.db == nil {
+		return nil, nil, 0, ErrDecayedLogUnavailable
+	}
+
+	before, err := os.Stat(d.dbPath)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// A copy left behind by an interrupted compaction is stale, so we'll
+	// remove it before copying all buckets into a fresh database next to
+	// the current one.
+	if err := os.Remove(compactPath); err != nil && !os.IsNotExist(err) {
+		return nil, nil, 0, err
+	}
+	snapshotID, err := compactBoltDB(d.db, compactPath)
+	if err != nil {
+		os.Remove(compactPath)
+		return nil, nil, 0, err
+	}
+
+	return d.db, before, snapshotID, nil
+}
+
+// compactBoltDB copies all top-level buckets of the given database into a new
+// database at dstPath. The buckets of the decayed log aren't nested. Like
+// bbolt's compact command, the copy is committed every compactTxMaxSize bytes,
+// rather than holding the whole database in a single transaction. It returns
+// the ID of the read transaction the copy was made from.
+func compactBoltDB(src *bolt.DB, dstPath string) (int, error) {
+	dst, err := bolt.Open(dstPath, dbPermissions, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshotID int
+	err = src.View(func(srcTx *bolt.Tx) error {
+		snapshotID = srcTx.ID()
+
+		dstTx, err := dst.Begin(true)
+		if err != nil {
+			return err
+		}
+
+		// The pending transaction is replaced with each commit, so
+		// we'll roll back whichever one is left if the copy fails.
+		defer func() {
+			dstTx.Rollback()
+		}()
+
+		var size int
+		err = srcTx.ForEach(func(name []byte,
+			srcBkt *bolt.Bucket) error {
+
+			dstBkt, err := dstTx.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+
+			// The keys are copied in order, so we can fill the
+			// pages of the new bucket completely.
+			dstBkt.FillPercent = 1.0
+
+			return srcBkt.ForEach(func(k, v []byte) error {
+				// Once the pending transaction is full, we'll
+				// commit it and continue in a new one.
+				if size+len(k)+len(v) > compactTxMaxSize {
+					if err := dstTx.Commit(); err != nil {
+						return err
+					}
+
+					tx, err := dst.Begin(true)
+					if err != nil {
+						return err
+					}
+					dstTx = tx
+					size = 0
+
+					dstBkt = dstTx.Bucket(name)
+					dstBkt.FillPercent = 1.0
+				}
+				size += len(k) + len(v)
+
+				return dstBkt.Put(k, v)
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		return dstTx.Commit()
+	})
+	if err != nil {
+		dst.Close()
+		return 0, err
+	}
+
+	return snapshotID, dst.Close()
+}
+
+// syncBoltDB brings the top-level buckets of the database at dstPath up to
+// date with the given database, only writing the entries that differ between
+// them. It's used to apply the writes made while a compacted copy was taken,
+// which are few compared to the size of the copy.
+func syncBoltDB(src *bolt.DB, dstPath string) error {
+	dst, err := bolt.Open(dstPath, dbPermissions, nil)
+	if err != nil {
+		return err
+	}
+
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return dst.Update(func(dstTx *bolt.Tx) error {
+			return srcTx.ForEach(func(name []byte,
+				srcBkt *bolt.Bucket) error {
+
+				return syncBoltBucket(srcBkt, dstTx, name)
+			})
+		})
+	})
+	if err != nil {
+		dst.Close()
+		return err
+	}
+
+	return dst.Close()
+}
+
+// syncBoltBucket writes the entries of srcBkt that are new or changed to the
+// bucket of the same name in dstTx, and removes the entries missing from
+// srcBkt.
+func syncBoltBucket(srcBkt *bolt.Bucket, dstTx *bolt.Tx, name []byte) error {
+	dstBkt, err := dstTx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return err
+	}
+
+	err = srcBkt.ForEach(func(k, v []byte) error {
+		if dstV := dstBkt.Get(k); dstV != nil && bytes.Equal(dstV, v) {
+			return nil
+		}
+
+		return dstBkt.Put(k, v)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Keys are looked up with a cursor rather than Get, as the latter
+	// can't tell a missing key apart from one with an empty value.
+	srcCursor := srcBkt.Cursor()
+	var deleted [][]byte
+	err = dstBkt.ForEach(func(k, _ []byte) error {
+		if srcK, _ := srcCursor.Seek(k); !bytes.Equal(srcK, k) {
+			deleted = append(deleted, append([]byte(nil), k...))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, k := range deleted {
+		if err := dstBkt.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A compile time check to see if DecayedLog adheres to the ReplayLog
 // interface.
-var _ sphinx.ReplayLog = (*DecayedLog)(nil)
+var _ ReplayLog = (*DecayedLog)(nil)
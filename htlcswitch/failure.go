@@ -36,6 +36,171 @@ func (f *ForwardingError) Error() string {
 	return fmt.Sprintf("%v: %v", f.FailureMessage.Error(), f.ExtraMsg)
 }
 
+// FailureDetail is an enum used to report the local reason an htlc was failed
+// by our node, which may be more specific than the failure message sent back
+// to the sender over the wire.
+type FailureDetail uint8
+
+const (
+	// FailureDetailNone indicates that the wire failure message fully
+	// describes the failure.
+	FailureDetailNone FailureDetail = iota
+
+	// FailureDetailLinkNotEligible indicates that the outgoing link is not
+	// yet eligible to forward htlcs.
+	FailureDetailLinkNotEligible
+
+	// FailureDetailInsufficientBalance indicates that the outgoing link
+	// doesn't have enough balance to carry the htlc.
+	FailureDetailInsufficientBalance
+
+	// FailureDetailMaxPendingAmount indicates that adding the htlc would
+	// exceed the maximum value of pending htlcs on the outgoing link.
+	FailureDetailMaxPendingAmount
+
+	// FailureDetailBelowMinHtlc indicates that the htlc is below the
+	// minimum htlc value accepted by the remote party.
+	FailureDetailBelowMinHtlc
+
+	// FailureDetailHtlcAddFailed indicates that the htlc couldn't be added
+	// to the outgoing channel's state machine for another reason.
+	FailureDetailHtlcAddFailed
+
+	// FailureDetailIncompleteForward indicates that the circuit for the
+	// forward couldn't be completed, and was failed back.
+	FailureDetailIncompleteForward
+
+	// FailureDetailInvoiceNotFound indicates that we were the exit hop of
+	// the htlc, but didn't have an invoice for its payment hash.
+	FailureDetailInvoiceNotFound
+
+	// FailureDetailOnionEncode indicates that we were unable to encode the
+	// onion for the next hop.
+	FailureDetailOnionEncode
+
+	// FailureDetailInterceptorTimeout indicates that a held forward was
+	// not resolved by the interceptor before its deadline.
+	FailureDetailInterceptorTimeout
+
+	// FailureDetailInterceptorFail indicates that a held forward was
+	// failed by the interceptor.
+	FailureDetailInterceptorFail
+
+	// FailureDetailChannelLimit indicates that the forward would exceed
+	// the in-flight limits of its incoming channel.
+	FailureDetailChannelLimit
+
+	// FailureDetailPeerLimit indicates that the forward would exceed the
+	// in-flight limits of its incoming peer.
+	FailureDetailPeerLimit
+
+	// FailureDetailRateLimit indicates that the incoming peer exceeded the
+	// rate at which it may offer new forwards.
+	FailureDetailRateLimit
+
+	// FailureDetailQueueTimeout indicates that the forward was queued by
+	// the forwarding limiter for too long.
+	FailureDetailQueueTimeout
+)
+
+// String returns a human readable version of the failure detail.
+func (f FailureDetail) String() string {
+	switch f {
+	case FailureDetailNone:
+		return "none"
+
+	case FailureDetailLinkNotEligible:
+		return "link not eligible"
+
+	case FailureDetailInsufficientBalance:
+		return "insufficient balance"
+
+	case FailureDetailMaxPendingAmount:
+		return "max pending amount exceeded"
+
+	case FailureDetailBelowMinHtlc:
+		return "below min htlc"
+
+	case FailureDetailHtlcAddFailed:
+		return "htlc add failed"
+
+	case FailureDetailIncompleteForward:
+		return "incomplete forward"
+
+	case FailureDetailInvoiceNotFound:
+		return "invoice not found"
+
+	case FailureDetailOnionEncode:
+		return "could not encode onion"
+
+	case FailureDetailInterceptorTimeout:
+		return "interceptor timeout"
+
+	case FailureDetailInterceptorFail:
+		return "failed by interceptor"
+
+	case FailureDetailChannelLimit:
+		return "incoming channel limit exceeded"
+
+	case FailureDetailPeerLimit:
+		return "incoming peer limit exceeded"
+
+	case FailureDetailRateLimit:
+		return "incoming peer rate limit exceeded"
+
+	case FailureDetailQueueTimeout:
+		return "forwarding queue timeout"
+
+	default:
+		return "unknown failure detail"
+	}
+}
+
+// LinkError is an error produced when our node fails an htlc, either while
+// accepting it on the incoming link or while forwarding it to the outgoing
+// link. It holds the failure message sent back over the wire, along with an
+// optional detail giving the local reason for the failure.
+type LinkError struct {
+	// msg is the wire failure message sent back to the htlc's sender.
+	msg lnwire.FailureMessage
+
+	// FailureDetail gives the local reason the htlc was failed, if it
+	// isn't fully described by the wire failure message.
+	FailureDetail
+}
+
+// NewLinkError returns a LinkError which is fully described by the given wire
+// failure message.
+func NewLinkError(msg lnwire.FailureMessage) *LinkError {
+	return &LinkError{msg: msg}
+}
+
+// NewDetailedLinkError returns a LinkError with the given wire failure message
+// and local failure detail.
+func NewDetailedLinkError(msg lnwire.FailureMessage,
+	detail FailureDetail) *LinkError {
+
+	return &LinkError{
+		msg:           msg,
+		FailureDetail: detail,
+	}
+}
+
+// WireMessage returns the failure message sent back over the wire.
+func (l *LinkError) WireMessage() lnwire.FailureMessage {
+	return l.msg
+}
+
+// Error implements the built-in error interface, including the failure
+// detail when it is set.
+func (l *LinkError) Error() string {
+	if l.FailureDetail == FailureDetailNone {
+		return l.msg.Error()
+	}
+
+	return fmt.Sprintf("%v: %v", l.msg.Error(), l.FailureDetail)
+}
+
 // ErrorDecrypter is an interface that is used to decrypt the onion encrypted
 // failure reason an extra out a well formed error.
 type ErrorDecrypter interface {
@@ -0,0 +1,379 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrHtlcNotifierExiting is returned when a client attempts to subscribe to
+// htlc events while the HtlcNotifier is shutting down.
+var ErrHtlcNotifierExiting = errors.New("htlc notifier exiting")
+
+// HtlcEventType indicates the role our node played in the htlc an event
+// refers to.
+type HtlcEventType uint8
+
+const (
+	// HtlcEventTypeSend is used for htlcs that originated at our node.
+	HtlcEventTypeSend HtlcEventType = iota
+
+	// HtlcEventTypeReceive is used for htlcs for which our node is the
+	// final hop.
+	HtlcEventTypeReceive
+
+	// HtlcEventTypeForward is used for htlcs that our node forwards from
+	// one channel to another.
+	HtlcEventTypeForward
+)
+
+// String returns a human readable version of the event type.
+func (h HtlcEventType) String() string {
+	switch h {
+	case HtlcEventTypeSend:
+		return "send"
+
+	case HtlcEventTypeReceive:
+		return "receive"
+
+	case HtlcEventTypeForward:
+		return "forward"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HtlcKey uniquely identifies an htlc event by the incoming and outgoing
+// circuit keys of the htlc. The incoming circuit has a zero channel id for
+// htlcs sent by our node, and the outgoing circuit is zero for htlcs received
+// by our node. Htlcs that never made it onto the outgoing channel have an
+// outgoing circuit with the requested channel id and a zero htlc id.
+type HtlcKey struct {
+	// IncomingCircuit is the circuit key of the htlc on the incoming
+	// channel.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit is the circuit key of the htlc on the outgoing
+	// channel.
+	OutgoingCircuit CircuitKey
+}
+
+// String returns a human readable version of the htlc key.
+func (k HtlcKey) String() string {
+	return fmt.Sprintf("%v -> %v", k.IncomingCircuit, k.OutgoingCircuit)
+}
+
+// HtlcInfo holds the amounts and timelocks of an htlc on its incoming and
+// outgoing channels. Values that aren't known at the point an event is
+// emitted are left as zero.
+type HtlcInfo struct {
+	// IncomingTimeLock is the time lock of the htlc on the incoming
+	// channel.
+	IncomingTimeLock uint32
+
+	// OutgoingTimeLock is the time lock of the htlc on the outgoing
+	// channel.
+	OutgoingTimeLock uint32
+
+	// IncomingAmt is the amount of the htlc on the incoming channel.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount of the htlc on the outgoing channel.
+	OutgoingAmt lnwire.MilliSatoshi
+}
+
+// ForwardingEvent is emitted when an htlc has been added to the outgoing
+// channel, either as part of a forward or a payment sent by our node.
+type ForwardingEvent struct {
+	HtlcKey
+	HtlcInfo
+	HtlcEventType
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// ForwardingFailEvent is emitted when an htlc that we added to the outgoing
+// channel has been failed by a downstream node, or cancelled back after
+// being resolved on chain.
+type ForwardingFailEvent struct {
+	HtlcKey
+	HtlcInfo
+	HtlcEventType
+
+	// FailureMessage is the decrypted failure reason for htlcs sent by
+	// our node. It is nil for forwards, since the failure is encrypted
+	// for the original sender.
+	FailureMessage lnwire.FailureMessage
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// LinkFailEvent is emitted when our node fails an htlc, either when it is
+// received on the incoming link, or when it can't be added to the outgoing
+// link.
+type LinkFailEvent struct {
+	HtlcKey
+	HtlcInfo
+	HtlcEventType
+
+	// LinkError is the wire failure message and local failure detail the
+	// htlc was failed with.
+	LinkError *LinkError
+
+	// Incoming is true if the htlc was failed on the incoming link, and
+	// false if it was failed when being added to the outgoing link.
+	Incoming bool
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// SettleEvent is emitted when an htlc is settled, either by our node as the
+// final hop, or by a downstream node for forwards and sends.
+type SettleEvent struct {
+	HtlcKey
+	HtlcInfo
+	HtlcEventType
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// HtlcEventClient represents an intent to receive notifications from the
+// HtlcNotifier about the htlcs handled by our node. The Updates channel will
+// be sent upon with each new event in the order they occurred.
+type HtlcEventClient struct {
+	// Updates is a receive only channel that new htlc events will be sent
+	// over. Each item will be one of ForwardingEvent, ForwardingFailEvent,
+	// LinkFailEvent or SettleEvent.
+	Updates <-chan interface{}
+
+	// Cancel is a function closure that should be executed when the client
+	// wishes to cancel their notification intent. Doing so allows the
+	// HtlcNotifier to free up resources.
+	Cancel func()
+}
+
+// htlcEventClient is the internal state the HtlcNotifier keeps for each
+// registered client.
+type htlcEventClient struct {
+	// ntfnQueue buffers all events for this client, such that a slow
+	// client never blocks the switch or a link dispatching the event.
+	ntfnQueue *queue.ConcurrentQueue
+
+	// updates is the channel that events are proxied to from the queue.
+	updates chan interface{}
+
+	cancelled uint32 // To be used atomically.
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// HtlcNotifier is a sub-system that dispatches notifications about htlcs
+// being forwarded, settled and failed by the switch and its links to all
+// registered clients.
+type HtlcNotifier struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// ntfnClientCounter is an atomically incremented counter used to
+	// assign a unique ID to each new client.
+	ntfnClientCounter uint64
+
+	clientMtx sync.RWMutex
+	clients   map[uint64]*htlcEventClient
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHtlcNotifier creates a new HtlcNotifier.
+func NewHtlcNotifier() *HtlcNotifier {
+	return &HtlcNotifier{
+		clients: make(map[uint64]*htlcEventClient),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts the HtlcNotifier.
+func (h *HtlcNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&h.started, 0, 1) {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals the HtlcNotifier for a graceful shutdown, and waits for all
+// client goroutines to exit.
+func (h *HtlcNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&h.stopped, 0, 1) {
+		return nil
+	}
+
+	close(h.quit)
+	h.wg.Wait()
+
+	h.clientMtx.Lock()
+	for clientID, client := range h.clients {
+		client.ntfnQueue.Stop()
+		delete(h.clients, clientID)
+	}
+	h.clientMtx.Unlock()
+
+	return nil
+}
+
+// SubscribeHtlcEvents returns a new HtlcEventClient which can be used by the
+// caller to receive notifications whenever an htlc is forwarded, settled or
+// failed by our node.
+func (h *HtlcNotifier) SubscribeHtlcEvents() (*HtlcEventClient, error) {
+	select {
+	case <-h.quit:
+		return nil, ErrHtlcNotifierExiting
+	default:
+	}
+
+	clientID := atomic.AddUint64(&h.ntfnClientCounter, 1)
+
+	client := &htlcEventClient{
+		ntfnQueue:  queue.NewConcurrentQueue(20),
+		updates:    make(chan interface{}),
+		cancelChan: make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	// Before registering the client, we'll launch a goroutine that will
+	// proxy all events appended to the end of the queue to the channel the
+	// caller will feed off of.
+	h.wg.Add(1)
+	client.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		defer client.wg.Done()
+
+		for {
+			select {
+			case event := <-client.ntfnQueue.ChanOut():
+				select {
+				case client.updates <- event:
+				case <-client.cancelChan:
+					return
+				case <-h.quit:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+
+			case <-h.quit:
+				return
+			}
+		}
+	}()
+
+	h.clientMtx.Lock()
+	h.clients[clientID] = client
+	h.clientMtx.Unlock()
+
+	return &HtlcEventClient{
+		Updates: client.updates,
+		Cancel: func() {
+			h.cancelClient(clientID)
+		},
+	}, nil
+}
+
+// cancelClient unregisters the client with the given ID, freeing any
+// resources allocated to it.
+func (h *HtlcNotifier) cancelClient(clientID uint64) {
+	h.clientMtx.Lock()
+	client, ok := h.clients[clientID]
+	delete(h.clients, clientID)
+	h.clientMtx.Unlock()
+
+	if !ok || !atomic.CompareAndSwapUint32(&client.cancelled, 0, 1) {
+		return
+	}
+
+	close(client.cancelChan)
+	client.wg.Wait()
+	client.ntfnQueue.Stop()
+}
+
+// NotifyHtlcEvent dispatches the passed event to all registered clients. The
+// event must be one of ForwardingEvent, ForwardingFailEvent, LinkFailEvent or
+// SettleEvent.
+func (h *HtlcNotifier) NotifyHtlcEvent(event interface{}) {
+	h.clientMtx.RLock()
+	defer h.clientMtx.RUnlock()
+
+	for _, client := range h.clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-client.cancelChan:
+		case <-h.quit:
+			return
+		}
+	}
+}
+
+// newHtlcKey returns the htlc key of the given packet.
+func newHtlcKey(pkt *htlcPacket) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: pkt.inKey(),
+		OutgoingCircuit: pkt.outKey(),
+	}
+}
+
+// newHtlcInfo returns the amounts and timelocks of the given add packet.
+func newHtlcInfo(pkt *htlcPacket) HtlcInfo {
+	return HtlcInfo{
+		IncomingTimeLock: pkt.incomingTimeout,
+		OutgoingTimeLock: pkt.outgoingTimeout,
+		IncomingAmt:      pkt.incomingAmount,
+		OutgoingAmt:      pkt.amount,
+	}
+}
+
+// circuitHtlcKey returns the htlc key of the given circuit. The outgoing
+// circuit is left as zero if the circuit was never opened.
+func circuitHtlcKey(circuit *PaymentCircuit) HtlcKey {
+	key := HtlcKey{
+		IncomingCircuit: circuit.Incoming,
+	}
+	if circuit.Outgoing != nil {
+		key.OutgoingCircuit = *circuit.Outgoing
+	}
+
+	return key
+}
+
+// circuitHtlcInfo returns the amounts of the htlc tracked by the given
+// circuit. Circuits don't record timelocks, so these are left as zero.
+func circuitHtlcInfo(circuit *PaymentCircuit) HtlcInfo {
+	return HtlcInfo{
+		IncomingAmt: circuit.IncomingAmount,
+		OutgoingAmt: circuit.OutgoingAmount,
+	}
+}
+
+// packetEventType returns the event type of an htlc routed through the switch,
+// which is a send if it originated at our node, and a forward otherwise.
+func packetEventType(incomingChanID lnwire.ShortChannelID) HtlcEventType {
+	if incomingChanID == sourceHop {
+		return HtlcEventTypeSend
+	}
+
+	return HtlcEventTypeForward
+}
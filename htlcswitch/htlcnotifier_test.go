@@ -0,0 +1,236 @@
+package htlcswitch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestHtlcNotifierDispatch ensures that all events reported to the
+// HtlcNotifier are delivered, in order, to every registered client.
+func TestHtlcNotifierDispatch(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewHtlcNotifier()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+
+	clientA, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer clientA.Cancel()
+
+	clientB, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	key := HtlcKey{
+		IncomingCircuit: CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(1)},
+		OutgoingCircuit: CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(2)},
+	}
+	expectedEvents := []interface{}{
+		ForwardingEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+		},
+		SettleEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+		},
+	}
+
+	// Report all events before any of them are consumed, ensuring that a
+	// slow client never blocks the switch or its links.
+	for _, event := range expectedEvents {
+		notifier.NotifyHtlcEvent(event)
+	}
+
+	for _, client := range []*HtlcEventClient{clientA, clientB} {
+		for _, expected := range expectedEvents {
+			select {
+			case event := <-client.Updates:
+				if !reflect.DeepEqual(event, expected) {
+					t.Fatalf("expected event %v, got %v",
+						expected, event)
+				}
+
+			case <-time.After(5 * time.Second):
+				t.Fatalf("no htlc event received")
+			}
+		}
+	}
+
+	// Once cancelled, a client should no longer receive events.
+	clientB.Cancel()
+	notifier.NotifyHtlcEvent(expectedEvents[0])
+	select {
+	case event := <-clientB.Updates:
+		t.Fatalf("cancelled client received event: %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// New clients can't subscribe once the notifier is stopped.
+	if err := notifier.Stop(); err != nil {
+		t.Fatalf("unable to stop notifier: %v", err)
+	}
+	if _, err := notifier.SubscribeHtlcEvents(); err != ErrHtlcNotifierExiting {
+		t.Fatalf("expected ErrHtlcNotifierExiting, got %v", err)
+	}
+}
+
+// TestSwitchHtlcEvents checks that the switch reports forwards that it fails,
+// and forwards that are settled or failed by the outgoing link, to the htlc
+// notifier.
+func TestSwitchHtlcEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	events := make(chan interface{}, 10)
+	ctx.s.cfg.NotifyHtlcEvent = func(event interface{}) {
+		events <- event
+	}
+
+	assertEvent := func() interface{} {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("no htlc event received")
+		}
+
+		return nil
+	}
+
+	aliceChanID := ctx.aliceLink.ShortChanID()
+	bobChanID := ctx.bobLink.ShortChanID()
+
+	// A forward over an unknown link should be failed by the switch, and
+	// reported as a link failure on the outgoing side.
+	unknownChanID := lnwire.NewShortChanIDFromInt(999)
+	ctx.forward(0, [32]byte{})
+	ctx.assertPacket(ctx.bobLink)
+
+	packet := &htlcPacket{
+		incomingChanID:  aliceChanID,
+		incomingHTLCID:  1,
+		outgoingChanID:  unknownChanID,
+		incomingAmount:  2000,
+		amount:          1000,
+		incomingTimeout: 150,
+		outgoingTimeout: 140,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			Amount: 1000,
+		},
+	}
+	for range ctx.s.ForwardPackets(nil, packet) {
+	}
+	ctx.assertPacket(ctx.aliceLink)
+
+	linkFail, ok := assertEvent().(LinkFailEvent)
+	if !ok {
+		t.Fatalf("expected link fail event")
+	}
+	expectedKey := HtlcKey{
+		IncomingCircuit: CircuitKey{ChanID: aliceChanID, HtlcID: 1},
+		OutgoingCircuit: CircuitKey{ChanID: unknownChanID},
+	}
+	expectedInfo := HtlcInfo{
+		IncomingTimeLock: 150,
+		OutgoingTimeLock: 140,
+		IncomingAmt:      2000,
+		OutgoingAmt:      1000,
+	}
+	if linkFail.HtlcKey != expectedKey || linkFail.HtlcInfo != expectedInfo ||
+		linkFail.HtlcEventType != HtlcEventTypeForward ||
+		linkFail.Incoming {
+
+		t.Fatalf("unexpected link fail event: %v", linkFail)
+	}
+	wireMsg := linkFail.LinkError.WireMessage()
+	if _, ok := wireMsg.(*lnwire.FailUnknownNextPeer); !ok {
+		t.Fatalf("expected unknown next peer failure, got %T", wireMsg)
+	}
+
+	// Complete the circuit of the first forward, and settle it back from
+	// bob. The settle should be reported with the circuit's amounts.
+	settlePkt := &htlcPacket{
+		incomingChanID: aliceChanID,
+		incomingHTLCID: 0,
+		htlc:           &lnwire.UpdateAddHTLC{},
+	}
+	if err := ctx.bobLink.completeCircuit(settlePkt); err != nil {
+		t.Fatalf("unable to complete circuit: %v", err)
+	}
+
+	err := ctx.s.forward(&htlcPacket{
+		outgoingChanID: bobChanID,
+		outgoingHTLCID: settlePkt.outgoingHTLCID,
+		htlc:           &lnwire.UpdateFulfillHTLC{},
+	})
+	if err != nil {
+		t.Fatalf("unable to forward settle: %v", err)
+	}
+	ctx.assertPacket(ctx.aliceLink)
+
+	settle, ok := assertEvent().(SettleEvent)
+	if !ok {
+		t.Fatalf("expected settle event")
+	}
+	expectedKey = HtlcKey{
+		IncomingCircuit: CircuitKey{ChanID: aliceChanID, HtlcID: 0},
+		OutgoingCircuit: CircuitKey{
+			ChanID: bobChanID,
+			HtlcID: settlePkt.outgoingHTLCID,
+		},
+	}
+	expectedInfo = HtlcInfo{IncomingAmt: 2000, OutgoingAmt: 1000}
+	if settle.HtlcKey != expectedKey || settle.HtlcInfo != expectedInfo ||
+		settle.HtlcEventType != HtlcEventTypeForward {
+
+		t.Fatalf("unexpected settle event: %v", settle)
+	}
+
+	// Finally, a forward failed by a downstream node should be reported as
+	// a forwarding failure, without the failure message since it's
+	// encrypted for the sender.
+	ctx.forward(2, [32]byte{})
+	ctx.assertPacket(ctx.bobLink)
+
+	failPkt := &htlcPacket{
+		incomingChanID: aliceChanID,
+		incomingHTLCID: 2,
+		htlc:           &lnwire.UpdateAddHTLC{},
+	}
+	if err := ctx.bobLink.completeCircuit(failPkt); err != nil {
+		t.Fatalf("unable to complete circuit: %v", err)
+	}
+
+	err = ctx.s.forward(&htlcPacket{
+		outgoingChanID: bobChanID,
+		outgoingHTLCID: failPkt.outgoingHTLCID,
+		htlc:           &lnwire.UpdateFailHTLC{},
+	})
+	if err != nil {
+		t.Fatalf("unable to forward fail: %v", err)
+	}
+	ctx.assertPacket(ctx.aliceLink)
+
+	fwdFail, ok := assertEvent().(ForwardingFailEvent)
+	if !ok {
+		t.Fatalf("expected forwarding fail event")
+	}
+	if fwdFail.IncomingCircuit.HtlcID != 2 ||
+		fwdFail.HtlcEventType != HtlcEventTypeForward ||
+		fwdFail.FailureMessage != nil {
+
+		t.Fatalf("unexpected forwarding fail event: %v", fwdFail)
+	}
+}
@@ -0,0 +1,669 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// heldForwardsKey is the key of the bucket that stores the deadline of
+	// each forward held for the interceptor, keyed by its incoming circuit
+	// key. This allows held forwards to be held again, with their original
+	// deadline, once their incoming links replay them after a restart.
+	// Once a held forward is resolved, the resolution is stored along with
+	// the deadline until the incoming link committed it.
+	heldForwardsKey = []byte("held-forwards")
+
+	// ErrInterceptorExists is returned when a forward interceptor is set
+	// while another one is already registered with the switch.
+	ErrInterceptorExists = errors.New("forward interceptor already " +
+		"registered")
+
+	// ErrForwardNotHeld is returned when attempting to resolve a forward
+	// that isn't held by the switch, e.g. because it was already resolved
+	// or it timed out.
+	ErrForwardNotHeld = errors.New("forward not held")
+
+	// ErrInvalidPreimage is returned when attempting to settle a held
+	// forward with a preimage that doesn't match its payment hash.
+	ErrInvalidPreimage = errors.New("preimage doesn't match payment hash")
+)
+
+// InterceptedPacket contains the details of a forward held by the switch
+// until the interceptor decides on it.
+type InterceptedPacket struct {
+	// IncomingCircuit is the circuit key of the incoming HTLC, which
+	// uniquely identifies the held forward.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the channel the HTLC was requested to be
+	// forwarded over.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Hash is the payment hash of the HTLC.
+	Hash [32]byte
+
+	// IncomingAmount is the amount of the incoming HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// OutgoingAmount is the amount of the HTLC to be forwarded.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute expiry height of the incoming HTLC.
+	IncomingExpiry uint32
+
+	// OutgoingExpiry is the absolute expiry height of the HTLC to be
+	// forwarded.
+	OutgoingExpiry uint32
+
+	// Deadline is the time at which the forward is failed back if it
+	// hasn't been resolved by then.
+	Deadline time.Time
+}
+
+// ForwardInterceptor is handed every forward held by the switch. It's called
+// while the switch processes incoming HTLCs, so it MUST NOT block. The held
+// forward is then resolved with ResumeHeldForward, SettleHeldForward or
+// FailHeldForward.
+type ForwardInterceptor func(InterceptedPacket)
+
+// heldAction is the decision taken on a held forward.
+type heldAction uint8
+
+const (
+	// heldActionResume forwards the held forward as it would have been
+	// without the interceptor.
+	heldActionResume heldAction = 1
+
+	// heldActionSettle settles the held forward back to the incoming
+	// link.
+	heldActionSettle heldAction = 2
+
+	// heldActionFail fails the held forward back to the incoming link.
+	heldActionFail heldAction = 3
+)
+
+// heldResolution is the decision taken on a held forward. It's persisted until
+// the incoming link committed it, so it can be replayed should the incoming
+// link replay the forward after a restart.
+type heldResolution struct {
+	action heldAction
+
+	// preimage is the preimage a settled forward is settled with.
+	preimage [32]byte
+
+	// reason is the encrypted failure a failed forward is failed with.
+	reason lnwire.OpaqueReason
+}
+
+// heldRecord is the persisted state of a held forward.
+type heldRecord struct {
+	deadline time.Time
+
+	// resolution is the decision taken on the forward, or nil if it's
+	// still held.
+	resolution *heldResolution
+}
+
+// heldForward is a forward the switch holds until the interceptor resolves
+// it, or its deadline passes.
+type heldForward struct {
+	packet   *htlcPacket
+	deadline time.Time
+
+	// released is closed once the forward is no longer held.
+	released chan struct{}
+}
+
+// interceptedPacket returns the details of the held forward to hand to the
+// interceptor.
+func (f *heldForward) interceptedPacket() InterceptedPacket {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+
+	return InterceptedPacket{
+		IncomingCircuit: f.packet.inKey(),
+		OutgoingChanID:  f.packet.outgoingChanID,
+		Hash:            htlc.PaymentHash,
+		IncomingAmount:  f.packet.incomingAmount,
+		OutgoingAmount:  f.packet.amount,
+		IncomingExpiry:  f.packet.incomingTimeout,
+		OutgoingExpiry:  f.packet.outgoingTimeout,
+		Deadline:        f.deadline,
+	}
+}
+
+// SetInterceptor registers the interceptor that decides on every forward the
+// switch sees from now on. Any forwards that are already held, either for a
+// previous interceptor or since before a restart, are handed to it right
+// away.
+func (s *Switch) SetInterceptor(interceptor ForwardInterceptor) error {
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	if s.interceptor != nil {
+		return ErrInterceptorExists
+	}
+	s.interceptor = interceptor
+
+	for _, fwd := range s.heldForwards {
+		interceptor(fwd.interceptedPacket())
+	}
+
+	return nil
+}
+
+// RemoveInterceptor unregisters the current interceptor, after which new
+// forwards are no longer held. Forwards that are already held remain so until
+// they're resolved, or their deadline passes.
+func (s *Switch) RemoveInterceptor() {
+	s.heldMtx.Lock()
+	s.interceptor = nil
+	s.heldMtx.Unlock()
+}
+
+// HeldForward returns the details of the forward identified by the given
+// incoming circuit key, if it's held.
+func (s *Switch) HeldForward(inKey CircuitKey) (InterceptedPacket, error) {
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	fwd, ok := s.heldForwards[inKey]
+	if !ok {
+		return InterceptedPacket{}, ErrForwardNotHeld
+	}
+
+	return fwd.interceptedPacket(), nil
+}
+
+// interceptForward holds the given forward, if an interceptor is registered
+// or the forward was held before a restart. It returns true if the forward is
+// held, in which case it must not be forwarded any further.
+func (s *Switch) interceptForward(packet *htlcPacket) bool {
+	inKey := packet.inKey()
+
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	// If the forward is already held, this is a replay of the incoming
+	// link, which we'll drop.
+	if _, ok := s.heldForwards[inKey]; ok {
+		return true
+	}
+
+	var deadline time.Time
+	record, ok := s.heldRecords[inKey]
+	switch {
+
+	// The forward was resolved already, but the incoming link replayed it
+	// before committing the resolution, so we'll replay the resolution.
+	case ok && record.resolution != nil:
+		return s.replayHeldResolution(packet, record.resolution)
+
+	// The forward was held before a restart, so we'll hold it again until
+	// its original deadline.
+	case ok:
+		deadline = record.deadline
+		delete(s.heldRecords, inKey)
+
+	// Forwards that have been resumed already have a circuit, and are
+	// left to the circuit map to handle.
+	case s.interceptor == nil || s.circuits.LookupCircuit(inKey) != nil:
+		return false
+
+	default:
+		deadline = time.Now().Add(s.cfg.InterceptorTimeout)
+		err := s.persistHeldForward(
+			inKey, &heldRecord{deadline: deadline},
+		)
+		if err != nil {
+			log.Errorf("Unable to persist held forward %v, "+
+				"forwarding: %v", inKey, err)
+			return false
+		}
+	}
+
+	log.Debugf("Holding forward %v until %v", inKey, deadline)
+
+	fwd := &heldForward{
+		packet:   packet,
+		deadline: deadline,
+		released: make(chan struct{}),
+	}
+	s.heldForwards[inKey] = fwd
+
+	s.wg.Add(1)
+	go s.expireHeldForward(fwd)
+
+	if s.interceptor != nil {
+		s.interceptor(fwd.interceptedPacket())
+	}
+
+	return true
+}
+
+// expireHeldForward fails the held forward back once its deadline passes,
+// unless it was resolved in the meantime.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Switch) expireHeldForward(fwd *heldForward) {
+	defer s.wg.Done()
+
+	select {
+	case <-time.After(time.Until(fwd.deadline)):
+	case <-fwd.released:
+		return
+	case <-s.quit:
+		return
+	}
+
+	inKey := fwd.packet.inKey()
+	log.Warnf("Held forward %v timed out, failing", inKey)
+
+	var failure lnwire.FailureMessage
+	update, err := s.cfg.FetchLastChannelUpdate(fwd.packet.outgoingChanID)
+	if err != nil {
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	} else {
+		failure = lnwire.NewTemporaryChannelFailure(update)
+	}
+
+	err = s.failHeldForward(
+		inKey, NewDetailedLinkError(
+			failure, FailureDetailInterceptorTimeout,
+		),
+	)
+	if err != nil && err != ErrForwardNotHeld {
+		log.Errorf("Unable to fail held forward %v: %v", inKey, err)
+	}
+}
+
+// replayHeldResolution replays the resolution of a held forward that was
+// replayed by its incoming link. It returns true if the forward must not be
+// forwarded any further.
+//
+// NOTE: The heldMtx MUST be held when calling this method.
+func (s *Switch) replayHeldResolution(packet *htlcPacket,
+	resolution *heldResolution) bool {
+
+	inKey := packet.inKey()
+
+	switch resolution.action {
+
+	// Resumed forwards are left to the circuit map to handle.
+	case heldActionResume:
+		return false
+
+	case heldActionSettle:
+		log.Debugf("Replaying settle of held forward %v", inKey)
+
+		err := s.resolveHeldForward(packet, &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: resolution.preimage,
+		})
+		if err != nil {
+			log.Errorf("Unable to replay settle of held forward "+
+				"%v: %v", inKey, err)
+		}
+
+	case heldActionFail:
+		log.Debugf("Replaying fail of held forward %v", inKey)
+
+		err := s.resolveHeldForward(packet, &lnwire.UpdateFailHTLC{
+			Reason: resolution.reason,
+		})
+		if err != nil {
+			log.Errorf("Unable to replay fail of held forward "+
+				"%v: %v", inKey, err)
+		}
+	}
+
+	return true
+}
+
+// lookupHeldForward returns the forward identified by the given incoming
+// circuit key, if it's held.
+func (s *Switch) lookupHeldForward(inKey CircuitKey) (*heldForward, error) {
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	fwd, ok := s.heldForwards[inKey]
+	if !ok {
+		return nil, ErrForwardNotHeld
+	}
+
+	return fwd, nil
+}
+
+// releaseHeldForward stops holding the forward identified by the given
+// incoming circuit key, and returns it. The given resolution is persisted
+// first, so it can be replayed until the incoming link committed it.
+func (s *Switch) releaseHeldForward(inKey CircuitKey,
+	resolution *heldResolution) (*heldForward, error) {
+
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	fwd, ok := s.heldForwards[inKey]
+	if !ok {
+		return nil, ErrForwardNotHeld
+	}
+
+	record := &heldRecord{
+		deadline:   fwd.deadline,
+		resolution: resolution,
+	}
+	if err := s.persistHeldForward(inKey, record); err != nil {
+		return nil, err
+	}
+
+	delete(s.heldForwards, inKey)
+	s.heldRecords[inKey] = record
+	close(fwd.released)
+
+	return fwd, nil
+}
+
+// forgetHeldForwards removes the persisted resolutions of the forwards
+// identified by the given incoming circuit keys, as their incoming links
+// committed them.
+func (s *Switch) forgetHeldForwards(inKeys ...CircuitKey) error {
+	s.heldMtx.Lock()
+	defer s.heldMtx.Unlock()
+
+	var resolved []CircuitKey
+	for _, inKey := range inKeys {
+		record, ok := s.heldRecords[inKey]
+		if ok && record.resolution != nil {
+			resolved = append(resolved, inKey)
+		}
+	}
+	if len(resolved) == 0 {
+		return nil
+	}
+
+	if err := s.deleteHeldForwards(resolved...); err != nil {
+		return err
+	}
+	for _, inKey := range resolved {
+		delete(s.heldRecords, inKey)
+	}
+
+	return nil
+}
+
+// heldCircuitModifier wraps the circuit map handed to the links. Once a link
+// commits the settle or fail of an incoming HTLC, it deletes the HTLC's
+// circuit, at which point the resolution of a held forward no longer needs to
+// be replayed.
+type heldCircuitModifier struct {
+	CircuitModifier
+
+	s *Switch
+}
+
+// DeleteCircuits removes the given circuits from the circuit map, along with
+// the persisted resolutions of held forwards with the same incoming circuit
+// keys.
+//
+// NOTE: Part of the CircuitModifier interface.
+func (m *heldCircuitModifier) DeleteCircuits(inKeys ...CircuitKey) error {
+	if err := m.CircuitModifier.DeleteCircuits(inKeys...); err != nil {
+		return err
+	}
+
+	return m.s.forgetHeldForwards(inKeys...)
+}
+
+// ResumeHeldForward forwards the held forward identified by the given
+// incoming circuit key as it would have been without the interceptor.
+func (s *Switch) ResumeHeldForward(inKey CircuitKey) error {
+	fwd, err := s.releaseHeldForward(
+		inKey, &heldResolution{action: heldActionResume},
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Resuming held forward %v", inKey)
+
+	return s.forward(fwd.packet)
+}
+
+// SettleHeldForward settles the held forward identified by the given incoming
+// circuit key back to the incoming link, using the given preimage.
+func (s *Switch) SettleHeldForward(inKey CircuitKey,
+	preimage [32]byte) error {
+
+	fwd, err := s.lookupHeldForward(inKey)
+	if err != nil {
+		return err
+	}
+
+	htlc := fwd.packet.htlc.(*lnwire.UpdateAddHTLC)
+	if sha256.Sum256(preimage[:]) != htlc.PaymentHash {
+		return ErrInvalidPreimage
+	}
+
+	// We'll add the preimage to the cache before settling, so that the
+	// incoming HTLC can be claimed on-chain should the channel be force
+	// closed in the meantime.
+	if err := s.cfg.PreimageCache.AddPreimage(preimage[:]); err != nil {
+		return err
+	}
+
+	fwd, err = s.releaseHeldForward(inKey, &heldResolution{
+		action:   heldActionSettle,
+		preimage: preimage,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Settling held forward %v", inKey)
+
+	s.cfg.NotifyHtlcEvent(SettleEvent{
+		HtlcKey:       newHtlcKey(fwd.packet),
+		HtlcInfo:      newHtlcInfo(fwd.packet),
+		HtlcEventType: HtlcEventTypeForward,
+		Timestamp:     time.Now(),
+	})
+
+	return s.resolveHeldForward(fwd.packet, &lnwire.UpdateFulfillHTLC{
+		PaymentPreimage: preimage,
+	})
+}
+
+// FailHeldForward fails the held forward identified by the given incoming
+// circuit key back to the incoming link, with the given failure.
+func (s *Switch) FailHeldForward(inKey CircuitKey,
+	failure lnwire.FailureMessage) error {
+
+	return s.failHeldForward(
+		inKey, NewDetailedLinkError(failure, FailureDetailInterceptorFail),
+	)
+}
+
+// failHeldForward fails the held forward identified by the given incoming
+// circuit key back to the incoming link, with the given link error.
+func (s *Switch) failHeldForward(inKey CircuitKey, linkErr *LinkError) error {
+	fwd, err := s.lookupHeldForward(inKey)
+	if err != nil {
+		return err
+	}
+
+	reason, err := fwd.packet.obfuscator.EncryptFirstHop(
+		linkErr.WireMessage(),
+	)
+	if err != nil {
+		return err
+	}
+
+	fwd, err = s.releaseHeldForward(inKey, &heldResolution{
+		action: heldActionFail,
+		reason: reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Failing held forward %v: %v", inKey, linkErr)
+
+	s.cfg.NotifyHtlcEvent(LinkFailEvent{
+		HtlcKey:       newHtlcKey(fwd.packet),
+		HtlcInfo:      newHtlcInfo(fwd.packet),
+		HtlcEventType: HtlcEventTypeForward,
+		LinkError:     linkErr,
+		Incoming:      false,
+		Timestamp:     time.Now(),
+	})
+
+	return s.resolveHeldForward(fwd.packet, &lnwire.UpdateFailHTLC{
+		Reason: reason,
+	})
+}
+
+// resolveHeldForward delivers the settle or fail of a released forward to
+// its incoming link, as if it came back from the outgoing link.
+func (s *Switch) resolveHeldForward(packet *htlcPacket,
+	htlc lnwire.Message) error {
+
+	return s.mailOrchestrator.Deliver(packet.incomingChanID, &htlcPacket{
+		incomingChanID: packet.incomingChanID,
+		incomingHTLCID: packet.incomingHTLCID,
+		outgoingChanID: packet.outgoingChanID,
+		sourceRef:      packet.sourceRef,
+		htlc:           htlc,
+	})
+}
+
+// loadHeldForwards creates the bucket of held forwards if it doesn't exist
+// yet, and returns the records of all forwards held before a restart.
+func (s *Switch) loadHeldForwards() (map[CircuitKey]*heldRecord, error) {
+	records := make(map[CircuitKey]*heldRecord)
+	err := s.cfg.DB.Update(func(tx *bolt.Tx) error {
+		heldBkt, err := tx.CreateBucketIfNotExists(heldForwardsKey)
+		if err != nil {
+			return err
+		}
+
+		return heldBkt.ForEach(func(k, v []byte) error {
+			var inKey CircuitKey
+			if err := inKey.SetBytes(k); err != nil {
+				return err
+			}
+
+			record, err := decodeHeldRecord(v)
+			if err != nil {
+				return err
+			}
+			records[inKey] = record
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// encodeHeldRecord serializes the given record of a held forward. The
+// deadline is followed by the resolution, if there's any.
+func encodeHeldRecord(record *heldRecord) []byte {
+	var deadline [8]byte
+	binary.BigEndian.PutUint64(
+		deadline[:], uint64(record.deadline.UnixNano()),
+	)
+
+	v := deadline[:]
+	if record.resolution == nil {
+		return v
+	}
+
+	v = append(v, byte(record.resolution.action))
+	switch record.resolution.action {
+	case heldActionSettle:
+		v = append(v, record.resolution.preimage[:]...)
+	case heldActionFail:
+		v = append(v, record.resolution.reason...)
+	}
+
+	return v
+}
+
+// decodeHeldRecord deserializes the record of a held forward.
+func decodeHeldRecord(v []byte) (*heldRecord, error) {
+	if len(v) < 8 {
+		return nil, ErrCorruptedCircuitMap
+	}
+
+	record := &heldRecord{
+		deadline: time.Unix(0, int64(binary.BigEndian.Uint64(v[:8]))),
+	}
+	if len(v) == 8 {
+		return record, nil
+	}
+
+	resolution := &heldResolution{
+		action: heldAction(v[8]),
+	}
+	data := v[9:]
+	switch resolution.action {
+	case heldActionResume:
+		// Resumed forwards carry no further data.
+
+	case heldActionSettle:
+		if len(data) != len(resolution.preimage) {
+			return nil, ErrCorruptedCircuitMap
+		}
+		copy(resolution.preimage[:], data)
+
+	case heldActionFail:
+		resolution.reason = append(lnwire.OpaqueReason(nil), data...)
+
+	default:
+		return nil, ErrCorruptedCircuitMap
+	}
+	record.resolution = resolution
+
+	return record, nil
+}
+
+// persistHeldForward stores the record of the forward identified by the given
+// incoming circuit key.
+func (s *Switch) persistHeldForward(inKey CircuitKey,
+	record *heldRecord) error {
+
+	return s.cfg.DB.Update(func(tx *bolt.Tx) error {
+		heldBkt := tx.Bucket(heldForwardsKey)
+		if heldBkt == nil {
+			return ErrCorruptedCircuitMap
+		}
+
+		return heldBkt.Put(inKey.Bytes(), encodeHeldRecord(record))
+	})
+}
+
+// deleteHeldForwards removes the records of the forwards identified by the
+// given incoming circuit keys.
+func (s *Switch) deleteHeldForwards(inKeys ...CircuitKey) error {
+	return s.cfg.DB.Update(func(tx *bolt.Tx) error {
+		heldBkt := tx.Bucket(heldForwardsKey)
+		if heldBkt == nil {
+			return ErrCorruptedCircuitMap
+		}
+
+		for _, inKey := range inKeys {
+			if err := heldBkt.Delete(inKey.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
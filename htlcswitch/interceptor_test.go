@@ -0,0 +1,424 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/fastsha256"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// interceptorTestCtx holds a switch with links to alice and bob, with an
+// interceptor collecting the forwards held by the switch.
+type interceptorTestCtx struct {
+	t *testing.T
+
+	db        *channeldb.DB
+	s         *Switch
+	aliceLink *mockChannelLink
+	bobLink   *mockChannelLink
+
+	intercepted chan InterceptedPacket
+}
+
+// newInterceptorTestCtx creates a switch with links to alice and bob.
+func newInterceptorTestCtx(t *testing.T) *interceptorTestCtx {
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	c := &interceptorTestCtx{
+		t:  t,
+		db: db,
+		aliceLink: newMockChannelLink(
+			nil, chanID1, aliceChanID, alicePeer, true,
+		),
+		bobLink: newMockChannelLink(
+			nil, chanID2, bobChanID, bobPeer, true,
+		),
+	}
+	c.start()
+
+	return c
+}
+
+// start starts a new switch backed by the context's database, and adds
+// links to alice and bob to it.
+func (c *interceptorTestCtx) start() {
+	s, err := initSwitchWithDB(testStartingHeight, c.db)
+	if err != nil {
+		c.t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		c.t.Fatalf("unable to start switch: %v", err)
+	}
+
+	c.s = s
+	c.intercepted = make(chan InterceptedPacket, 10)
+	c.aliceLink = newMockChannelLink(
+		s, c.aliceLink.ChanID(), c.aliceLink.ShortChanID(),
+		c.aliceLink.peer, true,
+	)
+	c.bobLink = newMockChannelLink(
+		s, c.bobLink.ChanID(), c.bobLink.ShortChanID(),
+		c.bobLink.peer, true,
+	)
+	if err := s.AddLink(c.aliceLink); err != nil {
+		c.t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(c.bobLink); err != nil {
+		c.t.Fatalf("unable to add bob link: %v", err)
+	}
+}
+
+// restart stops the switch, and starts a new one backed by the same
+// database.
+func (c *interceptorTestCtx) restart() {
+	if err := c.s.Stop(); err != nil {
+		c.t.Fatalf("unable to stop switch: %v", err)
+	}
+
+	c.start()
+}
+
+// setInterceptor registers an interceptor with the switch that collects all
+// held forwards.
+func (c *interceptorTestCtx) setInterceptor() {
+	err := c.s.SetInterceptor(func(pkt InterceptedPacket) {
+		c.intercepted <- pkt
+	})
+	if err != nil {
+		c.t.Fatalf("unable to set interceptor: %v", err)
+	}
+}
+
+// forward forwards an htlc with the given id and payment hash from alice to
+// bob through the switch.
+func (c *interceptorTestCtx) forward(htlcID uint64, hash [32]byte) {
+	packet := &htlcPacket{
+		incomingChanID:  c.aliceLink.ShortChanID(),
+		incomingHTLCID:  htlcID,
+		outgoingChanID:  c.bobLink.ShortChanID(),
+		incomingAmount:  2000,
+		amount:          1000,
+		incomingTimeout: 150,
+		outgoingTimeout: 140,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: hash,
+			Amount:      1000,
+		},
+	}
+
+	for err := range c.s.ForwardPackets(nil, packet) {
+		if err != nil {
+			c.t.Fatalf("unable to forward packet: %v", err)
+		}
+	}
+}
+
+// assertIntercepted asserts that the forward with the given incoming circuit
+// key was handed to the interceptor, and returns it.
+func (c *interceptorTestCtx) assertIntercepted(
+	inKey CircuitKey) InterceptedPacket {
+
+	select {
+	case pkt := <-c.intercepted:
+		if pkt.IncomingCircuit != inKey {
+			c.t.Fatalf("expected forward %v to be intercepted, "+
+				"got %v", inKey, pkt.IncomingCircuit)
+		}
+		return pkt
+
+	case <-time.After(time.Second):
+		c.t.Fatalf("forward %v not intercepted", inKey)
+	}
+
+	return InterceptedPacket{}
+}
+
+// assertNoPacket asserts that the given link didn't receive any packet.
+func (c *interceptorTestCtx) assertNoPacket(link *mockChannelLink) {
+	select {
+	case pkt := <-link.packets:
+		c.t.Fatalf("unexpected packet: %v", pkt.htlc)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// assertPacket asserts that the given link received a packet, and returns it.
+func (c *interceptorTestCtx) assertPacket(link *mockChannelLink) *htlcPacket {
+	select {
+	case pkt := <-link.packets:
+		return pkt
+	case <-time.After(time.Second):
+		c.t.Fatalf("packet not received")
+	}
+
+	return nil
+}
+
+// TestSwitchForwardInterceptor checks that forwards are held by the switch
+// while an interceptor is registered, and can then be resumed, settled or
+// failed.
+func TestSwitchForwardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// Without an interceptor, forwards go straight to bob.
+	ctx.forward(0, rhash)
+	ctx.assertPacket(ctx.bobLink)
+
+	ctx.setInterceptor()
+
+	// Only a single interceptor can be registered.
+	err = ctx.s.SetInterceptor(func(InterceptedPacket) {})
+	if err != ErrInterceptorExists {
+		t.Fatalf("expected ErrInterceptorExists, got %v", err)
+	}
+
+	// The next forward should be held, without a circuit.
+	inKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 1}
+	ctx.forward(1, rhash)
+	pkt := ctx.assertIntercepted(inKey)
+	ctx.assertNoPacket(ctx.bobLink)
+
+	if pkt.OutgoingChanID != ctx.bobLink.ShortChanID() ||
+		pkt.Hash != rhash || pkt.IncomingAmount != 2000 ||
+		pkt.OutgoingAmount != 1000 || pkt.IncomingExpiry != 150 ||
+		pkt.OutgoingExpiry != 140 {
+
+		t.Fatalf("unexpected intercepted packet: %v", pkt)
+	}
+	if ctx.s.circuits.LookupCircuit(inKey) != nil {
+		t.Fatalf("held forward shouldn't have a circuit")
+	}
+
+	// A replay of the held forward should be dropped.
+	ctx.forward(1, rhash)
+	select {
+	case pkt := <-ctx.intercepted:
+		t.Fatalf("replay intercepted: %v", pkt.IncomingCircuit)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Resuming the forward should send it on to bob.
+	if err := ctx.s.ResumeHeldForward(inKey); err != nil {
+		t.Fatalf("unable to resume held forward: %v", err)
+	}
+	ctx.assertPacket(ctx.bobLink)
+
+	if err := ctx.s.ResumeHeldForward(inKey); err != ErrForwardNotHeld {
+		t.Fatalf("expected ErrForwardNotHeld, got %v", err)
+	}
+
+	// A held forward can be settled back to alice with the preimage.
+	inKey.HtlcID = 2
+	ctx.forward(2, rhash)
+	ctx.assertIntercepted(inKey)
+
+	err = ctx.s.SettleHeldForward(inKey, [32]byte{1})
+	if err != ErrInvalidPreimage {
+		t.Fatalf("expected ErrInvalidPreimage, got %v", err)
+	}
+	if err := ctx.s.SettleHeldForward(inKey, preimage); err != nil {
+		t.Fatalf("unable to settle held forward: %v", err)
+	}
+
+	settle := ctx.assertPacket(ctx.aliceLink)
+	fulfill, ok := settle.htlc.(*lnwire.UpdateFulfillHTLC)
+	if !ok {
+		t.Fatalf("expected settle, got %T", settle.htlc)
+	}
+	if fulfill.PaymentPreimage != preimage {
+		t.Fatalf("settled with wrong preimage")
+	}
+	if settle.incomingHTLCID != 2 {
+		t.Fatalf("settled wrong htlc %v", settle.incomingHTLCID)
+	}
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// A held forward can be failed back to alice.
+	inKey.HtlcID = 3
+	ctx.forward(3, rhash)
+	ctx.assertIntercepted(inKey)
+
+	err = ctx.s.FailHeldForward(inKey, &lnwire.FailUnknownNextPeer{})
+	if err != nil {
+		t.Fatalf("unable to fail held forward: %v", err)
+	}
+
+	fail := ctx.assertPacket(ctx.aliceLink)
+	if _, ok := fail.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatalf("expected fail, got %T", fail.htlc)
+	}
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// Once the interceptor is removed, forwards are no longer held.
+	ctx.s.RemoveInterceptor()
+	ctx.forward(4, rhash)
+	ctx.assertPacket(ctx.bobLink)
+}
+
+// TestSwitchForwardInterceptorTimeout checks that held forwards are failed
+// back once their deadline passes.
+func TestSwitchForwardInterceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	ctx.s.cfg.InterceptorTimeout = 100 * time.Millisecond
+	ctx.setInterceptor()
+
+	inKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 0}
+	ctx.forward(0, [32]byte{})
+	ctx.assertIntercepted(inKey)
+
+	fail := ctx.assertPacket(ctx.aliceLink)
+	if _, ok := fail.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatalf("expected fail, got %T", fail.htlc)
+	}
+
+	if err := ctx.s.ResumeHeldForward(inKey); err != ErrForwardNotHeld {
+		t.Fatalf("expected ErrForwardNotHeld, got %v", err)
+	}
+}
+
+// TestSwitchForwardInterceptorRestart checks that forwards held before a
+// restart are held again once they're replayed, even before an interceptor is
+// registered, and keep their original deadline.
+func TestSwitchForwardInterceptorRestart(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer func() {
+		ctx.s.Stop()
+	}()
+
+	ctx.setInterceptor()
+
+	inKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 0}
+	ctx.forward(0, [32]byte{})
+	pkt := ctx.assertIntercepted(inKey)
+
+	// Restart the switch, and replay the forward without an interceptor.
+	ctx.restart()
+
+	ctx.forward(0, [32]byte{})
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// Once the interceptor is registered, it should be handed the held
+	// forward with its original deadline.
+	ctx.setInterceptor()
+	restored := ctx.assertIntercepted(inKey)
+	if !restored.Deadline.Equal(pkt.Deadline) {
+		t.Fatalf("expected deadline %v, got %v", pkt.Deadline,
+			restored.Deadline)
+	}
+
+	if err := ctx.s.ResumeHeldForward(inKey); err != nil {
+		t.Fatalf("unable to resume held forward: %v", err)
+	}
+	ctx.assertPacket(ctx.bobLink)
+}
+
+// TestSwitchForwardInterceptorRestartResolved checks that the resolution of a
+// held forward is replayed if its incoming link replays the forward after a
+// restart, until the incoming link committed the resolution.
+func TestSwitchForwardInterceptorRestartResolved(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer func() {
+		ctx.s.Stop()
+	}()
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	ctx.setInterceptor()
+
+	// Hold two forwards, settling the first and failing the second.
+	settleKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 0}
+	ctx.forward(0, rhash)
+	ctx.assertIntercepted(settleKey)
+	if err := ctx.s.SettleHeldForward(settleKey, preimage); err != nil {
+		t.Fatalf("unable to settle held forward: %v", err)
+	}
+	ctx.assertPacket(ctx.aliceLink)
+
+	failKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 1}
+	ctx.forward(1, rhash)
+	ctx.assertIntercepted(failKey)
+	err = ctx.s.FailHeldForward(failKey, &lnwire.FailUnknownNextPeer{})
+	if err != nil {
+		t.Fatalf("unable to fail held forward: %v", err)
+	}
+	fail := ctx.assertPacket(ctx.aliceLink)
+	reason := fail.htlc.(*lnwire.UpdateFailHTLC).Reason
+
+	// Restart the switch before alice committed either resolution. Once
+	// she replays the forwards, the resolutions should be replayed, even
+	// without an interceptor.
+	ctx.restart()
+
+	ctx.forward(0, rhash)
+	settle := ctx.assertPacket(ctx.aliceLink)
+	fulfill, ok := settle.htlc.(*lnwire.UpdateFulfillHTLC)
+	if !ok {
+		t.Fatalf("expected settle, got %T", settle.htlc)
+	}
+	if fulfill.PaymentPreimage != preimage {
+		t.Fatalf("settled with wrong preimage")
+	}
+
+	ctx.forward(1, rhash)
+	fail = ctx.assertPacket(ctx.aliceLink)
+	replayed, ok := fail.htlc.(*lnwire.UpdateFailHTLC)
+	if !ok {
+		t.Fatalf("expected fail, got %T", fail.htlc)
+	}
+	if !bytes.Equal(replayed.Reason, reason) {
+		t.Fatalf("failed with wrong reason")
+	}
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// Once alice committed the settle, it's no longer replayed, so a
+	// replay of the forward after a restart is forwarded as usual.
+	err = ctx.s.CircuitModifier().DeleteCircuits(settleKey)
+	if err != nil {
+		t.Fatalf("unable to delete circuit: %v", err)
+	}
+
+	ctx.restart()
+
+	ctx.forward(0, rhash)
+	ctx.assertPacket(ctx.bobLink)
+	ctx.assertNoPacket(ctx.aliceLink)
+}
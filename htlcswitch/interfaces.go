@@ -2,6 +2,7 @@ package htlcswitch
 
 import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -62,6 +63,9 @@ type ChannelLink interface {
 	// is a more compact representation of a channel's full outpoint.
 	ChanID() lnwire.ChannelID
 
+	// ChannelPoint returns the funding outpoint of the channel link.
+	ChannelPoint() *wire.OutPoint
+
 	// ShortChanID returns the short channel ID for the channel link. The
 	// short channel ID encodes the exact location in the main chain that
 	// the original funding output can be found.
@@ -73,11 +77,27 @@ type ChannelLink interface {
 	// transaction changes location within the chain.
 	UpdateShortChanID() (lnwire.ShortChannelID, error)
 
+	// RealShortChanID returns the short channel ID pointing to the funding
+	// output of the channel within the chain. For zero-conf channels,
+	// whose links are identified by an alias, this is zero until the
+	// funding transaction has confirmed.
+	RealShortChanID() lnwire.ShortChannelID
+
+	// PeerAliasShortChanID returns the alias short channel ID the remote
+	// peer knows a zero-conf channel by, or zero if it hasn't told us
+	// about one.
+	PeerAliasShortChanID() lnwire.ShortChannelID
+
 	// UpdateForwardingPolicy updates the forwarding policy for the target
 	// ChannelLink. Once updated, the link will use the new forwarding
 	// policy to govern if it an incoming HTLC should be forwarded or not.
 	UpdateForwardingPolicy(ForwardingPolicy)
 
+	// SetForwardingPolicy replaces the forwarding policy of the target
+	// ChannelLink. Unlike UpdateForwardingPolicy, zero fields of the new
+	// policy are applied as well.
+	SetForwardingPolicy(ForwardingPolicy)
+
 	// HtlcSatifiesPolicy should return a nil error if the passed HTLC
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a valid protocol failure message should be returned in
@@ -99,6 +119,11 @@ type ChannelLink interface {
 	// total sent/received milli-satoshis.
 	Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 
+	// CommitStats returns the metrics of the commitments signed by the
+	// link, such as its commit and revocation latencies, and the interval
+	// it currently batches updates for.
+	CommitStats() CommitStats
+
 	// Peer returns the representation of remote peer with which we have
 	// the channel link opened.
 	Peer() lnpeer.Peer
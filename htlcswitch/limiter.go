@@ -0,0 +1,471 @@
+package htlcswitch
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/time/rate"
+)
+
+// ErrInvalidLimitMode is returned when forwarding limits are set with an
+// unknown limit mode.
+var ErrInvalidLimitMode = errors.New("invalid forwarding limit mode")
+
+// LimitMode determines what happens to a forward that exceeds the limits of
+// its incoming channel or peer.
+type LimitMode uint8
+
+const (
+	// LimitModeFail fails forwards that exceed the limits straight back to
+	// the incoming channel.
+	LimitModeFail LimitMode = iota
+
+	// LimitModeQueue queues forwards that exceed the limits until they can
+	// be admitted, failing them back if this takes longer than the queue
+	// timeout, or the queue of the incoming peer is full.
+	LimitModeQueue
+)
+
+// String returns a human readable version of the limit mode.
+func (m LimitMode) String() string {
+	switch m {
+	case LimitModeFail:
+		return "fail"
+
+	case LimitModeQueue:
+		return "queue"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ForwardingLimits bounds the htlcs that a single incoming channel or peer may
+// have in flight through our node, and the rate at which a peer may offer new
+// forwards. A zero value for any of the limits disables it.
+type ForwardingLimits struct {
+	// MaxChannelHtlcs is the maximum number of forwards that may be in
+	// flight from a single incoming channel.
+	MaxChannelHtlcs uint32
+
+	// MaxChannelAmount is the maximum total value of the forwards that may
+	// be in flight from a single incoming channel.
+	MaxChannelAmount lnwire.MilliSatoshi
+
+	// MaxPeerHtlcs is the maximum number of forwards that may be in flight
+	// from all channels with a single peer.
+	MaxPeerHtlcs uint32
+
+	// MaxPeerAmount is the maximum total value of the forwards that may be
+	// in flight from all channels with a single peer.
+	MaxPeerAmount lnwire.MilliSatoshi
+
+	// PeerRate is the number of new forwards per second a single peer may
+	// offer us on average.
+	PeerRate float64
+
+	// PeerBurst is the number of new forwards a single peer may offer us
+	// at once, above PeerRate. It's raised to one if PeerRate is set.
+	PeerBurst uint32
+
+	// Mode determines whether forwards exceeding the limits are failed
+	// back, or queued until they can be admitted.
+	Mode LimitMode
+
+	// QueueTimeout is the maximum amount of time a forward is queued for
+	// before it's failed back.
+	QueueTimeout time.Duration
+
+	// MaxQueued is the maximum number of forwards that may be queued for a
+	// single peer. Forwards that exceed the limits while the queue is full
+	// are failed back.
+	MaxQueued uint32
+}
+
+// validate checks that the forwarding limits are sane.
+func (l *ForwardingLimits) validate() error {
+	switch {
+	case l.Mode != LimitModeFail && l.Mode != LimitModeQueue:
+		return ErrInvalidLimitMode
+
+	case l.PeerRate < 0:
+		return errors.New("peer rate must not be negative")
+
+	case l.Mode == LimitModeQueue && l.QueueTimeout <= 0:
+		return errors.New("queue timeout must be positive in queue mode")
+	}
+
+	return nil
+}
+
+// rateLimit returns the token bucket parameters for the peer rate limit.
+func (l *ForwardingLimits) rateLimit() (rate.Limit, int) {
+	if l.PeerRate == 0 {
+		return rate.Inf, 0
+	}
+
+	burst := int(l.PeerBurst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return rate.Limit(l.PeerRate), burst
+}
+
+// LimitUsage reports the forwards in flight from an incoming channel or peer,
+// along with running totals of how its forwards were handled by the limiter.
+type LimitUsage struct {
+	// PendingHtlcs is the number of admitted forwards in flight.
+	PendingHtlcs uint32
+
+	// PendingAmount is the total value of the admitted forwards in
+	// flight.
+	PendingAmount lnwire.MilliSatoshi
+
+	// Queued is the number of forwards currently waiting to be admitted.
+	Queued uint32
+
+	// Admitted is the total number of forwards admitted.
+	Admitted uint64
+
+	// Rejected is the total number of forwards failed back by the
+	// limiter, including those that timed out while queued.
+	Rejected uint64
+
+	// RateLimited is the total number of forwards that exceeded the peer
+	// rate limit.
+	RateLimited uint64
+}
+
+// limitResult is the outcome of checking a forward against the limiter.
+type limitResult uint8
+
+const (
+	// limitAdmitted indicates that the forward may proceed.
+	limitAdmitted limitResult = iota
+
+	// limitQueued indicates that the forward has been queued until it can
+	// be admitted.
+	limitQueued
+
+	// limitRejected indicates that the forward should be failed back.
+	limitRejected
+)
+
+// limitReservation records the incoming channel, peer and amount of an
+// admitted forward, so they can be released once it's resolved.
+type limitReservation struct {
+	chanID lnwire.ShortChannelID
+	peer   [33]byte
+	amount lnwire.MilliSatoshi
+}
+
+// queuedForward is a forward waiting to be admitted by the limiter.
+type queuedForward struct {
+	packet   *htlcPacket
+	chanID   lnwire.ShortChannelID
+	deadline time.Time
+}
+
+// peerLimitState is the limiter's state for a single incoming peer.
+type peerLimitState struct {
+	usage  LimitUsage
+	bucket *rate.Limiter
+	queue  []*queuedForward
+}
+
+// forwardLimiter tracks the forwards in flight from each incoming channel and
+// peer, and decides whether new forwards are admitted, queued or rejected
+// according to the current ForwardingLimits.
+//
+// NOTE: Forwards are tracked from the time they're admitted, so forwards
+// that were already in flight when the switch started aren't counted.
+type forwardLimiter struct {
+	mu sync.Mutex
+
+	limits ForwardingLimits
+
+	reservations map[CircuitKey]*limitReservation
+	channels     map[lnwire.ShortChannelID]*LimitUsage
+	peers        map[[33]byte]*peerLimitState
+
+	// numQueued is the number of forwards queued across all peers.
+	numQueued int
+
+	now func() time.Time
+}
+
+// newForwardLimiter creates a new forward limiter with the given limits.
+func newForwardLimiter(limits ForwardingLimits) *forwardLimiter {
+	return &forwardLimiter{
+		limits:       limits,
+		reservations: make(map[CircuitKey]*limitReservation),
+		channels:     make(map[lnwire.ShortChannelID]*LimitUsage),
+		peers:        make(map[[33]byte]*peerLimitState),
+		now:          time.Now,
+	}
+}
+
+// Limits returns the current forwarding limits.
+func (f *forwardLimiter) Limits() ForwardingLimits {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.limits
+}
+
+// SetLimits replaces the forwarding limits, applying them to all forwards
+// checked from now on. Forwards already in flight are unaffected, while the
+// rate limit of each peer starts over with a full burst.
+func (f *forwardLimiter) SetLimits(limits ForwardingLimits) error {
+	if err := limits.validate(); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.limits = limits
+
+	limit, burst := limits.rateLimit()
+	for _, peer := range f.peers {
+		peer.bucket = rate.NewLimiter(limit, burst)
+	}
+
+	return nil
+}
+
+// Usage returns a snapshot of the usage of each incoming channel and peer.
+func (f *forwardLimiter) Usage() (map[lnwire.ShortChannelID]LimitUsage,
+	map[[33]byte]LimitUsage) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	channels := make(map[lnwire.ShortChannelID]LimitUsage, len(f.channels))
+	for chanID, usage := range f.channels {
+		channels[chanID] = *usage
+	}
+
+	peers := make(map[[33]byte]LimitUsage, len(f.peers))
+	for peer, state := range f.peers {
+		peers[peer] = state.usage
+	}
+
+	return channels, peers
+}
+
+// admit checks the given forward from the given peer against the limits. If
+// it's admitted, its value is reserved until release is called for it. If the
+// limits are exceeded, the forward is either queued or rejected, depending on
+// the limit mode, and the returned detail indicates which limit was hit.
+func (f *forwardLimiter) admit(pkt *htlcPacket,
+	peer [33]byte) (limitResult, FailureDetail) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Forwards that were already admitted, such as queued forwards being
+	// handled again, may proceed.
+	if _, ok := f.reservations[pkt.inKey()]; ok {
+		return limitAdmitted, FailureDetailNone
+	}
+
+	chanUsage := f.channelUsage(pkt.incomingChanID)
+	peerState := f.peerState(peer)
+	now := f.now()
+
+	// To keep queued forwards in order, new forwards from a peer with
+	// queued forwards are queued behind them.
+	detail := FailureDetailPeerLimit
+	if len(peerState.queue) == 0 {
+		detail = f.tryReserve(pkt, pkt.incomingChanID, peer, now)
+		if detail == FailureDetailNone {
+			return limitAdmitted, FailureDetailNone
+		}
+	}
+
+	if f.limits.Mode == LimitModeQueue && (f.limits.MaxQueued == 0 ||
+		uint32(len(peerState.queue)) < f.limits.MaxQueued) {
+
+		peerState.queue = append(peerState.queue, &queuedForward{
+			packet:   pkt,
+			chanID:   pkt.incomingChanID,
+			deadline: now.Add(f.limits.QueueTimeout),
+		})
+		peerState.usage.Queued++
+		chanUsage.Queued++
+		f.numQueued++
+
+		return limitQueued, detail
+	}
+
+	peerState.usage.Rejected++
+	chanUsage.Rejected++
+
+	return limitRejected, detail
+}
+
+// tryReserve reserves the value of the forward if it's within all limits,
+// returning FailureDetailNone. Otherwise, the detail of the first limit it
+// exceeds is returned.
+//
+// NOTE: This method MUST be called with the limiter's mutex held.
+func (f *forwardLimiter) tryReserve(pkt *htlcPacket,
+	chanID lnwire.ShortChannelID, peer [33]byte,
+	now time.Time) FailureDetail {
+
+	chanUsage := f.channelUsage(chanID)
+	peerState := f.peerState(peer)
+	amt := pkt.incomingAmount
+
+	switch {
+	case f.limits.MaxChannelHtlcs != 0 &&
+		chanUsage.PendingHtlcs >= f.limits.MaxChannelHtlcs:
+		return FailureDetailChannelLimit
+
+	case f.limits.MaxChannelAmount != 0 &&
+		chanUsage.PendingAmount+amt > f.limits.MaxChannelAmount:
+		return FailureDetailChannelLimit
+
+	case f.limits.MaxPeerHtlcs != 0 &&
+		peerState.usage.PendingHtlcs >= f.limits.MaxPeerHtlcs:
+		return FailureDetailPeerLimit
+
+	case f.limits.MaxPeerAmount != 0 &&
+		peerState.usage.PendingAmount+amt > f.limits.MaxPeerAmount:
+		return FailureDetailPeerLimit
+	}
+
+	// Only consume a token once the forward is known to be within the
+	// in-flight limits, so that rejected forwards don't count towards the
+	// peer's rate.
+	if !peerState.bucket.AllowN(now, 1) {
+		peerState.usage.RateLimited++
+		chanUsage.RateLimited++
+		return FailureDetailRateLimit
+	}
+
+	f.reservations[pkt.inKey()] = &limitReservation{
+		chanID: chanID,
+		peer:   peer,
+		amount: amt,
+	}
+
+	chanUsage.PendingHtlcs++
+	chanUsage.PendingAmount += amt
+	chanUsage.Admitted++
+	peerState.usage.PendingHtlcs++
+	peerState.usage.PendingAmount += amt
+	peerState.usage.Admitted++
+
+	return FailureDetailNone
+}
+
+// release frees the value reserved for the forward with the given incoming
+// circuit key, if it was admitted by the limiter.
+func (f *forwardLimiter) release(inKey CircuitKey) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.reservations[inKey]
+	if !ok {
+		return
+	}
+	delete(f.reservations, inKey)
+
+	chanUsage := f.channelUsage(res.chanID)
+	chanUsage.PendingHtlcs--
+	chanUsage.PendingAmount -= res.amount
+
+	peerState := f.peerState(res.peer)
+	peerState.usage.PendingHtlcs--
+	peerState.usage.PendingAmount -= res.amount
+}
+
+// dequeue goes through the queue of each peer in order, and returns the
+// queued forwards that have now been admitted, along with those whose
+// deadline has passed. The latter are counted as rejected.
+func (f *forwardLimiter) dequeue() ([]*htlcPacket, []*htlcPacket) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var admitted, expired []*htlcPacket
+
+	now := f.now()
+nextPeer:
+	for peer, state := range f.peers {
+		for len(state.queue) > 0 {
+			queued := state.queue[0]
+			chanUsage := f.channelUsage(queued.chanID)
+
+			switch {
+			case !now.Before(queued.deadline):
+				expired = append(expired, queued.packet)
+				state.usage.Rejected++
+				chanUsage.Rejected++
+
+			case f.tryReserve(
+				queued.packet, queued.chanID, peer, now,
+			) == FailureDetailNone:
+				admitted = append(admitted, queued.packet)
+
+			default:
+				// The head of the queue still can't be
+				// admitted, so neither can the forwards behind
+				// it.
+				continue nextPeer
+			}
+
+			state.queue = state.queue[1:]
+			state.usage.Queued--
+			chanUsage.Queued--
+			f.numQueued--
+		}
+	}
+
+	return admitted, expired
+}
+
+// hasQueued returns true if any forwards are waiting to be admitted.
+func (f *forwardLimiter) hasQueued() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.numQueued > 0
+}
+
+// channelUsage returns the usage of the given incoming channel, creating it
+// if needed.
+//
+// NOTE: This method MUST be called with the limiter's mutex held.
+func (f *forwardLimiter) channelUsage(
+	chanID lnwire.ShortChannelID) *LimitUsage {
+
+	usage, ok := f.channels[chanID]
+	if !ok {
+		usage = &LimitUsage{}
+		f.channels[chanID] = usage
+	}
+
+	return usage
+}
+
+// peerState returns the state of the given incoming peer, creating it if
+// needed.
+//
+// NOTE: This method MUST be called with the limiter's mutex held.
+func (f *forwardLimiter) peerState(peer [33]byte) *peerLimitState {
+	state, ok := f.peers[peer]
+	if !ok {
+		limit, burst := f.limits.rateLimit()
+		state = &peerLimitState{
+			bucket: rate.NewLimiter(limit, burst),
+		}
+		f.peers[peer] = state
+	}
+
+	return state
+}
@@ -0,0 +1,383 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+var (
+	limitTestPeerA = [33]byte{1}
+	limitTestPeerB = [33]byte{2}
+)
+
+// newLimitTestPacket returns a forward received over the given channel with
+// the given htlc id and incoming amount.
+func newLimitTestPacket(chanID uint64, htlcID uint64,
+	amt lnwire.MilliSatoshi) *htlcPacket {
+
+	return &htlcPacket{
+		incomingChanID: lnwire.NewShortChanIDFromInt(chanID),
+		incomingHTLCID: htlcID,
+		incomingAmount: amt,
+		htlc:           &lnwire.UpdateAddHTLC{},
+	}
+}
+
+// assertAdmit checks the given forward against the limiter, asserting the
+// result and failure detail.
+func assertAdmit(t *testing.T, l *forwardLimiter, pkt *htlcPacket,
+	peer [33]byte, expResult limitResult, expDetail FailureDetail) {
+
+	t.Helper()
+
+	result, detail := l.admit(pkt, peer)
+	if result != expResult {
+		t.Fatalf("expected result %v, got %v", expResult, result)
+	}
+	if result != limitAdmitted && detail != expDetail {
+		t.Fatalf("expected detail %v, got %v", expDetail, detail)
+	}
+}
+
+// TestForwardLimiterInFlight asserts that the limiter enforces the limits on
+// the number and value of forwards in flight from each incoming channel and
+// peer, and that released forwards free up their reservation.
+func TestForwardLimiterInFlight(t *testing.T) {
+	t.Parallel()
+
+	l := newForwardLimiter(ForwardingLimits{
+		MaxChannelHtlcs:  2,
+		MaxChannelAmount: 5000,
+		MaxPeerHtlcs:     3,
+		MaxPeerAmount:    6000,
+	})
+
+	// Two forwards over the first channel fill up its htlc limit, so a
+	// third is rejected.
+	pkt1 := newLimitTestPacket(1, 0, 1000)
+	pkt2 := newLimitTestPacket(1, 1, 1000)
+	pkt3 := newLimitTestPacket(1, 2, 1000)
+	assertAdmit(t, l, pkt1, limitTestPeerA, limitAdmitted, 0)
+	assertAdmit(t, l, pkt2, limitTestPeerA, limitAdmitted, 0)
+	assertAdmit(
+		t, l, pkt3, limitTestPeerA, limitRejected,
+		FailureDetailChannelLimit,
+	)
+
+	// Checking an admitted forward again should not reserve it twice.
+	assertAdmit(t, l, pkt1, limitTestPeerA, limitAdmitted, 0)
+
+	// A forward over another channel of the same peer is bound by the
+	// peer's value limit.
+	assertAdmit(
+		t, l, newLimitTestPacket(2, 0, 4500), limitTestPeerA,
+		limitRejected, FailureDetailPeerLimit,
+	)
+	assertAdmit(
+		t, l, newLimitTestPacket(2, 1, 4000), limitTestPeerA,
+		limitAdmitted, 0,
+	)
+
+	// The peer's htlc limit is now reached, while other peers remain
+	// unaffected.
+	assertAdmit(
+		t, l, newLimitTestPacket(2, 2, 1), limitTestPeerA,
+		limitRejected, FailureDetailPeerLimit,
+	)
+	assertAdmit(
+		t, l, newLimitTestPacket(3, 0, 5000), limitTestPeerB,
+		limitAdmitted, 0,
+	)
+
+	// The channel's value limit applies as well.
+	assertAdmit(
+		t, l, newLimitTestPacket(3, 1, 1), limitTestPeerB,
+		limitRejected, FailureDetailChannelLimit,
+	)
+
+	// Once the first forward is released, there's room for another
+	// forward over the first channel.
+	l.release(pkt1.inKey())
+	l.release(pkt1.inKey())
+	assertAdmit(t, l, pkt3, limitTestPeerA, limitAdmitted, 0)
+
+	channels, peers := l.Usage()
+	chanUsage := channels[lnwire.NewShortChanIDFromInt(1)]
+	if chanUsage.PendingHtlcs != 2 || chanUsage.PendingAmount != 2000 ||
+		chanUsage.Admitted != 3 || chanUsage.Rejected != 1 {
+
+		t.Fatalf("unexpected channel usage: %+v", chanUsage)
+	}
+	peerUsage := peers[limitTestPeerA]
+	if peerUsage.PendingHtlcs != 3 || peerUsage.PendingAmount != 6000 ||
+		peerUsage.Admitted != 4 || peerUsage.Rejected != 3 {
+
+		t.Fatalf("unexpected peer usage: %+v", peerUsage)
+	}
+}
+
+// TestForwardLimiterRate asserts that the limiter applies the token bucket
+// rate limit to new forwards from each peer.
+func TestForwardLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	l := newForwardLimiter(ForwardingLimits{
+		PeerRate:  1,
+		PeerBurst: 2,
+	})
+	l.now = func() time.Time {
+		return now
+	}
+
+	// The peer may offer its full burst at once, after which further
+	// forwards are rate limited.
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 0, 1), limitTestPeerA,
+		limitAdmitted, 0,
+	)
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 1, 1), limitTestPeerA,
+		limitAdmitted, 0,
+	)
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 2, 1), limitTestPeerA,
+		limitRejected, FailureDetailRateLimit,
+	)
+
+	// Other peers have a bucket of their own.
+	assertAdmit(
+		t, l, newLimitTestPacket(2, 0, 1), limitTestPeerB,
+		limitAdmitted, 0,
+	)
+
+	// After a second, a single new token is available.
+	now = now.Add(time.Second)
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 2, 1), limitTestPeerA,
+		limitAdmitted, 0,
+	)
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 3, 1), limitTestPeerA,
+		limitRejected, FailureDetailRateLimit,
+	)
+
+	// Lifting the rate limit admits forwards straight away.
+	if err := l.SetLimits(ForwardingLimits{}); err != nil {
+		t.Fatalf("unable to set limits: %v", err)
+	}
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 3, 1), limitTestPeerA,
+		limitAdmitted, 0,
+	)
+
+	_, peers := l.Usage()
+	if peers[limitTestPeerA].RateLimited != 2 {
+		t.Fatalf("expected 2 rate limited forwards, got %v",
+			peers[limitTestPeerA].RateLimited)
+	}
+}
+
+// TestForwardLimiterQueue asserts that forwards exceeding the limits in queue
+// mode are queued, and admitted in order once there's room for them, or
+// expired once their queue timeout passes.
+func TestForwardLimiterQueue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	l := newForwardLimiter(ForwardingLimits{
+		MaxPeerHtlcs: 1,
+		Mode:         LimitModeQueue,
+		QueueTimeout: 10 * time.Second,
+		MaxQueued:    2,
+	})
+	l.now = func() time.Time {
+		return now
+	}
+
+	pkt1 := newLimitTestPacket(1, 0, 1)
+	pkt2 := newLimitTestPacket(1, 1, 1)
+	pkt3 := newLimitTestPacket(1, 2, 1)
+	assertAdmit(t, l, pkt1, limitTestPeerA, limitAdmitted, 0)
+	assertAdmit(
+		t, l, pkt2, limitTestPeerA, limitQueued,
+		FailureDetailPeerLimit,
+	)
+
+	now = now.Add(5 * time.Second)
+	assertAdmit(
+		t, l, pkt3, limitTestPeerA, limitQueued,
+		FailureDetailPeerLimit,
+	)
+
+	// The queue of the peer is now full, so further forwards are
+	// rejected.
+	assertAdmit(
+		t, l, newLimitTestPacket(1, 3, 1), limitTestPeerA,
+		limitRejected, FailureDetailPeerLimit,
+	)
+	if !l.hasQueued() {
+		t.Fatalf("expected queued forwards")
+	}
+
+	// Nothing can be dequeued while the first forward is in flight.
+	admitted, expired := l.dequeue()
+	if len(admitted) != 0 || len(expired) != 0 {
+		t.Fatalf("expected no dequeued forwards, got %v admitted "+
+			"and %v expired", len(admitted), len(expired))
+	}
+
+	// Once it's released, the head of the queue is admitted.
+	l.release(pkt1.inKey())
+	admitted, expired = l.dequeue()
+	if len(admitted) != 1 || admitted[0] != pkt2 || len(expired) != 0 {
+		t.Fatalf("expected second forward to be admitted")
+	}
+
+	// Handling the admitted forward again should find its reservation.
+	assertAdmit(t, l, pkt2, limitTestPeerA, limitAdmitted, 0)
+
+	// The third forward expires once its queue timeout has passed.
+	now = now.Add(10 * time.Second)
+	admitted, expired = l.dequeue()
+	if len(admitted) != 0 || len(expired) != 1 || expired[0] != pkt3 {
+		t.Fatalf("expected third forward to expire")
+	}
+	if l.hasQueued() {
+		t.Fatalf("expected no queued forwards")
+	}
+
+	_, peers := l.Usage()
+	peerUsage := peers[limitTestPeerA]
+	if peerUsage.Queued != 0 || peerUsage.Admitted != 2 ||
+		peerUsage.Rejected != 2 {
+
+		t.Fatalf("unexpected peer usage: %+v", peerUsage)
+	}
+}
+
+// TestForwardLimiterValidate asserts that invalid limits are rejected.
+func TestForwardLimiterValidate(t *testing.T) {
+	t.Parallel()
+
+	l := newForwardLimiter(ForwardingLimits{})
+
+	invalid := []ForwardingLimits{
+		{Mode: LimitMode(2)},
+		{PeerRate: -1},
+		{Mode: LimitModeQueue},
+	}
+	for _, limits := range invalid {
+		if err := l.SetLimits(limits); err == nil {
+			t.Fatalf("expected limits %+v to be rejected", limits)
+		}
+	}
+
+	limits := ForwardingLimits{
+		MaxChannelHtlcs: 10,
+		Mode:            LimitModeQueue,
+		QueueTimeout:    time.Second,
+	}
+	if err := l.SetLimits(limits); err != nil {
+		t.Fatalf("unable to set limits: %v", err)
+	}
+	if l.Limits() != limits {
+		t.Fatalf("expected limits %+v, got %+v", limits, l.Limits())
+	}
+}
+
+// TestSwitchForwardingLimits asserts that the switch fails back forwards that
+// exceed the limits of their incoming channel, and admits queued forwards once
+// earlier forwards are resolved.
+func TestSwitchForwardingLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	err := ctx.s.UpdateForwardingLimits(ForwardingLimits{
+		MaxChannelHtlcs: 1,
+	})
+	if err != nil {
+		t.Fatalf("unable to update limits: %v", err)
+	}
+
+	// The first forward is admitted, while the second exceeds the limit
+	// of alice's channel, and is failed back.
+	ctx.forward(0, [32]byte{})
+	ctx.assertPacket(ctx.bobLink)
+
+	packet := &htlcPacket{
+		incomingChanID: ctx.aliceLink.ShortChanID(),
+		incomingHTLCID: 1,
+		outgoingChanID: ctx.bobLink.ShortChanID(),
+		incomingAmount: 2000,
+		amount:         1000,
+		obfuscator:     NewMockObfuscator(),
+		htlc:           &lnwire.UpdateAddHTLC{Amount: 1000},
+	}
+	for range ctx.s.ForwardPackets(nil, packet) {
+	}
+	failPkt := ctx.assertPacket(ctx.aliceLink)
+	if _, ok := failPkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatalf("expected fail htlc, got %T", failPkt.htlc)
+	}
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// In queue mode, the next forward is held until the first one is
+	// settled.
+	err = ctx.s.UpdateForwardingLimits(ForwardingLimits{
+		MaxChannelHtlcs: 1,
+		Mode:            LimitModeQueue,
+		QueueTimeout:    time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unable to update limits: %v", err)
+	}
+
+	ctx.forward(2, [32]byte{})
+	ctx.assertNoPacket(ctx.bobLink)
+
+	settlePkt := &htlcPacket{
+		incomingChanID: ctx.aliceLink.ShortChanID(),
+		incomingHTLCID: 0,
+		htlc:           &lnwire.UpdateAddHTLC{},
+	}
+	if err := ctx.bobLink.completeCircuit(settlePkt); err != nil {
+		t.Fatalf("unable to complete circuit: %v", err)
+	}
+
+	err = ctx.s.forward(&htlcPacket{
+		outgoingChanID: ctx.bobLink.ShortChanID(),
+		outgoingHTLCID: settlePkt.outgoingHTLCID,
+		htlc:           &lnwire.UpdateFulfillHTLC{},
+	})
+	if err != nil {
+		t.Fatalf("unable to forward settle: %v", err)
+	}
+	ctx.assertPacket(ctx.aliceLink)
+
+	queueTicker := ctx.s.cfg.LimitQueueTicker.(*ticker.Mock)
+	select {
+	case queueTicker.Force <- time.Now():
+	case <-time.After(time.Second):
+		t.Fatalf("limit queue ticker not resumed")
+	}
+
+	pkt := ctx.assertPacket(ctx.bobLink)
+	if pkt.incomingHTLCID != 2 {
+		t.Fatalf("expected queued forward, got htlc %v",
+			pkt.incomingHTLCID)
+	}
+
+	channels, _ := ctx.s.ForwardingLimitUsage()
+	usage := channels[ctx.aliceLink.ShortChanID()]
+	if usage.PendingHtlcs != 1 || usage.Admitted != 2 ||
+		usage.Rejected != 1 || usage.Queued != 0 {
+
+		t.Fatalf("unexpected channel usage: %+v", usage)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -54,6 +55,10 @@ const (
 	// DefaultMaxLinkFeeUpdateTimeout represents the maximum interval in
 	// which a link should propose to update its commitment fee rate.
 	DefaultMaxLinkFeeUpdateTimeout = 60 * time.Minute
+
+	// DefaultLogCommitTimeout is the default interval after which a link
+	// commits any updates that leave the commitment chains desynchronized.
+	DefaultLogCommitTimeout = 300 * time.Millisecond
 )
 
 // ForwardingPolicy describes the set of constraints that a given ChannelLink
@@ -69,6 +74,10 @@ type ForwardingPolicy struct {
 	// lifetime of the channel.
 	MinHTLC lnwire.MilliSatoshi
 
+	// MaxHTLC is the largest HTLC that is to be forwarded. A value of
+	// zero means no maximum is enforced.
+	MaxHTLC lnwire.MilliSatoshi
+
 	// BaseFee is the base fee, expressed in milli-satoshi that must be
 	// paid for each incoming HTLC. This field, combined with FeeRate is
 	// used to compute the required fee for a given HTLC.
@@ -182,6 +191,12 @@ type ChannelLinkConfig struct {
 	// been closed, or when the set of active HTLC's is updated.
 	UpdateContractSignals func(*contractcourt.ContractSignals) error
 
+	// NotifyHtlcEvent is a function closure that we'll use to notify
+	// outside sub-systems of htlcs added to, settled on and failed by this
+	// link. The event is one of ForwardingEvent, LinkFailEvent or
+	// SettleEvent.
+	NotifyHtlcEvent func(interface{})
+
 	// ChainEvents is an active subscription to the chain watcher for this
 	// channel to be notified of any on-chain activity related to this
 	// channel.
@@ -226,6 +241,21 @@ type ChannelLinkConfig struct {
 	// before we do a state update.
 	BatchSize uint32
 
+	// MinBatchInterval and MaxBatchInterval are the bounds of the interval
+	// the link waits for updates to be batched before signing a new
+	// commitment. If MaxBatchInterval is greater than MinBatchInterval,
+	// the link tunes the interval to its load within these bounds, and
+	// BatchTicker should tick at MinBatchInterval. Otherwise, the batch is
+	// flushed on every tick of BatchTicker.
+	MinBatchInterval time.Duration
+	MaxBatchInterval time.Duration
+
+	// LogCommitTimeout is the interval after which the link commits any
+	// updates that leave the commitment chains desynchronized, if no new
+	// commitment was signed in the meantime. If zero,
+	// DefaultLogCommitTimeout is used.
+	LogCommitTimeout time.Duration
+
 	// UnsafeReplay will cause a link to replay the adds in its latest
 	// commitment txn after the link is restarted. This should only be used
 	// in testing, it is here to ensure the sphinx replay detection on the
@@ -262,6 +292,10 @@ type channelLink struct {
 	// method in state machine.
 	batchCounter uint32
 
+	// batcher decides when the current batch is flushed, tuning the batch
+	// interval to the load of the link, and keeps its commit metrics.
+	batcher *batcher
+
 	// keystoneBatch represents a volatile list of keystones that must be
 	// written before attempting to sign the next commitment txn. These
 	// represent all the HTLC's forwarded to the link from the switch. Once
@@ -342,12 +376,20 @@ type channelLink struct {
 func NewChannelLink(cfg ChannelLinkConfig,
 	channel *lnwallet.LightningChannel) ChannelLink {
 
+	if cfg.LogCommitTimeout == 0 {
+		cfg.LogCommitTimeout = DefaultLogCommitTimeout
+	}
+
 	return &channelLink{
 		cfg:         cfg,
 		channel:     channel,
 		shortChanID: channel.ShortChanID(),
+		batcher: newBatcher(
+			cfg.MinBatchInterval, cfg.MaxBatchInterval,
+			cfg.BatchSize,
+		),
 		// TODO(roasbeef): just do reserve here?
-		logCommitTimer: time.NewTimer(300 * time.Millisecond),
+		logCommitTimer: time.NewTimer(cfg.LogCommitTimeout),
 		overflowQueue:  newPacketQueue(lnwallet.MaxHTLCNumber / 2),
 		htlcUpdates:    make(chan []channeldb.HTLC),
 		quit:           make(chan struct{}),
@@ -962,6 +1004,13 @@ out:
 				continue
 			}
 
+			// If the batch interval is tuned to the load of the
+			// link, we'll keep waiting for more updates until the
+			// interval has passed.
+			if !l.batcher.shouldFlush(time.Now()) {
+				continue
+			}
+
 			// Otherwise, attempt to extend the remote commitment
 			// chain including all the currently pending entries.
 			// If the send was unsuccessful, then abandon the
@@ -1092,9 +1141,21 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					reason       lnwire.OpaqueReason
 				)
 
+				var detail FailureDetail
+				switch err {
+				case lnwallet.ErrBelowChanReserve:
+					detail = FailureDetailInsufficientBalance
+				case lnwallet.ErrMaxPendingAmount:
+					detail = FailureDetailMaxPendingAmount
+				case lnwallet.ErrBelowMinHTLC:
+					detail = FailureDetailBelowMinHtlc
+				default:
+					detail = FailureDetailHtlcAddFailed
+				}
+
 				var failure lnwire.FailureMessage
 				update, err := l.cfg.FetchLastChannelUpdate(
-					l.ShortChanID(),
+					l.RealShortChanID(),
 				)
 				if err != nil {
 					failure = &lnwire.FailTemporaryNodeFailure{}
@@ -1104,6 +1165,17 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					)
 				}
 
+				l.cfg.NotifyHtlcEvent(LinkFailEvent{
+					HtlcKey:       newHtlcKey(pkt),
+					HtlcInfo:      newHtlcInfo(pkt),
+					HtlcEventType: packetEventType(pkt.incomingChanID),
+					LinkError: NewDetailedLinkError(
+						failure, detail,
+					),
+					Incoming:  false,
+					Timestamp: time.Now(),
+				})
+
 				// Encrypt the error back to the source unless
 				// the payment was generated locally.
 				if pkt.obfuscator == nil {
@@ -1171,6 +1243,13 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		l.cfg.NotifyHtlcEvent(ForwardingEvent{
+			HtlcKey:       newHtlcKey(pkt),
+			HtlcInfo:      newHtlcInfo(pkt),
+			HtlcEventType: packetEventType(pkt.incomingChanID),
+			Timestamp:     time.Now(),
+		})
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -1289,6 +1368,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 	}
 
 	l.batchCounter++
+	l.batcher.updateAdded(time.Now(), l.batchCounter)
 
 	// If this newly added update exceeds the min batch size for adds, or
 	// this is a settle request, then initiate an update.
@@ -1524,7 +1604,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			default:
 			}
 		}
-		l.logCommitTimer.Reset(300 * time.Millisecond)
+		l.logCommitTimer.Reset(l.cfg.LogCommitTimeout)
 		l.logCommitTick = l.logCommitTimer.C
 
 		// If both commitment chains are fully synced from our PoV,
@@ -1554,6 +1634,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 				"unable to accept revocation: %v", err)
 			return
 		}
+		l.batcher.revocationReceived(time.Now())
 
 		l.processRemoteSettleFails(fwdPkg, settleFails)
 		needUpdate := l.processRemoteAdds(fwdPkg, adds)
@@ -1706,6 +1787,7 @@ func (l *channelLink) updateCommitTx() error {
 		HtlcSigs:  htlcSigs,
 	}
 	l.cfg.Peer.SendMessage(false, commitSig)
+	l.batcher.commitSent(time.Now(), l.batchCounter)
 
 	// We've just initiated a state transition, attempt to stop the
 	// logCommitTimer. If the timer already ticked, then we'll consume the
@@ -1769,6 +1851,7 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	l.infof("Updating to short_chan_id=%v for chan_id=%v", sid, chanID)
 
 	l.Lock()
+	wasPending := l.shortChanID == sourceHop
 	l.shortChanID = sid
 	l.Unlock()
 
@@ -1784,13 +1867,39 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. Links that
+	// weren't pending, such as those of zero-conf channels, already do.
+	if wasPending {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
 
+// RealShortChanID returns the short channel ID pointing to the funding output
+// of the channel within the chain. For zero-conf channels, whose links are
+// identified by an alias, this is zero until the funding transaction has
+// confirmed.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) RealShortChanID() lnwire.ShortChannelID {
+	sid := l.ShortChanID()
+	if !sid.IsAlias() {
+		return sid
+	}
+
+	return l.channel.State().RealShortChanID()
+}
+
+// PeerAliasShortChanID returns the alias short channel ID the remote peer
+// knows a zero-conf channel by, or zero if it hasn't told us about one.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) PeerAliasShortChanID() lnwire.ShortChannelID {
+	return l.channel.State().PeerAliasShortChanID()
+}
+
 // ChanID returns the channel ID for the channel link. The channel ID is a more
 // compact representation of a channel's full outpoint.
 //
@@ -1799,6 +1908,13 @@ func (l *channelLink) ChanID() lnwire.ChannelID {
 	return lnwire.NewChanIDFromOutPoint(l.channel.ChannelPoint())
 }
 
+// ChannelPoint returns the funding outpoint of the channel link.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ChannelPoint() *wire.OutPoint {
+	return l.channel.ChannelPoint()
+}
+
 // Bandwidth returns the total amount that can flow through the channel link at
 // this given instance. The value returned is expressed in millisatoshi and can
 // be used by callers when making forwarding decisions to determine if a link
@@ -1866,6 +1982,21 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 	if newPolicy.MinHTLC != 0 {
 		l.cfg.FwrdingPolicy.MinHTLC = newPolicy.MinHTLC
 	}
+	if newPolicy.MaxHTLC != 0 {
+		l.cfg.FwrdingPolicy.MaxHTLC = newPolicy.MaxHTLC
+	}
+}
+
+// SetForwardingPolicy replaces the forwarding policy of the target
+// ChannelLink. Unlike UpdateForwardingPolicy, every field of the passed policy
+// is applied, including those set to zero, such as a base fee of zero or the
+// lack of a maximum HTLC.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) SetForwardingPolicy(newPolicy ForwardingPolicy) {
+	l.Lock()
+	l.cfg.FwrdingPolicy = newPolicy
+	l.Unlock()
 }
 
 // HtlcSatifiesPolicy should return a nil error if the passed HTLC details
@@ -1894,7 +2025,7 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 		// As part of the returned error, we'll send our latest routing
 		// policy so the sending node obtains the most up to date data.
 		var failure lnwire.FailureMessage
-		update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+		update, err := l.cfg.FetchLastChannelUpdate(l.RealShortChanID())
 		if err != nil {
 			failure = &lnwire.FailTemporaryNodeFailure{}
 		} else {
@@ -1906,6 +2037,26 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 		return failure
 	}
 
+	// Similarly, we'll ensure that the passed HTLC isn't too large for
+	// the next hop, if we advertised a maximum.
+	if policy.MaxHTLC != 0 && amtToForward > policy.MaxHTLC {
+		l.errorf("outgoing htlc(%x) is too large: max_htlc=%v, "+
+			"htlc_value=%v", payHash[:], policy.MaxHTLC,
+			amtToForward)
+
+		// As part of the returned error, we'll send our latest routing
+		// policy so the sending node obtains the most up to date data.
+		var failure lnwire.FailureMessage
+		update, err := l.cfg.FetchLastChannelUpdate(l.RealShortChanID())
+		if err != nil {
+			failure = &lnwire.FailTemporaryNodeFailure{}
+		} else {
+			failure = lnwire.NewTemporaryChannelFailure(update)
+		}
+
+		return failure
+	}
+
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
 	// constraints of the outgoing link.
@@ -1924,7 +2075,7 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 		// As part of the returned error, we'll send our latest routing
 		// policy so the sending node obtains the most up to date data.
 		var failure lnwire.FailureMessage
-		update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+		update, err := l.cfg.FetchLastChannelUpdate(l.RealShortChanID())
 		if err != nil {
 			failure = &lnwire.FailTemporaryNodeFailure{}
 		} else {
@@ -1947,7 +2098,7 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 
 		var failure lnwire.FailureMessage
 		update, err := l.cfg.FetchLastChannelUpdate(
-			l.ShortChanID(),
+			l.RealShortChanID(),
 		)
 		if err != nil {
 			failure = lnwire.NewTemporaryChannelFailure(update)
@@ -1979,7 +2130,7 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 		// date with our current policy.
 		var failure lnwire.FailureMessage
 		update, err := l.cfg.FetchLastChannelUpdate(
-			l.ShortChanID(),
+			l.RealShortChanID(),
 		)
 		if err != nil {
 			failure = lnwire.NewTemporaryChannelFailure(update)
@@ -2006,6 +2157,14 @@ func (l *channelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 		snapshot.TotalMSatReceived
 }
 
+// CommitStats returns the metrics of the commitments signed by the link, and
+// the interval it currently batches updates for.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) CommitStats() CommitStats {
+	return l.batcher.commitStats()
+}
+
 // String returns the string representation of channel link.
 //
 // NOTE: Part of the ChannelLink interface.
@@ -2291,10 +2450,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					"soon: expiry=%v, best_height=%v",
 					pd.RHash[:], pd.Timeout, heightNow)
 
-				failure := lnwire.FailFinalExpiryTooSoon{}
-				l.sendHTLCError(
-					pd.HtlcIndex, &failure, obfuscator, pd.SourceRef,
+				failure := NewLinkError(
+					&lnwire.FailFinalExpiryTooSoon{},
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 				needUpdate = true
 				continue
 			}
@@ -2309,10 +2468,11 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			if err != nil {
 				log.Errorf("unable to query invoice registry: "+
 					" %v", err)
-				failure := lnwire.FailUnknownPaymentHash{}
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				failure := NewDetailedLinkError(
+					lnwire.FailUnknownPaymentHash{},
+					FailureDetailInvoiceNotFound,
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
@@ -2358,10 +2518,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					"amount: expected %v, received %v",
 					invoice.Terms.Value, pd.Amount)
 
-				failure := lnwire.FailIncorrectPaymentAmount{}
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				failure := NewLinkError(
+					lnwire.FailIncorrectPaymentAmount{},
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
@@ -2385,10 +2545,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					"got %v", pd.RHash, invoice.Terms.Value,
 					fwdInfo.AmountToForward)
 
-				failure := lnwire.FailIncorrectPaymentAmount{}
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				failure := NewLinkError(
+					lnwire.FailIncorrectPaymentAmount{},
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
@@ -2406,12 +2566,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					pd.RHash[:], expectedHeight,
 					fwdInfo.OutgoingCTLV)
 
-				failure := lnwire.NewFinalIncorrectCltvExpiry(
-					fwdInfo.OutgoingCTLV,
-				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				failure := NewLinkError(
+					lnwire.NewFinalIncorrectCltvExpiry(
+						fwdInfo.OutgoingCTLV,
+					),
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
@@ -2422,12 +2582,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					pd.RHash[:], pd.Timeout,
 					fwdInfo.OutgoingCTLV)
 
-				failure := lnwire.NewFinalIncorrectCltvExpiry(
-					fwdInfo.OutgoingCTLV,
-				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				failure := NewLinkError(
+					lnwire.NewFinalIncorrectCltvExpiry(
+						fwdInfo.OutgoingCTLV,
+					),
 				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
@@ -2476,6 +2636,21 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			})
 			needUpdate = true
 
+			l.cfg.NotifyHtlcEvent(SettleEvent{
+				HtlcKey: HtlcKey{
+					IncomingCircuit: CircuitKey{
+						ChanID: l.ShortChanID(),
+						HtlcID: pd.HtlcIndex,
+					},
+				},
+				HtlcInfo: HtlcInfo{
+					IncomingTimeLock: pd.Timeout,
+					IncomingAmt:      pd.Amount,
+				},
+				HtlcEventType: HtlcEventTypeReceive,
+				Timestamp:     time.Now(),
+			})
+
 		// There are additional channels left within this route. So
 		// we'll simply do some forwarding package book-keeping.
 		default:
@@ -2560,7 +2735,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 				var failure lnwire.FailureMessage
 				update, err := l.cfg.FetchLastChannelUpdate(
-					l.ShortChanID(),
+					l.RealShortChanID(),
 				)
 				if err != nil {
 					failure = &lnwire.FailTemporaryNodeFailure{}
@@ -2570,9 +2745,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					)
 				}
 
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+				linkErr := NewDetailedLinkError(
+					failure, FailureDetailOnionEncode,
 				)
+				l.sendHTLCError(pd, linkErr, obfuscator, false)
 				needUpdate = true
 				continue
 			}
@@ -2674,17 +2850,18 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 }
 
 // sendHTLCError functions cancels HTLC and send cancel message back to the
-// peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e ErrorEncrypter, sourceRef *channeldb.AddRef) {
+// peer from which HTLC was received. The failure is reported to the htlc
+// notifier as a receive if we're the exit hop, and a forward otherwise.
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure *LinkError, e ErrorEncrypter, isReceive bool) {
 
-	reason, err := e.EncryptFirstHop(failure)
+	reason, err := e.EncryptFirstHop(failure.WireMessage())
 	if err != nil {
 		log.Errorf("unable to obfuscate error: %v", err)
 		return
 	}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
+	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2692,9 +2869,31 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
 		ChanID: l.ChanID(),
-		ID:     htlcIndex,
+		ID:     pd.HtlcIndex,
 		Reason: reason,
 	})
+
+	eventType := HtlcEventTypeForward
+	if isReceive {
+		eventType = HtlcEventTypeReceive
+	}
+
+	l.cfg.NotifyHtlcEvent(LinkFailEvent{
+		HtlcKey: HtlcKey{
+			IncomingCircuit: CircuitKey{
+				ChanID: l.ShortChanID(),
+				HtlcID: pd.HtlcIndex,
+			},
+		},
+		HtlcInfo: HtlcInfo{
+			IncomingTimeLock: pd.Timeout,
+			IncomingAmt:      pd.Amount,
+		},
+		HtlcEventType: eventType,
+		LinkError:     failure,
+		Incoming:      true,
+		Timestamp:     time.Now(),
+	})
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
@@ -1463,6 +1463,10 @@ func (m *mockPeer) QuitSignal() <-chan struct{} {
 	return m.quit
 }
 
+func (m *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 var _ lnpeer.Peer = (*mockPeer)(nil)
 
 func (m *mockPeer) SendMessage(sync bool, msgs ...lnwire.Message) error {
@@ -1559,6 +1563,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
 		},
+		NotifyHtlcEvent: func(interface{}) {},
 		UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 			return nil
 		},
@@ -4069,6 +4074,7 @@ func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
 		},
+		NotifyHtlcEvent: func(interface{}) {},
 		UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 			return nil
 		},
@@ -5120,6 +5126,49 @@ func TestForwardingAsymmetricTimeLockPolicies(t *testing.T) {
 	}
 }
 
+// TestSetForwardingPolicy tests that setting the forwarding policy of a link
+// applies every field of the new policy, including those set to zero, while
+// updating it leaves the fields set to zero untouched.
+func TestSetForwardingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := ForwardingPolicy{
+		MinHTLC:       500,
+		MaxHTLC:       5000,
+		BaseFee:       10,
+		FeeRate:       100,
+		TimeLockDelta: 20,
+	}
+	link := channelLink{
+		cfg: ChannelLinkConfig{
+			FwrdingPolicy: policy,
+		},
+	}
+
+	link.UpdateForwardingPolicy(ForwardingPolicy{FeeRate: 200})
+
+	expectedPolicy := policy
+	expectedPolicy.FeeRate = 200
+	if link.cfg.FwrdingPolicy != expectedPolicy {
+		t.Fatalf("expected policy %v, got %v", expectedPolicy,
+			link.cfg.FwrdingPolicy)
+	}
+
+	// A base fee of zero and the lack of a maximum HTLC should only be
+	// applied when setting the policy.
+	newPolicy := ForwardingPolicy{
+		MinHTLC:       500,
+		FeeRate:       300,
+		TimeLockDelta: 20,
+	}
+	link.SetForwardingPolicy(newPolicy)
+
+	if link.cfg.FwrdingPolicy != newPolicy {
+		t.Fatalf("expected policy %v, got %v", newPolicy,
+			link.cfg.FwrdingPolicy)
+	}
+}
+
 // TestHtlcSatisfyPolicy tests that a link is properly enforcing the HTLC
 // forwarding policy.
 func TestHtlcSatisfyPolicy(t *testing.T) {
@@ -5135,6 +5184,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 			FwrdingPolicy: ForwardingPolicy{
 				TimeLockDelta: 20,
 				MinHTLC:       500,
+				MaxHTLC:       5000,
 				BaseFee:       10,
 			},
 			FetchLastChannelUpdate: fetchLastChannelUpdate,
@@ -5159,6 +5209,15 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 		}
 	})
 
+	t.Run("above maxhtlc", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 5500, 5010,
+			200, 150, 0)
+		if _, ok := result.(*lnwire.FailTemporaryChannelFailure); !ok {
+			t.Fatalf("expected FailTemporaryChannelFailure " +
+				"failure code")
+		}
+	})
+
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
 			200, 150, 0)
@@ -157,9 +157,17 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		FetchLastChannelUpdate: func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
 			return nil, nil
 		},
-		Notifier:       &mockNotifier{},
-		FwdEventTicker: ticker.MockNew(DefaultFwdEventInterval),
-		LogEventTicker: ticker.MockNew(DefaultLogInterval),
+		Notifier:              &mockNotifier{},
+		FwdEventTicker:        ticker.MockNew(DefaultFwdEventInterval),
+		LogEventTicker:        ticker.MockNew(DefaultLogInterval),
+		LimitQueueTicker:      ticker.MockNew(DefaultLimitQueueInterval),
+		NotifyActiveChannel:   func(wire.OutPoint) {},
+		NotifyInactiveChannel: func(wire.OutPoint) {},
+		NotifyHtlcEvent:       func(interface{}) {},
+		PreimageCache: &mockPreimageCache{
+			preimageMap: make(map[[32]byte][]byte),
+		},
+		InterceptorTimeout: time.Minute,
 	}
 
 	return New(cfg, startingHeight)
@@ -238,6 +246,10 @@ func (s *mockServer) Start() error {
 	return nil
 }
 
+func (s *mockServer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 func (s *mockServer) QuitSignal() <-chan struct{} {
 	return s.quit
 }
@@ -573,6 +585,10 @@ type mockChannelLink struct {
 
 	shortChanID lnwire.ShortChannelID
 
+	realShortChanID lnwire.ShortChannelID
+
+	peerAlias lnwire.ShortChannelID
+
 	chanID lnwire.ChannelID
 
 	peer lnpeer.Peer
@@ -644,6 +660,8 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
+func (f *mockChannelLink) SetForwardingPolicy(_ ForwardingPolicy) {
+}
 func (f *mockChannelLink) HtlcSatifiesPolicy([32]byte, lnwire.MilliSatoshi,
 	lnwire.MilliSatoshi, uint32, uint32, uint32) lnwire.FailureMessage {
 	return nil
@@ -653,6 +671,10 @@ func (f *mockChannelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSato
 	return 0, 0, 0
 }
 
+func (f *mockChannelLink) CommitStats() CommitStats {
+	return CommitStats{}
+}
+
 func (f *mockChannelLink) AttachMailBox(mailBox MailBox) {
 	f.mailBox = mailBox
 	f.packets = mailBox.PacketOutBox()
@@ -666,6 +688,7 @@ func (f *mockChannelLink) Start() error {
 
 func (f *mockChannelLink) ChanID() lnwire.ChannelID                     { return f.chanID }
 func (f *mockChannelLink) ShortChanID() lnwire.ShortChannelID           { return f.shortChanID }
+func (f *mockChannelLink) ChannelPoint() *wire.OutPoint                 { return &wire.OutPoint{} }
 func (f *mockChannelLink) Bandwidth() lnwire.MilliSatoshi               { return 99999999 }
 func (f *mockChannelLink) Peer() lnpeer.Peer                            { return f.peer }
 func (f *mockChannelLink) Stop()                                        {}
@@ -675,6 +698,12 @@ func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
 	return f.shortChanID, nil
 }
+func (f *mockChannelLink) RealShortChanID() lnwire.ShortChannelID {
+	return f.realShortChanID
+}
+func (f *mockChannelLink) PeerAliasShortChanID() lnwire.ShortChannelID {
+	return f.peerAlias
+}
 
 var _ ChannelLink = (*mockChannelLink)(nil)
 
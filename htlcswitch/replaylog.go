@@ -0,0 +1,494 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// maxReplayWriteBatch is the maximum number of writes the hybrid replay log
+// commits to disk within a single transaction.
+const maxReplayWriteBatch = 500
+
+// ErrReplayLogExiting is returned when a write to the hybrid replay log is
+// aborted because the log is shutting down.
+var ErrReplayLogExiting = errors.New("sphinx replay log shutting down")
+
+// ReplayLog is the persistent log of hashed shared secrets used by the sphinx
+// router to reject replayed onion packets. On top of the sphinx.ReplayLog
+// interface, it allows the log to be monitored and its storage compacted.
+type ReplayLog interface {
+	sphinx.ReplayLog
+
+	// Stats returns the number of entries in the log, the lowest CLTV
+	// among them and the size of the log on disk.
+	Stats() (*ReplayLogStats, error)
+
+	// Compact reclaims the disk space freed by expired entries. It
+	// returns the size of the log on disk before and after compaction.
+	Compact() (int64, int64, error)
+}
+
+// ReplayLogStats describes the contents and disk usage of a replay log.
+type ReplayLogStats struct {
+	// NumEntries is the number of hashed shared secrets in the log.
+	NumEntries uint64
+
+	// OldestCLTV is the lowest CLTV of the entries in the log, after which
+	// the entry is garbage collected. It's zero if the log is empty.
+	OldestCLTV uint32
+
+	// NumBatches is the number of processed batches whose replay set is
+	// kept to make their processing idempotent.
+	NumBatches uint64
+
+	// DiskUsage is the size of the log's database file in bytes.
+	DiskUsage int64
+}
+
+// replayWrite is a set of new entries queued for the hybrid replay log's
+// writer, along with the replay set of the batch that added them, if any.
+type replayWrite struct {
+	entries map[sphinx.HashPrefix]uint32
+
+	batchID []byte
+	replays *sphinx.ReplaySet
+
+	done chan error
+}
+
+// HybridReplayLog is a ReplayLog that keeps all of its entries in memory, and
+// persists them in a DecayedLog's database. Replays are detected, and expired
+// entries found, without reading from disk, while new entries are queued for
+// a single writer that commits all queued writes within one transaction.
+// Calls to Put and PutBatch only return once their entries are on disk. As it
+// shares the database format of the DecayedLog, either log can be used with
+// the same database.
+type HybridReplayLog struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	store *DecayedLog
+
+	notifier chainntnfs.ChainNotifier
+
+	// mtx guards entries, which holds the CLTV of every hashed shared
+	// secret in the log, including those still queued for the writer.
+	mtx     sync.Mutex
+	entries map[sphinx.HashPrefix]uint32
+
+	writes chan *replayWrite
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewHybridReplayLog creates a new HybridReplayLog backed by the database at
+// the given path. Entries are evicted as their cltv expires using block
+// epochs from the given notifier.
+func NewHybridReplayLog(dbPath string,
+	notifier chainntnfs.ChainNotifier) *HybridReplayLog {
+
+	return &HybridReplayLog{
+		store:    NewDecayedLog(dbPath, nil),
+		notifier: notifier,
+		entries:  make(map[sphinx.HashPrefix]uint32),
+		writes:   make(chan *replayWrite),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start opens the log's database, loads its entries into memory and starts
+// the writer and garbage collector.
+func (h *HybridReplayLog) Start() error {
+	if !atomic.CompareAndSwapInt32(&h.started, 0, 1) {
+		return nil
+	}
+
+	if err := h.store.Start(); err != nil {
+		return err
+	}
+
+	// Once the store is started, we'll need to stop it again if we fail
+	// to start, so its database isn't left open.
+	if err := h.start(); err != nil {
+		h.store.Stop()
+		return err
+	}
+
+	return nil
+}
+
+// start loads the entries of the started store into memory, and launches the
+// writer and garbage collector.
+func (h *HybridReplayLog) start() error {
+	err := h.store.view(func(tx *bolt.Tx) error {
+		sharedHashes := tx.Bucket(sharedHashBucket)
+		if sharedHashes == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		return sharedHashes.ForEach(func(k, v []byte) error {
+			var hash sphinx.HashPrefix
+			copy(hash[:], k)
+			h.entries[hash] = binary.BigEndian.Uint32(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Loaded %v shared secret hashes into sphinx replay log",
+		len(h.entries))
+
+	// We'll register for block epochs before launching any goroutines,
+	// such that nothing needs to be torn down if the registration fails.
+	var epochClient *chainntnfs.BlockEpochEvent
+	if h.notifier != nil {
+		epochClient, err = h.notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return fmt.Errorf("Unable to register for epoch "+
+				"notifications: %v", err)
+		}
+	}
+
+	h.wg.Add(1)
+	go h.writer()
+
+	if epochClient != nil {
+		h.wg.Add(1)
+		go h.garbageCollector(epochClient)
+	}
+
+	return nil
+}
+
+// Stop halts the writer and garbage collector, and closes the log's database.
+func (h *HybridReplayLog) Stop() error {
+	if !atomic.CompareAndSwapInt32(&h.stopped, 0, 1) {
+		return nil
+	}
+
+	close(h.quit)
+	h.wg.Wait()
+
+	return h.store.Stop()
+}
+
+// writer commits the writes queued by Put and PutBatch to disk, combining all
+// writes queued at the time into a single transaction. This function MUST be
+// run as a goroutine.
+func (h *HybridReplayLog) writer() {
+	defer h.wg.Done()
+
+	for {
+		var writes []*replayWrite
+		select {
+		case write := <-h.writes:
+			writes = append(writes, write)
+		case <-h.quit:
+			return
+		}
+
+		// Collect all other writes that have been queued in the
+		// meantime.
+	collect:
+		for len(writes) < maxReplayWriteBatch {
+			select {
+			case write := <-h.writes:
+				writes = append(writes, write)
+			default:
+				break collect
+			}
+		}
+
+		err := h.store.update(func(tx *bolt.Tx) error {
+			return writeReplayEntries(tx, writes)
+		})
+		for _, write := range writes {
+			write.done <- err
+		}
+	}
+}
+
+// writeReplayEntries writes the entries and batch replay sets of the given
+// writes to the decayed log's buckets.
+func writeReplayEntries(tx *bolt.Tx, writes []*replayWrite) error {
+	sharedHashes := tx.Bucket(sharedHashBucket)
+	if sharedHashes == nil {
+		return ErrDecayedLogCorrupted
+	}
+
+	batchReplayBkt := tx.Bucket(batchReplayBucket)
+	if batchReplayBkt == nil {
+		return ErrDecayedLogCorrupted
+	}
+
+	for _, write := range writes {
+		for hash, cltv := range write.entries {
+			// Bolt references the value until the txn is
+			// committed, so each entry needs its own buffer.
+			var scratch [4]byte
+			binary.BigEndian.PutUint32(scratch[:], cltv)
+			err := sharedHashes.Put(hash[:], scratch[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		if write.batchID == nil {
+			continue
+		}
+
+		var replayBuf bytes.Buffer
+		if err := write.replays.Encode(&replayBuf); err != nil {
+			return err
+		}
+		err := batchReplayBkt.Put(write.batchID, replayBuf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commit queues the given write for the writer, and waits for it to be
+// committed to disk. If the write fails, its entries are removed from memory.
+func (h *HybridReplayLog) commit(write *replayWrite) error {
+	write.done = make(chan error, 1)
+
+	var err error
+	select {
+	case h.writes <- write:
+		select {
+		case err = <-write.done:
+		case <-h.quit:
+			err = ErrReplayLogExiting
+		}
+
+	case <-h.quit:
+		err = ErrReplayLogExiting
+	}
+
+	if err != nil {
+		h.mtx.Lock()
+		for hash := range write.entries {
+			delete(h.entries, hash)
+		}
+		h.mtx.Unlock()
+	}
+
+	return err
+}
+
+// garbageCollector removes entries whose expiry height has passed from memory
+// and disk. This function MUST be run as a goroutine.
+func (h *HybridReplayLog) garbageCollector(
+	epochClient *chainntnfs.BlockEpochEvent) {
+
+	defer h.wg.Done()
+	defer epochClient.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-epochClient.Epochs:
+			if !ok {
+				return
+			}
+
+			height := uint32(epoch.Height)
+			numExpired, err := h.gcExpiredHashes(height)
+			if err != nil {
+				log.Errorf("unable to expire hashes at "+
+					"height=%d: %v", height, err)
+			}
+
+			if numExpired > 0 {
+				log.Infof("Garbage collected %v shared "+
+					"secret hashes at height=%v",
+					numExpired, height)
+			}
+
+		case <-h.quit:
+			return
+		}
+	}
+}
+
+// gcExpiredHashes purges the log of all entries whose CLTV expires below the
+// provided height.
+func (h *HybridReplayLog) gcExpiredHashes(height uint32) (int, error) {
+	var expired []sphinx.HashPrefix
+
+	h.mtx.Lock()
+	for hash, cltv := range h.entries {
+		if cltv < height {
+			expired = append(expired, hash)
+			delete(h.entries, hash)
+		}
+	}
+	h.mtx.Unlock()
+
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	err := h.store.update(func(tx *bolt.Tx) error {
+		sharedHashes := tx.Bucket(sharedHashBucket)
+		if sharedHashes == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		for _, hash := range expired {
+			if err := sharedHashes.Delete(hash[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(expired), nil
+}
+
+// Get retrieves the CLTV of a processed HTLC given the hash prefix of its
+// shared secret.
+func (h *HybridReplayLog) Get(hash *sphinx.HashPrefix) (uint32, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	cltv, ok := h.entries[*hash]
+	if !ok {
+		return 0, sphinx.ErrLogEntryNotFound
+	}
+
+	return cltv, nil
+}
+
+// Put stores a shared secret hash with the given CLTV. It returns
+// sphinx.ErrReplayedPacket if the hash is already in the log.
+func (h *HybridReplayLog) Put(hash *sphinx.HashPrefix, cltv uint32) error {
+	h.mtx.Lock()
+	if _, ok := h.entries[*hash]; ok {
+		h.mtx.Unlock()
+		return sphinx.ErrReplayedPacket
+	}
+	h.entries[*hash] = cltv
+	h.mtx.Unlock()
+
+	return h.commit(&replayWrite{
+		entries: map[sphinx.HashPrefix]uint32{*hash: cltv},
+	})
+}
+
+// Delete removes a shared secret hash from the log.
+func (h *HybridReplayLog) Delete(hash *sphinx.HashPrefix) error {
+	h.mtx.Lock()
+	delete(h.entries, *hash)
+	h.mtx.Unlock()
+
+	return h.store.Delete(hash)
+}
+
+// PutBatch stores the hashed shared secrets of a batch, returning the set of
+// entries that are replays. Like the DecayedLog, it records the replay set of
+// each batch on disk, and returns the recorded set if the batch is processed
+// again.
+func (h *HybridReplayLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet,
+	error) {
+
+	// If this batch was processed before, we'll return the replay set
+	// recorded at the time.
+	var replays *sphinx.ReplaySet
+	err := h.store.view(func(tx *bolt.Tx) error {
+		batchReplayBkt := tx.Bucket(batchReplayBucket)
+		if batchReplayBkt == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		replayBytes := batchReplayBkt.Get(b.ID)
+		if replayBytes == nil {
+			return nil
+		}
+
+		replays = sphinx.NewReplaySet()
+		return replays.Decode(bytes.NewReader(replayBytes))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if replays == nil {
+		write := &replayWrite{
+			entries: make(map[sphinx.HashPrefix]uint32),
+			batchID: b.ID,
+			replays: sphinx.NewReplaySet(),
+		}
+
+		// Any entry of the batch that is already in the log is a
+		// replay. The others are added to the log right away, so
+		// concurrent batches see them as well.
+		h.mtx.Lock()
+		err := b.ForEach(func(seqNum uint16,
+			hashPrefix *sphinx.HashPrefix, cltv uint32) error {
+
+			if _, ok := h.entries[*hashPrefix]; ok {
+				write.replays.Add(seqNum)
+				return nil
+			}
+
+			h.entries[*hashPrefix] = cltv
+			write.entries[*hashPrefix] = cltv
+
+			return nil
+		})
+		h.mtx.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		// Merge the replays found in the log with those found within
+		// the batch during its construction.
+		write.replays.Merge(b.ReplaySet)
+
+		if err := h.commit(write); err != nil {
+			return nil, err
+		}
+		replays = write.replays
+	}
+
+	b.ReplaySet = replays
+	b.IsCommitted = true
+
+	return replays, nil
+}
+
+// Stats returns the number of entries in the log, the lowest CLTV among them
+// and the size of the log's database file.
+func (h *HybridReplayLog) Stats() (*ReplayLogStats, error) {
+	return h.store.Stats()
+}
+
+// Compact rewrites the log's database into a new file, reclaiming the space
+// freed by expired entries. It returns the size of the database file before
+// and after compaction.
+func (h *HybridReplayLog) Compact() (int64, int64, error) {
+	return h.store.Compact()
+}
+
+// A compile time check to see if HybridReplayLog adheres to the ReplayLog
+// interface.
+var _ ReplayLog = (*HybridReplayLog)(nil)
@@ -0,0 +1,373 @@
+package htlcswitch
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// randHashPrefix returns a random hash prefix to simulate a hashed shared
+// secret.
+func randHashPrefix(t *testing.T) *sphinx.HashPrefix {
+	var hash sphinx.HashPrefix
+	if _, err := rand.Read(hash[:]); err != nil {
+		t.Fatalf("unable to generate hash prefix: %v", err)
+	}
+
+	return &hash
+}
+
+// assertReplayLogEntry asserts that the given hash prefix is in the log with
+// the given CLTV.
+func assertReplayLogEntry(t *testing.T, l sphinx.ReplayLog,
+	hash *sphinx.HashPrefix, expCltv uint32) {
+
+	t.Helper()
+
+	val, err := l.Get(hash)
+	if err != nil {
+		t.Fatalf("unable to get entry: %v", err)
+	}
+	if val != expCltv {
+		t.Fatalf("expected cltv %v, got %v", expCltv, val)
+	}
+}
+
+// TestDecayedLogStatsAndCompact checks that the decayed log reports its
+// contents, and that compacting it shrinks its database file without losing
+// entries.
+func TestDecayedLogStatsAndCompact(t *testing.T) {
+	t.Parallel()
+
+	dbPath := tempDecayedLogPath(t)
+	d := NewDecayedLog(dbPath, nil)
+	if err := d.Start(); err != nil {
+		t.Fatalf("unable to start decayed log: %v", err)
+	}
+	defer shutdown(dbPath, d)
+
+	// Fill the log in a single batch, and delete some of its entries to
+	// leave free pages in the database file.
+	const numEntries = 5000
+	b := sphinx.NewBatch([]byte("batch"))
+	hashes := make([]*sphinx.HashPrefix, numEntries)
+	for i := range hashes {
+		hashes[i] = randHashPrefix(t)
+		b.Put(uint16(i), hashes[i], uint32(500+i))
+	}
+	if _, err := d.PutBatch(b); err != nil {
+		t.Fatalf("unable to put batch: %v", err)
+	}
+
+	stats, err := d.Stats()
+	if err != nil {
+		t.Fatalf("unable to fetch stats: %v", err)
+	}
+	if stats.NumEntries != numEntries || stats.OldestCLTV != 500 {
+		t.Fatalf("unexpected stats: %v entries, oldest cltv %v",
+			stats.NumEntries, stats.OldestCLTV)
+	}
+	if stats.DiskUsage == 0 {
+		t.Fatalf("expected disk usage to be reported")
+	}
+
+	const numDeleted = 100
+	for _, hash := range hashes[:numDeleted] {
+		if err := d.Delete(hash); err != nil {
+			t.Fatalf("unable to delete entry: %v", err)
+		}
+	}
+
+	// A stale copy left behind by an interrupted compaction must not get
+	// in the way.
+	err = ioutil.WriteFile(dbPath+".compact", []byte("stale"), 0600)
+	if err != nil {
+		t.Fatalf("unable to write stale compaction file: %v", err)
+	}
+
+	before, after, err := d.Compact()
+	if err != nil {
+		t.Fatalf("unable to compact log: %v", err)
+	}
+	if after >= before {
+		t.Fatalf("expected log to shrink, went from %v to %v bytes",
+			before, after)
+	}
+
+	// The remaining entries must have survived compaction, and the log
+	// must still accept new entries.
+	for i, hash := range hashes[numDeleted:] {
+		assertReplayLogEntry(t, d, hash, uint32(500+numDeleted+i))
+	}
+	if err := d.Put(randHashPrefix(t), cltv); err != nil {
+		t.Fatalf("unable to put entry after compaction: %v", err)
+	}
+
+	stats, err = d.Stats()
+	if err != nil {
+		t.Fatalf("unable to fetch stats: %v", err)
+	}
+	if stats.NumEntries != numEntries-numDeleted+1 ||
+		stats.NumBatches != 1 {
+
+		t.Fatalf("unexpected stats after compaction: %v entries, "+
+			"%v batches", stats.NumEntries, stats.NumBatches)
+	}
+	if _, err := os.Stat(dbPath + ".compact"); !os.IsNotExist(err) {
+		t.Fatalf("expected compaction file to be removed")
+	}
+}
+
+// TestDecayedLogCompactConcurrentWrites checks that the decayed log keeps
+// accepting entries while it's being compacted, and that none of the writes
+// made during the compaction are lost.
+func TestDecayedLogCompactConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	dbPath := tempDecayedLogPath(t)
+	d := NewDecayedLog(dbPath, nil)
+	if err := d.Start(); err != nil {
+		t.Fatalf("unable to start decayed log: %v", err)
+	}
+	defer shutdown(dbPath, d)
+
+	// Fill the log so that the copy takes a while, and delete some of its
+	// entries.
+	const numEntries = 5000
+	b := sphinx.NewBatch([]byte("batch"))
+	hashes := make([]*sphinx.HashPrefix, numEntries)
+	for i := range hashes {
+		hashes[i] = randHashPrefix(t)
+		b.Put(uint16(i), hashes[i], cltv)
+	}
+	if _, err := d.PutBatch(b); err != nil {
+		t.Fatalf("unable to put batch: %v", err)
+	}
+
+	const numDeleted = 100
+	for _, hash := range hashes[:numDeleted] {
+		if err := d.Delete(hash); err != nil {
+			t.Fatalf("unable to delete entry: %v", err)
+		}
+	}
+
+	// Compact the log while we keep adding and deleting entries, until
+	// the compaction is done.
+	errChan := make(chan error, 1)
+	go func() {
+		_, _, err := d.Compact()
+		errChan <- err
+	}()
+
+	var (
+		added   []*sphinx.HashPrefix
+		removed int
+		err     error
+	)
+	for done := false; !done; {
+		select {
+		case err = <-errChan:
+			done = true
+		default:
+		}
+
+		hash := randHashPrefix(t)
+		if err := d.Put(hash, cltv); err != nil {
+			t.Fatalf("unable to put entry: %v", err)
+		}
+		added = append(added, hash)
+
+		if removed < numEntries-numDeleted {
+			hash := hashes[numDeleted+removed]
+			if err := d.Delete(hash); err != nil {
+				t.Fatalf("unable to delete entry: %v", err)
+			}
+			removed++
+		}
+	}
+	if err != nil {
+		t.Fatalf("unable to compact log: %v", err)
+	}
+
+	// All entries added during the compaction must be in the compacted
+	// log, and those deleted must be gone.
+	for _, hash := range added {
+		assertReplayLogEntry(t, d, hash, cltv)
+	}
+	for _, hash := range hashes[:numDeleted+removed] {
+		if _, err := d.Get(hash); err != sphinx.ErrLogEntryNotFound {
+			t.Fatalf("expected ErrLogEntryNotFound, got %v", err)
+		}
+	}
+	for _, hash := range hashes[numDeleted+removed:] {
+		assertReplayLogEntry(t, d, hash, cltv)
+	}
+}
+
+// TestHybridReplayLogPutBatch checks that the hybrid replay log detects
+// replays within batches, processes batches idempotently, and persists its
+// entries in a database the decayed log can read.
+func TestHybridReplayLogPutBatch(t *testing.T) {
+	t.Parallel()
+
+	dbPath := tempDecayedLogPath(t)
+	h := NewHybridReplayLog(dbPath, nil)
+	if err := h.Start(); err != nil {
+		t.Fatalf("unable to start hybrid replay log: %v", err)
+	}
+	defer shutdown(dbPath, h)
+
+	replayed := randHashPrefix(t)
+	if err := h.Put(replayed, cltv); err != nil {
+		t.Fatalf("unable to put entry: %v", err)
+	}
+	if err := h.Put(replayed, cltv); err != sphinx.ErrReplayedPacket {
+		t.Fatalf("expected ErrReplayedPacket, got %v", err)
+	}
+
+	// Create a batch whose second entry was seen before, and whose third
+	// entry repeats the first one.
+	fresh := randHashPrefix(t)
+	newBatch := func() *sphinx.Batch {
+		b := sphinx.NewBatch([]byte("batch"))
+		b.Put(0, fresh, cltv+1)
+		b.Put(1, replayed, cltv+1)
+		b.Put(2, fresh, cltv+1)
+		return b
+	}
+	assertReplays := func(replays *sphinx.ReplaySet) {
+		t.Helper()
+
+		if replays.Size() != 2 || !replays.Contains(1) ||
+			!replays.Contains(2) {
+
+			t.Fatalf("unexpected replay set of size %v",
+				replays.Size())
+		}
+	}
+
+	replays, err := h.PutBatch(newBatch())
+	if err != nil {
+		t.Fatalf("unable to put batch: %v", err)
+	}
+	assertReplays(replays)
+	assertReplayLogEntry(t, h, fresh, cltv+1)
+	assertReplayLogEntry(t, h, replayed, cltv)
+
+	// Processing the same batch again must return the same replay set,
+	// even though all of its entries are in the log by now.
+	replays, err = h.PutBatch(newBatch())
+	if err != nil {
+		t.Fatalf("unable to put batch: %v", err)
+	}
+	assertReplays(replays)
+
+	stats, err := h.Stats()
+	if err != nil {
+		t.Fatalf("unable to fetch stats: %v", err)
+	}
+	if stats.NumEntries != 2 || stats.NumBatches != 1 ||
+		stats.OldestCLTV != cltv {
+
+		t.Fatalf("unexpected stats: %v entries, %v batches, oldest "+
+			"cltv %v", stats.NumEntries, stats.NumBatches,
+			stats.OldestCLTV)
+	}
+
+	// The entries must be readable by a decayed log using the same
+	// database.
+	h.Stop()
+
+	d := NewDecayedLog(dbPath, nil)
+	if err := d.Start(); err != nil {
+		t.Fatalf("unable to start decayed log: %v", err)
+	}
+	assertReplayLogEntry(t, d, fresh, cltv+1)
+	assertReplayLogEntry(t, d, replayed, cltv)
+	replays, err = d.PutBatch(newBatch())
+	if err != nil {
+		t.Fatalf("unable to put batch: %v", err)
+	}
+	assertReplays(replays)
+	d.Stop()
+
+	// After a restart, the hybrid log must load its entries from disk.
+	h2 := NewHybridReplayLog(dbPath, nil)
+	if err := h2.Start(); err != nil {
+		t.Fatalf("unable to restart hybrid replay log: %v", err)
+	}
+	defer h2.Stop()
+
+	assertReplayLogEntry(t, h2, fresh, cltv+1)
+	if err := h2.Put(replayed, cltv); err != sphinx.ErrReplayedPacket {
+		t.Fatalf("expected ErrReplayedPacket, got %v", err)
+	}
+}
+
+// TestHybridReplayLogGarbageCollector checks that the hybrid replay log
+// removes expired entries from memory and disk on new blocks.
+func TestHybridReplayLogGarbageCollector(t *testing.T) {
+	t.Parallel()
+
+	dbPath := tempDecayedLogPath(t)
+	notifier := &mockNotifier{
+		epochChan: make(chan *chainntnfs.BlockEpoch, 1),
+	}
+	h := NewHybridReplayLog(dbPath, notifier)
+	if err := h.Start(); err != nil {
+		t.Fatalf("unable to start hybrid replay log: %v", err)
+	}
+	defer shutdown(dbPath, h)
+
+	expiring := randHashPrefix(t)
+	if err := h.Put(expiring, cltv); err != nil {
+		t.Fatalf("unable to put entry: %v", err)
+	}
+	remaining := randHashPrefix(t)
+	if err := h.Put(remaining, cltv+1); err != nil {
+		t.Fatalf("unable to put entry: %v", err)
+	}
+
+	notifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: int32(cltv + 1),
+	}
+
+	// Wait for the garbage collector to remove the expired entry.
+	err := waitPredicate(func() bool {
+		_, err := h.Get(expiring)
+		return err == sphinx.ErrLogEntryNotFound
+	})
+	if err != nil {
+		t.Fatalf("expired entry wasn't garbage collected")
+	}
+	assertReplayLogEntry(t, h, remaining, cltv+1)
+
+	// The entry must have been removed from disk as well.
+	err = waitPredicate(func() bool {
+		stats, err := h.Stats()
+		return err == nil && stats.NumEntries == 1
+	})
+	if err != nil {
+		t.Fatalf("expired entry wasn't removed from disk")
+	}
+}
+
+// waitPredicate polls the given predicate until it returns true, or a second
+// has passed.
+func waitPredicate(pred func() bool) error {
+	timeout := time.After(time.Second)
+	for !pred() {
+		select {
+		case <-timeout:
+			return ErrReplayLogExiting
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	return nil
+}
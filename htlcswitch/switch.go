@@ -31,6 +31,10 @@ const (
 	// DefaultLogInterval is the duration between attempts to log statistics
 	// about forwarding events.
 	DefaultLogInterval = 10 * time.Second
+
+	// DefaultLimitQueueInterval is the duration between attempts to admit
+	// the forwards queued by the forwarding limiter.
+	DefaultLimitQueueInterval = 100 * time.Millisecond
 )
 
 var (
@@ -59,6 +63,18 @@ var (
 	// active links in the switch for a specific destination.
 	ErrNoLinksFound = errors.New("no channel links found")
 
+	// ErrCircuitNotForwarded is returned when attempting to fail back a
+	// circuit whose HTLC hasn't been assigned a keystone in an outgoing
+	// channel yet.
+	ErrCircuitNotForwarded = errors.New("circuit has not been forwarded")
+
+	// ErrCircuitPreimageKnown is returned when attempting to fail back a
+	// circuit whose preimage is known. The outgoing HTLC may have been
+	// swept on-chain with it, so the incoming HTLC must be settled rather
+	// than failed.
+	ErrCircuitPreimageKnown = errors.New("preimage of circuit is known, " +
+		"refusing to fail it back")
+
 	// zeroPreimage is the empty preimage which is returned when we have
 	// some errors.
 	zeroPreimage [sha256.Size]byte
@@ -117,6 +133,11 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// DeliveryScript is the script the caller would like the funds of a
+	// cooperative close to be sent to. If empty, a new script is
+	// generated, unless an upfront shutdown script was committed to.
+	DeliveryScript lnwire.DeliveryAddress
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan *lnrpc.CloseStatusUpdate
@@ -176,6 +197,41 @@ type Config struct {
 	// LogEventTicker is a signal instructing the htlcswitch to log
 	// aggregate stats about it's forwarding during the last interval.
 	LogEventTicker ticker.Ticker
+
+	// NotifyActiveChannel allows the switch to notify outside
+	// sub-systems that the link for the given channel is eligible to
+	// forward HTLCs, and the channel is now active.
+	NotifyActiveChannel func(wire.OutPoint)
+
+	// NotifyInactiveChannel allows the switch to notify outside
+	// sub-systems that the link for the given channel has been removed,
+	// and the channel is now inactive.
+	NotifyInactiveChannel func(wire.OutPoint)
+
+	// NotifyHtlcEvent allows the switch to notify outside sub-systems of
+	// htlcs being forwarded, settled and failed. The event is one of
+	// ForwardingEvent, ForwardingFailEvent, LinkFailEvent or SettleEvent.
+	NotifyHtlcEvent func(interface{})
+
+	// PreimageCache is a global witness beacon that the preimages of
+	// forwards settled by the interceptor are added to, so their incoming
+	// HTLCs can be claimed on-chain if needed.
+	PreimageCache contractcourt.WitnessBeacon
+
+	// InterceptorTimeout is the maximum amount of time a forward is held
+	// for the interceptor before it's failed back.
+	InterceptorTimeout time.Duration
+
+	// ForwardingLimits are the initial limits on the forwards a single
+	// incoming channel or peer may have in flight through the switch.
+	// They can be adjusted with UpdateForwardingLimits.
+	ForwardingLimits ForwardingLimits
+
+	// LimitQueueTicker is a signal instructing the switch to admit the
+	// forwards queued by the forwarding limiter that are now within the
+	// limits, and fail back those that have been queued for too long. It's
+	// only resumed while forwards are queued.
+	LimitQueueTicker ticker.Ticker
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -244,6 +300,17 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// realScidIndex maps the channel ID of live links of zero-conf
+	// channels, which are identified by an alias, to the real short
+	// channel ID they're additionally indexed by in the forwardingIndex
+	// once their funding transaction has confirmed.
+	realScidIndex map[lnwire.ChannelID]lnwire.ShortChannelID
+
+	// peerAliasIndex maps the channel ID of live links of zero-conf
+	// channels to the alias their remote peer knows them by, which they're
+	// additionally indexed by in the forwardingIndex.
+	peerAliasIndex map[lnwire.ChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -277,6 +344,33 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// interceptor, if set, is handed every forward held by the switch.
+	interceptor ForwardInterceptor
+
+	// heldForwards maps the incoming circuit key of each forward held for
+	// the interceptor to the held forward.
+	heldForwards map[CircuitKey]*heldForward
+
+	// heldRecords maps the incoming circuit keys of forwards that were
+	// held before a restart, or have been resolved without their incoming
+	// link having committed the resolution yet, to their persisted
+	// record. Once these forwards are replayed by their incoming link,
+	// they're held again, or their resolution is replayed.
+	heldRecords map[CircuitKey]*heldRecord
+
+	// heldMtx guards the interceptor and the held forwards.
+	heldMtx sync.Mutex
+
+	// limiter bounds the forwards each incoming channel and peer may have
+	// in flight through the switch.
+	limiter *forwardLimiter
+
+	// disabledLinks is the set of channels that the user disabled, which
+	// no new forwards are sent over. It's kept independently of the links
+	// themselves, so that it applies to links that are added later on.
+	disabledLinks map[lnwire.ChannelID]struct{}
+	disabledMtx   sync.RWMutex
 }
 
 // New creates the new instance of htlc switch.
@@ -294,7 +388,11 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		return nil, err
 	}
 
-	return &Switch{
+	if err := cfg.ForwardingLimits.validate(); err != nil {
+		return nil, err
+	}
+
+	s := &Switch{
 		bestHeight:        currentHeight,
 		cfg:               &cfg,
 		circuits:          circuitMap,
@@ -303,14 +401,26 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		realScidIndex:     make(map[lnwire.ChannelID]lnwire.ShortChannelID),
+		peerAliasIndex:    make(map[lnwire.ChannelID]lnwire.ShortChannelID),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		pendingPayments:   make(map[uint64]*pendingPayment),
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
+		heldForwards:      make(map[CircuitKey]*heldForward),
+		limiter:           newForwardLimiter(cfg.ForwardingLimits),
+		disabledLinks:     make(map[lnwire.ChannelID]struct{}),
 		quit:              make(chan struct{}),
-	}, nil
+	}
+
+	s.heldRecords, err = s.loadHeldForwards()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
 }
 
 // resolutionMsg is a struct that wraps an existing ResolutionMsg with a done
@@ -478,6 +588,34 @@ func (s *Switch) UpdateForwardingPolicies(newPolicy ForwardingPolicy,
 	return nil
 }
 
+// SetForwardingPolicy replaces the forwarding policy of the link of the target
+// channel with the given one. In contrast to UpdateForwardingPolicies, fields
+// set to zero are applied as well, so the passed policy must be complete.
+func (s *Switch) SetForwardingPolicy(chanPoint wire.OutPoint,
+	newPolicy ForwardingPolicy) error {
+
+	log.Debugf("Setting link policy of ChannelPoint(%v): %v", chanPoint,
+		newLogClosure(func() string {
+			return spew.Sdump(newPolicy)
+		}),
+	)
+
+	cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
+
+	s.indexMtx.RLock()
+	link, ok := s.linkIndex[cid]
+	s.indexMtx.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("unable to find ChannelPoint(%v) to set link "+
+			"policy", chanPoint)
+	}
+
+	link.SetForwardingPolicy(newPolicy)
+
+	return nil
+}
+
 // forward is used in order to find next channel link and apply htlc update.
 // Also this function is used by channel links itself in order to forward the
 // update after it has been included in the channel.
@@ -510,9 +648,12 @@ func (s *Switch) forward(packet *htlcPacket) error {
 			} else {
 				failure = lnwire.NewTemporaryChannelFailure(update)
 			}
+			linkErr := NewDetailedLinkError(
+				failure, FailureDetailIncompleteForward,
+			)
 			addErr := ErrIncompleteForward
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(packet, linkErr, addErr)
 		}
 
 		packet.circuit = circuit
@@ -591,6 +732,12 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 	for _, packet := range packets {
 		switch htlc := packet.htlc.(type) {
 		case *lnwire.UpdateAddHTLC:
+			// Forwards held for the interceptor don't get a
+			// circuit until they're resumed.
+			if s.interceptForward(packet) {
+				continue
+			}
+
 			circuit := newPaymentCircuit(&htlc.PaymentHash, packet)
 			packet.circuit = circuit
 			circuits = append(circuits, circuit)
@@ -662,13 +809,17 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 			failure = lnwire.NewTemporaryChannelFailure(update)
 		}
 
+		linkErr := NewDetailedLinkError(
+			failure, FailureDetailIncompleteForward,
+		)
+
 		for _, packet := range failedPackets {
 			addErr := errors.New("failing packet after " +
 				"detecting incomplete forward")
 
 			// We don't handle the error here since this method
 			// always returns an error.
-			s.failAddPacket(packet, failure, addErr)
+			s.failAddPacket(packet, linkErr, addErr)
 		}
 	}
 
@@ -774,10 +925,8 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 		s.indexMtx.RUnlock()
 		if err != nil {
 			log.Errorf("Link %v not found", pkt.outgoingChanID)
-			return &ForwardingError{
-				ErrorSource:    s.cfg.SelfKey,
-				FailureMessage: &lnwire.FailUnknownNextPeer{},
-			}
+			linkErr := NewLinkError(&lnwire.FailUnknownNextPeer{})
+			return s.failLocalDispatch(pkt, linkErr, "")
 		}
 
 		if !link.EligibleToForward() {
@@ -787,12 +936,11 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 
 			// The update does not need to be populated as the error
 			// will be returned back to the router.
-			htlcErr := lnwire.NewTemporaryChannelFailure(nil)
-			return &ForwardingError{
-				ErrorSource:    s.cfg.SelfKey,
-				ExtraMsg:       err.Error(),
-				FailureMessage: htlcErr,
-			}
+			linkErr := NewDetailedLinkError(
+				lnwire.NewTemporaryChannelFailure(nil),
+				FailureDetailLinkNotEligible,
+			)
+			return s.failLocalDispatch(pkt, linkErr, err.Error())
 		}
 
 		if link.Bandwidth() < htlc.Amount {
@@ -803,12 +951,11 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 
 			// The update does not need to be populated as the error
 			// will be returned back to the router.
-			htlcErr := lnwire.NewTemporaryChannelFailure(nil)
-			return &ForwardingError{
-				ErrorSource:    s.cfg.SelfKey,
-				ExtraMsg:       err.Error(),
-				FailureMessage: htlcErr,
-			}
+			linkErr := NewDetailedLinkError(
+				lnwire.NewTemporaryChannelFailure(nil),
+				FailureDetailInsufficientBalance,
+			)
+			return s.failLocalDispatch(pkt, linkErr, err.Error())
 		}
 
 		return link.HandleSwitchPacket(pkt)
@@ -820,6 +967,28 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 	return nil
 }
 
+// failLocalDispatch reports a locally initiated htlc that couldn't be handed
+// to its outgoing link to the htlc notifier, and returns the error to be
+// passed back to the router.
+func (s *Switch) failLocalDispatch(pkt *htlcPacket, linkErr *LinkError,
+	extraMsg string) *ForwardingError {
+
+	s.cfg.NotifyHtlcEvent(LinkFailEvent{
+		HtlcKey:       newHtlcKey(pkt),
+		HtlcInfo:      newHtlcInfo(pkt),
+		HtlcEventType: HtlcEventTypeSend,
+		LinkError:     linkErr,
+		Incoming:      false,
+		Timestamp:     time.Now(),
+	})
+
+	return &ForwardingError{
+		ErrorSource:    s.cfg.SelfKey,
+		ExtraMsg:       extraMsg,
+		FailureMessage: linkErr.WireMessage(),
+	}
+}
+
 // handleLocalResponse processes a Settle or Fail responding to a
 // locally-initiated payment. This is handled asynchronously to avoid blocking
 // the main event loop within the switch, as these operations can require
@@ -890,6 +1059,13 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 
 		preimage = htlc.PaymentPreimage
 
+		s.cfg.NotifyHtlcEvent(SettleEvent{
+			HtlcKey:       circuitHtlcKey(pkt.circuit),
+			HtlcInfo:      circuitHtlcInfo(pkt.circuit),
+			HtlcEventType: HtlcEventTypeSend,
+			Timestamp:     time.Now(),
+		})
+
 	// We've received a fail update which means we can finalize the user
 	// payment and return fail response.
 	case *lnwire.UpdateFailHTLC:
@@ -903,7 +1079,20 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 			return
 		}
 
-		paymentErr = s.parseFailedPayment(payment, pkt, htlc)
+		failure := s.parseFailedPayment(payment, pkt, htlc)
+		paymentErr = failure
+
+		// Failures that never cleared the outgoing link have already
+		// been reported to the htlc notifier by the link.
+		if !pkt.localFailure {
+			s.cfg.NotifyHtlcEvent(ForwardingFailEvent{
+				HtlcKey:        circuitHtlcKey(pkt.circuit),
+				HtlcInfo:       circuitHtlcInfo(pkt.circuit),
+				HtlcEventType:  HtlcEventTypeSend,
+				FailureMessage: failure.FailureMessage,
+				Timestamp:      time.Now(),
+			})
+		}
 
 	default:
 		log.Warnf("Received unknown response type: %T", pkt.htlc)
@@ -1020,6 +1209,14 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// Before selecting an outgoing link, we'll check the forward
+		// against the limits of its incoming channel and peer.
+		// Forwards over the limits are either failed back, or queued
+		// and handled again once they're admitted.
+		if admitted, err := s.limitForward(packet); !admitted {
+			return err
+		}
+
 		s.indexMtx.RLock()
 		targetLink, err := s.getLinkByShortID(packet.outgoingChanID)
 		if err != nil {
@@ -1028,11 +1225,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// If packet was forwarded from another channel link
 			// than we should notify this link that some error
 			// occurred.
-			failure := &lnwire.FailUnknownNextPeer{}
+			linkErr := NewLinkError(&lnwire.FailUnknownNextPeer{})
 			addErr := fmt.Errorf("unable to find link with "+
 				"destination %v", packet.outgoingChanID)
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(packet, linkErr, addErr)
 		}
 		interfaceLinks, _ := s.getLinks(targetLink.Peer().PubKey())
 		s.indexMtx.RUnlock()
@@ -1057,6 +1254,15 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// we'll skip it as well.
 			case link.ShortChanID() == sourceHop:
 				continue
+
+			// If the user disabled the link, then we'll record
+			// the failure, in case this is the link the sender
+			// selected.
+			case s.isLinkDisabled(link.ChanID()):
+				linkErrs[link.ShortChanID()] = s.disabledFailure(
+					link.ShortChanID(),
+				)
+				continue
 			}
 
 			// Before we check the link's bandwidth, we'll ensure
@@ -1099,11 +1305,14 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				failure = lnwire.NewTemporaryChannelFailure(update)
 			}
 
+			linkErr := NewDetailedLinkError(
+				failure, FailureDetailInsufficientBalance,
+			)
 			addErr := fmt.Errorf("unable to find appropriate "+
 				"channel link insufficient capacity, need "+
 				"%v", htlc.Amount)
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(packet, linkErr, addErr)
 
 		// If we had a forwarding failure due to the HTLC not
 		// satisfying the current policy, then we'll send back an
@@ -1113,12 +1322,12 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// At this point, some or all of the links rejected the
 			// HTLC so we couldn't forward it. So we'll try to look
 			// up the error that came from the source.
-			linkErr, ok := linkErrs[packet.outgoingChanID]
+			failure, ok := linkErrs[packet.outgoingChanID]
 			if !ok {
 				// If we can't find the error of the source,
 				// then we'll return an unknown next peer,
 				// though this should never happen.
-				linkErr = &lnwire.FailUnknownNextPeer{}
+				failure = &lnwire.FailUnknownNextPeer{}
 				log.Warnf("unable to find err source for "+
 					"outgoing_link=%v, errors=%v",
 					packet.outgoingChanID, newLogClosure(func() string {
@@ -1129,9 +1338,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			addErr := fmt.Errorf("incoming HTLC(%x) violated "+
 				"target outgoing link (id=%v) policy: %v",
 				htlc.PaymentHash[:], packet.outgoingChanID,
-				linkErr)
+				failure)
 
-			return s.failAddPacket(packet, linkErr, addErr)
+			return s.failAddPacket(
+				packet, NewLinkError(failure), addErr,
+			)
 		}
 
 		// Send the packet to the destination channel link which
@@ -1206,6 +1417,31 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// The forward is no longer in flight, so we'll release it
+		// from the limits of its incoming channel and peer.
+		s.limiter.release(circuit.Incoming)
+
+		// Report the settle or fail of the forward to the htlc
+		// notifier. Failures with a source were failed by the outgoing
+		// link, which already reported them.
+		switch {
+		case !isFail:
+			s.cfg.NotifyHtlcEvent(SettleEvent{
+				HtlcKey:       circuitHtlcKey(circuit),
+				HtlcInfo:      circuitHtlcInfo(circuit),
+				HtlcEventType: HtlcEventTypeForward,
+				Timestamp:     time.Now(),
+			})
+
+		case !packet.hasSource:
+			s.cfg.NotifyHtlcEvent(ForwardingFailEvent{
+				HtlcKey:       circuitHtlcKey(circuit),
+				HtlcInfo:      circuitHtlcInfo(circuit),
+				HtlcEventType: HtlcEventTypeForward,
+				Timestamp:     time.Now(),
+			})
+		}
+
 		// Check to see that the source link is online before removing
 		// the circuit.
 		return s.mailOrchestrator.Deliver(packet.incomingChanID, packet)
@@ -1215,16 +1451,117 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 	}
 }
 
+// limitForward checks the given forward against the limits of its incoming
+// channel and peer, returning true if it may proceed. Forwards over the
+// limits are either queued, or failed back with the returned error.
+func (s *Switch) limitForward(packet *htlcPacket) (bool, error) {
+	var peer [33]byte
+	s.indexMtx.RLock()
+	incomingLink, err := s.getLinkByShortID(packet.incomingChanID)
+	if err == nil {
+		peer = incomingLink.Peer().PubKey()
+	}
+	s.indexMtx.RUnlock()
+
+	result, detail := s.limiter.admit(packet, peer)
+	switch result {
+	case limitAdmitted:
+		return true, nil
+
+	case limitQueued:
+		log.Debugf("Queued forward %v from peer %x: %v",
+			packet.inKey(), peer, detail)
+
+		s.cfg.LimitQueueTicker.Resume()
+
+		return false, nil
+	}
+
+	addErr := fmt.Errorf("forward %v from peer %x rejected: %v",
+		packet.inKey(), peer, detail)
+
+	return false, s.failAddPacket(
+		packet, s.limitLinkError(packet, detail), addErr,
+	)
+}
+
+// processQueuedForwards handles the forwards queued by the forwarding limiter
+// that have now been admitted, and fails back those that have been queued
+// for too long. Once no forwards are queued, the limit queue ticker is paused.
+func (s *Switch) processQueuedForwards() {
+	admitted, expired := s.limiter.dequeue()
+
+	for _, packet := range expired {
+		linkErr := s.limitLinkError(packet, FailureDetailQueueTimeout)
+		addErr := fmt.Errorf("queued forward %v timed out",
+			packet.inKey())
+
+		// We don't handle the error here since this method always
+		// returns an error.
+		s.failAddPacket(packet, linkErr, addErr)
+	}
+
+	for _, packet := range admitted {
+		if err := s.handlePacketForward(packet); err != nil {
+			log.Errorf("Unable to forward queued packet %v: %v",
+				packet.inKey(), err)
+		}
+	}
+
+	if !s.limiter.hasQueued() {
+		s.cfg.LimitQueueTicker.Pause()
+	}
+}
+
+// limitLinkError returns the link error used to fail back a forward that
+// exceeded the forwarding limits.
+func (s *Switch) limitLinkError(packet *htlcPacket,
+	detail FailureDetail) *LinkError {
+
+	var failure lnwire.FailureMessage
+	update, err := s.cfg.FetchLastChannelUpdate(packet.outgoingChanID)
+	if err != nil {
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	} else {
+		failure = lnwire.NewTemporaryChannelFailure(update)
+	}
+
+	return NewDetailedLinkError(failure, detail)
+}
+
+// ForwardingLimits returns the current limits on the forwards a single
+// incoming channel or peer may have in flight through the switch.
+func (s *Switch) ForwardingLimits() ForwardingLimits {
+	return s.limiter.Limits()
+}
+
+// UpdateForwardingLimits replaces the limits on the forwards a single
+// incoming channel or peer may have in flight through the switch. The new
+// limits apply to all forwards checked from now on.
+func (s *Switch) UpdateForwardingLimits(limits ForwardingLimits) error {
+	return s.limiter.SetLimits(limits)
+}
+
+// ForwardingLimitUsage returns the forwards in flight from each incoming
+// channel and peer, along with running totals of how their forwards were
+// handled by the forwarding limiter.
+func (s *Switch) ForwardingLimitUsage() (map[lnwire.ShortChannelID]LimitUsage,
+	map[[33]byte]LimitUsage) {
+
+	return s.limiter.Usage()
+}
+
 // failAddPacket encrypts a fail packet back to an add packet's source.
-// The ciphertext will be derived from the failure message proivded by context.
-// This method returns the failErr if all other steps complete successfully.
-func (s *Switch) failAddPacket(packet *htlcPacket,
-	failure lnwire.FailureMessage, failErr error) error {
+// The ciphertext will be derived from the wire message of the link error
+// provided by context, and the failure is reported to the htlc notifier. This
+// method returns the failErr if all other steps complete successfully.
+func (s *Switch) failAddPacket(packet *htlcPacket, linkErr *LinkError,
+	failErr error) error {
 
 	// Encrypt the failure so that the sender will be able to read the error
 	// message. Since we failed this packet, we use EncryptFirstHop to
 	// obfuscate the failure for their eyes only.
-	reason, err := packet.obfuscator.EncryptFirstHop(failure)
+	reason, err := packet.obfuscator.EncryptFirstHop(linkErr.WireMessage())
 	if err != nil {
 		err := fmt.Errorf("unable to obfuscate "+
 			"error: %v", err)
@@ -1234,6 +1571,17 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	s.limiter.release(packet.inKey())
+
+	s.cfg.NotifyHtlcEvent(LinkFailEvent{
+		HtlcKey:       newHtlcKey(packet),
+		HtlcInfo:      newHtlcInfo(packet),
+		HtlcEventType: HtlcEventTypeForward,
+		LinkError:     linkErr,
+		Incoming:      false,
+		Timestamp:     time.Now(),
+	})
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -1412,10 +1760,12 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then the fee parameter should be the ideal fee-per-kw that will be used as
+// a starting point for close negotiation, and the optional delivery script the
+// script to send our funds to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
+	targetFeePerKw lnwallet.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan *lnrpc.CloseStatusUpdate,
 	chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
@@ -1427,6 +1777,7 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1516,6 +1867,9 @@ func (s *Switch) htlcForwarder() {
 	s.cfg.FwdEventTicker.Resume()
 	defer s.cfg.FwdEventTicker.Stop()
 
+	// The limit queue ticker is only resumed once forwards are queued.
+	defer s.cfg.LimitQueueTicker.Stop()
+
 out:
 	for {
 		select {
@@ -1589,6 +1943,12 @@ out:
 		case cmd := <-s.htlcPlex:
 			cmd.err <- s.handlePacketForward(cmd.pkt)
 
+		// Forwards are queued by the forwarding limiter, so we'll
+		// admit those that are now within the limits, and fail back
+		// those that have been queued for too long.
+		case <-s.cfg.LimitQueueTicker.Ticks():
+			s.processQueuedForwards()
+
 		// When this time ticks, then it indicates that we should
 		// collect all the forwarding events since the last internal,
 		// and write them out to our log.
@@ -1932,6 +2292,12 @@ func (s *Switch) AddLink(link ChannelLink) error {
 		)
 	}
 
+	// Links of channels whose short channel ID or next revocation point
+	// isn't known yet are only reported active once they are.
+	if link.EligibleToForward() {
+		s.cfg.NotifyActiveChannel(*link.ChannelPoint())
+	}
+
 	return nil
 }
 
@@ -1944,6 +2310,8 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	// in the multi-hop setting.
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
+	s.indexRealShortChanID(link)
+	s.indexPeerAlias(link)
 
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
@@ -1954,6 +2322,54 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.interfaceIndex[peerPub][link.ChanID()] = link
 }
 
+// indexRealShortChanID makes the link of a zero-conf channel available for
+// forwarding under the real short channel ID of its funding output, in
+// addition to the alias it's identified by. Any real short channel ID indexed
+// before is removed, as the funding transaction may have been reorged.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) indexRealShortChanID(link ChannelLink) {
+	chanID := link.ChanID()
+	if oldScid, ok := s.realScidIndex[chanID]; ok {
+		delete(s.forwardingIndex, oldScid)
+		delete(s.realScidIndex, chanID)
+	}
+
+	realScid := link.RealShortChanID()
+	if realScid == sourceHop || realScid == link.ShortChanID() {
+		return
+	}
+
+	s.forwardingIndex[realScid] = link
+	s.realScidIndex[chanID] = realScid
+}
+
+// indexPeerAlias makes the link of a zero-conf channel available for
+// forwarding under the alias its remote peer knows the channel by, as the
+// peer may have handed it out in route hints.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) indexPeerAlias(link ChannelLink) {
+	peerAlias := link.PeerAliasShortChanID()
+	if peerAlias == sourceHop {
+		return
+	}
+
+	// Aliases are picked at random by each node, so we'll make sure the
+	// peer's alias doesn't shadow another channel of ours.
+	if other, ok := s.forwardingIndex[peerAlias]; ok &&
+		other.ChanID() != link.ChanID() {
+
+		log.Warnf("Peer alias %v of ChannelLink(%v) collides with "+
+			"ChannelLink(%v), not indexing it", peerAlias,
+			link.ChanID(), other.ChanID())
+		return
+	}
+
+	s.forwardingIndex[peerAlias] = link
+	s.peerAliasIndex[link.ChanID()] = peerAlias
+}
+
 // GetLink is used to initiate the handling of the get link command. The
 // request will be propagated/handled to/in the main goroutine.
 func (s *Switch) GetLink(chanID lnwire.ChannelID) (ChannelLink, error) {
@@ -2000,6 +2416,45 @@ func (s *Switch) HasActiveLink(chanID lnwire.ChannelID) bool {
 	return ok
 }
 
+// SetLinkDisabled sets whether the user disabled the channel with the given
+// channel ID. No new forwards are sent over a disabled channel, while htlcs
+// already in flight over it are still resolved, and payments initiated by our
+// node may still use it. The setting also applies to links for the channel
+// that are added later on.
+func (s *Switch) SetLinkDisabled(chanID lnwire.ChannelID, disabled bool) {
+	s.disabledMtx.Lock()
+	defer s.disabledMtx.Unlock()
+
+	if disabled {
+		s.disabledLinks[chanID] = struct{}{}
+	} else {
+		delete(s.disabledLinks, chanID)
+	}
+}
+
+// isLinkDisabled returns true if the user disabled the channel with the given
+// channel ID.
+func (s *Switch) isLinkDisabled(chanID lnwire.ChannelID) bool {
+	s.disabledMtx.RLock()
+	defer s.disabledMtx.RUnlock()
+
+	_, ok := s.disabledLinks[chanID]
+	return ok
+}
+
+// disabledFailure returns the failure message used to fail back a forward
+// over a disabled channel.
+func (s *Switch) disabledFailure(
+	chanID lnwire.ShortChannelID) lnwire.FailureMessage {
+
+	update, err := s.cfg.FetchLastChannelUpdate(chanID)
+	if err != nil || update == nil {
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return lnwire.NewChannelDisabled(uint16(update.Flags), *update)
+}
+
 // RemoveLink purges the switch of any link associated with chanID. If a pending
 // or active link is not found, this method does nothing. Otherwise, the method
 // returns after the link has been completely shutdown.
@@ -2028,6 +2483,14 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if realScid, ok := s.realScidIndex[link.ChanID()]; ok {
+		delete(s.forwardingIndex, realScid)
+		delete(s.realScidIndex, link.ChanID())
+	}
+	if peerAlias, ok := s.peerAliasIndex[link.ChanID()]; ok {
+		delete(s.forwardingIndex, peerAlias)
+		delete(s.peerAliasIndex, link.ChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2042,6 +2505,8 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 		}
 	}
 
+	s.cfg.NotifyInactiveChannel(*link.ChannelPoint())
+
 	return link
 }
 
@@ -2056,7 +2521,26 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	// exists, then we will ignore the request.
 	link, ok := s.pendingLinkIndex[chanID]
 	if !ok {
-		return fmt.Errorf("link %v not found", chanID)
+		// Live links only have their short channel ID updated if
+		// they belong to a zero-conf channel, whose funding
+		// transaction either confirmed or was reorged out. Such links
+		// remain identified by their alias, so we'll only need to
+		// index them by the real short channel ID.
+		link, ok = s.linkIndex[chanID]
+		if !ok || !link.ShortChanID().IsAlias() {
+			return fmt.Errorf("link %v not found", chanID)
+		}
+
+		if _, err := link.UpdateShortChanID(); err != nil {
+			return err
+		}
+		s.indexRealShortChanID(link)
+
+		log.Infof("Updated real short_chan_id for ChannelLink(%v): "+
+			"alias=%v, real=%v", chanID, link.ShortChanID(),
+			link.RealShortChanID())
+
+		return nil
 	}
 
 	oldShortChanID := link.ShortChanID()
@@ -2090,6 +2574,10 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 		mailbox, chanID, shortChanID,
 	)
 
+	if link.EligibleToForward() {
+		s.cfg.NotifyActiveChannel(*link.ChannelPoint())
+	}
+
 	return nil
 }
 
@@ -2147,7 +2635,10 @@ func (s *Switch) findPayment(paymentID uint64) *pendingPayment {
 // CircuitModifier returns a reference to subset of the interfaces provided by
 // the circuit map, to allow links to open and close circuits.
 func (s *Switch) CircuitModifier() CircuitModifier {
-	return s.circuits
+	return &heldCircuitModifier{
+		CircuitModifier: s.circuits,
+		s:               s,
+	}
 }
 
 // numPendingPayments is helper function which returns the overall number of
@@ -2190,6 +2681,70 @@ func (s *Switch) lookupOpenCircuit(outKey CircuitKey) *PaymentCircuit {
 	return s.circuits.LookupOpenCircuit(outKey)
 }
 
+// ListCircuits returns a snapshot of all circuits currently held by the
+// switch's circuit map, including half-added circuits that haven't been
+// assigned a keystone yet.
+func (s *Switch) ListCircuits() []*PaymentCircuit {
+	return s.circuits.ListCircuits()
+}
+
+// FailStuckCircuit fails back the incoming HTLC of the open circuit identified
+// by inKey, as if its outgoing HTLC had been cancelled. This is meant to
+// release an incoming HTLC whose outgoing channel has been resolved on-chain
+// without the circuit being torn down. The switch only verifies that the
+// outgoing link is gone, the incoming link is active, and the preimage of the
+// HTLC isn't known.
+//
+// NOTE: The caller MUST ensure that the outgoing HTLC can no longer be
+// settled, otherwise the funds of the forward may be lost.
+func (s *Switch) FailStuckCircuit(inKey CircuitKey) error {
+	circuit := s.circuits.LookupCircuit(inKey)
+	if circuit == nil {
+		return ErrUnknownCircuit
+	}
+	if !circuit.HasKeystone() {
+		return ErrCircuitNotForwarded
+	}
+	outKey := *circuit.Outgoing
+
+	s.indexMtx.RLock()
+	_, outErr := s.getLinkByShortID(outKey.ChanID)
+	_, inErr := s.getLinkByShortID(inKey.ChanID)
+	s.indexMtx.RUnlock()
+
+	if outErr == nil {
+		return fmt.Errorf("outgoing channel %v is still active",
+			outKey.ChanID)
+	}
+
+	// Failures for locally initiated payments are handled by the switch
+	// itself, all others must be delivered to an active incoming link.
+	if inKey.ChanID != sourceHop && inErr != nil {
+		return fmt.Errorf("incoming channel %v is not active",
+			inKey.ChanID)
+	}
+
+	// Even if the outgoing channel is fully resolved, the remote party may
+	// have swept the outgoing HTLC with its preimage, without the settle
+	// ever making it back to the incoming link. Failing back the incoming
+	// HTLC would then lose us the funds of the forward. Instead, the
+	// incoming HTLC will be claimed with the preimage on-chain once it
+	// nears its expiry.
+	_, ok := s.cfg.PreimageCache.LookupPreimage(circuit.PaymentHash[:])
+	if ok {
+		return ErrCircuitPreimageKnown
+	}
+
+	log.Warnf("Manually failing back circuit %v, with outgoing htlc %v",
+		inKey, outKey)
+
+	return s.ProcessContractResolution(contractcourt.ResolutionMsg{
+		SourceChan: outKey.ChanID,
+		HtlcIndex:  outKey.HtlcID,
+		Failure:    &lnwire.FailPermanentChannelFailure{},
+	})
+}
+
 // FlushForwardingEvents flushes out the set of pending forwarding events to
 // the persistent log. This will be used by the switch to periodically flush
 // out the set of forwarding events to disk. External callers can also use this
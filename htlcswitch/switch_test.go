@@ -177,6 +177,97 @@ func TestSwitchSendPending(t *testing.T) {
 	}
 }
 
+// TestSwitchZeroConfRealShortChanID checks that the live link of a zero-conf
+// channel, which is identified by an alias, is indexed by the real short
+// channel ID of its funding output once it confirmed, and that it's removed
+// from the index again if the funding transaction is reorged out. The link
+// should also be found by the alias the remote peer knows the channel by.
+func TestSwitchZeroConfRealShortChanID(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	alias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight,
+		TxIndex:     1,
+	}
+	peerAlias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight + 1,
+		TxIndex:     2,
+	}
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, alias, alicePeer, true,
+	)
+	aliceChannelLink.peerAlias = peerAlias
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	// Before the funding transaction confirmed, the link can only be
+	// found by its alias, and the one the peer knows it by.
+	assertLink := func(scid lnwire.ShortChannelID, found bool) {
+		t.Helper()
+
+		s.indexMtx.RLock()
+		link, err := s.getLinkByShortID(scid)
+		s.indexMtx.RUnlock()
+
+		switch {
+		case found && err != nil:
+			t.Fatalf("unable to find link by %v: %v", scid, err)
+		case found && link != aliceChannelLink:
+			t.Fatalf("found wrong link by %v", scid)
+		case !found && err == nil:
+			t.Fatalf("expected no link to be found by %v", scid)
+		}
+	}
+	assertLink(alias, true)
+	assertLink(peerAlias, true)
+	assertLink(aliceChanID, false)
+
+	// Once the funding transaction confirms, the link should also be
+	// found by its real short channel ID.
+	aliceChannelLink.realShortChanID = aliceChanID
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+	assertLink(alias, true)
+	assertLink(aliceChanID, true)
+
+	// Should the funding transaction be reorged out, the real short
+	// channel ID is removed from the index again.
+	aliceChannelLink.realShortChanID = lnwire.ShortChannelID{}
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+	assertLink(alias, true)
+	assertLink(aliceChanID, false)
+
+	// Finally, removing the link should clear all entries.
+	aliceChannelLink.realShortChanID = aliceChanID
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+	s.RemoveLink(chanID1)
+	assertLink(alias, false)
+	assertLink(peerAlias, false)
+	assertLink(aliceChanID, false)
+}
+
 // TestSwitchForward checks the ability of htlc switch to forward add/settle
 // requests.
 func TestSwitchForward(t *testing.T) {
@@ -1912,3 +2003,146 @@ func TestMultiHopPaymentForwardingEvents(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchDisabledLink asserts that the switch fails back new forwards over
+// a link the user disabled, and resumes forwarding once it's re-enabled.
+func TestSwitchDisabledLink(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	ctx.s.SetLinkDisabled(ctx.bobLink.ChanID(), true)
+
+	packet := &htlcPacket{
+		incomingChanID: ctx.aliceLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: ctx.bobLink.ShortChanID(),
+		incomingAmount: 2000,
+		amount:         1000,
+		obfuscator:     NewMockObfuscator(),
+		htlc:           &lnwire.UpdateAddHTLC{Amount: 1000},
+	}
+	for range ctx.s.ForwardPackets(nil, packet) {
+	}
+	failPkt := ctx.assertPacket(ctx.aliceLink)
+	if _, ok := failPkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatalf("expected fail htlc, got %T", failPkt.htlc)
+	}
+	ctx.assertNoPacket(ctx.bobLink)
+
+	// Once the link is enabled again, forwards are sent over it.
+	ctx.s.SetLinkDisabled(ctx.bobLink.ChanID(), false)
+
+	ctx.forward(1, [32]byte{})
+	ctx.assertPacket(ctx.bobLink)
+}
+
+// TestSwitchFailStuckCircuit checks that the switch only fails back a circuit
+// manually once it has been forwarded and its outgoing link is gone.
+func TestSwitchFailStuckCircuit(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	// Unknown circuits can't be failed.
+	inKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 0}
+	if err := ctx.s.FailStuckCircuit(inKey); err != ErrUnknownCircuit {
+		t.Fatalf("expected ErrUnknownCircuit, got %v", err)
+	}
+
+	// Forward two htlcs to bob, and only assign a keystone to the first.
+	ctx.forward(0, [32]byte{0})
+	ctx.assertPacket(ctx.bobLink)
+	ctx.forward(1, [32]byte{1})
+	ctx.assertPacket(ctx.bobLink)
+
+	err := ctx.s.openCircuits(Keystone{
+		InKey:  inKey,
+		OutKey: CircuitKey{ChanID: ctx.bobLink.ShortChanID(), HtlcID: 0},
+	})
+	if err != nil {
+		t.Fatalf("unable to open circuit: %v", err)
+	}
+
+	circuits := ctx.s.ListCircuits()
+	if len(circuits) != 2 {
+		t.Fatalf("expected 2 circuits, got %v", len(circuits))
+	}
+	if !circuits[0].HasKeystone() || circuits[1].HasKeystone() {
+		t.Fatalf("unexpected keystones in listed circuits")
+	}
+
+	// Half-added circuits can't be failed.
+	halfKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 1}
+	err = ctx.s.FailStuckCircuit(halfKey)
+	if err != ErrCircuitNotForwarded {
+		t.Fatalf("expected ErrCircuitNotForwarded, got %v", err)
+	}
+
+	// As long as the outgoing link is active, the circuit can't be failed
+	// either.
+	if err := ctx.s.FailStuckCircuit(inKey); err == nil {
+		t.Fatalf("expected failure with active outgoing link")
+	}
+	ctx.assertNoPacket(ctx.aliceLink)
+
+	// Once bob's link is removed, the htlc is failed back to alice.
+	ctx.s.RemoveLink(ctx.bobLink.ChanID())
+
+	if err := ctx.s.FailStuckCircuit(inKey); err != nil {
+		t.Fatalf("unable to fail circuit: %v", err)
+	}
+	failPkt := ctx.assertPacket(ctx.aliceLink)
+	if _, ok := failPkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatalf("expected fail htlc, got %T", failPkt.htlc)
+	}
+	if failPkt.incomingHTLCID != 0 {
+		t.Fatalf("expected htlc 0 to be failed, got %v",
+			failPkt.incomingHTLCID)
+	}
+}
+
+// TestSwitchFailStuckCircuitPreimageKnown checks that the switch refuses to
+// fail back a circuit whose preimage is known, as the outgoing HTLC may have
+// been swept on-chain with it.
+func TestSwitchFailStuckCircuitPreimageKnown(t *testing.T) {
+	t.Parallel()
+
+	ctx := newInterceptorTestCtx(t)
+	defer ctx.s.Stop()
+
+	preimage := [32]byte{1, 2, 3}
+	hash := sha256.Sum256(preimage[:])
+
+	inKey := CircuitKey{ChanID: ctx.aliceLink.ShortChanID(), HtlcID: 0}
+	ctx.forward(0, hash)
+	ctx.assertPacket(ctx.bobLink)
+
+	err := ctx.s.openCircuits(Keystone{
+		InKey:  inKey,
+		OutKey: CircuitKey{ChanID: ctx.bobLink.ShortChanID(), HtlcID: 0},
+	})
+	if err != nil {
+		t.Fatalf("unable to open circuit: %v", err)
+	}
+
+	// Bob swept the outgoing htlc on-chain with the preimage, which we
+	// learnt, but the settle never made it to alice's link.
+	ctx.s.RemoveLink(ctx.bobLink.ChanID())
+	if err := ctx.s.cfg.PreimageCache.AddPreimage(preimage[:]); err != nil {
+		t.Fatalf("unable to add preimage: %v", err)
+	}
+
+	err = ctx.s.FailStuckCircuit(inKey)
+	if err != ErrCircuitPreimageKnown {
+		t.Fatalf("expected ErrCircuitPreimageKnown, got %v", err)
+	}
+	ctx.assertNoPacket(ctx.aliceLink)
+
+	// The circuit must still be around, so the htlc can be settled.
+	if ctx.s.lookupCircuit(inKey) == nil {
+		t.Fatalf("circuit should not have been removed")
+	}
+}
@@ -255,7 +255,7 @@ func createTestChannel(alicePrivKey, bobPrivKey []byte,
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(aliceAmount,
 		bobAmount, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, channeldb.SingleFunder)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -911,6 +911,7 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			Registry:               aliceServer.registry,
 			FeeEstimator:           feeEstimator,
 			PreimageCache:          pCache,
+			NotifyHtlcEvent:        func(interface{}) {},
 			UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 				return nil
 			},
@@ -954,6 +955,7 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			Registry:               bobServer.registry,
 			FeeEstimator:           feeEstimator,
 			PreimageCache:          pCache,
+			NotifyHtlcEvent:        func(interface{}) {},
 			UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 				return nil
 			},
@@ -997,6 +999,7 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			Registry:               bobServer.registry,
 			FeeEstimator:           feeEstimator,
 			PreimageCache:          pCache,
+			NotifyHtlcEvent:        func(interface{}) {},
 			UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 				return nil
 			},
@@ -1040,6 +1043,7 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			Registry:               carolServer.registry,
 			FeeEstimator:           feeEstimator,
 			PreimageCache:          pCache,
+			NotifyHtlcEvent:        func(interface{}) {},
 			UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 				return nil
 			},
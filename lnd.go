@@ -45,6 +45,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/walletunlocker"
@@ -261,6 +262,35 @@ func lndMain() error {
 				return err
 			}
 		}
+
+		// The signer macaroons are created on their own, so that nodes
+		// which already have their other macaroon files also receive
+		// them. A node using a remote signer doesn't expose the Signer
+		// service, so it doesn't need them.
+		if !cfg.RemoteSigner.Active && !fileExists(cfg.SignerMacPath) {
+			err = genSignerMacaroon(
+				ctx, macaroonService, cfg.SignerMacPath,
+				signerPermissions,
+			)
+			if err != nil {
+				ltndLog.Errorf("unable to create signer "+
+					"macaroon file: %v", err)
+				return err
+			}
+		}
+		if !cfg.RemoteSigner.Active &&
+			!fileExists(cfg.RemoteSignerMacPath) {
+
+			err = genSignerMacaroon(
+				ctx, macaroonService, cfg.RemoteSignerMacPath,
+				remoteSignerPermissions,
+			)
+			if err != nil {
+				ltndLog.Errorf("unable to create remote "+
+					"signer macaroon file: %v", err)
+				return err
+			}
+		}
 	}
 
 	// With the information parsed from the configuration, create valid
@@ -333,6 +363,19 @@ func lndMain() error {
 	grpcServer := grpc.NewServer(serverOpts...)
 	lnrpc.RegisterLightningServer(grpcServer, rpcServer)
 
+	// We'll also expose our signing capabilities, which allows this node
+	// to act as the remote signer of an instance that holds no keys. If
+	// we're using a remote signer ourselves, we hold no keys to sign with,
+	// and won't proxy the signer's.
+	if !cfg.RemoteSigner.Active {
+		signerServer := remotesigner.NewServer(
+			activeChainControl.signer,
+			activeChainControl.msgSigner,
+			activeChainControl.wallet.SecretKeyRing,
+		)
+		lnrpc.RegisterSignerServer(grpcServer, signerServer)
+	}
+
 	// Next, Start the gRPC server listening for HTTP/2 connections.
 	for _, listener := range cfg.RPCListeners {
 		lis, err := lncfg.ListenOnAddress(listener)
@@ -663,6 +706,33 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// genSignerMacaroon generates a macaroon file that only grants the given
+// permissions on the Signer service. This allows external applications to
+// build contracts around lnd's keys, or a watch-only node to use this node as
+// its remote signer, without being able to access any of its other
+// functionality.
+func genSignerMacaroon(ctx context.Context, svc *macaroons.Service,
+	signerFile string, ops []bakery.Op) error {
+
+	signerMac, err := svc.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, ops...,
+	)
+	if err != nil {
+		return err
+	}
+	signerMacBytes, err := signerMac.M().MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(signerFile, signerMacBytes, 0600)
+	if err != nil {
+		os.Remove(signerFile)
+		return err
+	}
+
+	return nil
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -708,9 +778,11 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	macaroonFiles := []string{
 		filepath.Join(networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
+		cfg.SignerMacPath, cfg.RemoteSignerMacPath,
 	}
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, macaroonFiles,
+		cfg.RemoteSigner.Active,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
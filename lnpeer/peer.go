@@ -34,6 +34,10 @@ type Peer interface {
 	// Address returns the network address of the remote peer.
 	Address() net.Addr
 
+	// RemoteLocalFeatures returns the local feature vector the remote peer
+	// sent within its init message.
+	RemoteLocalFeatures() *lnwire.FeatureVector
+
 	// QuitSignal is a method that should return a channel which will be
 	// sent upon or closed once the backing peer exits. This allows callers
 	// using the interface to cancel any processing in the event the backing
@@ -19,6 +19,8 @@ It has these top-level messages:
 	Transaction
 	GetTransactionsRequest
 	TransactionDetails
+	LabelTransactionRequest
+	LabelTransactionResponse
 	FeeLimit
 	SendRequest
 	SendResponse
@@ -41,27 +43,41 @@ It has these top-level messages:
 	DisconnectPeerResponse
 	HTLC
 	Channel
+	ChannelCommitStats
 	ListChannelsRequest
 	ListChannelsResponse
 	ChannelCloseSummary
 	ClosedChannelsRequest
 	ClosedChannelsResponse
+	ChannelEventSubscription
+	ChannelEventUpdate
 	Peer
 	ListPeersRequest
 	ListPeersResponse
 	GetInfoRequest
 	GetInfoResponse
+	GetRecoveryInfoRequest
+	GetRecoveryInfoResponse
+	RescanRequest
+	RescanResponse
+	FeeEstimatorHealthRequest
+	FeeSourceHealth
+	FeeEstimatorHealthResponse
 	ConfirmationUpdate
 	ChannelOpenUpdate
 	ChannelCloseUpdate
 	CloseChannelRequest
 	CloseStatusUpdate
 	PendingUpdate
+	ChannelAcceptRequest
+	ChannelAcceptResponse
 	OpenChannelRequest
 	OpenStatusUpdate
 	PendingHTLC
 	PendingChannelsRequest
 	PendingChannelsResponse
+	CancelPendingChannelRequest
+	CancelPendingChannelResponse
 	WalletBalanceRequest
 	WalletBalanceResponse
 	ChannelBalanceRequest
@@ -112,9 +128,61 @@ It has these top-level messages:
 	FeeReportResponse
 	PolicyUpdateRequest
 	PolicyUpdateResponse
+	UpdateChanStatusRequest
+	UpdateChanStatusResponse
 	ForwardingHistoryRequest
 	ForwardingEvent
 	ForwardingHistoryResponse
+	ForwardingStatsRequest
+	ForwardingStatsGroup
+	ForwardingStatsBucket
+	ForwardingStatsResponse
+	CircuitKey
+	ForwardHtlcInterceptRequest
+	ForwardHtlcInterceptResponse
+	SubscribeHtlcEventsRequest
+	HtlcEvent
+	HtlcInfo
+	ForwardEvent
+	ForwardFailEvent
+	SettleEvent
+	LinkFailEvent
+	ForwardLimits
+	LimitUsage
+	ChannelLimitUsage
+	PeerLimitUsage
+	ForwardingLimitsRequest
+	ForwardingLimitsResponse
+	UpdateForwardingLimitsRequest
+	UpdateForwardingLimitsResponse
+	ListCircuitsRequest
+	PaymentCircuit
+	ListCircuitsResponse
+	ListForwardingPackagesRequest
+	ForwardingPackage
+	ChannelForwardingPackages
+	ListForwardingPackagesResponse
+	FailStuckHtlcRequest
+	FailStuckHtlcResponse
+	ReplayLogInfoRequest
+	ReplayLogInfoResponse
+	CompactReplayLogRequest
+	CompactReplayLogResponse
+	KeyLocator
+	KeyDescriptor
+	KeyReq
+	TxOut
+	WalletKeyPath
+	SignDescriptor
+	SignReq
+	SignResp
+	InputScript
+	InputScriptResp
+	SignMessageReq
+	SignMessageResp
+	DerivePrivKeyResp
+	SharedKeyRequest
+	SharedKeyResponse
 */
 package lnrpc
 
@@ -139,6 +207,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type TransactionCategory int32
+
+const (
+	// / A transaction whose purpose is not known, such as an incoming payment
+	TransactionCategory_UNKNOWN TransactionCategory = 0
+	// / A transaction sending coins on behalf of the user
+	TransactionCategory_SEND TransactionCategory = 1
+	// / A channel funding transaction
+	TransactionCategory_CHANNEL_FUNDING TransactionCategory = 2
+	// / A transaction cooperatively closing a channel
+	TransactionCategory_COOPERATIVE_CLOSE TransactionCategory = 3
+	// / A justice transaction sweeping the funds of a breached channel
+	TransactionCategory_BREACH_REMEDY TransactionCategory = 4
+	// / A transaction sweeping commitment or HTLC outputs of a force close
+	TransactionCategory_SWEEP TransactionCategory = 5
+	// / A transaction double spending the inputs of a funding transaction to cancel a pending channel
+	TransactionCategory_FUNDING_CANCEL TransactionCategory = 6
+)
+
+var TransactionCategory_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "CHANNEL_FUNDING",
+	3: "COOPERATIVE_CLOSE",
+	4: "BREACH_REMEDY",
+	5: "SWEEP",
+	6: "FUNDING_CANCEL",
+}
+var TransactionCategory_value = map[string]int32{
+	"UNKNOWN":           0,
+	"SEND":              1,
+	"CHANNEL_FUNDING":   2,
+	"COOPERATIVE_CLOSE": 3,
+	"BREACH_REMEDY":     4,
+	"SWEEP":             5,
+	"FUNDING_CANCEL":    6,
+}
+
+func (x TransactionCategory) String() string {
+	return proto.EnumName(TransactionCategory_name, int32(x))
+}
+func (TransactionCategory) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type NewAddressRequest_AddressType int32
 
 const (
@@ -159,7 +270,7 @@ func (x NewAddressRequest_AddressType) String() string {
 	return proto.EnumName(NewAddressRequest_AddressType_name, int32(x))
 }
 func (NewAddressRequest_AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{21, 0}
+	return fileDescriptor0, []int{23, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -194,7 +305,302 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{35, 0}
+	return fileDescriptor0, []int{38, 0}
+}
+
+type ChannelEventUpdate_UpdateType int32
+
+const (
+	ChannelEventUpdate_PENDING_OPEN_CHANNEL ChannelEventUpdate_UpdateType = 0
+	ChannelEventUpdate_OPEN_CHANNEL         ChannelEventUpdate_UpdateType = 1
+	ChannelEventUpdate_ACTIVE_CHANNEL       ChannelEventUpdate_UpdateType = 2
+	ChannelEventUpdate_INACTIVE_CHANNEL     ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_CLOSING_CHANNEL      ChannelEventUpdate_UpdateType = 4
+	ChannelEventUpdate_CLOSED_CHANNEL       ChannelEventUpdate_UpdateType = 5
+)
+
+var ChannelEventUpdate_UpdateType_name = map[int32]string{
+	0: "PENDING_OPEN_CHANNEL",
+	1: "OPEN_CHANNEL",
+	2: "ACTIVE_CHANNEL",
+	3: "INACTIVE_CHANNEL",
+	4: "CLOSING_CHANNEL",
+	5: "CLOSED_CHANNEL",
+}
+var ChannelEventUpdate_UpdateType_value = map[string]int32{
+	"PENDING_OPEN_CHANNEL": 0,
+	"OPEN_CHANNEL":         1,
+	"ACTIVE_CHANNEL":       2,
+	"INACTIVE_CHANNEL":     3,
+	"CLOSING_CHANNEL":      4,
+	"CLOSED_CHANNEL":       5,
+}
+
+func (x ChannelEventUpdate_UpdateType) String() string {
+	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
+}
+func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0}
+}
+
+type UpdateChanStatusRequest_ChanStatusAction int32
+
+const (
+	UpdateChanStatusRequest_ENABLE  UpdateChanStatusRequest_ChanStatusAction = 0
+	UpdateChanStatusRequest_DISABLE UpdateChanStatusRequest_ChanStatusAction = 1
+	UpdateChanStatusRequest_AUTO    UpdateChanStatusRequest_ChanStatusAction = 2
+)
+
+var UpdateChanStatusRequest_ChanStatusAction_name = map[int32]string{
+	0: "ENABLE",
+	1: "DISABLE",
+	2: "AUTO",
+}
+var UpdateChanStatusRequest_ChanStatusAction_value = map[string]int32{
+	"ENABLE":  0,
+	"DISABLE": 1,
+	"AUTO":    2,
+}
+
+func (x UpdateChanStatusRequest_ChanStatusAction) String() string {
+	return proto.EnumName(UpdateChanStatusRequest_ChanStatusAction_name, int32(x))
+}
+func (UpdateChanStatusRequest_ChanStatusAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{120, 0}
+}
+
+type ForwardingStatsRequest_GroupBy int32
+
+const (
+	// / Group forwards by their outgoing channel.
+	ForwardingStatsRequest_OUTGOING_CHANNEL ForwardingStatsRequest_GroupBy = 0
+	// / Group forwards by their incoming channel.
+	ForwardingStatsRequest_INCOMING_CHANNEL ForwardingStatsRequest_GroupBy = 1
+	// / Group forwards by the peer of their outgoing channel, which the fees are charged for.
+	ForwardingStatsRequest_PEER ForwardingStatsRequest_GroupBy = 2
+)
+
+var ForwardingStatsRequest_GroupBy_name = map[int32]string{
+	0: "OUTGOING_CHANNEL",
+	1: "INCOMING_CHANNEL",
+	2: "PEER",
+}
+var ForwardingStatsRequest_GroupBy_value = map[string]int32{
+	"OUTGOING_CHANNEL": 0,
+	"INCOMING_CHANNEL": 1,
+	"PEER":             2,
+}
+
+func (x ForwardingStatsRequest_GroupBy) String() string {
+	return proto.EnumName(ForwardingStatsRequest_GroupBy_name, int32(x))
+}
+func (ForwardingStatsRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{125, 0}
+}
+
+type ForwardHtlcInterceptResponse_ResolveAction int32
+
+const (
+	ForwardHtlcInterceptResponse_RESUME ForwardHtlcInterceptResponse_ResolveAction = 0
+	ForwardHtlcInterceptResponse_SETTLE ForwardHtlcInterceptResponse_ResolveAction = 1
+	ForwardHtlcInterceptResponse_FAIL   ForwardHtlcInterceptResponse_ResolveAction = 2
+)
+
+var ForwardHtlcInterceptResponse_ResolveAction_name = map[int32]string{
+	0: "RESUME",
+	1: "SETTLE",
+	2: "FAIL",
+}
+var ForwardHtlcInterceptResponse_ResolveAction_value = map[string]int32{
+	"RESUME": 0,
+	"SETTLE": 1,
+	"FAIL":   2,
+}
+
+func (x ForwardHtlcInterceptResponse_ResolveAction) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_ResolveAction_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_ResolveAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{131, 0}
+}
+
+type ForwardHtlcInterceptResponse_FailureCode int32
+
+const (
+	ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE            ForwardHtlcInterceptResponse_FailureCode = 0
+	ForwardHtlcInterceptResponse_TEMPORARY_NODE_FAILURE               ForwardHtlcInterceptResponse_FailureCode = 1
+	ForwardHtlcInterceptResponse_PERMANENT_NODE_FAILURE               ForwardHtlcInterceptResponse_FailureCode = 2
+	ForwardHtlcInterceptResponse_PERMANENT_CHANNEL_FAILURE            ForwardHtlcInterceptResponse_FailureCode = 3
+	ForwardHtlcInterceptResponse_UNKNOWN_NEXT_PEER                    ForwardHtlcInterceptResponse_FailureCode = 4
+	ForwardHtlcInterceptResponse_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS ForwardHtlcInterceptResponse_FailureCode = 5
+	ForwardHtlcInterceptResponse_CHANNEL_DISABLED                     ForwardHtlcInterceptResponse_FailureCode = 6
+)
+
+var ForwardHtlcInterceptResponse_FailureCode_name = map[int32]string{
+	0: "TEMPORARY_CHANNEL_FAILURE",
+	1: "TEMPORARY_NODE_FAILURE",
+	2: "PERMANENT_NODE_FAILURE",
+	3: "PERMANENT_CHANNEL_FAILURE",
+	4: "UNKNOWN_NEXT_PEER",
+	5: "INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS",
+	6: "CHANNEL_DISABLED",
+}
+var ForwardHtlcInterceptResponse_FailureCode_value = map[string]int32{
+	"TEMPORARY_CHANNEL_FAILURE":            0,
+	"TEMPORARY_NODE_FAILURE":               1,
+	"PERMANENT_NODE_FAILURE":               2,
+	"PERMANENT_CHANNEL_FAILURE":            3,
+	"UNKNOWN_NEXT_PEER":                    4,
+	"INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS": 5,
+	"CHANNEL_DISABLED":                     6,
+}
+
+func (x ForwardHtlcInterceptResponse_FailureCode) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_FailureCode_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_FailureCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{131, 1}
+}
+
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_UNKNOWN HtlcEvent_EventType = 0
+	HtlcEvent_SEND    HtlcEvent_EventType = 1
+	HtlcEvent_RECEIVE HtlcEvent_EventType = 2
+	HtlcEvent_FORWARD HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "RECEIVE",
+	3: "FORWARD",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SEND":    1,
+	"RECEIVE": 2,
+	"FORWARD": 3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{133, 0} }
+
+type LinkFailEvent_FailureDetail int32
+
+const (
+	LinkFailEvent_NONE                 LinkFailEvent_FailureDetail = 0
+	LinkFailEvent_LINK_NOT_ELIGIBLE    LinkFailEvent_FailureDetail = 1
+	LinkFailEvent_INSUFFICIENT_BALANCE LinkFailEvent_FailureDetail = 2
+	LinkFailEvent_MAX_PENDING_AMOUNT   LinkFailEvent_FailureDetail = 3
+	LinkFailEvent_BELOW_MIN_HTLC       LinkFailEvent_FailureDetail = 4
+	LinkFailEvent_HTLC_ADD_FAILED      LinkFailEvent_FailureDetail = 5
+	LinkFailEvent_INCOMPLETE_FORWARD   LinkFailEvent_FailureDetail = 6
+	LinkFailEvent_INVOICE_NOT_FOUND    LinkFailEvent_FailureDetail = 7
+	LinkFailEvent_ONION_ENCODE         LinkFailEvent_FailureDetail = 8
+	LinkFailEvent_INTERCEPTOR_TIMEOUT  LinkFailEvent_FailureDetail = 9
+	LinkFailEvent_INTERCEPTOR_FAIL     LinkFailEvent_FailureDetail = 10
+	LinkFailEvent_CHANNEL_LIMIT        LinkFailEvent_FailureDetail = 11
+	LinkFailEvent_PEER_LIMIT           LinkFailEvent_FailureDetail = 12
+	LinkFailEvent_RATE_LIMIT           LinkFailEvent_FailureDetail = 13
+	LinkFailEvent_QUEUE_TIMEOUT        LinkFailEvent_FailureDetail = 14
+)
+
+var LinkFailEvent_FailureDetail_name = map[int32]string{
+	0:  "NONE",
+	1:  "LINK_NOT_ELIGIBLE",
+	2:  "INSUFFICIENT_BALANCE",
+	3:  "MAX_PENDING_AMOUNT",
+	4:  "BELOW_MIN_HTLC",
+	5:  "HTLC_ADD_FAILED",
+	6:  "INCOMPLETE_FORWARD",
+	7:  "INVOICE_NOT_FOUND",
+	8:  "ONION_ENCODE",
+	9:  "INTERCEPTOR_TIMEOUT",
+	10: "INTERCEPTOR_FAIL",
+	11: "CHANNEL_LIMIT",
+	12: "PEER_LIMIT",
+	13: "RATE_LIMIT",
+	14: "QUEUE_TIMEOUT",
+}
+var LinkFailEvent_FailureDetail_value = map[string]int32{
+	"NONE":                 0,
+	"LINK_NOT_ELIGIBLE":    1,
+	"INSUFFICIENT_BALANCE": 2,
+	"MAX_PENDING_AMOUNT":   3,
+	"BELOW_MIN_HTLC":       4,
+	"HTLC_ADD_FAILED":      5,
+	"INCOMPLETE_FORWARD":   6,
+	"INVOICE_NOT_FOUND":    7,
+	"ONION_ENCODE":         8,
+	"INTERCEPTOR_TIMEOUT":  9,
+	"INTERCEPTOR_FAIL":     10,
+	"CHANNEL_LIMIT":        11,
+	"PEER_LIMIT":           12,
+	"RATE_LIMIT":           13,
+	"QUEUE_TIMEOUT":        14,
+}
+
+func (x LinkFailEvent_FailureDetail) String() string {
+	return proto.EnumName(LinkFailEvent_FailureDetail_name, int32(x))
+}
+func (LinkFailEvent_FailureDetail) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{138, 0}
+}
+
+type ForwardLimits_LimitMode int32
+
+const (
+	ForwardLimits_FAIL  ForwardLimits_LimitMode = 0
+	ForwardLimits_QUEUE ForwardLimits_LimitMode = 1
+)
+
+var ForwardLimits_LimitMode_name = map[int32]string{
+	0: "FAIL",
+	1: "QUEUE",
+}
+var ForwardLimits_LimitMode_value = map[string]int32{
+	"FAIL":  0,
+	"QUEUE": 1,
+}
+
+func (x ForwardLimits_LimitMode) String() string {
+	return proto.EnumName(ForwardLimits_LimitMode_name, int32(x))
+}
+func (ForwardLimits_LimitMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{139, 0}
+}
+
+type ForwardingPackage_State int32
+
+const (
+	// / The package has been locked in, but not yet processed by the link.
+	ForwardingPackage_LOCKED_IN ForwardingPackage_State = 0
+	// / The adds of the package have been handed to the switch.
+	ForwardingPackage_PROCESSED ForwardingPackage_State = 1
+	// / All updates of the package have been acked.
+	ForwardingPackage_COMPLETED ForwardingPackage_State = 2
+)
+
+var ForwardingPackage_State_name = map[int32]string{
+	0: "LOCKED_IN",
+	1: "PROCESSED",
+	2: "COMPLETED",
+}
+var ForwardingPackage_State_value = map[string]int32{
+	"LOCKED_IN": 0,
+	"PROCESSED": 1,
+	"COMPLETED": 2,
+}
+
+func (x ForwardingPackage_State) String() string {
+	return proto.EnumName(ForwardingPackage_State_name, int32(x))
+}
+func (ForwardingPackage_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{151, 0}
 }
 
 type GenSeedRequest struct {
@@ -422,6 +828,10 @@ type Transaction struct {
 	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees" json:"total_fees,omitempty"`
 	// / Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses" json:"dest_addresses,omitempty"`
+	// / The label of the transaction, if any
+	Label string `protobuf:"bytes,9,opt,name=label" json:"label,omitempty"`
+	// / The category describing the purpose of the transaction
+	Category TransactionCategory `protobuf:"varint,10,opt,name=category,enum=lnrpc.TransactionCategory" json:"category,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
@@ -485,7 +895,37 @@ func (m *Transaction) GetDestAddresses() []string {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Transaction) GetCategory() TransactionCategory {
+	if m != nil {
+		return m.Category
+	}
+	return TransactionCategory_UNKNOWN
+}
+
 type GetTransactionsRequest struct {
+	// / If set, only transactions of these categories are returned.
+	Categories []TransactionCategory `protobuf:"varint,1,rep,packed,name=categories,enum=lnrpc.TransactionCategory" json:"categories,omitempty"`
+	// *
+	// If set, only transactions confirmed at or above this height are returned.
+	// Unconfirmed transactions are only returned if end_height is not set.
+	StartHeight int32 `protobuf:"varint,2,opt,name=start_height" json:"start_height,omitempty"`
+	// / If set, only transactions confirmed at or below this height are returned.
+	EndHeight int32 `protobuf:"varint,3,opt,name=end_height" json:"end_height,omitempty"`
+	// / If set, only transactions with exactly this label are returned.
+	Label string `protobuf:"bytes,4,opt,name=label" json:"label,omitempty"`
+	// *
+	// The number of matching transactions to skip, in order of ascending block
+	// height.
+	IndexOffset uint32 `protobuf:"varint,5,opt,name=index_offset" json:"index_offset,omitempty"`
+	// / The maximum number of transactions to return. If zero, all are returned.
+	MaxTransactions uint32 `protobuf:"varint,6,opt,name=max_transactions" json:"max_transactions,omitempty"`
 }
 
 func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
@@ -493,9 +933,57 @@ func (m *GetTransactionsRequest) String() string            { return proto.Compa
 func (*GetTransactionsRequest) ProtoMessage()               {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
+func (m *GetTransactionsRequest) GetCategories() []TransactionCategory {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *GetTransactionsRequest) GetStartHeight() int32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetEndHeight() int32 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *GetTransactionsRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetMaxTransactions() uint32 {
+	if m != nil {
+		return m.MaxTransactions
+	}
+	return 0
+}
+
 type TransactionDetails struct {
 	// / The list of transactions relevant to the wallet.
 	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	// *
+	// The index offset of the transaction following the last one returned, to
+	// be used as the index_offset of the request for the next page.
+	LastIndexOffset uint32 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// / The total number of transactions matching the filters.
+	TotalTransactions uint32 `protobuf:"varint,3,opt,name=total_transactions" json:"total_transactions,omitempty"`
 }
 
 func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
@@ -510,6 +998,54 @@ func (m *TransactionDetails) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (m *TransactionDetails) GetLastIndexOffset() uint32 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+func (m *TransactionDetails) GetTotalTransactions() uint32 {
+	if m != nil {
+		return m.TotalTransactions
+	}
+	return 0
+}
+
+type LabelTransactionRequest struct {
+	// / The hash of the transaction to label
+	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+	// / The new label of the transaction
+	Label string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *LabelTransactionRequest) Reset()                    { *m = LabelTransactionRequest{} }
+func (m *LabelTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*LabelTransactionRequest) ProtoMessage()               {}
+func (*LabelTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *LabelTransactionRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *LabelTransactionRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type LabelTransactionResponse struct {
+}
+
+func (m *LabelTransactionResponse) Reset()                    { *m = LabelTransactionResponse{} }
+func (m *LabelTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*LabelTransactionResponse) ProtoMessage()               {}
+func (*LabelTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
 type FeeLimit struct {
 	// Types that are valid to be assigned to Limit:
 	//	*FeeLimit_Fixed
@@ -520,7 +1056,7 @@ type FeeLimit struct {
 func (m *FeeLimit) Reset()                    { *m = FeeLimit{} }
 func (m *FeeLimit) String() string            { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()               {}
-func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 type isFeeLimit_Limit interface{ isFeeLimit_Limit() }
 
@@ -650,7 +1186,7 @@ type SendRequest struct {
 func (m *SendRequest) Reset()                    { *m = SendRequest{} }
 func (m *SendRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()               {}
-func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *SendRequest) GetDest() []byte {
 	if m != nil {
@@ -717,7 +1253,7 @@ type SendResponse struct {
 func (m *SendResponse) Reset()                    { *m = SendResponse{} }
 func (m *SendResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()               {}
-func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *SendResponse) GetPaymentError() string {
 	if m != nil {
@@ -752,7 +1288,7 @@ type SendToRouteRequest struct {
 func (m *SendToRouteRequest) Reset()                    { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()               {}
-func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *SendToRouteRequest) GetPaymentHash() []byte {
 	if m != nil {
@@ -787,7 +1323,7 @@ type ChannelPoint struct {
 func (m *ChannelPoint) Reset()                    { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string            { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()               {}
-func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 type isChannelPoint_FundingTxid interface{ isChannelPoint_FundingTxid() }
 
@@ -905,7 +1441,7 @@ type LightningAddress struct {
 func (m *LightningAddress) Reset()                    { *m = LightningAddress{} }
 func (m *LightningAddress) String() string            { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()               {}
-func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *LightningAddress) GetPubkey() string {
 	if m != nil {
@@ -928,12 +1464,14 @@ type SendManyRequest struct {
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / An optional label for the transaction.
+	Label string `protobuf:"bytes,6,opt,name=label" json:"label,omitempty"`
 }
 
 func (m *SendManyRequest) Reset()                    { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()               {}
-func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
@@ -956,6 +1494,13 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendManyResponse struct {
 	// / The id of the transaction
 	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
@@ -964,7 +1509,7 @@ type SendManyResponse struct {
 func (m *SendManyResponse) Reset()                    { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()               {}
-func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *SendManyResponse) GetTxid() string {
 	if m != nil {
@@ -982,12 +1527,14 @@ type SendCoinsRequest struct {
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / An optional label for the transaction.
+	Label string `protobuf:"bytes,6,opt,name=label" json:"label,omitempty"`
 }
 
 func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()               {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *SendCoinsRequest) GetAddr() string {
 	if m != nil {
@@ -1017,6 +1564,13 @@ func (m *SendCoinsRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendCoinsResponse struct {
 	// / The transaction ID of the transaction
 	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
@@ -1025,7 +1579,7 @@ type SendCoinsResponse struct {
 func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()               {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *SendCoinsResponse) GetTxid() string {
 	if m != nil {
@@ -1047,7 +1601,7 @@ type NewAddressRequest struct {
 func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()               {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
 	if m != nil {
@@ -1064,7 +1618,7 @@ type NewAddressResponse struct {
 func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()               {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
@@ -1081,7 +1635,7 @@ type SignMessageRequest struct {
 func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()               {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1098,7 +1652,7 @@ type SignMessageResponse struct {
 func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()               {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
@@ -1117,7 +1671,7 @@ type VerifyMessageRequest struct {
 func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()               {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1143,7 +1697,7 @@ type VerifyMessageResponse struct {
 func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()               {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
@@ -1170,7 +1724,7 @@ type ConnectPeerRequest struct {
 func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()               {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
@@ -1192,7 +1746,7 @@ type ConnectPeerResponse struct {
 func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()               {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 type DisconnectPeerRequest struct {
 	// / The pubkey of the node to disconnect from
@@ -1202,7 +1756,7 @@ type DisconnectPeerRequest struct {
 func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()               {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
@@ -1217,7 +1771,7 @@ type DisconnectPeerResponse struct {
 func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
 func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()               {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 type HTLC struct {
 	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
@@ -1229,7 +1783,7 @@ type HTLC struct {
 func (m *HTLC) Reset()                    { *m = HTLC{} }
 func (m *HTLC) String() string            { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()               {}
-func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *HTLC) GetIncoming() bool {
 	if m != nil {
@@ -1314,12 +1868,18 @@ type Channel struct {
 	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay" json:"csv_delay,omitempty"`
 	// / Whether this channel is advertised to the network or not
 	Private bool `protobuf:"varint,17,opt,name=private" json:"private,omitempty"`
+	// / Whether this channel could be used before its funding transaction confirmed
+	ZeroConf bool `protobuf:"varint,18,opt,name=zero_conf" json:"zero_conf,omitempty"`
+	// / The alias short channel ID the channel is known by until its funding transaction confirmed, if it's a zero-conf channel
+	AliasScid uint64 `protobuf:"varint,19,opt,name=alias_scid" json:"alias_scid,omitempty"`
+	// / The commitment metrics of the channel's link, set while the channel has a link
+	CommitStats *ChannelCommitStats `protobuf:"bytes,20,opt,name=commit_stats" json:"commit_stats,omitempty"`
 }
 
 func (m *Channel) Reset()                    { *m = Channel{} }
 func (m *Channel) String() string            { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *Channel) GetActive() bool {
 	if m != nil {
@@ -1440,6 +2000,89 @@ func (m *Channel) GetPrivate() bool {
 	return false
 }
 
+func (m *Channel) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
+func (m *Channel) GetAliasScid() uint64 {
+	if m != nil {
+		return m.AliasScid
+	}
+	return 0
+}
+
+func (m *Channel) GetCommitStats() *ChannelCommitStats {
+	if m != nil {
+		return m.CommitStats
+	}
+	return nil
+}
+
+type ChannelCommitStats struct {
+	// / The current interval updates are batched for before a new commitment is signed, in milliseconds.
+	BatchIntervalMs uint64 `protobuf:"varint,1,opt,name=batch_interval_ms" json:"batch_interval_ms,omitempty"`
+	// / The number of updates that haven't been included in a commitment yet.
+	PendingUpdates uint32 `protobuf:"varint,2,opt,name=pending_updates" json:"pending_updates,omitempty"`
+	// / The number of commitments signed since the link was started.
+	NumCommits uint64 `protobuf:"varint,3,opt,name=num_commits" json:"num_commits,omitempty"`
+	// / The number of updates included in the commitments signed since the link was started.
+	NumBatchedUpdates uint64 `protobuf:"varint,4,opt,name=num_batched_updates" json:"num_batched_updates,omitempty"`
+	// / The moving average of the time between the first update of a batch and the commitment including it being signed, in microseconds.
+	AvgCommitLatencyUs uint64 `protobuf:"varint,5,opt,name=avg_commit_latency_us" json:"avg_commit_latency_us,omitempty"`
+	// / The moving average of the time between sending a commitment and receiving the peer's revocation, in microseconds.
+	AvgRevocationLatencyUs uint64 `protobuf:"varint,6,opt,name=avg_revocation_latency_us" json:"avg_revocation_latency_us,omitempty"`
+}
+
+func (m *ChannelCommitStats) Reset()                    { *m = ChannelCommitStats{} }
+func (m *ChannelCommitStats) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCommitStats) ProtoMessage()               {}
+func (*ChannelCommitStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ChannelCommitStats) GetBatchIntervalMs() uint64 {
+	if m != nil {
+		return m.BatchIntervalMs
+	}
+	return 0
+}
+
+func (m *ChannelCommitStats) GetPendingUpdates() uint32 {
+	if m != nil {
+		return m.PendingUpdates
+	}
+	return 0
+}
+
+func (m *ChannelCommitStats) GetNumCommits() uint64 {
+	if m != nil {
+		return m.NumCommits
+	}
+	return 0
+}
+
+func (m *ChannelCommitStats) GetNumBatchedUpdates() uint64 {
+	if m != nil {
+		return m.NumBatchedUpdates
+	}
+	return 0
+}
+
+func (m *ChannelCommitStats) GetAvgCommitLatencyUs() uint64 {
+	if m != nil {
+		return m.AvgCommitLatencyUs
+	}
+	return 0
+}
+
+func (m *ChannelCommitStats) GetAvgRevocationLatencyUs() uint64 {
+	if m != nil {
+		return m.AvgRevocationLatencyUs
+	}
+	return 0
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly" json:"active_only,omitempty"`
 	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly" json:"inactive_only,omitempty"`
@@ -1450,7 +2093,7 @@ type ListChannelsRequest struct {
 func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()               {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
@@ -1488,7 +2131,7 @@ type ListChannelsResponse struct {
 func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()               {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *ListChannelsResponse) GetChannels() []*Channel {
 	if m != nil {
@@ -1523,7 +2166,7 @@ type ChannelCloseSummary struct {
 func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()               {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
@@ -1607,7 +2250,7 @@ type ClosedChannelsRequest struct {
 func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()               {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *ClosedChannelsRequest) GetCooperative() bool {
 	if m != nil {
@@ -1658,7 +2301,7 @@ type ClosedChannelsResponse struct {
 func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
 func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()               {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	if m != nil {
@@ -1667,47 +2310,313 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
-type Peer struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
-	// / Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
-	// / Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
-	// / Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
-	// / Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
-	// / A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
-	// / Ping time to this peer
-	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+type ChannelEventSubscription struct {
 }
 
-func (m *Peer) Reset()                    { *m = Peer{} }
-func (m *Peer) String() string            { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (m *ChannelEventSubscription) Reset()                    { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()               {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
-func (m *Peer) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_PendingOpenChannel
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	//	*ChannelEventUpdate_ClosingChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	Channel isChannelEventUpdate_Channel `protobuf_oneof:"channel"`
+	// / The type of event this update describes
+	Type ChannelEventUpdate_UpdateType `protobuf:"varint,7,opt,name=type,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
 }
 
-func (m *Peer) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+func (m *ChannelEventUpdate) Reset()                    { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()               {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
-func (m *Peer) GetBytesSent() uint64 {
-	if m != nil {
-		return m.BytesSent
+type isChannelEventUpdate_Channel interface{ isChannelEventUpdate_Channel() }
+
+type ChannelEventUpdate_PendingOpenChannel struct {
+	// / A channel that has finished the funding workflow and is waiting for its funding transaction to confirm
+	PendingOpenChannel *PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,1,opt,name=pending_open_channel,oneof"`
+}
+type ChannelEventUpdate_OpenChannel struct {
+	// / A channel whose funding transaction has confirmed
+	OpenChannel *Channel `protobuf:"bytes,2,opt,name=open_channel,oneof"`
+}
+type ChannelEventUpdate_ActiveChannel struct {
+	// / A channel that is now able to forward payments
+	ActiveChannel *Channel `protobuf:"bytes,3,opt,name=active_channel,oneof"`
+}
+type ChannelEventUpdate_InactiveChannel struct {
+	// / A channel that is no longer able to forward payments
+	InactiveChannel *Channel `protobuf:"bytes,4,opt,name=inactive_channel,oneof"`
+}
+type ChannelEventUpdate_ClosingChannel struct {
+	// / A channel that has started to close, either by broadcasting its closing transaction or detecting it on-chain
+	ClosingChannel *ChannelCloseSummary `protobuf:"bytes,5,opt,name=closing_channel,oneof"`
+}
+type ChannelEventUpdate_ClosedChannel struct {
+	// / A closed channel whose contracts have all been fully resolved
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,6,opt,name=closed_channel,oneof"`
+}
+
+func (*ChannelEventUpdate_PendingOpenChannel) isChannelEventUpdate_Channel() {}
+func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel()        {}
+func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel()      {}
+func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel()    {}
+func (*ChannelEventUpdate_ClosingChannel) isChannelEventUpdate_Channel()     {}
+func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel()      {}
+
+func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetPendingOpenChannel() *PendingChannelsResponse_PendingOpenChannel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_PendingOpenChannel); ok {
+		return x.PendingOpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
+		return x.OpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetActiveChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
+		return x.ActiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetInactiveChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
+		return x.InactiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetClosingChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosingChannel); ok {
+		return x.ClosingChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
+		return x.ClosedChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+	if m != nil {
+		return m.Type
+	}
+	return ChannelEventUpdate_PENDING_OPEN_CHANNEL
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ChannelEventUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ChannelEventUpdate_OneofMarshaler, _ChannelEventUpdate_OneofUnmarshaler, _ChannelEventUpdate_OneofSizer, []interface{}{
+		(*ChannelEventUpdate_PendingOpenChannel)(nil),
+		(*ChannelEventUpdate_OpenChannel)(nil),
+		(*ChannelEventUpdate_ActiveChannel)(nil),
+		(*ChannelEventUpdate_InactiveChannel)(nil),
+		(*ChannelEventUpdate_ClosingChannel)(nil),
+		(*ChannelEventUpdate_ClosedChannel)(nil),
+	}
+}
+
+func _ChannelEventUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_PendingOpenChannel:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PendingOpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_OpenChannel:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ActiveChannel:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ActiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_InactiveChannel:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.InactiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ClosingChannel:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosingChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ClosedChannel:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosedChannel); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ChannelEventUpdate.Channel has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ChannelEventUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ChannelEventUpdate)
+	switch tag {
+	case 1: // channel.pending_open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingChannelsResponse_PendingOpenChannel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_PendingOpenChannel{msg}
+		return true, err
+	case 2: // channel.open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Channel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_OpenChannel{msg}
+		return true, err
+	case 3: // channel.active_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Channel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ActiveChannel{msg}
+		return true, err
+	case 4: // channel.inactive_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Channel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_InactiveChannel{msg}
+		return true, err
+	case 5: // channel.closing_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ClosingChannel{msg}
+		return true, err
+	case 6: // channel.closed_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ClosedChannel{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_PendingOpenChannel:
+		s := proto.Size(x.PendingOpenChannel)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_OpenChannel:
+		s := proto.Size(x.OpenChannel)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ActiveChannel:
+		s := proto.Size(x.ActiveChannel)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_InactiveChannel:
+		s := proto.Size(x.InactiveChannel)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ClosingChannel:
+		s := proto.Size(x.ClosingChannel)
+		n += proto.SizeVarint(5<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ClosedChannel:
+		s := proto.Size(x.ClosedChannel)
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Peer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	// / Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
+	// / Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
+	// / Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
+	// / Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
+	// / A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
+	// / Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+}
+
+func (m *Peer) Reset()                    { *m = Peer{} }
+func (m *Peer) String() string            { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()               {}
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *Peer) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *Peer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Peer) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
 	}
 	return 0
 }
@@ -1753,7 +2662,7 @@ type ListPeersRequest struct {
 func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()               {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 type ListPeersResponse struct {
 	// / The list of currently connected peers
@@ -1763,7 +2672,7 @@ type ListPeersResponse struct {
 func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()               {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *ListPeersResponse) GetPeers() []*Peer {
 	if m != nil {
@@ -1778,7 +2687,7 @@ type GetInfoRequest struct {
 func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()               {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 type GetInfoResponse struct {
 	// / The identity pubkey of the current node.
@@ -1814,7 +2723,7 @@ type GetInfoResponse struct {
 func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()               {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *GetInfoResponse) GetIdentityPubkey() string {
 	if m != nil {
@@ -1914,127 +2823,416 @@ func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
 	return 0
 }
 
-type ConfirmationUpdate struct {
-	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
-	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+type GetRecoveryInfoRequest struct {
 }
 
-func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()               {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (m *GetRecoveryInfoRequest) Reset()                    { *m = GetRecoveryInfoRequest{} }
+func (m *GetRecoveryInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoRequest) ProtoMessage()               {}
+func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
+type GetRecoveryInfoResponse struct {
+	// / Whether the wallet is in recovery mode, or a rescan was requested
+	RecoveryMode bool `protobuf:"varint,1,opt,name=recovery_mode" json:"recovery_mode,omitempty"`
+	// / Whether the wallet has caught up with the chain after recovering
+	RecoveryFinished bool `protobuf:"varint,2,opt,name=recovery_finished" json:"recovery_finished,omitempty"`
+	// / The recovery progress, ranging from 0 to 1
+	Progress float64 `protobuf:"fixed64,3,opt,name=progress" json:"progress,omitempty"`
+	// / The height of the last block scanned by the wallet
+	CurrentHeight int32 `protobuf:"varint,4,opt,name=current_height" json:"current_height,omitempty"`
+	// / The height of the best block the wallet is scanning towards
+	TargetHeight int32 `protobuf:"varint,5,opt,name=target_height" json:"target_height,omitempty"`
+	// / The number of addresses derived by the wallet
+	AddressesDerived uint32 `protobuf:"varint,6,opt,name=addresses_derived" json:"addresses_derived,omitempty"`
+	// / The amount the confirmed balance grew by since the scan started
+	FundsFound int64 `protobuf:"varint,7,opt,name=funds_found" json:"funds_found,omitempty"`
+	// / The error the last rescan failed with, if any
+	RescanError string `protobuf:"bytes,8,opt,name=rescan_error" json:"rescan_error,omitempty"`
+}
+
+func (m *GetRecoveryInfoResponse) Reset()                    { *m = GetRecoveryInfoResponse{} }
+func (m *GetRecoveryInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoResponse) ProtoMessage()               {}
+func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *GetRecoveryInfoResponse) GetRecoveryMode() bool {
 	if m != nil {
-		return m.BlockSha
+		return m.RecoveryMode
 	}
-	return nil
+	return false
 }
 
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+func (m *GetRecoveryInfoResponse) GetRecoveryFinished() bool {
 	if m != nil {
-		return m.BlockHeight
+		return m.RecoveryFinished
+	}
+	return false
+}
+
+func (m *GetRecoveryInfoResponse) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
 	}
 	return 0
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+func (m *GetRecoveryInfoResponse) GetCurrentHeight() int32 {
 	if m != nil {
-		return m.NumConfsLeft
+		return m.CurrentHeight
 	}
 	return 0
 }
 
-type ChannelOpenUpdate struct {
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+func (m *GetRecoveryInfoResponse) GetTargetHeight() int32 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
 }
 
-func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()               {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *GetRecoveryInfoResponse) GetAddressesDerived() uint32 {
+	if m != nil {
+		return m.AddressesDerived
+	}
+	return 0
+}
 
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *GetRecoveryInfoResponse) GetFundsFound() int64 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.FundsFound
 	}
-	return nil
+	return 0
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
+func (m *GetRecoveryInfoResponse) GetRescanError() string {
+	if m != nil {
+		return m.RescanError
+	}
+	return ""
 }
 
-func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()               {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+type RescanRequest struct {
+	// / The height of the block to start the rescan from
+	FromHeight int32 `protobuf:"varint,1,opt,name=from_height" json:"from_height,omitempty"`
+	// *
+	// The number of additional addresses to derive and watch for each branch of
+	// the wallet's accounts beyond those already derived.
+	Lookahead uint32 `protobuf:"varint,2,opt,name=lookahead" json:"lookahead,omitempty"`
+}
 
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+func (m *RescanRequest) Reset()                    { *m = RescanRequest{} }
+func (m *RescanRequest) String() string            { return proto.CompactTextString(m) }
+func (*RescanRequest) ProtoMessage()               {}
+func (*RescanRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *RescanRequest) GetFromHeight() int32 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.FromHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelCloseUpdate) GetSuccess() bool {
+func (m *RescanRequest) GetLookahead() uint32 {
 	if m != nil {
-		return m.Success
+		return m.Lookahead
 	}
-	return false
+	return 0
 }
 
-type CloseChannelRequest struct {
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
-	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
-	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+type RescanResponse struct {
 }
 
-func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
-func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseChannelRequest) ProtoMessage()               {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (m *RescanResponse) Reset()                    { *m = RescanResponse{} }
+func (m *RescanResponse) String() string            { return proto.CompactTextString(m) }
+func (*RescanResponse) ProtoMessage()               {}
+func (*RescanResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
-func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
+type FeeEstimatorHealthRequest struct {
+}
+
+func (m *FeeEstimatorHealthRequest) Reset()                    { *m = FeeEstimatorHealthRequest{} }
+func (m *FeeEstimatorHealthRequest) String() string            { return proto.CompactTextString(m) }
+func (*FeeEstimatorHealthRequest) ProtoMessage()               {}
+func (*FeeEstimatorHealthRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+type FeeSourceHealth struct {
+	// / The name of the fee source
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// / Whether the last query of the source succeeded
+	Healthy bool `protobuf:"varint,2,opt,name=healthy" json:"healthy,omitempty"`
+	// / The unix timestamp of the last estimate produced by the source
+	LastSuccess int64 `protobuf:"varint,3,opt,name=last_success" json:"last_success,omitempty"`
+	// / The last estimate produced by the source, in sat/kw
+	LastFeePerKw int64 `protobuf:"varint,4,opt,name=last_fee_per_kw" json:"last_fee_per_kw,omitempty"`
+	// / The last error returned by the source
+	LastError string `protobuf:"bytes,5,opt,name=last_error" json:"last_error,omitempty"`
+	// / The unix timestamp of the last failure of the source
+	LastFailure int64 `protobuf:"varint,6,opt,name=last_failure" json:"last_failure,omitempty"`
+	// / The number of failed queries since the last success of the source
+	ConsecutiveFailures uint32 `protobuf:"varint,7,opt,name=consecutive_failures" json:"consecutive_failures,omitempty"`
+}
+
+func (m *FeeSourceHealth) Reset()                    { *m = FeeSourceHealth{} }
+func (m *FeeSourceHealth) String() string            { return proto.CompactTextString(m) }
+func (*FeeSourceHealth) ProtoMessage()               {}
+func (*FeeSourceHealth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *FeeSourceHealth) GetName() string {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *CloseChannelRequest) GetForce() bool {
+func (m *FeeSourceHealth) GetHealthy() bool {
 	if m != nil {
-		return m.Force
+		return m.Healthy
 	}
 	return false
 }
 
-func (m *CloseChannelRequest) GetTargetConf() int32 {
+func (m *FeeSourceHealth) GetLastSuccess() int64 {
 	if m != nil {
-		return m.TargetConf
+		return m.LastSuccess
 	}
 	return 0
 }
 
-func (m *CloseChannelRequest) GetSatPerByte() int64 {
+func (m *FeeSourceHealth) GetLastFeePerKw() int64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.LastFeePerKw
 	}
 	return 0
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
+func (m *FeeSourceHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *FeeSourceHealth) GetLastFailure() int64 {
+	if m != nil {
+		return m.LastFailure
+	}
+	return 0
+}
+
+func (m *FeeSourceHealth) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+type FeeEstimatorHealthResponse struct {
+	// *
+	// Whether fee estimates are combined from live sources. If false, a static
+	// fee rate is used for all estimates.
+	Composite bool `protobuf:"varint,1,opt,name=composite" json:"composite,omitempty"`
+	// / The health of each of the live fee sources
+	Sources []*FeeSourceHealth `protobuf:"bytes,2,rep,name=sources" json:"sources,omitempty"`
+	// / The lowest fee rate that will be used, in sat/kw
+	MinFeePerKw int64 `protobuf:"varint,3,opt,name=min_fee_per_kw" json:"min_fee_per_kw,omitempty"`
+	// / The highest fee rate that will be used, in sat/kw, or 0 if unbounded
+	MaxFeePerKw int64 `protobuf:"varint,4,opt,name=max_fee_per_kw" json:"max_fee_per_kw,omitempty"`
+	// / The fee rate used if no estimate is available at all, in sat/kw
+	FallbackFeePerKw int64 `protobuf:"varint,5,opt,name=fallback_fee_per_kw" json:"fallback_fee_per_kw,omitempty"`
+	// / The number of estimates that had to fall back as all sources failed
+	NumFallbacks uint64 `protobuf:"varint,6,opt,name=num_fallbacks" json:"num_fallbacks,omitempty"`
+	// / The unix timestamp of the last time all sources failed
+	LastFallback int64 `protobuf:"varint,7,opt,name=last_fallback" json:"last_fallback,omitempty"`
+}
+
+func (m *FeeEstimatorHealthResponse) Reset()                    { *m = FeeEstimatorHealthResponse{} }
+func (m *FeeEstimatorHealthResponse) String() string            { return proto.CompactTextString(m) }
+func (*FeeEstimatorHealthResponse) ProtoMessage()               {}
+func (*FeeEstimatorHealthResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *FeeEstimatorHealthResponse) GetComposite() bool {
+	if m != nil {
+		return m.Composite
+	}
+	return false
+}
+
+func (m *FeeEstimatorHealthResponse) GetSources() []*FeeSourceHealth {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *FeeEstimatorHealthResponse) GetMinFeePerKw() int64 {
+	if m != nil {
+		return m.MinFeePerKw
+	}
+	return 0
+}
+
+func (m *FeeEstimatorHealthResponse) GetMaxFeePerKw() int64 {
+	if m != nil {
+		return m.MaxFeePerKw
+	}
+	return 0
+}
+
+func (m *FeeEstimatorHealthResponse) GetFallbackFeePerKw() int64 {
+	if m != nil {
+		return m.FallbackFeePerKw
+	}
+	return 0
+}
+
+func (m *FeeEstimatorHealthResponse) GetNumFallbacks() uint64 {
+	if m != nil {
+		return m.NumFallbacks
+	}
+	return 0
+}
+
+func (m *FeeEstimatorHealthResponse) GetLastFallback() int64 {
+	if m != nil {
+		return m.LastFallback
+	}
+	return 0
+}
+
+type ConfirmationUpdate struct {
+	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+}
+
+func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()               {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
+	if m != nil {
+		return m.BlockSha
+	}
+	return nil
+}
+
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+	if m != nil {
+		return m.NumConfsLeft
+	}
+	return 0
+}
+
+type ChannelOpenUpdate struct {
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+}
+
+func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()               {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type ChannelCloseUpdate struct {
+	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
+}
+
+func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()               {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+	if m != nil {
+		return m.ClosingTxid
+	}
+	return nil
+}
+
+func (m *ChannelCloseUpdate) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type CloseChannelRequest struct {
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
+	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
+	// / The target number of blocks that the closure transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// *
+	// An optional address to send our funds to in case of a cooperative close.
+	// If the channel was opened with an upfront shutdown script, this must match
+	// it. If this is not set, a new wallet address is used, unless an upfront
+	// shutdown script was committed to.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address" json:"delivery_address,omitempty"`
+}
+
+func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()               {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *CloseChannelRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CloseChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
+	if m != nil {
+		return m.DeliveryAddress
+	}
+	return ""
+}
+
+type CloseStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
 	//	*CloseStatusUpdate_Confirmation
 	//	*CloseStatusUpdate_ChanClose
@@ -2044,7 +3242,7 @@ type CloseStatusUpdate struct {
 func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()               {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
 
@@ -2191,7 +3389,7 @@ type PendingUpdate struct {
 func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()               {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
@@ -2207,132 +3405,442 @@ func (m *PendingUpdate) GetOutputIndex() uint32 {
 	return 0
 }
 
-type OpenChannelRequest struct {
-	// / The pubkey of the node to open a channel with
-	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
-	// / The hex encoded pubkey of the node to open a channel with
-	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
-	// / The number of satoshis the wallet should commit to the channel
-	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
-	// / The number of satoshis to push to the remote side as part of the initial commitment state
-	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
-	// / Whether this channel should be private, not announced to the greater network.
-	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
-	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
-	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
-	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
-	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
-	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
-	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+type ChannelAcceptRequest struct {
+	// / The public key of the node proposing the channel
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hash of the genesis block of the chain the channel is to be opened on
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The temporary ID of the pending channel
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The amount the initiator funds the channel with, in satoshis
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt" json:"funding_amt,omitempty"`
+	// / The amount pushed to us on channel open, in millisatoshis
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt" json:"push_amt,omitempty"`
+	// / The dust limit of the initiator's commitment transaction, in satoshis
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit" json:"dust_limit,omitempty"`
+	// / The maximum value of pending HTLCs we may offer, in millisatoshis
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight" json:"max_value_in_flight,omitempty"`
+	// / The minimum balance we must keep in the channel, in satoshis
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve" json:"channel_reserve,omitempty"`
+	// / The smallest HTLC the initiator will accept, in millisatoshis
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	// / The initial fee rate of the commitment transactions, in sat/kw
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// / The number of blocks we must wait to sweep our funds after a force close
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The maximum number of pending HTLCs we may offer
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// / The channel flags, indicating whether the channel is to be announced
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags" json:"channel_flags,omitempty"`
+}
+
+func (m *ChannelAcceptRequest) Reset()                    { *m = ChannelAcceptRequest{} }
+func (m *ChannelAcceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptRequest) ProtoMessage()               {}
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *ChannelAcceptRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
 }
 
-func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
-func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*OpenChannelRequest) ProtoMessage()               {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
-
-func (m *OpenChannelRequest) GetNodePubkey() []byte {
+func (m *ChannelAcceptRequest) GetChainHash() []byte {
 	if m != nil {
-		return m.NodePubkey
+		return m.ChainHash
 	}
 	return nil
 }
 
-func (m *OpenChannelRequest) GetNodePubkeyString() string {
+func (m *ChannelAcceptRequest) GetPendingChanId() []byte {
 	if m != nil {
-		return m.NodePubkeyString
+		return m.PendingChanId
 	}
-	return ""
+	return nil
 }
 
-func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+func (m *ChannelAcceptRequest) GetFundingAmt() uint64 {
 	if m != nil {
-		return m.LocalFundingAmount
+		return m.FundingAmt
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPushSat() int64 {
+func (m *ChannelAcceptRequest) GetPushAmt() uint64 {
 	if m != nil {
-		return m.PushSat
+		return m.PushAmt
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetTargetConf() int32 {
+func (m *ChannelAcceptRequest) GetDustLimit() uint64 {
 	if m != nil {
-		return m.TargetConf
+		return m.DustLimit
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSatPerByte() int64 {
+func (m *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.MaxValueInFlight
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPrivate() bool {
+func (m *ChannelAcceptRequest) GetChannelReserve() uint64 {
 	if m != nil {
-		return m.Private
+		return m.ChannelReserve
 	}
-	return false
+	return 0
 }
 
-func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+func (m *ChannelAcceptRequest) GetMinHtlc() uint64 {
 	if m != nil {
-		return m.MinHtlcMsat
+		return m.MinHtlc
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+func (m *ChannelAcceptRequest) GetFeePerKw() uint64 {
 	if m != nil {
-		return m.RemoteCsvDelay
+		return m.FeePerKw
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *ChannelAcceptRequest) GetCsvDelay() uint32 {
 	if m != nil {
-		return m.MinConfs
+		return m.CsvDelay
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+func (m *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
 	if m != nil {
-		return m.SpendUnconfirmed
+		return m.MaxAcceptedHtlcs
 	}
-	return false
+	return 0
 }
 
-type OpenStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_Confirmation
-	//	*OpenStatusUpdate_ChanOpen
-	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
+	if m != nil {
+		return m.ChannelFlags
+	}
+	return 0
 }
 
-func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
-func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
-func (*OpenStatusUpdate) ProtoMessage()               {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+type ChannelAcceptResponse struct {
+	// / Whether the channel should be accepted
+	Accept bool `protobuf:"varint,1,opt,name=accept" json:"accept,omitempty"`
+	// / The temporary ID of the pending channel the response is for
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / An optional error message sent to the peer if the channel is rejected
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// / The maximum value of pending HTLCs the initiator may offer us, in millisatoshis. If 0, the default is used.
+	MaxValueInFlight uint64 `protobuf:"varint,4,opt,name=max_value_in_flight" json:"max_value_in_flight,omitempty"`
+	// / The maximum number of pending HTLCs the initiator may offer us. If 0, the default is used.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,5,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// / The minimum balance the initiator must keep in the channel, in satoshis. If 0, the default is used.
+	ChannelReserve uint64 `protobuf:"varint,6,opt,name=channel_reserve" json:"channel_reserve,omitempty"`
+	// / The dust limit of our commitment transaction, in satoshis. If 0, the default is used.
+	DustLimit uint64 `protobuf:"varint,7,opt,name=dust_limit" json:"dust_limit,omitempty"`
+	// / The smallest HTLC we'll accept from the initiator, in millisatoshis. If 0, the default is used.
+	MinHtlc uint64 `protobuf:"varint,8,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	// / The number of blocks the initiator must wait to sweep its funds after a force close. If 0, the default is used.
+	CsvDelay uint32 `protobuf:"varint,9,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The largest csv_delay of the request we'll accept. If 0, the default is used.
+	MaxCsvDelay uint32 `protobuf:"varint,10,opt,name=max_csv_delay" json:"max_csv_delay,omitempty"`
+	// / An optional address we'll commit to sending our funds to upon a cooperative close. Requires the initiator to support upfront shutdown scripts.
+	UpfrontShutdown string `protobuf:"bytes,11,opt,name=upfront_shutdown" json:"upfront_shutdown,omitempty"`
+	// / Whether the channel may be used before its funding transaction confirmed. The initiator must be trusted not to double spend it.
+	ZeroConf bool `protobuf:"varint,12,opt,name=zero_conf" json:"zero_conf,omitempty"`
+}
 
-type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
+func (m *ChannelAcceptResponse) Reset()                    { *m = ChannelAcceptResponse{} }
+func (m *ChannelAcceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptResponse) ProtoMessage()               {}
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
+func (m *ChannelAcceptResponse) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
 }
-type OpenStatusUpdate_Confirmation struct {
+
+func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *ChannelAcceptResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ChannelAcceptResponse) GetMaxValueInFlight() uint64 {
+	if m != nil {
+		return m.MaxValueInFlight
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetChannelReserve() uint64 {
+	if m != nil {
+		return m.ChannelReserve
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetDustLimit() uint64 {
+	if m != nil {
+		return m.DustLimit
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMinHtlc() uint64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMaxCsvDelay() uint32 {
+	if m != nil {
+		return m.MaxCsvDelay
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetUpfrontShutdown() string {
+	if m != nil {
+		return m.UpfrontShutdown
+	}
+	return ""
+}
+
+func (m *ChannelAcceptResponse) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
+type OpenChannelRequest struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hex encoded pubkey of the node to open a channel with
+	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+	// *
+	// Whether the remote node should be invited to contribute funds of its own
+	// to the channel, up to the local funding amount. Requires the remote node
+	// to support dual funded channels.
+	DualFund bool `protobuf:"varint,13,opt,name=dual_fund" json:"dual_fund,omitempty"`
+	// / The maximum value in millisatoshi of pending HTLCs the remote may offer us. If this is not set, the channel capacity minus the channel reserve is used.
+	MaxValueInFlightMsat uint64 `protobuf:"varint,14,opt,name=max_value_in_flight_msat" json:"max_value_in_flight_msat,omitempty"`
+	// / The maximum number of pending HTLCs the remote may offer us. If this is not set, the maximum of 483 is used.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,15,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// / The balance in satoshis the remote must keep in the channel. If this is not set, 1% of the channel capacity is used.
+	RemoteChanReserveSat int64 `protobuf:"varint,16,opt,name=remote_chan_reserve_sat" json:"remote_chan_reserve_sat,omitempty"`
+	// / The dust limit in satoshis of our commitment transaction. If this is not set, the default dust limit is used.
+	DustLimitSat int64 `protobuf:"varint,17,opt,name=dust_limit_sat" json:"dust_limit_sat,omitempty"`
+	// / The largest delay the remote may require on our commitment transaction. If this is not set, a default of 10000 blocks is used.
+	MaxRemoteCsvDelay uint32 `protobuf:"varint,18,opt,name=max_remote_csv_delay" json:"max_remote_csv_delay,omitempty"`
+	// *
+	// An optional address we'll commit to sending our funds to upon a
+	// cooperative close of the channel, which can't be changed afterwards.
+	// Requires the remote node to support upfront shutdown scripts.
+	CloseAddress string `protobuf:"bytes,19,opt,name=close_address" json:"close_address,omitempty"`
+}
+
+func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
+func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenChannelRequest) ProtoMessage()               {}
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *OpenChannelRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *OpenChannelRequest) GetNodePubkeyString() string {
+	if m != nil {
+		return m.NodePubkeyString
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetDualFund() bool {
+	if m != nil {
+		return m.DualFund
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetMaxValueInFlightMsat() uint64 {
+	if m != nil {
+		return m.MaxValueInFlightMsat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteChanReserveSat() int64 {
+	if m != nil {
+		return m.RemoteChanReserveSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetDustLimitSat() int64 {
+	if m != nil {
+		return m.DustLimitSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetMaxRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.MaxRemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_Confirmation
+	//	*OpenStatusUpdate_ChanOpen
+	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+}
+
+func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
+func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
+func (*OpenStatusUpdate) ProtoMessage()               {}
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
+
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
+}
+type OpenStatusUpdate_Confirmation struct {
 	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
 }
 type OpenStatusUpdate_ChanOpen struct {
@@ -2485,7 +3993,7 @@ type PendingHTLC struct {
 func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()               {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
@@ -2535,7 +4043,7 @@ type PendingChannelsRequest struct {
 func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
 func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()               {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 type PendingChannelsResponse struct {
 	// / The balance in satoshis encumbered in pending channels
@@ -2548,12 +4056,14 @@ type PendingChannelsResponse struct {
 	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels" json:"pending_force_closing_channels,omitempty"`
 	// / Channels waiting for closing tx to confirm
 	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels" json:"waiting_close_channels,omitempty"`
+	// / Channel reservations whose funding transaction hasn't been published yet
+	PendingReservations []*PendingChannelsResponse_PendingReservation `protobuf:"bytes,6,rep,name=pending_reservations" json:"pending_reservations,omitempty"`
 }
 
 func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
 func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()               {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
 	if m != nil {
@@ -2590,6 +4100,13 @@ func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsRe
 	return nil
 }
 
+func (m *PendingChannelsResponse) GetPendingReservations() []*PendingChannelsResponse_PendingReservation {
+	if m != nil {
+		return m.PendingReservations
+	}
+	return nil
+}
+
 type PendingChannelsResponse_PendingChannel struct {
 	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
 	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
@@ -2604,7 +4121,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 0}
+	return fileDescriptor0, []int{67, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
@@ -2671,7 +4188,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 1}
+	return fileDescriptor0, []int{67, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2724,7 +4241,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 2}
+	return fileDescriptor0, []int{67, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2752,7 +4269,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 3}
+	return fileDescriptor0, []int{67, 3}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2796,7 +4313,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 4}
+	return fileDescriptor0, []int{67, 4}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2848,38 +4365,140 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*Pendin
 	return nil
 }
 
-type WalletBalanceRequest struct {
+type PendingChannelsResponse_PendingReservation struct {
+	// / The identity pubkey of the remote node
+	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
+	// / The ID identifying the reservation until the funding transaction is known
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The total capacity of the channel
+	Capacity int64 `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
 }
 
-func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
-
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+func (m *PendingChannelsResponse_PendingReservation) Reset() {
+	*m = PendingChannelsResponse_PendingReservation{}
+}
+func (m *PendingChannelsResponse_PendingReservation) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingReservation) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{67, 5}
 }
 
-func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (m *PendingChannelsResponse_PendingReservation) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
+	}
+	return ""
+}
 
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *PendingChannelsResponse_PendingReservation) GetPendingChanId() []byte {
 	if m != nil {
-		return m.TotalBalance
+		return m.PendingChanId
 	}
-	return 0
+	return nil
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *PendingChannelsResponse_PendingReservation) GetCapacity() int64 {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.Capacity
+	}
+	return 0
+}
+
+type CancelPendingChannelRequest struct {
+	// / The pending channel ID of a reservation whose funding transaction hasn't been published yet
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The channel point of a pending channel whose funding transaction was published
+	ChannelPoint *ChannelPoint `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The target number of blocks the double spend of the funding inputs should confirm within
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte for the double spend of the funding inputs
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *CancelPendingChannelRequest) Reset()                    { *m = CancelPendingChannelRequest{} }
+func (m *CancelPendingChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelPendingChannelRequest) ProtoMessage()               {}
+func (*CancelPendingChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *CancelPendingChannelRequest) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *CancelPendingChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *CancelPendingChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *CancelPendingChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type CancelPendingChannelResponse struct {
+	// / The txid of the transaction double spending the funding inputs, if one was needed
+	CancelTxid string `protobuf:"bytes,1,opt,name=cancel_txid" json:"cancel_txid,omitempty"`
+}
+
+func (m *CancelPendingChannelResponse) Reset()                    { *m = CancelPendingChannelResponse{} }
+func (m *CancelPendingChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelPendingChannelResponse) ProtoMessage()               {}
+func (*CancelPendingChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *CancelPendingChannelResponse) GetCancelTxid() string {
+	if m != nil {
+		return m.CancelTxid
+	}
+	return ""
+}
+
+type WalletBalanceRequest struct {
+}
+
+func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()               {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+}
+
+func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()               {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
 	}
 	return 0
 }
@@ -2897,7 +4516,7 @@ type ChannelBalanceRequest struct {
 func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 type ChannelBalanceResponse struct {
 	// / Sum of channels balances denominated in satoshis
@@ -2909,7 +4528,7 @@ type ChannelBalanceResponse struct {
 func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
 func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
@@ -2945,7 +4564,7 @@ type QueryRoutesRequest struct {
 func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
@@ -2989,7 +4608,7 @@ type QueryRoutesResponse struct {
 func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
@@ -3019,7 +4638,7 @@ type Hop struct {
 func (m *Hop) Reset()                    { *m = Hop{} }
 func (m *Hop) String() string            { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *Hop) GetChanId() uint64 {
 	if m != nil {
@@ -3116,7 +4735,7 @@ type Route struct {
 func (m *Route) Reset()                    { *m = Route{} }
 func (m *Route) String() string            { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
@@ -3168,7 +4787,7 @@ type NodeInfoRequest struct {
 func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
@@ -3191,7 +4810,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
 func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
@@ -3230,7 +4849,7 @@ type LightningNode struct {
 func (m *LightningNode) Reset()                    { *m = LightningNode{} }
 func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
@@ -3275,7 +4894,7 @@ type NodeAddress struct {
 func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
 func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
@@ -3297,12 +4916,13 @@ type RoutingPolicy struct {
 	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
 	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat" json:"fee_rate_milli_msat,omitempty"`
 	Disabled         bool   `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
+	MaxHtlcMsat      uint64 `protobuf:"varint,6,opt,name=max_htlc_msat" json:"max_htlc_msat,omitempty"`
 }
 
 func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
@@ -3339,6 +4959,13 @@ func (m *RoutingPolicy) GetDisabled() bool {
 	return false
 }
 
+func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
+	if m != nil {
+		return m.MaxHtlcMsat
+	}
+	return 0
+}
+
 // *
 // A fully authenticated channel along with all its unique attributes.
 // Once an authenticated channel announcement has been processed on the network,
@@ -3363,7 +4990,7 @@ type ChannelEdge struct {
 func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
@@ -3432,7 +5059,7 @@ type ChannelGraphRequest struct {
 func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
 	if m != nil {
@@ -3452,7 +5079,7 @@ type ChannelGraph struct {
 func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
@@ -3479,7 +5106,7 @@ type ChanInfoRequest struct {
 func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
@@ -3494,7 +5121,7 @@ type NetworkInfoRequest struct {
 func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 type NetworkInfo struct {
 	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
@@ -3511,7 +5138,7 @@ type NetworkInfo struct {
 func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
@@ -3582,7 +5209,7 @@ type StopRequest struct {
 func (m *StopRequest) Reset()                    { *m = StopRequest{} }
 func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 type StopResponse struct {
 }
@@ -3590,7 +5217,7 @@ type StopResponse struct {
 func (m *StopResponse) Reset()                    { *m = StopResponse{} }
 func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 type GraphTopologySubscription struct {
 }
@@ -3598,7 +5225,7 @@ type GraphTopologySubscription struct {
 func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
 func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 type GraphTopologyUpdate struct {
 	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
@@ -3609,7 +5236,7 @@ type GraphTopologyUpdate struct {
 func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
@@ -3642,7 +5269,7 @@ type NodeUpdate struct {
 func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
@@ -3688,7 +5315,7 @@ type ChannelEdgeUpdate struct {
 func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *ChannelEdgeUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3746,7 +5373,7 @@ type ClosedChannelUpdate struct {
 func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
 func (m *ClosedChannelUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3794,7 +5421,7 @@ type HopHint struct {
 func (m *HopHint) Reset()                    { *m = HopHint{} }
 func (m *HopHint) String() string            { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
 func (m *HopHint) GetNodeId() string {
 	if m != nil {
@@ -3841,7 +5468,7 @@ type RouteHint struct {
 func (m *RouteHint) Reset()                    { *m = RouteHint{} }
 func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 func (m *RouteHint) GetHopHints() []*HopHint {
 	if m != nil {
@@ -3930,7 +5557,7 @@ type Invoice struct {
 func (m *Invoice) Reset()                    { *m = Invoice{} }
 func (m *Invoice) String() string            { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 func (m *Invoice) GetMemo() string {
 	if m != nil {
@@ -4090,7 +5717,7 @@ type AddInvoiceResponse struct {
 func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
@@ -4125,7 +5752,7 @@ type PaymentHash struct {
 func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
 func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
@@ -4159,7 +5786,7 @@ type ListInvoiceRequest struct {
 func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
@@ -4207,7 +5834,7 @@ type ListInvoiceResponse struct {
 func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
@@ -4248,7 +5875,7 @@ type InvoiceSubscription struct {
 func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
@@ -4286,7 +5913,7 @@ type Payment struct {
 func (m *Payment) Reset()                    { *m = Payment{} }
 func (m *Payment) String() string            { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 func (m *Payment) GetPaymentHash() string {
 	if m != nil {
@@ -4350,7 +5977,7 @@ type ListPaymentsRequest struct {
 func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 type ListPaymentsResponse struct {
 	// / The list of payments
@@ -4360,7 +5987,7 @@ type ListPaymentsResponse struct {
 func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
@@ -4375,7 +6002,7 @@ type DeleteAllPaymentsRequest struct {
 func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
 func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
 type DeleteAllPaymentsResponse struct {
 }
@@ -4383,7 +6010,7 @@ type DeleteAllPaymentsResponse struct {
 func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
 func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
 type AbandonChannelRequest struct {
 	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
@@ -4392,7 +6019,7 @@ type AbandonChannelRequest struct {
 func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()               {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
 func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -4407,7 +6034,7 @@ type AbandonChannelResponse struct {
 func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
 func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()               {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
 
 type DebugLevelRequest struct {
 	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
@@ -4417,7 +6044,7 @@ type DebugLevelRequest struct {
 func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
 
 func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
@@ -4440,7 +6067,7 @@ type DebugLevelResponse struct {
 func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
 
 func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
@@ -4457,7 +6084,7 @@ type PayReqString struct {
 func (m *PayReqString) Reset()                    { *m = PayReqString{} }
 func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
 
 func (m *PayReqString) GetPayReq() string {
 	if m != nil {
@@ -4482,7 +6109,7 @@ type PayReq struct {
 func (m *PayReq) Reset()                    { *m = PayReq{} }
 func (m *PayReq) String() string            { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
 
 func (m *PayReq) GetDestination() string {
 	if m != nil {
@@ -4560,7 +6187,7 @@ type FeeReportRequest struct {
 func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
 
 type ChannelFeeReport struct {
 	// / The channel that this fee report belongs to.
@@ -4576,7 +6203,7 @@ type ChannelFeeReport struct {
 func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
 
 func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
@@ -4620,7 +6247,7 @@ type FeeReportResponse struct {
 func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
 func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
@@ -4661,12 +6288,25 @@ type PolicyUpdateRequest struct {
 	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
 	// / The required timelock delta for HTLCs forwarded over the channel.
 	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+	// *
+	// The maximum HTLC size in milli-satoshis forwarded over the channel, capped
+	// at the capacity of the channel. If zero, the current maximum is kept.
+	MaxHtlcMsat uint64 `protobuf:"varint,6,opt,name=max_htlc_msat" json:"max_htlc_msat,omitempty"`
+	// *
+	// If set, the fees of the targeted channels are handed back to the automatic
+	// fee policy manager, after they were set through a previous policy update.
+	// The remaining policy fields are ignored.
+	AutoFees bool `protobuf:"varint,7,opt,name=auto_fees" json:"auto_fees,omitempty"`
+	// *
+	// If set, the maximum HTLC size of the channel is removed, so HTLCs up to its
+	// capacity are forwarded. Can't be combined with max_htlc_msat.
+	ClearMaxHtlc bool `protobuf:"varint,8,opt,name=clear_max_htlc" json:"clear_max_htlc,omitempty"`
 }
 
 func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
 
 type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
 
@@ -4722,6 +6362,27 @@ func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
 	return 0
 }
 
+func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
+	if m != nil {
+		return m.MaxHtlcMsat
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetAutoFees() bool {
+	if m != nil {
+		return m.AutoFees
+	}
+	return false
+}
+
+func (m *PolicyUpdateRequest) GetClearMaxHtlc() bool {
+	if m != nil {
+		return m.ClearMaxHtlc
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
@@ -4801,7 +6462,41 @@ type PolicyUpdateResponse struct {
 func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+type UpdateChanStatusRequest struct {
+	// / The channel whose status should be set.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point" json:"chan_point,omitempty"`
+	// / The status the channel should be set to.
+	Action UpdateChanStatusRequest_ChanStatusAction `protobuf:"varint,2,opt,name=action,enum=lnrpc.UpdateChanStatusRequest_ChanStatusAction" json:"action,omitempty"`
+}
+
+func (m *UpdateChanStatusRequest) Reset()                    { *m = UpdateChanStatusRequest{} }
+func (m *UpdateChanStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateChanStatusRequest) ProtoMessage()               {}
+func (*UpdateChanStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *UpdateChanStatusRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *UpdateChanStatusRequest) GetAction() UpdateChanStatusRequest_ChanStatusAction {
+	if m != nil {
+		return m.Action
+	}
+	return UpdateChanStatusRequest_ENABLE
+}
+
+type UpdateChanStatusResponse struct {
+}
+
+func (m *UpdateChanStatusResponse) Reset()                    { *m = UpdateChanStatusResponse{} }
+func (m *UpdateChanStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateChanStatusResponse) ProtoMessage()               {}
+func (*UpdateChanStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
 
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -4817,7 +6512,7 @@ type ForwardingHistoryRequest struct {
 func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
 func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
 func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
@@ -4830,111 +6525,1913 @@ func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
 	if m != nil {
 		return m.EndTime
 	}
-	return 0
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+	if m != nil {
+		return m.NumMaxEvents
+	}
+	return 0
+}
+
+type ForwardingEvent struct {
+	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// / The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
+	// / The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
+	// / The total amount of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
+	// / The total amount of the outgoign HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
+	// / The total fee that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+}
+
+func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()               {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+func (m *ForwardingEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
+	if m != nil {
+		return m.ChanIdIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
+	if m != nil {
+		return m.ChanIdOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtIn() uint64 {
+	if m != nil {
+		return m.AmtIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtOut() uint64 {
+	if m != nil {
+		return m.AmtOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type ForwardingHistoryResponse struct {
+	// / A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
+	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+}
+
+func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()               {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+	if m != nil {
+		return m.ForwardingEvents
+	}
+	return nil
+}
+
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
+
+type ForwardingStatsRequest struct {
+	// / Start time is the starting point of the time range, in seconds since the unix epoch.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
+	// / End time is the end point of the time range, in seconds since the unix epoch.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time" json:"end_time,omitempty"`
+	// / The size of the time buckets in seconds, which must be a multiple of an hour. If zero, all stats are returned in a single bucket.
+	BucketSize uint64 `protobuf:"varint,3,opt,name=bucket_size" json:"bucket_size,omitempty"`
+	// / How forwards are grouped within each time bucket.
+	GroupBy ForwardingStatsRequest_GroupBy `protobuf:"varint,4,opt,name=group_by,enum=lnrpc.ForwardingStatsRequest_GroupBy" json:"group_by,omitempty"`
+}
+
+func (m *ForwardingStatsRequest) Reset()                    { *m = ForwardingStatsRequest{} }
+func (m *ForwardingStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingStatsRequest) ProtoMessage()               {}
+func (*ForwardingStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
+
+func (m *ForwardingStatsRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ForwardingStatsRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ForwardingStatsRequest) GetBucketSize() uint64 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+func (m *ForwardingStatsRequest) GetGroupBy() ForwardingStatsRequest_GroupBy {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ForwardingStatsRequest_OUTGOING_CHANNEL
+}
+
+type ForwardingStatsGroup struct {
+	// / The channel ID of the group, set when grouping by channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The public key of the peer of the group, set when grouping by peer.
+	PeerPubKey string `protobuf:"bytes,2,opt,name=peer_pub_key" json:"peer_pub_key,omitempty"`
+	// / The number of forwards of the group.
+	NumForwards uint64 `protobuf:"varint,3,opt,name=num_forwards" json:"num_forwards,omitempty"`
+	// / The total amount forwarded by the group, in milli-satoshis.
+	VolumeMsat uint64 `protobuf:"varint,4,opt,name=volume_msat" json:"volume_msat,omitempty"`
+	// / The total fees earned by the group, in milli-satoshis.
+	FeeMsat uint64 `protobuf:"varint,5,opt,name=fee_msat" json:"fee_msat,omitempty"`
+}
+
+func (m *ForwardingStatsGroup) Reset()                    { *m = ForwardingStatsGroup{} }
+func (m *ForwardingStatsGroup) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingStatsGroup) ProtoMessage()               {}
+func (*ForwardingStatsGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *ForwardingStatsGroup) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ForwardingStatsGroup) GetPeerPubKey() string {
+	if m != nil {
+		return m.PeerPubKey
+	}
+	return ""
+}
+
+func (m *ForwardingStatsGroup) GetNumForwards() uint64 {
+	if m != nil {
+		return m.NumForwards
+	}
+	return 0
+}
+
+func (m *ForwardingStatsGroup) GetVolumeMsat() uint64 {
+	if m != nil {
+		return m.VolumeMsat
+	}
+	return 0
+}
+
+func (m *ForwardingStatsGroup) GetFeeMsat() uint64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+type ForwardingStatsBucket struct {
+	// / The start time of the bucket, in seconds since the unix epoch.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
+	// / The stats of each group with forwards within the bucket.
+	Groups []*ForwardingStatsGroup `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *ForwardingStatsBucket) Reset()                    { *m = ForwardingStatsBucket{} }
+func (m *ForwardingStatsBucket) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingStatsBucket) ProtoMessage()               {}
+func (*ForwardingStatsBucket) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *ForwardingStatsBucket) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ForwardingStatsBucket) GetGroups() []*ForwardingStatsGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type ForwardingStatsResponse struct {
+	// / The time buckets with forwards within the time range, in chronological order.
+	Buckets []*ForwardingStatsBucket `protobuf:"bytes,1,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *ForwardingStatsResponse) Reset()                    { *m = ForwardingStatsResponse{} }
+func (m *ForwardingStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingStatsResponse) ProtoMessage()               {}
+func (*ForwardingStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *ForwardingStatsResponse) GetBuckets() []*ForwardingStatsBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type CircuitKey struct {
+	// / The id of the channel that is part of this circuit.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The index of the incoming htlc in the incoming channel.
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id" json:"htlc_id,omitempty"`
+}
+
+func (m *CircuitKey) Reset()                    { *m = CircuitKey{} }
+func (m *CircuitKey) String() string            { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()               {}
+func (*CircuitKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptRequest struct {
+	// / The key of this forwarded htlc. It defines the incoming channel id and the index in this channel.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key" json:"incoming_circuit_key,omitempty"`
+	// / The incoming htlc amount, in millisatoshis.
+	IncomingAmountMsat uint64 `protobuf:"varint,2,opt,name=incoming_amount_msat" json:"incoming_amount_msat,omitempty"`
+	// / The incoming htlc expiry height.
+	IncomingExpiry uint32 `protobuf:"varint,3,opt,name=incoming_expiry" json:"incoming_expiry,omitempty"`
+	// / The htlc payment hash.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The channel id of the channel the htlc was requested to be forwarded over.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id" json:"outgoing_requested_chan_id,omitempty"`
+	// / The outgoing htlc amount, in millisatoshis.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat" json:"outgoing_amount_msat,omitempty"`
+	// / The outgoing htlc expiry height.
+	OutgoingExpiry uint32 `protobuf:"varint,7,opt,name=outgoing_expiry" json:"outgoing_expiry,omitempty"`
+	// / The unix timestamp at which the htlc is failed back if it hasn't been resolved.
+	HoldDeadline int64 `protobuf:"varint,8,opt,name=hold_deadline" json:"hold_deadline,omitempty"`
+}
+
+func (m *ForwardHtlcInterceptRequest) Reset()                    { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()               {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
+	if m != nil {
+		return m.OutgoingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetHoldDeadline() int64 {
+	if m != nil {
+		return m.HoldDeadline
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptResponse struct {
+	// / The key of the held htlc to resolve.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key" json:"incoming_circuit_key,omitempty"`
+	// / How the held htlc should be resolved.
+	Action ForwardHtlcInterceptResponse_ResolveAction `protobuf:"varint,2,opt,name=action,enum=lnrpc.ForwardHtlcInterceptResponse_ResolveAction" json:"action,omitempty"`
+	// / The preimage to settle the htlc with, if the action is SETTLE.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// / The failure to send back to the sender, if the action is FAIL.
+	FailureCode ForwardHtlcInterceptResponse_FailureCode `protobuf:"varint,4,opt,name=failure_code,enum=lnrpc.ForwardHtlcInterceptResponse_FailureCode" json:"failure_code,omitempty"`
+}
+
+func (m *ForwardHtlcInterceptResponse) Reset()                    { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()               {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetAction() ForwardHtlcInterceptResponse_ResolveAction {
+	if m != nil {
+		return m.Action
+	}
+	return ForwardHtlcInterceptResponse_RESUME
+}
+
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() ForwardHtlcInterceptResponse_FailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE
+}
+
+type SubscribeHtlcEventsRequest struct {
+}
+
+func (m *SubscribeHtlcEventsRequest) Reset()                    { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()               {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{132} }
+
+type HtlcEvent struct {
+	// / The short channel id the htlc came in on, zero for htlcs sent by our node.
+	IncomingChannelId uint64 `protobuf:"varint,1,opt,name=incoming_channel_id" json:"incoming_channel_id,omitempty"`
+	// / The short channel id the htlc went out on, zero for htlcs received by our node.
+	OutgoingChannelId uint64 `protobuf:"varint,2,opt,name=outgoing_channel_id" json:"outgoing_channel_id,omitempty"`
+	// / The index of the htlc on the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id" json:"incoming_htlc_id,omitempty"`
+	// / The index of the htlc on the outgoing channel, zero if it was never added to the outgoing channel.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id" json:"outgoing_htlc_id,omitempty"`
+	// / The time the event occurred, in unix nanoseconds.
+	TimestampNs uint64 `protobuf:"varint,5,opt,name=timestamp_ns" json:"timestamp_ns,omitempty"`
+	// / The role our node played in the htlc.
+	EventType HtlcEvent_EventType `protobuf:"varint,6,opt,name=event_type,enum=lnrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*HtlcEvent_ForwardEvent
+	//	*HtlcEvent_ForwardFailEvent
+	//	*HtlcEvent_SettleEvent
+	//	*HtlcEvent_LinkFailEvent
+	Event isHtlcEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *HtlcEvent) Reset()                    { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string            { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()               {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{133} }
+
+type isHtlcEvent_Event interface{ isHtlcEvent_Event() }
+
+type HtlcEvent_ForwardEvent struct {
+	ForwardEvent *ForwardEvent `protobuf:"bytes,7,opt,name=forward_event,oneof"`
+}
+type HtlcEvent_ForwardFailEvent struct {
+	ForwardFailEvent *ForwardFailEvent `protobuf:"bytes,8,opt,name=forward_fail_event,oneof"`
+}
+type HtlcEvent_SettleEvent struct {
+	SettleEvent *SettleEvent `protobuf:"bytes,9,opt,name=settle_event,oneof"`
+}
+type HtlcEvent_LinkFailEvent struct {
+	LinkFailEvent *LinkFailEvent `protobuf:"bytes,10,opt,name=link_fail_event,oneof"`
+}
+
+func (*HtlcEvent_ForwardEvent) isHtlcEvent_Event()     {}
+func (*HtlcEvent_ForwardFailEvent) isHtlcEvent_Event() {}
+func (*HtlcEvent_SettleEvent) isHtlcEvent_Event()      {}
+func (*HtlcEvent_LinkFailEvent) isHtlcEvent_Event()    {}
+
+func (m *HtlcEvent) GetEvent() isHtlcEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetIncomingChannelId() uint64 {
+	if m != nil {
+		return m.IncomingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingChannelId() uint64 {
+	if m != nil {
+		return m.OutgoingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return HtlcEvent_UNKNOWN
+}
+
+func (m *HtlcEvent) GetForwardEvent() *ForwardEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardEvent); ok {
+		return x.ForwardEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardFailEvent() *ForwardFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardFailEvent); ok {
+		return x.ForwardFailEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetSettleEvent() *SettleEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_SettleEvent); ok {
+		return x.SettleEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_LinkFailEvent); ok {
+		return x.LinkFailEvent
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HtlcEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HtlcEvent_OneofMarshaler, _HtlcEvent_OneofUnmarshaler, _HtlcEvent_OneofSizer, []interface{}{
+		(*HtlcEvent_ForwardEvent)(nil),
+		(*HtlcEvent_ForwardFailEvent)(nil),
+		(*HtlcEvent_SettleEvent)(nil),
+		(*HtlcEvent_LinkFailEvent)(nil),
+	}
+}
+
+func _HtlcEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HtlcEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *HtlcEvent_ForwardEvent:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ForwardEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_ForwardFailEvent:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ForwardFailEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_SettleEvent:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SettleEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_LinkFailEvent:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LinkFailEvent); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("HtlcEvent.Event has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _HtlcEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HtlcEvent)
+	switch tag {
+	case 7: // event.forward_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ForwardEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_ForwardEvent{msg}
+		return true, err
+	case 8: // event.forward_fail_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ForwardFailEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_ForwardFailEvent{msg}
+		return true, err
+	case 9: // event.settle_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SettleEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_SettleEvent{msg}
+		return true, err
+	case 10: // event.link_fail_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LinkFailEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_LinkFailEvent{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HtlcEvent_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HtlcEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *HtlcEvent_ForwardEvent:
+		s := proto.Size(x.ForwardEvent)
+		n += proto.SizeVarint(7<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_ForwardFailEvent:
+		s := proto.Size(x.ForwardFailEvent)
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_SettleEvent:
+		s := proto.Size(x.SettleEvent)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_LinkFailEvent:
+		s := proto.Size(x.LinkFailEvent)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type HtlcInfo struct {
+	// / The timelock of the htlc on the incoming channel.
+	IncomingTimelock uint32 `protobuf:"varint,1,opt,name=incoming_timelock" json:"incoming_timelock,omitempty"`
+	// / The timelock of the htlc on the outgoing channel.
+	OutgoingTimelock uint32 `protobuf:"varint,2,opt,name=outgoing_timelock" json:"outgoing_timelock,omitempty"`
+	// / The amount of the htlc on the incoming channel, in millisatoshis.
+	IncomingAmtMsat uint64 `protobuf:"varint,3,opt,name=incoming_amt_msat" json:"incoming_amt_msat,omitempty"`
+	// / The amount of the htlc on the outgoing channel, in millisatoshis.
+	OutgoingAmtMsat uint64 `protobuf:"varint,4,opt,name=outgoing_amt_msat" json:"outgoing_amt_msat,omitempty"`
+}
+
+func (m *HtlcInfo) Reset()                    { *m = HtlcInfo{} }
+func (m *HtlcInfo) String() string            { return proto.CompactTextString(m) }
+func (*HtlcInfo) ProtoMessage()               {}
+func (*HtlcInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+func (m *HtlcInfo) GetIncomingTimelock() uint32 {
+	if m != nil {
+		return m.IncomingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingTimelock() uint32 {
+	if m != nil {
+		return m.OutgoingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetIncomingAmtMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingAmtMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMsat
+	}
+	return 0
+}
+
+type ForwardEvent struct {
+	// / The amounts and timelocks of the htlc added to the outgoing channel.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+}
+
+func (m *ForwardEvent) Reset()                    { *m = ForwardEvent{} }
+func (m *ForwardEvent) String() string            { return proto.CompactTextString(m) }
+func (*ForwardEvent) ProtoMessage()               {}
+func (*ForwardEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *ForwardEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ForwardFailEvent struct {
+	// / The amounts of the htlc that was failed downstream.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	// / The failure code returned by the downstream node, only set for htlcs sent by our node.
+	WireFailureCode uint32 `protobuf:"varint,2,opt,name=wire_failure_code" json:"wire_failure_code,omitempty"`
+	// / A human readable version of the downstream failure, only set for htlcs sent by our node.
+	WireFailure string `protobuf:"bytes,3,opt,name=wire_failure" json:"wire_failure,omitempty"`
+}
+
+func (m *ForwardFailEvent) Reset()                    { *m = ForwardFailEvent{} }
+func (m *ForwardFailEvent) String() string            { return proto.CompactTextString(m) }
+func (*ForwardFailEvent) ProtoMessage()               {}
+func (*ForwardFailEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+func (m *ForwardFailEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *ForwardFailEvent) GetWireFailureCode() uint32 {
+	if m != nil {
+		return m.WireFailureCode
+	}
+	return 0
+}
+
+func (m *ForwardFailEvent) GetWireFailure() string {
+	if m != nil {
+		return m.WireFailure
+	}
+	return ""
+}
+
+type SettleEvent struct {
+	// / The amounts of the htlc that was settled.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+}
+
+func (m *SettleEvent) Reset()                    { *m = SettleEvent{} }
+func (m *SettleEvent) String() string            { return proto.CompactTextString(m) }
+func (*SettleEvent) ProtoMessage()               {}
+func (*SettleEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{137} }
+
+func (m *SettleEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type LinkFailEvent struct {
+	// / The amounts and timelocks of the htlc that was failed.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	// / The failure code sent back to the sender of the htlc.
+	WireFailureCode uint32 `protobuf:"varint,2,opt,name=wire_failure_code" json:"wire_failure_code,omitempty"`
+	// / A human readable version of the failure sent back to the sender.
+	WireFailure string `protobuf:"bytes,3,opt,name=wire_failure" json:"wire_failure,omitempty"`
+	// / The local reason the htlc was failed, if it isn't fully described by the wire failure.
+	FailureDetail LinkFailEvent_FailureDetail `protobuf:"varint,4,opt,name=failure_detail,enum=lnrpc.LinkFailEvent_FailureDetail" json:"failure_detail,omitempty"`
+	// / A human readable version of the failure detail.
+	FailureString string `protobuf:"bytes,5,opt,name=failure_string" json:"failure_string,omitempty"`
+	// / Whether the htlc was failed on the incoming link, rather than when being added to the outgoing link.
+	Incoming bool `protobuf:"varint,6,opt,name=incoming" json:"incoming,omitempty"`
+}
+
+func (m *LinkFailEvent) Reset()                    { *m = LinkFailEvent{} }
+func (m *LinkFailEvent) String() string            { return proto.CompactTextString(m) }
+func (*LinkFailEvent) ProtoMessage()               {}
+func (*LinkFailEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+func (m *LinkFailEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *LinkFailEvent) GetWireFailureCode() uint32 {
+	if m != nil {
+		return m.WireFailureCode
+	}
+	return 0
+}
+
+func (m *LinkFailEvent) GetWireFailure() string {
+	if m != nil {
+		return m.WireFailure
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetFailureDetail() LinkFailEvent_FailureDetail {
+	if m != nil {
+		return m.FailureDetail
+	}
+	return LinkFailEvent_NONE
+}
+
+func (m *LinkFailEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+type ForwardLimits struct {
+	// / The maximum number of forwards that may be in flight from a single incoming channel, 0 if unlimited.
+	MaxChanHtlcs uint32 `protobuf:"varint,1,opt,name=max_chan_htlcs" json:"max_chan_htlcs,omitempty"`
+	// / The maximum total value of the forwards that may be in flight from a single incoming channel, 0 if unlimited.
+	MaxChanAmtMsat uint64 `protobuf:"varint,2,opt,name=max_chan_amt_msat" json:"max_chan_amt_msat,omitempty"`
+	// / The maximum number of forwards that may be in flight from all channels with a single peer, 0 if unlimited.
+	MaxPeerHtlcs uint32 `protobuf:"varint,3,opt,name=max_peer_htlcs" json:"max_peer_htlcs,omitempty"`
+	// / The maximum total value of the forwards that may be in flight from all channels with a single peer, 0 if unlimited.
+	MaxPeerAmtMsat uint64 `protobuf:"varint,4,opt,name=max_peer_amt_msat" json:"max_peer_amt_msat,omitempty"`
+	// / The number of new forwards per second a single peer may offer on average, 0 if unlimited.
+	PeerRate float64 `protobuf:"fixed64,5,opt,name=peer_rate" json:"peer_rate,omitempty"`
+	// / The number of new forwards a single peer may offer at once, above the peer rate.
+	PeerBurst uint32 `protobuf:"varint,6,opt,name=peer_burst" json:"peer_burst,omitempty"`
+	// / Whether forwards that exceed the limits are failed back, or queued until they can be admitted.
+	Mode ForwardLimits_LimitMode `protobuf:"varint,7,opt,name=mode,enum=lnrpc.ForwardLimits_LimitMode" json:"mode,omitempty"`
+	// / How long a forward may be queued before it's failed back, in milliseconds.
+	QueueTimeoutMs uint64 `protobuf:"varint,8,opt,name=queue_timeout_ms" json:"queue_timeout_ms,omitempty"`
+	// / The maximum number of forwards that may be queued for a single peer, 0 if unlimited.
+	MaxQueued uint32 `protobuf:"varint,9,opt,name=max_queued" json:"max_queued,omitempty"`
+}
+
+func (m *ForwardLimits) Reset()                    { *m = ForwardLimits{} }
+func (m *ForwardLimits) String() string            { return proto.CompactTextString(m) }
+func (*ForwardLimits) ProtoMessage()               {}
+func (*ForwardLimits) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+func (m *ForwardLimits) GetMaxChanHtlcs() uint32 {
+	if m != nil {
+		return m.MaxChanHtlcs
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetMaxChanAmtMsat() uint64 {
+	if m != nil {
+		return m.MaxChanAmtMsat
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetMaxPeerHtlcs() uint32 {
+	if m != nil {
+		return m.MaxPeerHtlcs
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetMaxPeerAmtMsat() uint64 {
+	if m != nil {
+		return m.MaxPeerAmtMsat
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetPeerRate() float64 {
+	if m != nil {
+		return m.PeerRate
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetPeerBurst() uint32 {
+	if m != nil {
+		return m.PeerBurst
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetMode() ForwardLimits_LimitMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ForwardLimits_FAIL
+}
+
+func (m *ForwardLimits) GetQueueTimeoutMs() uint64 {
+	if m != nil {
+		return m.QueueTimeoutMs
+	}
+	return 0
+}
+
+func (m *ForwardLimits) GetMaxQueued() uint32 {
+	if m != nil {
+		return m.MaxQueued
+	}
+	return 0
+}
+
+type LimitUsage struct {
+	// / The number of admitted forwards in flight.
+	PendingHtlcs uint32 `protobuf:"varint,1,opt,name=pending_htlcs" json:"pending_htlcs,omitempty"`
+	// / The total value of the admitted forwards in flight.
+	PendingAmtMsat uint64 `protobuf:"varint,2,opt,name=pending_amt_msat" json:"pending_amt_msat,omitempty"`
+	// / The number of forwards waiting to be admitted.
+	Queued uint32 `protobuf:"varint,3,opt,name=queued" json:"queued,omitempty"`
+	// / The total number of forwards admitted.
+	Admitted uint64 `protobuf:"varint,4,opt,name=admitted" json:"admitted,omitempty"`
+	// / The total number of forwards failed back by the limiter.
+	Rejected uint64 `protobuf:"varint,5,opt,name=rejected" json:"rejected,omitempty"`
+	// / The total number of forwards that exceeded the peer rate limit.
+	RateLimited uint64 `protobuf:"varint,6,opt,name=rate_limited" json:"rate_limited,omitempty"`
+}
+
+func (m *LimitUsage) Reset()                    { *m = LimitUsage{} }
+func (m *LimitUsage) String() string            { return proto.CompactTextString(m) }
+func (*LimitUsage) ProtoMessage()               {}
+func (*LimitUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *LimitUsage) GetPendingHtlcs() uint32 {
+	if m != nil {
+		return m.PendingHtlcs
+	}
+	return 0
+}
+
+func (m *LimitUsage) GetPendingAmtMsat() uint64 {
+	if m != nil {
+		return m.PendingAmtMsat
+	}
+	return 0
+}
+
+func (m *LimitUsage) GetQueued() uint32 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *LimitUsage) GetAdmitted() uint64 {
+	if m != nil {
+		return m.Admitted
+	}
+	return 0
+}
+
+func (m *LimitUsage) GetRejected() uint64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *LimitUsage) GetRateLimited() uint64 {
+	if m != nil {
+		return m.RateLimited
+	}
+	return 0
+}
+
+type ChannelLimitUsage struct {
+	// / The short channel id of the incoming channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The usage of the incoming channel.
+	Usage *LimitUsage `protobuf:"bytes,2,opt,name=usage" json:"usage,omitempty"`
+}
+
+func (m *ChannelLimitUsage) Reset()                    { *m = ChannelLimitUsage{} }
+func (m *ChannelLimitUsage) String() string            { return proto.CompactTextString(m) }
+func (*ChannelLimitUsage) ProtoMessage()               {}
+func (*ChannelLimitUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+func (m *ChannelLimitUsage) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelLimitUsage) GetUsage() *LimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type PeerLimitUsage struct {
+	// / The public key of the incoming peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / The usage of the incoming peer.
+	Usage *LimitUsage `protobuf:"bytes,2,opt,name=usage" json:"usage,omitempty"`
+}
+
+func (m *PeerLimitUsage) Reset()                    { *m = PeerLimitUsage{} }
+func (m *PeerLimitUsage) String() string            { return proto.CompactTextString(m) }
+func (*PeerLimitUsage) ProtoMessage()               {}
+func (*PeerLimitUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+func (m *PeerLimitUsage) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerLimitUsage) GetUsage() *LimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type ForwardingLimitsRequest struct {
+}
+
+func (m *ForwardingLimitsRequest) Reset()                    { *m = ForwardingLimitsRequest{} }
+func (m *ForwardingLimitsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingLimitsRequest) ProtoMessage()               {}
+func (*ForwardingLimitsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+type ForwardingLimitsResponse struct {
+	// / The current forwarding limits.
+	Limits *ForwardLimits `protobuf:"bytes,1,opt,name=limits" json:"limits,omitempty"`
+	// / The usage of each incoming channel that has offered us forwards.
+	Channels []*ChannelLimitUsage `protobuf:"bytes,2,rep,name=channels" json:"channels,omitempty"`
+	// / The usage of each incoming peer that has offered us forwards.
+	Peers []*PeerLimitUsage `protobuf:"bytes,3,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *ForwardingLimitsResponse) Reset()                    { *m = ForwardingLimitsResponse{} }
+func (m *ForwardingLimitsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingLimitsResponse) ProtoMessage()               {}
+func (*ForwardingLimitsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+func (m *ForwardingLimitsResponse) GetLimits() *ForwardLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *ForwardingLimitsResponse) GetChannels() []*ChannelLimitUsage {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *ForwardingLimitsResponse) GetPeers() []*PeerLimitUsage {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type UpdateForwardingLimitsRequest struct {
+	// / The new forwarding limits.
+	Limits *ForwardLimits `protobuf:"bytes,1,opt,name=limits" json:"limits,omitempty"`
+}
+
+func (m *UpdateForwardingLimitsRequest) Reset()         { *m = UpdateForwardingLimitsRequest{} }
+func (m *UpdateForwardingLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateForwardingLimitsRequest) ProtoMessage()    {}
+func (*UpdateForwardingLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{145}
+}
+
+func (m *UpdateForwardingLimitsRequest) GetLimits() *ForwardLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type UpdateForwardingLimitsResponse struct {
+}
+
+func (m *UpdateForwardingLimitsResponse) Reset()         { *m = UpdateForwardingLimitsResponse{} }
+func (m *UpdateForwardingLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateForwardingLimitsResponse) ProtoMessage()    {}
+func (*UpdateForwardingLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{146}
+}
+
+type ListCircuitsRequest struct {
+	// / If set, only the circuits with this incoming or outgoing channel ID are returned.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+}
+
+func (m *ListCircuitsRequest) Reset()                    { *m = ListCircuitsRequest{} }
+func (m *ListCircuitsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListCircuitsRequest) ProtoMessage()               {}
+func (*ListCircuitsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *ListCircuitsRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+type PaymentCircuit struct {
+	// / The key of the incoming htlc of the circuit.
+	IncomingKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_key" json:"incoming_key,omitempty"`
+	// / The key of the outgoing htlc of the circuit, set if the circuit has a keystone.
+	OutgoingKey *CircuitKey `protobuf:"bytes,2,opt,name=outgoing_key" json:"outgoing_key,omitempty"`
+	// / Whether the circuit has been assigned a keystone, and thus is open.
+	HasKeystone bool `protobuf:"varint,3,opt,name=has_keystone" json:"has_keystone,omitempty"`
+	// / The payment hash of the htlc.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The incoming htlc amount, in millisatoshis.
+	IncomingAmtMsat uint64 `protobuf:"varint,5,opt,name=incoming_amt_msat" json:"incoming_amt_msat,omitempty"`
+	// / The outgoing htlc amount, in millisatoshis.
+	OutgoingAmtMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amt_msat" json:"outgoing_amt_msat,omitempty"`
+}
+
+func (m *PaymentCircuit) Reset()                    { *m = PaymentCircuit{} }
+func (m *PaymentCircuit) String() string            { return proto.CompactTextString(m) }
+func (*PaymentCircuit) ProtoMessage()               {}
+func (*PaymentCircuit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *PaymentCircuit) GetIncomingKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingKey
+	}
+	return nil
+}
+
+func (m *PaymentCircuit) GetOutgoingKey() *CircuitKey {
+	if m != nil {
+		return m.OutgoingKey
+	}
+	return nil
+}
+
+func (m *PaymentCircuit) GetHasKeystone() bool {
+	if m != nil {
+		return m.HasKeystone
+	}
+	return false
+}
+
+func (m *PaymentCircuit) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PaymentCircuit) GetIncomingAmtMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (m *PaymentCircuit) GetOutgoingAmtMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMsat
+	}
+	return 0
+}
+
+type ListCircuitsResponse struct {
+	// / The circuits held by the switch, sorted by their incoming key.
+	Circuits []*PaymentCircuit `protobuf:"bytes,1,rep,name=circuits" json:"circuits,omitempty"`
+}
+
+func (m *ListCircuitsResponse) Reset()                    { *m = ListCircuitsResponse{} }
+func (m *ListCircuitsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListCircuitsResponse) ProtoMessage()               {}
+func (*ListCircuitsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+func (m *ListCircuitsResponse) GetCircuits() []*PaymentCircuit {
+	if m != nil {
+		return m.Circuits
+	}
+	return nil
+}
+
+type ListForwardingPackagesRequest struct {
+	// / If set, only the forwarding packages of this channel are returned.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+}
+
+func (m *ListForwardingPackagesRequest) Reset()         { *m = ListForwardingPackagesRequest{} }
+func (m *ListForwardingPackagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListForwardingPackagesRequest) ProtoMessage()    {}
+func (*ListForwardingPackagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{150}
+}
+
+func (m *ListForwardingPackagesRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+type ForwardingPackage struct {
+	// / The remote commitment height at which the package was locked in.
+	Height uint64 `protobuf:"varint,1,opt,name=height" json:"height,omitempty"`
+	// / The processing state of the package.
+	State ForwardingPackage_State `protobuf:"varint,2,opt,name=state,enum=lnrpc.ForwardingPackage_State" json:"state,omitempty"`
+	// / The number of adds in the package.
+	NumAdds uint32 `protobuf:"varint,3,opt,name=num_adds" json:"num_adds,omitempty"`
+	// / The number of adds that were forwarded to the switch.
+	NumAddsForwarded uint32 `protobuf:"varint,4,opt,name=num_adds_forwarded" json:"num_adds_forwarded,omitempty"`
+	// / The number of adds that have been acked.
+	NumAddsAcked uint32 `protobuf:"varint,5,opt,name=num_adds_acked" json:"num_adds_acked,omitempty"`
+	// / The number of settles and fails in the package.
+	NumSettleFails uint32 `protobuf:"varint,6,opt,name=num_settle_fails" json:"num_settle_fails,omitempty"`
+	// / The number of settles and fails that have been acked.
+	NumSettleFailsAcked uint32 `protobuf:"varint,7,opt,name=num_settle_fails_acked" json:"num_settle_fails_acked,omitempty"`
+}
+
+func (m *ForwardingPackage) Reset()                    { *m = ForwardingPackage{} }
+func (m *ForwardingPackage) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingPackage) ProtoMessage()               {}
+func (*ForwardingPackage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+func (m *ForwardingPackage) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForwardingPackage) GetState() ForwardingPackage_State {
+	if m != nil {
+		return m.State
+	}
+	return ForwardingPackage_LOCKED_IN
+}
+
+func (m *ForwardingPackage) GetNumAdds() uint32 {
+	if m != nil {
+		return m.NumAdds
+	}
+	return 0
+}
+
+func (m *ForwardingPackage) GetNumAddsForwarded() uint32 {
+	if m != nil {
+		return m.NumAddsForwarded
+	}
+	return 0
+}
+
+func (m *ForwardingPackage) GetNumAddsAcked() uint32 {
+	if m != nil {
+		return m.NumAddsAcked
+	}
+	return 0
+}
+
+func (m *ForwardingPackage) GetNumSettleFails() uint32 {
+	if m != nil {
+		return m.NumSettleFails
+	}
+	return 0
+}
+
+func (m *ForwardingPackage) GetNumSettleFailsAcked() uint32 {
+	if m != nil {
+		return m.NumSettleFailsAcked
+	}
+	return 0
+}
+
+type ChannelForwardingPackages struct {
+	// / The short channel id of the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The channel point of the channel.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The forwarding packages of the channel, by increasing height.
+	Packages []*ForwardingPackage `protobuf:"bytes,3,rep,name=packages" json:"packages,omitempty"`
+}
+
+func (m *ChannelForwardingPackages) Reset()                    { *m = ChannelForwardingPackages{} }
+func (m *ChannelForwardingPackages) String() string            { return proto.CompactTextString(m) }
+func (*ChannelForwardingPackages) ProtoMessage()               {}
+func (*ChannelForwardingPackages) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+func (m *ChannelForwardingPackages) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelForwardingPackages) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *ChannelForwardingPackages) GetPackages() []*ForwardingPackage {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+type ListForwardingPackagesResponse struct {
+	// / The channels that have forwarding packages which aren't fully acked.
+	Channels []*ChannelForwardingPackages `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+}
+
+func (m *ListForwardingPackagesResponse) Reset()         { *m = ListForwardingPackagesResponse{} }
+func (m *ListForwardingPackagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListForwardingPackagesResponse) ProtoMessage()    {}
+func (*ListForwardingPackagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{153}
+}
+
+func (m *ListForwardingPackagesResponse) GetChannels() []*ChannelForwardingPackages {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type FailStuckHtlcRequest struct {
+	// / The key of the incoming htlc to fail back.
+	IncomingKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_key" json:"incoming_key,omitempty"`
+}
+
+func (m *FailStuckHtlcRequest) Reset()                    { *m = FailStuckHtlcRequest{} }
+func (m *FailStuckHtlcRequest) String() string            { return proto.CompactTextString(m) }
+func (*FailStuckHtlcRequest) ProtoMessage()               {}
+func (*FailStuckHtlcRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+func (m *FailStuckHtlcRequest) GetIncomingKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingKey
+	}
+	return nil
+}
+
+type FailStuckHtlcResponse struct {
+}
+
+func (m *FailStuckHtlcResponse) Reset()                    { *m = FailStuckHtlcResponse{} }
+func (m *FailStuckHtlcResponse) String() string            { return proto.CompactTextString(m) }
+func (*FailStuckHtlcResponse) ProtoMessage()               {}
+func (*FailStuckHtlcResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+type ReplayLogInfoRequest struct {
+}
+
+func (m *ReplayLogInfoRequest) Reset()                    { *m = ReplayLogInfoRequest{} }
+func (m *ReplayLogInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayLogInfoRequest) ProtoMessage()               {}
+func (*ReplayLogInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+type ReplayLogInfoResponse struct {
+	// / The number of hashed shared secrets in the log.
+	NumEntries uint64 `protobuf:"varint,1,opt,name=num_entries" json:"num_entries,omitempty"`
+	// / The lowest CLTV of the entries in the log, zero if the log is empty.
+	OldestCltv uint32 `protobuf:"varint,2,opt,name=oldest_cltv" json:"oldest_cltv,omitempty"`
+	// / The number of processed batches whose replay set is kept in the log.
+	NumBatches uint64 `protobuf:"varint,3,opt,name=num_batches" json:"num_batches,omitempty"`
+	// / The size of the log's database file in bytes.
+	DiskUsage int64 `protobuf:"varint,4,opt,name=disk_usage" json:"disk_usage,omitempty"`
+}
+
+func (m *ReplayLogInfoResponse) Reset()                    { *m = ReplayLogInfoResponse{} }
+func (m *ReplayLogInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplayLogInfoResponse) ProtoMessage()               {}
+func (*ReplayLogInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *ReplayLogInfoResponse) GetNumEntries() uint64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func (m *ReplayLogInfoResponse) GetOldestCltv() uint32 {
+	if m != nil {
+		return m.OldestCltv
+	}
+	return 0
+}
+
+func (m *ReplayLogInfoResponse) GetNumBatches() uint64 {
+	if m != nil {
+		return m.NumBatches
+	}
+	return 0
+}
+
+func (m *ReplayLogInfoResponse) GetDiskUsage() int64 {
+	if m != nil {
+		return m.DiskUsage
+	}
+	return 0
+}
+
+type CompactReplayLogRequest struct {
+}
+
+func (m *CompactReplayLogRequest) Reset()                    { *m = CompactReplayLogRequest{} }
+func (m *CompactReplayLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactReplayLogRequest) ProtoMessage()               {}
+func (*CompactReplayLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+type CompactReplayLogResponse struct {
+	// / The size of the log's database file in bytes before compaction.
+	SizeBefore int64 `protobuf:"varint,1,opt,name=size_before" json:"size_before,omitempty"`
+	// / The size of the log's database file in bytes after compaction.
+	SizeAfter int64 `protobuf:"varint,2,opt,name=size_after" json:"size_after,omitempty"`
+}
+
+func (m *CompactReplayLogResponse) Reset()                    { *m = CompactReplayLogResponse{} }
+func (m *CompactReplayLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactReplayLogResponse) ProtoMessage()               {}
+func (*CompactReplayLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *CompactReplayLogResponse) GetSizeBefore() int64 {
+	if m != nil {
+		return m.SizeBefore
+	}
+	return 0
+}
+
+func (m *CompactReplayLogResponse) GetSizeAfter() int64 {
+	if m != nil {
+		return m.SizeAfter
+	}
+	return 0
+}
+
+type KeyLocator struct {
+	// / The family of key being identified.
+	KeyFamily int32 `protobuf:"varint,1,opt,name=key_family" json:"key_family,omitempty"`
+	// / The precise index of the key being identified.
+	KeyIndex int32 `protobuf:"varint,2,opt,name=key_index" json:"key_index,omitempty"`
+}
+
+func (m *KeyLocator) Reset()                    { *m = KeyLocator{} }
+func (m *KeyLocator) String() string            { return proto.CompactTextString(m) }
+func (*KeyLocator) ProtoMessage()               {}
+func (*KeyLocator) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+func (m *KeyLocator) GetKeyFamily() int32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+func (m *KeyLocator) GetKeyIndex() int32 {
+	if m != nil {
+		return m.KeyIndex
+	}
+	return 0
+}
+
+type KeyDescriptor struct {
+	// *
+	// The raw bytes of the key being identified. Either this or the KeyLocator
+	// must be specified.
+	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,proto3" json:"raw_key_bytes,omitempty"`
+	// *
+	// The key locator that identifies which key to use for signing. Either this
+	// or the raw bytes of the target key must be specified.
+	KeyLoc *KeyLocator `protobuf:"bytes,2,opt,name=key_loc" json:"key_loc,omitempty"`
+}
+
+func (m *KeyDescriptor) Reset()                    { *m = KeyDescriptor{} }
+func (m *KeyDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*KeyDescriptor) ProtoMessage()               {}
+func (*KeyDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{161} }
+
+func (m *KeyDescriptor) GetRawKeyBytes() []byte {
+	if m != nil {
+		return m.RawKeyBytes
+	}
+	return nil
+}
+
+func (m *KeyDescriptor) GetKeyLoc() *KeyLocator {
+	if m != nil {
+		return m.KeyLoc
+	}
+	return nil
+}
+
+type KeyReq struct {
+	// / The target key family to derive a key from.
+	KeyFamily int32 `protobuf:"varint,1,opt,name=key_family" json:"key_family,omitempty"`
+}
+
+func (m *KeyReq) Reset()                    { *m = KeyReq{} }
+func (m *KeyReq) String() string            { return proto.CompactTextString(m) }
+func (*KeyReq) ProtoMessage()               {}
+func (*KeyReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{162} }
+
+func (m *KeyReq) GetKeyFamily() int32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+type TxOut struct {
+	// / The value of the output being spent.
+	Value int64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+	// / The script of the output being spent.
+	PkScript []byte `protobuf:"bytes,2,opt,name=pk_script,proto3" json:"pk_script,omitempty"`
+}
+
+func (m *TxOut) Reset()                    { *m = TxOut{} }
+func (m *TxOut) String() string            { return proto.CompactTextString(m) }
+func (*TxOut) ProtoMessage()               {}
+func (*TxOut) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+func (m *TxOut) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TxOut) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
+type WalletKeyPath struct {
+	// / The BIP43 purpose of the key scope the key belongs to.
+	Purpose uint32 `protobuf:"varint,1,opt,name=purpose" json:"purpose,omitempty"`
+	// / The coin type of the key scope the key belongs to.
+	CoinType uint32 `protobuf:"varint,2,opt,name=coin_type" json:"coin_type,omitempty"`
+	// / The account of the key.
+	Account uint32 `protobuf:"varint,3,opt,name=account" json:"account,omitempty"`
+	// / The branch of the key, 0 for external and 1 for internal addresses.
+	Branch uint32 `protobuf:"varint,4,opt,name=branch" json:"branch,omitempty"`
+	// / The index of the key within its branch.
+	Index uint32 `protobuf:"varint,5,opt,name=index" json:"index,omitempty"`
+}
+
+func (m *WalletKeyPath) Reset()                    { *m = WalletKeyPath{} }
+func (m *WalletKeyPath) String() string            { return proto.CompactTextString(m) }
+func (*WalletKeyPath) ProtoMessage()               {}
+func (*WalletKeyPath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+func (m *WalletKeyPath) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetCoinType() uint32 {
+	if m != nil {
+		return m.CoinType
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetBranch() uint32 {
+	if m != nil {
+		return m.Branch
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type SignDescriptor struct {
+	// *
+	// A descriptor that precisely describes *which* key to use for signing. This
+	// may provide the raw public key directly, or require the Signer to re-derive
+	// the key according to the populated derivation path.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,1,opt,name=key_desc" json:"key_desc,omitempty"`
+	// *
+	// A scalar value that will be added to the private key corresponding to the
+	// above public key to obtain the private key to be used to sign this input.
+	// This value is typically derived via the following computation:
+	//
+	// derivedKey = privkey + sha256(perCommitmentPoint || pubKey) mod N
+	SingleTweak []byte `protobuf:"bytes,2,opt,name=single_tweak,proto3" json:"single_tweak,omitempty"`
+	// *
+	// A private key that will be used in combination with its corresponding
+	// private key to derive the private key that is to be used to sign the target
+	// input. Within the Lightning protocol, this value is typically the
+	// commitment secret from a previously revoked commitment transaction. This
+	// value is in combination with two hash values, and the original private key
+	// to derive the private key to be used when signing.
+	//
+	// k = (privKey*sha256(pubKey || tweakPub) +
+	// tweakPriv*sha256(tweakPub || pubKey)) mod N
+	DoubleTweak []byte `protobuf:"bytes,3,opt,name=double_tweak,proto3" json:"double_tweak,omitempty"`
+	// *
+	// The full script required to properly redeem the output.  This field will
+	// only be populated if a p2wsh or a p2sh output is being signed.
+	WitnessScript []byte `protobuf:"bytes,4,opt,name=witness_script,proto3" json:"witness_script,omitempty"`
+	// *
+	// A description of the output being spent. The value and script MUST be
+	// provided.
+	Output *TxOut `protobuf:"bytes,5,opt,name=output" json:"output,omitempty"`
+	// *
+	// The target sighash type that should be used when generating the final
+	// sighash, and signature.
+	Sighash uint32 `protobuf:"varint,7,opt,name=sighash" json:"sighash,omitempty"`
+	// / The target input within the transaction that should be signed.
+	InputIndex int32 `protobuf:"varint,8,opt,name=input_index" json:"input_index,omitempty"`
+	// *
+	// The derivation path of the wallet key that controls the output being
+	// spent. When set, ComputeInputScript derives the key from this path, rather
+	// than looking up the output script among the addresses the signer knows
+	// of. This allows signing for addresses that were derived by a watch-only
+	// copy of the wallet.
+	WalletKeyPath *WalletKeyPath `protobuf:"bytes,9,opt,name=wallet_key_path" json:"wallet_key_path,omitempty"`
+}
+
+func (m *SignDescriptor) Reset()                    { *m = SignDescriptor{} }
+func (m *SignDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*SignDescriptor) ProtoMessage()               {}
+func (*SignDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+func (m *SignDescriptor) GetKeyDesc() *KeyDescriptor {
+	if m != nil {
+		return m.KeyDesc
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetSingleTweak() []byte {
+	if m != nil {
+		return m.SingleTweak
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetDoubleTweak() []byte {
+	if m != nil {
+		return m.DoubleTweak
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetWitnessScript() []byte {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetOutput() *TxOut {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetSighash() uint32 {
+	if m != nil {
+		return m.Sighash
+	}
+	return 0
+}
+
+func (m *SignDescriptor) GetInputIndex() int32 {
+	if m != nil {
+		return m.InputIndex
+	}
+	return 0
+}
+
+func (m *SignDescriptor) GetWalletKeyPath() *WalletKeyPath {
+	if m != nil {
+		return m.WalletKeyPath
+	}
+	return nil
+}
+
+type SignReq struct {
+	// / The raw bytes of the transaction to be signed.
+	RawTxBytes []byte `protobuf:"bytes,1,opt,name=raw_tx_bytes,proto3" json:"raw_tx_bytes,omitempty"`
+	// / A set of sign descriptors, for each input to be signed.
+	SignDescs []*SignDescriptor `protobuf:"bytes,2,rep,name=sign_descs" json:"sign_descs,omitempty"`
+}
+
+func (m *SignReq) Reset()                    { *m = SignReq{} }
+func (m *SignReq) String() string            { return proto.CompactTextString(m) }
+func (*SignReq) ProtoMessage()               {}
+func (*SignReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{166} }
+
+func (m *SignReq) GetRawTxBytes() []byte {
+	if m != nil {
+		return m.RawTxBytes
+	}
+	return nil
+}
+
+func (m *SignReq) GetSignDescs() []*SignDescriptor {
+	if m != nil {
+		return m.SignDescs
+	}
+	return nil
+}
+
+type SignResp struct {
+	// *
+	// A set of signatures realized in a fixed 64-byte format ordered in
+	// ascending input order.
+	RawSigs [][]byte `protobuf:"bytes,1,rep,name=raw_sigs,proto3" json:"raw_sigs,omitempty"`
+}
+
+func (m *SignResp) Reset()                    { *m = SignResp{} }
+func (m *SignResp) String() string            { return proto.CompactTextString(m) }
+func (*SignResp) ProtoMessage()               {}
+func (*SignResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+func (m *SignResp) GetRawSigs() [][]byte {
+	if m != nil {
+		return m.RawSigs
+	}
+	return nil
+}
+
+type InputScript struct {
+	// / The serializes witness stack for the specified input.
+	Witness [][]byte `protobuf:"bytes,1,rep,name=witness,proto3" json:"witness,omitempty"`
+	// *
+	// The optional sig script for the specified witness that will only be set if
+	// the input specified is a nested p2sh witness program.
+	SigScript []byte `protobuf:"bytes,2,opt,name=sig_script,proto3" json:"sig_script,omitempty"`
+}
+
+func (m *InputScript) Reset()                    { *m = InputScript{} }
+func (m *InputScript) String() string            { return proto.CompactTextString(m) }
+func (*InputScript) ProtoMessage()               {}
+func (*InputScript) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{168} }
+
+func (m *InputScript) GetWitness() [][]byte {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+func (m *InputScript) GetSigScript() []byte {
+	if m != nil {
+		return m.SigScript
+	}
+	return nil
+}
+
+type InputScriptResp struct {
+	// / The set of fully valid input scripts requested.
+	InputScripts []*InputScript `protobuf:"bytes,1,rep,name=input_scripts" json:"input_scripts,omitempty"`
+}
+
+func (m *InputScriptResp) Reset()                    { *m = InputScriptResp{} }
+func (m *InputScriptResp) String() string            { return proto.CompactTextString(m) }
+func (*InputScriptResp) ProtoMessage()               {}
+func (*InputScriptResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+func (m *InputScriptResp) GetInputScripts() []*InputScript {
+	if m != nil {
+		return m.InputScripts
+	}
+	return nil
+}
+
+type SignMessageReq struct {
+	// / The message to be signed.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// *
+	// The serialized public key of the key to sign the message with. Either this
+	// or the key locator must be specified.
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// *
+	// The key locator that identifies which key to sign the message with. Either
+	// this or the public key must be specified.
+	KeyLoc *KeyLocator `protobuf:"bytes,3,opt,name=key_loc" json:"key_loc,omitempty"`
+}
+
+func (m *SignMessageReq) Reset()                    { *m = SignMessageReq{} }
+func (m *SignMessageReq) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageReq) ProtoMessage()               {}
+func (*SignMessageReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *SignMessageReq) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+func (m *SignMessageReq) GetPubKey() []byte {
 	if m != nil {
-		return m.IndexOffset
+		return m.PubKey
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+func (m *SignMessageReq) GetKeyLoc() *KeyLocator {
 	if m != nil {
-		return m.NumMaxEvents
+		return m.KeyLoc
 	}
-	return 0
+	return nil
 }
 
-type ForwardingEvent struct {
-	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	// / The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
-	// / The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
-	// / The total amount of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
-	// / The total amount of the outgoign HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
-	// / The total fee that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+type SignMessageResp struct {
+	// / The DER encoded signature over the double-sha256 of the message.
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (m *SignMessageResp) Reset()                    { *m = SignMessageResp{} }
+func (m *SignMessageResp) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageResp) ProtoMessage()               {}
+func (*SignMessageResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
+func (m *SignMessageResp) GetSignature() []byte {
 	if m != nil {
-		return m.Timestamp
+		return m.Signature
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
-	if m != nil {
-		return m.ChanIdIn
-	}
-	return 0
+type DerivePrivKeyResp struct {
+	// / The raw bytes of the derived private key.
+	RawPrivKey []byte `protobuf:"bytes,1,opt,name=raw_priv_key,proto3" json:"raw_priv_key,omitempty"`
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
+func (m *DerivePrivKeyResp) Reset()                    { *m = DerivePrivKeyResp{} }
+func (m *DerivePrivKeyResp) String() string            { return proto.CompactTextString(m) }
+func (*DerivePrivKeyResp) ProtoMessage()               {}
+func (*DerivePrivKeyResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+func (m *DerivePrivKeyResp) GetRawPrivKey() []byte {
 	if m != nil {
-		return m.ChanIdOut
+		return m.RawPrivKey
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
-	if m != nil {
-		return m.AmtIn
-	}
-	return 0
+type SharedKeyRequest struct {
+	// / The ephemeral public key in the raw, compressed format.
+	EphemeralPubkey []byte `protobuf:"bytes,1,opt,name=ephemeral_pubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	// *
+	// The key descriptor of the local key to perform the ECDH operation with. If
+	// not specified, the node identity key is used.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,2,opt,name=key_desc" json:"key_desc,omitempty"`
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+func (m *SharedKeyRequest) Reset()                    { *m = SharedKeyRequest{} }
+func (m *SharedKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SharedKeyRequest) ProtoMessage()               {}
+func (*SharedKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+func (m *SharedKeyRequest) GetEphemeralPubkey() []byte {
 	if m != nil {
-		return m.AmtOut
+		return m.EphemeralPubkey
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetFee() uint64 {
+func (m *SharedKeyRequest) GetKeyDesc() *KeyDescriptor {
 	if m != nil {
-		return m.Fee
+		return m.KeyDesc
 	}
-	return 0
+	return nil
 }
 
-type ForwardingHistoryResponse struct {
-	// / A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
-	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+type SharedKeyResponse struct {
+	// / The shared public key, hashed with sha256.
+	SharedKey []byte `protobuf:"bytes,1,opt,name=shared_key,proto3" json:"shared_key,omitempty"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+func (m *SharedKeyResponse) Reset()                    { *m = SharedKeyResponse{} }
+func (m *SharedKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*SharedKeyResponse) ProtoMessage()               {}
+func (*SharedKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
 
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+func (m *SharedKeyResponse) GetSharedKey() []byte {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.SharedKey
 	}
 	return nil
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
-	if m != nil {
-		return m.LastOffsetIndex
-	}
-	return 0
-}
-
 func init() {
 	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
 	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
@@ -4947,6 +8444,8 @@ func init() {
 	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
 	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
 	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
+	proto.RegisterType((*LabelTransactionRequest)(nil), "lnrpc.LabelTransactionRequest")
+	proto.RegisterType((*LabelTransactionResponse)(nil), "lnrpc.LabelTransactionResponse")
 	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
 	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
 	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
@@ -4969,22 +8468,34 @@ func init() {
 	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
 	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
 	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ChannelCommitStats)(nil), "lnrpc.ChannelCommitStats")
 	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
 	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
+	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "lnrpc.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "lnrpc.GetRecoveryInfoResponse")
+	proto.RegisterType((*RescanRequest)(nil), "lnrpc.RescanRequest")
+	proto.RegisterType((*RescanResponse)(nil), "lnrpc.RescanResponse")
+	proto.RegisterType((*FeeEstimatorHealthRequest)(nil), "lnrpc.FeeEstimatorHealthRequest")
+	proto.RegisterType((*FeeSourceHealth)(nil), "lnrpc.FeeSourceHealth")
+	proto.RegisterType((*FeeEstimatorHealthResponse)(nil), "lnrpc.FeeEstimatorHealthResponse")
 	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
 	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
 	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
 	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
 	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
 	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
@@ -4995,6 +8506,9 @@ func init() {
 	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
 	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
 	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_PendingReservation)(nil), "lnrpc.PendingChannelsResponse.PendingReservation")
+	proto.RegisterType((*CancelPendingChannelRequest)(nil), "lnrpc.CancelPendingChannelRequest")
+	proto.RegisterType((*CancelPendingChannelResponse)(nil), "lnrpc.CancelPendingChannelResponse")
 	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
 	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
@@ -5045,11 +8559,73 @@ func init() {
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*UpdateChanStatusRequest)(nil), "lnrpc.UpdateChanStatusRequest")
+	proto.RegisterType((*UpdateChanStatusResponse)(nil), "lnrpc.UpdateChanStatusResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*ForwardingStatsRequest)(nil), "lnrpc.ForwardingStatsRequest")
+	proto.RegisterType((*ForwardingStatsGroup)(nil), "lnrpc.ForwardingStatsGroup")
+	proto.RegisterType((*ForwardingStatsBucket)(nil), "lnrpc.ForwardingStatsBucket")
+	proto.RegisterType((*ForwardingStatsResponse)(nil), "lnrpc.ForwardingStatsResponse")
+	proto.RegisterType((*CircuitKey)(nil), "lnrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "lnrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "lnrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "lnrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "lnrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "lnrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "lnrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "lnrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "lnrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "lnrpc.LinkFailEvent")
+	proto.RegisterType((*ForwardLimits)(nil), "lnrpc.ForwardLimits")
+	proto.RegisterType((*LimitUsage)(nil), "lnrpc.LimitUsage")
+	proto.RegisterType((*ChannelLimitUsage)(nil), "lnrpc.ChannelLimitUsage")
+	proto.RegisterType((*PeerLimitUsage)(nil), "lnrpc.PeerLimitUsage")
+	proto.RegisterType((*ForwardingLimitsRequest)(nil), "lnrpc.ForwardingLimitsRequest")
+	proto.RegisterType((*ForwardingLimitsResponse)(nil), "lnrpc.ForwardingLimitsResponse")
+	proto.RegisterType((*UpdateForwardingLimitsRequest)(nil), "lnrpc.UpdateForwardingLimitsRequest")
+	proto.RegisterType((*UpdateForwardingLimitsResponse)(nil), "lnrpc.UpdateForwardingLimitsResponse")
+	proto.RegisterType((*ListCircuitsRequest)(nil), "lnrpc.ListCircuitsRequest")
+	proto.RegisterType((*PaymentCircuit)(nil), "lnrpc.PaymentCircuit")
+	proto.RegisterType((*ListCircuitsResponse)(nil), "lnrpc.ListCircuitsResponse")
+	proto.RegisterType((*ListForwardingPackagesRequest)(nil), "lnrpc.ListForwardingPackagesRequest")
+	proto.RegisterType((*ForwardingPackage)(nil), "lnrpc.ForwardingPackage")
+	proto.RegisterType((*ChannelForwardingPackages)(nil), "lnrpc.ChannelForwardingPackages")
+	proto.RegisterType((*ListForwardingPackagesResponse)(nil), "lnrpc.ListForwardingPackagesResponse")
+	proto.RegisterType((*FailStuckHtlcRequest)(nil), "lnrpc.FailStuckHtlcRequest")
+	proto.RegisterType((*FailStuckHtlcResponse)(nil), "lnrpc.FailStuckHtlcResponse")
+	proto.RegisterType((*ReplayLogInfoRequest)(nil), "lnrpc.ReplayLogInfoRequest")
+	proto.RegisterType((*ReplayLogInfoResponse)(nil), "lnrpc.ReplayLogInfoResponse")
+	proto.RegisterType((*CompactReplayLogRequest)(nil), "lnrpc.CompactReplayLogRequest")
+	proto.RegisterType((*CompactReplayLogResponse)(nil), "lnrpc.CompactReplayLogResponse")
+	proto.RegisterType((*KeyLocator)(nil), "lnrpc.KeyLocator")
+	proto.RegisterType((*KeyDescriptor)(nil), "lnrpc.KeyDescriptor")
+	proto.RegisterType((*KeyReq)(nil), "lnrpc.KeyReq")
+	proto.RegisterType((*TxOut)(nil), "lnrpc.TxOut")
+	proto.RegisterType((*WalletKeyPath)(nil), "lnrpc.WalletKeyPath")
+	proto.RegisterType((*SignDescriptor)(nil), "lnrpc.SignDescriptor")
+	proto.RegisterType((*SignReq)(nil), "lnrpc.SignReq")
+	proto.RegisterType((*SignResp)(nil), "lnrpc.SignResp")
+	proto.RegisterType((*InputScript)(nil), "lnrpc.InputScript")
+	proto.RegisterType((*InputScriptResp)(nil), "lnrpc.InputScriptResp")
+	proto.RegisterType((*SignMessageReq)(nil), "lnrpc.SignMessageReq")
+	proto.RegisterType((*SignMessageResp)(nil), "lnrpc.SignMessageResp")
+	proto.RegisterType((*DerivePrivKeyResp)(nil), "lnrpc.DerivePrivKeyResp")
+	proto.RegisterType((*SharedKeyRequest)(nil), "lnrpc.SharedKeyRequest")
+	proto.RegisterType((*SharedKeyResponse)(nil), "lnrpc.SharedKeyResponse")
+	proto.RegisterEnum("lnrpc.TransactionCategory", TransactionCategory_name, TransactionCategory_value)
 	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
+	proto.RegisterEnum("lnrpc.UpdateChanStatusRequest_ChanStatusAction", UpdateChanStatusRequest_ChanStatusAction_name, UpdateChanStatusRequest_ChanStatusAction_value)
+	proto.RegisterEnum("lnrpc.ForwardingStatsRequest_GroupBy", ForwardingStatsRequest_GroupBy_name, ForwardingStatsRequest_GroupBy_value)
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_ResolveAction", ForwardHtlcInterceptResponse_ResolveAction_name, ForwardHtlcInterceptResponse_ResolveAction_value)
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_FailureCode", ForwardHtlcInterceptResponse_FailureCode_name, ForwardHtlcInterceptResponse_FailureCode_value)
+	proto.RegisterEnum("lnrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
+	proto.RegisterEnum("lnrpc.LinkFailEvent_FailureDetail", LinkFailEvent_FailureDetail_name, LinkFailEvent_FailureDetail_value)
+	proto.RegisterEnum("lnrpc.ForwardLimits_LimitMode", ForwardLimits_LimitMode_name, ForwardLimits_LimitMode_value)
+	proto.RegisterEnum("lnrpc.ForwardingPackage_State", ForwardingPackage_State_name, ForwardingPackage_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5293,8 +8869,13 @@ type LightningClient interface {
 	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
 	// * lncli: `listchaintxns`
 	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
+	// relevant to the wallet. The list can be filtered by category, block height
+	// range and label, and paginated using an index offset.
 	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
+	// * lncli: `labeltx`
+	// LabelTransaction sets the label of an on-chain transaction. Any previously
+	// set label is replaced, while the category of the transaction is kept.
+	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
 	// * lncli: `sendcoins`
 	// SendCoins executes a request to send coins to a particular address. Unlike
 	// SendMany, this RPC call only allows creating a single output at a time. If
@@ -5305,7 +8886,7 @@ type LightningClient interface {
 	// *
 	// SubscribeTransactions creates a uni-directional stream from the server to
 	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
+	// wallet are sent over. The filters of the request are ignored.
 	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
 	// * lncli: `sendmany`
 	// SendMany handles a request for a transaction that creates multiple specified
@@ -5345,12 +8926,39 @@ type LightningClient interface {
 	// it's identity pubkey, alias, the chains it is connected to, and information
 	// concerning the number of open+pending channels.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns information concerning the progress of the wallet
+	// in recovering its funds, either after being restored from a seed with a
+	// non-zero recovery window, or after a rescan was requested.
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	// * lncli: `rescan`
+	// Rescan replays the chain starting at the target height, looking for
+	// transactions that pay to or spend from the wallet, without recreating the
+	// wallet. The rescan is carried out in the background, its progress and outcome
+	// can be followed through GetRecoveryInfo. While it is in progress, the wallet
+	// is rewound to the target height: it reports itself as not synced to the chain,
+	// and refuses to select coins to send or fund channels with.
+	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error)
+	// * lncli: `feeestimatorhealth`
+	// FeeEstimatorHealth returns the health of the sources used to estimate
+	// on-chain fees, along with the bounds applied to their estimates and the
+	// number of times the estimator had to fall back to a default fee rate.
+	FeeEstimatorHealth(ctx context.Context, in *FeeEstimatorHealthRequest, opts ...grpc.CallOption) (*FeeEstimatorHealthResponse, error)
 	// * lncli: `pendingchannels`
 	// PendingChannels returns a list of all the channels that are currently
 	// considered "pending". A channel is pending if it has finished the funding
 	// workflow and is waiting for confirmations for the funding txn, or is in the
 	// process of closure, either initiated cooperatively or non-cooperatively.
 	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
+	// * lncli: `cancelpendingchannel`
+	// CancelPendingChannel cancels the funding flow of a pending channel. A
+	// reservation whose funding transaction hasn't been published yet,
+	// identified by its pending channel ID, is torn down and its coins are
+	// unlocked. For a pending channel we initiated whose funding transaction was
+	// already published, identified by its channel point, the wallet's funding
+	// inputs are double spent back to the wallet at a higher fee. The channel is
+	// forgotten once that transaction confirmed.
+	CancelPendingChannel(ctx context.Context, in *CancelPendingChannelRequest, opts ...grpc.CallOption) (*CancelPendingChannelResponse, error)
 	// * lncli: `listchannels`
 	// ListChannels returns a description of all the open channels that this node
 	// is a participant in.
@@ -5360,6 +8968,13 @@ type LightningClient interface {
 	// this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
 	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new channels pending open, channels becoming
+	// open, active or inactive, closing transactions being detected on-chain,
+	// and closed channels being fully resolved.
+	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
+	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -5372,6 +8987,14 @@ type LightningClient interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which the
+	// parameters of each inbound channel open request are sent to the client,
+	// which responds with whether the channel should be accepted. If the channel
+	// is rejected, the optional error message of the response is sent to the
+	// peer. If the client doesn't respond in time, the channel is rejected. If
+	// several clients are connected, all of them must accept the channel.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -5507,6 +9130,14 @@ type LightningClient interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `updatechanstatus`
+	// UpdateChanStatus manually sets the status of a channel. A disabled channel
+	// is announced to the network as disabled, and no new HTLCs are forwarded
+	// over it, while HTLCs already in flight are still resolved. An enabled
+	// channel is announced as enabled, even while the remote peer is offline.
+	// AUTO hands the channel back to automatic management. The status is kept
+	// across restarts.
+	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -5519,6 +9150,73 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// HTLC the switch is asked to forward is held and sent to the client, which
+	// responds with whether the HTLC should be resumed, settled with a known
+	// preimage, or failed. Held HTLCs that the client doesn't resolve in time are
+	// failed back. HTLCs stay held across restarts and client reconnections, and
+	// are resent to the client once it connects. Only a single client can be
+	// connected at a time.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error)
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client in which events are sent as htlcs are forwarded, settled and
+	// failed by the node. Unlike ForwardingHistory, failed forwards, htlcs
+	// rejected by our own links and failed payments are included, along with
+	// the reason they were failed.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error)
+	// *
+	// ForwardingLimits returns the limits on the forwards a single incoming
+	// channel or peer may have in flight through the node, and on the rate at
+	// which a peer may offer new forwards. The forwards in flight from each
+	// incoming channel and peer are returned as well, along with running totals
+	// of how their forwards were handled by the limiter.
+	ForwardingLimits(ctx context.Context, in *ForwardingLimitsRequest, opts ...grpc.CallOption) (*ForwardingLimitsResponse, error)
+	// *
+	// UpdateForwardingLimits replaces the limits on the forwards a single
+	// incoming channel or peer may have in flight through the node. The new
+	// limits apply to all forwards from now on, while forwards already in flight
+	// are unaffected.
+	UpdateForwardingLimits(ctx context.Context, in *UpdateForwardingLimitsRequest, opts ...grpc.CallOption) (*UpdateForwardingLimitsResponse, error)
+	// * lncli: `fwdingstats`
+	// ForwardingStats returns the fees earned, the volume and the number of
+	// forwards of the node within the target time range, grouped by incoming
+	// channel, outgoing channel or peer, and split into time buckets. The stats
+	// are read from an hourly index of the forwarding log, so the time range is
+	// extended to whole hours. If no time range is specified, then the stats of
+	// the past 24 hrs are returned.
+	ForwardingStats(ctx context.Context, in *ForwardingStatsRequest, opts ...grpc.CallOption) (*ForwardingStatsResponse, error)
+	// * lncli: `listcircuits`
+	// ListCircuits returns the payment circuits currently held by the switch.
+	// Circuits that have been assigned a keystone are open, and wait for their
+	// outgoing HTLC to be settled or failed. Circuits without a keystone are
+	// half-added, as their HTLC hasn't been locked into an outgoing channel yet.
+	ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*ListCircuitsResponse, error)
+	// * lncli: `listfwdpkgs`
+	// ListForwardingPackages returns the forwarding packages of each channel
+	// that haven't been fully acked yet. A forwarding package holds the HTLCs
+	// locked in by the remote peer at a particular commitment height, and is
+	// removed once all of them have been processed by the switch.
+	ListForwardingPackages(ctx context.Context, in *ListForwardingPackagesRequest, opts ...grpc.CallOption) (*ListForwardingPackagesResponse, error)
+	// * lncli: `failstuckhtlc`
+	// FailStuckHtlc fails back an incoming HTLC whose circuit is stuck after its
+	// outgoing channel has been closed. This is only allowed once the outgoing
+	// channel is fully resolved on-chain, and no longer has an active link, so
+	// the outgoing HTLC can't be settled anymore. It's refused if the preimage of
+	// the HTLC is known, as the remote party may have swept the outgoing HTLC
+	// with it. The HTLC is failed back with a permanent channel failure.
+	FailStuckHtlc(ctx context.Context, in *FailStuckHtlcRequest, opts ...grpc.CallOption) (*FailStuckHtlcResponse, error)
+	// * lncli: `replayloginfo`
+	// ReplayLogInfo returns the number of entries in the sphinx replay log, which
+	// records the onion packets processed by the node to reject replays, along
+	// with the lowest CLTV among them and the size of the log on disk.
+	ReplayLogInfo(ctx context.Context, in *ReplayLogInfoRequest, opts ...grpc.CallOption) (*ReplayLogInfoResponse, error)
+	// * lncli: `compactreplaylog`
+	// CompactReplayLog rewrites the database of the sphinx replay log to reclaim
+	// the disk space freed by expired entries. Onion packets can't be processed
+	// while the log is being compacted.
+	CompactReplayLog(ctx context.Context, in *CompactReplayLogRequest, opts ...grpc.CallOption) (*CompactReplayLogResponse, error)
 }
 
 type lightningClient struct {
@@ -5556,6 +9254,15 @@ func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactio
 	return out, nil
 }
 
+func (c *lightningClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
+	out := new(LabelTransactionResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LabelTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
 	out := new(SendCoinsResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, c.cc, opts...)
@@ -5669,6 +9376,33 @@ func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetRecoveryInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error) {
+	out := new(RescanResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/Rescan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FeeEstimatorHealth(ctx context.Context, in *FeeEstimatorHealthRequest, opts ...grpc.CallOption) (*FeeEstimatorHealthResponse, error) {
+	out := new(FeeEstimatorHealthResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FeeEstimatorHealth", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
 	out := new(PendingChannelsResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, c.cc, opts...)
@@ -5678,6 +9412,15 @@ func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChanne
 	return out, nil
 }
 
+func (c *lightningClient) CancelPendingChannel(ctx context.Context, in *CancelPendingChannelRequest, opts ...grpc.CallOption) (*CancelPendingChannelResponse, error) {
+	out := new(CancelPendingChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/CancelPendingChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
 	out := new(ListChannelsResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, c.cc, opts...)
@@ -5696,6 +9439,38 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelEventsClient interface {
+	Recv() (*ChannelEventUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, error) {
+	m := new(ChannelEventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
@@ -5706,7 +9481,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5737,8 +9512,39 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 	return m, nil
 }
 
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningChannelAcceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningChannelAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5779,7 +9585,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5819,7 +9625,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5886,7 +9692,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[7], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5999,7 +9805,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[8], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6057,6 +9863,15 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error) {
+	out := new(UpdateChanStatusResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChanStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, c.cc, opts...)
@@ -6066,6 +9881,141 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[9], c.cc, "/lnrpc.Lightning/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[10], c.cc, "/lnrpc.Lightning/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) ForwardingLimits(ctx context.Context, in *ForwardingLimitsRequest, opts ...grpc.CallOption) (*ForwardingLimitsResponse, error) {
+	out := new(ForwardingLimitsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingLimits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateForwardingLimits(ctx context.Context, in *UpdateForwardingLimitsRequest, opts ...grpc.CallOption) (*UpdateForwardingLimitsResponse, error) {
+	out := new(UpdateForwardingLimitsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateForwardingLimits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ForwardingStats(ctx context.Context, in *ForwardingStatsRequest, opts ...grpc.CallOption) (*ForwardingStatsResponse, error) {
+	out := new(ForwardingStatsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*ListCircuitsResponse, error) {
+	out := new(ListCircuitsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListCircuits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListForwardingPackages(ctx context.Context, in *ListForwardingPackagesRequest, opts ...grpc.CallOption) (*ListForwardingPackagesResponse, error) {
+	out := new(ListForwardingPackagesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListForwardingPackages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FailStuckHtlc(ctx context.Context, in *FailStuckHtlcRequest, opts ...grpc.CallOption) (*FailStuckHtlcResponse, error) {
+	out := new(FailStuckHtlcResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FailStuckHtlc", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ReplayLogInfo(ctx context.Context, in *ReplayLogInfoRequest, opts ...grpc.CallOption) (*ReplayLogInfoResponse, error) {
+	out := new(ReplayLogInfoResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ReplayLogInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CompactReplayLog(ctx context.Context, in *CompactReplayLogRequest, opts ...grpc.CallOption) (*CompactReplayLogResponse, error) {
+	out := new(CompactReplayLogResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/CompactReplayLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Lightning service
 
 type LightningServer interface {
@@ -6080,8 +10030,13 @@ type LightningServer interface {
 	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
 	// * lncli: `listchaintxns`
 	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
+	// relevant to the wallet. The list can be filtered by category, block height
+	// range and label, and paginated using an index offset.
 	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
+	// * lncli: `labeltx`
+	// LabelTransaction sets the label of an on-chain transaction. Any previously
+	// set label is replaced, while the category of the transaction is kept.
+	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
 	// * lncli: `sendcoins`
 	// SendCoins executes a request to send coins to a particular address. Unlike
 	// SendMany, this RPC call only allows creating a single output at a time. If
@@ -6092,7 +10047,7 @@ type LightningServer interface {
 	// *
 	// SubscribeTransactions creates a uni-directional stream from the server to
 	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
+	// wallet are sent over. The filters of the request are ignored.
 	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
 	// * lncli: `sendmany`
 	// SendMany handles a request for a transaction that creates multiple specified
@@ -6132,12 +10087,39 @@ type LightningServer interface {
 	// it's identity pubkey, alias, the chains it is connected to, and information
 	// concerning the number of open+pending channels.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns information concerning the progress of the wallet
+	// in recovering its funds, either after being restored from a seed with a
+	// non-zero recovery window, or after a rescan was requested.
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	// * lncli: `rescan`
+	// Rescan replays the chain starting at the target height, looking for
+	// transactions that pay to or spend from the wallet, without recreating the
+	// wallet. The rescan is carried out in the background, its progress and outcome
+	// can be followed through GetRecoveryInfo. While it is in progress, the wallet
+	// is rewound to the target height: it reports itself as not synced to the chain,
+	// and refuses to select coins to send or fund channels with.
+	Rescan(context.Context, *RescanRequest) (*RescanResponse, error)
+	// * lncli: `feeestimatorhealth`
+	// FeeEstimatorHealth returns the health of the sources used to estimate
+	// on-chain fees, along with the bounds applied to their estimates and the
+	// number of times the estimator had to fall back to a default fee rate.
+	FeeEstimatorHealth(context.Context, *FeeEstimatorHealthRequest) (*FeeEstimatorHealthResponse, error)
 	// * lncli: `pendingchannels`
 	// PendingChannels returns a list of all the channels that are currently
 	// considered "pending". A channel is pending if it has finished the funding
 	// workflow and is waiting for confirmations for the funding txn, or is in the
 	// process of closure, either initiated cooperatively or non-cooperatively.
 	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
+	// * lncli: `cancelpendingchannel`
+	// CancelPendingChannel cancels the funding flow of a pending channel. A
+	// reservation whose funding transaction hasn't been published yet,
+	// identified by its pending channel ID, is torn down and its coins are
+	// unlocked. For a pending channel we initiated whose funding transaction was
+	// already published, identified by its channel point, the wallet's funding
+	// inputs are double spent back to the wallet at a higher fee. The channel is
+	// forgotten once that transaction confirmed.
+	CancelPendingChannel(context.Context, *CancelPendingChannelRequest) (*CancelPendingChannelResponse, error)
 	// * lncli: `listchannels`
 	// ListChannels returns a description of all the open channels that this node
 	// is a participant in.
@@ -6147,6 +10129,13 @@ type LightningServer interface {
 	// this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
 	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new channels pending open, channels becoming
+	// open, active or inactive, closing transactions being detected on-chain,
+	// and closed channels being fully resolved.
+	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
+	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -6159,6 +10148,14 @@ type LightningServer interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which the
+	// parameters of each inbound channel open request are sent to the client,
+	// which responds with whether the channel should be accepted. If the channel
+	// is rejected, the optional error message of the response is sent to the
+	// peer. If the client doesn't respond in time, the channel is rejected. If
+	// several clients are connected, all of them must accept the channel.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -6294,6 +10291,14 @@ type LightningServer interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `updatechanstatus`
+	// UpdateChanStatus manually sets the status of a channel. A disabled channel
+	// is announced to the network as disabled, and no new HTLCs are forwarded
+	// over it, while HTLCs already in flight are still resolved. An enabled
+	// channel is announced as enabled, even while the remote peer is offline.
+	// AUTO hands the channel back to automatic management. The status is kept
+	// across restarts.
+	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -6306,6 +10311,73 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// HTLC the switch is asked to forward is held and sent to the client, which
+	// responds with whether the HTLC should be resumed, settled with a known
+	// preimage, or failed. Held HTLCs that the client doesn't resolve in time are
+	// failed back. HTLCs stay held across restarts and client reconnections, and
+	// are resent to the client once it connects. Only a single client can be
+	// connected at a time.
+	HtlcInterceptor(Lightning_HtlcInterceptorServer) error
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client in which events are sent as htlcs are forwarded, settled and
+	// failed by the node. Unlike ForwardingHistory, failed forwards, htlcs
+	// rejected by our own links and failed payments are included, along with
+	// the reason they were failed.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Lightning_SubscribeHtlcEventsServer) error
+	// *
+	// ForwardingLimits returns the limits on the forwards a single incoming
+	// channel or peer may have in flight through the node, and on the rate at
+	// which a peer may offer new forwards. The forwards in flight from each
+	// incoming channel and peer are returned as well, along with running totals
+	// of how their forwards were handled by the limiter.
+	ForwardingLimits(context.Context, *ForwardingLimitsRequest) (*ForwardingLimitsResponse, error)
+	// *
+	// UpdateForwardingLimits replaces the limits on the forwards a single
+	// incoming channel or peer may have in flight through the node. The new
+	// limits apply to all forwards from now on, while forwards already in flight
+	// are unaffected.
+	UpdateForwardingLimits(context.Context, *UpdateForwardingLimitsRequest) (*UpdateForwardingLimitsResponse, error)
+	// * lncli: `fwdingstats`
+	// ForwardingStats returns the fees earned, the volume and the number of
+	// forwards of the node within the target time range, grouped by incoming
+	// channel, outgoing channel or peer, and split into time buckets. The stats
+	// are read from an hourly index of the forwarding log, so the time range is
+	// extended to whole hours. If no time range is specified, then the stats of
+	// the past 24 hrs are returned.
+	ForwardingStats(context.Context, *ForwardingStatsRequest) (*ForwardingStatsResponse, error)
+	// * lncli: `listcircuits`
+	// ListCircuits returns the payment circuits currently held by the switch.
+	// Circuits that have been assigned a keystone are open, and wait for their
+	// outgoing HTLC to be settled or failed. Circuits without a keystone are
+	// half-added, as their HTLC hasn't been locked into an outgoing channel yet.
+	ListCircuits(context.Context, *ListCircuitsRequest) (*ListCircuitsResponse, error)
+	// * lncli: `listfwdpkgs`
+	// ListForwardingPackages returns the forwarding packages of each channel
+	// that haven't been fully acked yet. A forwarding package holds the HTLCs
+	// locked in by the remote peer at a particular commitment height, and is
+	// removed once all of them have been processed by the switch.
+	ListForwardingPackages(context.Context, *ListForwardingPackagesRequest) (*ListForwardingPackagesResponse, error)
+	// * lncli: `failstuckhtlc`
+	// FailStuckHtlc fails back an incoming HTLC whose circuit is stuck after its
+	// outgoing channel has been closed. This is only allowed once the outgoing
+	// channel is fully resolved on-chain, and no longer has an active link, so
+	// the outgoing HTLC can't be settled anymore. It's refused if the preimage of
+	// the HTLC is known, as the remote party may have swept the outgoing HTLC
+	// with it. The HTLC is failed back with a permanent channel failure.
+	FailStuckHtlc(context.Context, *FailStuckHtlcRequest) (*FailStuckHtlcResponse, error)
+	// * lncli: `replayloginfo`
+	// ReplayLogInfo returns the number of entries in the sphinx replay log, which
+	// records the onion packets processed by the node to reject replays, along
+	// with the lowest CLTV among them and the size of the log on disk.
+	ReplayLogInfo(context.Context, *ReplayLogInfoRequest) (*ReplayLogInfoResponse, error)
+	// * lncli: `compactreplaylog`
+	// CompactReplayLog rewrites the database of the sphinx replay log to reclaim
+	// the disk space freed by expired entries. Onion packets can't be processed
+	// while the log is being compacted.
+	CompactReplayLog(context.Context, *CompactReplayLogRequest) (*CompactReplayLogResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -6366,6 +10438,24 @@ func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LabelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LabelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LabelTransaction(ctx, req.(*LabelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendCoinsRequest)
 	if err := dec(in); err != nil {
@@ -6549,6 +10639,60 @@ func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_Rescan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).Rescan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/Rescan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).Rescan(ctx, req.(*RescanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FeeEstimatorHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeEstimatorHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FeeEstimatorHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FeeEstimatorHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FeeEstimatorHealth(ctx, req.(*FeeEstimatorHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PendingChannelsRequest)
 	if err := dec(in); err != nil {
@@ -6567,6 +10711,24 @@ func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_CancelPendingChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPendingChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CancelPendingChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CancelPendingChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CancelPendingChannel(ctx, req.(*CancelPendingChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChannelsRequest)
 	if err := dec(in); err != nil {
@@ -6603,6 +10765,27 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelEvents(m, &lightningSubscribeChannelEventsServer{stream})
+}
+
+type Lightning_SubscribeChannelEventsServer interface {
+	Send(*ChannelEventUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -6642,6 +10825,32 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CloseChannelRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -7027,74 +11236,283 @@ func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) erro
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FeeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateChanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateChanStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateChanStatus(ctx, req.(*UpdateChanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ForwardingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).HtlcInterceptor(&lightningHtlcInterceptorServer{stream})
+}
+
+type Lightning_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeHtlcEvents(m, &lightningSubscribeHtlcEventsServer{stream})
+}
+
+type Lightning_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_ForwardingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ForwardingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ForwardingLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ForwardingLimits(ctx, req.(*ForwardingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateForwardingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateForwardingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateForwardingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateForwardingLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateForwardingLimits(ctx, req.(*UpdateForwardingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ForwardingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ForwardingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ForwardingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ForwardingStats(ctx, req.(*ForwardingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCircuitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListCircuits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListCircuits(ctx, req.(*ListCircuitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListForwardingPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForwardingPackagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DebugLevel(ctx, in)
+		return srv.(LightningServer).ListForwardingPackages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DebugLevel",
+		FullMethod: "/lnrpc.Lightning/ListForwardingPackages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(LightningServer).ListForwardingPackages(ctx, req.(*ListForwardingPackagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeeReportRequest)
+func _Lightning_FailStuckHtlc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailStuckHtlcRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).FeeReport(ctx, in)
+		return srv.(LightningServer).FailStuckHtlc(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/FeeReport",
+		FullMethod: "/lnrpc.Lightning/FailStuckHtlc",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
+		return srv.(LightningServer).FailStuckHtlc(ctx, req.(*FailStuckHtlcRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyUpdateRequest)
+func _Lightning_ReplayLogInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayLogInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
+		return srv.(LightningServer).ReplayLogInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+		FullMethod: "/lnrpc.Lightning/ReplayLogInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+		return srv.(LightningServer).ReplayLogInfo(ctx, req.(*ReplayLogInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForwardingHistoryRequest)
+func _Lightning_CompactReplayLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactReplayLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ForwardingHistory(ctx, in)
+		return srv.(LightningServer).CompactReplayLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
+		FullMethod: "/lnrpc.Lightning/CompactReplayLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+		return srv.(LightningServer).CompactReplayLog(ctx, req.(*CompactReplayLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7115,6 +11533,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactions",
 			Handler:    _Lightning_GetTransactions_Handler,
 		},
+		{
+			MethodName: "LabelTransaction",
+			Handler:    _Lightning_LabelTransaction_Handler,
+		},
 		{
 			MethodName: "SendCoins",
 			Handler:    _Lightning_SendCoins_Handler,
@@ -7151,10 +11573,26 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetRecoveryInfo",
+			Handler:    _Lightning_GetRecoveryInfo_Handler,
+		},
+		{
+			MethodName: "Rescan",
+			Handler:    _Lightning_Rescan_Handler,
+		},
+		{
+			MethodName: "FeeEstimatorHealth",
+			Handler:    _Lightning_FeeEstimatorHealth_Handler,
+		},
 		{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
 		},
+		{
+			MethodName: "CancelPendingChannel",
+			Handler:    _Lightning_CancelPendingChannel_Handler,
+		},
 		{
 			MethodName: "ListChannels",
 			Handler:    _Lightning_ListChannels_Handler,
@@ -7239,10 +11677,46 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "UpdateChanStatus",
+			Handler:    _Lightning_UpdateChanStatus_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "ForwardingLimits",
+			Handler:    _Lightning_ForwardingLimits_Handler,
+		},
+		{
+			MethodName: "UpdateForwardingLimits",
+			Handler:    _Lightning_UpdateForwardingLimits_Handler,
+		},
+		{
+			MethodName: "ForwardingStats",
+			Handler:    _Lightning_ForwardingStats_Handler,
+		},
+		{
+			MethodName: "ListCircuits",
+			Handler:    _Lightning_ListCircuits_Handler,
+		},
+		{
+			MethodName: "ListForwardingPackages",
+			Handler:    _Lightning_ListForwardingPackages_Handler,
+		},
+		{
+			MethodName: "FailStuckHtlc",
+			Handler:    _Lightning_FailStuckHtlc_Handler,
+		},
+		{
+			MethodName: "ReplayLogInfo",
+			Handler:    _Lightning_ReplayLogInfo_Handler,
+		},
+		{
+			MethodName: "CompactReplayLog",
+			Handler:    _Lightning_CompactReplayLog_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7250,11 +11724,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeChannelEvents",
+			Handler:       _Lightning_SubscribeChannelEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ChannelAcceptor",
+			Handler:       _Lightning_ChannelAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "CloseChannel",
 			Handler:       _Lightning_CloseChannel_Handler,
@@ -7282,416 +11767,1038 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Lightning_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Lightning_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
+
+// Client API for Signer service
+
+type SignerClient interface {
+	// *
+	// SignOutputRaw is a method that can be used to generate a signature for a
+	// set of inputs/outputs to a transaction. Each request specifies details
+	// concerning how the outputs should be signed, which keys they should be
+	// signed with, and also any optional tweaks. The resulting signatures will be
+	// void of a sighash byte.
+	SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete InputIndex for the passed
+	// transaction with the signature as defined within the passed SignDescriptor.
+	// This method should be capable of generating the proper input script for
+	// both regular p2wkh output and p2wkh outputs nested within a regular p2sh
+	// output.
+	//
+	// Note that when using this method to sign inputs belonging to the wallet,
+	// the only items of the SignDescriptor that need to be populated are pkScript
+	// in the TxOut field, the value in that same field, the input index, and the
+	// wallet key path if the signer may not have derived the address yet.
+	ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error)
+	// *
+	// SignMessageWithKey signs the double-sha256 digest of the passed message
+	// with the private key that corresponds to either the passed public key, or
+	// the passed key locator.
+	SignMessageWithKey(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error)
+	// *
+	// DeriveNextKey attempts to derive the *next* key within the key family
+	// (account in BIP43) specified.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// DeriveKey attempts to derive an arbitrary key specified by the passed
+	// KeyLocator.
+	DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// DerivePrivKey returns the private key that corresponds to the passed key
+	// descriptor. Only the node identity key family may be exported, as the
+	// remote node needs it to process onion packets. All other keys never leave
+	// the signer.
+	DerivePrivKey(ctx context.Context, in *KeyDescriptor, opts ...grpc.CallOption) (*DerivePrivKeyResp, error)
+	// *
+	// DeriveSharedKey performs an ECDH operation between the key described by
+	// the passed key descriptor and the passed public key. If no key descriptor
+	// is specified, then the node identity key is used. The resulting shared key
+	// is the sha256 of the resulting shared point serialized in compressed
+	// format.
+	DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error) {
+	out := new(SignResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/SignOutputRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error) {
+	out := new(InputScriptResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/ComputeInputScript", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignMessageWithKey(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error) {
+	out := new(SignMessageResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/SignMessageWithKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/DeriveNextKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/DeriveKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DerivePrivKey(ctx context.Context, in *KeyDescriptor, opts ...grpc.CallOption) (*DerivePrivKeyResp, error) {
+	out := new(DerivePrivKeyResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/DerivePrivKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error) {
+	out := new(SharedKeyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/DeriveSharedKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Signer service
+
+type SignerServer interface {
+	// *
+	// SignOutputRaw is a method that can be used to generate a signature for a
+	// set of inputs/outputs to a transaction. Each request specifies details
+	// concerning how the outputs should be signed, which keys they should be
+	// signed with, and also any optional tweaks. The resulting signatures will be
+	// void of a sighash byte.
+	SignOutputRaw(context.Context, *SignReq) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete InputIndex for the passed
+	// transaction with the signature as defined within the passed SignDescriptor.
+	// This method should be capable of generating the proper input script for
+	// both regular p2wkh output and p2wkh outputs nested within a regular p2sh
+	// output.
+	//
+	// Note that when using this method to sign inputs belonging to the wallet,
+	// the only items of the SignDescriptor that need to be populated are pkScript
+	// in the TxOut field, the value in that same field, the input index, and the
+	// wallet key path if the signer may not have derived the address yet.
+	ComputeInputScript(context.Context, *SignReq) (*InputScriptResp, error)
+	// *
+	// SignMessageWithKey signs the double-sha256 digest of the passed message
+	// with the private key that corresponds to either the passed public key, or
+	// the passed key locator.
+	SignMessageWithKey(context.Context, *SignMessageReq) (*SignMessageResp, error)
+	// *
+	// DeriveNextKey attempts to derive the *next* key within the key family
+	// (account in BIP43) specified.
+	DeriveNextKey(context.Context, *KeyReq) (*KeyDescriptor, error)
+	// *
+	// DeriveKey attempts to derive an arbitrary key specified by the passed
+	// KeyLocator.
+	DeriveKey(context.Context, *KeyLocator) (*KeyDescriptor, error)
+	// *
+	// DerivePrivKey returns the private key that corresponds to the passed key
+	// descriptor. Only the node identity key family may be exported, as the
+	// remote node needs it to process onion packets. All other keys never leave
+	// the signer.
+	DerivePrivKey(context.Context, *KeyDescriptor) (*DerivePrivKeyResp, error)
+	// *
+	// DeriveSharedKey performs an ECDH operation between the key described by
+	// the passed key descriptor and the passed public key. If no key descriptor
+	// is specified, then the node identity key is used. The resulting shared key
+	// is the sha256 of the resulting shared point serialized in compressed
+	// format.
+	DeriveSharedKey(context.Context, *SharedKeyRequest) (*SharedKeyResponse, error)
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_SignOutputRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignOutputRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/SignOutputRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignOutputRaw(ctx, req.(*SignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_ComputeInputScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).ComputeInputScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/ComputeInputScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).ComputeInputScript(ctx, req.(*SignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignMessageWithKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignMessageWithKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/SignMessageWithKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignMessageWithKey(ctx, req.(*SignMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeriveNextKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/DeriveNextKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeriveNextKey(ctx, req.(*KeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeriveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyLocator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeriveKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/DeriveKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeriveKey(ctx, req.(*KeyLocator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DerivePrivKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyDescriptor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DerivePrivKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/DerivePrivKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DerivePrivKey(ctx, req.(*KeyDescriptor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeriveSharedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeriveSharedKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Signer/DeriveSharedKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeriveSharedKey(ctx, req.(*SharedKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignOutputRaw",
+			Handler:    _Signer_SignOutputRaw_Handler,
+		},
+		{
+			MethodName: "ComputeInputScript",
+			Handler:    _Signer_ComputeInputScript_Handler,
+		},
+		{
+			MethodName: "SignMessageWithKey",
+			Handler:    _Signer_SignMessageWithKey_Handler,
+		},
+		{
+			MethodName: "DeriveNextKey",
+			Handler:    _Signer_DeriveNextKey_Handler,
+		},
+		{
+			MethodName: "DeriveKey",
+			Handler:    _Signer_DeriveKey_Handler,
+		},
+		{
+			MethodName: "DerivePrivKey",
+			Handler:    _Signer_DerivePrivKey_Handler,
+		},
+		{
+			MethodName: "DeriveSharedKey",
+			Handler:    _Signer_DeriveSharedKey_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6c, 0x1c, 0xd9,
-	0x75, 0xb6, 0xaa, 0xd9, 0x4d, 0x76, 0x9f, 0x6e, 0x92, 0xcd, 0xcb, 0x87, 0x5a, 0x3d, 0x1a, 0x8d,
-	0xa6, 0x3c, 0x18, 0xc9, 0xfc, 0xe7, 0x97, 0x34, 0xb4, 0x3d, 0x18, 0xcf, 0xfc, 0xbf, 0xfd, 0x53,
-	0x24, 0x25, 0xca, 0xe6, 0x48, 0x74, 0x51, 0xe3, 0xf9, 0x6d, 0x27, 0x68, 0x17, 0xbb, 0x2f, 0x9b,
-	0x65, 0x75, 0x57, 0xb5, 0xab, 0xaa, 0xc9, 0x69, 0x4f, 0x06, 0xc8, 0x0b, 0x09, 0x10, 0xc4, 0x30,
-	0x82, 0x04, 0x08, 0x1c, 0x20, 0x08, 0xe0, 0x64, 0x61, 0x2f, 0xb3, 0x88, 0x37, 0x49, 0x76, 0xd9,
-	0x24, 0x40, 0x90, 0x85, 0x57, 0x81, 0x81, 0x6c, 0x92, 0x4d, 0x92, 0x5d, 0x80, 0x2c, 0x13, 0x04,
-	0xe7, 0xdc, 0x47, 0xdd, 0x5b, 0x55, 0x14, 0xe5, 0x47, 0xb2, 0xeb, 0xfb, 0xdd, 0x53, 0xf7, 0x79,
-	0x5e, 0xf7, 0xdc, 0x73, 0x1b, 0x1a, 0xf1, 0xa4, 0x7f, 0x67, 0x12, 0x47, 0x69, 0xc4, 0x6a, 0xa3,
-	0x30, 0x9e, 0xf4, 0xbb, 0xd7, 0x87, 0x51, 0x34, 0x1c, 0xf1, 0xbb, 0xfe, 0x24, 0xb8, 0xeb, 0x87,
-	0x61, 0x94, 0xfa, 0x69, 0x10, 0x85, 0x89, 0x20, 0x72, 0xbf, 0x0e, 0x4b, 0x0f, 0x79, 0x78, 0xc4,
-	0xf9, 0xc0, 0xe3, 0xdf, 0x9c, 0xf2, 0x24, 0x65, 0xff, 0x0b, 0x56, 0x7c, 0xfe, 0x2d, 0xce, 0x07,
-	0xbd, 0x89, 0x9f, 0x24, 0x93, 0xd3, 0xd8, 0x4f, 0x78, 0xc7, 0xb9, 0xe9, 0xdc, 0x6e, 0x79, 0x6d,
-	0x51, 0x71, 0xa8, 0x71, 0xf6, 0x2a, 0xb4, 0x12, 0x24, 0xe5, 0x61, 0x1a, 0x47, 0x93, 0x59, 0xa7,
-	0x42, 0x74, 0x4d, 0xc4, 0xf6, 0x04, 0xe4, 0x8e, 0x60, 0x59, 0xf7, 0x90, 0x4c, 0xa2, 0x30, 0xe1,
-	0xec, 0x1e, 0xac, 0xf5, 0x83, 0xc9, 0x29, 0x8f, 0x7b, 0xf4, 0xf1, 0x38, 0xe4, 0xe3, 0x28, 0x0c,
-	0xfa, 0x1d, 0xe7, 0xe6, 0xdc, 0xed, 0x86, 0xc7, 0x44, 0x1d, 0x7e, 0xf1, 0x9e, 0xac, 0x61, 0xb7,
-	0x60, 0x99, 0x87, 0x02, 0xe7, 0x03, 0xfa, 0x4a, 0x76, 0xb5, 0x94, 0xc1, 0xf8, 0x81, 0xfb, 0x57,
-	0x0e, 0xac, 0x3c, 0x0a, 0x83, 0xf4, 0x03, 0x7f, 0x34, 0xe2, 0xa9, 0x9a, 0xd3, 0x2d, 0x58, 0x3e,
-	0x27, 0x80, 0xe6, 0x74, 0x1e, 0xc5, 0x03, 0x39, 0xa3, 0x25, 0x01, 0x1f, 0x4a, 0xf4, 0xc2, 0x91,
-	0x55, 0x2e, 0x1c, 0x59, 0xe9, 0x72, 0xcd, 0x5d, 0xb0, 0x5c, 0xb7, 0x60, 0x39, 0xe6, 0xfd, 0xe8,
-	0x8c, 0xc7, 0xb3, 0xde, 0x79, 0x10, 0x0e, 0xa2, 0xf3, 0x4e, 0xf5, 0xa6, 0x73, 0xbb, 0xe6, 0x2d,
-	0x29, 0xf8, 0x03, 0x42, 0xdd, 0x35, 0x60, 0xe6, 0x2c, 0xc4, 0xba, 0xb9, 0x43, 0x58, 0x7d, 0x3f,
-	0x1c, 0x45, 0xfd, 0x67, 0x3f, 0xe5, 0xec, 0x4a, 0xba, 0xaf, 0x94, 0x76, 0xbf, 0x01, 0x6b, 0x76,
-	0x47, 0x72, 0x00, 0x1c, 0xd6, 0x77, 0x4e, 0xfd, 0x70, 0xc8, 0x55, 0x93, 0x6a, 0x08, 0x9f, 0x84,
-	0x76, 0x7f, 0x1a, 0xc7, 0x3c, 0x2c, 0x8c, 0x61, 0x59, 0xe2, 0x7a, 0x10, 0xaf, 0x42, 0x2b, 0xe4,
-	0xe7, 0x19, 0x99, 0x64, 0x99, 0x90, 0x9f, 0x2b, 0x12, 0xb7, 0x03, 0x1b, 0xf9, 0x6e, 0xe4, 0x00,
-	0xbe, 0x5b, 0x81, 0xe6, 0xd3, 0xd8, 0x0f, 0x13, 0xbf, 0x8f, 0x5c, 0xcc, 0x3a, 0xb0, 0x90, 0x7e,
-	0xd8, 0x3b, 0xf5, 0x93, 0x53, 0xea, 0xae, 0xe1, 0xa9, 0x22, 0xdb, 0x80, 0x79, 0x7f, 0x1c, 0x4d,
-	0xc3, 0x94, 0x3a, 0x98, 0xf3, 0x64, 0x89, 0xbd, 0x01, 0x2b, 0xe1, 0x74, 0xdc, 0xeb, 0x47, 0xe1,
-	0x49, 0x10, 0x8f, 0x85, 0x2c, 0xd0, 0x7e, 0xd5, 0xbc, 0x62, 0x05, 0xbb, 0x01, 0x70, 0x8c, 0xeb,
-	0x20, 0xba, 0xa8, 0x52, 0x17, 0x06, 0xc2, 0x5c, 0x68, 0xc9, 0x12, 0x0f, 0x86, 0xa7, 0x69, 0xa7,
-	0x46, 0x0d, 0x59, 0x18, 0xb6, 0x91, 0x06, 0x63, 0xde, 0x4b, 0x52, 0x7f, 0x3c, 0xe9, 0xcc, 0xd3,
-	0x68, 0x0c, 0x84, 0xea, 0xa3, 0xd4, 0x1f, 0xf5, 0x4e, 0x38, 0x4f, 0x3a, 0x0b, 0xb2, 0x5e, 0x23,
-	0xec, 0x75, 0x58, 0x1a, 0xf0, 0x24, 0xed, 0xf9, 0x83, 0x41, 0xcc, 0x93, 0x84, 0x27, 0x9d, 0x3a,
-	0x71, 0x63, 0x0e, 0xc5, 0x55, 0x7b, 0xc8, 0x53, 0x63, 0x75, 0x12, 0xb9, 0x3b, 0xee, 0x01, 0x30,
-	0x03, 0xde, 0xe5, 0xa9, 0x1f, 0x8c, 0x12, 0xf6, 0x16, 0xb4, 0x52, 0x83, 0x98, 0xa4, 0xaf, 0xb9,
-	0xc5, 0xee, 0x90, 0xda, 0xb8, 0x63, 0x7c, 0xe0, 0x59, 0x74, 0xee, 0x43, 0xa8, 0x3f, 0xe0, 0xfc,
-	0x20, 0x18, 0x07, 0x29, 0xdb, 0x80, 0xda, 0x49, 0xf0, 0x21, 0x17, 0x9b, 0x3d, 0xb7, 0x7f, 0xc5,
-	0x13, 0x45, 0xd6, 0x85, 0x85, 0x09, 0x8f, 0xfb, 0x5c, 0x2d, 0xff, 0xfe, 0x15, 0x4f, 0x01, 0xf7,
-	0x17, 0xa0, 0x36, 0xc2, 0x8f, 0xdd, 0xef, 0x57, 0xa0, 0x79, 0xc4, 0x43, 0xcd, 0x44, 0x0c, 0xaa,
-	0x38, 0x25, 0xc9, 0x38, 0xf4, 0x9b, 0xbd, 0x02, 0x4d, 0x9a, 0x66, 0x92, 0xc6, 0x41, 0x38, 0xa4,
-	0xc6, 0x1a, 0x1e, 0x20, 0x74, 0x44, 0x08, 0x6b, 0xc3, 0x9c, 0x3f, 0x4e, 0x69, 0x07, 0xe7, 0x3c,
-	0xfc, 0x89, 0x0c, 0x36, 0xf1, 0x67, 0x63, 0xe4, 0x45, 0xbd, 0x6b, 0x2d, 0xaf, 0x29, 0xb1, 0x7d,
-	0xdc, 0xb6, 0x3b, 0xb0, 0x6a, 0x92, 0xa8, 0xd6, 0x6b, 0xd4, 0xfa, 0x8a, 0x41, 0x29, 0x3b, 0xb9,
-	0x05, 0xcb, 0x8a, 0x3e, 0x16, 0x83, 0xa5, 0x7d, 0x6c, 0x78, 0x4b, 0x12, 0x56, 0x53, 0xb8, 0x0d,
-	0xed, 0x93, 0x20, 0xf4, 0x47, 0xbd, 0xfe, 0x28, 0x3d, 0xeb, 0x0d, 0xf8, 0x28, 0xf5, 0x69, 0x47,
-	0x6b, 0xde, 0x12, 0xe1, 0x3b, 0xa3, 0xf4, 0x6c, 0x17, 0x51, 0xf6, 0x06, 0x34, 0x4e, 0x38, 0xef,
-	0xd1, 0x4a, 0x74, 0xea, 0x37, 0x9d, 0xdb, 0xcd, 0xad, 0x65, 0xb9, 0xf4, 0x6a, 0x75, 0xbd, 0xfa,
-	0x89, 0xfc, 0xe5, 0xfe, 0x9e, 0x03, 0x2d, 0xb1, 0x54, 0x52, 0x85, 0xbe, 0x06, 0x8b, 0x6a, 0x44,
-	0x3c, 0x8e, 0xa3, 0x58, 0xb2, 0xbf, 0x0d, 0xb2, 0x4d, 0x68, 0x2b, 0x60, 0x12, 0xf3, 0x60, 0xec,
-	0x0f, 0xb9, 0x94, 0xb7, 0x02, 0xce, 0xb6, 0xb2, 0x16, 0xe3, 0x68, 0x9a, 0x0a, 0x25, 0xd6, 0xdc,
-	0x6a, 0xc9, 0x41, 0x79, 0x88, 0x79, 0x36, 0x89, 0xfb, 0x6d, 0x07, 0x18, 0x0e, 0xeb, 0x69, 0x24,
-	0xaa, 0xe5, 0x2a, 0xe4, 0x77, 0xc0, 0x79, 0xe1, 0x1d, 0xa8, 0x5c, 0xb4, 0x03, 0xaf, 0xc1, 0x3c,
-	0x75, 0x89, 0xb2, 0x3a, 0x57, 0x18, 0x96, 0xac, 0x73, 0xbf, 0xe7, 0x40, 0x0b, 0x35, 0x47, 0xc8,
-	0x47, 0x87, 0x51, 0x10, 0xa6, 0xec, 0x1e, 0xb0, 0x93, 0x69, 0x38, 0x08, 0xc2, 0x61, 0x2f, 0xfd,
-	0x30, 0x18, 0xf4, 0x8e, 0x67, 0xd8, 0x04, 0x8d, 0x67, 0xff, 0x8a, 0x57, 0x52, 0xc7, 0xde, 0x80,
-	0xb6, 0x85, 0x26, 0x69, 0x2c, 0x46, 0xb5, 0x7f, 0xc5, 0x2b, 0xd4, 0xa0, 0xfc, 0x47, 0xd3, 0x74,
-	0x32, 0x4d, 0x7b, 0x41, 0x38, 0xe0, 0x1f, 0xd2, 0x9a, 0x2d, 0x7a, 0x16, 0x76, 0x7f, 0x09, 0x5a,
-	0xe6, 0x77, 0xee, 0xe7, 0xa0, 0x7d, 0x80, 0x8a, 0x21, 0x0c, 0xc2, 0xe1, 0xb6, 0x90, 0x5e, 0xd4,
-	0x56, 0x93, 0xe9, 0xf1, 0x33, 0x3e, 0x93, 0xfb, 0x28, 0x4b, 0x28, 0x12, 0xa7, 0x51, 0x92, 0xca,
-	0x75, 0xa1, 0xdf, 0xee, 0x3f, 0x3a, 0xb0, 0x8c, 0x8b, 0xfe, 0x9e, 0x1f, 0xce, 0xd4, 0x8a, 0x1f,
-	0x40, 0x0b, 0x9b, 0x7a, 0x1a, 0x6d, 0x0b, 0x9d, 0x27, 0x64, 0xf9, 0xb6, 0x5c, 0xa4, 0x1c, 0xf5,
-	0x1d, 0x93, 0x14, 0xcd, 0xf4, 0xcc, 0xb3, 0xbe, 0x46, 0xa1, 0x4b, 0xfd, 0x78, 0xc8, 0x53, 0xd2,
-	0x86, 0x52, 0x3b, 0x82, 0x80, 0x76, 0xa2, 0xf0, 0x84, 0xdd, 0x84, 0x56, 0xe2, 0xa7, 0xbd, 0x09,
-	0x8f, 0x69, 0xd5, 0x48, 0x70, 0xe6, 0x3c, 0x48, 0xfc, 0xf4, 0x90, 0xc7, 0xf7, 0x67, 0x29, 0xef,
-	0x7e, 0x1e, 0x56, 0x0a, 0xbd, 0xa0, 0xac, 0x66, 0x53, 0xc4, 0x9f, 0x6c, 0x0d, 0x6a, 0x67, 0xfe,
-	0x68, 0xca, 0xa5, 0x92, 0x16, 0x85, 0x77, 0x2a, 0x6f, 0x3b, 0xee, 0xeb, 0xd0, 0xce, 0x86, 0x2d,
-	0x99, 0x9e, 0x41, 0x15, 0x57, 0x50, 0x36, 0x40, 0xbf, 0xdd, 0x5f, 0x71, 0x04, 0xe1, 0x4e, 0x14,
-	0x68, 0x85, 0x87, 0x84, 0xa8, 0x17, 0x15, 0x21, 0xfe, 0xbe, 0xd0, 0x20, 0xfc, 0xec, 0x93, 0x75,
-	0x6f, 0xc1, 0x8a, 0x31, 0x84, 0xe7, 0x0c, 0xf6, 0xdb, 0x0e, 0xac, 0x3c, 0xe6, 0xe7, 0x72, 0xd7,
-	0xd5, 0x68, 0xdf, 0x86, 0x6a, 0x3a, 0x9b, 0x08, 0x27, 0x6b, 0x69, 0xeb, 0x35, 0xb9, 0x69, 0x05,
-	0xba, 0x3b, 0xb2, 0xf8, 0x74, 0x36, 0xe1, 0x1e, 0x7d, 0xe1, 0x7e, 0x0e, 0x9a, 0x06, 0xc8, 0xae,
-	0xc2, 0xea, 0x07, 0x8f, 0x9e, 0x3e, 0xde, 0x3b, 0x3a, 0xea, 0x1d, 0xbe, 0x7f, 0xff, 0x8b, 0x7b,
-	0x5f, 0xe9, 0xed, 0x6f, 0x1f, 0xed, 0xb7, 0xaf, 0xb0, 0x0d, 0x60, 0x8f, 0xf7, 0x8e, 0x9e, 0xee,
-	0xed, 0x5a, 0xb8, 0xe3, 0xde, 0x01, 0x66, 0x76, 0x23, 0x47, 0xde, 0x81, 0x05, 0x69, 0x55, 0x94,
-	0x51, 0x95, 0x45, 0xf7, 0x75, 0x60, 0x47, 0xc1, 0x30, 0x7c, 0x8f, 0x27, 0x89, 0x3f, 0xd4, 0xe2,
-	0xde, 0x86, 0xb9, 0x71, 0x32, 0x94, 0x52, 0x8e, 0x3f, 0xdd, 0x4f, 0xc1, 0xaa, 0x45, 0x27, 0x1b,
-	0xbe, 0x0e, 0x8d, 0x24, 0x18, 0x86, 0x7e, 0x3a, 0x8d, 0xb9, 0x6c, 0x3a, 0x03, 0xdc, 0x07, 0xb0,
-	0xf6, 0x65, 0x1e, 0x07, 0x27, 0xb3, 0xcb, 0x9a, 0xb7, 0xdb, 0xa9, 0xe4, 0xdb, 0xd9, 0x83, 0xf5,
-	0x5c, 0x3b, 0xb2, 0x7b, 0xc1, 0x6c, 0x72, 0x4b, 0xea, 0x9e, 0x28, 0x18, 0xa2, 0x57, 0x31, 0x45,
-	0xcf, 0x7d, 0x1f, 0xd8, 0x4e, 0x14, 0x86, 0xbc, 0x9f, 0x1e, 0x72, 0x1e, 0x67, 0xde, 0x71, 0xc6,
-	0x59, 0xcd, 0xad, 0xab, 0x72, 0xaf, 0xf2, 0xf2, 0x2c, 0x59, 0x8e, 0x41, 0x75, 0xc2, 0xe3, 0x31,
-	0x35, 0x5c, 0xf7, 0xe8, 0xb7, 0xbb, 0x0e, 0xab, 0x56, 0xb3, 0xd2, 0xb1, 0x79, 0x13, 0xd6, 0x77,
-	0x83, 0xa4, 0x5f, 0xec, 0xb0, 0x03, 0x0b, 0x93, 0xe9, 0x71, 0x2f, 0x93, 0x1b, 0x55, 0x44, 0x7b,
-	0x9f, 0xff, 0x44, 0x36, 0xf6, 0x1b, 0x0e, 0x54, 0xf7, 0x9f, 0x1e, 0xec, 0xb0, 0x2e, 0xd4, 0x83,
-	0xb0, 0x1f, 0x8d, 0x51, 0xb5, 0x8a, 0x49, 0xeb, 0xf2, 0x85, 0xf2, 0x70, 0x1d, 0x1a, 0xa4, 0x91,
-	0xd1, 0x85, 0x91, 0x8e, 0x6c, 0x06, 0xa0, 0xfb, 0xc4, 0x3f, 0x9c, 0x04, 0x31, 0xf9, 0x47, 0xca,
-	0xeb, 0xa9, 0x92, 0xd6, 0x2b, 0x56, 0xb8, 0xff, 0x59, 0x85, 0x05, 0xa9, 0x8f, 0xa9, 0xbf, 0x7e,
-	0x1a, 0x9c, 0x71, 0x39, 0x12, 0x59, 0x42, 0x4b, 0x16, 0xf3, 0x71, 0x94, 0xf2, 0x9e, 0xb5, 0x0d,
-	0x36, 0x88, 0x54, 0x7d, 0xd1, 0x50, 0x6f, 0x82, 0x9a, 0x9d, 0x46, 0xd6, 0xf0, 0x6c, 0x10, 0x17,
-	0x0b, 0x81, 0x5e, 0x30, 0xa0, 0x31, 0x55, 0x3d, 0x55, 0xc4, 0x95, 0xe8, 0xfb, 0x13, 0xbf, 0x1f,
-	0xa4, 0x33, 0x29, 0xc0, 0xba, 0x8c, 0x6d, 0x8f, 0xa2, 0xbe, 0x3f, 0xea, 0x1d, 0xfb, 0x23, 0x3f,
-	0xec, 0x73, 0xe9, 0xa3, 0xd9, 0x20, 0xba, 0x61, 0x72, 0x48, 0x8a, 0x4c, 0xb8, 0x6a, 0x39, 0x14,
-	0xdd, 0xb9, 0x7e, 0x34, 0x1e, 0x07, 0x29, 0x7a, 0x6f, 0x64, 0xd9, 0xe7, 0x3c, 0x03, 0xa1, 0x99,
-	0x88, 0xd2, 0xb9, 0x58, 0xbd, 0x86, 0xe8, 0xcd, 0x02, 0xb1, 0x15, 0x74, 0x0f, 0x50, 0xe9, 0x3c,
-	0x3b, 0xef, 0x80, 0x68, 0x25, 0x43, 0x70, 0x1f, 0xa6, 0x61, 0xc2, 0xd3, 0x74, 0xc4, 0x07, 0x7a,
-	0x40, 0x4d, 0x22, 0x2b, 0x56, 0xb0, 0x7b, 0xb0, 0x2a, 0x1c, 0xca, 0xc4, 0x4f, 0xa3, 0xe4, 0x34,
-	0x48, 0x7a, 0x09, 0xba, 0x66, 0x2d, 0xa2, 0x2f, 0xab, 0x62, 0x6f, 0xc3, 0xd5, 0x1c, 0x1c, 0xf3,
-	0x3e, 0x0f, 0xce, 0xf8, 0xa0, 0xb3, 0x48, 0x5f, 0x5d, 0x54, 0xcd, 0x6e, 0x42, 0x13, 0xfd, 0xe8,
-	0xe9, 0x64, 0xe0, 0xa3, 0xad, 0x5d, 0xa2, 0x7d, 0x30, 0x21, 0xf6, 0x26, 0x2c, 0x4e, 0xb8, 0x30,
-	0x88, 0xa7, 0xe9, 0xa8, 0x9f, 0x74, 0x96, 0xc9, 0x5a, 0x35, 0xa5, 0x30, 0x21, 0xe7, 0x7a, 0x36,
-	0x05, 0x32, 0x65, 0x3f, 0x21, 0x87, 0xca, 0x9f, 0x75, 0xda, 0xc4, 0x6e, 0x19, 0x40, 0x32, 0x12,
-	0x07, 0x67, 0x7e, 0xca, 0x3b, 0x2b, 0xc4, 0x5b, 0xaa, 0xe8, 0xfe, 0x91, 0x03, 0xab, 0x07, 0x41,
-	0x92, 0x4a, 0x26, 0xd4, 0x2a, 0xf7, 0x15, 0x68, 0x0a, 0xf6, 0xeb, 0x45, 0xe1, 0x68, 0x26, 0x39,
-	0x12, 0x04, 0xf4, 0x24, 0x1c, 0xcd, 0xd8, 0x27, 0x60, 0x31, 0x08, 0x4d, 0x12, 0x21, 0xc3, 0x2d,
-	0x05, 0x12, 0xd1, 0x2b, 0xd0, 0x9c, 0x4c, 0x8f, 0x47, 0x41, 0x5f, 0x90, 0xcc, 0x89, 0x56, 0x04,
-	0x44, 0x04, 0xe8, 0x08, 0x89, 0x91, 0x08, 0x8a, 0x2a, 0x51, 0x34, 0x25, 0x86, 0x24, 0xee, 0x7d,
-	0x58, 0xb3, 0x07, 0x28, 0x95, 0xd5, 0x26, 0xd4, 0x25, 0x6f, 0x27, 0x9d, 0x26, 0xad, 0xcf, 0x92,
-	0x5c, 0x1f, 0x49, 0xea, 0xe9, 0x7a, 0xf7, 0x87, 0x55, 0x58, 0x95, 0xe8, 0xce, 0x28, 0x4a, 0xf8,
-	0xd1, 0x74, 0x3c, 0xf6, 0xe3, 0x12, 0xa1, 0x71, 0x2e, 0x11, 0x9a, 0x8a, 0x2d, 0x34, 0xc8, 0xca,
-	0xa7, 0x7e, 0x10, 0x0a, 0x2f, 0x4e, 0x48, 0x9c, 0x81, 0xb0, 0xdb, 0xb0, 0xdc, 0x1f, 0x45, 0x89,
-	0xf0, 0x6c, 0xcc, 0x23, 0x52, 0x1e, 0x2e, 0x0a, 0x79, 0xad, 0x4c, 0xc8, 0x4d, 0x21, 0x9d, 0xcf,
-	0x09, 0xa9, 0x0b, 0x2d, 0x6c, 0x94, 0x2b, 0x9d, 0xb3, 0x20, 0x3c, 0x2d, 0x13, 0xc3, 0xf1, 0xe4,
-	0x45, 0x42, 0xc8, 0xdf, 0x72, 0x99, 0x40, 0xe0, 0x09, 0x0c, 0x75, 0x9a, 0x41, 0xdd, 0x90, 0x02,
-	0x51, 0xac, 0x62, 0x0f, 0x00, 0x44, 0x5f, 0x64, 0xaa, 0x81, 0x4c, 0xf5, 0xeb, 0xf6, 0x8e, 0x98,
-	0x6b, 0x7f, 0x07, 0x0b, 0xd3, 0x98, 0x93, 0xb1, 0x36, 0xbe, 0x74, 0x7f, 0xcb, 0x81, 0xa6, 0x51,
-	0xc7, 0xd6, 0x61, 0x65, 0xe7, 0xc9, 0x93, 0xc3, 0x3d, 0x6f, 0xfb, 0xe9, 0xa3, 0x2f, 0xef, 0xf5,
-	0x76, 0x0e, 0x9e, 0x1c, 0xed, 0xb5, 0xaf, 0x20, 0x7c, 0xf0, 0x64, 0x67, 0xfb, 0xa0, 0xf7, 0xe0,
-	0x89, 0xb7, 0xa3, 0x60, 0x07, 0x0d, 0xb9, 0xb7, 0xf7, 0xde, 0x93, 0xa7, 0x7b, 0x16, 0x5e, 0x61,
-	0x6d, 0x68, 0xdd, 0xf7, 0xf6, 0xb6, 0x77, 0xf6, 0x25, 0x32, 0xc7, 0xd6, 0xa0, 0xfd, 0xe0, 0xfd,
-	0xc7, 0xbb, 0x8f, 0x1e, 0x3f, 0xec, 0xed, 0x6c, 0x3f, 0xde, 0xd9, 0x3b, 0xd8, 0xdb, 0x6d, 0x57,
-	0xd9, 0x22, 0x34, 0xb6, 0xef, 0x6f, 0x3f, 0xde, 0x7d, 0xf2, 0x78, 0x6f, 0xb7, 0x5d, 0x73, 0xff,
-	0xc1, 0x81, 0x75, 0x1a, 0xf5, 0x20, 0x2f, 0x20, 0x37, 0xa1, 0xd9, 0x8f, 0xa2, 0x09, 0x47, 0x7d,
-	0xae, 0x55, 0xb6, 0x09, 0x21, 0xf3, 0x0b, 0x05, 0x79, 0x12, 0xc5, 0x7d, 0x2e, 0xe5, 0x03, 0x08,
-	0x7a, 0x80, 0x08, 0x32, 0xbf, 0xdc, 0x5e, 0x41, 0x21, 0xc4, 0xa3, 0x29, 0x30, 0x41, 0xb2, 0x01,
-	0xf3, 0xc7, 0x31, 0xf7, 0xfb, 0xa7, 0x52, 0x32, 0x64, 0x89, 0x7d, 0x32, 0x73, 0xc2, 0xfb, 0xb8,
-	0xfa, 0x23, 0x3e, 0x20, 0x8e, 0xa9, 0x7b, 0xcb, 0x12, 0xdf, 0x91, 0x30, 0x6a, 0x06, 0xff, 0xd8,
-	0x0f, 0x07, 0x51, 0xc8, 0x07, 0xc4, 0x34, 0x75, 0x2f, 0x03, 0xdc, 0x43, 0xd8, 0xc8, 0xcf, 0x4f,
-	0xca, 0xd7, 0x5b, 0x86, 0x7c, 0x09, 0x6f, 0xb9, 0x7b, 0xf1, 0x6e, 0x1a, 0xb2, 0xf6, 0x2f, 0x0e,
-	0x54, 0xd1, 0xd8, 0x5e, 0x6c, 0x98, 0x4d, 0xff, 0x69, 0xce, 0xf2, 0x9f, 0x28, 0x9c, 0x80, 0xa7,
-	0x0c, 0xa1, 0x7e, 0x85, 0x89, 0x32, 0x90, 0xac, 0x3e, 0xe6, 0xfd, 0x33, 0x9a, 0xb1, 0xae, 0x47,
-	0x04, 0x05, 0x04, 0x5d, 0x51, 0xfa, 0x5a, 0x0a, 0x88, 0x2a, 0xab, 0x3a, 0xfa, 0x72, 0x21, 0xab,
-	0xa3, 0xef, 0x3a, 0xb0, 0x10, 0x84, 0xc7, 0xd1, 0x34, 0x1c, 0x90, 0x40, 0xd4, 0x3d, 0x55, 0xc4,
-	0xe5, 0x9b, 0x90, 0xa0, 0x06, 0x63, 0xc5, 0xfe, 0x19, 0xe0, 0x32, 0x3c, 0xaa, 0x24, 0xe4, 0x5c,
-	0xe8, 0x60, 0xc2, 0x5b, 0xb0, 0x62, 0x60, 0x72, 0x35, 0x5f, 0x85, 0xda, 0x04, 0x01, 0xb9, 0x94,
-	0x4a, 0x95, 0x93, 0x57, 0x22, 0x6a, 0xdc, 0x36, 0x2c, 0x3d, 0xe4, 0xe9, 0xa3, 0xf0, 0x24, 0x52,
-	0x2d, 0x7d, 0xa7, 0x0a, 0xcb, 0x1a, 0x92, 0x0d, 0xdd, 0x86, 0xe5, 0x60, 0xc0, 0xc3, 0x34, 0x48,
-	0x67, 0x3d, 0xeb, 0x44, 0x94, 0x87, 0xd1, 0x9b, 0xf3, 0x47, 0x81, 0x9f, 0x48, 0x7f, 0x41, 0x14,
-	0xd8, 0x16, 0xac, 0xa1, 0xa9, 0x51, 0xd6, 0x43, 0x6f, 0xb1, 0x38, 0x98, 0x95, 0xd6, 0xa1, 0x32,
-	0x40, 0x5c, 0x6a, 0x7b, 0xfd, 0x89, 0xf0, 0x6a, 0xca, 0xaa, 0x70, 0xd5, 0x44, 0x4b, 0x38, 0xe5,
-	0x9a, 0x30, 0x47, 0x1a, 0x28, 0x04, 0x85, 0xe6, 0x85, 0xaa, 0xca, 0x07, 0x85, 0x8c, 0xc0, 0x52,
-	0xbd, 0x10, 0x58, 0x42, 0x55, 0x36, 0x0b, 0xfb, 0x7c, 0xd0, 0x4b, 0xa3, 0x1e, 0xa9, 0x5c, 0xda,
-	0x9d, 0xba, 0x97, 0x87, 0x29, 0x04, 0xc6, 0x93, 0x34, 0xe4, 0x29, 0x69, 0xa5, 0xba, 0xa7, 0x8a,
-	0x28, 0x5d, 0x44, 0x22, 0x0c, 0x48, 0xc3, 0x93, 0x25, 0x74, 0x4b, 0xa7, 0x71, 0x90, 0x74, 0x5a,
-	0x84, 0xd2, 0x6f, 0xf6, 0x69, 0x58, 0x3f, 0xe6, 0x49, 0xda, 0x3b, 0xe5, 0xfe, 0x80, 0xc7, 0xb4,
-	0xfb, 0x22, 0x5e, 0x25, 0xac, 0x7d, 0x79, 0x25, 0xf6, 0x7d, 0xc6, 0xe3, 0x24, 0x88, 0x42, 0xb2,
-	0xf3, 0x0d, 0x4f, 0x15, 0xb1, 0x3d, 0x5c, 0x10, 0x6d, 0x43, 0xf5, 0xaa, 0x2e, 0xd3, 0x62, 0x94,
-	0x57, 0xba, 0xdf, 0x22, 0x9f, 0x5b, 0xc7, 0xdf, 0xde, 0x27, 0x87, 0x81, 0xbd, 0x04, 0x0d, 0xb1,
-	0x32, 0xc9, 0xa9, 0x2f, 0x8f, 0x01, 0x75, 0x02, 0x8e, 0x4e, 0x7d, 0xd4, 0x32, 0xd6, 0x62, 0x8b,
-	0x80, 0x66, 0x93, 0xb0, 0x7d, 0xb1, 0xd6, 0xaf, 0xc1, 0x92, 0x8a, 0xec, 0x25, 0xbd, 0x11, 0x3f,
-	0x49, 0xd5, 0x31, 0x3d, 0x9c, 0x8e, 0xb1, 0xbb, 0xe4, 0x80, 0x9f, 0xa4, 0xee, 0x63, 0x58, 0x91,
-	0x92, 0xff, 0x64, 0xc2, 0x55, 0xd7, 0x9f, 0x2d, 0xb3, 0xa0, 0xcd, 0xad, 0x55, 0x5b, 0x55, 0x50,
-	0xac, 0x21, 0x67, 0x56, 0x5d, 0x0f, 0x98, 0xa9, 0x49, 0x64, 0x83, 0xd2, 0x8c, 0xa9, 0x60, 0x80,
-	0x9c, 0x8e, 0x85, 0xe1, 0xaa, 0x26, 0xd3, 0x7e, 0x1f, 0xf5, 0x87, 0xd0, 0xaa, 0xaa, 0xe8, 0x7e,
-	0xdf, 0x81, 0x55, 0x6a, 0x4d, 0xf9, 0x00, 0xfa, 0x04, 0xf9, 0xe2, 0xc3, 0x6c, 0xf5, 0xcd, 0x00,
-	0xc9, 0x1a, 0xd4, 0x4c, 0xfd, 0x2d, 0x0a, 0x3f, 0xf9, 0x99, 0xb8, 0x5a, 0x38, 0x13, 0xff, 0xbd,
-	0x03, 0x2b, 0x42, 0x85, 0xa6, 0x7e, 0x3a, 0x4d, 0xe4, 0xf4, 0xff, 0x0f, 0x2c, 0x0a, 0x5b, 0x28,
-	0x85, 0x50, 0x0e, 0x74, 0x4d, 0xeb, 0x0b, 0x42, 0x05, 0xf1, 0xfe, 0x15, 0xcf, 0x26, 0x66, 0x9f,
-	0x87, 0x96, 0x19, 0x9e, 0xa5, 0x31, 0x37, 0xb7, 0xae, 0xa9, 0x59, 0x16, 0x38, 0x67, 0xff, 0x8a,
-	0x67, 0x7d, 0xc0, 0xde, 0x25, 0x87, 0x26, 0xec, 0x51, 0xb3, 0x32, 0xc0, 0x75, 0xad, 0x44, 0xed,
-	0xeb, 0xcf, 0x0d, 0xf2, 0xfb, 0x75, 0x98, 0x17, 0x1e, 0xac, 0xfb, 0x10, 0x16, 0xad, 0x91, 0x5a,
-	0x67, 0xfd, 0x96, 0x38, 0xeb, 0x17, 0x42, 0x43, 0x95, 0x62, 0x68, 0xc8, 0xfd, 0xd3, 0x39, 0x60,
-	0xc8, 0x6d, 0xb9, 0xed, 0x44, 0x17, 0x3a, 0x1a, 0x58, 0x07, 0xa2, 0x96, 0x67, 0x42, 0xec, 0x0e,
-	0x30, 0xa3, 0xa8, 0xa2, 0x67, 0xc2, 0xda, 0x94, 0xd4, 0xa0, 0x5a, 0x94, 0xc6, 0x5a, 0x9a, 0x55,
-	0x79, 0xf4, 0x13, 0xfb, 0x56, 0x5a, 0x87, 0x06, 0x65, 0x32, 0x4d, 0x4e, 0xd1, 0xc5, 0x57, 0x47,
-	0x26, 0x55, 0xce, 0x33, 0xc8, 0xfc, 0xa5, 0x0c, 0xb2, 0x90, 0x67, 0x10, 0xd3, 0x69, 0xaf, 0x5b,
-	0x4e, 0x3b, 0x3a, 0x8b, 0x63, 0x74, 0x31, 0xd3, 0x51, 0xbf, 0x37, 0xc6, 0xde, 0xe5, 0x09, 0xc9,
-	0x02, 0xd9, 0x26, 0xb4, 0xa5, 0x7b, 0x91, 0x9d, 0x0c, 0x80, 0xd6, 0xb8, 0x80, 0xa3, 0xbe, 0xc6,
-	0x8f, 0x49, 0x03, 0xd0, 0x29, 0xa9, 0xe6, 0x65, 0x00, 0x9e, 0xa5, 0x12, 0x64, 0xb1, 0xde, 0x34,
-	0x94, 0xdc, 0xc2, 0x07, 0x74, 0x36, 0xaa, 0x7b, 0xc5, 0x0a, 0xf7, 0x47, 0x0e, 0xb4, 0x71, 0xcf,
-	0x2c, 0xbe, 0x7e, 0x07, 0x48, 0xac, 0x5e, 0x90, 0xad, 0x2d, 0xda, 0x9f, 0x9d, 0xab, 0xdf, 0x86,
-	0x06, 0x35, 0x18, 0x4d, 0x78, 0x28, 0x99, 0xba, 0x63, 0x33, 0x75, 0xa6, 0xd1, 0xf6, 0xaf, 0x78,
-	0x19, 0xb1, 0xc1, 0xd2, 0x7f, 0xe7, 0x40, 0x53, 0x0e, 0xf3, 0xa7, 0x8e, 0x1c, 0x74, 0xa1, 0x8e,
-	0xdc, 0x6d, 0x1c, 0xcf, 0x75, 0x19, 0xed, 0xd9, 0xd8, 0x4f, 0xa7, 0x31, 0x1a, 0x70, 0x2b, 0x6a,
-	0x90, 0x87, 0xd1, 0x1a, 0x93, 0xf2, 0x4e, 0x7a, 0x69, 0x30, 0xea, 0xa9, 0x5a, 0x79, 0xb3, 0x52,
-	0x56, 0x85, 0x3a, 0x2c, 0x49, 0xfd, 0x21, 0x97, 0x86, 0x56, 0x14, 0xdc, 0x0e, 0x6c, 0xc8, 0x09,
-	0xe5, 0x7c, 0x5b, 0xf7, 0x2f, 0x5b, 0x70, 0xb5, 0x50, 0xa5, 0xaf, 0x26, 0xe5, 0x71, 0x78, 0x14,
-	0x8c, 0x8f, 0x23, 0x7d, 0x30, 0x70, 0xcc, 0x93, 0xb2, 0x55, 0xc5, 0x86, 0xb0, 0xae, 0x3c, 0x0a,
-	0x5c, 0xd3, 0xcc, 0xd2, 0x55, 0xc8, 0x15, 0x7a, 0xd3, 0xe6, 0x81, 0x7c, 0x87, 0x0a, 0x37, 0xb5,
-	0x40, 0x79, 0x7b, 0xec, 0x14, 0x3a, 0xda, 0x75, 0x91, 0xe6, 0xc2, 0x70, 0x6f, 0xb0, 0xaf, 0x37,
-	0x2e, 0xe9, 0xcb, 0x72, 0x85, 0xbd, 0x0b, 0x5b, 0x63, 0x33, 0xb8, 0xa1, 0xea, 0xc8, 0x1e, 0x14,
-	0xfb, 0xab, 0xbe, 0xd0, 0xdc, 0xc8, 0xc9, 0xb7, 0x3b, 0xbd, 0xa4, 0x61, 0xf6, 0x0d, 0xd8, 0x38,
-	0xf7, 0x83, 0x54, 0x0d, 0xcb, 0x70, 0x1c, 0x6a, 0xd4, 0xe5, 0xd6, 0x25, 0x5d, 0x7e, 0x20, 0x3e,
-	0xb6, 0x8c, 0xe4, 0x05, 0x2d, 0x76, 0xff, 0xc6, 0x81, 0x25, 0xbb, 0x1d, 0x64, 0x53, 0xa9, 0x3c,
-	0x94, 0x12, 0x55, 0xee, 0x67, 0x0e, 0x2e, 0x9e, 0xad, 0x2b, 0x65, 0x67, 0x6b, 0xf3, 0x44, 0x3b,
-	0x77, 0x59, 0xd8, 0xa9, 0xfa, 0x62, 0x61, 0xa7, 0x5a, 0x59, 0xd8, 0xa9, 0xfb, 0xef, 0x0e, 0xb0,
-	0x22, 0x2f, 0xb1, 0x87, 0xe2, 0x70, 0x1f, 0xf2, 0x91, 0xd4, 0x49, 0xff, 0xfb, 0xc5, 0xf8, 0x51,
-	0xad, 0x9d, 0xfa, 0x1a, 0x05, 0xc3, 0x54, 0x3a, 0xa6, 0xbb, 0xb5, 0xe8, 0x95, 0x55, 0xe5, 0x02,
-	0x61, 0xd5, 0xcb, 0x03, 0x61, 0xb5, 0xcb, 0x03, 0x61, 0xf3, 0xf9, 0x40, 0x58, 0xf7, 0xd7, 0x1d,
-	0x58, 0x2d, 0xd9, 0xf4, 0x9f, 0xdf, 0xc4, 0x71, 0x9b, 0x2c, 0x5d, 0x50, 0x91, 0xdb, 0x64, 0x82,
-	0xdd, 0x5f, 0x82, 0x45, 0x8b, 0xd1, 0x7f, 0x7e, 0xfd, 0xe7, 0x3d, 0x46, 0xc1, 0x67, 0x16, 0xd6,
-	0xfd, 0xd7, 0x0a, 0xb0, 0xa2, 0xb0, 0xfd, 0x8f, 0x8e, 0xa1, 0xb8, 0x4e, 0x73, 0x25, 0xeb, 0xf4,
-	0xdf, 0x6a, 0x07, 0xde, 0x80, 0x15, 0x99, 0xc7, 0x60, 0x84, 0x74, 0x04, 0xc7, 0x14, 0x2b, 0xd0,
-	0x67, 0xb6, 0xa3, 0x90, 0x75, 0xeb, 0xfe, 0xdb, 0x30, 0x86, 0xb9, 0x60, 0xa4, 0xbb, 0x01, 0x6b,
-	0x22, 0x2f, 0xe2, 0xbe, 0x68, 0x4a, 0xd9, 0x95, 0x3f, 0x74, 0x60, 0x3d, 0x57, 0x91, 0xdd, 0xd6,
-	0x0a, 0xd3, 0x61, 0xdb, 0x13, 0x1b, 0xc4, 0xf1, 0x6b, 0x37, 0x23, 0xc7, 0x6d, 0xc5, 0x0a, 0x5c,
-	0x1f, 0xc3, 0x2d, 0xc9, 0xad, 0x7a, 0x59, 0x95, 0x7b, 0x55, 0x64, 0x6f, 0x84, 0x7c, 0x94, 0x1b,
-	0xf8, 0x89, 0xc8, 0xb7, 0x30, 0x2b, 0xb2, 0xab, 0x20, 0x7b, 0xc8, 0xaa, 0x88, 0x1e, 0xa5, 0x65,
-	0xa6, 0xec, 0xf1, 0x96, 0xd6, 0xb9, 0x3f, 0x74, 0x80, 0x7d, 0x69, 0xca, 0xe3, 0x19, 0xdd, 0xda,
-	0xea, 0x58, 0xd3, 0xd5, 0x7c, 0x24, 0x65, 0x7e, 0x32, 0x3d, 0xfe, 0x22, 0x9f, 0xa9, 0xbb, 0xfd,
-	0x4a, 0x76, 0xb7, 0xff, 0x32, 0x00, 0x1e, 0xe5, 0xf4, 0x55, 0x30, 0x79, 0x72, 0xe1, 0x74, 0x2c,
-	0x1a, 0x2c, 0xbd, 0x7e, 0xaf, 0x5e, 0x7e, 0xfd, 0x5e, 0xbb, 0xec, 0xfa, 0xfd, 0x5d, 0x58, 0xb5,
-	0xc6, 0xad, 0xb7, 0x55, 0x5d, 0x4a, 0x3b, 0xcf, 0xb9, 0x94, 0xfe, 0xcd, 0x0a, 0xcc, 0xed, 0x47,
-	0x13, 0x33, 0xce, 0xea, 0xd8, 0x71, 0x56, 0x69, 0x4b, 0x7a, 0xda, 0x54, 0x48, 0x15, 0x63, 0x81,
-	0x6c, 0x13, 0x96, 0xfc, 0x71, 0x8a, 0x07, 0xff, 0x93, 0x28, 0x3e, 0xf7, 0xe3, 0x81, 0xd8, 0xeb,
-	0xfb, 0x95, 0x8e, 0xe3, 0xe5, 0x6a, 0xd8, 0x1a, 0xcc, 0x69, 0xa5, 0x4b, 0x04, 0x58, 0x44, 0xc7,
-	0x8d, 0xee, 0x68, 0x66, 0x32, 0x66, 0x21, 0x4b, 0xc8, 0x4a, 0xf6, 0xf7, 0xc2, 0xed, 0x16, 0xa2,
-	0x53, 0x56, 0x85, 0x76, 0x0d, 0x97, 0x8f, 0xc8, 0x64, 0xb0, 0x49, 0x95, 0xcd, 0xc0, 0x58, 0xdd,
-	0xbe, 0xb1, 0xfa, 0x67, 0x07, 0x6a, 0xb4, 0x36, 0xa8, 0x06, 0x04, 0xef, 0xeb, 0x50, 0x2b, 0xad,
-	0xc9, 0xa2, 0x97, 0x87, 0x99, 0x6b, 0x65, 0xc7, 0x54, 0xf4, 0x84, 0xcc, 0x0c, 0x99, 0x9b, 0xd0,
-	0x10, 0x25, 0x9d, 0x09, 0x42, 0x24, 0x19, 0xc8, 0x6e, 0x40, 0xf5, 0x34, 0x9a, 0x28, 0xbf, 0x05,
-	0xd4, 0x4d, 0x43, 0x34, 0xf1, 0x08, 0xcf, 0xc6, 0x83, 0xed, 0x89, 0x69, 0x09, 0x6b, 0x94, 0x87,
-	0xd1, 0x1e, 0xeb, 0x66, 0xcd, 0x65, 0xca, 0xa1, 0xee, 0x26, 0x2c, 0x3f, 0x8e, 0x06, 0xdc, 0x88,
-	0x77, 0x5d, 0xc8, 0xe7, 0xee, 0x2f, 0x3b, 0x50, 0x57, 0xc4, 0xec, 0x36, 0x54, 0xd1, 0xc9, 0xc8,
-	0x1d, 0x21, 0xf4, 0x0d, 0x23, 0xd2, 0x79, 0x44, 0x81, 0x5a, 0x99, 0xe2, 0x1a, 0x99, 0xc3, 0xa9,
-	0xa2, 0x1a, 0x99, 0x3f, 0xa5, 0x87, 0x9b, 0x73, 0x43, 0x72, 0xa8, 0xfb, 0x03, 0x07, 0x16, 0xad,
-	0x3e, 0xf0, 0x10, 0x3a, 0xf2, 0x93, 0x54, 0xde, 0xda, 0xc8, 0xed, 0x31, 0x21, 0x73, 0xa3, 0x2b,
-	0x76, 0x04, 0x54, 0xc7, 0xe6, 0xe6, 0xcc, 0xd8, 0xdc, 0x3d, 0x68, 0x64, 0x39, 0x4c, 0x55, 0x4b,
-	0xdb, 0x62, 0x8f, 0xea, 0xee, 0x34, 0x23, 0xc2, 0x76, 0xfa, 0xd1, 0x28, 0x8a, 0xe5, 0x75, 0x81,
-	0x28, 0xb8, 0xef, 0x42, 0xd3, 0xa0, 0xc7, 0x61, 0x84, 0x3c, 0x3d, 0x8f, 0xe2, 0x67, 0x2a, 0x10,
-	0x2b, 0x8b, 0x3a, 0x0d, 0xa0, 0x92, 0xa5, 0x01, 0xb8, 0x7f, 0xed, 0xc0, 0x22, 0xf2, 0x60, 0x10,
-	0x0e, 0x0f, 0xa3, 0x51, 0xd0, 0x9f, 0xd1, 0xde, 0x2b, 0x76, 0x93, 0x3a, 0x43, 0xf1, 0xa2, 0x0d,
-	0x23, 0xd7, 0xab, 0x33, 0xa8, 0x14, 0x51, 0x5d, 0x46, 0x19, 0x46, 0x09, 0x38, 0xf6, 0x13, 0x29,
-	0x16, 0xd2, 0xfc, 0x59, 0x20, 0x4a, 0x1a, 0x02, 0xb1, 0x9f, 0xf2, 0xde, 0x38, 0x18, 0x8d, 0x02,
-	0x41, 0x2b, 0x9c, 0xa3, 0xb2, 0x2a, 0xec, 0x73, 0x10, 0x24, 0xfe, 0x71, 0x16, 0x02, 0xd7, 0x65,
-	0xf7, 0xcf, 0x2b, 0xd0, 0x94, 0x8a, 0x7b, 0x6f, 0x30, 0xe4, 0xf2, 0xbe, 0x86, 0xdc, 0x4f, 0xad,
-	0x64, 0x0c, 0x44, 0xd5, 0x5b, 0x0e, 0xab, 0x81, 0xe4, 0xb7, 0x7c, 0xae, 0xb8, 0xe5, 0xd7, 0xa1,
-	0x81, 0xac, 0xf7, 0x26, 0x79, 0xc6, 0xe2, 0xae, 0x27, 0x03, 0x54, 0xed, 0x16, 0xd5, 0xd6, 0xb2,
-	0x5a, 0x02, 0x9e, 0x7b, 0xbb, 0xf3, 0x36, 0xb4, 0x64, 0x33, 0xb4, 0x27, 0xa4, 0x53, 0x32, 0xe6,
-	0xb7, 0xf6, 0xcb, 0xb3, 0x28, 0xd5, 0x97, 0x5b, 0xea, 0xcb, 0xfa, 0x65, 0x5f, 0x2a, 0x4a, 0xf7,
-	0xa1, 0xbe, 0x34, 0x7b, 0x18, 0xfb, 0x93, 0x53, 0x25, 0xa5, 0xf7, 0x60, 0x35, 0x08, 0xfb, 0xa3,
-	0xe9, 0x80, 0xf7, 0xa6, 0xa1, 0x1f, 0x86, 0xd1, 0x34, 0xec, 0x73, 0x95, 0x33, 0x50, 0x56, 0xe5,
-	0x0e, 0x74, 0xd2, 0x11, 0x35, 0xc4, 0x36, 0xa1, 0x86, 0x1d, 0x29, 0xab, 0x50, 0x2e, 0xc2, 0x82,
-	0x84, 0xdd, 0x86, 0x1a, 0x1f, 0x0c, 0xb9, 0x3a, 0x2d, 0x32, 0xfb, 0xdc, 0x8e, 0xbb, 0xea, 0x09,
-	0x02, 0x54, 0x28, 0x88, 0xe6, 0x14, 0x8a, 0x6d, 0x51, 0xe6, 0xb1, 0xf8, 0x68, 0xe0, 0xae, 0x01,
-	0x7b, 0x2c, 0x64, 0xc0, 0x8c, 0xb7, 0xff, 0xda, 0x1c, 0x34, 0x0d, 0x18, 0x75, 0xc3, 0x10, 0x07,
-	0xdc, 0x1b, 0x04, 0xfe, 0x98, 0xa7, 0x3c, 0x96, 0x7c, 0x9f, 0x43, 0x91, 0xce, 0x3f, 0x1b, 0xf6,
-	0xa2, 0x69, 0xda, 0x1b, 0xf0, 0x61, 0xcc, 0x85, 0x91, 0x47, 0xa3, 0x63, 0xa1, 0x48, 0x37, 0xf6,
-	0x3f, 0x34, 0xe9, 0x04, 0x07, 0xe5, 0x50, 0x15, 0x3d, 0x17, 0x6b, 0x54, 0xcd, 0xa2, 0xe7, 0x62,
-	0x45, 0xf2, 0x5a, 0xad, 0x56, 0xa2, 0xd5, 0xde, 0x82, 0x0d, 0xa1, 0xbf, 0xa4, 0xa4, 0xf7, 0x72,
-	0x8c, 0x75, 0x41, 0x2d, 0xdb, 0x84, 0x36, 0x8e, 0x59, 0x89, 0x44, 0x12, 0x7c, 0x4b, 0x44, 0xa6,
-	0x1c, 0xaf, 0x80, 0x23, 0x2d, 0x85, 0x88, 0x4c, 0x5a, 0x71, 0x9b, 0x58, 0xc0, 0x89, 0xd6, 0xff,
-	0xd0, 0xa6, 0x6d, 0x48, 0xda, 0x1c, 0xee, 0x2e, 0x42, 0xf3, 0x28, 0x8d, 0x26, 0x6a, 0x53, 0x96,
-	0xa0, 0x25, 0x8a, 0x32, 0x77, 0xe3, 0x25, 0xb8, 0x46, 0x5c, 0xf4, 0x34, 0x9a, 0x44, 0xa3, 0x68,
-	0x38, 0x3b, 0x9a, 0x1e, 0x27, 0xfd, 0x38, 0x98, 0xe0, 0xc9, 0xca, 0xfd, 0x5b, 0x07, 0x56, 0xad,
-	0x5a, 0x19, 0x7e, 0xfa, 0xb4, 0x10, 0x02, 0x7d, 0xe9, 0x2e, 0x18, 0x6f, 0xc5, 0x50, 0xae, 0x82,
-	0x50, 0x04, 0x11, 0xdf, 0x97, 0xf7, 0xf0, 0xdb, 0xb0, 0xac, 0x46, 0xa6, 0x3e, 0x14, 0x5c, 0xd8,
-	0x29, 0x72, 0xa1, 0xfc, 0x7e, 0x49, 0x7e, 0xa0, 0x9a, 0xf8, 0xbf, 0xf2, 0x56, 0x76, 0x40, 0x73,
-	0x54, 0x71, 0x08, 0x7d, 0x93, 0x66, 0x9e, 0x46, 0xd4, 0x08, 0xfa, 0x1a, 0x4c, 0xdc, 0xdf, 0x76,
-	0x00, 0xb2, 0xd1, 0xd1, 0x5d, 0x9e, 0x36, 0x10, 0x22, 0x19, 0xdc, 0x30, 0x06, 0xaf, 0x42, 0x4b,
-	0xdf, 0x01, 0x65, 0x36, 0xa7, 0xa9, 0x30, 0x74, 0x18, 0x6f, 0xc1, 0xf2, 0x70, 0x14, 0x1d, 0x93,
-	0xc1, 0xa6, 0x64, 0xa0, 0x44, 0x66, 0xb0, 0x2c, 0x09, 0xf8, 0x81, 0x44, 0x33, 0x03, 0x55, 0x35,
-	0x0c, 0x94, 0xfb, 0xed, 0x8a, 0xbe, 0x03, 0xc8, 0xe6, 0x7c, 0xa1, 0x94, 0xb1, 0xad, 0x82, 0x3a,
-	0xbd, 0x20, 0xe4, 0x4e, 0x11, 0xb7, 0xc3, 0x4b, 0x03, 0x02, 0xef, 0xc2, 0x52, 0x2c, 0xf4, 0x95,
-	0x52, 0x66, 0xd5, 0xe7, 0x28, 0xb3, 0xc5, 0xd8, 0xb2, 0x62, 0x9f, 0x84, 0xb6, 0x3f, 0x38, 0xe3,
-	0x71, 0x1a, 0xd0, 0x91, 0x8c, 0x5c, 0x08, 0xa1, 0x82, 0x97, 0x0d, 0x9c, 0x2c, 0xfb, 0x2d, 0x58,
-	0x96, 0x59, 0x43, 0x9a, 0x52, 0x66, 0xb3, 0x66, 0x30, 0x12, 0xba, 0x7f, 0xac, 0xae, 0x1b, 0xec,
-	0x3d, 0xbc, 0x78, 0x45, 0xcc, 0xd9, 0x55, 0x72, 0xb3, 0xfb, 0x84, 0x0c, 0xfd, 0x0f, 0xd4, 0xb9,
-	0x6f, 0xce, 0xb8, 0xc1, 0x1f, 0xc8, 0xab, 0x1a, 0x7b, 0x49, 0xab, 0x2f, 0xb2, 0xa4, 0xee, 0x8f,
-	0x1c, 0x58, 0xd8, 0x8f, 0x26, 0xfb, 0x32, 0x97, 0x81, 0x04, 0x41, 0xe7, 0xdd, 0xa9, 0xe2, 0x73,
-	0xb2, 0x1c, 0x4a, 0x2d, 0xf7, 0x62, 0xde, 0x72, 0xff, 0x3f, 0x78, 0x89, 0xa2, 0x0e, 0x71, 0x34,
-	0x89, 0x62, 0x14, 0x46, 0x7f, 0x24, 0xcc, 0x74, 0x14, 0xa6, 0xa7, 0x4a, 0x8d, 0x3d, 0x8f, 0x84,
-	0x8e, 0x77, 0x78, 0x2c, 0x11, 0x4e, 0xb7, 0xf4, 0x34, 0x84, 0x76, 0x2b, 0x56, 0xb8, 0x9f, 0x85,
-	0x06, 0xb9, 0xca, 0x34, 0xad, 0x37, 0xa0, 0x71, 0x1a, 0x4d, 0x7a, 0xa7, 0x41, 0x98, 0x2a, 0xe1,
-	0x5e, 0xca, 0x7c, 0xd8, 0x7d, 0x5a, 0x10, 0x4d, 0xe0, 0xfe, 0x7e, 0x0d, 0x16, 0x1e, 0x85, 0x67,
-	0x51, 0xd0, 0xa7, 0x9b, 0x89, 0x31, 0x1f, 0x47, 0x2a, 0x0b, 0x11, 0x7f, 0xe3, 0x52, 0x50, 0xb6,
-	0xce, 0x24, 0x95, 0x57, 0x0b, 0xaa, 0x88, 0x0e, 0x42, 0x9c, 0x65, 0x0a, 0x0b, 0xd1, 0x31, 0x10,
-	0x3c, 0x40, 0xc4, 0x66, 0x52, 0xb5, 0x2c, 0x65, 0x69, 0x9c, 0x35, 0x23, 0x8d, 0x93, 0xee, 0xb1,
-	0x44, 0xde, 0x85, 0xbc, 0x98, 0x57, 0x45, 0x3a, 0xf0, 0xc4, 0x5c, 0x44, 0x8b, 0xc8, 0xd5, 0x58,
-	0x90, 0x07, 0x1e, 0x13, 0x44, 0x77, 0x44, 0x7c, 0x20, 0x68, 0x84, 0xf2, 0x35, 0x21, 0x74, 0xdd,
-	0xf2, 0x79, 0xd9, 0x0d, 0xc1, 0xf3, 0x39, 0x18, 0x35, 0xf4, 0x80, 0x6b, 0x45, 0x2a, 0xe6, 0x00,
-	0x22, 0x13, 0x3a, 0x8f, 0x1b, 0xc7, 0x24, 0x91, 0x50, 0xa5, 0x8e, 0x49, 0xc8, 0x28, 0xfe, 0x68,
-	0x74, 0xec, 0xf7, 0x9f, 0x51, 0xda, 0x3d, 0xdd, 0x11, 0x34, 0x3c, 0x1b, 0xa4, 0xcc, 0x89, 0x6c,
-	0x37, 0xe9, 0xfe, 0xb4, 0xea, 0x99, 0x10, 0xdb, 0x82, 0x26, 0x1d, 0x0d, 0xe5, 0x7e, 0x2e, 0xd1,
-	0x7e, 0xb6, 0xcd, 0xb3, 0x23, 0xed, 0xa8, 0x49, 0x64, 0xde, 0x96, 0x2c, 0xdb, 0xb7, 0x25, 0x42,
-	0x69, 0xca, 0x4b, 0xa6, 0x36, 0xf5, 0x96, 0x01, 0x68, 0x4d, 0xe5, 0x82, 0x09, 0x82, 0x15, 0x22,
-	0xb0, 0x30, 0x76, 0x03, 0xea, 0x78, 0x6c, 0x99, 0xf8, 0xc1, 0xa0, 0xc3, 0xf4, 0xe9, 0x49, 0x63,
-	0xd8, 0x86, 0xfa, 0x4d, 0x97, 0x41, 0xab, 0xb4, 0x2a, 0x16, 0x86, 0x6b, 0xa3, 0xcb, 0x24, 0x44,
-	0x6b, 0x62, 0x47, 0x2d, 0xd0, 0x4d, 0x81, 0x6d, 0x0f, 0x06, 0x92, 0x37, 0xf5, 0x31, 0x3a, 0xe3,
-	0x2a, 0xc7, 0xe2, 0xaa, 0x92, 0xdd, 0xad, 0x94, 0xef, 0xee, 0x73, 0xd7, 0xc0, 0xdd, 0x83, 0xe6,
-	0xa1, 0x91, 0x7a, 0x4e, 0x4c, 0xae, 0x92, 0xce, 0xa5, 0x60, 0x18, 0x88, 0x31, 0x9c, 0x8a, 0x39,
-	0x1c, 0xf7, 0x4f, 0x1c, 0x60, 0x07, 0x41, 0x92, 0xea, 0xe1, 0x8b, 0xbe, 0x5d, 0x68, 0xe9, 0x60,
-	0x47, 0x96, 0x4b, 0x66, 0x61, 0x48, 0x43, 0x43, 0xe9, 0x45, 0x27, 0x27, 0x09, 0x57, 0x99, 0x1f,
-	0x16, 0x86, 0x1c, 0x8a, 0x3e, 0x0e, 0xfa, 0x0b, 0x81, 0xe8, 0x21, 0x91, 0x19, 0x20, 0x05, 0x1c,
-	0xf5, 0x6c, 0xcc, 0xcf, 0x78, 0x9c, 0x68, 0xd1, 0xd2, 0x65, 0x9d, 0xf2, 0x96, 0x5f, 0xe5, 0x4d,
-	0xa8, 0xeb, 0x76, 0x6d, 0x15, 0xa2, 0x28, 0x75, 0x3d, 0xaa, 0x2a, 0xf2, 0xfa, 0xad, 0x41, 0x0b,
-	0xb5, 0x59, 0xac, 0x60, 0x77, 0x80, 0x9d, 0x04, 0x71, 0x9e, 0x7c, 0x8e, 0xc8, 0x4b, 0x6a, 0xdc,
-	0x0f, 0x60, 0x55, 0x76, 0x69, 0x3a, 0x37, 0xf6, 0x26, 0x3a, 0x97, 0x31, 0x72, 0xa5, 0xc8, 0xc8,
-	0xee, 0x7f, 0x38, 0xb0, 0x20, 0x77, 0x9a, 0xb6, 0x25, 0xff, 0x06, 0xa1, 0xe1, 0x59, 0x18, 0xeb,
-	0x58, 0xd9, 0xe7, 0xc4, 0xf5, 0x52, 0x75, 0x15, 0x14, 0xd4, 0x5c, 0x99, 0x82, 0x62, 0x50, 0x9d,
-	0xf8, 0xe9, 0x29, 0x9d, 0x65, 0x1b, 0x1e, 0xfd, 0x66, 0x6d, 0x11, 0x79, 0x11, 0x8a, 0x90, 0xa2,
-	0x2e, 0x65, 0x8f, 0x30, 0x84, 0xbd, 0x2d, 0x3e, 0xc2, 0xb8, 0x0e, 0x0d, 0x1a, 0x40, 0x2f, 0x0b,
-	0xac, 0x64, 0x00, 0x72, 0xae, 0x28, 0x90, 0x84, 0xc9, 0xd4, 0xd2, 0x0c, 0x71, 0xd7, 0xc5, 0xce,
-	0xcb, 0x25, 0xd0, 0xf7, 0x5d, 0x32, 0xc5, 0x30, 0x83, 0x33, 0x8e, 0x90, 0x03, 0xc8, 0x73, 0x84,
-	0x24, 0xf5, 0x74, 0xbd, 0xdb, 0x85, 0xce, 0x2e, 0x1f, 0xf1, 0x94, 0x6f, 0x8f, 0x46, 0xf9, 0xf6,
-	0x5f, 0x82, 0x6b, 0x25, 0x75, 0xd2, 0x9f, 0xfd, 0x12, 0xac, 0x6f, 0x8b, 0x74, 0xac, 0x9f, 0x57,
-	0xce, 0x82, 0xdb, 0x81, 0x8d, 0x7c, 0x93, 0xb2, 0xb3, 0x07, 0xb0, 0xb2, 0xcb, 0x8f, 0xa7, 0xc3,
-	0x03, 0x7e, 0x96, 0x75, 0xc4, 0xa0, 0x9a, 0x9c, 0x46, 0xe7, 0x52, 0x30, 0xe9, 0x37, 0x7b, 0x19,
-	0x60, 0x84, 0x34, 0xbd, 0x64, 0xc2, 0xfb, 0x2a, 0x85, 0x9c, 0x90, 0xa3, 0x09, 0xef, 0xbb, 0x6f,
-	0x01, 0x33, 0xdb, 0x91, 0xeb, 0x85, 0xf6, 0x68, 0x7a, 0xdc, 0x4b, 0x66, 0x49, 0xca, 0xc7, 0x2a,
-	0x37, 0xde, 0x84, 0xdc, 0x5b, 0xd0, 0x3a, 0xf4, 0x67, 0x1e, 0xff, 0xa6, 0x7c, 0xb5, 0x72, 0x15,
-	0x16, 0x26, 0xfe, 0x0c, 0xd5, 0x94, 0x8e, 0xf8, 0x50, 0xb5, 0xfb, 0x6f, 0x15, 0x98, 0x17, 0x94,
-	0xd8, 0xea, 0x80, 0x27, 0x69, 0x10, 0x8a, 0xdb, 0x5f, 0xd9, 0xaa, 0x01, 0x15, 0x58, 0xb9, 0x52,
-	0xc2, 0xca, 0xf2, 0xd4, 0xa4, 0xd2, 0x71, 0x25, 0xbf, 0x5a, 0x18, 0x32, 0x57, 0x96, 0xd7, 0x23,
-	0x42, 0x0e, 0x19, 0x90, 0x0b, 0x0e, 0x66, 0x56, 0x4f, 0x8c, 0x4f, 0x49, 0xa9, 0xe4, 0x5c, 0x13,
-	0x2a, 0xb5, 0xad, 0x0b, 0x82, 0xc1, 0x0b, 0xb6, 0xb5, 0x60, 0x43, 0xeb, 0x2f, 0x60, 0x43, 0xc5,
-	0x51, 0xea, 0x79, 0x36, 0x14, 0x5e, 0xc0, 0x86, 0xba, 0x0c, 0xda, 0x0f, 0x38, 0xf7, 0x38, 0x7a,
-	0x67, 0x8a, 0x77, 0xbf, 0xeb, 0x40, 0x5b, 0x72, 0x91, 0xae, 0x63, 0xaf, 0x5a, 0x5e, 0x68, 0x69,
-	0xd2, 0xec, 0x6b, 0xb0, 0x48, 0xbe, 0xa1, 0x8e, 0x82, 0xca, 0x90, 0xad, 0x05, 0xe2, 0x3c, 0xd4,
-	0x55, 0xd5, 0x38, 0x18, 0xc9, 0x4d, 0x31, 0x21, 0x15, 0x48, 0x8d, 0x7d, 0x99, 0x44, 0xe3, 0x78,
-	0xba, 0xec, 0xfe, 0x85, 0x03, 0x2b, 0xc6, 0x80, 0x25, 0x17, 0xbe, 0x0b, 0x4a, 0x1a, 0x44, 0x48,
-	0x54, 0x48, 0xee, 0x55, 0x5b, 0x6c, 0xb2, 0xcf, 0x2c, 0x62, 0xda, 0x4c, 0x7f, 0x46, 0x03, 0x4c,
-	0xa6, 0x63, 0xa9, 0x44, 0x4d, 0x08, 0x19, 0xe9, 0x9c, 0xf3, 0x67, 0x9a, 0x44, 0xa8, 0x71, 0x0b,
-	0xa3, 0x04, 0x0d, 0xf4, 0x69, 0x35, 0x91, 0xb0, 0x67, 0x36, 0xe8, 0xfe, 0xd8, 0x81, 0x55, 0x71,
-	0x38, 0x91, 0x47, 0x3f, 0xfd, 0xa2, 0x61, 0x5e, 0x9c, 0xc6, 0x84, 0x44, 0xee, 0x5f, 0xf1, 0x64,
-	0x99, 0x7d, 0xe6, 0x05, 0x0f, 0x54, 0x3a, 0x31, 0xe7, 0x82, 0xbd, 0x98, 0x2b, 0xdb, 0x8b, 0xe7,
-	0xac, 0x74, 0x59, 0x08, 0xb0, 0x56, 0x1a, 0x02, 0xbc, 0xbf, 0x00, 0xb5, 0xa4, 0x1f, 0x4d, 0xb8,
-	0xbb, 0x01, 0x6b, 0xf6, 0xe4, 0xa4, 0x0a, 0xfa, 0x9e, 0x03, 0x9d, 0x07, 0x22, 0x54, 0x1e, 0x84,
-	0xc3, 0xfd, 0x20, 0x49, 0xa3, 0x58, 0x3f, 0xd3, 0xba, 0x01, 0x90, 0xa4, 0x7e, 0x9c, 0x8a, 0x74,
-	0x4b, 0x19, 0xa0, 0xcb, 0x10, 0x1c, 0x23, 0x0f, 0x07, 0xa2, 0x56, 0xec, 0x8d, 0x2e, 0x17, 0x7c,
-	0x08, 0x79, 0x7c, 0xb2, 0x2c, 0xf1, 0xeb, 0x22, 0xd3, 0x0d, 0x7d, 0x05, 0x7e, 0x46, 0x7a, 0x5d,
-	0x9c, 0x4b, 0x72, 0xa8, 0xfb, 0x67, 0x0e, 0x2c, 0x67, 0x83, 0xdc, 0x43, 0xd0, 0xd6, 0x0e, 0xd2,
-	0xfc, 0x66, 0xda, 0x41, 0x85, 0x0e, 0x03, 0xb4, 0xc7, 0x72, 0x6c, 0x06, 0x42, 0x12, 0x2b, 0x4b,
-	0xd1, 0x54, 0x39, 0x38, 0x26, 0x24, 0xb2, 0x46, 0xd0, 0x13, 0x90, 0x5e, 0x8d, 0x2c, 0x51, 0xb6,
-	0xec, 0x38, 0xa5, 0xaf, 0xe6, 0xc5, 0xc1, 0x4c, 0x16, 0x95, 0x29, 0x5d, 0x20, 0x14, 0x7f, 0xba,
-	0xdf, 0x71, 0xe0, 0x5a, 0xc9, 0xe2, 0x4a, 0xc9, 0xd8, 0x85, 0x95, 0x13, 0x5d, 0xa9, 0x16, 0x40,
-	0x88, 0xc7, 0x86, 0xba, 0xdb, 0xb1, 0x27, 0xed, 0x15, 0x3f, 0xd0, 0xbe, 0x8f, 0x58, 0x52, 0x2b,
-	0x79, 0xab, 0x58, 0xb1, 0xf5, 0x3b, 0x73, 0xb0, 0x24, 0xee, 0xfc, 0xc4, 0x83, 0x69, 0x1e, 0xb3,
-	0xf7, 0x60, 0x41, 0x3e, 0x78, 0x67, 0xeb, 0xb2, 0x5b, 0xfb, 0x89, 0x7d, 0x77, 0x23, 0x0f, 0x4b,
-	0xde, 0x59, 0xfd, 0xd5, 0x1f, 0xfd, 0xd3, 0xef, 0x56, 0x16, 0x59, 0xf3, 0xee, 0xd9, 0x9b, 0x77,
-	0x87, 0x3c, 0x4c, 0xb0, 0x8d, 0x5f, 0x00, 0xc8, 0x9e, 0x82, 0xb3, 0x8e, 0xf6, 0xd9, 0x72, 0x6f,
-	0xdc, 0xbb, 0xd7, 0x4a, 0x6a, 0x64, 0xbb, 0xd7, 0xa8, 0xdd, 0x55, 0x77, 0x09, 0xdb, 0x0d, 0xc2,
-	0x20, 0x15, 0xef, 0xc2, 0xdf, 0x71, 0x36, 0xd9, 0x00, 0x5a, 0xe6, 0x4b, 0x6f, 0xa6, 0x42, 0x37,
-	0x25, 0xef, 0xcc, 0xbb, 0x2f, 0x95, 0xd6, 0xa9, 0xb8, 0x15, 0xf5, 0xb1, 0xee, 0xb6, 0xb1, 0x8f,
-	0x29, 0x51, 0x64, 0xbd, 0x8c, 0x60, 0xc9, 0x7e, 0xd0, 0xcd, 0xae, 0x1b, 0x62, 0x5d, 0x78, 0x4e,
-	0xde, 0x7d, 0xf9, 0x82, 0x5a, 0xd9, 0xd7, 0xcb, 0xd4, 0xd7, 0x55, 0x97, 0x61, 0x5f, 0x7d, 0xa2,
-	0x51, 0xcf, 0xc9, 0xdf, 0x71, 0x36, 0xb7, 0x7e, 0x7c, 0x03, 0x1a, 0x3a, 0xd8, 0xca, 0xbe, 0x01,
-	0x8b, 0xd6, 0xa5, 0x2c, 0x53, 0xd3, 0x28, 0xbb, 0xc3, 0xed, 0x5e, 0x2f, 0xaf, 0x94, 0x1d, 0xdf,
-	0xa0, 0x8e, 0x3b, 0x6c, 0x03, 0x3b, 0x96, 0xb7, 0x9a, 0x77, 0xe9, 0x2a, 0x5a, 0xe4, 0xe2, 0x3e,
-	0x13, 0xf3, 0xcc, 0x2e, 0x52, 0xad, 0x79, 0x16, 0x2e, 0x5e, 0xad, 0x79, 0x16, 0x6f, 0x5f, 0xdd,
-	0xeb, 0xd4, 0xdd, 0x06, 0x5b, 0x33, 0xbb, 0xd3, 0x41, 0x50, 0x4e, 0xd9, 0xd3, 0xe6, 0x7b, 0x6f,
-	0xf6, 0xb2, 0x66, 0xac, 0xb2, 0x77, 0xe0, 0x9a, 0x45, 0x8a, 0x8f, 0xc1, 0xdd, 0x0e, 0x75, 0xc5,
-	0x18, 0x6d, 0x9f, 0xf9, 0xdc, 0x9b, 0x7d, 0x0d, 0x1a, 0xfa, 0x71, 0x23, 0xbb, 0x6a, 0xbc, 0x28,
-	0x35, 0x5f, 0x5c, 0x76, 0x3b, 0xc5, 0x8a, 0x32, 0xc6, 0x30, 0x5b, 0x46, 0xc6, 0x38, 0x80, 0x75,
-	0x79, 0x06, 0x38, 0xe6, 0x3f, 0xc9, 0x4c, 0x4a, 0x5e, 0xa9, 0xdf, 0x73, 0xd8, 0xbb, 0x50, 0x57,
-	0x6f, 0x46, 0xd9, 0x46, 0xf9, 0xdb, 0xd7, 0xee, 0xd5, 0x02, 0x2e, 0xb5, 0xc7, 0x57, 0x00, 0xb2,
-	0xb7, 0x90, 0x5a, 0xce, 0x0a, 0xaf, 0x30, 0xf5, 0x22, 0x16, 0x1f, 0x4e, 0xba, 0x1b, 0x34, 0xd5,
-	0x36, 0x23, 0x39, 0x0b, 0xf9, 0xb9, 0x4a, 0xfb, 0xdf, 0x85, 0xa6, 0xf1, 0x1c, 0x92, 0xa9, 0x16,
-	0x8a, 0x4f, 0x29, 0xbb, 0xdd, 0xb2, 0x2a, 0x39, 0xc0, 0x2f, 0xc0, 0xa2, 0xf5, 0xae, 0x51, 0x33,
-	0x72, 0xd9, 0xab, 0x49, 0xcd, 0xc8, 0xe5, 0x4f, 0x21, 0xbf, 0x0a, 0x4d, 0xe3, 0x15, 0x22, 0x33,
-	0x92, 0x0d, 0x73, 0xef, 0x0f, 0xf5, 0x88, 0xca, 0x1e, 0x2d, 0xae, 0xd1, 0x7c, 0x97, 0xdc, 0x06,
-	0xce, 0x97, 0x72, 0xdf, 0x71, 0x4f, 0xbf, 0x01, 0x4b, 0xf6, 0xbb, 0x44, 0x2d, 0x04, 0xa5, 0x2f,
-	0x1c, 0xb5, 0x10, 0x5c, 0xf0, 0x98, 0x51, 0xf2, 0xcf, 0xe6, 0xaa, 0xee, 0xe4, 0xee, 0x47, 0xf2,
-	0x9e, 0xf1, 0x63, 0xf6, 0x25, 0x94, 0x74, 0xf9, 0x18, 0x81, 0x65, 0xaf, 0x31, 0xed, 0x27, 0x0b,
-	0x9a, 0x39, 0x0b, 0xef, 0x16, 0xdc, 0x15, 0x6a, 0xbc, 0xc9, 0xb2, 0x19, 0x08, 0xf5, 0x4d, 0x8f,
-	0x12, 0x0c, 0xf5, 0x6d, 0xbe, 0x5b, 0x30, 0xd4, 0xb7, 0xf5, 0x76, 0x21, 0xaf, 0xbe, 0xd3, 0x00,
-	0xdb, 0x08, 0x61, 0x39, 0x97, 0x6d, 0xa3, 0x79, 0xbb, 0x3c, 0x3d, 0xb1, 0x7b, 0xe3, 0xf9, 0x49,
-	0x3a, 0xb6, 0x56, 0x50, 0xda, 0xe0, 0xae, 0xca, 0x26, 0xfd, 0x45, 0x68, 0x99, 0xef, 0xc9, 0xb4,
-	0x42, 0x2f, 0x79, 0x05, 0xa7, 0x15, 0x7a, 0xd9, 0x03, 0x34, 0xb5, 0xb9, 0xac, 0x65, 0x76, 0x83,
-	0x9b, 0x6b, 0x3f, 0xa8, 0xc9, 0x34, 0x5c, 0xd9, 0x3b, 0xa2, 0x4c, 0xc3, 0x95, 0xbe, 0xc2, 0x51,
-	0x9b, 0xcb, 0x56, 0xad, 0xb9, 0x88, 0x90, 0x30, 0xfb, 0x2a, 0x2c, 0x1b, 0xa9, 0x6c, 0x47, 0xb3,
-	0xb0, 0xaf, 0x19, 0xb5, 0x98, 0x34, 0xdd, 0x2d, 0x73, 0x14, 0xdd, 0xab, 0xd4, 0xfe, 0x8a, 0x6b,
-	0x4d, 0x02, 0x99, 0x74, 0x07, 0x9a, 0x66, 0x9a, 0xdc, 0x73, 0xda, 0xbd, 0x6a, 0x54, 0x99, 0x39,
-	0xbf, 0xf7, 0x1c, 0xf6, 0x07, 0x0e, 0xb4, 0xac, 0xa4, 0x33, 0xeb, 0xe2, 0x23, 0xd7, 0x4e, 0xc7,
-	0xac, 0x33, 0x1b, 0x72, 0x3d, 0x1a, 0xe4, 0xc1, 0xe6, 0x17, 0xac, 0x45, 0xf8, 0xc8, 0x3a, 0x70,
-	0xdc, 0xc9, 0xff, 0xf5, 0xc0, 0xc7, 0x79, 0x02, 0x33, 0xb1, 0xfc, 0xe3, 0x7b, 0x0e, 0xfb, 0x81,
-	0x03, 0x4b, 0xf6, 0x31, 0x59, 0x6f, 0x55, 0xe9, 0x81, 0x5c, 0x6f, 0xd5, 0x05, 0x67, 0xeb, 0xaf,
-	0xd2, 0x28, 0x9f, 0x6e, 0x7a, 0xd6, 0x28, 0xe5, 0x53, 0xab, 0x9f, 0x6d, 0xb4, 0xec, 0x1d, 0xf1,
-	0x47, 0x20, 0x2a, 0x76, 0xc3, 0x0c, 0x1d, 0x9d, 0xdf, 0x5e, 0xf3, 0x5f, 0x30, 0x6e, 0x3b, 0xf7,
-	0x1c, 0xf6, 0x75, 0xf1, 0x6f, 0x08, 0xf2, 0x5b, 0xe2, 0x92, 0x17, 0xfd, 0xde, 0x7d, 0x8d, 0xe6,
-	0x74, 0xc3, 0xbd, 0x66, 0xcd, 0x29, 0x6f, 0xa4, 0xb6, 0xc5, 0xe8, 0xe4, 0x9f, 0x5c, 0x64, 0xea,
-	0xbb, 0xf0, 0xc7, 0x17, 0x17, 0x0f, 0x72, 0x2c, 0x06, 0x29, 0xc9, 0x2d, 0x56, 0x7e, 0xc1, 0x66,
-	0xdc, 0x4d, 0x1a, 0xeb, 0x6b, 0xee, 0x2b, 0x17, 0x8e, 0xf5, 0x2e, 0x1d, 0x76, 0x71, 0xc4, 0x87,
-	0x00, 0x59, 0x9c, 0x95, 0xe5, 0xe2, 0x7c, 0xda, 0x82, 0x15, 0x43, 0xb1, 0xb6, 0xbc, 0xa8, 0x70,
-	0x20, 0xb6, 0xf8, 0x35, 0xa1, 0x56, 0x1e, 0xa9, 0x08, 0xe1, 0x35, 0x43, 0x75, 0xd8, 0x01, 0xd1,
-	0x6e, 0xb7, 0xac, 0xaa, 0x4c, 0xa9, 0xe8, 0x70, 0xe3, 0xfb, 0xb0, 0x78, 0x10, 0x45, 0xcf, 0xa6,
-	0x13, 0x7d, 0x6b, 0x61, 0xc7, 0xa1, 0xf6, 0xfd, 0xe4, 0xb4, 0x9b, 0x9b, 0x85, 0x7b, 0x93, 0x9a,
-	0xea, 0xb2, 0x8e, 0xd1, 0xd4, 0xdd, 0x8f, 0xb2, 0x38, 0xee, 0xc7, 0xcc, 0x87, 0x15, 0xed, 0x5c,
-	0xe8, 0x81, 0x77, 0xed, 0x66, 0xcc, 0x08, 0x64, 0xa1, 0x0b, 0xcb, 0xdd, 0x53, 0xa3, 0xbd, 0x9b,
-	0xa8, 0x36, 0xef, 0x39, 0xec, 0x10, 0x5a, 0xbb, 0xbc, 0x1f, 0x0d, 0xb8, 0x0c, 0xe6, 0xac, 0x66,
-	0x03, 0xd7, 0x51, 0xa0, 0xee, 0xa2, 0x05, 0xda, 0xfa, 0x7b, 0xe2, 0xcf, 0x62, 0xfe, 0xcd, 0xbb,
-	0x1f, 0xc9, 0x30, 0xd1, 0xc7, 0x4a, 0x7f, 0xab, 0x38, 0x9a, 0xa5, 0xbf, 0x73, 0x81, 0x37, 0x4b,
-	0x7f, 0x17, 0x02, 0x6f, 0xd6, 0x52, 0xab, 0x38, 0x1e, 0x1b, 0xc1, 0x4a, 0x21, 0x56, 0xc7, 0x5e,
-	0x51, 0x16, 0xf8, 0x82, 0x08, 0x5f, 0xf7, 0xe6, 0xc5, 0x04, 0x76, 0x6f, 0x9b, 0x76, 0x6f, 0x47,
-	0xb0, 0xb8, 0xcb, 0xc5, 0x62, 0x89, 0xd4, 0x88, 0xdc, 0x1b, 0x4b, 0x33, 0xf1, 0x22, 0xaf, 0xc0,
-	0xa9, 0xce, 0x36, 0xd0, 0x94, 0x97, 0xc0, 0xbe, 0x06, 0xcd, 0x87, 0x3c, 0x55, 0xb9, 0x10, 0xda,
-	0xd1, 0xcb, 0x25, 0x47, 0x74, 0x4b, 0x52, 0x29, 0x6c, 0x9e, 0xa1, 0xd6, 0xee, 0xf2, 0xc1, 0x90,
-	0x0b, 0xe5, 0xd4, 0x0b, 0x06, 0x1f, 0xb3, 0xff, 0x4f, 0x8d, 0xeb, 0x64, 0xac, 0x0d, 0xe3, 0x0a,
-	0xdd, 0x6c, 0x7c, 0x39, 0x87, 0x97, 0xb5, 0x1c, 0x46, 0x03, 0x6e, 0xb8, 0x2a, 0x21, 0x34, 0x8d,
-	0x1c, 0x42, 0x2d, 0x40, 0xc5, 0x7c, 0x48, 0x2d, 0x40, 0x25, 0x29, 0x87, 0xee, 0x6d, 0xea, 0xc7,
-	0x65, 0x37, 0xb3, 0x7e, 0x44, 0x9a, 0x61, 0xd6, 0xd3, 0xdd, 0x8f, 0xfc, 0x71, 0xfa, 0x31, 0xfb,
-	0x80, 0xde, 0x5b, 0x9a, 0xf9, 0x1e, 0x99, 0xe7, 0x9a, 0x4f, 0x0d, 0xd1, 0x8b, 0x65, 0x54, 0xd9,
-	0xde, 0xac, 0xe8, 0x8a, 0x3c, 0x9a, 0xcf, 0x00, 0x1c, 0xa5, 0xd1, 0x64, 0xd7, 0xe7, 0xe3, 0x28,
-	0xcc, 0x74, 0x6d, 0x96, 0xd3, 0x90, 0xe9, 0x2f, 0x23, 0xb1, 0x81, 0x7d, 0x60, 0xb8, 0xfa, 0x56,
-	0xba, 0x8c, 0x62, 0xae, 0x0b, 0xd3, 0x1e, 0xf4, 0x82, 0x94, 0xa4, 0x3e, 0xdc, 0x73, 0xd8, 0x36,
-	0x40, 0x16, 0xac, 0xd5, 0x8e, 0x7b, 0x21, 0x0e, 0xac, 0xd5, 0x5e, 0x49, 0x64, 0xf7, 0x10, 0x1a,
-	0x59, 0xf4, 0xef, 0x6a, 0x96, 0x07, 0x6a, 0xc5, 0x0a, 0xb5, 0x05, 0x2f, 0xc4, 0xe4, 0xdc, 0x36,
-	0x2d, 0x15, 0xb0, 0x3a, 0x2e, 0x15, 0x05, 0xda, 0x02, 0x58, 0x15, 0x03, 0xd4, 0xee, 0x08, 0xdd,
-	0xd2, 0xab, 0x99, 0x94, 0xc4, 0xc5, 0xb4, 0x34, 0x97, 0x86, 0x95, 0xac, 0x23, 0x3c, 0x72, 0xab,
-	0xc8, 0x10, 0x40, 0xd5, 0x3c, 0x86, 0x95, 0x42, 0x4c, 0x44, 0x8b, 0xf4, 0x45, 0xa1, 0x28, 0x2d,
-	0xd2, 0x17, 0x86, 0x53, 0xdc, 0x75, 0xea, 0x72, 0xd9, 0x05, 0xec, 0x32, 0x39, 0x0f, 0xd2, 0xfe,
-	0xe9, 0x3b, 0xce, 0xe6, 0xf1, 0x3c, 0xfd, 0x71, 0xe0, 0xa7, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x6a, 0x4a, 0x26, 0x19, 0x6a, 0x50, 0x00, 0x00,
+	// 10824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x5f, 0x6c, 0x24, 0x49,
+	0x9a, 0x57, 0x67, 0xfd, 0xb1, 0x5d, 0x5f, 0x55, 0xd9, 0xe5, 0xb0, 0xdb, 0x5d, 0x5d, 0xfd, 0x67,
+	0x3c, 0x39, 0xb3, 0x33, 0x4d, 0xef, 0x5c, 0xbb, 0xa7, 0x67, 0x76, 0x98, 0x9d, 0x99, 0xdb, 0x5d,
+	0xb7, 0x5d, 0xdd, 0xf6, 0x8e, 0xdb, 0xf6, 0xa6, 0xdd, 0xd3, 0xbb, 0x7b, 0xc7, 0xe5, 0xa5, 0xab,
+	0xc2, 0xe5, 0xdc, 0xae, 0xca, 0xac, 0xcd, 0xcc, 0xb2, 0xc7, 0xbb, 0x8c, 0x04, 0xdc, 0xc1, 0x89,
+	0xe3, 0x56, 0xa7, 0x13, 0x48, 0x27, 0x40, 0x08, 0x69, 0xe1, 0x81, 0x3b, 0x5e, 0x40, 0x82, 0x7b,
+	0x00, 0x5e, 0x40, 0x20, 0x10, 0x08, 0x90, 0xb8, 0x7b, 0xe1, 0x1e, 0x78, 0x42, 0x42, 0xfc, 0x91,
+	0x0e, 0x9d, 0x84, 0x10, 0x20, 0x10, 0xfa, 0xe2, 0x5f, 0x46, 0x64, 0x66, 0xd9, 0x9e, 0xbd, 0x39,
+	0x78, 0xb1, 0x2b, 0x7e, 0xdf, 0x17, 0xff, 0x23, 0xbe, 0xf8, 0xe2, 0x8b, 0x2f, 0x22, 0xa1, 0x16,
+	0x8d, 0x7b, 0x0f, 0xc6, 0x51, 0x98, 0x84, 0xa4, 0x3a, 0x0c, 0xa2, 0x71, 0xaf, 0x73, 0x7b, 0x10,
+	0x86, 0x83, 0x21, 0x5d, 0xf3, 0xc6, 0xfe, 0x9a, 0x17, 0x04, 0x61, 0xe2, 0x25, 0x7e, 0x18, 0xc4,
+	0x9c, 0xc9, 0xfe, 0x79, 0x98, 0x7f, 0x4a, 0x83, 0x03, 0x4a, 0xfb, 0x0e, 0xfd, 0xfe, 0x84, 0xc6,
+	0x09, 0xf9, 0x32, 0x2c, 0x7a, 0xf4, 0x07, 0x94, 0xf6, 0xdd, 0xb1, 0x17, 0xc7, 0xe3, 0x93, 0xc8,
+	0x8b, 0x69, 0xdb, 0x5a, 0xb5, 0xee, 0x35, 0x9c, 0x16, 0x27, 0xec, 0x2b, 0x9c, 0xbc, 0x0a, 0x8d,
+	0x18, 0x59, 0x69, 0x90, 0x44, 0xe1, 0xf8, 0xbc, 0x5d, 0x62, 0x7c, 0x75, 0xc4, 0xba, 0x1c, 0xb2,
+	0x87, 0xb0, 0xa0, 0x72, 0x88, 0xc7, 0x61, 0x10, 0x53, 0xf2, 0x10, 0x96, 0x7b, 0xfe, 0xf8, 0x84,
+	0x46, 0x2e, 0x8b, 0x3c, 0x0a, 0xe8, 0x28, 0x0c, 0xfc, 0x5e, 0xdb, 0x5a, 0x2d, 0xdf, 0xab, 0x39,
+	0x84, 0xd3, 0x30, 0xc6, 0x33, 0x41, 0x21, 0x6f, 0xc2, 0x02, 0x0d, 0x38, 0x4e, 0xfb, 0x2c, 0x96,
+	0xc8, 0x6a, 0x3e, 0x85, 0x31, 0x82, 0xfd, 0x8f, 0x2d, 0x58, 0xdc, 0x0e, 0xfc, 0xe4, 0x85, 0x37,
+	0x1c, 0xd2, 0x44, 0xd6, 0xe9, 0x4d, 0x58, 0x38, 0x63, 0x00, 0xab, 0xd3, 0x59, 0x18, 0xf5, 0x45,
+	0x8d, 0xe6, 0x39, 0xbc, 0x2f, 0xd0, 0xa9, 0x25, 0x2b, 0x4d, 0x2d, 0x59, 0x61, 0x73, 0x95, 0xa7,
+	0x34, 0xd7, 0x9b, 0xb0, 0x10, 0xd1, 0x5e, 0x78, 0x4a, 0xa3, 0x73, 0xf7, 0xcc, 0x0f, 0xfa, 0xe1,
+	0x59, 0xbb, 0xb2, 0x6a, 0xdd, 0xab, 0x3a, 0xf3, 0x12, 0x7e, 0xc1, 0x50, 0x7b, 0x19, 0x88, 0x5e,
+	0x0b, 0xde, 0x6e, 0xf6, 0x00, 0x96, 0x9e, 0x07, 0xc3, 0xb0, 0xf7, 0xf2, 0x27, 0xac, 0x5d, 0x41,
+	0xf6, 0xa5, 0xc2, 0xec, 0x57, 0x60, 0xd9, 0xcc, 0x48, 0x14, 0x80, 0xc2, 0xf5, 0x8d, 0x13, 0x2f,
+	0x18, 0x50, 0x99, 0xa4, 0x2c, 0xc2, 0x1f, 0x81, 0x56, 0x6f, 0x12, 0x45, 0x34, 0xc8, 0x95, 0x61,
+	0x41, 0xe0, 0xaa, 0x10, 0xaf, 0x42, 0x23, 0xa0, 0x67, 0x29, 0x9b, 0x18, 0x32, 0x01, 0x3d, 0x93,
+	0x2c, 0x76, 0x1b, 0x56, 0xb2, 0xd9, 0x88, 0x02, 0xfc, 0x87, 0x12, 0xd4, 0x0f, 0x23, 0x2f, 0x88,
+	0xbd, 0x1e, 0x8e, 0x62, 0xd2, 0x86, 0xd9, 0xe4, 0x53, 0xf7, 0xc4, 0x8b, 0x4f, 0x58, 0x76, 0x35,
+	0x47, 0x06, 0xc9, 0x0a, 0xcc, 0x78, 0xa3, 0x70, 0x12, 0x24, 0x2c, 0x83, 0xb2, 0x23, 0x42, 0xe4,
+	0x2d, 0x58, 0x0c, 0x26, 0x23, 0xb7, 0x17, 0x06, 0xc7, 0x7e, 0x34, 0xe2, 0x73, 0x81, 0xf5, 0x57,
+	0xd5, 0xc9, 0x13, 0xc8, 0x5d, 0x80, 0x23, 0x6c, 0x07, 0x9e, 0x45, 0x85, 0x65, 0xa1, 0x21, 0xc4,
+	0x86, 0x86, 0x08, 0x51, 0x7f, 0x70, 0x92, 0xb4, 0xab, 0x2c, 0x21, 0x03, 0xc3, 0x34, 0x12, 0x7f,
+	0x44, 0xdd, 0x38, 0xf1, 0x46, 0xe3, 0xf6, 0x0c, 0x2b, 0x8d, 0x86, 0x30, 0x7a, 0x98, 0x78, 0x43,
+	0xf7, 0x98, 0xd2, 0xb8, 0x3d, 0x2b, 0xe8, 0x0a, 0x21, 0x6f, 0xc0, 0x7c, 0x9f, 0xc6, 0x89, 0xeb,
+	0xf5, 0xfb, 0x11, 0x8d, 0x63, 0x1a, 0xb7, 0xe7, 0xd8, 0x68, 0xcc, 0xa0, 0x64, 0x19, 0xaa, 0x43,
+	0xef, 0x88, 0x0e, 0xdb, 0x35, 0x56, 0x4c, 0x1e, 0x20, 0xef, 0xc1, 0x5c, 0xcf, 0x4b, 0xe8, 0x20,
+	0x8c, 0xce, 0xdb, 0xb0, 0x6a, 0xdd, 0x9b, 0x7f, 0xd4, 0x79, 0xc0, 0x04, 0xc3, 0x03, 0xad, 0x1d,
+	0x37, 0x04, 0x87, 0xa3, 0x78, 0xed, 0xff, 0x63, 0xc1, 0xca, 0x53, 0x9a, 0x68, 0x4c, 0xb1, 0xec,
+	0xec, 0x0f, 0x00, 0x04, 0x9b, 0x4f, 0x63, 0x36, 0x69, 0x2f, 0x4e, 0x54, 0xe3, 0xc6, 0x06, 0x8b,
+	0x13, 0x2f, 0x4a, 0x64, 0x83, 0xf1, 0xf1, 0x67, 0x60, 0xd8, 0x20, 0x34, 0xe8, 0x4b, 0x0e, 0xde,
+	0x37, 0x1a, 0x92, 0x56, 0xb4, 0xa2, 0x57, 0xd4, 0x86, 0x86, 0x1f, 0xf4, 0xe9, 0xa7, 0x6e, 0x78,
+	0x7c, 0x1c, 0x53, 0xde, 0x15, 0x4d, 0xc7, 0xc0, 0xc8, 0x7d, 0x68, 0x8d, 0xbc, 0x4f, 0xdd, 0x44,
+	0xab, 0x14, 0xeb, 0x90, 0xa6, 0x93, 0xc3, 0xed, 0xdf, 0xb4, 0x80, 0x68, 0xb5, 0xd9, 0xa4, 0x89,
+	0xe7, 0x0f, 0x63, 0xf2, 0x1e, 0x34, 0x8c, 0xe8, 0x58, 0xfd, 0xfa, 0x23, 0x92, 0xaf, 0xbe, 0x63,
+	0xf0, 0xe1, 0xb8, 0x1b, 0x7a, 0x71, 0xe2, 0x1a, 0x65, 0x2c, 0xb1, 0xbc, 0xf3, 0x04, 0xf2, 0x00,
+	0x08, 0x1f, 0x01, 0x46, 0x5e, 0x65, 0xc6, 0x5e, 0x40, 0xb1, 0x37, 0xe0, 0xc6, 0x0e, 0xb6, 0x82,
+	0x9e, 0xbf, 0xe8, 0x2d, 0x02, 0x95, 0xe4, 0x53, 0xbf, 0x2f, 0xe6, 0x07, 0xfb, 0x9d, 0xb6, 0x60,
+	0x49, 0x6b, 0x41, 0xbb, 0x03, 0xed, 0x7c, 0x22, 0x62, 0xe2, 0x3d, 0x85, 0xb9, 0x27, 0x94, 0xee,
+	0xf8, 0x23, 0x3f, 0x21, 0x2b, 0x50, 0x3d, 0xf6, 0x3f, 0xa5, 0x3c, 0xc9, 0xf2, 0xd6, 0x35, 0x87,
+	0x07, 0x49, 0x07, 0x66, 0xc7, 0x34, 0xea, 0x51, 0x39, 0xe7, 0xb6, 0xae, 0x39, 0x12, 0x78, 0x3c,
+	0x0b, 0xd5, 0x21, 0x46, 0xb6, 0xff, 0x46, 0x09, 0xea, 0x07, 0x34, 0xe8, 0x6b, 0xc5, 0xc3, 0x71,
+	0x2c, 0xa4, 0x05, 0xfb, 0x4d, 0x5e, 0x81, 0x3a, 0xfe, 0x77, 0xe3, 0x24, 0xf2, 0x83, 0x81, 0x28,
+	0x24, 0x20, 0x74, 0xc0, 0x10, 0xd2, 0x82, 0xb2, 0x37, 0xe2, 0x43, 0xa3, 0xec, 0xe0, 0x4f, 0x94,
+	0x2a, 0x63, 0xef, 0x7c, 0x84, 0x02, 0x48, 0x4d, 0xd5, 0x86, 0x53, 0x17, 0xd8, 0x16, 0xce, 0xd5,
+	0x07, 0xb0, 0xa4, 0xb3, 0xc8, 0xd4, 0xab, 0x2c, 0xf5, 0x45, 0x8d, 0x53, 0x64, 0xf2, 0x26, 0x2c,
+	0x48, 0xfe, 0x88, 0x17, 0x96, 0x8d, 0x95, 0x9a, 0x33, 0x2f, 0x60, 0x59, 0x85, 0x7b, 0xd0, 0x3a,
+	0xf6, 0x03, 0x6f, 0xe8, 0xf6, 0x86, 0xc9, 0xa9, 0xdb, 0xa7, 0xc3, 0xc4, 0x63, 0xd3, 0xb8, 0xea,
+	0xcc, 0x33, 0x7c, 0x63, 0x98, 0x9c, 0x6e, 0x22, 0x4a, 0xde, 0x82, 0xda, 0x31, 0xa5, 0x2e, 0x6b,
+	0x89, 0xf6, 0xdc, 0xaa, 0x75, 0xaf, 0xfe, 0x68, 0x41, 0x8c, 0x1c, 0xd9, 0xba, 0xce, 0xdc, 0xb1,
+	0xf8, 0x65, 0xff, 0x05, 0x0b, 0x1a, 0xbc, 0xa9, 0xc4, 0xba, 0xf9, 0x3a, 0x34, 0x65, 0x89, 0x68,
+	0x14, 0x85, 0x91, 0xe8, 0x53, 0x13, 0xc4, 0x41, 0x2e, 0x81, 0x71, 0x44, 0xfd, 0x91, 0x37, 0xa0,
+	0x42, 0xc8, 0xe6, 0x70, 0xf2, 0x28, 0x4d, 0x31, 0x0a, 0x27, 0x09, 0x5f, 0xb9, 0xea, 0x8f, 0x1a,
+	0xa2, 0x50, 0x0e, 0x62, 0x8e, 0xc9, 0x62, 0xff, 0xc8, 0x02, 0x82, 0xc5, 0x3a, 0x0c, 0x39, 0x59,
+	0xb4, 0x42, 0xb6, 0x07, 0xac, 0x2b, 0xf7, 0x40, 0x69, 0x5a, 0x0f, 0xbc, 0x0e, 0x33, 0x2c, 0x4b,
+	0x1c, 0xf9, 0xe5, 0x5c, 0xb1, 0x04, 0xcd, 0xfe, 0xb1, 0x05, 0x0d, 0x5c, 0x2e, 0x02, 0x3a, 0xdc,
+	0x0f, 0xfd, 0x20, 0x21, 0x0f, 0x81, 0x1c, 0x4f, 0x82, 0xbe, 0x1f, 0x0c, 0x5c, 0x1c, 0xed, 0xee,
+	0xd1, 0x79, 0xc2, 0xe4, 0x94, 0x75, 0xaf, 0xb1, 0x75, 0xcd, 0x29, 0xa0, 0x91, 0xb7, 0xa0, 0x65,
+	0xa0, 0x71, 0x12, 0xf1, 0x52, 0x6d, 0x5d, 0x73, 0x72, 0x14, 0x94, 0x34, 0xe1, 0x24, 0x19, 0x4f,
+	0xc4, 0x9c, 0x15, 0xd3, 0xd2, 0xc0, 0x1e, 0xcf, 0x43, 0x43, 0x8f, 0x67, 0x7f, 0x0d, 0x5a, 0x3b,
+	0x28, 0xbc, 0x02, 0x3f, 0x18, 0xac, 0x73, 0x91, 0x8d, 0x4b, 0xd4, 0x78, 0x72, 0xf4, 0x92, 0x9e,
+	0x8b, 0x7e, 0x14, 0x21, 0x9c, 0x12, 0x27, 0x61, 0x9c, 0x88, 0x76, 0x61, 0xbf, 0xed, 0xff, 0x61,
+	0xc1, 0x02, 0x36, 0xfa, 0x33, 0x2f, 0x38, 0x97, 0x2d, 0xbe, 0x03, 0x0d, 0x4c, 0xea, 0x30, 0x5c,
+	0xe7, 0x0b, 0x1d, 0x17, 0x45, 0xf7, 0x44, 0x23, 0x65, 0xb8, 0x1f, 0xe8, 0xac, 0xa8, 0x9b, 0x9d,
+	0x3b, 0x46, 0x6c, 0x9c, 0x74, 0x89, 0x17, 0x0d, 0x68, 0xc2, 0x96, 0x40, 0x29, 0x76, 0x39, 0xb4,
+	0x11, 0x06, 0xc7, 0x64, 0x15, 0x1a, 0xb1, 0x97, 0xb8, 0x63, 0x1a, 0xb1, 0x56, 0x63, 0x13, 0xa7,
+	0xec, 0x40, 0xec, 0x25, 0xfb, 0x34, 0x7a, 0x7c, 0x9e, 0xd0, 0x54, 0xac, 0xcc, 0x68, 0x62, 0xa5,
+	0xf3, 0x75, 0x58, 0xcc, 0xe5, 0x8d, 0x33, 0x38, 0xad, 0x38, 0xfe, 0xc4, 0xc8, 0xa7, 0xde, 0x70,
+	0x42, 0xc5, 0x7a, 0xcd, 0x03, 0x1f, 0x94, 0xde, 0xb7, 0xec, 0x37, 0xa0, 0x95, 0x56, 0x46, 0x4c,
+	0x85, 0x02, 0xa9, 0x66, 0xff, 0xba, 0xc5, 0x19, 0x37, 0x42, 0x3f, 0x5d, 0xac, 0x08, 0x54, 0x70,
+	0x89, 0x94, 0x8c, 0xf8, 0x7b, 0xaa, 0x6e, 0xf0, 0x87, 0xd5, 0x04, 0xf6, 0x9b, 0xb0, 0xa8, 0x15,
+	0xec, 0x82, 0x2a, 0xfc, 0xc8, 0x82, 0xc5, 0x5d, 0x7a, 0x26, 0x46, 0x88, 0xac, 0xc3, 0xfb, 0x50,
+	0x49, 0xce, 0xc7, 0x5c, 0x0b, 0x9f, 0x7f, 0xf4, 0xba, 0xe8, 0xe0, 0x1c, 0xdf, 0x03, 0x11, 0x3c,
+	0x3c, 0x1f, 0x53, 0x87, 0xc5, 0xb0, 0xbf, 0x06, 0x75, 0x0d, 0x24, 0x37, 0x60, 0xe9, 0xc5, 0xf6,
+	0xe1, 0x6e, 0xf7, 0xe0, 0xc0, 0xdd, 0x7f, 0xfe, 0xf8, 0xe3, 0xee, 0x77, 0xdc, 0xad, 0xf5, 0x83,
+	0xad, 0xd6, 0x35, 0xb2, 0x02, 0x64, 0xb7, 0x7b, 0x70, 0xd8, 0xdd, 0x34, 0x70, 0xcb, 0x7e, 0x00,
+	0x44, 0xcf, 0x46, 0x94, 0xbc, 0x0d, 0xb3, 0x42, 0xed, 0x90, 0x5a, 0x97, 0x08, 0xda, 0x6f, 0x00,
+	0x39, 0xf0, 0x07, 0xc1, 0x33, 0x1a, 0xc7, 0xde, 0x40, 0x89, 0x86, 0x16, 0x94, 0x47, 0xf1, 0x40,
+	0x48, 0x04, 0xfc, 0x69, 0xbf, 0x03, 0x4b, 0x06, 0x9f, 0x48, 0xf8, 0x36, 0xd4, 0x62, 0x7f, 0x10,
+	0x78, 0xc9, 0x24, 0xa2, 0x22, 0xe9, 0x14, 0xb0, 0x9f, 0xc0, 0xf2, 0x27, 0x34, 0xf2, 0x8f, 0xcf,
+	0x2f, 0x4b, 0xde, 0x4c, 0xa7, 0x94, 0x4d, 0xa7, 0x0b, 0xd7, 0x33, 0xe9, 0x88, 0xec, 0xf9, 0x10,
+	0x14, 0x5d, 0x32, 0xe7, 0xf0, 0x80, 0x36, 0x4d, 0x4b, 0xfa, 0x34, 0xb5, 0x9f, 0x03, 0xd9, 0x08,
+	0x83, 0x80, 0xf6, 0x92, 0x7d, 0x4a, 0xa3, 0x74, 0xfb, 0x94, 0x8e, 0xb7, 0xfa, 0xa3, 0x1b, 0xa2,
+	0xaf, 0xb2, 0x73, 0x5f, 0x0c, 0x44, 0x02, 0x95, 0x31, 0x8d, 0x46, 0x2c, 0xe1, 0x39, 0x87, 0xfd,
+	0xb6, 0xaf, 0xc3, 0x92, 0x91, 0xac, 0x58, 0x80, 0xdf, 0x86, 0xeb, 0x9b, 0x7e, 0xdc, 0xcb, 0x67,
+	0xd8, 0x86, 0xd9, 0xf1, 0xe4, 0xc8, 0x4d, 0x67, 0x93, 0x0c, 0xa2, 0x1a, 0x9d, 0x8d, 0x22, 0x12,
+	0xfb, 0x33, 0x16, 0x54, 0xb6, 0x0e, 0x77, 0x36, 0x48, 0x07, 0xe6, 0xfc, 0xa0, 0x17, 0x8e, 0x50,
+	0x0c, 0xf3, 0x4a, 0xab, 0xf0, 0xd4, 0x59, 0x72, 0x1b, 0x6a, 0x4c, 0x7a, 0xa3, 0x8e, 0x2b, 0x76,
+	0x3a, 0x29, 0x80, 0x7a, 0x0e, 0xfd, 0x74, 0xec, 0x47, 0x4c, 0x81, 0x96, 0x3a, 0x5c, 0x85, 0xeb,
+	0x39, 0x39, 0x82, 0xfd, 0xbf, 0xaa, 0x30, 0x2b, 0x64, 0x37, 0xcb, 0xaf, 0x97, 0xf8, 0xa7, 0x54,
+	0x94, 0x44, 0x84, 0x70, 0xd5, 0x8b, 0xe8, 0x28, 0x4c, 0xa8, 0x6b, 0x74, 0x83, 0x09, 0x22, 0x57,
+	0x8f, 0x27, 0xe4, 0x8e, 0x71, 0x15, 0x60, 0x25, 0xab, 0x39, 0x26, 0x88, 0x8d, 0x85, 0x80, 0xeb,
+	0xf7, 0x59, 0x99, 0x2a, 0x8e, 0x0c, 0x62, 0x4b, 0xf4, 0xbc, 0xb1, 0xd7, 0xf3, 0x93, 0x73, 0x31,
+	0xad, 0x55, 0x18, 0xd3, 0x1e, 0x86, 0x3d, 0x6f, 0xe8, 0x1e, 0x79, 0x43, 0x2f, 0xe8, 0x51, 0xa1,
+	0xc4, 0x9b, 0x20, 0xea, 0xe9, 0xa2, 0x48, 0x92, 0x8d, 0xeb, 0xf2, 0x19, 0x14, 0xd5, 0xdb, 0x5e,
+	0x38, 0x1a, 0xf9, 0x09, 0xaa, 0xf7, 0x4c, 0x0b, 0x28, 0x3b, 0x1a, 0xc2, 0x6a, 0xc2, 0x43, 0x67,
+	0xbc, 0xf5, 0x6a, 0x3c, 0x37, 0x03, 0xc4, 0x54, 0x50, 0x95, 0x40, 0x51, 0xf4, 0xf2, 0x8c, 0x69,
+	0xf6, 0x65, 0x47, 0x43, 0xb0, 0x1f, 0x26, 0x41, 0x4c, 0x93, 0x64, 0x48, 0xfb, 0xaa, 0x40, 0x75,
+	0xc6, 0x96, 0x27, 0x90, 0x87, 0xb0, 0xc4, 0xb5, 0xca, 0xd8, 0x4b, 0xc2, 0xf8, 0xc4, 0x8f, 0xdd,
+	0x18, 0xd5, 0xb8, 0x06, 0xe3, 0x2f, 0x22, 0x91, 0xf7, 0xe1, 0x46, 0x06, 0x8e, 0x68, 0x8f, 0xfa,
+	0xa7, 0xb4, 0xdf, 0x6e, 0xb2, 0x58, 0xd3, 0xc8, 0x64, 0x15, 0xea, 0xb8, 0xd1, 0x9a, 0x8c, 0xfb,
+	0x1e, 0xae, 0xcb, 0xf3, 0xac, 0x1f, 0x74, 0x88, 0xbc, 0x0d, 0xcd, 0x31, 0xe5, 0x8b, 0xe7, 0x49,
+	0x32, 0xec, 0xc5, 0xed, 0x05, 0xb6, 0xb2, 0xd5, 0xc5, 0x64, 0xc2, 0x91, 0xeb, 0x98, 0x1c, 0x38,
+	0x28, 0x7b, 0x31, 0x53, 0xbe, 0xbc, 0xf3, 0x76, 0x8b, 0x0d, 0xb7, 0x14, 0x60, 0x73, 0x24, 0xf2,
+	0x4f, 0xbd, 0x84, 0xb6, 0x17, 0xd9, 0xd8, 0x92, 0x41, 0x8c, 0xf7, 0x03, 0x1a, 0x85, 0x5c, 0xe0,
+	0x13, 0x46, 0x4b, 0x01, 0x6c, 0x64, 0x6f, 0xe8, 0x7b, 0xb1, 0x1b, 0xf7, 0xfc, 0x7e, 0x7b, 0x89,
+	0x95, 0x54, 0x43, 0xc8, 0x4f, 0x43, 0x43, 0xf4, 0x4a, 0x9c, 0x78, 0x49, 0xdc, 0x5e, 0x66, 0x93,
+	0xfe, 0xa6, 0x28, 0xa7, 0x18, 0xd8, 0x1b, 0x8c, 0xe3, 0x00, 0x19, 0x1c, 0x83, 0xdd, 0xfe, 0x3b,
+	0x25, 0x20, 0x79, 0x26, 0xec, 0xba, 0x23, 0x2f, 0xe9, 0x9d, 0xb8, 0x7e, 0x90, 0xd0, 0xe8, 0xd4,
+	0x1b, 0xba, 0x23, 0x2e, 0x68, 0x2b, 0x4e, 0x9e, 0x40, 0xee, 0xc1, 0x82, 0x6c, 0x0a, 0xd9, 0xa4,
+	0x7c, 0x5b, 0x91, 0x85, 0x65, 0xc3, 0xf3, 0x22, 0xf0, 0xdd, 0x44, 0xc5, 0xd1, 0x21, 0x1c, 0x06,
+	0x18, 0x64, 0x99, 0xd0, 0xbe, 0x4a, 0x8f, 0x4f, 0x95, 0x22, 0x12, 0x79, 0x17, 0xae, 0x7b, 0xa7,
+	0x03, 0x91, 0x80, 0x3b, 0xf4, 0x12, 0x1a, 0xf4, 0xce, 0xdd, 0x49, 0xcc, 0xe6, 0x50, 0xc5, 0x29,
+	0x26, 0x92, 0x8f, 0xe0, 0x26, 0x12, 0x22, 0x7a, 0x1a, 0xf6, 0xb8, 0x3c, 0xd0, 0x62, 0xce, 0xb0,
+	0x98, 0xd3, 0x19, 0xec, 0xbf, 0x6a, 0xc1, 0xd2, 0x8e, 0x1f, 0x27, 0xa2, 0xe9, 0xd4, 0x32, 0xf9,
+	0x0a, 0xd4, 0xb9, 0xc8, 0x70, 0xc3, 0x60, 0x78, 0x2e, 0xa4, 0x08, 0x70, 0x68, 0x2f, 0x18, 0x9e,
+	0x93, 0xd7, 0xa0, 0xe9, 0x07, 0x3a, 0x0b, 0x97, 0xbb, 0x0d, 0x3f, 0xd0, 0x98, 0x5e, 0x81, 0xfa,
+	0x78, 0x72, 0x34, 0xf4, 0x7b, 0x9c, 0xa5, 0xcc, 0x53, 0xe1, 0x10, 0x63, 0x40, 0x45, 0x97, 0x8f,
+	0x1e, 0xce, 0x51, 0x61, 0x1c, 0x75, 0x81, 0x21, 0x8b, 0xfd, 0x18, 0x96, 0xcd, 0x02, 0x8a, 0x05,
+	0xe6, 0x3e, 0xcc, 0x09, 0x79, 0x14, 0xb7, 0xeb, 0x6c, 0x4c, 0xcf, 0x9b, 0x63, 0xc5, 0x51, 0x74,
+	0xfb, 0xb7, 0x2a, 0xb0, 0x24, 0x07, 0xc7, 0x30, 0x8c, 0xe9, 0xc1, 0x64, 0x34, 0xf2, 0xa2, 0x02,
+	0x41, 0x67, 0x5d, 0x22, 0xe8, 0x4a, 0xa6, 0xa0, 0x43, 0xf1, 0x73, 0xe2, 0xf9, 0x01, 0xd7, 0xd2,
+	0xb9, 0x94, 0xd4, 0x10, 0x1c, 0x4f, 0xbd, 0x61, 0x18, 0x73, 0xcd, 0x55, 0xb7, 0x7b, 0x64, 0xe1,
+	0xbc, 0x60, 0xae, 0x16, 0x09, 0x66, 0x5d, 0xb0, 0xce, 0x64, 0x04, 0xab, 0x0d, 0x0d, 0x4c, 0x94,
+	0xca, 0x75, 0x62, 0x96, 0x6b, 0xd2, 0x3a, 0x86, 0xe5, 0xc9, 0x8a, 0x31, 0x2e, 0x33, 0x17, 0x8a,
+	0x84, 0x18, 0x9a, 0x55, 0x70, 0x1d, 0xd2, 0xb8, 0x6b, 0x42, 0x88, 0xe5, 0x49, 0xe4, 0x09, 0x00,
+	0xcf, 0x8b, 0xa9, 0x57, 0xdc, 0x3c, 0xf2, 0x46, 0x66, 0xf6, 0x6a, 0x6d, 0xff, 0x00, 0x03, 0x93,
+	0x88, 0x32, 0x05, 0x4b, 0x8b, 0x69, 0xff, 0xb2, 0x05, 0x75, 0x8d, 0x46, 0xae, 0xc3, 0xe2, 0xc6,
+	0xde, 0xde, 0x7e, 0xd7, 0x59, 0x3f, 0xdc, 0xfe, 0xa4, 0xeb, 0x6e, 0xec, 0xec, 0x1d, 0x74, 0x5b,
+	0xd7, 0x10, 0xde, 0xd9, 0xdb, 0x58, 0xdf, 0x71, 0x9f, 0xec, 0x39, 0x1b, 0x12, 0xb6, 0x50, 0xf9,
+	0x72, 0xba, 0xcf, 0xf6, 0x0e, 0xbb, 0x06, 0x5e, 0x22, 0x2d, 0x68, 0x3c, 0x76, 0xba, 0xeb, 0x1b,
+	0x5b, 0x02, 0x29, 0x93, 0x65, 0x68, 0x3d, 0x79, 0xbe, 0xbb, 0xb9, 0xbd, 0xfb, 0xd4, 0xdd, 0x58,
+	0xdf, 0xdd, 0xe8, 0xee, 0x74, 0x37, 0x5b, 0x15, 0xd2, 0x84, 0xda, 0xfa, 0xe3, 0xf5, 0xdd, 0xcd,
+	0xbd, 0xdd, 0xee, 0x66, 0xab, 0x6a, 0xff, 0x3b, 0x0b, 0xae, 0xb3, 0x52, 0xf7, 0xb3, 0x13, 0x64,
+	0x15, 0xea, 0xbd, 0x30, 0x1c, 0xd3, 0xc8, 0xd3, 0x96, 0x59, 0x1d, 0xc2, 0xc1, 0xcf, 0x17, 0xb5,
+	0xe3, 0x30, 0xea, 0x51, 0x31, 0x3f, 0x80, 0x41, 0x4f, 0x10, 0xc1, 0xc1, 0x2f, 0xba, 0x97, 0x73,
+	0xf0, 0xe9, 0x51, 0xe7, 0x18, 0x67, 0x59, 0x81, 0x99, 0xa3, 0x88, 0x7a, 0xbd, 0x13, 0x31, 0x33,
+	0x44, 0x08, 0x6d, 0x84, 0x72, 0x4b, 0xd4, 0xc3, 0xd6, 0x1f, 0xd2, 0x3e, 0x1b, 0x31, 0x73, 0xce,
+	0x82, 0xc0, 0x37, 0x04, 0x8c, 0x52, 0xd9, 0x3b, 0xf2, 0x82, 0x7e, 0x18, 0xd0, 0x3e, 0x1b, 0x34,
+	0x73, 0x4e, 0x0a, 0xd8, 0xfb, 0xb0, 0x92, 0xad, 0x9f, 0x98, 0x5f, 0xef, 0x69, 0xf3, 0x8b, 0xef,
+	0x86, 0x3a, 0xd3, 0x7b, 0x53, 0x9b, 0x6b, 0x1d, 0x68, 0x0b, 0x86, 0xee, 0x29, 0x0d, 0x92, 0x83,
+	0xc9, 0x51, 0xdc, 0x8b, 0xfc, 0x31, 0x0a, 0x1e, 0xfb, 0x57, 0xaa, 0x40, 0x74, 0xe2, 0x73, 0x26,
+	0xf9, 0xc8, 0x00, 0x96, 0xa5, 0x7c, 0x0d, 0xc7, 0x34, 0x70, 0x45, 0x5a, 0x42, 0xef, 0x7b, 0x5b,
+	0x64, 0xbb, 0xcf, 0x59, 0xb2, 0x05, 0x95, 0xf8, 0xde, 0x98, 0x06, 0x82, 0xb6, 0x75, 0xcd, 0x29,
+	0x4c, 0x90, 0xbc, 0x0b, 0x0d, 0x23, 0x83, 0xd2, 0xaa, 0x95, 0x97, 0x1b, 0x5b, 0xd7, 0x1c, 0x83,
+	0x8b, 0xbc, 0x0f, 0xf3, 0x42, 0xd0, 0xc9, 0x78, 0xe5, 0x29, 0xf1, 0x32, 0x7c, 0xe4, 0x23, 0x68,
+	0xf9, 0x81, 0x89, 0xb5, 0x2b, 0x53, 0xe2, 0xe6, 0x38, 0xc9, 0x93, 0x54, 0x7a, 0xc8, 0xc8, 0xd5,
+	0x55, 0xeb, 0xe2, 0x8e, 0xd8, 0xba, 0xe6, 0x64, 0x23, 0x91, 0x4d, 0x98, 0xef, 0xb1, 0x3e, 0x56,
+	0xc9, 0xcc, 0x5c, 0x21, 0x99, 0x4c, 0x1c, 0xb5, 0x71, 0x9a, 0x35, 0x36, 0x4e, 0xf9, 0xde, 0x7c,
+	0xc0, 0xff, 0x69, 0x1b, 0xa7, 0x3f, 0x67, 0x01, 0xa4, 0x20, 0x69, 0xc3, 0xf2, 0x7e, 0x97, 0x4f,
+	0xbc, 0xbd, 0xfd, 0xee, 0xae, 0xbb, 0xb1, 0xb5, 0xbe, 0xbb, 0xdb, 0xdd, 0x69, 0x5d, 0xc3, 0x49,
+	0x6a, 0x20, 0x16, 0x21, 0x30, 0xbf, 0xbe, 0xc1, 0xe7, 0xbd, 0xc0, 0x4a, 0x38, 0x71, 0xb7, 0x77,
+	0x33, 0x68, 0x99, 0x2c, 0xc1, 0x02, 0xce, 0x6c, 0x36, 0x9d, 0x05, 0x58, 0xc1, 0xe8, 0x6c, 0xba,
+	0x6f, 0x2a, 0xac, 0xfa, 0xb8, 0xc6, 0xa5, 0x79, 0x40, 0x87, 0xf6, 0x7f, 0xb2, 0xa0, 0x82, 0xba,
+	0xfc, 0x74, 0xbd, 0x5f, 0xdf, 0x9e, 0x95, 0x8d, 0xed, 0x19, 0x33, 0x67, 0xa3, 0xc1, 0x83, 0x6b,
+	0x77, 0x7c, 0x59, 0xd7, 0x90, 0x94, 0x1e, 0xd1, 0xde, 0xa9, 0x58, 0xc2, 0x35, 0x04, 0x65, 0x39,
+	0xee, 0x7f, 0x59, 0x6c, 0x21, 0xcb, 0x65, 0x58, 0xd2, 0x58, 0xcc, 0xd9, 0x94, 0xc6, 0xe2, 0xb5,
+	0x61, 0xd6, 0x0f, 0x8e, 0xc2, 0x49, 0xd0, 0x67, 0xb2, 0x7b, 0xce, 0x91, 0x41, 0x9c, 0xe9, 0x63,
+	0xb6, 0xa6, 0xf8, 0x23, 0x29, 0xa9, 0x53, 0xc0, 0x26, 0x68, 0x35, 0x89, 0xd9, 0xde, 0x45, 0x0a,
+	0x31, 0xfb, 0x3d, 0x58, 0xd4, 0x30, 0x31, 0xf1, 0x5f, 0x85, 0xea, 0x18, 0x81, 0xb6, 0x65, 0x68,
+	0x8a, 0xc8, 0xe4, 0x70, 0x8a, 0xdd, 0xc2, 0x93, 0xae, 0x64, 0x3b, 0x38, 0x0e, 0x65, 0x4a, 0xbf,
+	0x5a, 0x81, 0x05, 0x05, 0x89, 0x84, 0xee, 0xc1, 0x82, 0xdf, 0xa7, 0x41, 0xe2, 0x27, 0xe7, 0xae,
+	0x61, 0x9c, 0xc9, 0xc2, 0xb8, 0x59, 0x64, 0x9a, 0xa0, 0xb4, 0xa1, 0xb2, 0x00, 0x79, 0x04, 0xcb,
+	0xa8, 0x26, 0xc9, 0x99, 0xac, 0xa4, 0x11, 0xb7, 0x11, 0x15, 0xd2, 0xa4, 0xd6, 0x65, 0xce, 0xa4,
+	0x58, 0x6c, 0x9a, 0x8a, 0x48, 0xd8, 0x6a, 0x3c, 0x25, 0xac, 0x32, 0x37, 0x74, 0xa7, 0x40, 0xee,
+	0x50, 0x82, 0x5b, 0xb8, 0x73, 0x87, 0x12, 0xda, 0xc1, 0xc6, 0x5c, 0xee, 0x60, 0x03, 0x57, 0xdd,
+	0xf3, 0xa0, 0x47, 0xfb, 0x6e, 0x12, 0xba, 0x4c, 0x3b, 0x60, 0xbd, 0x33, 0xe7, 0x64, 0x61, 0xec,
+	0xdb, 0x84, 0xc6, 0x49, 0x40, 0x13, 0xb6, 0x80, 0xce, 0x39, 0x32, 0x88, 0x0b, 0x01, 0x63, 0xe1,
+	0xba, 0x4e, 0xcd, 0x11, 0x21, 0xdc, 0xf5, 0x4e, 0x22, 0x3f, 0x6e, 0x37, 0x18, 0xca, 0x7e, 0xa3,
+	0x1e, 0x79, 0x44, 0x63, 0x3c, 0x02, 0xf0, 0xfa, 0x34, 0x62, 0xbd, 0xcf, 0xcf, 0x4b, 0xf8, 0x66,
+	0xa2, 0x98, 0x88, 0x79, 0x9f, 0xd2, 0x28, 0xf6, 0xc3, 0x80, 0x6d, 0x23, 0x6a, 0x8e, 0x0c, 0x62,
+	0x7a, 0xd8, 0x20, 0x59, 0xf9, 0x84, 0x5b, 0x09, 0x6c, 0x8c, 0x62, 0x22, 0xee, 0x98, 0x9f, 0xd2,
+	0xc4, 0x11, 0x87, 0x61, 0xfa, 0x58, 0xf9, 0x17, 0x25, 0xb8, 0x91, 0x23, 0xa5, 0x66, 0x59, 0x75,
+	0xac, 0x36, 0x0a, 0xfb, 0x72, 0x61, 0x35, 0x41, 0xd4, 0xea, 0x15, 0x70, 0xec, 0x07, 0x7e, 0x7c,
+	0x22, 0x0e, 0x31, 0xe7, 0x9c, 0x3c, 0x01, 0x67, 0xd3, 0x38, 0x0a, 0x07, 0x6a, 0x12, 0x5b, 0x8e,
+	0x0a, 0xe3, 0x46, 0x53, 0x1e, 0xb6, 0x69, 0xfb, 0xeb, 0xaa, 0x93, 0x41, 0xb1, 0x5c, 0xc2, 0x9c,
+	0x65, 0x9c, 0x4e, 0x99, 0x20, 0x96, 0x4b, 0x9d, 0x21, 0xb9, 0x7d, 0x1a, 0xb1, 0x2d, 0x1c, 0x1f,
+	0x32, 0x79, 0x02, 0xaa, 0x10, 0xb8, 0x58, 0xc7, 0xee, 0x31, 0x9b, 0xcd, 0x7c, 0xa2, 0xeb, 0x10,
+	0x8e, 0xbe, 0x88, 0xc6, 0x3d, 0x2f, 0x10, 0x36, 0x6a, 0x3e, 0xb6, 0x0c, 0xcc, 0xde, 0x83, 0xa6,
+	0xc3, 0xc2, 0x9a, 0x66, 0x72, 0x1c, 0x85, 0x23, 0x59, 0x50, 0x8b, 0x15, 0x54, 0x87, 0x70, 0xc8,
+	0x0f, 0xc3, 0xf0, 0xa5, 0x87, 0x63, 0x40, 0x6c, 0x70, 0x52, 0x00, 0x27, 0xb7, 0x4c, 0x50, 0x98,
+	0x38, 0x6e, 0xc1, 0xcd, 0x27, 0x94, 0x76, 0xe3, 0xc4, 0x1f, 0x79, 0x49, 0x18, 0x6d, 0x51, 0x6f,
+	0x98, 0x9c, 0xc8, 0xde, 0xfc, 0xd3, 0x25, 0x58, 0x78, 0x42, 0xe9, 0x41, 0x38, 0x89, 0x7a, 0x94,
+	0x93, 0x70, 0x54, 0x06, 0xde, 0x48, 0x9a, 0x9d, 0xd8, 0x6f, 0x1c, 0x5f, 0x27, 0x8c, 0x2a, 0xb7,
+	0x0a, 0x32, 0x88, 0xb5, 0x64, 0xa7, 0x36, 0xf1, 0xa4, 0xd7, 0x93, 0x7d, 0x54, 0x76, 0x0c, 0x0c,
+	0xe7, 0x10, 0x0b, 0x6b, 0xfb, 0xf4, 0x0a, 0xd7, 0x5c, 0x33, 0x30, 0xce, 0x46, 0x06, 0xf1, 0x16,
+	0xe3, 0x6a, 0xb4, 0x86, 0xa8, 0xdc, 0x8e, 0x3d, 0x7f, 0x88, 0x26, 0xad, 0x19, 0x2d, 0x37, 0x81,
+	0xa1, 0xe4, 0xe9, 0x61, 0xcd, 0x7b, 0x13, 0x36, 0xa6, 0x05, 0x1c, 0x0b, 0x9d, 0xba, 0x90, 0x66,
+	0xff, 0xbd, 0x12, 0x74, 0x8a, 0x5a, 0x29, 0x35, 0xc7, 0xf5, 0xc2, 0xd1, 0x38, 0x8c, 0xfd, 0x44,
+	0x0e, 0xea, 0x14, 0x20, 0x0f, 0x61, 0x36, 0x66, 0x0d, 0x18, 0xb3, 0xe3, 0xf1, 0xfa, 0xa3, 0x95,
+	0xf4, 0x28, 0x43, 0x6f, 0x59, 0x47, 0xb2, 0xe1, 0xc0, 0x1d, 0xf9, 0x81, 0xde, 0x1e, 0xbc, 0xd9,
+	0x32, 0x28, 0xe3, 0xf3, 0x3e, 0xcd, 0xb7, 0x5b, 0x06, 0x45, 0xc1, 0x79, 0xec, 0x0d, 0x87, 0x47,
+	0x5e, 0xef, 0xa5, 0xce, 0xcc, 0xcd, 0x37, 0x45, 0x24, 0x9c, 0x12, 0x38, 0xf3, 0x25, 0x49, 0x6e,
+	0x36, 0x4d, 0x10, 0xb9, 0x44, 0xd3, 0x72, 0x44, 0x0c, 0x73, 0x13, 0xb4, 0x7f, 0xc0, 0xec, 0x7f,
+	0xea, 0xb0, 0x58, 0xe8, 0x85, 0xb7, 0xa0, 0xc6, 0xc5, 0x68, 0x7c, 0xe2, 0x09, 0x93, 0xe4, 0x1c,
+	0x03, 0x0e, 0x4e, 0x3c, 0xd4, 0x9e, 0x0d, 0xc9, 0xcc, 0x4f, 0x3f, 0xeb, 0x0c, 0xdb, 0x92, 0x93,
+	0x76, 0x5e, 0x1e, 0x43, 0xc7, 0xee, 0x90, 0x1e, 0x27, 0xf2, 0x78, 0x21, 0x98, 0x8c, 0x30, 0xbb,
+	0x78, 0x87, 0x1e, 0x27, 0xf6, 0x2e, 0x2c, 0x0a, 0x2d, 0x06, 0x55, 0x48, 0x91, 0xf5, 0x57, 0x8b,
+	0x76, 0x86, 0xf5, 0x47, 0x4b, 0xa6, 0xda, 0xc3, 0xce, 0x48, 0x32, 0xdb, 0x45, 0xdb, 0x49, 0x0d,
+	0x11, 0xa8, 0x41, 0x89, 0x04, 0xc5, 0xf6, 0x4c, 0x1e, 0x62, 0x88, 0xea, 0x18, 0x18, 0x4e, 0x11,
+	0x39, 0x07, 0xc4, 0x14, 0x11, 0x41, 0xfb, 0x77, 0x2c, 0x58, 0x62, 0xa9, 0x89, 0x94, 0x53, 0x6b,
+	0xf6, 0xd5, 0x8b, 0xd9, 0xe8, 0x69, 0x21, 0x5c, 0x72, 0xf5, 0x7d, 0x09, 0x0f, 0x7c, 0x7e, 0xab,
+	0x7d, 0x25, 0x67, 0xb5, 0xbf, 0x0f, 0xad, 0x3e, 0x1d, 0xfa, 0x4c, 0x04, 0x4b, 0xd5, 0x89, 0xcf,
+	0xc2, 0x1c, 0x6e, 0xff, 0x5b, 0x0b, 0x16, 0xb9, 0xda, 0x99, 0x78, 0xc9, 0x24, 0x16, 0x4d, 0xf5,
+	0x11, 0x34, 0xf9, 0x7e, 0x50, 0xac, 0xee, 0xa2, 0x52, 0xcb, 0xe6, 0x3e, 0x80, 0x33, 0x6f, 0x5d,
+	0x73, 0x4c, 0x66, 0xf2, 0x75, 0xb4, 0x23, 0xa5, 0x43, 0xa9, 0x5d, 0x32, 0xed, 0x48, 0xb9, 0x51,
+	0x86, 0xea, 0xbe, 0x1e, 0x81, 0x7c, 0xc8, 0x36, 0xf5, 0x81, 0xcb, 0x92, 0x6d, 0x97, 0xcd, 0xe8,
+	0xb9, 0x8e, 0xdd, 0xba, 0xe6, 0x68, 0xec, 0x8f, 0xe7, 0x60, 0x86, 0x9b, 0x73, 0xec, 0xa7, 0xd0,
+	0x34, 0x4a, 0x6a, 0x9c, 0x51, 0x34, 0xc4, 0xe1, 0x71, 0xf6, 0xf8, 0xab, 0x94, 0x3f, 0xfe, 0xb2,
+	0xff, 0x4b, 0x19, 0x96, 0x45, 0xbe, 0xeb, 0xbd, 0x1e, 0x1d, 0x27, 0x9a, 0xa0, 0x0f, 0xc2, 0x3e,
+	0xd5, 0x75, 0xab, 0x86, 0xa3, 0x43, 0x19, 0xfb, 0x04, 0x3f, 0xb8, 0xcc, 0xd8, 0x27, 0x74, 0x0d,
+	0x0a, 0x2d, 0x1c, 0xdc, 0x08, 0x9d, 0x85, 0xe5, 0x5a, 0x85, 0x10, 0x9e, 0x16, 0x73, 0x75, 0x57,
+	0x87, 0xd8, 0x2a, 0x3b, 0x89, 0x4f, 0x18, 0x99, 0x6b, 0xbb, 0x2a, 0x8c, 0xe5, 0xe8, 0x4f, 0xe2,
+	0x44, 0x1c, 0xd6, 0x72, 0x39, 0xa1, 0x21, 0x28, 0x7c, 0x50, 0x1c, 0xb1, 0x63, 0x2a, 0x17, 0xe5,
+	0xd7, 0x50, 0x99, 0x30, 0x2a, 0x4e, 0x11, 0x09, 0x4b, 0x2e, 0x07, 0x7e, 0x44, 0x63, 0x1a, 0x9d,
+	0x72, 0x4b, 0x46, 0xc5, 0xc9, 0xc2, 0x58, 0x2e, 0x14, 0x89, 0x68, 0xda, 0x64, 0x6a, 0x57, 0xc5,
+	0x51, 0xe1, 0x02, 0xc3, 0x6f, 0xc5, 0x30, 0xfc, 0x1a, 0x96, 0xd0, 0x7a, 0xd6, 0x12, 0xfa, 0x00,
+	0x08, 0x16, 0xcd, 0x63, 0x9d, 0x42, 0xfb, 0xc2, 0xbe, 0xda, 0x60, 0x6c, 0x05, 0x14, 0xdd, 0xda,
+	0x74, 0x3c, 0xf4, 0x06, 0x31, 0xd3, 0xc7, 0x9a, 0x8e, 0x09, 0xda, 0xff, 0xa4, 0x0c, 0xd7, 0x33,
+	0xdd, 0x2d, 0x96, 0x10, 0x66, 0xd4, 0x47, 0x24, 0x35, 0xea, 0x63, 0xa8, 0xa8, 0x17, 0x4b, 0xc5,
+	0xbd, 0xb8, 0x0c, 0x55, 0xbe, 0x2c, 0xf2, 0xbd, 0x0c, 0x0f, 0x4c, 0x6b, 0xfd, 0xca, 0xf4, 0xd6,
+	0x2f, 0xae, 0x79, 0x75, 0x6a, 0xcd, 0x0b, 0x7a, 0x6b, 0xa6, 0xb8, 0xb7, 0xcc, 0x91, 0x32, 0x9b,
+	0x1b, 0x29, 0x7a, 0x6f, 0xce, 0x65, 0x7a, 0xd3, 0xe8, 0xad, 0x5a, 0xb6, 0xb7, 0x5e, 0x87, 0x26,
+	0x96, 0x2c, 0xe5, 0x00, 0xde, 0xfa, 0x06, 0x88, 0xd2, 0x6b, 0x32, 0x3e, 0x8e, 0xc2, 0x20, 0x71,
+	0xe3, 0x93, 0x49, 0xd2, 0x0f, 0xcf, 0x02, 0xd6, 0xf1, 0x35, 0x27, 0x87, 0x9b, 0xf6, 0xee, 0x46,
+	0xc6, 0xde, 0x6d, 0xff, 0xf7, 0x2a, 0x10, 0xcd, 0x26, 0x31, 0x65, 0xd2, 0x96, 0xf2, 0x93, 0xf6,
+	0x01, 0x10, 0x2d, 0x28, 0x0f, 0xf6, 0x79, 0x8f, 0x15, 0x50, 0x50, 0x59, 0x11, 0x76, 0x26, 0x35,
+	0x1b, 0xd9, 0x49, 0x13, 0x17, 0xcd, 0x85, 0x34, 0x35, 0x59, 0x63, 0x2f, 0x91, 0x27, 0x34, 0x32,
+	0x9c, 0x5d, 0x03, 0x66, 0x2e, 0x5d, 0x03, 0x66, 0x73, 0x6b, 0x80, 0x76, 0x46, 0x30, 0x67, 0x9e,
+	0x11, 0x60, 0x2f, 0x88, 0xfe, 0x72, 0x47, 0x98, 0xbb, 0x38, 0x90, 0x31, 0x40, 0xec, 0x05, 0x61,
+	0x19, 0xcb, 0x76, 0x57, 0x0e, 0xc7, 0x5e, 0xc0, 0xc8, 0x6c, 0x91, 0x67, 0x5d, 0x55, 0x75, 0x52,
+	0x00, 0x35, 0xf2, 0x18, 0x67, 0x81, 0x3b, 0x09, 0x84, 0x90, 0xa7, 0x7d, 0xd1, 0x57, 0x79, 0x02,
+	0xa6, 0xd5, 0x9f, 0x88, 0xd6, 0x62, 0xb3, 0x73, 0xce, 0x49, 0x01, 0xf2, 0x01, 0xb4, 0x0b, 0x26,
+	0x03, 0xaf, 0x06, 0x3f, 0x79, 0x99, 0x4a, 0x9f, 0x32, 0x63, 0x16, 0xa6, 0xce, 0x98, 0xf7, 0xe1,
+	0x86, 0xac, 0x29, 0xce, 0x5d, 0x31, 0x3d, 0x58, 0x7f, 0xb5, 0xf8, 0x91, 0xd0, 0x14, 0x32, 0x73,
+	0x71, 0x53, 0xf3, 0x85, 0x45, 0x58, 0xe4, 0x0a, 0x9f, 0x89, 0xe2, 0xb0, 0xc1, 0x7c, 0x73, 0xed,
+	0x4c, 0xb8, 0x8e, 0x5b, 0x44, 0x63, 0x12, 0x8c, 0x2d, 0xb6, 0x72, 0x61, 0x5f, 0x12, 0xf6, 0x72,
+	0x1d, 0xb4, 0x7f, 0xdb, 0x82, 0x16, 0x8e, 0x7c, 0x63, 0x51, 0xff, 0x00, 0x98, 0xfe, 0x71, 0xc5,
+	0x35, 0xdd, 0xe0, 0xfd, 0x83, 0x2f, 0xe9, 0xef, 0x43, 0x8d, 0x25, 0x18, 0x8e, 0x69, 0x20, 0x56,
+	0xf4, 0xb6, 0xb9, 0xa2, 0xa7, 0xaa, 0xdf, 0xd6, 0x35, 0x27, 0x65, 0xd6, 0xd6, 0xf3, 0x7f, 0x6d,
+	0x41, 0x5d, 0x14, 0xf3, 0x27, 0x3e, 0xee, 0xed, 0xc0, 0x1c, 0x2e, 0xed, 0xda, 0x99, 0xaa, 0x0a,
+	0xa3, 0x8c, 0x1c, 0xe1, 0x99, 0x3a, 0x9a, 0x45, 0x8c, 0xa3, 0xde, 0x2c, 0x8c, 0xf2, 0x9a, 0x69,
+	0xb9, 0xb1, 0x9b, 0xf8, 0x43, 0x57, 0x52, 0xc5, 0x8e, 0xb4, 0x88, 0x84, 0x72, 0x3f, 0x4e, 0xd0,
+	0x77, 0x89, 0xef, 0x45, 0x79, 0x00, 0x77, 0xe8, 0x39, 0x9b, 0x2a, 0xdf, 0xd3, 0xfd, 0xcd, 0x79,
+	0xb8, 0x31, 0xc5, 0xdc, 0x9a, 0x1e, 0x6f, 0x0e, 0xfd, 0xd1, 0x51, 0xa8, 0x4e, 0x06, 0x2c, 0xfd,
+	0x78, 0xd3, 0x20, 0x91, 0x01, 0x5c, 0x2f, 0xb2, 0xc6, 0xca, 0xad, 0xce, 0xe7, 0xb7, 0xef, 0x3a,
+	0xc5, 0xe9, 0x91, 0x13, 0x68, 0x4b, 0x42, 0xc6, 0x06, 0x2a, 0xbd, 0x9e, 0xde, 0xba, 0x24, 0x2f,
+	0xc3, 0x16, 0xee, 0x4c, 0x4d, 0x8d, 0x9c, 0xc3, 0x5d, 0x49, 0x63, 0x8a, 0x73, 0x3e, 0xbf, 0xca,
+	0x95, 0xea, 0xc6, 0xac, 0xfc, 0x66, 0xa6, 0x97, 0x24, 0x4c, 0xbe, 0x07, 0x2b, 0x67, 0x9e, 0x9f,
+	0xc8, 0x62, 0x69, 0xe6, 0x98, 0x2a, 0xcb, 0xf2, 0xd1, 0x25, 0x59, 0xbe, 0xe0, 0x91, 0x8d, 0xdd,
+	0xc4, 0x94, 0x14, 0x09, 0x4d, 0x0d, 0xf3, 0x5c, 0xc4, 0x78, 0xd2, 0xcf, 0xf3, 0x73, 0x74, 0x9c,
+	0x93, 0xc6, 0x74, 0x0a, 0x93, 0xeb, 0xfc, 0x73, 0x0b, 0xe6, 0xcd, 0x44, 0x70, 0x36, 0x08, 0xe9,
+	0x23, 0x57, 0x3c, 0x69, 0x3b, 0xcc, 0xc0, 0xf9, 0x33, 0xbc, 0x52, 0xd1, 0x19, 0x9e, 0x7e, 0x72,
+	0x56, 0xbe, 0xcc, 0x25, 0xa1, 0x72, 0x35, 0x97, 0x84, 0x6a, 0x91, 0x4b, 0x42, 0xe7, 0xbf, 0x59,
+	0x40, 0xf2, 0x43, 0x96, 0x3c, 0x55, 0x66, 0x67, 0x21, 0xfa, 0x7e, 0xea, 0x6a, 0xad, 0x27, 0xbb,
+	0x48, 0xc6, 0xc6, 0xf9, 0xa7, 0xcb, 0x36, 0x7d, 0xfb, 0xdb, 0x74, 0x8a, 0x48, 0x19, 0x27, 0x89,
+	0xca, 0xe5, 0x4e, 0x12, 0xd5, 0xcb, 0x9d, 0x24, 0x66, 0xb2, 0x4e, 0x12, 0x9d, 0x5f, 0xb4, 0x60,
+	0xa9, 0x60, 0x6c, 0x7d, 0x71, 0x15, 0xc7, 0x6e, 0x32, 0x44, 0x4e, 0x49, 0x74, 0x93, 0x0e, 0x76,
+	0xfe, 0x38, 0x34, 0x8d, 0xf9, 0xf4, 0xc5, 0xe5, 0x9f, 0xdd, 0xc1, 0xf3, 0x71, 0x66, 0x60, 0x9d,
+	0xff, 0x5c, 0x02, 0x92, 0x9f, 0xd3, 0xff, 0x4f, 0xcb, 0x90, 0x6f, 0xa7, 0x72, 0x41, 0x3b, 0xfd,
+	0xa1, 0x2e, 0x37, 0xa9, 0x79, 0x56, 0x3b, 0x3a, 0xe6, 0x23, 0x26, 0x4f, 0x40, 0x1b, 0x86, 0xe9,
+	0xa1, 0x32, 0x67, 0xb8, 0x81, 0x6b, 0x6b, 0x6e, 0xc6, 0x51, 0xa5, 0xf3, 0x8b, 0xe9, 0x54, 0xd3,
+	0x84, 0xcc, 0xe7, 0x90, 0x1d, 0x57, 0xdf, 0x39, 0x5d, 0x20, 0x3f, 0xec, 0x7f, 0x66, 0xc1, 0x2d,
+	0x7e, 0xdc, 0x9a, 0xe9, 0x36, 0xe5, 0xd3, 0x9c, 0xcb, 0xc5, 0x2a, 0xce, 0xe5, 0xab, 0x45, 0xb2,
+	0xec, 0x4a, 0x56, 0x27, 0xdc, 0x57, 0xe4, 0x2d, 0x37, 0x3a, 0x44, 0xec, 0x8c, 0xda, 0xce, 0x05,
+	0x81, 0x81, 0xd9, 0xdf, 0x80, 0xdb, 0xc5, 0x35, 0x11, 0x8b, 0x3f, 0x9e, 0x7a, 0x33, 0xba, 0xab,
+	0xb9, 0x5b, 0xea, 0x10, 0x5e, 0x77, 0xe1, 0x17, 0x5d, 0x1e, 0xf3, 0xee, 0x95, 0x2a, 0xc5, 0x5f,
+	0xb1, 0xe0, 0x7a, 0x86, 0x90, 0x9a, 0xfc, 0xb9, 0xd6, 0x60, 0xaa, 0x12, 0x26, 0x88, 0x63, 0x4a,
+	0xe9, 0xe9, 0x19, 0x09, 0x90, 0x27, 0xe0, 0x98, 0x9d, 0x04, 0x39, 0x58, 0xf4, 0x5c, 0x11, 0xc9,
+	0xbe, 0xa1, 0x76, 0xdd, 0x99, 0x82, 0x1f, 0xc3, 0x4a, 0x96, 0x90, 0xba, 0x6e, 0x9a, 0x45, 0x96,
+	0x41, 0xd4, 0xad, 0x0d, 0x0d, 0xc5, 0x2c, 0x6f, 0x21, 0xcd, 0xfe, 0x2d, 0x0b, 0xc8, 0xb7, 0x26,
+	0x34, 0x3a, 0x67, 0x1e, 0xd9, 0xca, 0xcf, 0xe0, 0x46, 0xf6, 0x68, 0x12, 0x5d, 0x26, 0x3f, 0xa6,
+	0xe7, 0xd2, 0x6f, 0xbf, 0x94, 0xfa, 0xed, 0xdf, 0x01, 0x40, 0x73, 0xa7, 0x72, 0xf3, 0x66, 0x5b,
+	0xa1, 0x60, 0x32, 0xe2, 0x09, 0x16, 0xba, 0xd6, 0x57, 0x2e, 0x77, 0xad, 0xaf, 0x5e, 0xe6, 0x5a,
+	0xff, 0x21, 0x2c, 0x19, 0xe5, 0x56, 0xdd, 0x2a, 0x1d, 0xce, 0xad, 0x0b, 0x1c, 0xce, 0x7f, 0xa9,
+	0x04, 0xe5, 0xad, 0x70, 0xac, 0xfb, 0xd8, 0x58, 0xa6, 0x8f, 0x8d, 0x58, 0xdf, 0x5d, 0x35, 0xfd,
+	0x84, 0xd8, 0x37, 0x40, 0x72, 0x1f, 0xe6, 0xbd, 0x51, 0x82, 0x27, 0x69, 0xc7, 0x61, 0x74, 0xe6,
+	0x45, 0xdc, 0x90, 0x55, 0x7e, 0x5c, 0x6a, 0x5b, 0x4e, 0x86, 0x42, 0x96, 0xa1, 0xac, 0x16, 0x42,
+	0xc6, 0x80, 0x41, 0xd4, 0xd9, 0x99, 0x4f, 0xe5, 0xb9, 0xb0, 0x63, 0x88, 0x10, 0x0e, 0x25, 0x33,
+	0x3e, 0xdf, 0xf0, 0x71, 0x71, 0x56, 0x44, 0x42, 0x59, 0x81, 0xcd, 0xc7, 0xd8, 0xc4, 0xe9, 0xad,
+	0x0c, 0xeb, 0x27, 0xcd, 0x73, 0xa6, 0x87, 0xe9, 0x7f, 0xb4, 0xa0, 0xca, 0xda, 0x06, 0xe5, 0x05,
+	0x1f, 0xfb, 0xca, 0xcd, 0x86, 0xb5, 0x49, 0xd3, 0xc9, 0xc2, 0xc4, 0x36, 0xae, 0x3b, 0x95, 0x54,
+	0x85, 0x34, 0x94, 0xac, 0x42, 0x8d, 0x87, 0xd4, 0x2d, 0x0f, 0xc6, 0x92, 0x82, 0xe4, 0x2e, 0xfa,
+	0xc8, 0x8f, 0xa5, 0xca, 0x0a, 0xd2, 0x33, 0x30, 0x1c, 0x3b, 0x0c, 0x4f, 0xcb, 0x83, 0xe9, 0xf1,
+	0x6a, 0x71, 0x0d, 0x21, 0x0b, 0xa3, 0x8e, 0xa4, 0x92, 0xd5, 0x9b, 0x29, 0x83, 0xda, 0xf7, 0x61,
+	0x61, 0x37, 0xec, 0x53, 0xed, 0x50, 0x70, 0xea, 0x38, 0xb7, 0xff, 0x84, 0x05, 0x73, 0x92, 0x99,
+	0xdc, 0x83, 0x4a, 0x20, 0x4f, 0x05, 0xd3, 0xdd, 0xa3, 0xf2, 0x08, 0x46, 0x3e, 0x87, 0x71, 0xa0,
+	0xb4, 0x63, 0xb6, 0xff, 0x74, 0xaf, 0x21, 0x2d, 0xff, 0x0a, 0x4b, 0x8b, 0x9b, 0x11, 0xed, 0x19,
+	0xd4, 0xfe, 0x0d, 0x0b, 0x9a, 0x46, 0x1e, 0x28, 0x07, 0xd9, 0x01, 0x06, 0xdf, 0x1b, 0x8a, 0xee,
+	0xd1, 0x21, 0xbd, 0xa3, 0x4b, 0x46, 0x47, 0xa7, 0x87, 0xdd, 0x65, 0xfd, 0xb0, 0xfb, 0x21, 0xd4,
+	0xd2, 0x4b, 0x69, 0x15, 0x63, 0x05, 0xc4, 0x1c, 0xa5, 0xaf, 0x73, 0xcd, 0xb8, 0xa3, 0xd6, 0x0b,
+	0x87, 0xea, 0x8c, 0x8b, 0x07, 0xec, 0x0f, 0xa1, 0xae, 0xf1, 0x63, 0x31, 0x02, 0x9a, 0x9c, 0x85,
+	0xd1, 0x4b, 0xe9, 0xd9, 0x20, 0x82, 0xca, 0x99, 0xbf, 0x94, 0x3a, 0xf3, 0xdb, 0xbf, 0x67, 0x41,
+	0x13, 0xc7, 0xa0, 0x1f, 0x0c, 0xf6, 0xc3, 0xa1, 0xdf, 0x3b, 0x67, 0x7d, 0x2f, 0x87, 0x9b, 0x90,
+	0x19, 0x72, 0x2c, 0x9a, 0xb0, 0x61, 0x99, 0xe3, 0x53, 0x54, 0x85, 0x71, 0x0e, 0xe3, 0x0c, 0x38,
+	0xf2, 0x62, 0x31, 0x2d, 0x84, 0x4a, 0x62, 0x80, 0xec, 0x08, 0x8a, 0x52, 0x37, 0xf2, 0x12, 0xea,
+	0x8e, 0xfc, 0xe1, 0xd0, 0xe7, 0xbc, 0x15, 0x71, 0x04, 0x95, 0x27, 0x61, 0x9e, 0x7d, 0x3f, 0xf6,
+	0x8e, 0x52, 0xf7, 0x27, 0x15, 0x96, 0xf6, 0xbe, 0xd4, 0xd2, 0x24, 0x8e, 0xa7, 0x0c, 0xd0, 0xfe,
+	0xfb, 0x25, 0xa8, 0x4b, 0x1f, 0x96, 0xfe, 0x80, 0x0a, 0x8b, 0x39, 0x06, 0x53, 0x51, 0xa4, 0x21,
+	0x92, 0x6e, 0x6c, 0x35, 0x34, 0x24, 0x3b, 0x30, 0xca, 0xf9, 0x81, 0x81, 0xfe, 0x06, 0x61, 0x9f,
+	0xbe, 0xcd, 0xf4, 0x12, 0xee, 0x0d, 0x98, 0x02, 0x92, 0xfa, 0x88, 0x51, 0xab, 0x29, 0x95, 0x01,
+	0x17, 0xfa, 0xff, 0xbd, 0x0f, 0x0d, 0x91, 0x0c, 0xeb, 0xb9, 0xf6, 0xac, 0x31, 0x45, 0x8c, 0x5e,
+	0x75, 0x0c, 0x4e, 0x19, 0xf3, 0x91, 0x8c, 0x39, 0x77, 0x59, 0x4c, 0xc9, 0x69, 0x3f, 0x55, 0x6e,
+	0x95, 0x4f, 0x23, 0x6f, 0x2c, 0x8f, 0x84, 0xb1, 0x23, 0xfd, 0xa0, 0x37, 0x9c, 0xf4, 0xa9, 0x3b,
+	0x09, 0xbc, 0x20, 0x08, 0x27, 0x41, 0x8f, 0xca, 0x9b, 0x00, 0x45, 0x24, 0xbb, 0x0f, 0x0d, 0x3d,
+	0x21, 0x72, 0x1f, 0xaa, 0x98, 0x91, 0x5c, 0x3b, 0x8a, 0x27, 0x3a, 0x67, 0x21, 0xf7, 0xa0, 0x4a,
+	0xfb, 0x03, 0x75, 0x72, 0x4a, 0x32, 0x9e, 0x49, 0xfd, 0x01, 0x75, 0x38, 0x03, 0x8a, 0x1d, 0x44,
+	0x33, 0x62, 0xc7, 0x5c, 0x77, 0xd0, 0xb1, 0x22, 0xd8, 0xee, 0xe3, 0xad, 0xe1, 0x5d, 0x3e, 0x53,
+	0x34, 0x76, 0xfb, 0x17, 0xca, 0x50, 0xd7, 0x60, 0x94, 0x20, 0x03, 0x2c, 0xb0, 0xdb, 0xf7, 0xbd,
+	0x11, 0x4d, 0x68, 0x24, 0x66, 0x47, 0x06, 0x45, 0x3e, 0xf4, 0xc1, 0x0d, 0x27, 0x89, 0xdb, 0xa7,
+	0x83, 0x88, 0x72, 0x55, 0xc0, 0x72, 0x32, 0xa8, 0x3c, 0xad, 0xd5, 0xf8, 0xf8, 0x08, 0xca, 0xa0,
+	0xd2, 0x69, 0x85, 0xb7, 0x51, 0x25, 0x75, 0x5a, 0xe1, 0x2d, 0x92, 0x95, 0x7d, 0xd5, 0x02, 0xd9,
+	0xf7, 0x1e, 0xac, 0x70, 0x29, 0x27, 0xe4, 0x81, 0x9b, 0x19, 0x58, 0x53, 0xa8, 0x68, 0x9a, 0xc5,
+	0x32, 0xcb, 0x29, 0x11, 0xfb, 0x3f, 0xe0, 0x06, 0x60, 0xcb, 0xc9, 0xe1, 0xc8, 0xcb, 0x2c, 0xb1,
+	0x3a, 0x2f, 0xf7, 0x37, 0xcd, 0xe1, 0xf2, 0x3a, 0xa9, 0xc1, 0x5b, 0x13, 0xbc, 0x19, 0xdc, 0x6e,
+	0x42, 0xfd, 0x20, 0x09, 0xc7, 0xb2, 0x53, 0xe6, 0xa1, 0xc1, 0x83, 0xa9, 0xbb, 0x02, 0x1b, 0x45,
+	0x87, 0xe1, 0x38, 0x1c, 0x86, 0x83, 0x73, 0xc3, 0x05, 0xf1, 0x5f, 0x5a, 0xb0, 0x64, 0x50, 0x85,
+	0x7d, 0xf2, 0x5d, 0x3e, 0x09, 0x94, 0x9f, 0x36, 0x1f, 0x78, 0x8b, 0x9a, 0x08, 0xe6, 0x8c, 0xdc,
+	0x56, 0xcf, 0x7f, 0xc7, 0x64, 0x3d, 0x3d, 0xd8, 0x48, 0x1d, 0xc6, 0xcb, 0x79, 0xf3, 0x22, 0x8e,
+	0x42, 0x11, 0x7f, 0x5e, 0x44, 0x90, 0x49, 0xfc, 0x34, 0x34, 0x34, 0x4f, 0x3b, 0x69, 0xa8, 0x52,
+	0xbe, 0x79, 0xfa, 0x3e, 0x52, 0x96, 0xa0, 0xa7, 0xc0, 0xd8, 0xfe, 0x15, 0x0b, 0x20, 0x2d, 0x1d,
+	0x0e, 0x8c, 0x74, 0x19, 0xe1, 0x6f, 0x00, 0xa4, 0x00, 0x9e, 0x99, 0x2b, 0xd7, 0xab, 0x74, 0x65,
+	0xaa, 0x4b, 0x0c, 0xd5, 0xca, 0x37, 0x61, 0x61, 0x30, 0x0c, 0x8f, 0xd8, 0xb2, 0xce, 0xae, 0xf8,
+	0xc4, 0xe2, 0x48, 0x70, 0x9e, 0xc3, 0x4f, 0x04, 0x9a, 0x2e, 0x63, 0x15, 0x6d, 0x19, 0xb3, 0x7f,
+	0x54, 0x82, 0xc5, 0x5c, 0x9d, 0xa7, 0xce, 0x32, 0xf2, 0x28, 0x27, 0x4e, 0xa7, 0xec, 0x76, 0x98,
+	0x49, 0x76, 0xff, 0x52, 0x53, 0xce, 0x87, 0x30, 0x1f, 0x71, 0x79, 0x25, 0x85, 0x59, 0xe5, 0x02,
+	0x61, 0xd6, 0x8c, 0xf4, 0x20, 0x3a, 0xd5, 0x7a, 0xfd, 0x53, 0x1a, 0x25, 0x3e, 0xdb, 0x4c, 0x33,
+	0x45, 0x83, 0x8b, 0xe0, 0x05, 0x0d, 0x67, 0xeb, 0xff, 0x9b, 0xb0, 0x20, 0xee, 0x02, 0x29, 0x4e,
+	0x71, 0x9f, 0x35, 0x85, 0x91, 0xd1, 0xfe, 0x6b, 0xf2, 0xe0, 0xde, 0xec, 0xc3, 0xe9, 0x2d, 0xa2,
+	0xd7, 0xae, 0x94, 0xa9, 0xdd, 0x6b, 0xc2, 0xfc, 0x6e, 0xdc, 0xe7, 0x96, 0x3e, 0xde, 0x7d, 0xe1,
+	0xf4, 0x60, 0x36, 0x69, 0xe5, 0x2a, 0x4d, 0x8a, 0x16, 0xfb, 0xd9, 0xad, 0x70, 0xbc, 0x25, 0xbc,
+	0xdd, 0xd9, 0x44, 0x50, 0xdb, 0x3b, 0x19, 0xbc, 0xc0, 0x0f, 0xbe, 0x70, 0x7d, 0x6f, 0x66, 0xd7,
+	0xf7, 0x6f, 0xc0, 0x2d, 0x04, 0xc6, 0x51, 0x38, 0x0e, 0x23, 0x9c, 0x8c, 0xde, 0x90, 0x2f, 0xe6,
+	0x61, 0x90, 0x9c, 0x48, 0x31, 0x76, 0x11, 0x0b, 0xdb, 0x04, 0xe2, 0xe6, 0x85, 0xab, 0xe6, 0x42,
+	0x1f, 0xe1, 0xd2, 0x2d, 0x4f, 0xb0, 0xbf, 0x0a, 0x35, 0xa6, 0x50, 0xb3, 0x6a, 0xbd, 0x05, 0xb5,
+	0x93, 0x70, 0xec, 0x9e, 0xf8, 0x41, 0x22, 0x27, 0xf7, 0x7c, 0xaa, 0xe9, 0x6e, 0xb1, 0x06, 0x51,
+	0x0c, 0xf6, 0xaf, 0x57, 0x61, 0x76, 0x3b, 0x38, 0x0d, 0xfd, 0x1e, 0x3b, 0xb7, 0x1f, 0xd1, 0x51,
+	0x28, 0x9d, 0x99, 0xf0, 0x37, 0x36, 0x05, 0xbb, 0x83, 0x33, 0x4e, 0x84, 0xc1, 0x40, 0x06, 0x51,
+	0x41, 0x88, 0xd2, 0xbb, 0xc2, 0x7c, 0xea, 0x68, 0x08, 0x6e, 0x33, 0x22, 0xfd, 0x5a, 0xb5, 0x08,
+	0xa5, 0x57, 0x36, 0xab, 0xda, 0x95, 0x4d, 0xcc, 0x47, 0x78, 0xe6, 0x0b, 0xd7, 0x6d, 0x19, 0x64,
+	0xdb, 0xa2, 0x88, 0x72, 0x3b, 0x1f, 0x53, 0x35, 0x84, 0x5f, 0x8d, 0x01, 0xa2, 0x3a, 0xc2, 0x23,
+	0x70, 0x1e, 0x2e, 0x7c, 0x75, 0x88, 0x19, 0x27, 0x32, 0x37, 0xb3, 0xf9, 0x9b, 0x07, 0x59, 0x98,
+	0x3b, 0x76, 0x28, 0x41, 0xca, 0xeb, 0x00, 0xfc, 0x2e, 0x74, 0x16, 0xd7, 0x36, 0x53, 0xfc, 0x9a,
+	0x94, 0x08, 0xb1, 0x81, 0x22, 0x5d, 0x89, 0x98, 0xf6, 0xd9, 0xe0, 0xc6, 0x5a, 0x03, 0xc4, 0x52,
+	0x6b, 0xbd, 0xc9, 0x0e, 0xe2, 0x2a, 0x8e, 0x0e, 0x91, 0x47, 0x50, 0x67, 0x1b, 0x48, 0xd1, 0x9f,
+	0xf3, 0xac, 0x3f, 0x5b, 0xfa, 0x0e, 0x93, 0xf5, 0xa8, 0xce, 0xa4, 0x1f, 0x4a, 0x2e, 0xe4, 0x2e,
+	0x2e, 0x79, 0xfd, 0xbe, 0x70, 0xc1, 0x68, 0xb1, 0xdc, 0x52, 0x80, 0xd9, 0x4d, 0x78, 0x83, 0x71,
+	0x86, 0x45, 0xc6, 0x60, 0x60, 0xe4, 0x2e, 0xcc, 0xe1, 0xe6, 0x66, 0xec, 0xf9, 0xfd, 0x36, 0x51,
+	0x7b, 0x2c, 0x85, 0x61, 0x1a, 0xf2, 0x37, 0x3b, 0x92, 0x5b, 0xe2, 0xb6, 0x17, 0x1d, 0xc3, 0xb6,
+	0x51, 0x61, 0x36, 0x89, 0x96, 0x79, 0x8f, 0x1a, 0xa0, 0x9d, 0x00, 0x59, 0xef, 0xf7, 0xc5, 0xd8,
+	0xd4, 0x5d, 0x03, 0x22, 0xfd, 0xaa, 0xb8, 0x08, 0x15, 0xf5, 0x6e, 0xa9, 0xb8, 0x77, 0x2f, 0x6c,
+	0x03, 0xbb, 0x0b, 0xf5, 0x7d, 0xed, 0xf2, 0x39, 0x1b, 0xe4, 0xf2, 0xda, 0xb9, 0x98, 0x18, 0x1a,
+	0xa2, 0x15, 0xa7, 0xa4, 0x17, 0xc7, 0xfe, 0xeb, 0x16, 0x10, 0x74, 0x38, 0x56, 0xc5, 0xe7, 0x79,
+	0xdb, 0xd0, 0x50, 0x26, 0x91, 0xf4, 0xb6, 0x91, 0x81, 0xe5, 0x9e, 0xa4, 0xe0, 0xde, 0x09, 0xb9,
+	0x27, 0x29, 0x50, 0xc7, 0x41, 0x7d, 0xc1, 0xe7, 0x39, 0xc8, 0xbb, 0x53, 0x39, 0x1c, 0xe5, 0x6c,
+	0x44, 0xd1, 0xc3, 0x55, 0x4d, 0x2d, 0x15, 0x56, 0x97, 0xa2, 0xb2, 0xad, 0x7c, 0x1f, 0x8f, 0xfc,
+	0x44, 0xba, 0xa6, 0x08, 0x91, 0x9c, 0x8a, 0x3e, 0xfd, 0x8d, 0x8a, 0xca, 0x94, 0x37, 0x2a, 0x8e,
+	0xfd, 0x28, 0xcb, 0xce, 0x6f, 0x95, 0x15, 0x50, 0xec, 0x17, 0xb0, 0x24, 0xb2, 0xd4, 0x95, 0x1b,
+	0xb3, 0x13, 0xad, 0xcb, 0x06, 0x72, 0x29, 0x3f, 0x90, 0xed, 0xff, 0x6d, 0xc1, 0xac, 0xe8, 0x69,
+	0xd6, 0x2d, 0xd9, 0x57, 0x08, 0x6a, 0x8e, 0x81, 0x91, 0xb6, 0x71, 0xd3, 0x9c, 0x8d, 0x7a, 0x0e,
+	0xe4, 0x05, 0x54, 0xb9, 0x48, 0x40, 0xe1, 0xad, 0x5d, 0x2f, 0x39, 0x61, 0x3b, 0xde, 0x9a, 0xc3,
+	0x7e, 0x93, 0x16, 0xb7, 0xcf, 0x70, 0x41, 0x88, 0x3f, 0x0b, 0x9f, 0x61, 0xe0, 0xeb, 0x6d, 0x0e,
+	0xc7, 0x36, 0x60, 0x05, 0x70, 0x53, 0xf3, 0x4b, 0x0a, 0xe0, 0xc8, 0xe5, 0x01, 0x36, 0xc3, 0xc4,
+	0x85, 0xd1, 0x14, 0xb1, 0xaf, 0xf3, 0x9e, 0x17, 0x4d, 0xa0, 0x0e, 0x44, 0xc5, 0x25, 0xb4, 0x14,
+	0x4e, 0x47, 0x84, 0x28, 0x40, 0x76, 0x44, 0x08, 0x56, 0x47, 0xd1, 0xf1, 0x62, 0xcc, 0x26, 0x1d,
+	0xd2, 0x84, 0xae, 0x0f, 0x87, 0xd9, 0xf4, 0x6f, 0xc1, 0xcd, 0x02, 0x9a, 0xd0, 0x67, 0xbf, 0x05,
+	0xd7, 0xd7, 0xf9, 0x85, 0x9d, 0x2f, 0xca, 0xfb, 0x0f, 0x8f, 0x7e, 0xb3, 0x49, 0x8a, 0xcc, 0x9e,
+	0xc0, 0xe2, 0x26, 0x3d, 0x9a, 0x0c, 0x76, 0xe8, 0x69, 0x9a, 0x11, 0x81, 0x4a, 0x7c, 0x12, 0x9e,
+	0x89, 0x89, 0xc9, 0x7e, 0xa3, 0xb5, 0x71, 0x88, 0x3c, 0x6e, 0x3c, 0xa6, 0x3d, 0x79, 0x31, 0x9c,
+	0x21, 0x07, 0x63, 0xda, 0xb3, 0xdf, 0x03, 0xa2, 0xa7, 0x93, 0xda, 0x8f, 0xe3, 0xc9, 0x91, 0x1b,
+	0x9f, 0xc7, 0x09, 0x1d, 0xc9, 0x1b, 0xef, 0x3a, 0x64, 0xbf, 0x09, 0x8d, 0x7d, 0x0f, 0x1f, 0x5a,
+	0x10, 0xef, 0x56, 0xa0, 0x5d, 0xc8, 0x3b, 0x47, 0x31, 0xa5, 0xec, 0x42, 0x8c, 0x6c, 0xff, 0x7e,
+	0x09, 0x66, 0x38, 0x27, 0xa6, 0xda, 0xa7, 0x71, 0xe2, 0x07, 0xdc, 0x3d, 0x40, 0xa4, 0xaa, 0x41,
+	0xb9, 0xa1, 0x5c, 0x2a, 0x18, 0xca, 0x62, 0xd7, 0x24, 0x2f, 0xd9, 0x4a, 0x37, 0x64, 0x1d, 0xc3,
+	0xc1, 0x95, 0xba, 0xd3, 0x73, 0xc3, 0x44, 0x0a, 0x64, 0x4c, 0x88, 0xe9, 0xaa, 0xc7, 0xcb, 0x27,
+	0x67, 0xa9, 0x18, 0xb9, 0x3a, 0x54, 0xb8, 0xb6, 0xce, 0x4a, 0xa7, 0x49, 0x13, 0xcf, 0xaf, 0xa1,
+	0x73, 0x57, 0x58, 0x43, 0xf9, 0x56, 0xea, 0xa2, 0x35, 0x14, 0xae, 0xb0, 0x86, 0xe2, 0x25, 0x92,
+	0x27, 0x94, 0x3a, 0x14, 0xb5, 0x33, 0x39, 0x76, 0xff, 0xa2, 0x05, 0x2d, 0x31, 0x8a, 0x14, 0x8d,
+	0xbc, 0x6a, 0x68, 0xa1, 0x85, 0xd7, 0x2a, 0x5f, 0x87, 0x26, 0xd3, 0x0d, 0x95, 0xad, 0x54, 0x18,
+	0x76, 0x0d, 0x90, 0x39, 0x1e, 0x8a, 0x43, 0xc6, 0x91, 0x3f, 0x14, 0x9d, 0xa2, 0x43, 0xd2, 0xdc,
+	0x1a, 0x79, 0xe2, 0x4c, 0xc3, 0x72, 0x54, 0xd8, 0xfe, 0x07, 0x16, 0x2c, 0x6a, 0x05, 0x16, 0xa3,
+	0xf0, 0x43, 0x68, 0x28, 0x9f, 0x3b, 0xaa, 0x64, 0xf9, 0x0d, 0x73, 0xda, 0xa4, 0xd1, 0x0c, 0x66,
+	0xd6, 0x99, 0xde, 0x39, 0x2b, 0x60, 0x3c, 0x19, 0x09, 0x21, 0xaa, 0x43, 0x38, 0x90, 0xce, 0x28,
+	0x7d, 0xa9, 0x58, 0xb8, 0x18, 0x37, 0x30, 0x66, 0x9d, 0x42, 0x9d, 0x56, 0x31, 0x55, 0x84, 0x75,
+	0x4a, 0x07, 0xed, 0x7f, 0x5a, 0x82, 0x25, 0xbe, 0x39, 0x11, 0x5b, 0x3f, 0xf5, 0x4e, 0xc1, 0x0c,
+	0xdf, 0x8d, 0xf1, 0x19, 0xb9, 0x75, 0xcd, 0x11, 0x61, 0xf2, 0x95, 0x2b, 0x6e, 0xa8, 0x94, 0xdb,
+	0xea, 0x94, 0xbe, 0x28, 0x17, 0xf5, 0xc5, 0x05, 0x2d, 0x5d, 0x64, 0x28, 0xac, 0x16, 0x1b, 0x0a,
+	0xaf, 0x64, 0x98, 0x63, 0x4b, 0xd9, 0x24, 0x09, 0x79, 0x07, 0xcd, 0x8a, 0x6b, 0x8b, 0x12, 0x60,
+	0xd7, 0x36, 0x86, 0xd4, 0x8b, 0x5c, 0x19, 0x49, 0xf8, 0x99, 0x65, 0x50, 0x7c, 0x2a, 0x29, 0xee,
+	0x85, 0x63, 0x8a, 0xc7, 0x52, 0x66, 0x43, 0x0a, 0x71, 0xf7, 0xaf, 0x2c, 0xb8, 0xc1, 0x21, 0x6c,
+	0x1d, 0xee, 0xb1, 0x24, 0x5b, 0xf9, 0x9d, 0xdc, 0x18, 0x9e, 0x22, 0x5b, 0xf5, 0x96, 0x7c, 0xca,
+	0x5f, 0x5e, 0x10, 0x5e, 0x4a, 0xf3, 0x8f, 0xd6, 0x44, 0x84, 0x29, 0x99, 0x3c, 0x48, 0x91, 0x75,
+	0x16, 0xcd, 0x11, 0xd1, 0xed, 0xaf, 0x40, 0x2b, 0x4b, 0x23, 0x00, 0x33, 0xdd, 0xdd, 0xf5, 0xc7,
+	0x3b, 0x78, 0x01, 0xb6, 0x0e, 0xb3, 0x9b, 0xdb, 0x07, 0x2c, 0x60, 0x91, 0x39, 0xa8, 0xac, 0x3f,
+	0x3f, 0xdc, 0x6b, 0x95, 0x70, 0x95, 0xc9, 0x67, 0x25, 0x2a, 0xfb, 0x63, 0x0b, 0xda, 0x4f, 0xf8,
+	0x49, 0x05, 0x9e, 0xa8, 0xfa, 0x71, 0x82, 0x4f, 0x8a, 0x89, 0xda, 0xde, 0x05, 0xe0, 0x2f, 0x87,
+	0xb1, 0xeb, 0x63, 0xc2, 0xf2, 0x99, 0x22, 0xd8, 0xf9, 0x34, 0xe8, 0x73, 0x2a, 0x1f, 0xf4, 0x2a,
+	0x9c, 0x53, 0xce, 0xca, 0x05, 0xef, 0x85, 0xbd, 0xc1, 0x9d, 0xf1, 0xb1, 0x6b, 0xe8, 0x29, 0x5b,
+	0x30, 0xf9, 0x86, 0x2f, 0x83, 0xda, 0x7f, 0xd7, 0x82, 0x85, 0xb4, 0x90, 0xec, 0x62, 0xa1, 0x29,
+	0x76, 0x85, 0x5e, 0xa3, 0x00, 0x65, 0x93, 0xf5, 0x51, 0xd1, 0x11, 0x65, 0xd3, 0x10, 0x26, 0x0a,
+	0x45, 0x28, 0x9c, 0x28, 0xdf, 0x65, 0x0d, 0xe2, 0xfe, 0x5a, 0xa8, 0x62, 0x09, 0x75, 0x51, 0x84,
+	0xd8, 0xed, 0xbf, 0x51, 0xc2, 0x62, 0xf1, 0x41, 0x2a, 0x83, 0x52, 0x47, 0xe1, 0x0e, 0xaa, 0xf8,
+	0xd3, 0xfe, 0x55, 0x0b, 0x6e, 0x16, 0x34, 0xae, 0x10, 0x39, 0x9b, 0xb0, 0x78, 0xac, 0x88, 0xb2,
+	0x01, 0x2c, 0xf3, 0xaa, 0x87, 0x59, 0x69, 0x27, 0x1f, 0x41, 0x29, 0x95, 0xbc, 0x49, 0x0d, 0x9f,
+	0xf1, 0x3c, 0xc1, 0xfe, 0x9f, 0x16, 0xac, 0xa4, 0x89, 0xf2, 0x47, 0x13, 0xbe, 0x80, 0xce, 0x5e,
+	0x85, 0xfa, 0xd1, 0xa4, 0xf7, 0x92, 0x26, 0xdc, 0x48, 0x27, 0x9e, 0x3e, 0xd0, 0x20, 0xb2, 0x0e,
+	0x73, 0x83, 0x28, 0x9c, 0x8c, 0xdd, 0x23, 0x6e, 0x7f, 0x99, 0x7f, 0xf4, 0xa5, 0x5c, 0x1d, 0xf5,
+	0xe2, 0x3c, 0x78, 0x8a, 0xdc, 0x8f, 0xcf, 0x1d, 0x15, 0xcd, 0xfe, 0x3a, 0xcc, 0x0a, 0x10, 0xef,
+	0x7b, 0xee, 0x3d, 0x3f, 0x7c, 0xba, 0xa7, 0x5f, 0xed, 0xbc, 0xc6, 0x6f, 0x81, 0x6e, 0xec, 0x3d,
+	0xd3, 0x51, 0x36, 0x0f, 0xf6, 0xbb, 0x5d, 0xa7, 0x55, 0xc2, 0xe3, 0xd4, 0xe5, 0x4c, 0x6e, 0x2c,
+	0xc1, 0x0b, 0x4e, 0x1a, 0xd9, 0x36, 0x84, 0x46, 0xae, 0x79, 0x6e, 0x63, 0x60, 0x52, 0x49, 0x10,
+	0x5d, 0x23, 0x1f, 0x7e, 0x30, 0x30, 0x6c, 0xa0, 0xd3, 0x70, 0x38, 0x19, 0xd1, 0xf4, 0xfc, 0xa2,
+	0xe2, 0xe8, 0x90, 0x71, 0x42, 0x28, 0x7c, 0xe5, 0x65, 0xd8, 0x1e, 0xc2, 0xf5, 0x4c, 0xb9, 0x1f,
+	0xb3, 0xa6, 0xbd, 0xb4, 0xcf, 0xde, 0x81, 0x19, 0xd6, 0x7c, 0xd2, 0x04, 0x79, 0xab, 0xb8, 0xcd,
+	0x59, 0x2b, 0x38, 0x82, 0xd5, 0xfe, 0x16, 0xdc, 0xc8, 0xf5, 0x89, 0xba, 0x00, 0x3e, 0xcb, 0x3b,
+	0x55, 0x0e, 0xd4, 0xdb, 0xc5, 0x09, 0xf2, 0xe2, 0x39, 0x92, 0xd9, 0xfe, 0x06, 0xc0, 0x86, 0x1f,
+	0xf5, 0x26, 0x7e, 0xf2, 0x31, 0xbf, 0x40, 0x3b, 0xa5, 0xb9, 0xf1, 0x42, 0x18, 0x8a, 0xfb, 0xd4,
+	0x9c, 0x24, 0x82, 0xf6, 0x6f, 0x96, 0xe1, 0x96, 0xc8, 0x64, 0x2b, 0x19, 0xf6, 0xb6, 0x83, 0x84,
+	0x46, 0xfa, 0xc5, 0x87, 0x2e, 0x2c, 0x4b, 0x57, 0x4b, 0xb7, 0xc7, 0xb3, 0x52, 0x07, 0x87, 0xa9,
+	0xcd, 0x36, 0x2d, 0x84, 0x53, 0xc8, 0x8e, 0xa7, 0xf4, 0x0a, 0xe7, 0x0e, 0x9a, 0xa9, 0x1e, 0x52,
+	0x71, 0x0a, 0x69, 0xec, 0x4e, 0xab, 0xc4, 0x85, 0x6a, 0xc5, 0x85, 0x5d, 0x16, 0xce, 0xa9, 0x9c,
+	0xdc, 0xdc, 0x63, 0x60, 0xe4, 0x6b, 0xd0, 0x09, 0x27, 0xc9, 0x20, 0xe4, 0x1e, 0x71, 0xac, 0x72,
+	0xc2, 0x0e, 0x8c, 0xad, 0xc2, 0x47, 0xc6, 0x05, 0x1c, 0x58, 0x03, 0x45, 0xd5, 0x6b, 0xc0, 0x85,
+	0x55, 0x21, 0x0d, 0x6b, 0xa0, 0x70, 0x51, 0x03, 0x7e, 0xad, 0x2d, 0x0b, 0xe3, 0x42, 0x7d, 0x12,
+	0x0e, 0xfb, 0x6e, 0x9f, 0x7a, 0xfd, 0xa1, 0x1f, 0x48, 0xf3, 0x91, 0x09, 0xda, 0x7f, 0xbb, 0x02,
+	0xb7, 0x8b, 0x3b, 0x4b, 0x8c, 0xa3, 0x2f, 0xa8, 0xb7, 0xb6, 0x33, 0x0b, 0xeb, 0xdb, 0xe6, 0x68,
+	0x2c, 0xcc, 0xfb, 0x81, 0x43, 0xe3, 0x70, 0x78, 0x4a, 0xcd, 0xa5, 0x95, 0x5f, 0x08, 0x35, 0x2c,
+	0x74, 0x2a, 0x4c, 0x0e, 0xa0, 0x21, 0xae, 0xf4, 0xb9, 0x3d, 0x34, 0xeb, 0x56, 0x8c, 0x55, 0xfc,
+	0xc2, 0xcc, 0x9e, 0xf0, 0x78, 0x1b, 0x78, 0x36, 0x65, 0x24, 0x62, 0xbf, 0x0d, 0x4d, 0xa3, 0x24,
+	0xb8, 0x90, 0x3b, 0xdd, 0x83, 0xe7, 0xcf, 0x70, 0x21, 0x07, 0x98, 0x39, 0xe8, 0x1e, 0x1e, 0xca,
+	0x75, 0xfc, 0xc9, 0xfa, 0xf6, 0x4e, 0xab, 0x64, 0xff, 0xae, 0x05, 0x75, 0x2d, 0x41, 0x72, 0x07,
+	0x6e, 0x1e, 0x76, 0x9f, 0xed, 0xef, 0x39, 0xeb, 0xce, 0x77, 0xa4, 0xc0, 0x73, 0x91, 0xf7, 0xb9,
+	0x83, 0x89, 0x74, 0x60, 0x25, 0x25, 0xef, 0xee, 0x6d, 0x76, 0x15, 0xcd, 0x42, 0xda, 0x7e, 0xd7,
+	0x79, 0xb6, 0xbe, 0xdb, 0xdd, 0x3d, 0x34, 0x69, 0x25, 0x4c, 0x36, 0xa5, 0x65, 0x93, 0x2d, 0xe3,
+	0x2b, 0x1b, 0xcf, 0x77, 0x3f, 0xde, 0xdd, 0x7b, 0xb1, 0xeb, 0xee, 0x76, 0xbf, 0x7d, 0xe8, 0x32,
+	0xe1, 0x5a, 0x21, 0xf7, 0xe0, 0x75, 0x14, 0xbe, 0x8e, 0xd3, 0xdd, 0x38, 0x74, 0xf7, 0x1c, 0x57,
+	0xf2, 0xec, 0xaf, 0x7f, 0xe7, 0x19, 0x26, 0xb4, 0xd9, 0x3d, 0x5c, 0xdf, 0xde, 0x39, 0x68, 0x55,
+	0x51, 0x4c, 0xcb, 0x54, 0x85, 0xb6, 0xb2, 0xd9, 0x9a, 0xb1, 0x6f, 0x43, 0x47, 0xd8, 0x2d, 0x8e,
+	0x28, 0xb6, 0x25, 0x5b, 0xf0, 0xd4, 0x66, 0xf8, 0xf7, 0x2b, 0x50, 0x53, 0xa8, 0x38, 0x4c, 0x14,
+	0xe3, 0x21, 0x7b, 0x34, 0x5b, 0x44, 0xc2, 0x18, 0x6a, 0x28, 0x6b, 0x31, 0xf8, 0xb4, 0x2e, 0x22,
+	0xe1, 0xf6, 0x4b, 0x25, 0x24, 0x65, 0x12, 0x97, 0xec, 0x39, 0x1c, 0x79, 0x55, 0x12, 0x92, 0x97,
+	0x8b, 0xf8, 0x1c, 0x8e, 0x32, 0x40, 0xa9, 0x29, 0x6e, 0x20, 0x8d, 0x51, 0x06, 0x86, 0x2f, 0xc0,
+	0xb2, 0xd5, 0x9d, 0xbf, 0x9b, 0x32, 0x63, 0x3c, 0x2b, 0xab, 0x5a, 0xe1, 0x01, 0xfb, 0xcb, 0xdf,
+	0x4a, 0x49, 0xb9, 0xc9, 0x87, 0xd0, 0x94, 0x9e, 0x27, 0x0c, 0x6d, 0xcf, 0x1a, 0x4a, 0xaa, 0x18,
+	0xad, 0x2c, 0x2e, 0x5e, 0x94, 0x33, 0x78, 0xc9, 0x36, 0x10, 0x09, 0xe0, 0x60, 0x15, 0x29, 0xcc,
+	0x19, 0x6f, 0xad, 0x89, 0x14, 0x70, 0x20, 0xca, 0x54, 0x0a, 0x22, 0xe1, 0x09, 0xb2, 0xb0, 0x22,
+	0xf1, 0x44, 0x6a, 0xab, 0x96, 0x76, 0x12, 0x7b, 0xc0, 0x48, 0x32, 0xbe, 0xc1, 0x49, 0xbe, 0x01,
+	0x0b, 0x43, 0x3f, 0x78, 0xa9, 0x97, 0x00, 0x32, 0xbe, 0x1d, 0xc1, 0x4b, 0x3d, 0xfb, 0x2c, 0xbb,
+	0xfd, 0x11, 0xd4, 0x54, 0xe3, 0xa0, 0x52, 0x2c, 0xc6, 0x62, 0xeb, 0x1a, 0x4e, 0xa6, 0x83, 0xee,
+	0xee, 0x66, 0xcb, 0x42, 0xd8, 0xe9, 0x6e, 0x74, 0xb7, 0x3f, 0xc1, 0x21, 0x5f, 0x87, 0xd9, 0x27,
+	0x7b, 0xce, 0x8b, 0x75, 0x67, 0xb3, 0x55, 0xc6, 0x0d, 0x02, 0x4f, 0xe6, 0x1f, 0x59, 0x30, 0xc7,
+	0xa7, 0xf5, 0x71, 0x88, 0x7a, 0x96, 0xea, 0x77, 0xec, 0x2c, 0xcd, 0x07, 0x27, 0x4f, 0x40, 0x6e,
+	0xd5, 0xf3, 0x8a, 0x5b, 0x68, 0x65, 0x39, 0x82, 0x91, 0xb6, 0x72, 0x93, 0xe1, 0x83, 0x2d, 0x4f,
+	0x30, 0xd2, 0x56, 0xdc, 0x7c, 0xb8, 0xe5, 0x09, 0xf6, 0x3b, 0xd0, 0xd0, 0xfb, 0x9c, 0xbc, 0x06,
+	0x15, 0x3f, 0x38, 0x0e, 0xdb, 0x96, 0xe1, 0xc3, 0x25, 0xab, 0xe9, 0x30, 0xa2, 0xfd, 0x67, 0x2d,
+	0x68, 0x65, 0xfb, 0xf9, 0x4a, 0x31, 0xb1, 0x70, 0x67, 0x7e, 0x44, 0x5d, 0x5d, 0xd6, 0xc9, 0x8a,
+	0xe7, 0x08, 0x6c, 0x5b, 0xac, 0x81, 0xc2, 0xfd, 0xc5, 0xc0, 0xec, 0x47, 0xf8, 0xa0, 0xad, 0x1a,
+	0x2d, 0x57, 0x2b, 0xff, 0xef, 0x56, 0xa0, 0x69, 0x8c, 0x92, 0xff, 0x4f, 0x85, 0x27, 0xdf, 0x84,
+	0x79, 0x19, 0xa7, 0xcf, 0x5e, 0x38, 0x16, 0x8b, 0x87, 0x5d, 0x34, 0x94, 0xe5, 0x6a, 0xc1, 0xdf,
+	0x42, 0x76, 0x32, 0x31, 0x71, 0xb7, 0x24, 0x11, 0xe3, 0x6d, 0xdd, 0x0c, 0x6a, 0xdc, 0x42, 0x99,
+	0x31, 0x6f, 0xa1, 0xd8, 0xff, 0xb0, 0x04, 0x4d, 0x23, 0x17, 0x9c, 0x11, 0xbb, 0x7b, 0xbb, 0xf2,
+	0xf9, 0xa4, 0xed, 0xdd, 0x8f, 0xdd, 0xdd, 0xbd, 0x43, 0xb7, 0xbb, 0xb3, 0xfd, 0x74, 0x9b, 0xef,
+	0x23, 0xdb, 0xb0, 0xbc, 0xbd, 0x7b, 0xf0, 0xfc, 0xc9, 0x93, 0xed, 0x8d, 0x6d, 0x14, 0xe4, 0x8f,
+	0xd7, 0x77, 0xf0, 0x6d, 0xa4, 0x56, 0x09, 0x1f, 0x56, 0x7a, 0xb6, 0xfe, 0x6d, 0x57, 0xbe, 0xdc,
+	0xb2, 0xfe, 0x6c, 0xef, 0xf9, 0xee, 0x61, 0xab, 0x8c, 0x4f, 0xac, 0x3c, 0xee, 0xee, 0xec, 0xbd,
+	0x70, 0x9f, 0x6d, 0xef, 0xba, 0xe8, 0xa4, 0xdb, 0xaa, 0xe0, 0x5b, 0x2c, 0xf8, 0xcb, 0x5d, 0xdf,
+	0xdc, 0x64, 0x6b, 0x09, 0x3e, 0xa5, 0x84, 0x09, 0x30, 0x85, 0x7d, 0x7f, 0xa7, 0xcb, 0x5f, 0x67,
+	0x62, 0x33, 0x70, 0x06, 0x4b, 0xb2, 0xbd, 0xfb, 0xc9, 0xde, 0xf6, 0x46, 0x97, 0x15, 0xe6, 0xc9,
+	0xde, 0xf3, 0xdd, 0xcd, 0xd6, 0x2c, 0x7b, 0x0b, 0x66, 0x77, 0x7b, 0x6f, 0xd7, 0xed, 0xee, 0x6e,
+	0xec, 0x6d, 0x76, 0x5b, 0x73, 0xf8, 0xe0, 0xe6, 0xf6, 0xee, 0x61, 0xd7, 0xd9, 0xe8, 0xee, 0x1f,
+	0xee, 0x39, 0xee, 0xe1, 0xf6, 0xb3, 0xee, 0xde, 0xf3, 0xc3, 0x56, 0x8d, 0x6f, 0x05, 0x52, 0x02,
+	0x5b, 0x40, 0x81, 0x2c, 0x42, 0x53, 0xae, 0x3c, 0x3b, 0xdb, 0xcf, 0xb6, 0x0f, 0x5b, 0x75, 0x32,
+	0x0f, 0x80, 0x0b, 0x98, 0x08, 0x37, 0x30, 0xec, 0xac, 0x1f, 0x76, 0x45, 0xb8, 0x89, 0x51, 0xbe,
+	0xf5, 0xbc, 0xfb, 0xbc, 0xab, 0xd2, 0x9e, 0xb7, 0xff, 0x72, 0x19, 0x9a, 0x62, 0x72, 0x30, 0x6f,
+	0xc7, 0x58, 0xba, 0x68, 0x30, 0x15, 0x8c, 0xfb, 0x2b, 0x5b, 0xa9, 0x8b, 0x46, 0x8a, 0xe2, 0xf8,
+	0x52, 0x88, 0x9a, 0xb9, 0xe2, 0x00, 0x20, 0x47, 0x90, 0xa9, 0xb2, 0xbd, 0x06, 0x4f, 0x55, 0x73,
+	0xfc, 0x48, 0x51, 0x99, 0x2a, 0x43, 0xb2, 0xf2, 0x20, 0x47, 0x60, 0x2f, 0xc2, 0x20, 0xc0, 0x2c,
+	0x36, 0x55, 0x66, 0xb1, 0x49, 0x01, 0xdc, 0x50, 0xb0, 0xc0, 0xd1, 0x24, 0x8a, 0xe5, 0xcb, 0x26,
+	0x1a, 0x42, 0x1e, 0x41, 0x85, 0x3d, 0xc1, 0xc1, 0x5f, 0xfc, 0xb9, 0x6b, 0x2e, 0x09, 0xbc, 0x35,
+	0x1e, 0xb0, 0x7f, 0xcf, 0x98, 0xdb, 0x1d, 0xf2, 0xe2, 0xea, 0xf8, 0xfd, 0x09, 0x9d, 0x50, 0x26,
+	0xef, 0xd0, 0x61, 0x65, 0x14, 0x8b, 0x7b, 0x9a, 0x39, 0x1c, 0xf3, 0xc7, 0x22, 0x33, 0xbc, 0x2f,
+	0x2e, 0x6c, 0x6a, 0x88, 0xbd, 0x0a, 0x35, 0x95, 0xbc, 0xd2, 0x8c, 0xae, 0x91, 0x1a, 0x54, 0x59,
+	0x2f, 0xb5, 0x2c, 0xfb, 0xdf, 0x58, 0x00, 0x8c, 0xe5, 0x79, 0xec, 0x0d, 0xf8, 0x9b, 0xce, 0x86,
+	0x27, 0x39, 0xef, 0x19, 0x13, 0xc4, 0x22, 0x4a, 0x20, 0xd3, 0x2f, 0x39, 0x1c, 0x0d, 0x03, 0xa2,
+	0x78, 0xbc, 0x3b, 0x44, 0x08, 0xa7, 0x9d, 0xd7, 0x1f, 0xf9, 0x49, 0x42, 0xe5, 0xe2, 0xaf, 0xc2,
+	0xfc, 0x64, 0xe9, 0x7b, 0xb4, 0x97, 0x50, 0xa9, 0xc2, 0xab, 0x30, 0x7b, 0xd0, 0xc3, 0x4b, 0x84,
+	0x6b, 0xad, 0x38, 0x79, 0xaa, 0x38, 0x06, 0x66, 0x7f, 0xa2, 0x3c, 0x28, 0xb4, 0xaa, 0x4d, 0xdf,
+	0x46, 0xbd, 0x09, 0xd5, 0x49, 0x2c, 0xdf, 0xa5, 0x4e, 0xf5, 0xe9, 0x34, 0xae, 0xc3, 0xe9, 0xf6,
+	0x01, 0x5e, 0xb2, 0xa1, 0x91, 0x99, 0xe8, 0x94, 0x67, 0x8f, 0xae, 0x9c, 0xe8, 0x4d, 0x7d, 0xff,
+	0xc8, 0xc8, 0xe9, 0x25, 0x32, 0xc3, 0xda, 0x24, 0x69, 0x62, 0x53, 0xf0, 0x16, 0xcc, 0xb0, 0xfa,
+	0xc6, 0x19, 0x57, 0x4e, 0x63, 0x74, 0x39, 0x82, 0x87, 0xbc, 0xab, 0xbd, 0x45, 0x56, 0xe8, 0x5f,
+	0xa3, 0x15, 0x4c, 0x71, 0x92, 0x2f, 0xcb, 0x87, 0x8c, 0xb8, 0x4b, 0xcd, 0x75, 0xed, 0x21, 0x23,
+	0xbd, 0x22, 0x8c, 0xc7, 0x7e, 0x06, 0x77, 0xb8, 0xdd, 0x6c, 0x4a, 0x75, 0x3e, 0x5f, 0x89, 0xed,
+	0x55, 0xb8, 0x3b, 0x2d, 0x39, 0x61, 0x8c, 0x5b, 0x13, 0x0f, 0x2f, 0xf2, 0x2d, 0x4e, 0xac, 0x3d,
+	0x41, 0x5b, 0xdc, 0xd1, 0xf6, 0x8f, 0x4b, 0x30, 0x2f, 0xce, 0x86, 0x44, 0x24, 0xf2, 0x15, 0x68,
+	0x48, 0x69, 0x7f, 0xf1, 0x96, 0xca, 0x60, 0xc3, 0x68, 0x4a, 0x77, 0x90, 0x86, 0x8e, 0xe2, 0x68,
+	0x3a, 0x1b, 0x0e, 0xde, 0x13, 0x2f, 0xc6, 0x9f, 0x71, 0x12, 0x06, 0xf2, 0xbd, 0x3a, 0x03, 0xbb,
+	0xd2, 0xae, 0xb7, 0x50, 0x03, 0xaa, 0x7e, 0x2e, 0x0d, 0x68, 0x66, 0x9a, 0x06, 0xb4, 0x2d, 0x5e,
+	0x8b, 0x54, 0xad, 0x2a, 0xc6, 0xdb, 0xdb, 0x30, 0x27, 0x36, 0x93, 0xd2, 0x9a, 0x71, 0xdd, 0x3c,
+	0xa8, 0x13, 0x31, 0x1c, 0xc5, 0x66, 0x7f, 0x15, 0xee, 0x60, 0x52, 0x69, 0x07, 0xee, 0x7b, 0xbd,
+	0x97, 0xde, 0x80, 0x5e, 0xa1, 0xab, 0x7e, 0xaf, 0x04, 0x8b, 0xb9, 0x78, 0x28, 0x4c, 0xb4, 0x37,
+	0x79, 0x2a, 0x8e, 0x08, 0x91, 0x77, 0xd9, 0xed, 0xcc, 0x84, 0xb6, 0x4b, 0x45, 0x82, 0x36, 0x4d,
+	0xe0, 0x01, 0x9a, 0x5b, 0xa8, 0xc3, 0x99, 0x51, 0xcc, 0xb0, 0xe7, 0xac, 0xfa, 0x7d, 0xb9, 0x56,
+	0xa8, 0x30, 0xbb, 0x42, 0x2e, 0x7e, 0x4b, 0xb3, 0x94, 0x10, 0x54, 0x4d, 0xa7, 0x80, 0x22, 0x6d,
+	0xb3, 0x0c, 0xf5, 0xf0, 0x55, 0x47, 0x61, 0xbb, 0xcf, 0xa0, 0xf2, 0x80, 0x5d, 0x68, 0xf0, 0xa8,
+	0x8a, 0xa8, 0x6f, 0x3e, 0x64, 0x71, 0x74, 0x30, 0xcc, 0x62, 0x22, 0x6d, 0x6e, 0x6e, 0x98, 0x42,
+	0xb5, 0xdf, 0x85, 0x2a, 0xab, 0x27, 0x3e, 0xc5, 0xb8, 0xb3, 0xb7, 0xf1, 0x71, 0x77, 0xd3, 0xdd,
+	0x46, 0x6d, 0xbe, 0x09, 0xb5, 0x7d, 0x67, 0x6f, 0xa3, 0x7b, 0x70, 0xd0, 0x45, 0x95, 0xbe, 0x09,
+	0x35, 0xa9, 0x4c, 0x6c, 0xb6, 0x4a, 0xf6, 0xaf, 0x59, 0x70, 0x53, 0x1e, 0xdc, 0xe4, 0x3a, 0xec,
+	0xf2, 0xdb, 0x05, 0x97, 0xdc, 0x1e, 0x7c, 0x17, 0x8f, 0x79, 0x79, 0x5a, 0xed, 0xb2, 0x21, 0x7f,
+	0x72, 0x99, 0x39, 0x8a, 0xd3, 0xfe, 0x39, 0xb8, 0x3b, 0x6d, 0x00, 0x89, 0x51, 0xf9, 0x51, 0xee,
+	0x8d, 0xc5, 0xd5, 0xcc, 0x21, 0x54, 0x3e, 0xae, 0x8a, 0x61, 0x3f, 0x83, 0x65, 0x54, 0xef, 0x0e,
+	0x92, 0x49, 0xef, 0x25, 0x2a, 0xb7, 0x72, 0x5c, 0xfe, 0x64, 0x52, 0x01, 0x6f, 0xc0, 0x64, 0x92,
+	0x13, 0x92, 0x6a, 0x05, 0x96, 0x1d, 0x3a, 0x1e, 0x7a, 0xe7, 0x3b, 0xe1, 0x40, 0x77, 0x86, 0xc5,
+	0x2b, 0x3d, 0x19, 0x42, 0x7a, 0xcc, 0x8b, 0xbd, 0x4b, 0x83, 0x44, 0x7c, 0xd6, 0x44, 0xbd, 0x8e,
+	0x2b, 0x20, 0xe4, 0x08, 0x87, 0xec, 0xc3, 0x14, 0x78, 0x24, 0x29, 0xf4, 0x6e, 0x1d, 0x92, 0x69,
+	0xf0, 0x47, 0x72, 0x8d, 0x17, 0x76, 0x05, 0xc4, 0xde, 0x8a, 0xf0, 0xe3, 0x97, 0x2e, 0x5f, 0xa9,
+	0xc4, 0xbd, 0xc6, 0x14, 0xc1, 0xb5, 0x69, 0x23, 0x1c, 0x8d, 0xbd, 0x5e, 0xa2, 0x4a, 0x29, 0x8b,
+	0xfe, 0xb3, 0xd0, 0xce, 0x93, 0xd2, 0xc2, 0xa3, 0x15, 0xdb, 0x3d, 0xa2, 0xc7, 0x61, 0x24, 0xaf,
+	0xf6, 0xe8, 0x10, 0x66, 0xcc, 0x82, 0xde, 0x71, 0x42, 0x23, 0x71, 0x6c, 0xa9, 0x21, 0xf6, 0x37,
+	0x01, 0x3e, 0xa6, 0xe7, 0x3b, 0x61, 0xcf, 0x4b, 0xc2, 0x08, 0xb9, 0xf1, 0xb5, 0x86, 0x63, 0x6f,
+	0xe4, 0x0b, 0xdf, 0x96, 0xaa, 0xa3, 0x21, 0xa8, 0xa4, 0x61, 0x28, 0x35, 0xe6, 0x57, 0x9d, 0x14,
+	0xb0, 0x8f, 0xa0, 0xf9, 0x31, 0x3d, 0xdf, 0x14, 0x87, 0xc0, 0x61, 0x84, 0x23, 0x36, 0xf2, 0xce,
+	0xb0, 0xc7, 0xf4, 0x8f, 0x31, 0x38, 0x26, 0x48, 0xbe, 0x0c, 0xb3, 0x18, 0x18, 0x86, 0xbd, 0x8c,
+	0x74, 0x4f, 0x0b, 0xe6, 0x48, 0x0e, 0xfb, 0x1e, 0xcc, 0xe0, 0x70, 0xa0, 0xdf, 0xbf, 0xac, 0xac,
+	0xf6, 0x87, 0x50, 0x3d, 0xfc, 0x74, 0x6f, 0x92, 0xa4, 0xee, 0x6a, 0x96, 0xee, 0xae, 0x86, 0xfa,
+	0xe6, 0x4b, 0x97, 0x17, 0x55, 0xb8, 0xfe, 0xa4, 0x00, 0x9e, 0x90, 0x34, 0xf9, 0x15, 0xb0, 0x8f,
+	0xe9, 0xf9, 0xbe, 0x97, 0x9c, 0x70, 0x05, 0x24, 0x1a, 0x87, 0xb1, 0xbc, 0x42, 0x21, 0x83, 0xfc,
+	0xb9, 0x2c, 0x3f, 0xe0, 0x46, 0x11, 0xf1, 0x44, 0x99, 0x02, 0x30, 0x9e, 0xd7, 0xeb, 0xb1, 0x0b,
+	0xf6, 0x5c, 0xf4, 0xc9, 0x20, 0x7f, 0x30, 0xd5, 0x0b, 0xc4, 0x83, 0xa9, 0x4d, 0x47, 0x84, 0xb0,
+	0xbc, 0xbc, 0x81, 0xb9, 0x60, 0xe3, 0x01, 0xfb, 0x77, 0x4a, 0x30, 0x8f, 0x6f, 0xe7, 0x6b, 0xcd,
+	0xfb, 0x10, 0xe6, 0xb0, 0xbe, 0x78, 0xea, 0x9e, 0x59, 0xe8, 0x8d, 0x6e, 0x70, 0x14, 0x17, 0x73,
+	0xab, 0xf1, 0x83, 0xc1, 0x90, 0xba, 0xc9, 0x19, 0xf5, 0x5e, 0x8a, 0x7a, 0x1b, 0x18, 0xf2, 0xf4,
+	0xc3, 0xc9, 0x91, 0xe2, 0xe1, 0x56, 0x47, 0x03, 0x43, 0x21, 0x7c, 0xe6, 0x27, 0x01, 0x8d, 0x63,
+	0xd9, 0x82, 0x15, 0xf1, 0xe5, 0x29, 0x03, 0xc5, 0x8b, 0x55, 0xfc, 0x7d, 0x20, 0x71, 0x35, 0x4b,
+	0x5e, 0xac, 0x62, 0x1d, 0xe3, 0x08, 0x1a, 0x36, 0x51, 0xec, 0x0f, 0x94, 0x23, 0x41, 0xd3, 0x91,
+	0x41, 0x1c, 0xdf, 0x7e, 0x90, 0x3e, 0x39, 0x34, 0xc7, 0x6f, 0x0a, 0x6a, 0x10, 0xf9, 0x9a, 0xfa,
+	0x08, 0x16, 0x56, 0x92, 0xf9, 0xe7, 0xd4, 0x8c, 0xa6, 0x30, 0x7a, 0xd1, 0xc9, 0x32, 0xdb, 0x7d,
+	0x98, 0xc5, 0x56, 0xc5, 0x01, 0xc5, 0x14, 0xde, 0x33, 0x7c, 0xe6, 0x58, 0x1f, 0xac, 0x06, 0x86,
+	0x67, 0xd6, 0xb1, 0x3f, 0x08, 0x58, 0x6b, 0x4a, 0xfd, 0x4e, 0xae, 0xce, 0x66, 0xef, 0x38, 0x1a,
+	0xa3, 0xfd, 0x06, 0xcc, 0xf1, 0x5c, 0xe2, 0x31, 0xd3, 0xb9, 0xbd, 0x33, 0x37, 0xf6, 0x07, 0x5c,
+	0x90, 0x36, 0x1c, 0x15, 0xb6, 0x9f, 0x42, 0x7d, 0x1b, 0x2b, 0x77, 0xc0, 0x9b, 0xaf, 0x0d, 0xb3,
+	0xa2, 0x41, 0x05, 0xa7, 0x0c, 0xf2, 0x69, 0x3d, 0x30, 0x87, 0xaf, 0x86, 0xd8, 0x1f, 0xc3, 0x82,
+	0x96, 0x10, 0xcb, 0xf7, 0x7d, 0x68, 0xf2, 0x86, 0xe3, 0x2c, 0xd9, 0x4f, 0x18, 0xe9, 0xec, 0x26,
+	0xa3, 0xed, 0xf3, 0x91, 0x97, 0x7e, 0x7e, 0xa1, 0xe0, 0xd3, 0x0b, 0x99, 0x3b, 0x44, 0x8d, 0x54,
+	0x3f, 0xd7, 0xa6, 0x77, 0xf9, 0xd2, 0xe9, 0xbd, 0x06, 0x0b, 0x99, 0x0f, 0x44, 0xe4, 0x3f, 0x0e,
+	0xd1, 0xd0, 0x3f, 0xea, 0xf0, 0x47, 0xd1, 0x07, 0x08, 0xdf, 0x28, 0xdc, 0x8f, 0xfc, 0x53, 0x26,
+	0x19, 0xe2, 0xb1, 0xec, 0x49, 0xf4, 0x99, 0x74, 0xd3, 0xe7, 0xa6, 0x0c, 0xcc, 0x1e, 0x43, 0xeb,
+	0xe0, 0xc4, 0x8b, 0x68, 0x9f, 0x8b, 0x13, 0xe9, 0x36, 0x4a, 0xc7, 0x27, 0x74, 0x44, 0x23, 0x6f,
+	0x68, 0x3e, 0x55, 0x95, 0xc3, 0x8d, 0xc9, 0x57, 0xba, 0xca, 0xe4, 0xb3, 0xdf, 0x81, 0x45, 0x2d,
+	0x47, 0x21, 0xc1, 0xb1, 0x23, 0x19, 0xa8, 0x15, 0x54, 0x43, 0xee, 0xff, 0xb2, 0x05, 0x4b, 0x05,
+	0x1f, 0xd7, 0x9a, 0x66, 0x3d, 0xc4, 0xa7, 0x65, 0xa5, 0x65, 0x9c, 0xbf, 0x18, 0xdd, 0x2a, 0x15,
+	0x3f, 0x4b, 0x5d, 0x46, 0x13, 0x82, 0x78, 0x67, 0xda, 0xe9, 0x3e, 0xeb, 0x6e, 0x7e, 0xa7, 0x55,
+	0xc1, 0xfd, 0xea, 0xc1, 0x8b, 0x6e, 0x77, 0xbf, 0x55, 0x45, 0x63, 0x89, 0xf9, 0xe6, 0x74, 0x6b,
+	0xe6, 0xd1, 0xaf, 0x95, 0x61, 0x9e, 0xcf, 0x27, 0xfe, 0x99, 0x38, 0x1a, 0x91, 0x67, 0x30, 0x2b,
+	0x3e, 0xf3, 0x47, 0xe4, 0x3c, 0x30, 0x3f, 0x2c, 0xd8, 0x59, 0xc9, 0xc2, 0x62, 0xa9, 0x5e, 0xfa,
+	0x53, 0xbf, 0xfd, 0xef, 0xff, 0x7c, 0xa9, 0x49, 0xea, 0x6b, 0xa7, 0x6f, 0xaf, 0x0d, 0x68, 0x10,
+	0x63, 0x1a, 0x3f, 0x0b, 0x90, 0x7e, 0x00, 0x8f, 0xb4, 0xd5, 0xd8, 0xcc, 0x7c, 0xd9, 0xaf, 0x73,
+	0xb3, 0x80, 0x22, 0xd2, 0xbd, 0xc9, 0xd2, 0x5d, 0xb2, 0xe7, 0x31, 0x5d, 0x3f, 0xf0, 0x13, 0x3e,
+	0xe5, 0x3f, 0xb0, 0xee, 0x93, 0x3e, 0x34, 0xf4, 0xef, 0xdb, 0x11, 0x69, 0xbb, 0x2e, 0xf8, 0xba,
+	0x5e, 0xe7, 0x56, 0x21, 0x4d, 0x5e, 0xdb, 0x60, 0x79, 0x5c, 0xb7, 0x5b, 0x98, 0xc7, 0x84, 0x71,
+	0xa4, 0xb9, 0x0c, 0x61, 0xde, 0xfc, 0x8c, 0x1d, 0xb9, 0xad, 0x69, 0x4a, 0xb9, 0x8f, 0xe8, 0x75,
+	0xee, 0x4c, 0xa1, 0x8a, 0xbc, 0xee, 0xb0, 0xbc, 0x6e, 0xd8, 0x04, 0xf3, 0xea, 0x31, 0x1e, 0xf9,
+	0x11, 0xbd, 0x0f, 0xac, 0xfb, 0x8f, 0xfe, 0xeb, 0x4f, 0x41, 0x4d, 0xdd, 0x35, 0x22, 0xdf, 0x93,
+	0xcb, 0x96, 0xb8, 0x00, 0x4c, 0x6e, 0x19, 0x62, 0xd0, 0xbc, 0x2f, 0xdc, 0xb9, 0x5d, 0x4c, 0x14,
+	0x19, 0xdf, 0x65, 0x19, 0xb7, 0xc9, 0x0a, 0x66, 0x2c, 0xae, 0xfe, 0xae, 0xb1, 0x3b, 0xf4, 0xfc,
+	0x05, 0xd8, 0x97, 0x30, 0x6f, 0xde, 0x36, 0x36, 0xea, 0x99, 0xbb, 0x9d, 0xdc, 0xb9, 0x33, 0x85,
+	0x2a, 0xb2, 0xbb, 0xcd, 0xb2, 0x5b, 0x21, 0xcb, 0x7a, 0x76, 0xda, 0xf3, 0x1e, 0x0b, 0x99, 0xcf,
+	0xd2, 0x91, 0x3b, 0x6a, 0x60, 0x15, 0x7d, 0xae, 0x4e, 0x0d, 0x91, 0xfc, 0xc7, 0xdc, 0xec, 0x36,
+	0xcb, 0x8a, 0x10, 0xd6, 0x7d, 0xc6, 0xe7, 0xda, 0x4e, 0xa1, 0x95, 0xfd, 0x16, 0x1a, 0x91, 0x9b,
+	0x9c, 0x29, 0x5f, 0x5a, 0xeb, 0xbc, 0x32, 0x95, 0x2e, 0x6a, 0xf6, 0x2a, 0xcb, 0xee, 0x96, 0xbd,
+	0x92, 0xcd, 0x6e, 0x8d, 0x7d, 0x26, 0x08, 0xc7, 0xcc, 0xcf, 0x40, 0x4d, 0x7d, 0x29, 0x88, 0xdc,
+	0xd0, 0x3e, 0xe5, 0xa4, 0x7f, 0xd4, 0xa8, 0xd3, 0xce, 0x13, 0x8a, 0x06, 0xa4, 0x9e, 0x05, 0x26,
+	0xbe, 0x03, 0xd7, 0xd5, 0x11, 0xd6, 0xe7, 0x69, 0xc1, 0x82, 0xaf, 0xdb, 0x3d, 0xb4, 0xc8, 0x87,
+	0x30, 0x27, 0x3f, 0xcb, 0x44, 0x56, 0x8a, 0x3f, 0x3a, 0xd5, 0xb9, 0x91, 0xc3, 0x85, 0xb8, 0xfb,
+	0x0e, 0x40, 0xfa, 0x61, 0x21, 0x35, 0xbf, 0x73, 0x9f, 0x34, 0xea, 0xdc, 0x2c, 0xa0, 0x48, 0x15,
+	0x9f, 0x55, 0xb5, 0x45, 0xd8, 0xfc, 0x0e, 0xe8, 0x99, 0x7c, 0xe4, 0x7a, 0x13, 0xea, 0xda, 0xd2,
+	0x41, 0x6e, 0x6a, 0xab, 0xb2, 0xf9, 0xe1, 0xa0, 0x4e, 0xa7, 0x88, 0x24, 0x0a, 0xf8, 0x4d, 0x68,
+	0x1a, 0x1f, 0x09, 0x52, 0x13, 0xa8, 0xe8, 0x13, 0x44, 0x9d, 0xdb, 0xc5, 0x44, 0x91, 0xd6, 0x77,
+	0xa1, 0xae, 0x7d, 0xd2, 0x87, 0x68, 0x8f, 0x40, 0x65, 0x3e, 0xe6, 0xd3, 0xe9, 0x14, 0x91, 0x44,
+	0x7d, 0x97, 0x59, 0x7d, 0xe7, 0xed, 0x1a, 0xd6, 0x97, 0x19, 0x80, 0xb0, 0x4f, 0xbf, 0x07, 0xf3,
+	0xe6, 0x47, 0x7e, 0xd4, 0xe4, 0x2b, 0xfc, 0x5c, 0x50, 0xe7, 0xce, 0x14, 0xaa, 0x39, 0x7e, 0xee,
+	0x2f, 0xa9, 0x4c, 0xd6, 0x7e, 0x28, 0x16, 0xf0, 0xcf, 0xc8, 0xb7, 0xa0, 0xa6, 0x9e, 0xde, 0x26,
+	0xe9, 0xa7, 0x8d, 0xcc, 0x07, 0xba, 0x3b, 0xed, 0x3c, 0x41, 0x24, 0xbe, 0xc8, 0x12, 0xaf, 0x93,
+	0xb4, 0x06, 0x7c, 0xd9, 0x60, 0x4f, 0x70, 0x6b, 0xcb, 0x86, 0xfe, 0x4a, 0x77, 0x67, 0x25, 0x0b,
+	0x17, 0x2f, 0x1b, 0x09, 0x3b, 0x20, 0x19, 0xc1, 0x42, 0xe6, 0x95, 0x66, 0x7d, 0x6c, 0x17, 0x3c,
+	0xec, 0xdc, 0xb9, 0x3b, 0x8d, 0x6c, 0x36, 0x08, 0x59, 0x12, 0xd9, 0xc8, 0xa7, 0x9a, 0x59, 0x76,
+	0x3b, 0x30, 0xc3, 0x9f, 0x1d, 0x26, 0xea, 0xb2, 0x96, 0xfe, 0xac, 0x71, 0xe7, 0x7a, 0x06, 0x15,
+	0x69, 0x5e, 0x67, 0x69, 0x2e, 0xd8, 0x80, 0x69, 0xf2, 0x87, 0x91, 0xb1, 0x2b, 0x23, 0x20, 0xf9,
+	0xc7, 0x78, 0xc9, 0x6a, 0xfa, 0x8a, 0x41, 0xf1, 0x6b, 0xc6, 0x9d, 0x57, 0x2f, 0xe0, 0x10, 0x39,
+	0xde, 0x60, 0x39, 0x2e, 0x92, 0x05, 0xcc, 0x11, 0xbd, 0x11, 0xd7, 0xf8, 0x43, 0xc6, 0x24, 0x80,
+	0x85, 0xcc, 0x7b, 0x2e, 0xaa, 0xc1, 0x8a, 0xdf, 0xd9, 0xea, 0xdc, 0x9d, 0x46, 0x2e, 0x12, 0xdf,
+	0x52, 0x6c, 0xaf, 0xc9, 0x67, 0xd1, 0x7e, 0xc9, 0x82, 0xe5, 0xa2, 0xd7, 0x3a, 0x88, 0x3c, 0x70,
+	0xba, 0xe0, 0x51, 0x92, 0xce, 0x6b, 0x17, 0xf2, 0x88, 0xfc, 0xdf, 0x60, 0xf9, 0xaf, 0xda, 0xb7,
+	0x8a, 0xf2, 0x5f, 0xe3, 0xcf, 0x7e, 0x60, 0x6b, 0xff, 0x31, 0x68, 0xe8, 0xdf, 0x68, 0x51, 0x3a,
+	0x40, 0xc1, 0x97, 0x65, 0x3a, 0xb7, 0x0a, 0x69, 0xe6, 0xbc, 0x24, 0x0d, 0x3d, 0x43, 0x9c, 0x97,
+	0xe6, 0x47, 0x2a, 0xd2, 0x45, 0xb1, 0xe8, 0xdb, 0x1c, 0x9d, 0x3b, 0x53, 0xa8, 0x45, 0xc3, 0x50,
+	0xd5, 0x8a, 0x5f, 0xa2, 0x23, 0x9f, 0xc0, 0x8a, 0x92, 0xeb, 0xfa, 0xc7, 0x0d, 0x62, 0xf2, 0x4a,
+	0xc1, 0x27, 0x0f, 0xf4, 0xdb, 0x17, 0x9d, 0x9b, 0x53, 0xbf, 0x89, 0xf0, 0xd0, 0x22, 0xdf, 0x85,
+	0x05, 0xed, 0x39, 0xa8, 0x83, 0xf3, 0xa0, 0xa7, 0x64, 0x57, 0xfe, 0x95, 0xc8, 0x4e, 0x91, 0x9b,
+	0xa9, 0x1c, 0x78, 0xb6, 0xd1, 0x38, 0xd8, 0xfc, 0x1b, 0x50, 0xd7, 0xd2, 0xb8, 0x28, 0xdd, 0x1b,
+	0x1a, 0x49, 0x7f, 0x9e, 0xef, 0xa1, 0x45, 0xf6, 0x61, 0xc1, 0x78, 0x76, 0x34, 0x8c, 0xb2, 0xaa,
+	0x87, 0xf9, 0x1c, 0x69, 0xe7, 0x56, 0x31, 0x95, 0x65, 0x74, 0xcf, 0x7a, 0x68, 0x91, 0xbf, 0x84,
+	0x1f, 0x93, 0xd4, 0x9f, 0x82, 0x32, 0x2e, 0xb5, 0x66, 0x4a, 0xd6, 0xd6, 0x69, 0x7a, 0xd1, 0x6c,
+	0x87, 0x55, 0x7b, 0xe7, 0xfe, 0x37, 0x8d, 0xee, 0xfa, 0xa1, 0x61, 0xa1, 0x7b, 0x90, 0xfd, 0xb0,
+	0xe4, 0x67, 0x59, 0x06, 0xfd, 0x49, 0xdd, 0xcf, 0x1e, 0x5a, 0xe4, 0x37, 0x2c, 0x98, 0x37, 0xaf,
+	0x40, 0xa8, 0xea, 0x16, 0x5e, 0xb6, 0xe8, 0xdc, 0x99, 0x42, 0x15, 0x83, 0xea, 0xbb, 0xac, 0x94,
+	0x87, 0xf7, 0x1d, 0xa3, 0x94, 0xe2, 0x43, 0x2b, 0x7f, 0xb0, 0xd2, 0x92, 0x0f, 0xf8, 0x67, 0x5e,
+	0xe5, 0xbd, 0x1c, 0xa2, 0x29, 0x02, 0xd9, 0x01, 0xa3, 0x7f, 0xe3, 0x94, 0x75, 0xc2, 0xcf, 0xc3,
+	0x82, 0x16, 0x97, 0x8d, 0xbb, 0xab, 0xc6, 0xb7, 0x5f, 0x67, 0x75, 0xba, 0x6b, 0xdf, 0x34, 0xea,
+	0x94, 0xd5, 0x84, 0xd6, 0xa1, 0xae, 0x7d, 0xc2, 0x34, 0xd5, 0x11, 0x72, 0x9f, 0x35, 0x9d, 0x5e,
+	0xc8, 0x11, 0x2c, 0x68, 0xec, 0xc6, 0xe4, 0xb8, 0x62, 0x32, 0xf6, 0x7d, 0x56, 0xd6, 0xd7, 0xed,
+	0x57, 0xa6, 0x96, 0x75, 0x8d, 0x5d, 0x64, 0xc0, 0x12, 0xef, 0x03, 0xa4, 0x77, 0xe8, 0x48, 0xe6,
+	0x0e, 0x97, 0x9a, 0xc6, 0xf9, 0x6b, 0x76, 0xe6, 0x0c, 0x94, 0x57, 0xbd, 0xb8, 0xaa, 0xd9, 0xd0,
+	0x2e, 0x8c, 0xc5, 0xaa, 0xf4, 0xf9, 0xcb, 0x6e, 0x9d, 0x4e, 0x11, 0xa9, 0x48, 0xfc, 0xc9, 0xf4,
+	0xc9, 0x73, 0x68, 0xee, 0x84, 0xe1, 0xcb, 0xc9, 0x58, 0x96, 0x98, 0x98, 0x47, 0x17, 0x78, 0x25,
+	0xaf, 0x93, 0xa9, 0x85, 0xbd, 0xca, 0x92, 0xea, 0x90, 0xb6, 0x96, 0xd4, 0xda, 0x0f, 0xd3, 0x3b,
+	0x7a, 0x9f, 0x11, 0x0f, 0x16, 0x95, 0xa4, 0x53, 0x05, 0xef, 0x98, 0xc9, 0x18, 0xf2, 0x2d, 0x9b,
+	0x85, 0xb1, 0x97, 0x91, 0xa5, 0x5d, 0x8b, 0x65, 0x9a, 0x4c, 0xa6, 0x34, 0x36, 0x29, 0xfa, 0x4f,
+	0x88, 0x8b, 0x3a, 0x4b, 0x69, 0xc1, 0xd5, 0x0d, 0x9f, 0x4e, 0xd3, 0x00, 0xcd, 0x35, 0x6f, 0xec,
+	0x9d, 0x47, 0xf4, 0xfb, 0x6b, 0x3f, 0x14, 0x57, 0x80, 0x3e, 0x93, 0x2b, 0x8d, 0xa8, 0xb9, 0xb9,
+	0xd2, 0x64, 0x2e, 0x55, 0x75, 0x6e, 0x15, 0xd2, 0x8a, 0x9a, 0x5a, 0xde, 0xd1, 0x22, 0x43, 0x58,
+	0xcc, 0xdd, 0xc3, 0x52, 0x82, 0x7f, 0xda, 0xed, 0xad, 0xce, 0xea, 0x74, 0x06, 0x33, 0xb7, 0xfb,
+	0x66, 0x6e, 0x07, 0xd0, 0xe4, 0x46, 0x8d, 0x23, 0xca, 0x9f, 0xbd, 0xc8, 0x7c, 0x91, 0x47, 0x7f,
+	0x54, 0xa3, 0xb3, 0x54, 0x40, 0x33, 0xb5, 0x40, 0xf6, 0xe6, 0x04, 0xf9, 0x19, 0xa8, 0x3f, 0xa5,
+	0x89, 0x7c, 0xe7, 0x42, 0xed, 0x26, 0x32, 0x0f, 0x5f, 0x74, 0x0a, 0x9e, 0xc9, 0x30, 0xc7, 0x0c,
+	0x4b, 0x6d, 0x8d, 0xf6, 0x07, 0x94, 0x0b, 0x27, 0xd7, 0xef, 0x7f, 0x46, 0xbe, 0xcd, 0x12, 0x57,
+	0xcf, 0xf1, 0xac, 0x68, 0xcf, 0x23, 0xe8, 0x89, 0x2f, 0x64, 0xf0, 0xa2, 0x94, 0x83, 0xb0, 0x4f,
+	0x35, 0x7d, 0x38, 0x80, 0xba, 0xf6, 0x8a, 0x94, 0x9a, 0x40, 0xf9, 0x17, 0xb1, 0x3a, 0x9d, 0x22,
+	0x92, 0x68, 0xe7, 0x7b, 0x2c, 0x1f, 0x9b, 0xac, 0xa6, 0xf9, 0xb0, 0x59, 0xaf, 0x69, 0xde, 0x6b,
+	0x3f, 0xf4, 0x46, 0xc9, 0x67, 0xe4, 0x05, 0xfb, 0x84, 0x8d, 0xfe, 0x96, 0x47, 0xba, 0x3d, 0xca,
+	0x3e, 0xfb, 0xd1, 0x21, 0x79, 0x92, 0xb9, 0x65, 0xe2, 0x59, 0x31, 0x3d, 0xf6, 0x2b, 0x00, 0xf8,
+	0x1a, 0xc5, 0xa6, 0x47, 0x47, 0x61, 0x90, 0xca, 0xda, 0xf4, 0xbd, 0x8a, 0xce, 0x92, 0x81, 0x89,
+	0x7d, 0xcd, 0x0b, 0x6d, 0x3f, 0xa9, 0x77, 0xb1, 0xd2, 0x59, 0xa7, 0x3e, 0x69, 0xd1, 0xe9, 0x14,
+	0x71, 0xa8, 0x75, 0x7d, 0x1d, 0x20, 0xbd, 0x88, 0xa7, 0x76, 0x87, 0xb9, 0x3b, 0x7e, 0x9d, 0x9b,
+	0x05, 0x14, 0x51, 0xb6, 0x7d, 0xa8, 0xa5, 0x37, 0xbb, 0x6e, 0xa4, 0x1a, 0xb2, 0x71, 0x0f, 0xac,
+	0xd3, 0xce, 0x13, 0x44, 0xaf, 0xb4, 0x58, 0x53, 0x01, 0x99, 0x93, 0x1a, 0x33, 0xf1, 0x61, 0x29,
+	0xbd, 0xa5, 0xc2, 0x14, 0x1c, 0xf6, 0x02, 0x83, 0xac, 0x49, 0xc1, 0x9d, 0xa7, 0xce, 0xad, 0x42,
+	0x5a, 0x91, 0x7d, 0x0a, 0x47, 0x2b, 0x7f, 0xfd, 0x01, 0x45, 0x73, 0x00, 0xad, 0xec, 0x85, 0x18,
+	0x65, 0x7d, 0x98, 0x72, 0x29, 0xa7, 0xf3, 0xca, 0x54, 0xfa, 0xb4, 0xfc, 0x62, 0x46, 0xc7, 0xfc,
+	0x46, 0xb0, 0x98, 0xbb, 0x06, 0xa2, 0x44, 0xc8, 0xb4, 0xdb, 0x37, 0x9d, 0xd5, 0xe9, 0x0c, 0x45,
+	0x1b, 0x9d, 0xf8, 0xcc, 0x4f, 0x7a, 0x27, 0x98, 0xdd, 0xcf, 0xc1, 0x82, 0xe1, 0x8d, 0x1c, 0x46,
+	0xe4, 0xb5, 0x2b, 0x38, 0x2b, 0x77, 0xec, 0x0b, 0x99, 0x52, 0x25, 0x6e, 0x07, 0x96, 0x0a, 0x5c,
+	0x75, 0x89, 0xdc, 0x27, 0x4d, 0x77, 0xe3, 0xed, 0xb4, 0xb2, 0x4e, 0xac, 0x0f, 0x2d, 0xec, 0x8c,
+	0xac, 0x43, 0x04, 0xc9, 0x9f, 0x77, 0x1b, 0x8e, 0x17, 0x9d, 0x57, 0xa6, 0xd2, 0xcd, 0xce, 0x20,
+	0x8b, 0x69, 0xcb, 0xac, 0x09, 0xc7, 0x91, 0x5f, 0xb0, 0x60, 0xa5, 0xd8, 0x0f, 0x83, 0xbc, 0x6e,
+	0xf4, 0xf1, 0xb4, 0xcc, 0xbf, 0x74, 0x09, 0x97, 0xb9, 0x51, 0xb3, 0xf3, 0x45, 0xe0, 0x43, 0x62,
+	0x21, 0x73, 0x67, 0x42, 0x6d, 0x0c, 0x8b, 0x2f, 0xc4, 0x74, 0xee, 0x4e, 0x23, 0x17, 0x99, 0xa6,
+	0x44, 0x7e, 0x38, 0x04, 0x63, 0x61, 0x91, 0xd5, 0x7d, 0x20, 0xcc, 0xdd, 0x98, 0xe9, 0x6e, 0xd2,
+	0xb9, 0x55, 0x48, 0x2b, 0xda, 0x28, 0x89, 0x5c, 0xa4, 0x7b, 0x04, 0xf9, 0x93, 0x16, 0xac, 0x14,
+	0x1f, 0x6f, 0xab, 0xa6, 0xbd, 0xd0, 0x7d, 0xa2, 0xf3, 0xa5, 0x4b, 0xb8, 0x44, 0x21, 0x3a, 0xac,
+	0x10, 0xcb, 0x84, 0x68, 0x85, 0x38, 0x3e, 0xeb, 0x8f, 0x5f, 0x0e, 0x62, 0x12, 0x42, 0xd3, 0x38,
+	0xb2, 0x56, 0x86, 0xa5, 0xa2, 0x73, 0xf1, 0xce, 0xed, 0x62, 0xa2, 0xc8, 0xe7, 0x35, 0x96, 0xcf,
+	0x1d, 0xbb, 0x5d, 0x50, 0xd9, 0x35, 0x74, 0x50, 0xc0, 0xa6, 0xf5, 0xa0, 0xa9, 0x0e, 0x8c, 0xd9,
+	0xa2, 0x71, 0x4b, 0x59, 0x25, 0xf2, 0x07, 0xe4, 0x9d, 0xdb, 0xc5, 0x44, 0x73, 0x42, 0x93, 0x26,
+	0xb7, 0x5c, 0x20, 0xcb, 0x30, 0x1c, 0x90, 0x09, 0xb4, 0xb2, 0x47, 0xd3, 0x6a, 0x8a, 0x4c, 0x39,
+	0xce, 0xee, 0xbc, 0x32, 0x95, 0x2e, 0xf2, 0x12, 0xeb, 0xaf, 0x7d, 0xdd, 0xc8, 0x6b, 0xad, 0xc7,
+	0xf9, 0xd1, 0xe4, 0xfd, 0xb7, 0xca, 0x30, 0x83, 0xb6, 0x3b, 0x8a, 0x47, 0xa0, 0x4d, 0xfc, 0xb5,
+	0xc7, 0xf6, 0x20, 0x8e, 0x77, 0xa6, 0x34, 0x64, 0x71, 0xa8, 0xd7, 0x59, 0x30, 0xc2, 0xf1, 0x98,
+	0x7c, 0x84, 0x9f, 0xef, 0x19, 0x8d, 0x27, 0x09, 0xd5, 0x4f, 0xda, 0xb2, 0xd1, 0x56, 0x0a, 0x4e,
+	0xc5, 0x30, 0xf6, 0x86, 0xf1, 0xa1, 0xf3, 0x17, 0x7e, 0x72, 0x82, 0x17, 0x87, 0xae, 0x17, 0xda,
+	0x1a, 0x3b, 0x2b, 0x45, 0x70, 0x3c, 0x26, 0xef, 0x42, 0x93, 0x9f, 0x59, 0xed, 0xd2, 0x4f, 0xd9,
+	0xc5, 0xa3, 0x66, 0x7a, 0x72, 0x84, 0xf1, 0x0a, 0x0f, 0x92, 0xc8, 0xbb, 0x50, 0xe3, 0xb1, 0x30,
+	0x46, 0xfe, 0x0c, 0x6d, 0x4a, 0xac, 0xaf, 0x43, 0xd3, 0x38, 0x1f, 0x23, 0x85, 0x6c, 0x9d, 0x74,
+	0xad, 0xcd, 0x9e, 0xa5, 0x6d, 0xc2, 0x02, 0x07, 0xd5, 0xd9, 0x55, 0x6a, 0x9f, 0xce, 0x9c, 0x9f,
+	0x75, 0xda, 0x79, 0x02, 0xef, 0xd4, 0xa3, 0x99, 0x71, 0x14, 0x26, 0xe1, 0x3b, 0xff, 0x77, 0x00,
+	0xb1, 0x9e, 0x88, 0xa3, 0xa8, 0x8a, 0x00, 0x00,
 }
@@ -3,8 +3,10 @@ package btcwallet
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -17,7 +19,10 @@ import (
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/wallet/txauthor"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
 	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
@@ -31,6 +36,17 @@ var (
 	// stored within the top-level waleltdb buckets of btcwallet.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level waleltdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
+	// defaultScopes are the key scopes of the addresses handed out by the
+	// wallet, which are watched when recovering funds.
+	defaultScopes = []waddrmgr.KeyScope{
+		waddrmgr.KeyScopeBIP0049Plus,
+		waddrmgr.KeyScopeBIP0084,
+	}
+
 	// lightningAddrSchema is the scope addr schema for all keys that we
 	// derive. We'll treat them all as p2wkh addresses, as atm we must
 	// specify a particular type.
@@ -40,6 +56,26 @@ var (
 	}
 )
 
+var (
+	// ErrRescanInProgress is returned when coins are to be selected while
+	// a rescan is in progress. As the wallet's sync state is rewound to
+	// the start of the rescan, the confirmations of its outputs can't be
+	// trusted until it completes.
+	ErrRescanInProgress = errors.New("a rescan is in progress, coins " +
+		"can't be selected until it completes")
+
+	// ErrNoWatchOnlyWallet is returned when a watch-only wallet is
+	// requested, but no wallet exists yet. As it may not be created from
+	// a seed, it must be copied from the remote signer.
+	ErrNoWatchOnlyWallet = errors.New("no watch-only wallet found, a " +
+		"copy of the remote signer's wallet must be provided")
+
+	// ErrNotWatchOnly is returned when a watch-only wallet is requested,
+	// but the existing wallet still holds private keys.
+	ErrNotWatchOnly = errors.New("wallet holds private keys, but must " +
+		"be watch-only")
+)
+
 // BtcWallet is an implementation of the lnwallet.WalletController interface
 // backed by an active instance of btcwallet. At the time of the writing of
 // this documentation, this implementation requires a full btcd node to
@@ -62,6 +98,31 @@ type BtcWallet struct {
 	// FetchInputInfo.
 	utxoCache map[wire.OutPoint]*wire.TxOut
 	cacheMtx  sync.RWMutex
+
+	// keyPathCache caches the derivation paths of the keys controlling
+	// the output scripts a watch-only wallet had the remote signer sign
+	// for.
+	keyPathCache map[string]walletKeyPath
+	keyPathMtx   sync.RWMutex
+
+	// sendMtx serializes the coin selection of transactions authored by
+	// a watch-only wallet, until they've been published.
+	sendMtx sync.Mutex
+
+	// scanStartHeight is the height the wallet started to sync from,
+	// either at startup or when a rescan was requested, and
+	// scanStartBalance is its confirmed balance at that point.
+	scanStartHeight  int32
+	scanStartBalance btcutil.Amount
+
+	// rescanning is true while a rescan requested through Rescan is in
+	// progress, and rescanRequested is true once any rescan has been
+	// requested since startup. rescanErr is the error the last rescan
+	// failed with, if any.
+	rescanning      bool
+	rescanRequested bool
+	rescanErr       error
+	rescanMtx       sync.Mutex
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -74,6 +135,13 @@ func New(cfg Config) (*BtcWallet, error) {
 	// Ensure the wallet exists or create it when the create flag is set.
 	netDir := NetworkDir(cfg.DataDir, cfg.NetParams)
 
+	// A watch-only wallet can't sign anything itself, so it needs a
+	// signer holding its keys.
+	if cfg.WatchOnly && cfg.RemoteSigner == nil {
+		return nil, errors.New("a watch-only wallet requires a remote " +
+			"signer")
+	}
+
 	// Create the key scope for the coin type being managed by this wallet.
 	chainKeyScope := waddrmgr.KeyScope{
 		Purpose: keychain.BIP0043Purpose,
@@ -99,7 +167,13 @@ func New(cfg Config) (*BtcWallet, error) {
 			return nil, err
 		}
 
-		if !walletExists {
+		switch {
+		// A watch-only wallet can't be created from a seed, it must
+		// have been copied from the remote signer.
+		case !walletExists && cfg.WatchOnly:
+			return nil, ErrNoWatchOnlyWallet
+
+		case !walletExists:
 			// Wallet has never been created, perform initial
 			// set up.
 			wallet, err = loader.CreateNewWallet(
@@ -109,7 +183,7 @@ func New(cfg Config) (*BtcWallet, error) {
 			if err != nil {
 				return nil, err
 			}
-		} else {
+		default:
 			// Wallet has been created and been initialized at
 			// this point, open it along with all the required DB
 			// namespaces, and the DB itself.
@@ -120,6 +194,25 @@ func New(cfg Config) (*BtcWallet, error) {
 		}
 	}
 
+	// If the wallet is meant to be watch-only, we'll make sure it doesn't
+	// hold any private keys, stripping them if we were asked to.
+	if cfg.WatchOnly && !wallet.Manager.WatchOnly() {
+		if !cfg.ConvertToWatchOnly {
+			return nil, ErrNotWatchOnly
+		}
+
+		err := walletdb.Update(wallet.Database(), func(
+			tx walletdb.ReadWriteTx) error {
+
+			addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return wallet.Manager.ConvertToWatchingOnly(addrmgrNs)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert wallet to "+
+				"watch-only: %v", err)
+		}
+	}
+
 	return &BtcWallet{
 		cfg:           &cfg,
 		wallet:        wallet,
@@ -128,6 +221,7 @@ func New(cfg Config) (*BtcWallet, error) {
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
 		utxoCache:     make(map[wire.OutPoint]*wire.TxOut),
+		keyPathCache:  make(map[string]walletKeyPath),
 	}, nil
 }
 
@@ -156,24 +250,10 @@ func (b *BtcWallet) Start() error {
 	// We'll start by unlocking the wallet and ensuring that the KeyScope:
 	// (1017, 1) exists within the internal waddrmgr. We'll need this in
 	// order to properly generate the keys required for signing various
-	// contracts.
-	if err := b.wallet.Unlock(b.cfg.PrivatePass, nil); err != nil {
-		return err
-	}
-	_, err := b.wallet.Manager.FetchScopedKeyManager(b.chainKeyScope)
-	if err != nil {
-		// If the scope hasn't yet been created (it wouldn't been
-		// loaded by default if it was), then we'll manually create the
-		// scope for the first time ourselves.
-		err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
-			addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-
-			_, err := b.wallet.Manager.NewScopedKeyManager(
-				addrmgrNs, b.chainKeyScope, lightningAddrSchema,
-			)
-			return err
-		})
-		if err != nil {
+	// contracts. A watch-only wallet has nothing to unlock, and leaves the
+	// derivation of those keys to the remote signer.
+	if !b.cfg.WatchOnly {
+		if err := b.unlock(); err != nil {
 			return err
 		}
 	}
@@ -184,6 +264,17 @@ func (b *BtcWallet) Start() error {
 		return err
 	}
 
+	// Note the height we start syncing from and our balance at that
+	// point, so the progress of a recovery can be reported.
+	balance, err := b.ConfirmedBalance(1)
+	if err != nil {
+		return err
+	}
+	b.rescanMtx.Lock()
+	b.scanStartHeight = b.wallet.Manager.SyncedTo().Height
+	b.scanStartBalance = balance
+	b.rescanMtx.Unlock()
+
 	// Start the underlying btcwallet core.
 	b.wallet.Start()
 
@@ -194,6 +285,30 @@ func (b *BtcWallet) Start() error {
 	return nil
 }
 
+// unlock unlocks the wallet, and creates the KeyScope used to derive the keys
+// of our contracts if it doesn't exist yet.
+func (b *BtcWallet) unlock() error {
+	if err := b.wallet.Unlock(b.cfg.PrivatePass, nil); err != nil {
+		return err
+	}
+	_, err := b.wallet.Manager.FetchScopedKeyManager(b.chainKeyScope)
+	if err == nil {
+		return nil
+	}
+
+	// If the scope hasn't yet been created (it wouldn't been loaded by
+	// default if it was), then we'll manually create the scope for the
+	// first time ourselves.
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		_, err := b.wallet.Manager.NewScopedKeyManager(
+			addrmgrNs, b.chainKeyScope, lightningAddrSchema,
+		)
+		return err
+	})
+}
+
 // Stop signals the wallet for shutdown. Shutdown may entail closing
 // any active sockets, database handles, stopping goroutines, etc.
 //
@@ -274,9 +389,133 @@ func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
 	// SendOutputs.
 	feeSatPerKB := btcutil.Amount(feeRate.FeePerKVByte())
 
+	// The confirmations of our outputs can't be trusted while a rescan
+	// is in progress, so we won't select any coins.
+	if err := b.checkRescan(); err != nil {
+		return nil, err
+	}
+
+	// A watch-only wallet can't sign the transaction itself, so we'll
+	// author it here, and have the remote signer sign its inputs.
+	if b.cfg.WatchOnly {
+		return b.sendOutputsRemote(outputs, feeSatPerKB)
+	}
+
 	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
 }
 
+// sendOutputsRemote funds a transaction paying out to the specified outputs
+// with confirmed coins of a watch-only wallet, has the remote signer sign each
+// of its inputs, and broadcasts it.
+func (b *BtcWallet) sendOutputsRemote(outputs []*wire.TxOut,
+	feeSatPerKB btcutil.Amount) (*wire.MsgTx, error) {
+
+	for _, output := range outputs {
+		if err := txrules.CheckOutput(output, feeSatPerKB); err != nil {
+			return nil, err
+		}
+	}
+
+	// We'll hold the send mutex until the transaction has been published,
+	// so a concurrent send can't select the same coins.
+	b.sendMtx.Lock()
+	defer b.sendMtx.Unlock()
+
+	utxos, err := b.ListUnspentWitness(1, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll select the largest coins first, in order to keep the
+	// transaction small.
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].Value > utxos[j].Value
+	})
+	inputSource := func(target btcutil.Amount) (btcutil.Amount,
+		[]*wire.TxIn, []btcutil.Amount, [][]byte, error) {
+
+		var (
+			total   btcutil.Amount
+			inputs  []*wire.TxIn
+			values  []btcutil.Amount
+			scripts [][]byte
+		)
+		for _, utxo := range utxos {
+			if total >= target {
+				break
+			}
+
+			total += utxo.Value
+			inputs = append(inputs, wire.NewTxIn(
+				&utxo.OutPoint, nil, nil,
+			))
+			values = append(values, utxo.Value)
+			scripts = append(scripts, utxo.PkScript)
+		}
+
+		return total, inputs, values, scripts, nil
+	}
+	changeSource := func() ([]byte, error) {
+		changeAddr, err := b.NewAddress(lnwallet.WitnessPubKey, true)
+		if err != nil {
+			return nil, err
+		}
+
+		return txscript.PayToAddrScript(changeAddr)
+	}
+
+	authoredTx, err := txauthor.NewUnsignedTransaction(
+		outputs, feeSatPerKB, inputSource, changeSource,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if authoredTx.ChangeIndex >= 0 {
+		authoredTx.RandomizeChangePosition()
+	}
+
+	// With the transaction authored, we'll lock its inputs while they're
+	// being signed, so they can't be selected to fund a channel either.
+	tx := authoredTx.Tx
+	for _, txIn := range tx.TxIn {
+		b.LockOutpoint(txIn.PreviousOutPoint)
+	}
+	defer func() {
+		for _, txIn := range tx.TxIn {
+			b.UnlockOutpoint(txIn.PreviousOutPoint)
+		}
+	}()
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i, txIn := range tx.TxIn {
+		inputScript, err := b.ComputeInputScript(
+			tx, &lnwallet.SignDescriptor{
+				Output: &wire.TxOut{
+					Value: int64(
+						authoredTx.PrevInputValues[i],
+					),
+					PkScript: authoredTx.PrevScripts[i],
+				},
+				HashType:   txscript.SigHashAll,
+				SigHashes:  sigHashes,
+				InputIndex: i,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.ScriptSig
+		txIn.Witness = inputScript.Witness
+	}
+
+	if err := b.PublishTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
 // LockOutpoint marks an outpoint as locked meaning it will no longer be deemed
 // as eligible for coin selection. Locking outputs are utilized in order to
 // avoid race conditions when selecting inputs for usage when funding a
@@ -301,6 +540,13 @@ func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
 // This is a part of the WalletController interface.
 func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	[]*lnwallet.Utxo, error) {
+
+	// The confirmations of our outputs can't be trusted while a rescan
+	// is in progress, as the wallet's sync state has been rewound.
+	if err := b.checkRescan(); err != nil {
+		return nil, err
+	}
+
 	// First, grab all the unfiltered currently unspent outputs.
 	unspentOutputs, err := b.wallet.ListUnspent(minConfs, maxConfs, nil)
 	if err != nil {
@@ -752,3 +998,279 @@ func (b *BtcWallet) IsSynced() (bool, int64, error) {
 
 	return true, bestTimestamp, nil
 }
+
+// RecoveryInfo returns the progress of the wallet in recovering its funds by
+// scanning the chain.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) RecoveryInfo() (*lnwallet.RecoveryInfo, error) {
+	b.rescanMtx.Lock()
+	rescanning := b.rescanning
+	rescanRequested := b.rescanRequested
+	rescanErr := b.rescanErr
+	startHeight := b.scanStartHeight
+	startBalance := b.scanStartBalance
+	b.rescanMtx.Unlock()
+
+	syncState := b.wallet.Manager.SyncedTo()
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var addrsDerived uint32
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		for _, scope := range defaultScopes {
+			scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(
+				scope,
+			)
+			if err != nil {
+				return err
+			}
+
+			props, err := scopedMgr.AccountProperties(
+				addrmgrNs, defaultAccount,
+			)
+			if err != nil {
+				return err
+			}
+
+			addrsDerived += props.ExternalKeyCount +
+				props.InternalKeyCount
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The funds found are those our balance grew by since the scan
+	// started. Outputs confirmed after the start of a rescan only count
+	// towards our balance again once the rescan passes them, so we won't
+	// report a negative amount in the meantime.
+	balance, err := b.ConfirmedBalance(1)
+	if err != nil {
+		return nil, err
+	}
+	var fundsFound btcutil.Amount
+	if balance > startBalance {
+		fundsFound = balance - startBalance
+	}
+
+	finished := !rescanning && rescanErr == nil &&
+		syncState.Height >= bestHeight && b.wallet.ChainSynced()
+
+	return &lnwallet.RecoveryInfo{
+		RecoveryMode:     b.cfg.RecoveryWindow > 0 || rescanRequested,
+		Finished:         finished,
+		StartHeight:      startHeight,
+		CurrentHeight:    syncState.Height,
+		TargetHeight:     bestHeight,
+		AddressesDerived: addrsDerived,
+		FundsFound:       fundsFound,
+		RescanErr:        rescanErr,
+	}, nil
+}
+
+// Rescan replays the chain starting at the block with the passed height,
+// watching for all addresses and outputs known to the wallet, after deriving
+// lookAhead additional addresses for each branch of the default accounts.
+//
+// NOTE: The sync state of the running wallet is rewound to the start block
+// for the duration of the rescan, so it reports itself as not synced, and
+// refuses to select coins until the rescan completes. If the rescan fails,
+// the sync state is restored to what it was before.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) Rescan(startHeight int32, lookAhead uint32) error {
+	b.rescanMtx.Lock()
+	defer b.rescanMtx.Unlock()
+
+	if b.rescanning {
+		return fmt.Errorf("a rescan is already in progress")
+	}
+
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	if startHeight < 0 || startHeight > bestHeight {
+		return fmt.Errorf("start height %v is outside of the known "+
+			"chain, best height is %v", startHeight, bestHeight)
+	}
+
+	startHash, err := b.cfg.ChainSource.GetBlockHash(int64(startHeight))
+	if err != nil {
+		return err
+	}
+	startHeader, err := b.cfg.ChainSource.GetBlockHeader(startHash)
+	if err != nil {
+		return err
+	}
+	startStamp := &waddrmgr.BlockStamp{
+		Height:    startHeight,
+		Hash:      *startHash,
+		Timestamp: startHeader.Timestamp,
+	}
+
+	// Before starting the rescan, we'll extend each branch of the default
+	// accounts by the look-ahead, and gather all addresses and outputs to
+	// watch for.
+	var (
+		addrs   []btcutil.Address
+		unspent []wtxmgr.Credit
+	)
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		for _, scope := range defaultScopes {
+			scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(
+				scope,
+			)
+			if err != nil {
+				return err
+			}
+
+			if lookAhead > 0 {
+				props, err := scopedMgr.AccountProperties(
+					addrmgrNs, defaultAccount,
+				)
+				if err != nil {
+					return err
+				}
+
+				err = scopedMgr.ExtendExternalAddresses(
+					addrmgrNs, defaultAccount,
+					props.ExternalKeyCount+lookAhead-1,
+				)
+				if err != nil {
+					return err
+				}
+				err = scopedMgr.ExtendInternalAddresses(
+					addrmgrNs, defaultAccount,
+					props.InternalKeyCount+lookAhead-1,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = scopedMgr.ForEachAccountAddress(
+				addrmgrNs, defaultAccount,
+				func(addr waddrmgr.ManagedAddress) error {
+					addrs = append(addrs, addr.Address())
+					return nil
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		unspent, err = b.wallet.TxStore.UnspentOutputs(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	outPoints := make(map[wire.OutPoint]btcutil.Address, len(unspent))
+	for _, output := range unspent {
+		_, outputAddrs, _, err := txscript.ExtractPkScriptAddrs(
+			output.PkScript, b.netParams,
+		)
+		if err != nil {
+			return err
+		}
+		if len(outputAddrs) == 0 {
+			continue
+		}
+
+		outPoints[output.OutPoint] = outputAddrs[0]
+	}
+
+	startBalance, err := b.ConfirmedBalance(1)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll rewind the wallet's sync state to the start block.
+	// The wallet will advance its sync state again as the rescan
+	// progresses. We'll note the current state, so it can be restored if
+	// the rescan fails.
+	prevStamp := b.wallet.Manager.SyncedTo()
+	prevSynced := b.wallet.ChainSynced()
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return b.wallet.Manager.SetSyncedTo(addrmgrNs, startStamp)
+	})
+	if err != nil {
+		return err
+	}
+
+	b.wallet.SetChainSynced(false)
+	b.scanStartHeight = startHeight
+	b.scanStartBalance = startBalance
+	b.rescanning = true
+	b.rescanRequested = true
+	b.rescanErr = nil
+
+	errChan := b.wallet.SubmitRescan(&base.RescanJob{
+		InitialSync: true,
+		Addrs:       addrs,
+		OutPoints:   outPoints,
+		BlockStamp:  *startStamp,
+	})
+
+	go func() {
+		err := <-errChan
+
+		// If the rescan failed, the wallet is left behind the chain.
+		// As it was synced up to the point the rescan started, we'll
+		// restore its sync state, rather than leaving it behind.
+		if err != nil {
+			err = fmt.Errorf("rescan from height %v failed: %v",
+				startHeight, err)
+
+			restoreErr := walletdb.Update(b.db, func(
+				tx walletdb.ReadWriteTx) error {
+
+				addrmgrNs := tx.ReadWriteBucket(
+					waddrmgrNamespaceKey,
+				)
+				return b.wallet.Manager.SetSyncedTo(
+					addrmgrNs, &prevStamp,
+				)
+			})
+			if restoreErr != nil {
+				err = fmt.Errorf("%v, unable to restore sync "+
+					"state: %v", err, restoreErr)
+			} else {
+				b.wallet.SetChainSynced(prevSynced)
+			}
+		}
+
+		b.rescanMtx.Lock()
+		b.rescanning = false
+		b.rescanErr = err
+		b.rescanMtx.Unlock()
+	}()
+
+	return nil
+}
+
+// checkRescan returns ErrRescanInProgress if a rescan is in progress.
+func (b *BtcWallet) checkRescan() error {
+	b.rescanMtx.Lock()
+	defer b.rescanMtx.Unlock()
+
+	if b.rescanning {
+		return ErrRescanInProgress
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 
 	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/btcsuite/btcwallet/wallet"
 
 	// This is required to register bdb as a valid walletdb driver. In the
@@ -96,6 +97,40 @@ type Config struct {
 	// encrypted at all, in which case it should be attempted to be loaded
 	// normally when creating the BtcWallet.
 	Wallet *wallet.Wallet
+
+	// WatchOnly indicates that the wallet must not hold any private key
+	// material, as all signing is carried out by a remote signer. In this
+	// mode, a wallet is never created from a seed, and an existing wallet
+	// that still holds private keys is refused unless ConvertToWatchOnly
+	// is set.
+	WatchOnly bool
+
+	// ConvertToWatchOnly, if set along with WatchOnly, irreversibly
+	// removes all private key material from an existing wallet, only
+	// keeping the account extended public keys needed to derive and watch
+	// its addresses. This is used to turn a copy of the remote signer's
+	// wallet into the watch-only wallet of this node.
+	ConvertToWatchOnly bool
+
+	// RemoteSigner is the signer that holds the private keys of a
+	// watch-only wallet. It must be set along with WatchOnly, and is used
+	// to sign all inputs and outputs on behalf of the wallet.
+	RemoteSigner RemoteSigner
+}
+
+// RemoteSigner is the interface a watch-only wallet requires of the signer
+// holding its private keys.
+type RemoteSigner interface {
+	lnwallet.Signer
+
+	// ComputeWalletInputScript generates a complete InputScript for the
+	// passed transaction, signing the input described by the passed
+	// SignDescriptor with the wallet key at the passed derivation path.
+	// As the path is passed along, the signer doesn't need to know the
+	// address of the output being spent.
+	ComputeWalletInputScript(tx *wire.MsgTx,
+		signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+		path waddrmgr.DerivationPath) (*lnwallet.InputScript, error)
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
@@ -1,6 +1,9 @@
 package btcwallet
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -82,6 +85,103 @@ func (b *BtcWallet) fetchOutputAddr(script []byte) (waddrmgr.ManagedAddress, err
 	return nil, lnwallet.ErrNotMine
 }
 
+// walletKeyPath is the key scope and derivation path of a wallet key.
+type walletKeyPath struct {
+	scope waddrmgr.KeyScope
+	path  waddrmgr.DerivationPath
+}
+
+// fetchKeyPath returns the key scope and derivation path of the wallet key
+// that controls the passed output script. The manager doesn't keep track of
+// the index of an address, so it's found by deriving the keys of the branch
+// of the address until the matching one is found.
+func (b *BtcWallet) fetchKeyPath(script []byte) (*walletKeyPath, error) {
+	b.keyPathMtx.RLock()
+	keyPath, ok := b.keyPathCache[string(script)]
+	b.keyPathMtx.RUnlock()
+	if ok {
+		return &keyPath, nil
+	}
+
+	walletAddr, err := b.fetchOutputAddr(script)
+	if err != nil {
+		return nil, err
+	}
+	pka, ok := walletAddr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return nil, lnwallet.ErrNotMine
+	}
+
+	account := pka.Account()
+	branch := waddrmgr.ExternalBranch
+	if pka.Internal() {
+		branch = waddrmgr.InternalBranch
+	}
+
+	var found bool
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		for _, scope := range defaultScopes {
+			scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(
+				scope,
+			)
+			if err != nil {
+				return err
+			}
+
+			props, err := scopedMgr.AccountProperties(
+				addrmgrNs, account,
+			)
+			if err != nil {
+				return err
+			}
+			numKeys := props.ExternalKeyCount
+			if branch == waddrmgr.InternalBranch {
+				numKeys = props.InternalKeyCount
+			}
+
+			for i := uint32(0); i < numKeys; i++ {
+				path := waddrmgr.DerivationPath{
+					Account: account,
+					Branch:  branch,
+					Index:   i,
+				}
+				addr, err := scopedMgr.DeriveFromKeyPath(
+					addrmgrNs, path,
+				)
+				if err != nil {
+					return err
+				}
+
+				pubKey := addr.(waddrmgr.ManagedPubKeyAddress).PubKey()
+				if pubKey.IsEqual(pka.PubKey()) {
+					keyPath = walletKeyPath{
+						scope: scope,
+						path:  path,
+					}
+					found = true
+					return nil
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, lnwallet.ErrNotMine
+	}
+
+	b.keyPathMtx.Lock()
+	b.keyPathCache[string(script)] = keyPath
+	b.keyPathMtx.Unlock()
+
+	return &keyPath, nil
+}
+
 // fetchPrivKey attempts to retrieve the raw private key corresponding to the
 // passed public key if populated, or the key descriptor path (if non-empty).
 func (b *BtcWallet) fetchPrivKey(keyDesc *keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
@@ -160,6 +260,12 @@ func maybeTweakPrivKey(signDesc *lnwallet.SignDescriptor,
 func (b *BtcWallet) SignOutputRaw(tx *wire.MsgTx,
 	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
 
+	// A watch-only wallet holds no private keys, so the signature is
+	// generated by the remote signer.
+	if b.cfg.WatchOnly {
+		return b.cfg.RemoteSigner.SignOutputRaw(tx, signDesc)
+	}
+
 	witnessScript := signDesc.WitnessScript
 
 	// First attempt to fetch the private key which corresponds to the
@@ -201,6 +307,21 @@ func (b *BtcWallet) ComputeInputScript(tx *wire.MsgTx,
 	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
 
 	outputScript := signDesc.Output.PkScript
+
+	// A watch-only wallet holds no private keys, so the input script is
+	// generated by the remote signer. It may not have derived the address
+	// of the output yet, so we'll tell it which key to sign with.
+	if b.cfg.WatchOnly {
+		keyPath, err := b.fetchKeyPath(outputScript)
+		if err != nil {
+			return nil, err
+		}
+
+		return b.cfg.RemoteSigner.ComputeWalletInputScript(
+			tx, signDesc, keyPath.scope, keyPath.path,
+		)
+	}
+
 	walletAddr, err := b.fetchOutputAddr(outputScript)
 	if err != nil {
 		return nil, err
@@ -212,6 +333,93 @@ func (b *BtcWallet) ComputeInputScript(tx *wire.MsgTx,
 		return nil, err
 	}
 
+	return b.computeInputScript(
+		tx, signDesc, privKey,
+		pka.AddrType() == waddrmgr.NestedWitnessPubKey,
+	)
+}
+
+// ComputeWalletInputScript generates a complete InputScript for the passed
+// transaction, signing the input described by the passed SignDescriptor with
+// the wallet key at the passed derivation path. Unlike ComputeInputScript,
+// the address of the output being spent doesn't need to be known to the
+// wallet, which allows signing for the addresses derived by a watch-only copy
+// of it.
+func (b *BtcWallet) ComputeWalletInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) (*lnwallet.InputScript, error) {
+
+	if b.cfg.WatchOnly {
+		return b.cfg.RemoteSigner.ComputeWalletInputScript(
+			tx, signDesc, scope, path,
+		)
+	}
+
+	scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var privKey *btcec.PrivateKey
+	err = walletdb.View(b.db, func(dbTx walletdb.ReadTx) error {
+		addrmgrNs := dbTx.ReadBucket(waddrmgrNamespaceKey)
+
+		addr, err := scopedMgr.DeriveFromKeyPath(addrmgrNs, path)
+		if err != nil {
+			return err
+		}
+
+		privKey, err = addr.(waddrmgr.ManagedPubKeyAddress).PrivKey()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Before signing, we'll make sure the derived key actually controls
+	// the output, either directly or nested within a p2sh output.
+	outputScript := signDesc.Output.PkScript
+	pubKeyHash := btcutil.Hash160(privKey.PubKey().SerializeCompressed())
+	p2wkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		pubKeyHash, b.netParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p2wkhScript, err := txscript.PayToAddrScript(p2wkhAddr)
+	if err != nil {
+		return nil, err
+	}
+	p2shAddr, err := btcutil.NewAddressScriptHash(p2wkhScript, b.netParams)
+	if err != nil {
+		return nil, err
+	}
+	p2shScript, err := txscript.PayToAddrScript(p2shAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.Equal(outputScript, p2wkhScript):
+		return b.computeInputScript(tx, signDesc, privKey, false)
+
+	case bytes.Equal(outputScript, p2shScript):
+		return b.computeInputScript(tx, signDesc, privKey, true)
+
+	default:
+		return nil, fmt.Errorf("key %v/%v/%v of scope %v doesn't "+
+			"control output script %x", path.Account, path.Branch,
+			path.Index, scope, outputScript)
+	}
+}
+
+// computeInputScript generates a complete InputScript spending the p2wkh
+// output, or the p2wkh output nested within a p2sh output, of the passed
+// private key.
+func (b *BtcWallet) computeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor, privKey *btcec.PrivateKey,
+	nested bool) (*lnwallet.InputScript, error) {
+
 	var witnessProgram []byte
 	inputScript := &lnwallet.InputScript{}
 
@@ -219,7 +427,7 @@ func (b *BtcWallet) ComputeInputScript(tx *wire.MsgTx,
 
 	// If we're spending p2wkh output nested within a p2sh output, then
 	// we'll need to attach a sigScript in addition to witness data.
-	case pka.AddrType() == waddrmgr.NestedWitnessPubKey:
+	case nested:
 		pubKey := privKey.PubKey()
 		pubKeyHash := btcutil.Hash160(pubKey.SerializeCompressed())
 
@@ -252,13 +460,13 @@ func (b *BtcWallet) ComputeInputScript(tx *wire.MsgTx,
 	// p2wkh witness program will be expanded into a regular p2kh
 	// script.
 	default:
-		witnessProgram = outputScript
+		witnessProgram = signDesc.Output.PkScript
 	}
 
 	// If a tweak (single or double) is specified, then we'll need to use
 	// this tweak to derive the final private key to be used for signing
 	// this output.
-	privKey, err = maybeTweakPrivKey(signDesc, privKey)
+	privKey, err := maybeTweakPrivKey(signDesc, privKey)
 	if err != nil {
 		return nil, err
 	}
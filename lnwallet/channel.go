@@ -756,8 +756,8 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
 		htlc.Amt.ToSatoshis(), lc.channelState.LocalChanCfg.DustLimit)
 	if !isDustLocal && localCommitKeys != nil {
 		ourP2WSH, ourWitnessScript, err = genHtlcScript(
-			htlc.Incoming, true, htlc.RefundTimeout, htlc.RHash,
-			localCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, true,
+			htlc.RefundTimeout, htlc.RHash, localCommitKeys)
 		if err != nil {
 			return pd, err
 		}
@@ -766,8 +766,8 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
 		htlc.Amt.ToSatoshis(), lc.channelState.RemoteChanCfg.DustLimit)
 	if !isDustRemote && remoteCommitKeys != nil {
 		theirP2WSH, theirWitnessScript, err = genHtlcScript(
-			htlc.Incoming, false, htlc.RefundTimeout, htlc.RHash,
-			remoteCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, false,
+			htlc.RefundTimeout, htlc.RHash, remoteCommitKeys)
 		if err != nil {
 			return pd, err
 		}
@@ -847,11 +847,13 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 	var localCommitKeys, remoteCommitKeys *CommitmentKeyRing
 	if localCommitPoint != nil {
 		localCommitKeys = deriveCommitmentKeys(localCommitPoint, true,
-			lc.localChanCfg, lc.remoteChanCfg)
+			lc.channelState.ChanType, lc.localChanCfg,
+			lc.remoteChanCfg)
 	}
 	if remoteCommitPoint != nil {
 		remoteCommitKeys = deriveCommitmentKeys(remoteCommitPoint, false,
-			lc.localChanCfg, lc.remoteChanCfg)
+			lc.channelState.ChanType, lc.localChanCfg,
+			lc.remoteChanCfg)
 	}
 
 	// With the key rings re-created, we'll now convert all the on-disk
@@ -913,6 +915,9 @@ type CommitmentKeyRing struct {
 	// from the local payment base point or the local private key from the
 	// base point secret. This may be included in a SignDescriptor to
 	// generate signatures for the local payment key.
+	//
+	// NOTE: This will be nil on the remote party's commitment of a channel
+	// with a static remote key, as our payment key isn't tweaked there.
 	LocalCommitKeyTweak []byte
 
 	// TODO(roasbeef): need delay tweak as well?
@@ -938,7 +943,8 @@ type CommitmentKeyRing struct {
 
 	// NoDelayKey is the other party's payment key in the commitment tx.
 	// This is the key used to generate the unencumbered output within the
-	// commitment transaction.
+	// commitment transaction. For channels with a static remote key, this
+	// is the other party's untweaked payment base point.
 	NoDelayKey *btcec.PublicKey
 
 	// RevocationKey is the key that can be used by the other party to
@@ -949,8 +955,10 @@ type CommitmentKeyRing struct {
 
 // deriveCommitmentKey generates a new commitment key set using the base points
 // and commitment point. The keys are derived differently depending whether the
-// commitment transaction is ours or the remote peer's.
+// commitment transaction is ours or the remote peer's, and whether the channel
+// type uses a static remote key.
 func deriveCommitmentKeys(commitPoint *btcec.PublicKey, isOurCommit bool,
+	chanType channeldb.ChannelType,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig) *CommitmentKeyRing {
 
 	// First, we'll derive all the keys that don't depend on the context of
@@ -995,11 +1003,25 @@ func deriveCommitmentKeys(commitPoint *btcec.PublicKey, isOurCommit bool,
 	// With the base points assigned, we can now derive the actual keys
 	// using the base point, and the current commitment tweak.
 	keyRing.DelayKey = TweakPubKey(delayBasePoint, commitPoint)
-	keyRing.NoDelayKey = TweakPubKey(noDelayBasePoint, commitPoint)
 	keyRing.RevocationKey = DeriveRevocationPubkey(
 		revocationBasePoint, commitPoint,
 	)
 
+	// If the channel uses a static remote key, then the unencumbered
+	// output pays directly to the payment base point of the party that
+	// doesn't own the commitment. If this is the remote party's
+	// commitment, that base point is ours, so we'll also blank out the
+	// local commit tweak to signal that our key shouldn't be tweaked when
+	// signing for that output.
+	if chanType.HasStaticRemoteKey() {
+		keyRing.NoDelayKey = noDelayBasePoint
+		if !isOurCommit {
+			keyRing.LocalCommitKeyTweak = nil
+		}
+	} else {
+		keyRing.NoDelayKey = TweakPubKey(noDelayBasePoint, commitPoint)
+	}
+
 	return keyRing
 }
 
@@ -1536,7 +1558,8 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			wireMsg.Amount.ToSatoshis(), remoteDustLimit)
 		if !isDustRemote {
 			theirP2WSH, theirWitnessScript, err := genHtlcScript(
-				false, false, wireMsg.Expiry, wireMsg.PaymentHash,
+				lc.channelState.ChanType, false, false,
+				wireMsg.Expiry, wireMsg.PaymentHash,
 				remoteCommitKeys,
 			)
 			if err != nil {
@@ -1695,8 +1718,8 @@ func (lc *LightningChannel) restoreCommitState(
 		// We'll also re-create the set of commitment keys needed to
 		// fully re-derive the state.
 		pendingRemoteKeyChain = deriveCommitmentKeys(
-			pendingCommitPoint, false, lc.localChanCfg,
-			lc.remoteChanCfg,
+			pendingCommitPoint, false, lc.channelState.ChanType,
+			lc.localChanCfg, lc.remoteChanCfg,
 		)
 	}
 
@@ -1956,7 +1979,8 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	// With the commitment point generated, we can now generate the four
 	// keys we'll need to reconstruct the commitment state,
 	keyRing := deriveCommitmentKeys(commitmentPoint, false,
-		&chanState.LocalChanCfg, &chanState.RemoteChanCfg)
+		chanState.ChanType, &chanState.LocalChanCfg,
+		&chanState.RemoteChanCfg)
 
 	// Next, reconstruct the scripts as they were present at this state
 	// number so we can have the proper witness script to sign and include
@@ -1972,7 +1996,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	if err != nil {
 		return nil, err
 	}
-	localPkScript, err := CommitScriptUnencumbered(keyRing.NoDelayKey)
+	localWitnessScript, localPkScript, err := commitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2012,7 +2038,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		localSignDesc = &SignDescriptor{
 			SingleTweak:   keyRing.LocalCommitKeyTweak,
 			KeyDesc:       chanState.LocalChanCfg.PaymentBasePoint,
-			WitnessScript: localPkScript,
+			WitnessScript: localWitnessScript,
 			Output: &wire.TxOut{
 				PkScript: localPkScript,
 				Value:    int64(localAmt),
@@ -2074,6 +2100,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = senderHTLCScript(
 				keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 				keyRing.RevocationKey, htlc.RHash[:],
+				chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2086,7 +2113,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = receiverHTLCScript(
 				htlc.RefundTimeout, keyRing.LocalHtlcKey,
 				keyRing.RemoteHtlcKey, keyRing.RevocationKey,
-				htlc.RHash[:],
+				htlc.RHash[:], chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2338,49 +2365,59 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// on its total weight. Once we have the total weight, we'll multiply
 	// by the current fee-per-kw, then divide by 1000 to get the proper
 	// fee.
-	totalCommitWeight := CommitWeight + (HtlcWeight * numHTLCs)
+	chanType := lc.channelState.ChanType
+	totalCommitWeight := CommitWeightForType(chanType) +
+		(HtlcWeight * numHTLCs)
 
 	// With the weight known, we can now calculate the commitment fee,
 	// ensuring that we account for any dust outputs trimmed above.
 	commitFee := c.feePerKw.FeeForWeight(totalCommitWeight)
-	commitFeeMSat := lnwire.NewMSatFromSatoshis(commitFee)
 
-	// Currently, within the protocol, the initiator always pays the fees.
-	// So we'll subtract the fee amount from the balance of the current
-	// initiator. If the initiator is unable to pay the fee fully, then
-	// their entire output is consumed.
+	// Currently, within the protocol, the initiator always pays the fees,
+	// as well as the value of any anchor outputs. So we'll subtract these
+	// amounts from the balance of the current initiator. If the initiator
+	// is unable to pay them fully, then their entire output is consumed.
+	initiatorCost := commitFee + anchorAmount(chanType)
+	initiatorCostMSat := lnwire.NewMSatFromSatoshis(initiatorCost)
 	switch {
-	case lc.channelState.IsInitiator && commitFee > ourBalance.ToSatoshis():
+	case lc.channelState.IsInitiator && initiatorCost > ourBalance.ToSatoshis():
 		ourBalance = 0
 
 	case lc.channelState.IsInitiator:
-		ourBalance -= commitFeeMSat
+		ourBalance -= initiatorCostMSat
 
-	case !lc.channelState.IsInitiator && commitFee > theirBalance.ToSatoshis():
+	case !lc.channelState.IsInitiator && initiatorCost > theirBalance.ToSatoshis():
 		theirBalance = 0
 
 	case !lc.channelState.IsInitiator:
-		theirBalance -= commitFeeMSat
+		theirBalance -= initiatorCostMSat
 	}
 
 	var (
 		delay                      uint32
 		delayBalance, p2wkhBalance btcutil.Amount
+		localFundingKey            *btcec.PublicKey
+		remoteFundingKey           *btcec.PublicKey
 	)
 	if c.isOurs {
 		delay = uint32(lc.localChanCfg.CsvDelay)
 		delayBalance = ourBalance.ToSatoshis()
 		p2wkhBalance = theirBalance.ToSatoshis()
+		localFundingKey = lc.localChanCfg.MultiSigKey.PubKey
+		remoteFundingKey = lc.remoteChanCfg.MultiSigKey.PubKey
 	} else {
 		delay = uint32(lc.remoteChanCfg.CsvDelay)
 		delayBalance = theirBalance.ToSatoshis()
 		p2wkhBalance = ourBalance.ToSatoshis()
+		localFundingKey = lc.remoteChanCfg.MultiSigKey.PubKey
+		remoteFundingKey = lc.localChanCfg.MultiSigKey.PubKey
 	}
 
 	// Generate a new commitment transaction with all the latest
 	// unsettled/un-timed out HTLCs.
-	commitTx, err := CreateCommitTx(lc.fundingTxIn(), keyRing, delay,
-		delayBalance, p2wkhBalance, c.dustLimit)
+	commitTx, err := CreateCommitTx(chanType, lc.fundingTxIn(), keyRing,
+		localFundingKey, remoteFundingKey, delay, delayBalance,
+		p2wkhBalance, c.dustLimit, numHTLCs)
 	if err != nil {
 		return err
 	}
@@ -2671,7 +2708,8 @@ func processRemoveEntry(htlc *PaymentDescriptor, ourBalance,
 // generating a new commitment for the remote party. The jobs generated by the
 // signature can be submitted to the sigPool to generate all the signatures
 // asynchronously and in parallel.
-func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
+func genRemoteHtlcSigJobs(chanType channeldb.ChannelType,
+	keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
 	remoteCommitView *commitment) ([]signJob, chan struct{}, error) {
 
@@ -2719,7 +2757,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.tx, err = createHtlcTimeoutTx(
-			op, outputAmt, htlc.Timeout,
+			chanType, op, outputAmt, htlc.Timeout,
 			uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
@@ -2737,7 +2775,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Output: &wire.TxOut{
 				Value: int64(htlc.Amount.ToSatoshis()),
 			},
-			HashType:   txscript.SigHashAll,
+			HashType:   HtlcSigHashType(chanType),
 			SigHashes:  txscript.NewTxSigHashes(sigJob.tx),
 			InputIndex: 0,
 		}
@@ -2771,7 +2809,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.tx, err = createHtlcSuccessTx(
-			op, outputAmt, uint32(remoteChanCfg.CsvDelay),
+			chanType, op, outputAmt, uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
 		if err != nil {
@@ -2788,7 +2826,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Output: &wire.TxOut{
 				Value: int64(htlc.Amount.ToSatoshis()),
 			},
-			HashType:   txscript.SigHashAll,
+			HashType:   HtlcSigHashType(chanType),
 			SigHashes:  txscript.NewTxSigHashes(sigJob.tx),
 			InputIndex: 0,
 		}
@@ -2996,8 +3034,8 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	// Grab the next commitment point for the remote party. This will be
 	// used within fetchCommitmentView to derive all the keys necessary to
 	// construct the commitment state.
-	keyRing := deriveCommitmentKeys(commitPoint, false, lc.localChanCfg,
-		lc.remoteChanCfg)
+	keyRing := deriveCommitmentKeys(commitPoint, false,
+		lc.channelState.ChanType, lc.localChanCfg, lc.remoteChanCfg)
 
 	// Create a new commitment view which will calculate the evaluated
 	// state of the remote node's new commitment including our latest added
@@ -3032,8 +3070,9 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	// need to generate signatures of each of them for the remote party's
 	// commitment state. We do so in two phases: first we generate and
 	// submit the set of signature jobs to the worker pool.
-	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(keyRing,
-		lc.localChanCfg, lc.remoteChanCfg, newCommitView,
+	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+		lc.channelState.ChanType, keyRing, lc.localChanCfg,
+		lc.remoteChanCfg, newCommitView,
 	)
 	if err != nil {
 		return sig, htlcSigs, err
@@ -3527,13 +3566,15 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 	// Add the fee from the previous commitment state back to the
 	// initiator's balance, so that the fee can be recalculated and
 	// re-applied in case fee estimation parameters have changed or the
-	// number of outstanding HTLCs has changed.
+	// number of outstanding HTLCs has changed. Any anchor outputs paid for
+	// by the initiator are added back as well, as they're re-applied
+	// along with the fee.
+	chanType := lc.channelState.ChanType
+	initiatorCost := commitChain.tip().fee + anchorAmount(chanType)
 	if lc.channelState.IsInitiator {
-		ourBalance += lnwire.NewMSatFromSatoshis(
-			commitChain.tip().fee)
+		ourBalance += lnwire.NewMSatFromSatoshis(initiatorCost)
 	} else if !lc.channelState.IsInitiator {
-		theirBalance += lnwire.NewMSatFromSatoshis(
-			commitChain.tip().fee)
+		theirBalance += lnwire.NewMSatFromSatoshis(initiatorCost)
 	}
 	nextHeight := commitChain.tip().height + 1
 
@@ -3599,7 +3640,7 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 		totalHtlcWeight += HtlcWeight
 	}
 
-	totalCommitWeight := CommitWeight + totalHtlcWeight
+	totalCommitWeight := CommitWeightForType(chanType) + totalHtlcWeight
 	return ourBalance, theirBalance, totalCommitWeight, filteredHTLCView, feePerKw
 }
 
@@ -3635,9 +3676,10 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 		view, remoteChain, false,
 	)
 
-	// Calculate the commitment fee, and subtract it from the initiator's
-	// balance.
-	commitFee := feePerKw.FeeForWeight(commitWeight)
+	// Calculate the commitment fee, and subtract it along with any anchor
+	// outputs from the initiator's balance.
+	commitFee := feePerKw.FeeForWeight(commitWeight) +
+		anchorAmount(lc.channelState.ChanType)
 	commitFeeMsat := lnwire.NewMSatFromSatoshis(commitFee)
 	if lc.channelState.IsInitiator {
 		ourBalance -= commitFeeMsat
@@ -3738,7 +3780,8 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 // meant to verify all the signatures for HTLC's attached to a newly created
 // commitment state. The jobs generated are fully populated, and can be sent
 // directly into the pool of workers.
-func genHtlcSigValidationJobs(localCommitmentView *commitment,
+func genHtlcSigValidationJobs(chanType channeldb.ChannelType,
+	localCommitmentView *commitment,
 	keyRing *CommitmentKeyRing, htlcSigs []lnwire.Sig,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig) ([]verifyJob, error) {
 
@@ -3786,8 +3829,9 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcSuccessFee(feePerKw)
 				outputAmt := htlc.Amount.ToSatoshis() - htlcFee
 
-				successTx, err := createHtlcSuccessTx(op,
-					outputAmt, uint32(localChanCfg.CsvDelay),
+				successTx, err := createHtlcSuccessTx(
+					chanType, op, outputAmt,
+					uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey)
 				if err != nil {
 					return nil, err
@@ -3796,7 +3840,7 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				hashCache := txscript.NewTxSigHashes(successTx)
 				sigHash, err := txscript.CalcWitnessSigHash(
 					htlc.ourWitnessScript, hashCache,
-					txscript.SigHashAll, successTx, 0,
+					HtlcSigHashType(chanType), successTx, 0,
 					int64(htlc.Amount.ToSatoshis()),
 				)
 				if err != nil {
@@ -3838,8 +3882,8 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcTimeoutFee(feePerKw)
 				outputAmt := htlc.Amount.ToSatoshis() - htlcFee
 
-				timeoutTx, err := createHtlcTimeoutTx(op,
-					outputAmt, htlc.Timeout,
+				timeoutTx, err := createHtlcTimeoutTx(
+					chanType, op, outputAmt, htlc.Timeout,
 					uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey,
 				)
@@ -3850,7 +3894,7 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				hashCache := txscript.NewTxSigHashes(timeoutTx)
 				sigHash, err := txscript.CalcWitnessSigHash(
 					htlc.ourWitnessScript, hashCache,
-					txscript.SigHashAll, timeoutTx, 0,
+					HtlcSigHashType(chanType), timeoutTx, 0,
 					int64(htlc.Amount.ToSatoshis()),
 				)
 				if err != nil {
@@ -3992,8 +4036,8 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		return err
 	}
 	commitPoint := ComputeCommitmentPoint(commitSecret[:])
-	keyRing := deriveCommitmentKeys(commitPoint, true, lc.localChanCfg,
-		lc.remoteChanCfg)
+	keyRing := deriveCommitmentKeys(commitPoint, true,
+		lc.channelState.ChanType, lc.localChanCfg, lc.remoteChanCfg)
 
 	// With the current commitment point re-calculated, construct the new
 	// commitment view which includes all the entries (pending or committed)
@@ -4039,8 +4083,8 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	// pool to verify each of the HTLc signatures presented. Once
 	// generated, we'll submit these jobs to the worker pool.
 	verifyJobs, err := genHtlcSigValidationJobs(
-		localCommitmentView, keyRing, htlcSigs, lc.localChanCfg,
-		lc.remoteChanCfg,
+		lc.channelState.ChanType, localCommitmentView, keyRing,
+		htlcSigs, lc.localChanCfg, lc.remoteChanCfg,
 	)
 	if err != nil {
 		return err
@@ -4838,7 +4882,8 @@ func (lc *LightningChannel) ShortChanID() lnwire.ShortChannelID {
 // genHtlcScript generates the proper P2WSH public key scripts for the HTLC
 // output modified by two-bits denoting if this is an incoming HTLC, and if the
 // HTLC is being applied to their commitment transaction or ours.
-func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
+func genHtlcScript(chanType channeldb.ChannelType, isIncoming, ourCommit bool,
+	timeout uint32, rHash [32]byte,
 	keyRing *CommitmentKeyRing) ([]byte, []byte, error) {
 
 	var (
@@ -4846,6 +4891,11 @@ func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
 		err           error
 	)
 
+	// Anchor channels require the HTLC outputs to be spent by a
+	// confirmed transaction only, as the commitment's anchors are the
+	// only outputs that can be used for CPFP.
+	confirmedSpend := chanType.HasAnchors()
+
 	// Generate the proper redeem scripts for the HTLC output modified by
 	// two-bits denoting if this is an incoming HTLC, and if the HTLC is
 	// being applied to their commitment transaction or ours.
@@ -4856,28 +4906,31 @@ func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
 	case isIncoming && ourCommit:
 		witnessScript, err = receiverHTLCScript(timeout,
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-			keyRing.RevocationKey, rHash[:])
+			keyRing.RevocationKey, rHash[:], confirmedSpend)
 
 	// We're being paid via an HTLC by the remote party, and the HTLC is
 	// being added to their commitment transaction, so we use the sender's
 	// version of the HTLC script.
 	case isIncoming && !ourCommit:
 		witnessScript, err = senderHTLCScript(keyRing.RemoteHtlcKey,
-			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// We're sending an HTLC which is being added to our commitment
 	// transaction. Therefore, we need to use the sender's version of the
 	// HTLC script.
 	case !isIncoming && ourCommit:
 		witnessScript, err = senderHTLCScript(keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// Finally, we're paying the remote party via an HTLC, which is being
 	// added to their commitment transaction. Therefore, we use the
 	// receiver's version of the HTLC script.
 	case !isIncoming && !ourCommit:
 		witnessScript, err = receiverHTLCScript(timeout, keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -4907,8 +4960,8 @@ func (lc *LightningChannel) addHTLC(commitTx *wire.MsgTx, ourCommit bool,
 	timeout := paymentDesc.Timeout
 	rHash := paymentDesc.RHash
 
-	p2wsh, witnessScript, err := genHtlcScript(isIncoming, ourCommit,
-		timeout, rHash, keyRing)
+	p2wsh, witnessScript, err := genHtlcScript(lc.channelState.ChanType,
+		isIncoming, ourCommit, timeout, rHash, keyRing)
 	if err != nil {
 		return err
 	}
@@ -5036,14 +5089,14 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 	// First, we'll generate the commitment point and the revocation point
 	// so we can re-construct the HTLC state and also our payment key.
 	keyRing := deriveCommitmentKeys(
-		commitPoint, false, &chanState.LocalChanCfg,
-		&chanState.RemoteChanCfg,
+		commitPoint, false, chanState.ChanType,
+		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 	)
 
 	// Next, we'll obtain HTLC resolutions for all the outgoing HTLC's we
 	// had on their commitment transaction.
 	htlcResolutions, err := extractHtlcResolutions(
-		SatPerKWeight(remoteCommit.FeePerKw), false, signer, remoteCommit.Htlcs,
+		chanState.ChanType, SatPerKWeight(remoteCommit.FeePerKw), false, signer, remoteCommit.Htlcs,
 		keyRing, &chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 		*commitSpend.SpenderTxHash, pCache,
 	)
@@ -5056,7 +5109,9 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 	// Before we can generate the proper sign descriptor, we'll need to
 	// locate the output index of our non-delayed output on the commitment
 	// transaction.
-	selfP2WKH, err := CommitScriptUnencumbered(keyRing.NoDelayKey)
+	selfWitnessScript, selfPkScript, err := commitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create self commit script: %v", err)
 	}
@@ -5067,7 +5122,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 	)
 
 	for outputIndex, txOut := range commitTxBroadcast.TxOut {
-		if bytes.Equal(txOut.PkScript, selfP2WKH) {
+		if bytes.Equal(txOut.PkScript, selfPkScript) {
 			selfPoint = &wire.OutPoint{
 				Hash:  *commitSpend.SpenderTxHash,
 				Index: uint32(outputIndex),
@@ -5088,10 +5143,10 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 			SelfOutputSignDesc: SignDescriptor{
 				KeyDesc:       localPayBase,
 				SingleTweak:   keyRing.LocalCommitKeyTweak,
-				WitnessScript: selfP2WKH,
+				WitnessScript: selfWitnessScript,
 				Output: &wire.TxOut{
 					Value:    localBalance,
-					PkScript: selfP2WKH,
+					PkScript: selfPkScript,
 				},
 				HashType: txscript.SigHashAll,
 			},
@@ -5159,7 +5214,9 @@ type IncomingHtlcResolution struct {
 	// pass after the SignedSuccessTx is confirmed in the chain before the
 	// output can be swept.
 	//
-	// NOTE: If SignedSuccessTx is nil, then this field isn't needed.
+	// NOTE: If SignedSuccessTx is nil, then this is the relative time
+	// lock that must pass after the commitment transaction is confirmed,
+	// which is only non-zero for channels using anchor outputs.
 	CsvDelay uint32
 
 	// ClaimOutpoint is the final outpoint that needs to be spent in order
@@ -5199,7 +5256,9 @@ type OutgoingHtlcResolution struct {
 	// pass after the SignedTimeoutTx is confirmed in the chain before the
 	// output can be swept.
 	//
-	// NOTE: If SignedTimeoutTx is nil, then this field isn't needed.
+	// NOTE: If SignedTimeoutTx is nil, then this is the relative time
+	// lock that must pass after the commitment transaction is confirmed,
+	// which is only non-zero for channels using anchor outputs.
 	CsvDelay uint32
 
 	// ClaimOutpoint is the final outpoint that needs to be spent in order
@@ -5232,8 +5291,9 @@ type HtlcResolutions struct {
 // newOutgoingHtlcResolution generates a new HTLC resolution capable of
 // allowing the caller to sweep an outgoing HTLC present on either their, or
 // the remote party's commitment transaction.
-func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelConfig,
-	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
+func newOutgoingHtlcResolution(chanType channeldb.ChannelType, signer Signer,
+	localChanCfg *channeldb.ChannelConfig, commitHash chainhash.Hash,
+	htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32, localCommit bool,
 ) (*OutgoingHtlcResolution, error) {
 
@@ -5251,6 +5311,7 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 		htlcReceiverScript, err := receiverHTLCScript(htlc.RefundTimeout,
 			keyRing.LocalHtlcKey, keyRing.RemoteHtlcKey,
 			keyRing.RevocationKey, htlc.RHash[:],
+			chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5261,9 +5322,12 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 		}
 
 		// With the script generated, we can completely populated the
-		// SignDescriptor needed to sweep the output.
+		// SignDescriptor needed to sweep the output. For anchor
+		// channels, the output can only be swept once the commitment
+		// has confirmed, which we signal with a CSV delay of one.
 		return &OutgoingHtlcResolution{
 			Expiry:        htlc.RefundTimeout,
+			CsvDelay:      confirmedSpendDelay(chanType),
 			ClaimOutpoint: op,
 			SweepSignDesc: SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
@@ -5290,7 +5354,7 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	// With the fee calculated, re-construct the second level timeout
 	// transaction.
 	timeoutTx, err := createHtlcTimeoutTx(
-		op, secondLevelOutputAmt, htlc.RefundTimeout, csvDelay,
+		chanType, op, secondLevelOutputAmt, htlc.RefundTimeout, csvDelay,
 		keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
@@ -5301,7 +5365,8 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	// that's capable of generating the signature required to spend the
 	// HTLC output using the timeout transaction.
 	htlcCreationScript, err := senderHTLCScript(keyRing.LocalHtlcKey,
-		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:])
+		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:],
+		chanType.HasAnchors())
 	if err != nil {
 		return nil, err
 	}
@@ -5320,7 +5385,8 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	// With the sign desc created, we can now construct the full witness
 	// for the timeout transaction, and populate it as well.
 	timeoutWitness, err := senderHtlcSpendTimeout(
-		htlc.Signature, signer, &timeoutSignDesc, timeoutTx,
+		htlc.Signature, HtlcSigHashType(chanType), signer,
+		&timeoutSignDesc, timeoutTx,
 	)
 	if err != nil {
 		return nil, err
@@ -5372,8 +5438,9 @@ func newOutgoingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 // they can just sweep the output immediately with knowledge of the pre-image.
 //
 // TODO(roasbeef) consolidate code with above func
-func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelConfig,
-	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
+func newIncomingHtlcResolution(chanType channeldb.ChannelType, signer Signer,
+	localChanCfg *channeldb.ChannelConfig, commitHash chainhash.Hash,
+	htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32,
 	localCommit bool, preimage [32]byte) (*IncomingHtlcResolution, error) {
 
@@ -5390,6 +5457,7 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 		htlcSenderScript, err := senderHTLCScript(
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 			keyRing.RevocationKey, htlc.RHash[:],
+			chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5400,11 +5468,13 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 		}
 
 		// With the script generated, we can completely populated the
-		// SignDescriptor needed to sweep the output.
+		// SignDescriptor needed to sweep the output. For anchor
+		// channels, the output can only be swept once the commitment
+		// has confirmed, which we signal with a CSV delay of one.
 		return &IncomingHtlcResolution{
 			Preimage:      preimage,
 			ClaimOutpoint: op,
-			CsvDelay:      csvDelay,
+			CsvDelay:      confirmedSpendDelay(chanType),
 			SweepSignDesc: SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
 				SingleTweak:   keyRing.LocalHtlcKeyTweak,
@@ -5425,7 +5495,7 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	htlcFee := htlcSuccessFee(feePerKw)
 	secondLevelOutputAmt := htlc.Amt.ToSatoshis() - htlcFee
 	successTx, err := createHtlcSuccessTx(
-		op, secondLevelOutputAmt, csvDelay,
+		chanType, op, secondLevelOutputAmt, csvDelay,
 		keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
@@ -5436,7 +5506,7 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	// SignDesc needed spend the HTLC output using the success transaction.
 	htlcCreationScript, err := receiverHTLCScript(htlc.RefundTimeout,
 		keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-		keyRing.RevocationKey, htlc.RHash[:],
+		keyRing.RevocationKey, htlc.RHash[:], chanType.HasAnchors(),
 	)
 	if err != nil {
 		return nil, err
@@ -5456,7 +5526,8 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 	// Next, we'll construct the full witness needed to satisfy the input
 	// of the success transaction.
 	successWitness, err := receiverHtlcSpendRedeem(
-		htlc.Signature, preimage[:], signer, &successSignDesc, successTx,
+		htlc.Signature, HtlcSigHashType(chanType), preimage[:], signer,
+		&successSignDesc, successTx,
 	)
 	if err != nil {
 		return nil, err
@@ -5504,7 +5575,8 @@ func newIncomingHtlcResolution(signer Signer, localChanCfg *channeldb.ChannelCon
 // extractHtlcResolutions creates a series of outgoing HTLC resolutions, and
 // the local key used when generating the HTLC scrips. This function is to be
 // used in two cases: force close, or a unilateral close.
-func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
+func extractHtlcResolutions(chanType channeldb.ChannelType,
+	feePerKw SatPerKWeight, ourCommit bool,
 	signer Signer, htlcs []channeldb.HTLC, keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, pCache PreimageCache) (*HtlcResolutions, error) {
@@ -5541,7 +5613,7 @@ func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
 			var pre [32]byte
 			copy(pre[:], preimage)
 			ihr, err := newIncomingHtlcResolution(
-				signer, localChanCfg, commitHash, &htlc, keyRing,
+				chanType, signer, localChanCfg, commitHash, &htlc, keyRing,
 				feePerKw, dustLimit, uint32(csvDelay), ourCommit,
 				pre,
 			)
@@ -5554,7 +5626,7 @@ func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
 		}
 
 		ohr, err := newOutgoingHtlcResolution(
-			signer, localChanCfg, commitHash, &htlc, keyRing,
+			chanType, signer, localChanCfg, commitHash, &htlc, keyRing,
 			feePerKw, dustLimit, uint32(csvDelay), ourCommit,
 		)
 		if err != nil {
@@ -5599,11 +5671,37 @@ type LocalForceCloseSummary struct {
 	// HTLC's, we'll need to go to the second level to sweep them fully.
 	HtlcResolutions *HtlcResolutions
 
+	// AnchorResolution contains the data required to bump the fee of the
+	// commitment transaction by spending our anchor output using CPFP.
+	//
+	// NOTE: This will be nil if the channel doesn't use anchor outputs.
+	AnchorResolution *AnchorResolution
+
 	// ChanSnapshot is a snapshot of the final state of the channel at the
 	// time the summary was created.
 	ChanSnapshot channeldb.ChannelSnapshot
 }
 
+// AnchorResolution houses the information necessary to spend our anchor
+// output of a broadcast commitment transaction, allowing us to attach a child
+// transaction that bumps the effective fee rate of the commitment.
+type AnchorResolution struct {
+	// CommitAnchor is the outpoint of our anchor output within the
+	// commitment transaction.
+	CommitAnchor wire.OutPoint
+
+	// AnchorSignDescriptor is the sign descriptor required to spend the
+	// anchor output.
+	AnchorSignDescriptor SignDescriptor
+
+	// CommitWeight is the weight of the fully signed commitment
+	// transaction.
+	CommitWeight int64
+
+	// CommitFee is the fee paid by the commitment transaction.
+	CommitFee btcutil.Amount
+}
+
 // ForceClose executes a unilateral closure of the transaction at the current
 // lowest commitment height of the channel. Following a force closure, all
 // state transitions, or modifications to the state update logs will be
@@ -5656,8 +5754,8 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 		return nil, err
 	}
 	commitPoint := ComputeCommitmentPoint(revocation[:])
-	keyRing := deriveCommitmentKeys(commitPoint, true, &chanState.LocalChanCfg,
-		&chanState.RemoteChanCfg)
+	keyRing := deriveCommitmentKeys(commitPoint, true, chanState.ChanType,
+		&chanState.LocalChanCfg, &chanState.RemoteChanCfg)
 	selfScript, err := CommitScriptToSelf(csvTimeout, keyRing.DelayKey,
 		keyRing.RevocationKey)
 	if err != nil {
@@ -5721,22 +5819,83 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 	// outgoing HTLC's that we'll need to claim as well.
 	txHash := commitTx.TxHash()
 	htlcResolutions, err := extractHtlcResolutions(
-		SatPerKWeight(localCommit.FeePerKw), true, signer,
-		localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
+		chanState.ChanType, SatPerKWeight(localCommit.FeePerKw), true,
+		signer, localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, txHash, pCache)
 	if err != nil {
 		return nil, err
 	}
 
+	// Finally, if this is an anchor channel, we'll locate our anchor
+	// output so the commitment's fee can be bumped if needed.
+	var anchorResolution *AnchorResolution
+	if chanState.ChanType.HasAnchors() {
+		anchorResolution, err = newAnchorResolution(
+			chanState, commitTx, localCommit.CommitFee,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &LocalForceCloseSummary{
 		ChanPoint:        chanState.FundingOutpoint,
 		CloseTx:          commitTx,
 		CommitResolution: commitResolution,
 		HtlcResolutions:  htlcResolutions,
+		AnchorResolution: anchorResolution,
 		ChanSnapshot:     *chanState.Snapshot(),
 	}, nil
 }
 
+// newAnchorResolution creates a new AnchorResolution for our anchor output
+// within the passed fully signed commitment transaction. If the commitment
+// doesn't carry our anchor, nil is returned.
+func newAnchorResolution(chanState *channeldb.OpenChannel,
+	commitTx *wire.MsgTx, commitFee btcutil.Amount) (*AnchorResolution,
+	error) {
+
+	fundingKey := chanState.LocalChanCfg.MultiSigKey
+	anchorScript, err := CommitScriptAnchor(fundingKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	anchorPkScript, err := WitnessScriptHash(anchorScript)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txOut := range commitTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, anchorPkScript) {
+			continue
+		}
+
+		return &AnchorResolution{
+			CommitAnchor: wire.OutPoint{
+				Hash:  commitTx.TxHash(),
+				Index: uint32(i),
+			},
+			AnchorSignDescriptor: SignDescriptor{
+				KeyDesc:       fundingKey,
+				WitnessScript: anchorScript,
+				Output: &wire.TxOut{
+					PkScript: anchorPkScript,
+					Value:    txOut.Value,
+				},
+				HashType: txscript.SigHashAll,
+			},
+			CommitWeight: blockchain.GetTransactionWeight(
+				btcutil.NewTx(commitTx),
+			),
+			CommitFee: commitFee,
+		}, nil
+	}
+
+	// Our anchor is omitted if we have nothing at stake in the
+	// commitment, in which case there's nothing to resolve.
+	return nil, nil
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -5917,8 +6076,10 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 		lc.computeView(htlcView, false, false)
 
 	// If we are the channel initiator, we must remember to subtract the
-	// commitment fee from our available balance.
-	commitFee := feePerKw.FeeForWeight(commitWeight)
+	// commitment fee, along with any anchor outputs, from our available
+	// balance.
+	commitFee := feePerKw.FeeForWeight(commitWeight) +
+		anchorAmount(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance -= lnwire.NewMSatFromSatoshis(commitFee)
 	}
@@ -6069,10 +6230,18 @@ func (lc *LightningChannel) generateRevocation(height uint64) (*lnwire.RevokeAnd
 // funding output. The commitment transaction contains two outputs: one paying
 // to the "owner" of the commitment transaction which can be spent after a
 // relative block delay or revocation event, and the other paying the
-// counterparty within the channel, which can be spent immediately.
-func CreateCommitTx(fundingOutput wire.TxIn,
-	keyRing *CommitmentKeyRing, csvTimeout uint32,
-	amountToSelf, amountToThem, dustLimit btcutil.Amount) (*wire.MsgTx, error) {
+// counterparty within the channel, which can be spent immediately. If the
+// channel type uses anchor outputs, the output paying the counterparty is
+// encumbered by a one block CSV delay, and up to two additional anchor
+// outputs, one for each party's funding key, are added so either side can
+// bump the fee of the commitment using CPFP. As in BOLT 3, a party's anchor is
+// omitted if it has no output on the commitment, and there are no untrimmed
+// HTLCs, of which numHTLCs must be passed in.
+func CreateCommitTx(chanType channeldb.ChannelType, fundingOutput wire.TxIn,
+	keyRing *CommitmentKeyRing, localFundingKey,
+	remoteFundingKey *btcec.PublicKey, csvTimeout uint32,
+	amountToSelf, amountToThem, dustLimit btcutil.Amount,
+	numHTLCs int64) (*wire.MsgTx, error) {
 
 	// First, we create the script for the delayed "pay-to-self" output.
 	// This output has 2 main redemption clauses: either we can redeem the
@@ -6090,8 +6259,10 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	}
 
 	// Next, we create the script paying to them. This is just a regular
-	// P2WPKH output, without any added CSV delay.
-	theirWitnessKeyHash, err := CommitScriptUnencumbered(keyRing.NoDelayKey)
+	// P2WPKH output, without any added CSV delay, unless this is an
+	// anchor channel, in which case it's a P2WSH output that can only be
+	// spent once the commitment has confirmed.
+	_, theirScript, err := commitScriptToRemote(chanType, keyRing.NoDelayKey)
 	if err != nil {
 		return nil, err
 	}
@@ -6103,22 +6274,82 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	commitTx.AddTxIn(&fundingOutput)
 
 	// Avoid creating dust outputs within the commitment transaction.
-	if amountToSelf >= dustLimit {
+	localOutput := amountToSelf >= dustLimit
+	if localOutput {
 		commitTx.AddTxOut(&wire.TxOut{
 			PkScript: payToUsScriptHash,
 			Value:    int64(amountToSelf),
 		})
 	}
-	if amountToThem >= dustLimit {
+	remoteOutput := amountToThem >= dustLimit
+	if remoteOutput {
 		commitTx.AddTxOut(&wire.TxOut{
-			PkScript: theirWitnessKeyHash,
+			PkScript: theirScript,
 			Value:    int64(amountToThem),
 		})
 	}
 
+	// Finally, if this is an anchor channel, we'll add an anchor output
+	// for each party that has something at stake in the commitment,
+	// allowing either of them to attach a child transaction to the
+	// commitment to bump its fee.
+	if chanType.HasAnchors() {
+		var anchorKeys []*btcec.PublicKey
+		if localOutput || numHTLCs > 0 {
+			anchorKeys = append(anchorKeys, localFundingKey)
+		}
+		if remoteOutput || numHTLCs > 0 {
+			anchorKeys = append(anchorKeys, remoteFundingKey)
+		}
+
+		for _, fundingKey := range anchorKeys {
+			anchorScript, err := CommitScriptAnchor(fundingKey)
+			if err != nil {
+				return nil, err
+			}
+			anchorPkScript, err := WitnessScriptHash(anchorScript)
+			if err != nil {
+				return nil, err
+			}
+
+			commitTx.AddTxOut(&wire.TxOut{
+				PkScript: anchorPkScript,
+				Value:    int64(AnchorSize),
+			})
+		}
+	}
+
 	return commitTx, nil
 }
 
+// commitScriptToRemote returns the witness script and output script of the
+// commitment output paying to the non-owner of the commitment, given the
+// channel type. For legacy channels the output is a plain P2WKH output, so
+// the witness script is the output script itself.
+func commitScriptToRemote(chanType channeldb.ChannelType,
+	key *btcec.PublicKey) ([]byte, []byte, error) {
+
+	if !chanType.HasAnchors() {
+		pkScript, err := CommitScriptUnencumbered(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return pkScript, pkScript, nil
+	}
+
+	witnessScript, err := CommitScriptToRemoteConfirmed(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkScript, err := WitnessScriptHash(witnessScript)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return witnessScript, pkScript, nil
+}
+
 // CreateCooperativeCloseTx creates a transaction which if signed by both
 // parties, then broadcast cooperatively closes an active channel. The creation
 // of the closure transaction is modified by a boolean indicating if the party
@@ -6160,7 +6391,40 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 // CalcFee returns the commitment fee to use for the given
 // fee rate (fee-per-kw).
 func (lc *LightningChannel) CalcFee(feeRate SatPerKWeight) btcutil.Amount {
-	return feeRate.FeeForWeight(CommitWeight)
+	return feeRate.FeeForWeight(CommitWeightForType(lc.channelState.ChanType))
+}
+
+// confirmedSpendDelay returns the relative delay that must pass before
+// outputs paying to us directly from the remote party's commitment can be
+// swept, given the channel type.
+func confirmedSpendDelay(chanType channeldb.ChannelType) uint32 {
+	if chanType.HasAnchors() {
+		return 1
+	}
+
+	return 0
+}
+
+// CommitWeightForType returns the weight of a base commitment transaction
+// without any HTLC outputs for the given channel type.
+func CommitWeightForType(chanType channeldb.ChannelType) int64 {
+	if chanType.HasAnchors() {
+		return AnchorCommitWeight
+	}
+
+	return CommitWeight
+}
+
+// anchorAmount returns the total value of the anchor outputs that the
+// initiator of a channel of the given type pays for on each commitment. As in
+// BOLT 3, this is deducted even if an anchor is omitted, in which case its
+// value goes to fees.
+func anchorAmount(chanType channeldb.ChannelType) btcutil.Amount {
+	if chanType.HasAnchors() {
+		return 2 * AnchorSize
+	}
+
+	return 0
 }
 
 // RemoteNextRevocation returns the channelState's RemoteNextRevocation.
@@ -17,6 +17,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -4829,7 +4830,7 @@ func TestChannelUnilateralClosePendingCommit(t *testing.T) {
 	})
 	aliceSignDesc.SigHashes = txscript.NewTxSigHashes(sweepTx)
 	sweepTx.TxIn[0].Witness, err = CommitSpendNoDelay(
-		aliceChannel.Signer, &aliceSignDesc, sweepTx,
+		aliceChannel.Signer, &aliceSignDesc, sweepTx, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to generate sweep witness: %v", err)
@@ -4850,6 +4851,313 @@ func TestChannelUnilateralClosePendingCommit(t *testing.T) {
 	}
 }
 
+// TestChannelUnilateralCloseStaticRemoteKey tests that if the remote party
+// broadcasts their commitment for a channel using the static remote key
+// format, our output pays directly to our untweaked payment basepoint, and we
+// can sweep it even without knowing the commitment point used.
+func TestChannelUnilateralCloseStaticRemoteKey(t *testing.T) {
+	t.Parallel()
+
+	// Create a test channel using the static remote key commitment
+	// format, funded evenly with Alice having 5 BTC, and Bob having 5 BTC.
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannelsWithType(
+		channeldb.SingleFunder | channeldb.StaticRemoteKeyBit,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll simulate Bob broadcasting his current commitment.
+	remoteCommit := bobChannel.channelState.LocalCommitment
+	bobCommit := remoteCommit.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	spendDetail := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	// Alice's output on Bob's commitment should pay directly to her
+	// untweaked payment basepoint.
+	aliceCfg := aliceChannel.channelState.LocalChanCfg
+	expectedScript, err := CommitScriptUnencumbered(
+		aliceCfg.PaymentBasePoint.PubKey,
+	)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	found := false
+	for _, txOut := range bobCommit.TxOut {
+		if bytes.Equal(txOut.PkScript, expectedScript) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("remote commitment doesn't pay to alice's " +
+			"untweaked payment basepoint")
+	}
+
+	// We'll now create the close summary using a commitment point other
+	// than the one Bob used. Since the output to Alice doesn't depend on
+	// it, she should still be able to locate it.
+	aliceCloseSummary, err := NewUnilateralCloseSummary(
+		aliceChannel.channelState, aliceChannel.Signer,
+		aliceChannel.pCache, spendDetail, remoteCommit,
+		aliceChannel.channelState.RemoteNextRevocation,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice close summary: %v", err)
+	}
+	if aliceCloseSummary.CommitResolution == nil {
+		t.Fatalf("unable to find alice's commit resolution")
+	}
+
+	// As the key isn't tweaked, the sign descriptor shouldn't carry a
+	// tweak.
+	aliceSignDesc := aliceCloseSummary.CommitResolution.SelfOutputSignDesc
+	if aliceSignDesc.SingleTweak != nil {
+		t.Fatalf("expected no single tweak for static remote key")
+	}
+
+	// Finally, we'll ensure that we're able to properly sweep our output
+	// using the tweakless witness.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: aliceCloseSummary.CommitResolution.SelfOutPoint,
+	})
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: testHdSeed[:],
+		Value:    aliceSignDesc.Output.Value,
+	})
+	aliceSignDesc.SigHashes = txscript.NewTxSigHashes(sweepTx)
+	sweepTx.TxIn[0].Witness, err = CommitSpendNoDelay(
+		aliceChannel.Signer, &aliceSignDesc, sweepTx, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate sweep witness: %v", err)
+	}
+
+	vm, err := txscript.NewEngine(
+		aliceSignDesc.Output.PkScript,
+		sweepTx, 0, txscript.StandardVerifyFlags, nil,
+		nil, aliceSignDesc.Output.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("sweep of static remote key output is invalid: %v",
+			err)
+	}
+}
+
+// TestForceCloseAnchors tests that a commitment using the anchor output
+// format carries an anchor output for each party, that the second-level HTLC
+// transactions are signed by the remote party using SIGHASH_SINGLE|ANYONECANPAY
+// and that the remote party's output can only be swept once the commitment
+// has confirmed.
+func TestForceCloseAnchors(t *testing.T) {
+	t.Parallel()
+
+	// Create a test channel using the anchor output commitment format,
+	// funded evenly with Alice having 5 BTC, and Bob having 5 BTC.
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannelsWithType(
+		channeldb.SingleFunder | channeldb.StaticRemoteKeyBit |
+			channeldb.AnchorOutputsBit,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll add an outgoing HTLC from Alice to Bob, and lock it in on both
+	// commitments.
+	htlcAmount := lnwire.NewMSatFromSatoshis(20000)
+	htlcAlice, _ := createHTLC(0, htlcAmount)
+	if _, err := aliceChannel.AddHTLC(htlcAlice, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlcAlice); err != nil {
+		t.Fatalf("bob unable to recv add htlc: %v", err)
+	}
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("Can't update the channel state: %v", err)
+	}
+
+	// Both commitments should carry two anchor outputs.
+	aliceCommit := aliceChannel.channelState.LocalCommitment.CommitTx
+	bobCommit := bobChannel.channelState.LocalCommitment.CommitTx
+	for _, commitTx := range []*wire.MsgTx{aliceCommit, bobCommit} {
+		numAnchors := 0
+		for _, txOut := range commitTx.TxOut {
+			if txOut.Value == int64(AnchorSize) {
+				numAnchors++
+			}
+		}
+		if numAnchors != 2 {
+			t.Fatalf("expected 2 anchor outputs, found %v",
+				numAnchors)
+		}
+	}
+
+	// Alice will now force close the channel.
+	closeSummary, err := aliceChannel.ForceClose()
+	if err != nil {
+		t.Fatalf("unable to force close channel: %v", err)
+	}
+
+	// Alice should be able to spend her anchor output in order to bump
+	// the fee of the commitment through CPFP.
+	anchorRes := closeSummary.AnchorResolution
+	if anchorRes == nil {
+		t.Fatalf("alice's anchor resolution not populated")
+	}
+	if anchorRes.CommitFee !=
+		aliceChannel.channelState.LocalCommitment.CommitFee {
+
+		t.Fatalf("expected commit fee %v, got %v",
+			aliceChannel.channelState.LocalCommitment.CommitFee,
+			anchorRes.CommitFee)
+	}
+
+	anchorSignDesc := anchorRes.AnchorSignDescriptor
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: anchorRes.CommitAnchor,
+	})
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: testHdSeed[:],
+		Value:    anchorSignDesc.Output.Value,
+	})
+	anchorSignDesc.SigHashes = txscript.NewTxSigHashes(sweepTx)
+	sweepTx.TxIn[0].Witness, err = CommitSpendAnchor(
+		aliceChannel.Signer, &anchorSignDesc, sweepTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate anchor witness: %v", err)
+	}
+	vm, err := txscript.NewEngine(
+		anchorSignDesc.Output.PkScript, sweepTx, 0,
+		txscript.StandardVerifyFlags, nil, nil,
+		anchorSignDesc.Output.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("anchor spend is invalid: %v", err)
+	}
+
+	// The second-level timeout transaction of the outgoing HTLC should
+	// carry Bob's signature using SIGHASH_SINGLE|ANYONECANPAY, and must
+	// only be valid once the commitment has confirmed.
+	if len(closeSummary.HtlcResolutions.OutgoingHTLCs) != 1 {
+		t.Fatalf("expected 1 outgoing htlc resolution, got %v",
+			len(closeSummary.HtlcResolutions.OutgoingHTLCs))
+	}
+	timeoutTx := closeSummary.HtlcResolutions.OutgoingHTLCs[0].SignedTimeoutTx
+	if timeoutTx == nil {
+		t.Fatalf("htlc timeout transaction not populated")
+	}
+	if timeoutTx.TxIn[0].Sequence != 1 {
+		t.Fatalf("expected sequence 1 on htlc timeout tx, got %v",
+			timeoutTx.TxIn[0].Sequence)
+	}
+	remoteSig := timeoutTx.TxIn[0].Witness[1]
+	expectedHashType := txscript.SigHashSingle |
+		txscript.SigHashAnyOneCanPay
+	if txscript.SigHashType(remoteSig[len(remoteSig)-1]) !=
+		expectedHashType {
+
+		t.Fatalf("expected remote htlc sig using sighash %v, got %v",
+			expectedHashType, remoteSig[len(remoteSig)-1])
+	}
+
+	htlcOutput := aliceCommit.TxOut[timeoutTx.TxIn[0].PreviousOutPoint.Index]
+	vm, err = txscript.NewEngine(
+		htlcOutput.PkScript, timeoutTx, 0,
+		txscript.StandardVerifyFlags, nil, nil, htlcOutput.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("htlc timeout spend is invalid: %v", err)
+	}
+
+	// Finally, if Bob instead broadcasts his commitment, Alice should be
+	// able to sweep her output, but only with a relative lock of one
+	// block.
+	bobTxHash := bobCommit.TxHash()
+	spendDetail := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+	aliceCloseSummary, err := NewUnilateralCloseSummary(
+		aliceChannel.channelState, aliceChannel.Signer,
+		aliceChannel.pCache, spendDetail,
+		bobChannel.channelState.LocalCommitment,
+		aliceChannel.channelState.RemoteCurrentRevocation,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice close summary: %v", err)
+	}
+	if aliceCloseSummary.CommitResolution == nil {
+		t.Fatalf("unable to find alice's commit resolution")
+	}
+
+	aliceSignDesc := aliceCloseSummary.CommitResolution.SelfOutputSignDesc
+	sweepTx = wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: aliceCloseSummary.CommitResolution.SelfOutPoint,
+		Sequence:         1,
+	})
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: testHdSeed[:],
+		Value:    aliceSignDesc.Output.Value,
+	})
+	aliceSignDesc.SigHashes = txscript.NewTxSigHashes(sweepTx)
+	sweepTx.TxIn[0].Witness, err = CommitSpendToRemoteConfirmed(
+		aliceChannel.Signer, &aliceSignDesc, sweepTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate sweep witness: %v", err)
+	}
+	vm, err = txscript.NewEngine(
+		aliceSignDesc.Output.PkScript, sweepTx, 0,
+		txscript.StandardVerifyFlags, nil, nil,
+		aliceSignDesc.Output.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("sweep of to_remote output is invalid: %v", err)
+	}
+
+	// Without the relative lock, the sweep must be rejected.
+	sweepTx.TxIn[0].Sequence = 0
+	aliceSignDesc.SigHashes = txscript.NewTxSigHashes(sweepTx)
+	sweepTx.TxIn[0].Witness, err = CommitSpendToRemoteConfirmed(
+		aliceChannel.Signer, &aliceSignDesc, sweepTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate sweep witness: %v", err)
+	}
+	vm, err = txscript.NewEngine(
+		aliceSignDesc.Output.PkScript, sweepTx, 0,
+		txscript.StandardVerifyFlags, nil, nil,
+		aliceSignDesc.Output.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err == nil {
+		t.Fatalf("sweep of to_remote output without relative lock " +
+			"should be invalid")
+	}
+}
+
 // TestDesyncHTLCs checks that we cannot add HTLCs that would make the
 // balance negative, when the remote and local update logs are desynced.
 func TestDesyncHTLCs(t *testing.T) {
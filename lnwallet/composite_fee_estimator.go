@@ -0,0 +1,472 @@
+package lnwallet
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFeeCacheDuration is the default amount of time an estimate
+	// produced by the CompositeFeeEstimator is served from its cache
+	// before the underlying sources are queried again.
+	DefaultFeeCacheDuration = 5 * time.Minute
+
+	// DefaultFeeSmoothingWindow is the default number of consecutive
+	// estimates for a confirmation target that the CompositeFeeEstimator
+	// takes the median of.
+	DefaultFeeSmoothingWindow = 3
+
+	// minFeeRetryBackoff is the amount of time the CompositeFeeEstimator
+	// waits before querying its sources again after all of them failed.
+	// The delay doubles with each consecutive failure, up to the cache
+	// duration.
+	minFeeRetryBackoff = 5 * time.Second
+)
+
+// ErrNoFeeEstimate is returned by a FeeSource that doesn't have enough data to
+// produce an estimate for the requested confirmation target.
+var ErrNoFeeEstimate = errors.New("fee source has no estimate")
+
+// FeeSource is a source of raw fee rate estimates that can be combined by the
+// CompositeFeeEstimator. Unlike a FeeEstimator, a FeeSource must not mask
+// failures with a fall back fee rate: any error, or the lack of an estimate,
+// is reported to the caller so it can be accounted for.
+type FeeSource interface {
+	// Name returns a human readable name for the source, used to report
+	// its health.
+	Name() string
+
+	// FetchFeePerKW returns the source's estimate in sat/kw for a
+	// transaction to be confirmed in numBlocks blocks.
+	FetchFeePerKW(numBlocks uint32) (SatPerKWeight, error)
+}
+
+// CompositeFeeEstimatorConfig houses the parameters of the
+// CompositeFeeEstimator.
+type CompositeFeeEstimatorConfig struct {
+	// Sources is the set of sources queried for each estimate. Sources
+	// that also implement the FeeEstimator interface will be started and
+	// stopped along with the CompositeFeeEstimator.
+	Sources []FeeSource
+
+	// FallbackFeePerKW is the fee rate returned if none of the sources is
+	// able to produce an estimate and no earlier estimate is cached for
+	// the confirmation target.
+	FallbackFeePerKW SatPerKWeight
+
+	// MinFeePerKW is the lowest fee rate that will ever be returned. If it
+	// is below FeePerKwFloor, FeePerKwFloor is used instead.
+	MinFeePerKW SatPerKWeight
+
+	// MaxFeePerKW is the highest fee rate that will ever be returned. A
+	// value of zero disables the ceiling.
+	MaxFeePerKW SatPerKWeight
+
+	// CacheDuration is the amount of time an estimate is served from the
+	// cache before the sources are queried again.
+	CacheDuration time.Duration
+
+	// SmoothingWindow is the number of consecutive estimates for a
+	// confirmation target that the median is taken of, damping sudden
+	// spikes reported by the sources.
+	SmoothingWindow int
+}
+
+// FeeSourceHealth describes how well a single FeeSource has been performing.
+type FeeSourceHealth struct {
+	// Name is the name of the source.
+	Name string
+
+	// LastSuccess is the last time the source produced an estimate.
+	LastSuccess time.Time
+
+	// LastFeePerKW is the last estimate produced by the source.
+	LastFeePerKW SatPerKWeight
+
+	// LastError is the last error returned by the source, if any.
+	LastError error
+
+	// LastFailure is the last time the source failed to produce an
+	// estimate.
+	LastFailure time.Time
+
+	// ConsecutiveFailures is the number of times the source failed to
+	// produce an estimate since its last success.
+	ConsecutiveFailures uint32
+}
+
+// Healthy returns true if the last query of the source succeeded.
+func (h *FeeSourceHealth) Healthy() bool {
+	return h.ConsecutiveFailures == 0
+}
+
+// FeeEstimatorHealth is a snapshot of the state of the CompositeFeeEstimator
+// and its sources.
+type FeeEstimatorHealth struct {
+	// Sources describes the health of each source, in the order they
+	// were configured.
+	Sources []FeeSourceHealth
+
+	// MinFeePerKW is the fee floor enforced by the estimator.
+	MinFeePerKW SatPerKWeight
+
+	// MaxFeePerKW is the fee ceiling enforced by the estimator, or zero if
+	// there is none.
+	MaxFeePerKW SatPerKWeight
+
+	// FallbackFeePerKW is the fee rate returned if no estimate is
+	// available at all.
+	FallbackFeePerKW SatPerKWeight
+
+	// NumFallbacks is the number of estimates that had to be served from
+	// a stale cache entry or the fall back fee rate, as all sources
+	// failed.
+	NumFallbacks uint64
+
+	// LastFallback is the last time all sources failed.
+	LastFallback time.Time
+
+	// RetryAfter is the time before which the sources won't be queried
+	// again, as all of them failed. It is zero if the last query
+	// succeeded.
+	RetryAfter time.Time
+}
+
+// feeCacheEntry holds the recent estimates for a single confirmation target.
+type feeCacheEntry struct {
+	// samples are the most recent aggregated estimates, oldest first.
+	samples []SatPerKWeight
+
+	// feePerKW is the smoothed and bounded estimate served to callers.
+	feePerKW SatPerKWeight
+
+	// updated is the time the entry was last refreshed from the sources.
+	updated time.Time
+}
+
+// CompositeFeeEstimator is an implementation of the FeeEstimator interface
+// that combines the estimates of several FeeSources. For each confirmation
+// target, the median of the sources that answered is taken, smoothed over the
+// last few estimates, bounded and then cached. If every source fails, the
+// last known estimate, or ultimately a static fall back fee rate, is
+// returned instead.
+type CompositeFeeEstimator struct {
+	cfg CompositeFeeEstimatorConfig
+
+	// cacheMtx guards the cache. It's never held while querying the
+	// sources, so a slow source doesn't block callers that can be served
+	// from the cache.
+	cacheMtx sync.Mutex
+	cache    map[uint32]*feeCacheEntry
+
+	// healthMtx guards the health of the sources, the fall back counters
+	// and the retry backoff.
+	healthMtx    sync.Mutex
+	health       []FeeSourceHealth
+	numFallbacks uint64
+	lastFallback time.Time
+
+	// retryBackoff is the current delay between queries of the sources
+	// while all of them fail, and retryAfter the time before which they
+	// won't be queried again. Both are zero while the sources are
+	// healthy.
+	retryBackoff time.Duration
+	retryAfter   time.Time
+
+	// now returns the current time. It's overridden in tests.
+	now func() time.Time
+}
+
+// NewCompositeFeeEstimator creates a new CompositeFeeEstimator from the given
+// config, applying defaults to unset parameters.
+func NewCompositeFeeEstimator(
+	cfg CompositeFeeEstimatorConfig) (*CompositeFeeEstimator, error) {
+
+	if cfg.MinFeePerKW < FeePerKwFloor {
+		cfg.MinFeePerKW = FeePerKwFloor
+	}
+	if cfg.MaxFeePerKW != 0 && cfg.MaxFeePerKW < cfg.MinFeePerKW {
+		return nil, errors.New("maximum fee rate must not be below " +
+			"the minimum fee rate")
+	}
+	if cfg.CacheDuration == 0 {
+		cfg.CacheDuration = DefaultFeeCacheDuration
+	}
+	if cfg.SmoothingWindow <= 0 {
+		cfg.SmoothingWindow = DefaultFeeSmoothingWindow
+	}
+
+	health := make([]FeeSourceHealth, len(cfg.Sources))
+	for i, source := range cfg.Sources {
+		health[i].Name = source.Name()
+	}
+
+	return &CompositeFeeEstimator{
+		cfg:    cfg,
+		cache:  make(map[uint32]*feeCacheEntry),
+		health: health,
+		now:    time.Now,
+	}, nil
+}
+
+// Start starts all sources that need to be started.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) Start() error {
+	for _, source := range c.cfg.Sources {
+		estimator, ok := source.(FeeEstimator)
+		if !ok {
+			continue
+		}
+		if err := estimator.Start(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop stops all sources that need to be stopped.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) Stop() error {
+	for _, source := range c.cfg.Sources {
+		estimator, ok := source.(FeeEstimator)
+		if !ok {
+			continue
+		}
+		if err := estimator.Stop(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	// If we've recently produced an estimate for this target, then we'll
+	// serve it directly. Otherwise, we'll remember the stale estimate in
+	// case all sources fail.
+	c.cacheMtx.Lock()
+	now := c.now()
+	entry, ok := c.cache[numBlocks]
+	if ok && now.Sub(entry.updated) < c.cfg.CacheDuration {
+		c.cacheMtx.Unlock()
+		return entry.feePerKW, nil
+	}
+	var staleFeePerKW SatPerKWeight
+	if ok {
+		staleFeePerKW = entry.feePerKW
+	}
+	c.cacheMtx.Unlock()
+
+	// If all sources failed recently, we'll wait out the backoff rather
+	// than hitting them again on every call.
+	var estimates []SatPerKWeight
+	if !c.backingOff(now) {
+		estimates = c.querySources(numBlocks)
+	}
+
+	// If none of the sources were able to produce an estimate, we'll fall
+	// back to the last estimate we have for this target, even if it's
+	// stale, and otherwise to the static fall back fee rate.
+	if len(estimates) == 0 {
+		c.healthMtx.Lock()
+		c.numFallbacks++
+		c.lastFallback = now
+		c.healthMtx.Unlock()
+
+		if ok {
+			walletLog.Warnf("All fee sources failed, using last "+
+				"known fee rate of %v sat/kw for conf target "+
+				"of %v", int64(staleFeePerKW), numBlocks)
+			return staleFeePerKW, nil
+		}
+
+		feePerKW := c.bound(c.cfg.FallbackFeePerKW)
+		walletLog.Warnf("All fee sources failed, using fall back fee "+
+			"rate of %v sat/kw for conf target of %v",
+			int64(feePerKW), numBlocks)
+		return feePerKW, nil
+	}
+
+	c.cacheMtx.Lock()
+	defer c.cacheMtx.Unlock()
+
+	// A concurrent caller may have refreshed the entry while we were
+	// querying the sources. In that case we'll serve its estimate, rather
+	// than adding a second sample for the same period.
+	entry, ok = c.cache[numBlocks]
+	if ok && c.now().Sub(entry.updated) < c.cfg.CacheDuration {
+		return entry.feePerKW, nil
+	}
+	if !ok {
+		entry = &feeCacheEntry{}
+		c.cache[numBlocks] = entry
+	}
+
+	// We'll add the median of the sources to the samples for this target,
+	// and serve the median of the last few samples to smooth out any
+	// spikes.
+	entry.samples = append(entry.samples, medianFeeRate(estimates))
+	if len(entry.samples) > c.cfg.SmoothingWindow {
+		entry.samples = entry.samples[1:]
+	}
+	entry.feePerKW = c.bound(medianFeeRate(entry.samples))
+	entry.updated = now
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v from %v "+
+		"fee source(s)", int64(entry.feePerKW), numBlocks,
+		len(estimates))
+
+	return entry.feePerKW, nil
+}
+
+// backingOff returns true if the sources shouldn't be queried at the passed
+// time, as all of them failed recently.
+func (c *CompositeFeeEstimator) backingOff(now time.Time) bool {
+	c.healthMtx.Lock()
+	defer c.healthMtx.Unlock()
+
+	return now.Before(c.retryAfter)
+}
+
+// querySources concurrently queries all sources for an estimate for the given
+// confirmation target, recording their health, and returns the estimates of
+// those that succeeded. If all of them fail, the sources are backed off.
+func (c *CompositeFeeEstimator) querySources(numBlocks uint32) []SatPerKWeight {
+	type result struct {
+		feePerKW SatPerKWeight
+		err      error
+	}
+
+	results := make([]result, len(c.cfg.Sources))
+	var wg sync.WaitGroup
+	for i, source := range c.cfg.Sources {
+		wg.Add(1)
+		go func(i int, source FeeSource) {
+			defer wg.Done()
+
+			feePerKW, err := source.FetchFeePerKW(numBlocks)
+			if err == nil && feePerKW == 0 {
+				err = ErrNoFeeEstimate
+			}
+			results[i] = result{feePerKW: feePerKW, err: err}
+		}(i, source)
+	}
+	wg.Wait()
+
+	c.healthMtx.Lock()
+	defer c.healthMtx.Unlock()
+
+	now := c.now()
+	estimates := make([]SatPerKWeight, 0, len(results))
+	for i, res := range results {
+		health := &c.health[i]
+		if res.err != nil {
+			walletLog.Errorf("Unable to query fee source %v: %v",
+				health.Name, res.err)
+
+			health.LastError = res.err
+			health.LastFailure = now
+			health.ConsecutiveFailures++
+			continue
+		}
+
+		health.LastSuccess = now
+		health.LastFeePerKW = res.feePerKW
+		health.ConsecutiveFailures = 0
+		estimates = append(estimates, res.feePerKW)
+	}
+
+	// As long as a single source answers, we'll keep querying them
+	// normally. Otherwise, we'll wait before trying again, doubling the
+	// delay with each consecutive failure up to the cache duration.
+	if len(estimates) > 0 {
+		c.retryBackoff = 0
+		c.retryAfter = time.Time{}
+		return estimates
+	}
+
+	switch {
+	case c.retryBackoff == 0:
+		c.retryBackoff = minFeeRetryBackoff
+	default:
+		c.retryBackoff *= 2
+	}
+	if c.retryBackoff > c.cfg.CacheDuration {
+		c.retryBackoff = c.cfg.CacheDuration
+	}
+	c.retryAfter = now.Add(c.retryBackoff)
+
+	walletLog.Warnf("All fee sources failed, retrying in %v",
+		c.retryBackoff)
+
+	return estimates
+}
+
+// bound clamps the given fee rate to the configured floor and ceiling.
+func (c *CompositeFeeEstimator) bound(feePerKW SatPerKWeight) SatPerKWeight {
+	switch {
+	case feePerKW < c.cfg.MinFeePerKW:
+		walletLog.Debugf("Estimated fee rate of %v sat/kw is too low, "+
+			"using fee floor of %v sat/kw instead", int64(feePerKW),
+			int64(c.cfg.MinFeePerKW))
+		return c.cfg.MinFeePerKW
+
+	case c.cfg.MaxFeePerKW != 0 && feePerKW > c.cfg.MaxFeePerKW:
+		walletLog.Debugf("Estimated fee rate of %v sat/kw is too high, "+
+			"using fee ceiling of %v sat/kw instead",
+			int64(feePerKW), int64(c.cfg.MaxFeePerKW))
+		return c.cfg.MaxFeePerKW
+	}
+
+	return feePerKW
+}
+
+// Health returns a snapshot of the health of the estimator and its sources.
+func (c *CompositeFeeEstimator) Health() *FeeEstimatorHealth {
+	c.healthMtx.Lock()
+	defer c.healthMtx.Unlock()
+
+	sources := make([]FeeSourceHealth, len(c.health))
+	copy(sources, c.health)
+
+	return &FeeEstimatorHealth{
+		Sources:          sources,
+		MinFeePerKW:      c.cfg.MinFeePerKW,
+		MaxFeePerKW:      c.cfg.MaxFeePerKW,
+		FallbackFeePerKW: c.cfg.FallbackFeePerKW,
+		NumFallbacks:     c.numFallbacks,
+		LastFallback:     c.lastFallback,
+		RetryAfter:       c.retryAfter,
+	}
+}
+
+// medianFeeRate returns the median of the given non-empty set of fee rates. If
+// the set has an even number of elements, the mean of the two middle elements
+// is returned.
+func medianFeeRate(feeRates []SatPerKWeight) SatPerKWeight {
+	sorted := make([]SatPerKWeight, len(feeRates))
+	copy(sorted, feeRates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
+
+// A compile-time assertion to ensure that CompositeFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*CompositeFeeEstimator)(nil)
@@ -0,0 +1,374 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockFeeSource is a FeeSource whose estimate and error can be changed by the
+// test.
+type mockFeeSource struct {
+	mu       sync.Mutex
+	name     string
+	feePerKW SatPerKWeight
+	err      error
+	queries  int
+}
+
+func (m *mockFeeSource) Name() string {
+	return m.name
+}
+
+func (m *mockFeeSource) FetchFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queries++
+	return m.feePerKW, m.err
+}
+
+func (m *mockFeeSource) set(feePerKW SatPerKWeight, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.feePerKW = feePerKW
+	m.err = err
+}
+
+// TestCompositeFeeEstimator tests that the CompositeFeeEstimator takes the
+// median of its sources, caches, smooths and bounds its estimates, and falls
+// back gracefully when its sources fail.
+func TestCompositeFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	source1 := &mockFeeSource{name: "one", feePerKW: 1000}
+	source2 := &mockFeeSource{name: "two", feePerKW: 2000}
+	source3 := &mockFeeSource{name: "three", feePerKW: 9000}
+
+	estimator, err := NewCompositeFeeEstimator(CompositeFeeEstimatorConfig{
+		Sources:          []FeeSource{source1, source2, source3},
+		FallbackFeePerKW: 5000,
+		MinFeePerKW:      500,
+		MaxFeePerKW:      10000,
+		CacheDuration:    time.Minute,
+		SmoothingWindow:  3,
+	})
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+
+	now := time.Unix(1000000, 0)
+	estimator.now = func() time.Time {
+		return now
+	}
+
+	assertFee := func(numBlocks uint32, expected SatPerKWeight) {
+		t.Helper()
+
+		feePerKW, err := estimator.EstimateFeePerKW(numBlocks)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+		if feePerKW != expected {
+			t.Fatalf("expected fee rate of %v, got %v", expected,
+				feePerKW)
+		}
+	}
+
+	// The median of the three sources should be returned, ignoring the
+	// outlier.
+	assertFee(6, 2000)
+
+	// While the estimate is cached, the sources shouldn't be queried
+	// again, even though their estimates change.
+	source2.set(4000, nil)
+	assertFee(6, 2000)
+	if source1.queries != 1 {
+		t.Fatalf("expected 1 query, got %v", source1.queries)
+	}
+
+	// Once the cache expires, the new median of 4000 is smoothed with the
+	// previous estimate of 2000.
+	now = now.Add(time.Minute)
+	assertFee(6, 3000)
+
+	// A single failing source should be reported as unhealthy, while the
+	// median of the remaining two is used.
+	source1.set(0, errors.New("offline"))
+	now = now.Add(time.Minute)
+	assertFee(6, 4000)
+
+	health := estimator.Health()
+	if health.Sources[0].Healthy() || health.Sources[0].LastError == nil {
+		t.Fatalf("expected source one to be unhealthy")
+	}
+	if !health.Sources[1].Healthy() || !health.Sources[2].Healthy() {
+		t.Fatalf("expected sources two and three to be healthy")
+	}
+
+	// Estimates should be bounded by the floor and ceiling.
+	source1.set(100, nil)
+	source2.set(100, nil)
+	source3.set(100, nil)
+	assertFee(1, 500)
+
+	source1.set(50000, nil)
+	source2.set(50000, nil)
+	source3.set(50000, nil)
+	assertFee(2, 10000)
+
+	// If all sources fail, the stale estimate for a known target should be
+	// returned, and the fall back fee rate for an unknown one.
+	source1.set(0, errors.New("offline"))
+	source2.set(0, ErrNoFeeEstimate)
+	source3.set(0, nil)
+	now = now.Add(time.Minute)
+	assertFee(6, 4000)
+	assertFee(12, 5000)
+
+	health = estimator.Health()
+	if health.NumFallbacks != 2 {
+		t.Fatalf("expected 2 fallbacks, got %v", health.NumFallbacks)
+	}
+	for _, source := range health.Sources {
+		if source.Healthy() {
+			t.Fatalf("expected source %v to be unhealthy",
+				source.Name)
+		}
+	}
+}
+
+// TestCompositeFeeEstimatorBackoff tests that the sources aren't queried again
+// on every call while all of them fail, but only once the backoff expired.
+func TestCompositeFeeEstimatorBackoff(t *testing.T) {
+	t.Parallel()
+
+	source := &mockFeeSource{name: "one", err: errors.New("offline")}
+	estimator, err := NewCompositeFeeEstimator(CompositeFeeEstimatorConfig{
+		Sources:          []FeeSource{source},
+		FallbackFeePerKW: 5000,
+		CacheDuration:    time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+
+	now := time.Unix(1000000, 0)
+	estimator.now = func() time.Time {
+		return now
+	}
+
+	assertQueries := func(numBlocks uint32, expected int) {
+		t.Helper()
+
+		feePerKW, err := estimator.EstimateFeePerKW(numBlocks)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+		if feePerKW != 5000 {
+			t.Fatalf("expected fall back fee rate, got %v",
+				feePerKW)
+		}
+
+		source.mu.Lock()
+		defer source.mu.Unlock()
+		if source.queries != expected {
+			t.Fatalf("expected %v queries, got %v", expected,
+				source.queries)
+		}
+	}
+
+	// The first failure should back off the source, so further calls,
+	// even for other targets, are served without querying it.
+	assertQueries(6, 1)
+	assertQueries(6, 1)
+	assertQueries(12, 1)
+
+	// Once the backoff expired, the source is queried again. As it still
+	// fails, the backoff doubles.
+	now = now.Add(minFeeRetryBackoff)
+	assertQueries(6, 2)
+	now = now.Add(minFeeRetryBackoff)
+	assertQueries(6, 2)
+	now = now.Add(minFeeRetryBackoff)
+	assertQueries(6, 3)
+
+	// The backoff never exceeds the cache duration.
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Minute)
+		assertQueries(6, 4+i)
+	}
+
+	// Once the source recovers, the backoff is reset.
+	source.set(2000, nil)
+	now = now.Add(time.Minute)
+	feePerKW, err := estimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if feePerKW != 2000 {
+		t.Fatalf("expected fee rate of 2000, got %v", feePerKW)
+	}
+	if health := estimator.Health(); !health.RetryAfter.IsZero() {
+		t.Fatalf("expected backoff to be reset")
+	}
+}
+
+// blockingFeeSource is a FeeSource that announces each query and blocks until
+// the test releases it.
+type blockingFeeSource struct {
+	feePerKW SatPerKWeight
+	queried  chan uint32
+	release  chan struct{}
+}
+
+func (b *blockingFeeSource) Name() string {
+	return "blocking"
+}
+
+func (b *blockingFeeSource) FetchFeePerKW(numBlocks uint32) (SatPerKWeight,
+	error) {
+
+	b.queried <- numBlocks
+	<-b.release
+
+	return b.feePerKW, nil
+}
+
+// TestCompositeFeeEstimatorSlowSource tests that cached estimates are served
+// while the sources are being queried for another confirmation target.
+func TestCompositeFeeEstimatorSlowSource(t *testing.T) {
+	t.Parallel()
+
+	source := &blockingFeeSource{
+		feePerKW: 2000,
+		queried:  make(chan uint32),
+		release:  make(chan struct{}),
+	}
+	estimator, err := NewCompositeFeeEstimator(CompositeFeeEstimatorConfig{
+		Sources:       []FeeSource{source},
+		CacheDuration: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+
+	now := time.Unix(1000000, 0)
+	estimator.now = func() time.Time {
+		return now
+	}
+
+	estimate := func(numBlocks uint32) chan SatPerKWeight {
+		feeChan := make(chan SatPerKWeight, 1)
+		go func() {
+			feePerKW, err := estimator.EstimateFeePerKW(numBlocks)
+			if err != nil {
+				t.Errorf("unable to estimate fee: %v", err)
+			}
+			feeChan <- feePerKW
+		}()
+		return feeChan
+	}
+
+	assertQueried := func(numBlocks uint32) {
+		t.Helper()
+
+		select {
+		case target := <-source.queried:
+			if target != numBlocks {
+				t.Fatalf("expected query for %v, got %v",
+					numBlocks, target)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("source not queried")
+		}
+	}
+
+	assertFee := func(feeChan chan SatPerKWeight) {
+		t.Helper()
+
+		select {
+		case feePerKW := <-feeChan:
+			if feePerKW != 2000 {
+				t.Fatalf("expected fee rate of 2000, got %v",
+					feePerKW)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("estimate not returned")
+		}
+	}
+
+	// We'll first populate the cache for a conf target of 6.
+	feeChan := estimate(6)
+	assertQueried(6)
+	source.release <- struct{}{}
+	assertFee(feeChan)
+
+	// While the source is blocked on a query for another target, the
+	// cached estimate must still be served.
+	slowFeeChan := estimate(12)
+	assertQueried(12)
+	assertFee(estimate(6))
+
+	source.release <- struct{}{}
+	assertFee(slowFeeChan)
+}
+
+// TestWebAPIFeeSource tests that the WebAPIFeeSource picks the estimate of the
+// closest lower confirmation target served by the web API.
+func TestWebAPIFeeSource(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		status = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"fee_by_block_target": `+
+				`{"2": 40000, "6": 20000, "12": 8000}}`)
+		},
+	))
+	defer server.Close()
+
+	source := NewWebAPIFeeSource(server.URL)
+
+	testCases := []struct {
+		numBlocks uint32
+		expected  SatPerKWeight
+	}{
+		{numBlocks: 1, expected: 10000},
+		{numBlocks: 2, expected: 10000},
+		{numBlocks: 5, expected: 10000},
+		{numBlocks: 6, expected: 5000},
+		{numBlocks: 144, expected: 2000},
+	}
+	for _, test := range testCases {
+		feePerKW, err := source.FetchFeePerKW(test.numBlocks)
+		if err != nil {
+			t.Fatalf("unable to fetch fee: %v", err)
+		}
+		if feePerKW != test.expected {
+			t.Fatalf("expected fee rate of %v for target %v, got %v",
+				test.expected, test.numBlocks, feePerKW)
+		}
+	}
+
+	// A failing web API should result in an error.
+	mu.Lock()
+	status = http.StatusInternalServerError
+	mu.Unlock()
+
+	if _, err := source.FetchFeePerKW(6); err == nil {
+		t.Fatalf("expected error from failing web API")
+	}
+}
@@ -78,6 +78,26 @@ func ErrChanReserveTooLarge(reserve,
 	}
 }
 
+// ErrDustLimitTooSmall returns an error indicating that the dust limit we
+// tried to set for our commitment transaction is too small.
+func ErrDustLimitTooSmall(dustLimit,
+	minDustLimit btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("dust limit of %v sat is too small, min is %v sat",
+			int64(dustLimit), int64(minDustLimit)),
+	}
+}
+
+// ErrDustLimitTooLarge returns an error indicating that the dust limit we
+// tried to set for our commitment transaction is too large.
+func ErrDustLimitTooLarge(dustLimit,
+	capacity btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("dust limit of %v sat must be below the channel "+
+			"capacity of %v sat", int64(dustLimit), int64(capacity)),
+	}
+}
+
 // ErrNonZeroPushAmount is returned by a remote peer that receives a
 // FundingOpen request for a channel with non-zero push amount while
 // they have 'rejectpush' enabled.
@@ -240,9 +240,34 @@ func (b *BtcdFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, erro
 	return satPerKw, nil
 }
 
+// Name returns a human readable name for the fee source.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (b *BtcdFeeEstimator) Name() string {
+	return "btcd"
+}
+
+// FetchFeePerKW returns btcd's estimate for a transaction to be confirmed in
+// numBlocks blocks. Unlike EstimateFeePerKW, failures are reported to the
+// caller rather than masked by the fall back fee rate.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (b *BtcdFeeEstimator) FetchFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feeEstimate, err := b.fetchEstimate(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if feeEstimate == 0 {
+		return 0, ErrNoFeeEstimate
+	}
+
+	return feeEstimate, nil
+}
+
 // A compile-time assertion to ensure that BtcdFeeEstimator implements the
-// FeeEstimator interface.
+// FeeEstimator and FeeSource interfaces.
 var _ FeeEstimator = (*BtcdFeeEstimator)(nil)
+var _ FeeSource = (*BtcdFeeEstimator)(nil)
 
 // BitcoindFeeEstimator is an implementation of the FeeEstimator interface
 // backed by the RPC interface of an active bitcoind node. This implementation
@@ -411,6 +436,31 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 	return satPerKw, nil
 }
 
+// Name returns a human readable name for the fee source.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (b *BitcoindFeeEstimator) Name() string {
+	return "bitcoind"
+}
+
+// FetchFeePerKW returns bitcoind's estimate for a transaction to be confirmed
+// in numBlocks blocks. Unlike EstimateFeePerKW, failures are reported to the
+// caller rather than masked by the fall back fee rate.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (b *BitcoindFeeEstimator) FetchFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feeEstimate, err := b.fetchEstimate(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if feeEstimate == 0 {
+		return 0, ErrNoFeeEstimate
+	}
+
+	return feeEstimate, nil
+}
+
 // A compile-time assertion to ensure that BitcoindFeeEstimator implements the
-// FeeEstimator interface.
+// FeeEstimator and FeeSource interfaces.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
+var _ FeeSource = (*BitcoindFeeEstimator)(nil)
@@ -96,6 +96,43 @@ type TransactionDetail struct {
 	DestAddresses []btcutil.Address
 }
 
+// RecoveryInfo describes the progress of the wallet in recovering its funds by
+// scanning the chain, either as part of a seed restore with a non-zero
+// recovery window, or as part of a manually requested rescan.
+type RecoveryInfo struct {
+	// RecoveryMode indicates whether the wallet was started with a
+	// non-zero recovery window, or a rescan was requested since startup.
+	RecoveryMode bool
+
+	// Finished indicates whether the wallet has caught up with the chain
+	// after scanning for its funds.
+	Finished bool
+
+	// StartHeight is the height of the block the current scan started
+	// from.
+	StartHeight int32
+
+	// CurrentHeight is the height of the last block the wallet has
+	// scanned.
+	CurrentHeight int32
+
+	// TargetHeight is the height of the current best block of the chain
+	// backend, which the wallet is scanning towards.
+	TargetHeight int32
+
+	// AddressesDerived is the total number of addresses the wallet has
+	// derived across all of its default key scopes.
+	AddressesDerived uint32
+
+	// FundsFound is the amount the confirmed balance of the wallet grew
+	// by since the current scan started.
+	FundsFound btcutil.Amount
+
+	// RescanErr is the error the last rescan requested through Rescan
+	// failed with, if any.
+	RescanErr error
+}
+
 // TransactionSubscription is an interface which describes an object capable of
 // receiving notifications of new transaction related to the underlying wallet.
 // TODO(roasbeef): add balance updates?
@@ -208,6 +245,21 @@ type WalletController interface {
 	// known to the wallet, expressed in Unix epoch time
 	IsSynced() (bool, int64, error)
 
+	// RecoveryInfo returns the progress of the wallet in recovering its
+	// funds by scanning the chain.
+	RecoveryInfo() (*RecoveryInfo, error)
+
+	// Rescan replays the chain starting at the block with the passed
+	// height, watching for all addresses and outputs known to the wallet.
+	// Before the rescan begins, lookAhead additional addresses are derived
+	// for each branch of the wallet's default accounts, such that funds
+	// sent to addresses that were never handed out locally are also
+	// found. The rescan is carried out in the background, its progress
+	// and outcome can be followed through RecoveryInfo. While it is in
+	// progress, the wallet reports itself as not synced, and refuses to
+	// select coins, as the confirmations of its outputs can't be trusted.
+	Rescan(startHeight int32, lookAhead uint32) error
+
 	// Start initializes the wallet, making any necessary connections,
 	// starting up required goroutines etc.
 	Start() error
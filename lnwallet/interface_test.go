@@ -407,8 +407,9 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	// In this scenario, we'll test a dual funder reservation, with each
 	// side putting in 10 BTC.
 
-	// Alice initiates a channel funded with 5 BTC for each side, so 10 BTC
-	// total. She also generates 2 BTC in change.
+	// Alice initiates a channel funded with 5 BTC, inviting Bob to
+	// contribute another 5 BTC, so 10 BTC total. She also generates 2 BTC
+	// in change.
 	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -418,16 +419,23 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		NodeID:          bobPub,
 		NodeAddr:        bobAddr,
 		FundingAmount:   fundingAmount,
-		Capacity:        fundingAmount * 2,
+		Capacity:        fundingAmount,
 		CommitFeePerKw:  feePerKw,
 		FundingFeePerKw: feePerKw,
 		PushMSat:        0,
 		Flags:           lnwire.FFAnnounceChannel,
+		DualFund:        true,
 	}
 	aliceChanReservation, err := alice.InitChannelReservation(aliceReq)
 	if err != nil {
 		t.Fatalf("unable to initialize funding reservation: %v", err)
 	}
+
+	// Once Bob offers to match her funds, Alice accepts his contribution.
+	err = aliceChanReservation.AcceptRemoteFunding(fundingAmount)
+	if err != nil {
+		t.Fatalf("unable to accept bob's funds: %v", err)
+	}
 	aliceChanReservation.SetNumConfsRequired(numReqConfs)
 	err = aliceChanReservation.CommitConstraints(
 		csvDelay, lnwallet.MaxHTLCNumber/2,
@@ -462,6 +470,7 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		FundingFeePerKw: feePerKw,
 		PushMSat:        0,
 		Flags:           lnwire.FFAnnounceChannel,
+		DualFund:        true,
 	}
 	bobChanReservation, err := bob.InitChannelReservation(bobReq)
 	if err != nil {
@@ -515,8 +524,22 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		t.Fatalf("bob's commit signatures not populated")
 	}
 
-	// To conclude, we'll consume first Alice's signatures with Bob, and
-	// then the other way around.
+	// Both of them should have arrived at the same funding outpoint.
+	aliceFundingPoint := aliceChanReservation.FundingOutpoint()
+	bobFundingPoint := bobChanReservation.FundingOutpoint()
+	if *aliceFundingPoint != *bobFundingPoint {
+		t.Fatalf("funding outpoint mismatch: alice has %v, bob has %v",
+			aliceFundingPoint, bobFundingPoint)
+	}
+
+	// To conclude, Bob verifies Alice's commitment signature, after which
+	// Alice consumes Bob's input scripts and commitment signature. As
+	// Alice is the one broadcasting the funding transaction, Bob doesn't
+	// need her input scripts.
+	_, err = bobChanReservation.CompleteReservation(nil, aliceCommitSig)
+	if err != nil {
+		t.Fatalf("unable to consume alice's sigs: %v", err)
+	}
 	_, err = aliceChanReservation.CompleteReservation(
 		bobFundingSigs, bobCommitSig,
 	)
@@ -524,12 +547,6 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		for _, in := range aliceChanReservation.FinalFundingTx().TxIn {
 			fmt.Println(in.PreviousOutPoint.String())
 		}
-		t.Fatalf("unable to consume alice's sigs: %v", err)
-	}
-	_, err = bobChanReservation.CompleteReservation(
-		aliceFundingSigs, aliceCommitSig,
-	)
-	if err != nil {
 		t.Fatalf("unable to consume bob's sigs: %v", err)
 	}
 
@@ -736,7 +753,7 @@ func testCancelNonExistentReservation(miner *rpctest.Harness,
 	// Create our own reservation, give it some ID.
 	res, err := lnwallet.NewChannelReservation(
 		10000, 10000, feePerKw, alice, 22, 10, &testHdSeed,
-		lnwire.FFAnnounceChannel,
+		lnwire.FFAnnounceChannel, false, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to create res: %v", err)
@@ -1902,6 +1919,104 @@ func testSignOutputUsingTweaks(r *rpctest.Harness,
 	}
 }
 
+// testWalletRescan tests that a rescan requested on an existing wallet replays
+// the chain without losing track of any funds, derives the requested number of
+// look-ahead addresses, and reports its progress until it finishes.
+func testWalletRescan(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	err := loadTestCredits(r, w, 20, 4)
+	if err != nil {
+		t.Fatalf("unable to send money to lnwallet: %v", err)
+	}
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	origBalance, err := w.ConfirmedBalance(1)
+	if err != nil {
+		t.Fatalf("unable to query for balance: %v", err)
+	}
+	origInfo, err := w.RecoveryInfo()
+	if err != nil {
+		t.Fatalf("unable to fetch recovery info: %v", err)
+	}
+	if !origInfo.Finished {
+		t.Fatalf("expected synced wallet to report a finished " +
+			"recovery")
+	}
+
+	// A rescan beyond the current chain tip should be rejected.
+	_, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+	if err := w.Rescan(bestHeight+1, 0); err == nil {
+		t.Fatalf("expected rescan beyond the chain tip to fail")
+	}
+
+	// Otherwise, we'll rescan the last few blocks, which include the
+	// transactions that funded the wallet above.
+	const lookAhead = 5
+	if err := w.Rescan(bestHeight-10, lookAhead); err != nil {
+		t.Fatalf("unable to start rescan: %v", err)
+	}
+
+	var info *lnwallet.RecoveryInfo
+	timeout := time.After(30 * time.Second)
+	for {
+		info, err = w.RecoveryInfo()
+		if err != nil {
+			t.Fatalf("unable to fetch recovery info: %v", err)
+		}
+		if info.Finished {
+			break
+		}
+
+		select {
+		case <-timeout:
+			t.Fatalf("rescan didn't finish, at height %v of %v",
+				info.CurrentHeight, info.TargetHeight)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	if !info.RecoveryMode {
+		t.Fatalf("expected wallet to be in recovery mode after rescan")
+	}
+	if info.CurrentHeight != info.TargetHeight {
+		t.Fatalf("expected wallet to be synced to height %v, is at "+
+			"height %v", info.TargetHeight, info.CurrentHeight)
+	}
+
+	// Both the external and internal branch of each of the two default
+	// scopes should have been extended by the look-ahead.
+	expectedAddrs := origInfo.AddressesDerived + 4*lookAhead
+	if info.AddressesDerived != expectedAddrs {
+		t.Fatalf("expected %v addresses derived, got %v",
+			expectedAddrs, info.AddressesDerived)
+	}
+
+	if info.RescanErr != nil {
+		t.Fatalf("rescan failed: %v", info.RescanErr)
+	}
+
+	// All of the funds were already known to the wallet, so none should
+	// have been found, and the balance should be unchanged.
+	if info.FundsFound != 0 {
+		t.Fatalf("expected no funds to be found, found %v",
+			info.FundsFound)
+	}
+	balance, err := w.ConfirmedBalance(1)
+	if err != nil {
+		t.Fatalf("unable to query for balance: %v", err)
+	}
+	if balance != origBalance {
+		t.Fatalf("balance mismatch after rescan: expected %v, got %v",
+			origBalance, balance)
+	}
+}
+
 func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 	_ *lnwallet.LightningWallet, t *testing.T) {
 
@@ -2218,6 +2333,10 @@ var walletTests = []walletTestCase{
 		name: "test cancel non-existent reservation",
 		test: testCancelNonExistentReservation,
 	},
+	{
+		name: "wallet rescan",
+		test: testWalletRescan,
+	},
 	{
 		name: "reorg wallet balance",
 		test: testReorgWalletBalance,
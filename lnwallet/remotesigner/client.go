@@ -0,0 +1,323 @@
+package remotesigner
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	// DefaultTimeout is the default amount of time we'll wait for the
+	// remote signer to answer a single request.
+	DefaultTimeout = 5 * time.Second
+)
+
+// Config houses the information required to connect to a remote signer.
+type Config struct {
+	// RPCHost is the host:port of the remote signer's RPC server.
+	RPCHost string
+
+	// TLSCertPath is the path to the TLS certificate of the remote
+	// signer's RPC server.
+	TLSCertPath string
+
+	// MacaroonPath is the path to the macaroon used to authenticate with
+	// the remote signer. If empty, no macaroon will be sent.
+	MacaroonPath string
+
+	// Timeout is the maximum amount of time we'll wait for a response to
+	// a single request.
+	Timeout time.Duration
+}
+
+// Client is an implementation of the lnwallet.Signer, lnwallet.MessageSigner
+// and keychain.SecretKeyRing interfaces which delegates all operations to a
+// remote lnd instance over the Signer gRPC service. This allows the local
+// instance to operate without holding any of the private keys that secure its
+// on-chain funds.
+type Client struct {
+	conn    *grpc.ClientConn
+	client  lnrpc.SignerClient
+	timeout time.Duration
+}
+
+// A compile time check to ensure that Client implements all the signing
+// interfaces it is meant to stand in for.
+var _ lnwallet.Signer = (*Client)(nil)
+var _ lnwallet.MessageSigner = (*Client)(nil)
+var _ keychain.SecretKeyRing = (*Client)(nil)
+var _ WalletSigner = (*Client)(nil)
+
+// New establishes a connection to the remote signer described by the passed
+// config, and returns a Client that uses it.
+func New(cfg *Config) (*Client, error) {
+	creds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote signer TLS "+
+			"cert: %v", err)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+
+	if cfg.MacaroonPath != "" {
+		macBytes, err := ioutil.ReadFile(cfg.MacaroonPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read remote signer "+
+				"macaroon: %v", err)
+		}
+
+		mac := &macaroon.Macaroon{}
+		if err := mac.UnmarshalBinary(macBytes); err != nil {
+			return nil, fmt.Errorf("unable to decode remote "+
+				"signer macaroon: %v", err)
+		}
+
+		cred := macaroons.NewMacaroonCredential(mac)
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+
+	conn, err := grpc.Dial(cfg.RPCHost, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer: "+
+			"%v", err)
+	}
+
+	return NewFromConn(conn, cfg.Timeout), nil
+}
+
+// NewFromConn creates a Client on top of an existing gRPC connection. A zero
+// timeout selects the DefaultTimeout.
+func NewFromConn(conn *grpc.ClientConn, timeout time.Duration) *Client {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{
+		conn:    conn,
+		client:  lnrpc.NewSignerClient(conn),
+		timeout: timeout,
+	}
+}
+
+// Close tears down the connection to the remote signer.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// signReq assembles a SignReq for a single input of the passed transaction.
+func signReq(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnrpc.SignReq, error) {
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SignReq{
+		RawTxBytes: buf.Bytes(),
+		SignDescs: []*lnrpc.SignDescriptor{
+			MarshalSignDescriptor(signDesc),
+		},
+	}, nil
+}
+
+// SignOutputRaw generates a signature for the passed transaction according to
+// the data within the passed SignDescriptor.
+//
+// NOTE: This is part of the lnwallet.Signer interface.
+func (c *Client) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	req, err := signReq(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.SignOutputRaw(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RawSigs) != 1 {
+		return nil, fmt.Errorf("remote signer returned %v "+
+			"signatures, expected 1", len(resp.RawSigs))
+	}
+
+	return resp.RawSigs[0], nil
+}
+
+// ComputeInputScript generates a complete InputScript for the passed
+// transaction with the signature as defined within the passed SignDescriptor.
+//
+// NOTE: This is part of the lnwallet.Signer interface.
+func (c *Client) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	req, err := signReq(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.computeInputScript(req)
+}
+
+// ComputeWalletInputScript generates a complete InputScript for the passed
+// transaction, which the remote signer signs with the wallet key at the
+// passed derivation path. This allows signing for wallet addresses the remote
+// signer hasn't derived itself.
+//
+// NOTE: This is part of the WalletSigner interface.
+func (c *Client) ComputeWalletInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) (*lnwallet.InputScript, error) {
+
+	req, err := signReq(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+	req.SignDescs[0].WalletKeyPath = MarshalWalletKeyPath(scope, path)
+
+	return c.computeInputScript(req)
+}
+
+// computeInputScript has the remote signer generate the input script for the
+// single input of the passed request.
+func (c *Client) computeInputScript(
+	req *lnrpc.SignReq) (*lnwallet.InputScript, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.ComputeInputScript(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.InputScripts) != 1 {
+		return nil, fmt.Errorf("remote signer returned %v input "+
+			"scripts, expected 1", len(resp.InputScripts))
+	}
+
+	return &lnwallet.InputScript{
+		Witness:   resp.InputScripts[0].Witness,
+		ScriptSig: resp.InputScripts[0].SigScript,
+	}, nil
+}
+
+// SignMessage attempts to sign a target message with the private key that
+// corresponds to the passed public key.
+//
+// NOTE: This is part of the lnwallet.MessageSigner interface.
+func (c *Client) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.SignMessageWithKey(ctx, &lnrpc.SignMessageReq{
+		Msg:    msg,
+		PubKey: pubKey.SerializeCompressed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.ParseDERSignature(resp.Signature, btcec.S256())
+}
+
+// DeriveNextKey attempts to derive the *next* key within the key family
+// specified.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (c *Client) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.DeriveNextKey(ctx, &lnrpc.KeyReq{
+		KeyFamily: int32(keyFam),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return UnmarshalKeyDescriptor(resp)
+}
+
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (c *Client) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.DeriveKey(ctx, MarshalKeyLocator(keyLoc))
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return UnmarshalKeyDescriptor(resp)
+}
+
+// DerivePrivKey attempts to derive the private key that corresponds to the
+// passed key descriptor. The remote signer will only ever hand out the node
+// identity key.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (c *Client) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.DerivePrivKey(ctx, MarshalKeyDescriptor(keyDesc))
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), resp.RawPrivKey)
+	return privKey, nil
+}
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the target key descriptor and remote public key. The output returned will
+// be the sha256 of the resulting shared point serialized in compressed
+// format.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (c *Client) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.DeriveSharedKey(ctx, &lnrpc.SharedKeyRequest{
+		EphemeralPubkey: pubKey.SerializeCompressed(),
+		KeyDesc:         MarshalKeyDescriptor(keyDesc),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.SharedKey, nil
+}
@@ -0,0 +1,178 @@
+package remotesigner
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// MarshalKeyLocator converts a keychain.KeyLocator into its RPC counterpart.
+func MarshalKeyLocator(keyLoc keychain.KeyLocator) *lnrpc.KeyLocator {
+	return &lnrpc.KeyLocator{
+		KeyFamily: int32(keyLoc.Family),
+		KeyIndex:  int32(keyLoc.Index),
+	}
+}
+
+// UnmarshalKeyLocator converts an RPC key locator into a keychain.KeyLocator.
+// A nil locator is interpreted as the empty locator.
+func UnmarshalKeyLocator(keyLoc *lnrpc.KeyLocator) keychain.KeyLocator {
+	if keyLoc == nil {
+		return keychain.KeyLocator{}
+	}
+
+	return keychain.KeyLocator{
+		Family: keychain.KeyFamily(keyLoc.KeyFamily),
+		Index:  uint32(keyLoc.KeyIndex),
+	}
+}
+
+// MarshalKeyDescriptor converts a keychain.KeyDescriptor into its RPC
+// counterpart.
+func MarshalKeyDescriptor(keyDesc keychain.KeyDescriptor) *lnrpc.KeyDescriptor {
+	var rawKeyBytes []byte
+	if keyDesc.PubKey != nil {
+		rawKeyBytes = keyDesc.PubKey.SerializeCompressed()
+	}
+
+	return &lnrpc.KeyDescriptor{
+		RawKeyBytes: rawKeyBytes,
+		KeyLoc:      MarshalKeyLocator(keyDesc.KeyLocator),
+	}
+}
+
+// UnmarshalKeyDescriptor converts an RPC key descriptor into a
+// keychain.KeyDescriptor. An error is returned if the descriptor carries a
+// malformed public key.
+func UnmarshalKeyDescriptor(
+	keyDesc *lnrpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	if keyDesc == nil {
+		return keychain.KeyDescriptor{}, nil
+	}
+
+	desc := keychain.KeyDescriptor{
+		KeyLocator: UnmarshalKeyLocator(keyDesc.KeyLoc),
+	}
+
+	if len(keyDesc.RawKeyBytes) != 0 {
+		pubKey, err := btcec.ParsePubKey(
+			keyDesc.RawKeyBytes, btcec.S256(),
+		)
+		if err != nil {
+			return desc, fmt.Errorf("unable to parse key "+
+				"descriptor public key: %v", err)
+		}
+		desc.PubKey = pubKey
+	}
+
+	return desc, nil
+}
+
+// MarshalWalletKeyPath converts the key scope and derivation path of a wallet
+// key into its RPC counterpart.
+func MarshalWalletKeyPath(scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) *lnrpc.WalletKeyPath {
+
+	return &lnrpc.WalletKeyPath{
+		Purpose:  scope.Purpose,
+		CoinType: scope.Coin,
+		Account:  path.Account,
+		Branch:   path.Branch,
+		Index:    path.Index,
+	}
+}
+
+// UnmarshalWalletKeyPath converts an RPC wallet key path into the key scope
+// and derivation path of the key.
+func UnmarshalWalletKeyPath(
+	keyPath *lnrpc.WalletKeyPath) (waddrmgr.KeyScope, waddrmgr.DerivationPath) {
+
+	scope := waddrmgr.KeyScope{
+		Purpose: keyPath.Purpose,
+		Coin:    keyPath.CoinType,
+	}
+	path := waddrmgr.DerivationPath{
+		Account: keyPath.Account,
+		Branch:  keyPath.Branch,
+		Index:   keyPath.Index,
+	}
+
+	return scope, path
+}
+
+// MarshalSignDescriptor converts an lnwallet.SignDescriptor into its RPC
+// counterpart. The sighash midstate isn't transmitted, as it is recomputed by
+// the signer from the raw transaction.
+func MarshalSignDescriptor(signDesc *lnwallet.SignDescriptor) *lnrpc.SignDescriptor {
+	var doubleTweak []byte
+	if signDesc.DoubleTweak != nil {
+		doubleTweak = signDesc.DoubleTweak.Serialize()
+	}
+
+	var output *lnrpc.TxOut
+	if signDesc.Output != nil {
+		output = &lnrpc.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		}
+	}
+
+	return &lnrpc.SignDescriptor{
+		KeyDesc:       MarshalKeyDescriptor(signDesc.KeyDesc),
+		SingleTweak:   signDesc.SingleTweak,
+		DoubleTweak:   doubleTweak,
+		WitnessScript: signDesc.WitnessScript,
+		Output:        output,
+		Sighash:       uint32(signDesc.HashType),
+		InputIndex:    int32(signDesc.InputIndex),
+	}
+}
+
+// UnmarshalSignDescriptor converts an RPC sign descriptor into an
+// lnwallet.SignDescriptor. The passed sighash midstate is attached to the
+// resulting descriptor.
+func UnmarshalSignDescriptor(signDesc *lnrpc.SignDescriptor,
+	sigHashes *txscript.TxSigHashes) (*lnwallet.SignDescriptor, error) {
+
+	if signDesc.Output == nil {
+		return nil, fmt.Errorf("sign descriptor must include the " +
+			"output being spent")
+	}
+
+	if len(signDesc.SingleTweak) != 0 && len(signDesc.DoubleTweak) != 0 {
+		return nil, lnwallet.ErrTweakOverdose
+	}
+
+	keyDesc, err := UnmarshalKeyDescriptor(signDesc.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	var doubleTweak *btcec.PrivateKey
+	if len(signDesc.DoubleTweak) != 0 {
+		doubleTweak, _ = btcec.PrivKeyFromBytes(
+			btcec.S256(), signDesc.DoubleTweak,
+		)
+	}
+
+	return &lnwallet.SignDescriptor{
+		KeyDesc:       keyDesc,
+		SingleTweak:   signDesc.SingleTweak,
+		DoubleTweak:   doubleTweak,
+		WitnessScript: signDesc.WitnessScript,
+		Output: &wire.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		},
+		HashType:   txscript.SigHashType(signDesc.Sighash),
+		SigHashes:  sigHashes,
+		InputIndex: int(signDesc.InputIndex),
+	}, nil
+}
@@ -0,0 +1,495 @@
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var (
+	testPrivKeyBytes = []byte{
+		0x2b, 0xd8, 0x06, 0xc9, 0x7f, 0x0e, 0x00, 0xaf,
+		0x1a, 0x1f, 0xc3, 0x32, 0x8f, 0xa7, 0x63, 0xa9,
+		0x26, 0x97, 0x23, 0xc8, 0xdb, 0x8f, 0xac, 0x4f,
+		0x93, 0xaf, 0x71, 0xdb, 0x18, 0x6d, 0x6e, 0x90,
+	}
+
+	testPrivKey, testPubKey = btcec.PrivKeyFromBytes(
+		btcec.S256(), testPrivKeyBytes,
+	)
+)
+
+// mockKeyRing is a SecretKeyRing that maps every locator onto a single key.
+type mockKeyRing struct {
+	key *btcec.PrivateKey
+}
+
+func (m *mockKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{Family: keyFam},
+		PubKey:     m.key.PubKey(),
+	}, nil
+}
+
+func (m *mockKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     m.key.PubKey(),
+	}, nil
+}
+
+func (m *mockKeyRing) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	return m.key, nil
+}
+
+func (m *mockKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	s := &btcec.PublicKey{}
+	x, y := btcec.S256().ScalarMult(pubKey.X, pubKey.Y, m.key.D.Bytes())
+	s.X = x
+	s.Y = y
+
+	h := sha256.Sum256(s.SerializeCompressed())
+	return h[:], nil
+}
+
+// mockSigner signs every input with a single key.
+type mockSigner struct {
+	key *btcec.PrivateKey
+
+	// keyPaths records the wallet key paths passed to
+	// ComputeWalletInputScript.
+	keyPaths []waddrmgr.DerivationPath
+}
+
+func (m *mockSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	if !m.key.PubKey().IsEqual(signDesc.KeyDesc.PubKey) {
+		return nil, fmt.Errorf("incorrect key passed")
+	}
+
+	sig, err := txscript.RawTxInWitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.WitnessScript,
+		signDesc.HashType, m.key,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:len(sig)-1], nil
+}
+
+func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	witness, err := txscript.WitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.Output.PkScript,
+		signDesc.HashType, m.key, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwallet.InputScript{
+		Witness: witness,
+	}, nil
+}
+
+func (m *mockSigner) ComputeWalletInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) (*lnwallet.InputScript, error) {
+
+	if scope != waddrmgr.KeyScopeBIP0084 {
+		return nil, fmt.Errorf("unexpected key scope %v", scope)
+	}
+	m.keyPaths = append(m.keyPaths, path)
+
+	return m.ComputeInputScript(tx, signDesc)
+}
+
+func (m *mockSigner) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	if !m.key.PubKey().IsEqual(pubKey) {
+		return nil, fmt.Errorf("unknown public key")
+	}
+
+	return m.key.Sign(chainhash.DoubleHashB(msg))
+}
+
+// newTestClient spins up a signer server backed by the passed signer over an
+// in-memory connection, and returns a client connected to it.
+func newTestClient(t *testing.T, signer *mockSigner) (*Client, func()) {
+	lis := bufconn.Listen(1 << 16)
+
+	grpcServer := grpc.NewServer()
+	lnrpc.RegisterSignerServer(grpcServer, NewServer(
+		signer, signer, &mockKeyRing{key: testPrivKey},
+	))
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.Dial(
+		"bufnet", grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unable to dial signer: %v", err)
+	}
+
+	client := NewFromConn(conn, 0)
+	return client, func() {
+		client.Close()
+		grpcServer.Stop()
+	}
+}
+
+// TestSignDescriptorMarshalling ensures that a sign descriptor survives a
+// round trip through its RPC representation.
+func TestSignDescriptorMarshalling(t *testing.T) {
+	t.Parallel()
+
+	doubleTweak, _ := btcec.NewPrivateKey(btcec.S256())
+	signDesc := &lnwallet.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyRevocationBase,
+				Index:  7,
+			},
+			PubKey: testPubKey,
+		},
+		DoubleTweak:   doubleTweak,
+		WitnessScript: []byte{txscript.OP_TRUE},
+		Output: &wire.TxOut{
+			Value:    5000,
+			PkScript: []byte{txscript.OP_0, txscript.OP_DATA_1, 0x1},
+		},
+		HashType:   txscript.SigHashAll,
+		InputIndex: 2,
+	}
+
+	decoded, err := UnmarshalSignDescriptor(
+		MarshalSignDescriptor(signDesc), nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to unmarshal sign descriptor: %v", err)
+	}
+
+	if decoded.KeyDesc.KeyLocator != signDesc.KeyDesc.KeyLocator {
+		t.Fatalf("key locator mismatch: expected %v, got %v",
+			signDesc.KeyDesc.KeyLocator, decoded.KeyDesc.KeyLocator)
+	}
+	if !decoded.KeyDesc.PubKey.IsEqual(testPubKey) {
+		t.Fatalf("public key mismatch")
+	}
+	if decoded.DoubleTweak.D.Cmp(doubleTweak.D) != 0 {
+		t.Fatalf("double tweak mismatch")
+	}
+	if !bytes.Equal(decoded.WitnessScript, signDesc.WitnessScript) {
+		t.Fatalf("witness script mismatch")
+	}
+	if decoded.Output.Value != signDesc.Output.Value ||
+		!bytes.Equal(decoded.Output.PkScript, signDesc.Output.PkScript) {
+
+		t.Fatalf("output mismatch")
+	}
+	if decoded.HashType != signDesc.HashType ||
+		decoded.InputIndex != signDesc.InputIndex {
+
+		t.Fatalf("sighash or input index mismatch")
+	}
+
+	// A descriptor carrying both tweaks should be rejected.
+	rpcDesc := MarshalSignDescriptor(signDesc)
+	rpcDesc.SingleTweak = []byte{0x1}
+	if _, err := UnmarshalSignDescriptor(rpcDesc, nil); err == nil {
+		t.Fatalf("expected descriptor with both tweaks to be rejected")
+	}
+}
+
+// TestRemoteSignerKeys ensures that keys can be derived through the remote
+// signer, and that only the node identity key can be exported.
+func TestRemoteSignerKeys(t *testing.T) {
+	t.Parallel()
+
+	client, cleanUp := newTestClient(t, &mockSigner{key: testPrivKey})
+	defer cleanUp()
+
+	keyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamilyHtlcBase,
+		Index:  3,
+	}
+	keyDesc, err := client.DeriveKey(keyLoc)
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	if keyDesc.KeyLocator != keyLoc {
+		t.Fatalf("expected locator %v, got %v", keyLoc,
+			keyDesc.KeyLocator)
+	}
+	if !keyDesc.PubKey.IsEqual(testPubKey) {
+		t.Fatalf("derived key mismatch")
+	}
+
+	keyDesc, err = client.DeriveNextKey(keychain.KeyFamilyDelayBase)
+	if err != nil {
+		t.Fatalf("unable to derive next key: %v", err)
+	}
+	if keyDesc.Family != keychain.KeyFamilyDelayBase {
+		t.Fatalf("expected family %v, got %v",
+			keychain.KeyFamilyDelayBase, keyDesc.Family)
+	}
+
+	nodeKey, err := client.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to derive node key: %v", err)
+	}
+	if nodeKey.D.Cmp(testPrivKey.D) != 0 {
+		t.Fatalf("node key mismatch")
+	}
+
+	_, err = client.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyMultiSig,
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected multisig private key export to fail")
+	}
+
+	remoteKey, _ := btcec.NewPrivateKey(btcec.S256())
+	sharedKey, err := client.ScalarMult(keyDesc, remoteKey.PubKey())
+	if err != nil {
+		t.Fatalf("unable to derive shared key: %v", err)
+	}
+	expected, _ := (&mockKeyRing{key: remoteKey}).ScalarMult(
+		keyDesc, testPubKey,
+	)
+	if !bytes.Equal(sharedKey, expected) {
+		t.Fatalf("shared key mismatch: expected %x, got %x",
+			expected, sharedKey)
+	}
+}
+
+// TestRemoteSignerSignatures ensures that signatures produced by the remote
+// signer are valid.
+func TestRemoteSignerSignatures(t *testing.T) {
+	t.Parallel()
+
+	client, cleanUp := newTestClient(t, &mockSigner{key: testPrivKey})
+	defer cleanUp()
+
+	witnessScript, err := txscript.NewScriptBuilder().
+		AddData(testPubKey.SerializeCompressed()).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	if err != nil {
+		t.Fatalf("unable to build script: %v", err)
+	}
+	pkScript, err := lnwallet.WitnessScriptHash(witnessScript)
+	if err != nil {
+		t.Fatalf("unable to build pkscript: %v", err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: pkScript})
+
+	signDesc := &lnwallet.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: testPubKey,
+		},
+		WitnessScript: witnessScript,
+		Output: &wire.TxOut{
+			Value:    2000,
+			PkScript: pkScript,
+		},
+		HashType: txscript.SigHashAll,
+	}
+
+	rawSig, err := client.SignOutputRaw(tx, signDesc)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	sig, err := btcec.ParseDERSignature(rawSig, btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, txscript.NewTxSigHashes(tx),
+		txscript.SigHashAll, tx, 0, 2000,
+	)
+	if err != nil {
+		t.Fatalf("unable to compute sighash: %v", err)
+	}
+	if !sig.Verify(sigHash, testPubKey) {
+		t.Fatalf("remote signature is invalid")
+	}
+
+	msg := []byte("lightning")
+	msgSig, err := client.SignMessage(testPubKey, msg)
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+	if !msgSig.Verify(chainhash.DoubleHashB(msg), testPubKey) {
+		t.Fatalf("remote message signature is invalid")
+	}
+}
+
+// TestSignerKeyLocators ensures that messages can be signed with a key
+// identified by its locator, and that the node identity key is used for ECDH
+// when no key descriptor is specified.
+func TestSignerKeyLocators(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer(
+		&mockSigner{key: testPrivKey}, &mockSigner{key: testPrivKey},
+		&mockKeyRing{key: testPrivKey},
+	)
+	ctx := context.Background()
+
+	msg := []byte("contract")
+	resp, err := server.SignMessageWithKey(ctx, &lnrpc.SignMessageReq{
+		Msg: msg,
+		KeyLoc: &lnrpc.KeyLocator{
+			KeyFamily: int32(keychain.KeyFamilyMultiSig),
+			KeyIndex:  4,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to sign message with key locator: %v", err)
+	}
+	sig, err := btcec.ParseDERSignature(resp.Signature, btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	if !sig.Verify(chainhash.DoubleHashB(msg), testPubKey) {
+		t.Fatalf("key locator signature is invalid")
+	}
+
+	// Specifying both a public key and a key locator is ambiguous, so it
+	// should be rejected.
+	_, err = server.SignMessageWithKey(ctx, &lnrpc.SignMessageReq{
+		Msg:    msg,
+		PubKey: testPubKey.SerializeCompressed(),
+		KeyLoc: &lnrpc.KeyLocator{},
+	})
+	if err == nil {
+		t.Fatalf("expected request with both pub_key and key_loc " +
+			"to be rejected")
+	}
+
+	remoteKey, _ := btcec.NewPrivateKey(btcec.S256())
+	sharedResp, err := server.DeriveSharedKey(ctx, &lnrpc.SharedKeyRequest{
+		EphemeralPubkey: remoteKey.PubKey().SerializeCompressed(),
+	})
+	if err != nil {
+		t.Fatalf("unable to derive shared key: %v", err)
+	}
+	expected, _ := (&mockKeyRing{key: remoteKey}).ScalarMult(
+		keychain.KeyDescriptor{}, testPubKey,
+	)
+	if !bytes.Equal(sharedResp.SharedKey, expected) {
+		t.Fatalf("shared key mismatch: expected %x, got %x",
+			expected, sharedResp.SharedKey)
+	}
+}
+
+// TestRemoteSignerWalletKeyPath ensures that the derivation path of a wallet
+// key is passed along to the signer when signing for a wallet output, so it
+// doesn't need to know the address being spent.
+func TestRemoteSignerWalletKeyPath(t *testing.T) {
+	t.Parallel()
+
+	signer := &mockSigner{key: testPrivKey}
+	client, cleanUp := newTestClient(t, signer)
+	defer cleanUp()
+
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(testPubKey.SerializeCompressed())).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to build pkscript: %v", err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: pkScript})
+
+	const amt = 2000
+	signDesc := &lnwallet.SignDescriptor{
+		Output: &wire.TxOut{
+			Value:    amt,
+			PkScript: pkScript,
+		},
+		HashType: txscript.SigHashAll,
+	}
+
+	// Without a key path, the signer has to look up the key itself.
+	if _, err := client.ComputeInputScript(tx, signDesc); err != nil {
+		t.Fatalf("unable to compute input script: %v", err)
+	}
+	if len(signer.keyPaths) != 0 {
+		t.Fatalf("expected no key path, got %v", signer.keyPaths)
+	}
+
+	path := waddrmgr.DerivationPath{
+		Account: 0,
+		Branch:  waddrmgr.InternalBranch,
+		Index:   1337,
+	}
+	inputScript, err := client.ComputeWalletInputScript(
+		tx, signDesc, waddrmgr.KeyScopeBIP0084, path,
+	)
+	if err != nil {
+		t.Fatalf("unable to compute input script: %v", err)
+	}
+	if len(signer.keyPaths) != 1 || signer.keyPaths[0] != path {
+		t.Fatalf("expected key path %v, got %v", path,
+			signer.keyPaths)
+	}
+
+	// The witness returned should spend the output.
+	tx.TxIn[0].Witness = inputScript.Witness
+	vm, err := txscript.NewEngine(
+		pkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx), amt,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("invalid input script: %v", err)
+	}
+}
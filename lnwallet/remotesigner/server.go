@@ -0,0 +1,304 @@
+package remotesigner
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"golang.org/x/net/context"
+)
+
+// WalletSigner is implemented by signers that can sign for the outputs of
+// their wallet given the derivation path of the controlling key, rather than
+// by looking up the output script among the addresses they've derived.
+type WalletSigner interface {
+	// ComputeWalletInputScript generates a complete InputScript for the
+	// passed transaction, signing the input described by the passed
+	// SignDescriptor with the wallet key at the passed derivation path.
+	ComputeWalletInputScript(tx *wire.MsgTx,
+		signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+		path waddrmgr.DerivationPath) (*lnwallet.InputScript, error)
+}
+
+// Server is an implementation of the lnrpc.SignerServer gRPC service backed by
+// the signer, message signer and key ring of a node that holds the wallet
+// seed.
+type Server struct {
+	signer    lnwallet.Signer
+	msgSigner lnwallet.MessageSigner
+	keyRing   keychain.SecretKeyRing
+}
+
+// A compile time check to ensure that Server fully implements the SignerServer
+// gRPC service.
+var _ lnrpc.SignerServer = (*Server)(nil)
+
+// NewServer creates a new signer RPC server backed by the passed signing
+// primitives.
+func NewServer(signer lnwallet.Signer, msgSigner lnwallet.MessageSigner,
+	keyRing keychain.SecretKeyRing) *Server {
+
+	return &Server{
+		signer:    signer,
+		msgSigner: msgSigner,
+		keyRing:   keyRing,
+	}
+}
+
+// parseSignReq deserializes the transaction carried by the passed request and
+// converts each of its sign descriptors.
+func parseSignReq(in *lnrpc.SignReq) (*wire.MsgTx,
+	[]*lnwallet.SignDescriptor, error) {
+
+	if len(in.SignDescs) == 0 {
+		return nil, nil, fmt.Errorf("at least one sign descriptor " +
+			"must be specified")
+	}
+
+	tx := wire.NewMsgTx(2)
+	if err := tx.Deserialize(bytes.NewReader(in.RawTxBytes)); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode tx: %v", err)
+	}
+
+	// The sighash midstate is shared by all inputs of the transaction, so
+	// we only compute it once.
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	signDescs := make([]*lnwallet.SignDescriptor, 0, len(in.SignDescs))
+	for _, desc := range in.SignDescs {
+		signDesc, err := UnmarshalSignDescriptor(desc, sigHashes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if signDesc.InputIndex < 0 ||
+			signDesc.InputIndex >= len(tx.TxIn) {
+
+			return nil, nil, fmt.Errorf("input index %v out of "+
+				"range", signDesc.InputIndex)
+		}
+
+		signDescs = append(signDescs, signDesc)
+	}
+
+	return tx, signDescs, nil
+}
+
+// SignOutputRaw generates a signature for each of the passed sign descriptors.
+// The resulting signatures are void of a sighash byte.
+func (s *Server) SignOutputRaw(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.SignResp, error) {
+
+	tx, signDescs, err := parseSignReq(in)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([][]byte, 0, len(signDescs))
+	for _, signDesc := range signDescs {
+		sig, err := s.signer.SignOutputRaw(tx, signDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		sigs = append(sigs, sig)
+	}
+
+	return &lnrpc.SignResp{
+		RawSigs: sigs,
+	}, nil
+}
+
+// ComputeInputScript generates a complete input script for each of the passed
+// sign descriptors.
+func (s *Server) ComputeInputScript(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.InputScriptResp, error) {
+
+	tx, signDescs, err := parseSignReq(in)
+	if err != nil {
+		return nil, err
+	}
+
+	inputScripts := make([]*lnrpc.InputScript, 0, len(signDescs))
+	for i, signDesc := range signDescs {
+		var inputScript *lnwallet.InputScript
+
+		// If the derivation path of the wallet key was passed, then
+		// we'll sign with the key at that path, as the address of the
+		// output may not be known to our wallet.
+		keyPath := in.SignDescs[i].WalletKeyPath
+		if keyPath != nil {
+			walletSigner, ok := s.signer.(WalletSigner)
+			if !ok {
+				return nil, fmt.Errorf("signer can't sign " +
+					"with wallet keys by derivation path")
+			}
+
+			scope, path := UnmarshalWalletKeyPath(keyPath)
+			inputScript, err = walletSigner.ComputeWalletInputScript(
+				tx, signDesc, scope, path,
+			)
+		} else {
+			inputScript, err = s.signer.ComputeInputScript(
+				tx, signDesc,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		inputScripts = append(inputScripts, &lnrpc.InputScript{
+			Witness:   inputScript.Witness,
+			SigScript: inputScript.ScriptSig,
+		})
+	}
+
+	return &lnrpc.InputScriptResp{
+		InputScripts: inputScripts,
+	}, nil
+}
+
+// SignMessageWithKey signs the double-sha256 digest of the passed message with
+// the private key that corresponds to either the passed public key, or the
+// passed key locator.
+func (s *Server) SignMessageWithKey(ctx context.Context,
+	in *lnrpc.SignMessageReq) (*lnrpc.SignMessageResp, error) {
+
+	if len(in.Msg) == 0 {
+		return nil, fmt.Errorf("a message to sign must be specified")
+	}
+
+	var sig *btcec.Signature
+	switch {
+	case len(in.PubKey) != 0 && in.KeyLoc != nil:
+		return nil, fmt.Errorf("only one of pub_key and key_loc " +
+			"may be specified")
+
+	// If a key locator was passed, then we'll derive the private key
+	// ourselves, as the message signer only knows how to look up keys by
+	// their public key.
+	case in.KeyLoc != nil:
+		privKey, err := s.keyRing.DerivePrivKey(keychain.KeyDescriptor{
+			KeyLocator: UnmarshalKeyLocator(in.KeyLoc),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err = privKey.Sign(chainhash.DoubleHashB(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		pubKey, err := btcec.ParsePubKey(in.PubKey, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse public key: %v",
+				err)
+		}
+
+		sig, err = s.msgSigner.SignMessage(pubKey, in.Msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lnrpc.SignMessageResp{
+		Signature: sig.Serialize(),
+	}, nil
+}
+
+// DeriveNextKey derives the next key within the specified key family.
+func (s *Server) DeriveNextKey(ctx context.Context,
+	in *lnrpc.KeyReq) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := s.keyRing.DeriveNextKey(
+		keychain.KeyFamily(in.KeyFamily),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalKeyDescriptor(keyDesc), nil
+}
+
+// DeriveKey derives the key specified by the passed key locator.
+func (s *Server) DeriveKey(ctx context.Context,
+	in *lnrpc.KeyLocator) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := s.keyRing.DeriveKey(UnmarshalKeyLocator(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalKeyDescriptor(keyDesc), nil
+}
+
+// DerivePrivKey returns the node identity private key. Any other key family is
+// refused, as only the identity key is needed by the remote node in order to
+// process onion packets.
+func (s *Server) DerivePrivKey(ctx context.Context,
+	in *lnrpc.KeyDescriptor) (*lnrpc.DerivePrivKeyResp, error) {
+
+	keyDesc, err := UnmarshalKeyDescriptor(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyDesc.Family != keychain.KeyFamilyNodeKey {
+		return nil, fmt.Errorf("refusing to export private key of "+
+			"key family %v", keyDesc.Family)
+	}
+
+	privKey, err := s.keyRing.DerivePrivKey(keyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DerivePrivKeyResp{
+		RawPrivKey: privKey.Serialize(),
+	}, nil
+}
+
+// DeriveSharedKey performs an ECDH operation between the key described by the
+// passed key descriptor and the passed public key. If no key descriptor is
+// passed, then the node identity key is used.
+func (s *Server) DeriveSharedKey(ctx context.Context,
+	in *lnrpc.SharedKeyRequest) (*lnrpc.SharedKeyResponse, error) {
+
+	ephemeralPubKey, err := btcec.ParsePubKey(
+		in.EphemeralPubkey, btcec.S256(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ephemeral public "+
+			"key: %v", err)
+	}
+
+	keyDesc := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		},
+	}
+	if in.KeyDesc != nil {
+		keyDesc, err = UnmarshalKeyDescriptor(in.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sharedKey, err := s.keyRing.ScalarMult(keyDesc, ephemeralPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SharedKeyResponse{
+		SharedKey: sharedKey,
+	}, nil
+}
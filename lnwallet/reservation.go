@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"fmt"
 	"net"
 	"sync"
 
@@ -12,6 +13,19 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+const (
+	// DefaultMaxCsvDelay is the default largest CSV delay we'll accept the
+	// remote party requiring for our funds in case of a unilateral close.
+	//
+	// TODO(halseth): find a more scientific choice of value.
+	DefaultMaxCsvDelay = 10000
+
+	// minNumHtlc is the smallest number of pending HTLCs either party
+	// must allow the other to offer. If this is too small, the channel
+	// won't be able to route many payments at once.
+	minNumHtlc = 5
+)
+
 // ChannelContribution is the primary constituent of the funding workflow
 // within lnwallet. Each side first exchanges their respective contributions
 // along with channel specific parameters like the min fee/KB. Once
@@ -26,6 +40,11 @@ type ChannelContribution struct {
 	// Inputs to the funding transaction.
 	Inputs []*wire.TxIn
 
+	// PrevOutputs are the outputs spent by the inputs to the funding
+	// transaction, in the same order as Inputs. They're required to
+	// verify the counterparty's input scripts in a dual funder workflow.
+	PrevOutputs []*wire.TxOut
+
 	// ChangeOutputs are the Outputs to be used in the case that the total
 	// value of the funding inputs is greater than the total potential
 	// channel capacity.
@@ -36,6 +55,11 @@ type ChannelContribution struct {
 	// send to the remote party.
 	FirstCommitmentPoint *btcec.PublicKey
 
+	// UpfrontShutdown is the script this node commits to paying its funds
+	// to upon a cooperative close of the channel. If empty, the node may
+	// pay its funds to any script.
+	UpfrontShutdown lnwire.DeliveryAddress
+
 	// ChannelConfig is the concrete contribution that this node is
 	// offering to the channel. This includes all the various constraints
 	// such as the min HTLC, and also all the keys which will be used for
@@ -49,6 +73,21 @@ func (c *ChannelContribution) toChanConfig() channeldb.ChannelConfig {
 	return *c.ChannelConfig
 }
 
+// prevOutput returns the output spent by the contributed input with the
+// passed outpoint.
+func (c *ChannelContribution) prevOutput(op wire.OutPoint) (*wire.TxOut, error) {
+	for i, txIn := range c.Inputs {
+		if txIn.PreviousOutPoint != op || i >= len(c.PrevOutputs) {
+			continue
+		}
+
+		return c.PrevOutputs[i], nil
+	}
+
+	return nil, fmt.Errorf("previous output of contributed input %v "+
+		"unknown", op)
+}
+
 // InputScript represents any script inputs required to redeem a previous
 // output. This struct is used rather than just a witness, or scripSig in
 // order to accommodate nested p2sh which utilizes both types of input scripts.
@@ -120,6 +159,10 @@ type ChannelReservation struct {
 	// commitment state.
 	pushMSat lnwire.MilliSatoshi
 
+	// maxCsvDelay is the largest CSV delay we'll accept the remote party
+	// requiring for our funds in case of a unilateral close.
+	maxCsvDelay uint16
+
 	// chanOpen houses a struct containing the channel and additional
 	// confirmation details will be sent on once the channel is considered
 	// 'open'. A channel is open once the funding transaction has reached a
@@ -137,23 +180,40 @@ type ChannelReservation struct {
 func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 	commitFeePerKw SatPerKWeight, wallet *LightningWallet,
 	id uint64, pushMSat lnwire.MilliSatoshi, chainHash *chainhash.Hash,
-	flags lnwire.FundingFlag) (*ChannelReservation, error) {
+	flags lnwire.FundingFlag, staticRemoteKey, anchors bool) (
+	*ChannelReservation, error) {
 
 	var (
 		ourBalance   lnwire.MilliSatoshi
 		theirBalance lnwire.MilliSatoshi
 		initiator    bool
+		commitType   channeldb.ChannelType
 	)
 
-	commitFee := commitFeePerKw.FeeForWeight(CommitWeight)
+	// If both parties support it, the commitments of the channel will pay
+	// the to_remote output to a static key. Anchor channels always use a
+	// static remote key.
+	if staticRemoteKey || anchors {
+		commitType |= channeldb.StaticRemoteKeyBit
+	}
+	if anchors {
+		commitType |= channeldb.AnchorOutputsBit
+	}
+
+	// The initiator pays for the commitment fee, as well as the value of
+	// the anchor outputs if the channel uses them.
+	commitFee := commitFeePerKw.FeeForWeight(CommitWeightForType(commitType))
 	fundingMSat := lnwire.NewMSatFromSatoshis(fundingAmt)
 	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
-	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
+	feeMSat := lnwire.NewMSatFromSatoshis(
+		commitFee + anchorAmount(commitType),
+	)
 
+	switch {
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
 	// some funds to us within the first commitment state.
-	if fundingAmt == 0 {
+	case fundingAmt == 0:
 		ourBalance = pushMSat
 		theirBalance = capacityMSat - feeMSat - pushMSat
 		initiator = false
@@ -166,25 +226,15 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 				int64(2*DefaultDustLimit()),
 			)
 		}
-	} else {
-		// TODO(roasbeef): need to rework fee structure in general and
-		// also when we "unlock" dual funder within the daemon
-
-		if capacity == fundingAmt {
-			// If we're initiating a single funder workflow, then
-			// we pay all the initial fees within the commitment
-			// transaction. We also deduct our balance by the
-			// amount pushed as part of the initial state.
-			ourBalance = capacityMSat - feeMSat - pushMSat
-			theirBalance = pushMSat
-		} else {
-			// Otherwise, this is a dual funder workflow where both
-			// slides split the amount funded and the commitment
-			// fee.
-			ourBalance = fundingMSat - (feeMSat / 2)
-			theirBalance = capacityMSat - fundingMSat - (feeMSat / 2) + pushMSat
-		}
 
+	// If we're initiating the funding workflow, then we pay all the
+	// initial fees within the commitment transaction. We also deduct our
+	// balance by the amount pushed as part of the initial state. Should
+	// the remote party contribute funds of its own, they'll be added via
+	// AcceptRemoteFunding.
+	case capacity == fundingAmt:
+		ourBalance = capacityMSat - feeMSat - pushMSat
+		theirBalance = pushMSat
 		initiator = true
 
 		// If we, the initiator don't have enough funds to actually pay
@@ -195,6 +245,22 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 				int64(2*DefaultDustLimit()),
 			)
 		}
+
+	// Otherwise, we're the responder of a dual funder workflow,
+	// contributing funds of our own to the channel. As the initiator
+	// still pays the commitment fee, our balance is exactly the amount we
+	// fund, plus anything pushed to us.
+	default:
+		ourBalance = fundingMSat + pushMSat
+		theirBalance = capacityMSat - fundingMSat - feeMSat - pushMSat
+		initiator = false
+
+		if int64(theirBalance) < 0 {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee), int64(theirBalance.ToSatoshis()),
+				int64(2*DefaultDustLimit()),
+			)
+		}
 	}
 
 	// If we're the initiator and our starting balance within the channel
@@ -211,21 +277,17 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 	}
 
 	// Next we'll set the channel type based on what we can ascertain about
-	// the balances/push amount within the channel.
+	// the contributions to the channel. Unless we contribute as the
+	// responder, this starts out as a single-funder channel.
 	var chanType channeldb.ChannelType
-
-	// If either of the balances are zero at this point, or we have a
-	// non-zero push amt (there's no pushing for dual funder), then this is
-	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || pushMSat != 0 {
-		chanType = channeldb.SingleFunder
-	} else {
-		// Otherwise, this is a dual funder channel, and no side is
-		// technically the "initiator"
-		initiator = false
+	if !initiator && fundingAmt != 0 {
 		chanType = channeldb.DualFunder
+	} else {
+		chanType = channeldb.SingleFunder
 	}
 
+	chanType |= commitType
+
 	return &ChannelReservation{
 		ourContribution: &ChannelContribution{
 			FundingAmount: ourBalance.ToSatoshis(),
@@ -257,6 +319,7 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 			Db: wallet.Cfg.Database,
 		},
 		pushMSat:      pushMSat,
+		maxCsvDelay:   DefaultMaxCsvDelay,
 		reservationID: id,
 		chanOpen:      make(chan *openChanDetails, 1),
 		chanOpenErr:   make(chan error, 1),
@@ -276,32 +339,74 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
-// CommitConstraints takes the constraints that the remote party specifies for
-// the type of commitments that we can generate for them. These constraints
-// include several parameters that serve as flow control restricting the amount
-// of satoshis that can be transferred in a single commitment. This function
-// will also attempt to verify the constraints for sanity, returning an error
-// if the parameters are seemed unsound.
-func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
-	maxValueInFlight, minHtlc lnwire.MilliSatoshi,
-	chanReserve, dustLimit btcutil.Amount) error {
-
+// SetOurDustLimit sets the dust limit of our version of the commitment
+// transaction, below which outputs are trimmed from it. The dust limit must not
+// be below the default dust limit, as such outputs wouldn't be relayed by the
+// network.
+//
+// NOTE: This MUST be called before the remote party's constraints are
+// committed via CommitConstraints.
+func (r *ChannelReservation) SetOurDustLimit(dustLimit btcutil.Amount) error {
 	r.Lock()
 	defer r.Unlock()
 
-	// Fail if we consider csvDelay excessively large.
-	// TODO(halseth): find a more scientific choice of value.
-	const maxDelay = 10000
-	if csvDelay > maxDelay {
-		return ErrCsvDelayTooLarge(csvDelay, maxDelay)
+	if dustLimit < DefaultDustLimit() {
+		return ErrDustLimitTooSmall(dustLimit, DefaultDustLimit())
 	}
-
-	// The dust limit should always be greater or equal to the channel
-	// reserve. The reservation request should be denied if otherwise.
-	if dustLimit > chanReserve {
-		return ErrChanReserveTooSmall(chanReserve, dustLimit)
+	if dustLimit >= r.partialState.Capacity {
+		return ErrDustLimitTooLarge(dustLimit, r.partialState.Capacity)
 	}
 
+	r.ourContribution.DustLimit = dustLimit
+
+	return nil
+}
+
+// SetOurUpfrontShutdown commits us to paying our funds to the passed script
+// upon a cooperative close of the channel.
+func (r *ChannelReservation) SetOurUpfrontShutdown(
+	script lnwire.DeliveryAddress) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.ourContribution.UpfrontShutdown = script
+}
+
+// SetMaxCsvDelay sets the largest CSV delay we'll accept the remote party
+// requiring for our funds in case of a unilateral close. Any larger delay
+// will cause CommitConstraints to fail.
+func (r *ChannelReservation) SetMaxCsvDelay(maxDelay uint16) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.maxCsvDelay = maxDelay
+}
+
+// ValidateTheirConstraints verifies the constraints we're about to require
+// the remote party to adhere to within the commitments it creates for us,
+// returning an error if they're unsound, and would thus be refused by the
+// remote party, or render the channel unusable.
+func (r *ChannelReservation) ValidateTheirConstraints(maxHtlcs uint16,
+	maxValueInFlight, minHtlc lnwire.MilliSatoshi,
+	chanReserve btcutil.Amount) error {
+
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.validateConstraints(
+		maxHtlcs, maxValueInFlight, minHtlc, chanReserve,
+	)
+}
+
+// validateConstraints verifies the set of constraints one party requires the
+// other to adhere to, which apply in both directions.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (r *ChannelReservation) validateConstraints(maxHtlcs uint16,
+	maxValueInFlight, minHtlc lnwire.MilliSatoshi,
+	chanReserve btcutil.Amount) error {
+
 	// Fail if we consider the channel reserve to be too large.  We
 	// currently fail if it is greater than 20% of the channel capacity.
 	maxChanReserve := r.partialState.Capacity / 5
@@ -325,7 +430,6 @@ func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
 
 	// Fail if we consider maxHtlcs too small. If this is too small we
 	// cannot offer many HTLCs to the remote.
-	const minNumHtlc = 5
 	if maxHtlcs < minNumHtlc {
 		return ErrMaxHtlcNumTooSmall(maxHtlcs, minNumHtlc)
 	}
@@ -337,6 +441,75 @@ func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
 			minNumHtlc*minHtlc)
 	}
 
+	return nil
+}
+
+// AcceptRemoteFunding is called by the initiator of a funding workflow once
+// the responder has offered to contribute the given amount of funds to the
+// channel, turning the reservation into a dual funder reservation. The
+// channel capacity and the responder's initial balance are increased by the
+// contributed amount. As the initiator, we'll still pay the full commitment
+// fee.
+//
+// NOTE: This MUST be called before the responder's contribution is processed
+// via ProcessContribution.
+func (r *ChannelReservation) AcceptRemoteFunding(amt btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.partialState.IsInitiator {
+		return fmt.Errorf("only the initiator can accept funds " +
+			"contributed by the remote party")
+	}
+	if r.fundingTx != nil {
+		return fmt.Errorf("funding transaction already constructed")
+	}
+	if amt <= 0 {
+		return fmt.Errorf("invalid remote funding amount: %v", amt)
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+
+	r.partialState.ChanType |= channeldb.DualFunder
+	r.partialState.Capacity += amt
+	r.partialState.LocalCommitment.RemoteBalance += amtMSat
+	r.partialState.RemoteCommitment.RemoteBalance += amtMSat
+	r.theirContribution.FundingAmount += amt
+
+	return nil
+}
+
+// CommitConstraints takes the constraints that the remote party specifies for
+// the type of commitments that we can generate for them. These constraints
+// include several parameters that serve as flow control restricting the amount
+// of satoshis that can be transferred in a single commitment. This function
+// will also attempt to verify the constraints for sanity, returning an error
+// if the parameters are seemed unsound.
+func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
+	maxValueInFlight, minHtlc lnwire.MilliSatoshi,
+	chanReserve, dustLimit btcutil.Amount) error {
+
+	r.Lock()
+	defer r.Unlock()
+
+	// Fail if we consider csvDelay excessively large.
+	if csvDelay > r.maxCsvDelay {
+		return ErrCsvDelayTooLarge(csvDelay, r.maxCsvDelay)
+	}
+
+	// The dust limit should always be greater or equal to the channel
+	// reserve. The reservation request should be denied if otherwise.
+	if dustLimit > chanReserve {
+		return ErrChanReserveTooSmall(chanReserve, dustLimit)
+	}
+
+	err := r.validateConstraints(
+		maxHtlcs, maxValueInFlight, minHtlc, chanReserve,
+	)
+	if err != nil {
+		return err
+	}
+
 	// Our dust limit should always be less than or equal our proposed
 	// channel reserve.
 	if r.ourContribution.DustLimit > chanReserve {
@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
 )
 
 var (
@@ -44,6 +45,15 @@ const (
 	// StateHintSize bytes amongst the sequence number and locktime fields
 	// of the commitment transaction.
 	maxStateHint uint64 = (1 << 48) - 1
+
+	// AnchorSize is the value of each of the two anchor outputs on the
+	// commitment transaction of a channel using anchor outputs.
+	AnchorSize = btcutil.Amount(330)
+
+	// anchorAnyoneCanSpendDelay is the number of blocks after the
+	// commitment transaction confirms that anyone may sweep an anchor
+	// output, cleaning up the UTXO set.
+	anchorAnyoneCanSpendDelay = 16
 )
 
 // WitnessScriptHash generates a pay-to-witness-script-hash public key script
@@ -191,9 +201,16 @@ func Ripemd160H(d []byte) []byte {
 //         OP_HASH160 <ripemd160(payment hash)> OP_EQUALVERIFY
 //         OP_CHECKSIG
 //     OP_ENDIF
+//     [1 OP_CHECKSEQUENCEVERIFY OP_DROP] <- if confirmedSpend
 // OP_ENDIF
+//
+// If confirmedSpend is true, as is the case for channels using anchor outputs,
+// the non-revocation clauses may only be used once the commitment transaction
+// has confirmed, preventing the output from being spent by an unconfirmed
+// child that would pin the commitment.
 func senderHTLCScript(senderHtlcKey, receiverHtlcKey,
-	revocationKey *btcec.PublicKey, paymentHash []byte) ([]byte, error) {
+	revocationKey *btcec.PublicKey, paymentHash []byte,
+	confirmedSpend bool) ([]byte, error) {
 
 	builder := txscript.NewScriptBuilder()
 
@@ -266,6 +283,14 @@ func senderHTLCScript(senderHtlcKey, receiverHtlcKey,
 	// Close out the OP_IF statement above.
 	builder.AddOp(txscript.OP_ENDIF)
 
+	// Add a 1 block CSV delay if a confirmation is required for the
+	// non-revocation clauses.
+	if confirmedSpend {
+		builder.AddOp(txscript.OP_1)
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+
 	// Close out the OP_IF statement at the top of the script.
 	builder.AddOp(txscript.OP_ENDIF)
 
@@ -353,7 +378,8 @@ func SenderHtlcSpendRedeem(signer Signer, signDesc *SignDescriptor,
 // HTLC to activate the time locked covenant clause of a soon to be expired
 // HTLC.  This script simply spends the multi-sig output using the
 // pre-generated HTLC timeout transaction.
-func senderHtlcSpendTimeout(receiverSig []byte, signer Signer,
+func senderHtlcSpendTimeout(receiverSig []byte,
+	receiverSigHash txscript.SigHashType, signer Signer,
 	signDesc *SignDescriptor, htlcTimeoutTx *wire.MsgTx) (wire.TxWitness, error) {
 
 	sweepSig, err := signer.SignOutputRaw(htlcTimeoutTx, signDesc)
@@ -367,7 +393,7 @@ func senderHtlcSpendTimeout(receiverSig []byte, signer Signer,
 	// original OP_CHECKMULTISIG.
 	witnessStack := wire.TxWitness(make([][]byte, 5))
 	witnessStack[0] = nil
-	witnessStack[1] = append(receiverSig, byte(txscript.SigHashAll))
+	witnessStack[1] = append(receiverSig, byte(receiverSigHash))
 	witnessStack[2] = append(sweepSig, byte(signDesc.HashType))
 	witnessStack[3] = nil
 	witnessStack[4] = signDesc.WitnessScript
@@ -404,10 +430,14 @@ func senderHtlcSpendTimeout(receiverSig []byte, signer Signer,
 //         OP_DROP <cltv expiry> OP_CHECKLOCKTIMEVERIFY OP_DROP
 //         OP_CHECKSIG
 //     OP_ENDIF
+//     [1 OP_CHECKSEQUENCEVERIFY OP_DROP] <- if confirmedSpend
 // OP_ENDIF
+//
+// As with senderHTLCScript, confirmedSpend requires the commitment transaction
+// to be confirmed before the non-revocation clauses can be used.
 func receiverHTLCScript(cltvExpiry uint32, senderHtlcKey,
 	receiverHtlcKey, revocationKey *btcec.PublicKey,
-	paymentHash []byte) ([]byte, error) {
+	paymentHash []byte, confirmedSpend bool) ([]byte, error) {
 
 	builder := txscript.NewScriptBuilder()
 
@@ -490,6 +520,14 @@ func receiverHTLCScript(cltvExpiry uint32, senderHtlcKey,
 	// Close out the inner if statement.
 	builder.AddOp(txscript.OP_ENDIF)
 
+	// Add a 1 block CSV delay if a confirmation is required for the
+	// non-revocation clauses.
+	if confirmedSpend {
+		builder.AddOp(txscript.OP_1)
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+
 	// Close out the outer if statement.
 	builder.AddOp(txscript.OP_ENDIF)
 
@@ -504,7 +542,8 @@ func receiverHTLCScript(cltvExpiry uint32, senderHtlcKey,
 // signed has a relative timelock delay enforced by its sequence number. This
 // delay give the sender of the HTLC enough time to revoke the output if this
 // is a breach commitment transaction.
-func receiverHtlcSpendRedeem(senderSig, paymentPreimage []byte,
+func receiverHtlcSpendRedeem(senderSig []byte,
+	senderSigHash txscript.SigHashType, paymentPreimage []byte,
 	signer Signer, signDesc *SignDescriptor,
 	htlcSuccessTx *wire.MsgTx) (wire.TxWitness, error) {
 
@@ -522,7 +561,7 @@ func receiverHtlcSpendRedeem(senderSig, paymentPreimage []byte,
 	// order to consume the extra pop within OP_CHECKMULTISIG.
 	witnessStack := wire.TxWitness(make([][]byte, 5))
 	witnessStack[0] = nil
-	witnessStack[1] = append(senderSig, byte(txscript.SigHashAll))
+	witnessStack[1] = append(senderSig, byte(senderSigHash))
 	witnessStack[2] = append(sweepSig, byte(signDesc.HashType))
 	witnessStack[3] = paymentPreimage
 	witnessStack[4] = signDesc.WitnessScript
@@ -638,7 +677,8 @@ func receiverHtlcSpendTimeout(signer Signer, signDesc *SignDescriptor,
 // NOTE: The passed amount for the HTLC should take into account the required
 // fee rate at the time the HTLC was created. The fee should be able to
 // entirely pay for this (tiny: 1-in 1-out) transaction.
-func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
+func createHtlcTimeoutTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	cltvExpiry, csvDelay uint32,
 	revocationKey, delayKey *btcec.PublicKey) (*wire.MsgTx, error) {
 
@@ -652,6 +692,7 @@ func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	// original HTLC on the sender's commitment transaction.
 	timeoutTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
@@ -687,8 +728,8 @@ func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 // In order to spend the HTLC output, the witness for the passed transaction
 // should be:
 //   * <0> <sender sig> <recvr sig> <preimage>
-func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
-	csvDelay uint32,
+func createHtlcSuccessTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt btcutil.Amount, csvDelay uint32,
 	revocationKey, delayKey *btcec.PublicKey) (*wire.MsgTx, error) {
 
 	// Create a version two transaction (as the success version of this
@@ -699,6 +740,7 @@ func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	// original HTLC on the sender's commitment transaction.
 	successTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
@@ -1031,9 +1073,10 @@ func CommitSpendRevoke(signer Signer, signDesc *SignDescriptor,
 //
 // NOTE: The passed SignDescriptor should include the raw (untweaked) public
 // key of the receiver and also the proper single tweak value based on the
-// current commitment point.
+// current commitment point. If the output pays to a static remote key, then
+// tweakless should be true, and the untweaked public key is used directly.
 func CommitSpendNoDelay(signer Signer, signDesc *SignDescriptor,
-	sweepTx *wire.MsgTx) (wire.TxWitness, error) {
+	sweepTx *wire.MsgTx, tweakless bool) (wire.TxWitness, error) {
 
 	if signDesc.KeyDesc.PubKey == nil {
 		return nil, fmt.Errorf("cannot generate witness with nil " +
@@ -1051,15 +1094,152 @@ func CommitSpendNoDelay(signer Signer, signDesc *SignDescriptor,
 	// exact same as a regular p2wkh witness, but we'll need to ensure that
 	// we use the tweaked public key as the last item in the witness stack
 	// which was originally used to created the pkScript we're spending.
+	// Outputs paying to a static remote key use the untweaked key instead.
 	witness := make([][]byte, 2)
 	witness[0] = append(sweepSig, byte(signDesc.HashType))
-	witness[1] = TweakPubKeyWithTweak(
-		signDesc.KeyDesc.PubKey, signDesc.SingleTweak,
-	).SerializeCompressed()
+	if tweakless {
+		witness[1] = signDesc.KeyDesc.PubKey.SerializeCompressed()
+	} else {
+		witness[1] = TweakPubKeyWithTweak(
+			signDesc.KeyDesc.PubKey, signDesc.SingleTweak,
+		).SerializeCompressed()
+	}
 
 	return witness, nil
 }
 
+// CommitScriptToRemoteConfirmed constructs the script for the output on the
+// commitment transaction paying to the remote party of a channel using anchor
+// outputs. The output can only be spent once the commitment transaction has
+// confirmed, ensuring the remote party can't use it to attach an unconfirmed
+// child to the commitment.
+//
+// Possible Input Scripts:
+//     REMOTE: <sig>
+//
+// <key> OP_CHECKSIGVERIFY 1 OP_CHECKSEQUENCEVERIFY
+func CommitScriptToRemoteConfirmed(key *btcec.PublicKey) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	// Only the given key can spend the output.
+	builder.AddData(key.SerializeCompressed())
+	builder.AddOp(txscript.OP_CHECKSIGVERIFY)
+
+	// Check that the it has one confirmation.
+	builder.AddOp(txscript.OP_1)
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+
+	return builder.Script()
+}
+
+// CommitSpendToRemoteConfirmed constructs a valid witness allowing a node to
+// spend their settled output on the counterparty's commitment transaction of
+// a channel using anchor outputs. The spending input must have a sequence of
+// at least one to satisfy the CSV check.
+//
+// NOTE: The passed SignDescriptor should include the raw (untweaked) payment
+// base point of the receiver, as the output always pays to a static remote
+// key.
+func CommitSpendToRemoteConfirmed(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx) (wire.TxWitness, error) {
+
+	if signDesc.KeyDesc.PubKey == nil {
+		return nil, fmt.Errorf("cannot generate witness with nil " +
+			"KeyDesc pubkey")
+	}
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := wire.TxWitness(make([][]byte, 2))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// CommitScriptAnchor constructs the script for an anchor output on the
+// commitment transaction of a channel using anchor outputs. The owner of the
+// funding key can spend it at any time, allowing them to attach a child
+// paying additional fees to the commitment (CPFP). Once the commitment has
+// been confirmed for 16 blocks, anyone may spend it to clean up the UTXO set.
+//
+// Possible Input Scripts:
+//     By owner:                <sig>
+//     By anyone (after 16 conf):  <emptyvector>
+//
+// <funding key> OP_CHECKSIG OP_IFDUP
+// OP_NOTIF
+//     OP_16 OP_CHECKSEQUENCEVERIFY
+// OP_ENDIF
+func CommitScriptAnchor(fundingKey *btcec.PublicKey) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	// The owner of the funding key can always spend the output.
+	builder.AddData(fundingKey.SerializeCompressed())
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	// If the signature check succeeded, we'll keep its result on the
+	// stack, otherwise anyone can spend it after 16 confirmations.
+	builder.AddOp(txscript.OP_IFDUP)
+	builder.AddOp(txscript.OP_NOTIF)
+	builder.AddInt64(anchorAnyoneCanSpendDelay)
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	builder.AddOp(txscript.OP_ENDIF)
+
+	return builder.Script()
+}
+
+// CommitSpendAnchor constructs a valid witness allowing the owner of the
+// funding key to spend their anchor output on the commitment transaction.
+func CommitSpendAnchor(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx) (wire.TxWitness, error) {
+
+	if signDesc.KeyDesc.PubKey == nil {
+		return nil, fmt.Errorf("cannot generate witness with nil " +
+			"KeyDesc pubkey")
+	}
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := wire.TxWitness(make([][]byte, 2))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// HtlcSigHashType returns the sighash type that the remote party's signature
+// on a second-level HTLC transaction must commit to for the given channel
+// type. Channels using anchor outputs sign these transactions with
+// SIGHASH_SINGLE|SIGHASH_ANYONECANPAY, so additional inputs and outputs can be
+// attached to pay fees.
+func HtlcSigHashType(chanType channeldb.ChannelType) txscript.SigHashType {
+	if chanType.HasAnchors() {
+		return txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
+	}
+
+	return txscript.SigHashAll
+}
+
+// HtlcSecondLevelInputSequence returns the sequence of the input spending an
+// HTLC output of the commitment transaction through a second-level HTLC
+// transaction. For channels using anchor outputs, the HTLC output can only be
+// spent once the commitment has confirmed, so a relative lock of one block is
+// required.
+func HtlcSecondLevelInputSequence(chanType channeldb.ChannelType) uint32 {
+	if chanType.HasAnchors() {
+		return 1
+	}
+
+	return 0
+}
+
 // SingleTweakBytes computes set of bytes we call the single tweak. The purpose
 // of the single tweak is to randomize all regular delay and payment base
 // points. To do this, we generate a hash that binds the commitment point to
@@ -13,9 +13,84 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// TestCreateCommitTxAnchors tests that the anchor output of a party is only
+// added to an anchor commitment if the party has an output on it, or there are
+// untrimmed HTLCs.
+func TestCreateCommitTxAnchors(t *testing.T) {
+	t.Parallel()
+
+	fakeFundingTxIn := wire.NewTxIn(&wire.OutPoint{Index: 50}, nil, nil)
+
+	_, aliceKeyPub := btcec.PrivKeyFromBytes(btcec.S256(),
+		testWalletPrivKey)
+	_, bobKeyPub := btcec.PrivKeyFromBytes(btcec.S256(), bobsPrivKey)
+	keyRing := &CommitmentKeyRing{
+		DelayKey:      aliceKeyPub,
+		RevocationKey: bobKeyPub,
+		NoDelayKey:    bobKeyPub,
+	}
+
+	anchorPkScript := func(key *btcec.PublicKey) []byte {
+		script, err := CommitScriptAnchor(key)
+		if err != nil {
+			t.Fatalf("unable to create anchor script: %v", err)
+		}
+		pkScript, err := WitnessScriptHash(script)
+		if err != nil {
+			t.Fatalf("unable to create anchor pkScript: %v", err)
+		}
+		return pkScript
+	}
+	aliceAnchor := anchorPkScript(aliceKeyPub)
+	bobAnchor := anchorPkScript(bobKeyPub)
+
+	const balance = btcutil.Amount(1 * 10e8)
+	tests := []struct {
+		name                   string
+		toAlice, toBob         btcutil.Amount
+		numHTLCs               int64
+		aliceAnchor, bobAnchor bool
+	}{
+		{"both outputs", balance, balance, 0, true, true},
+		{"alice only", balance, 0, 0, true, false},
+		{"bob only", 0, balance, 0, false, true},
+		{"bob only with htlcs", 0, balance, 1, true, true},
+		{"no outputs with htlcs", 0, 0, 1, true, true},
+	}
+	for _, test := range tests {
+		commitTx, err := CreateCommitTx(
+			channeldb.SingleFunder|channeldb.StaticRemoteKeyBit|
+				channeldb.AnchorOutputsBit,
+			*fakeFundingTxIn, keyRing, aliceKeyPub, bobKeyPub, 5,
+			test.toAlice, test.toBob, DefaultDustLimit(),
+			test.numHTLCs,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to create commitment: %v",
+				test.name, err)
+		}
+
+		var hasAlice, hasBob bool
+		for _, txOut := range commitTx.TxOut {
+			switch {
+			case bytes.Equal(txOut.PkScript, aliceAnchor):
+				hasAlice = true
+			case bytes.Equal(txOut.PkScript, bobAnchor):
+				hasBob = true
+			}
+		}
+		if hasAlice != test.aliceAnchor || hasBob != test.bobAnchor {
+			t.Fatalf("%v: expected anchors alice=%v bob=%v, got "+
+				"alice=%v bob=%v", test.name, test.aliceAnchor,
+				test.bobAnchor, hasAlice, hasBob)
+		}
+	}
+}
+
 // TestCommitmentSpendValidation test the spendability of both outputs within
 // the commitment transaction.
 //
@@ -79,8 +154,9 @@ func TestCommitmentSpendValidation(t *testing.T) {
 		RevocationKey: revokePubKey,
 		NoDelayKey:    bobPayKey,
 	}
-	commitmentTx, err := CreateCommitTx(*fakeFundingTxIn, keyRing, csvTimeout,
-		channelBalance, channelBalance, DefaultDustLimit())
+	commitmentTx, err := CreateCommitTx(channeldb.SingleFunder,
+		*fakeFundingTxIn, keyRing, nil, nil, csvTimeout,
+		channelBalance, channelBalance, DefaultDustLimit(), 0)
 	if err != nil {
 		t.Fatalf("unable to create commitment transaction: %v", nil)
 	}
@@ -205,7 +281,7 @@ func TestCommitmentSpendValidation(t *testing.T) {
 		InputIndex: 0,
 	}
 	bobRegularSpend, err := CommitSpendNoDelay(bobSigner, signDesc,
-		sweepTx)
+		sweepTx, false)
 	if err != nil {
 		t.Fatalf("unable to create bob regular spend: %v", err)
 	}
@@ -353,7 +429,7 @@ func TestHTLCSenderSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := senderHTLCScript(aliceLocalKey, bobLocalKey,
-		revocationKey, paymentHash[:])
+		revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
@@ -501,7 +577,8 @@ func TestHTLCSenderSpendValidation(t *testing.T) {
 					InputIndex:    0,
 				}
 
-				return senderHtlcSpendTimeout(bobRecvrSig, aliceSigner,
+				return senderHtlcSpendTimeout(bobRecvrSig,
+					txscript.SigHashAll, aliceSigner,
 					signDesc, sweepTx)
 			}),
 			true,
@@ -608,7 +685,7 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := receiverHTLCScript(cltvTimeout, aliceLocalKey,
-		bobLocalKey, revocationKey, paymentHash[:])
+		bobLocalKey, revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
@@ -695,6 +772,7 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 				}
 
 				return receiverHtlcSpendRedeem(aliceSenderSig,
+					txscript.SigHashAll,
 					bytes.Repeat([]byte{1}, 45), bobSigner,
 					signDesc, sweepTx)
 
@@ -717,6 +795,7 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 				}
 
 				return receiverHtlcSpendRedeem(aliceSenderSig,
+					txscript.SigHashAll,
 					paymentPreimage[:], bobSigner,
 					signDesc, sweepTx)
 			}),
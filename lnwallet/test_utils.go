@@ -98,6 +98,15 @@ var (
 // the test has been finalized. The clean up function will remote all temporary
 // files created
 func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error) {
+	return CreateTestChannelsWithType(channeldb.SingleFunder)
+}
+
+// CreateTestChannelsWithType is identical to CreateTestChannels, but creates
+// channels of the passed type, allowing tests to exercise the commitment
+// format variants.
+func CreateTestChannelsWithType(chanType channeldb.ChannelType) (
+	*LightningChannel, *LightningChannel, func(), error) {
+
 	channelCapacity, err := btcutil.NewAmount(10)
 	if err != nil {
 		return nil, nil, nil, err
@@ -212,7 +221,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 
 	aliceCommitTx, bobCommitTx, err := CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, chanType)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -235,10 +244,15 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		return nil, nil, nil, err
 	}
 	commitFee := calcStaticFee(0)
+	if chanType.HasAnchors() {
+		commitFee = btcutil.Amount(feePerKw) *
+			btcutil.Amount(CommitWeightForType(chanType)) / 1000
+	}
+	initiatorBal := channelBal - commitFee - anchorAmount(chanType)
 
 	aliceCommit := channeldb.ChannelCommitment{
 		CommitHeight:  0,
-		LocalBalance:  lnwire.NewMSatFromSatoshis(channelBal - commitFee),
+		LocalBalance:  lnwire.NewMSatFromSatoshis(initiatorBal),
 		RemoteBalance: lnwire.NewMSatFromSatoshis(channelBal),
 		CommitFee:     commitFee,
 		FeePerKw:      btcutil.Amount(feePerKw),
@@ -248,7 +262,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 	bobCommit := channeldb.ChannelCommitment{
 		CommitHeight:  0,
 		LocalBalance:  lnwire.NewMSatFromSatoshis(channelBal),
-		RemoteBalance: lnwire.NewMSatFromSatoshis(channelBal - commitFee),
+		RemoteBalance: lnwire.NewMSatFromSatoshis(initiatorBal),
 		CommitFee:     commitFee,
 		FeePerKw:      btcutil.Amount(feePerKw),
 		CommitTx:      bobCommitTx,
@@ -270,7 +284,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		IdentityPub:             aliceKeys[0].PubKey(),
 		FundingOutpoint:         *prevOut,
 		ShortChannelID:          shortChanID,
-		ChanType:                channeldb.SingleFunder,
+		ChanType:                chanType,
 		IsInitiator:             true,
 		Capacity:                channelCapacity,
 		RemoteCurrentRevocation: bobCommitPoint,
@@ -288,7 +302,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		IdentityPub:             bobKeys[0].PubKey(),
 		FundingOutpoint:         *prevOut,
 		ShortChannelID:          shortChanID,
-		ChanType:                channeldb.SingleFunder,
+		ChanType:                chanType,
 		IsInitiator:             false,
 		Capacity:                channelCapacity,
 		RemoteCurrentRevocation: aliceCommitPoint,
@@ -408,7 +422,7 @@ func (m *mockSigner) SignOutputRaw(tx *wire.MsgTx, signDesc *SignDescriptor) ([]
 
 	sig, err := txscript.RawTxInWitnessSignature(tx, signDesc.SigHashes,
 		signDesc.InputIndex, signDesc.Output.Value, signDesc.WitnessScript,
-		txscript.SigHashAll, privKey)
+		signDesc.HashType, privKey)
 	if err != nil {
 		return nil, err
 	}
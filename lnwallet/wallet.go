@@ -29,6 +29,14 @@ const (
 	// The size of the buffered queue of requests to the wallet from the
 	// outside word.
 	msgBufferSize = 100
+
+	// FundingTxInSequence is the sequence number of the inputs of funding
+	// transactions. It signals opt-in replaceability as defined in BIP
+	// 125, such that the funding transaction of a pending channel can be
+	// double spent in order to cancel it while it's still in the mempool.
+	// Both parties of a dual funded channel must use it for the inputs of
+	// each other, so they arrive at the same funding transaction.
+	FundingTxInSequence = wire.MaxTxInSequenceNum - 2
 )
 
 // ErrInsufficientFunds is a type matching the error interface which is
@@ -98,6 +106,24 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// DualFund indicates that the reservation is for a channel both
+	// parties contribute funds to. As the funding transaction can only be
+	// constructed once the inputs of both parties are known, only native
+	// witness outputs are selected to fund such a channel, as their input
+	// scripts don't alter the txid of the funding transaction.
+	DualFund bool
+
+	// StaticRemoteKey indicates that both parties support the static
+	// remote key commitment format, so the to_remote output of each
+	// commitment will pay to the untweaked payment base point of the
+	// party that doesn't own the commitment.
+	StaticRemoteKey bool
+
+	// Anchors indicates that both parties support the anchor output
+	// commitment format, which adds an anchor output for each party to
+	// the commitments, allowing their fees to be bumped using CPFP.
+	Anchors bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -369,6 +395,142 @@ func (l *LightningWallet) ActiveReservations() []*ChannelReservation {
 	return reservations
 }
 
+// PublishLabelledTransaction broadcasts the passed transaction and records the
+// passed label for it, such that the purpose of the transaction can be
+// reported as part of the wallet's transaction history. The label is only
+// stored once the transaction has been published, so a transaction that was
+// rejected doesn't leave a label behind.
+func (l *LightningWallet) PublishLabelledTransaction(tx *wire.MsgTx,
+	label *channeldb.TxLabel) error {
+
+	if err := l.PublishTransaction(tx); err != nil {
+		return err
+	}
+
+	// The transaction is out at this point, so failing to store its label
+	// mustn't be reported as a failed broadcast to the caller.
+	txid := tx.TxHash()
+	if err := l.Cfg.Database.PutTxLabel(txid, label); err != nil {
+		walletLog.Errorf("Unable to store label for tx %v: %v",
+			txid, err)
+	}
+
+	return nil
+}
+
+// CreateFundingCancelTx creates and signs a transaction double spending the
+// wallet's inputs to the passed funding transaction back to a fresh wallet
+// address. Once it confirms, the funding transaction can no longer confirm,
+// which cancels the channel it was meant to open. The fee is derived from the
+// passed fee rate, but raised if needed to replace the funding transaction
+// within the mempool.
+//
+// NOTE: Mempools only accept the replacement if the funding transaction
+// signals replaceability, which those created before its inputs used
+// FundingTxInSequence don't. Such funding transactions can only be canceled
+// once they've been evicted from the mempool.
+func (l *LightningWallet) CreateFundingCancelTx(fundingTx *wire.MsgTx,
+	feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	cancelTx := wire.NewMsgTx(2)
+
+	// We'll spend each of the funding transaction's inputs that belong to
+	// our wallet. Those contributed by the remote party in a dual funded
+	// channel are left alone, as spending any of the inputs suffices.
+	var (
+		weightEstimate TxWeightEstimator
+		prevOutputs    []*wire.TxOut
+		totalIn        btcutil.Amount
+		allOurs        = true
+	)
+	for _, txIn := range fundingTx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == ErrNotMine {
+			allOurs = false
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(info.PkScript):
+			weightEstimate.AddP2WKHInput()
+		case txscript.IsPayToScriptHash(info.PkScript):
+			weightEstimate.AddNestedP2WKHInput()
+		default:
+			return nil, fmt.Errorf("unsupported funding input "+
+				"script: %x", info.PkScript)
+		}
+
+		cancelTx.AddTxIn(wire.NewTxIn(&txIn.PreviousOutPoint, nil, nil))
+		prevOutputs = append(prevOutputs, info)
+		totalIn += btcutil.Amount(info.Value)
+	}
+	if len(prevOutputs) == 0 {
+		return nil, errors.New("funding transaction has no wallet " +
+			"inputs")
+	}
+
+	addr, err := l.NewAddress(WitnessPubKey, false)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	weightEstimate.AddP2WKHOutput()
+
+	weight := int64(weightEstimate.Weight())
+	fee := feeRate.FeeForWeight(weight)
+
+	// If all inputs of the funding transaction are ours, we know the fee
+	// it pays. In order to replace it within the mempool, we must pay at
+	// least as much, plus the relay fee for our own transaction.
+	if allOurs {
+		var totalOut btcutil.Amount
+		for _, txOut := range fundingTx.TxOut {
+			totalOut += btcutil.Amount(txOut.Value)
+		}
+
+		minFee := totalIn - totalOut + FeePerKwFloor.FeeForWeight(weight)
+		if fee < minFee {
+			fee = minFee
+		}
+	}
+
+	if totalIn-fee <= DefaultDustLimit() {
+		return nil, fmt.Errorf("funding inputs worth %v can't pay "+
+			"fee of %v", totalIn, fee)
+	}
+	cancelTx.AddTxOut(&wire.TxOut{
+		Value:    int64(totalIn - fee),
+		PkScript: pkScript,
+	})
+
+	// With the transaction assembled, we can now sign each of its inputs.
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(cancelTx),
+	}
+	for i, prevOutput := range prevOutputs {
+		signDesc.Output = prevOutput
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			cancelTx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cancelTx.TxIn[i].SignatureScript = inputScript.ScriptSig
+		cancelTx.TxIn[i].Witness = inputScript.Witness
+	}
+
+	return cancelTx, nil
+}
+
 // requestHandler is the primary goroutine(s) responsible for handling, and
 // dispatching replies to all messages.
 func (l *LightningWallet) requestHandler() {
@@ -457,6 +619,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	reservation, err := NewChannelReservation(
 		req.Capacity, req.FundingAmount, req.CommitFeePerKw, l, id,
 		req.PushMSat, l.Cfg.NetParams.GenesisHash, req.Flags,
+		req.StaticRemoteKey, req.Anchors,
 	)
 	if err != nil {
 		req.err <- err
@@ -475,10 +638,16 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	// don't need to perform any coin selection. Otherwise, attempt to
 	// obtain enough coins to meet the required funding amount.
 	if req.FundingAmount != 0 {
+		// If we're contributing to a channel initiated by the remote
+		// party, then the initiator pays for the funding output, so
+		// we'll only pay for our own inputs and change.
+		payFundingOutput := req.FundingAmount == req.Capacity
+
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
 			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.DualFund, payFundingOutput,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -634,16 +803,18 @@ func (l *LightningWallet) handleFundingCancelRequest(req *fundingReserveCancelMs
 func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 	ourChanCfg, theirChanCfg *channeldb.ChannelConfig,
 	localCommitPoint, remoteCommitPoint *btcec.PublicKey,
-	fundingTxIn wire.TxIn) (*wire.MsgTx, *wire.MsgTx, error) {
+	fundingTxIn wire.TxIn, chanType channeldb.ChannelType) (*wire.MsgTx,
+	*wire.MsgTx, error) {
 
 	localCommitmentKeys := deriveCommitmentKeys(localCommitPoint, true,
-		ourChanCfg, theirChanCfg)
+		chanType, ourChanCfg, theirChanCfg)
 	remoteCommitmentKeys := deriveCommitmentKeys(remoteCommitPoint, false,
-		ourChanCfg, theirChanCfg)
+		chanType, ourChanCfg, theirChanCfg)
 
-	ourCommitTx, err := CreateCommitTx(fundingTxIn, localCommitmentKeys,
-		uint32(ourChanCfg.CsvDelay), localBalance, remoteBalance,
-		ourChanCfg.DustLimit)
+	ourCommitTx, err := CreateCommitTx(chanType, fundingTxIn,
+		localCommitmentKeys, ourChanCfg.MultiSigKey.PubKey,
+		theirChanCfg.MultiSigKey.PubKey, uint32(ourChanCfg.CsvDelay),
+		localBalance, remoteBalance, ourChanCfg.DustLimit, 0)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -653,9 +824,10 @@ func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 		return nil, nil, err
 	}
 
-	theirCommitTx, err := CreateCommitTx(fundingTxIn, remoteCommitmentKeys,
-		uint32(theirChanCfg.CsvDelay), remoteBalance, localBalance,
-		theirChanCfg.DustLimit)
+	theirCommitTx, err := CreateCommitTx(chanType, fundingTxIn,
+		remoteCommitmentKeys, theirChanCfg.MultiSigKey.PubKey,
+		ourChanCfg.MultiSigKey.PubKey, uint32(theirChanCfg.CsvDelay),
+		remoteBalance, localBalance, theirChanCfg.DustLimit, 0)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -807,6 +979,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		theirContribution.ChannelConfig,
 		ourContribution.FirstCommitmentPoint,
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
+		pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -816,27 +989,19 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
+	// The initiator's payment base point always comes first, matching the
+	// obfuscator derived by the channel state machine.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	if chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
 	} else {
-		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		switch bytes.Compare(ourSer, theirSer) {
-		case -1:
-			stateObfuscator = DeriveStateHintObfuscator(
-				ourContribution.PaymentBasePoint.PubKey,
-				theirContribution.PaymentBasePoint.PubKey,
-			)
-		default:
-			stateObfuscator = DeriveStateHintObfuscator(
-				theirContribution.PaymentBasePoint.PubKey,
-				ourContribution.PaymentBasePoint.PubKey,
-			)
-		}
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
@@ -961,23 +1126,34 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
 	for i, txin := range fundingTx.TxIn {
 		if len(inputScripts) != 0 && len(txin.Witness) == 0 {
+			if sigIndex >= len(inputScripts) {
+				msg.err <- fmt.Errorf("counterparty only sent %v "+
+					"input scripts", len(inputScripts))
+				msg.completeChan <- nil
+				return
+			}
+
 			// Attach the input scripts so we can verify it below.
 			txin.Witness = inputScripts[sigIndex].Witness
 			txin.SignatureScript = inputScripts[sigIndex].ScriptSig
 
 			// Fetch the alleged previous output along with the
-			// pkscript referenced by this input.
+			// pkscript referenced by this input, as presented in
+			// the counterparty's contribution.
 			//
 			// TODO(roasbeef): when dual funder pass actual
 			// height-hint
-			pkScript, err := WitnessScriptHash(
-				txin.Witness[len(txin.Witness)-1],
+			prevOut, err := res.theirContribution.prevOutput(
+				txin.PreviousOutPoint,
 			)
 			if err != nil {
+				msg.err <- err
+				msg.completeChan <- nil
+				return
 			}
 			output, err := l.Cfg.ChainIO.GetUtxo(
 				&txin.PreviousOutPoint,
-				pkScript, 0,
+				prevOut.PkScript, 0,
 			)
 			if output == nil {
 				msg.err <- fmt.Errorf("input to funding tx "+
@@ -1077,6 +1253,8 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// he stored within the database.
 	res.partialState.LocalChanCfg = res.ourContribution.toChanConfig()
 	res.partialState.RemoteChanCfg = res.theirContribution.toChanConfig()
+	res.partialState.LocalShutdownScript = res.ourContribution.UpfrontShutdown
+	res.partialState.RemoteShutdownScript = res.theirContribution.UpfrontShutdown
 
 	// We'll also record the finalized funding txn, which will allow us to
 	// rebroadcast on startup in case we fail.
@@ -1130,7 +1308,7 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 		pendingReservation.theirContribution.ChannelConfig,
 		pendingReservation.ourContribution.FirstCommitmentPoint,
 		pendingReservation.theirContribution.FirstCommitmentPoint,
-		*fundingTxIn,
+		*fundingTxIn, pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -1241,6 +1419,8 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// which will be used for the lifetime of this channel.
 	chanState.LocalChanCfg = pendingReservation.ourContribution.toChanConfig()
 	chanState.RemoteChanCfg = pendingReservation.theirContribution.toChanConfig()
+	chanState.LocalShutdownScript = pendingReservation.ourContribution.UpfrontShutdown
+	chanState.RemoteShutdownScript = pendingReservation.theirContribution.UpfrontShutdown
 	err = chanState.SyncPending(pendingReservation.nodeAddr, uint32(bestHeight))
 	if err != nil {
 		req.err <- err
@@ -1260,10 +1440,12 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 // outputs which sum to at least 'numCoins' amount of satoshis. If coin
 // selection is successful/possible, then the selected coins are available
 // within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// also be generated. If nativeOnly is set, only native witness outputs are
+// considered. If payFundingOutput is set, the fee for the funding output is
+// included in the selection.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
+	amt btcutil.Amount, minConfs int32, nativeOnly, payFundingOutput bool,
 	contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
@@ -1281,11 +1463,22 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	if err != nil {
 		return err
 	}
+	if nativeOnly {
+		nativeCoins := make([]*Utxo, 0, len(coins))
+		for _, coin := range coins {
+			if coin.AddressType == WitnessPubKey {
+				nativeCoins = append(nativeCoins, coin)
+			}
+		}
+		coins = nativeCoins
+	}
 
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, coins, payFundingOutput,
+	)
 	if err != nil {
 		return err
 	}
@@ -1294,6 +1487,7 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// prevents concurrent funding requests from referring to and this
 	// double-spending the same set of coins.
 	contribution.Inputs = make([]*wire.TxIn, len(selectedCoins))
+	contribution.PrevOutputs = make([]*wire.TxOut, len(selectedCoins))
 	for i, coin := range selectedCoins {
 		outpoint := &coin.OutPoint
 		l.lockedOutPoints[*outpoint] = struct{}{}
@@ -1302,6 +1496,11 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 		// Empty sig script, we'll actually sign if this reservation is
 		// queued up to be completed (the other side accepts).
 		contribution.Inputs[i] = wire.NewTxIn(outpoint, nil, nil)
+		contribution.Inputs[i].Sequence = FundingTxInSequence
+		contribution.PrevOutputs[i] = &wire.TxOut{
+			Value:    int64(coin.Value),
+			PkScript: coin.PkScript,
+		}
 	}
 
 	// Record any change output(s) generated as a result of the coin
@@ -1382,9 +1581,10 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 // coinSelect attempts to select a sufficient amount of coins, including a
 // change output to fund amt satoshis, adhering to the specified fee rate. The
 // specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
+// function properly. The weight of the funding output is only accounted for
+// if payFundingOutput is set.
 func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
-	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
+	coins []*Utxo, payFundingOutput bool) ([]*Utxo, btcutil.Amount, error) {
 
 	amtNeeded := amt
 	for {
@@ -1410,7 +1610,9 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 		}
 
 		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
+		if payFundingOutput {
+			weightEstimate.AddP2WSHOutput()
+		}
 
 		// Assume that change output is a P2WKH output.
 		//
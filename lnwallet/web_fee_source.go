@@ -0,0 +1,108 @@
+package lnwallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// DefaultWebAPIFeeSourceTimeout is the default amount of time to wait for a
+// web API to answer a fee request.
+const DefaultWebAPIFeeSourceTimeout = 10 * time.Second
+
+// WebAPIFeeSource is an implementation of the FeeSource interface that fetches
+// fee estimates from an HTTP endpoint serving JSON of the following form, with
+// fee rates expressed in sat/kvB:
+//
+//	{"fee_by_block_target": {"1": 30000, "2": 25000, "6": 12000}}
+//
+// If the endpoint doesn't serve an estimate for the exact confirmation target
+// requested, the estimate of the closest lower target is used, as it
+// confirms at least as fast.
+type WebAPIFeeSource struct {
+	// URL is the endpoint queried for fee estimates.
+	URL string
+
+	// Client is the HTTP client used to query the endpoint. If nil, a
+	// client timing out after DefaultWebAPIFeeSourceTimeout is used.
+	Client *http.Client
+}
+
+// webAPIFeeResponse is the JSON body served by a fee estimation web API.
+type webAPIFeeResponse struct {
+	FeeByBlockTarget map[uint32]uint32 `json:"fee_by_block_target"`
+}
+
+// NewWebAPIFeeSource creates a new WebAPIFeeSource querying the given URL.
+func NewWebAPIFeeSource(url string) *WebAPIFeeSource {
+	return &WebAPIFeeSource{
+		URL: url,
+		Client: &http.Client{
+			Timeout: DefaultWebAPIFeeSourceTimeout,
+		},
+	}
+}
+
+// Name returns a human readable name for the fee source.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (w *WebAPIFeeSource) Name() string {
+	return fmt.Sprintf("web(%v)", w.URL)
+}
+
+// FetchFeePerKW queries the web API and returns its estimate in sat/kw for a
+// transaction to be confirmed in numBlocks blocks.
+//
+// NOTE: This method is part of the FeeSource interface.
+func (w *WebAPIFeeSource) FetchFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultWebAPIFeeSourceTimeout}
+	}
+
+	resp, err := client.Get(w.URL)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status: %v", resp.Status)
+	}
+
+	var feeResp webAPIFeeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&feeResp); err != nil {
+		return 0, fmt.Errorf("unable to decode fee response: %v", err)
+	}
+	if len(feeResp.FeeByBlockTarget) == 0 {
+		return 0, ErrNoFeeEstimate
+	}
+
+	// We'll look for the closest target that is at most the requested
+	// one. If all served targets are higher, we'll use the lowest of
+	// them.
+	targets := make([]uint32, 0, len(feeResp.FeeByBlockTarget))
+	for target := range feeResp.FeeByBlockTarget {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i] < targets[j]
+	})
+
+	target := targets[0]
+	for _, t := range targets {
+		if t > numBlocks {
+			break
+		}
+		target = t
+	}
+
+	satPerKVByte := SatPerKVByte(feeResp.FeeByBlockTarget[target])
+	return satPerKVByte.FeePerKWeight(), nil
+}
+
+// A compile-time assertion to ensure that WebAPIFeeSource implements the
+// FeeSource interface.
+var _ FeeSource = (*WebAPIFeeSource)(nil)
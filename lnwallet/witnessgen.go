@@ -69,6 +69,25 @@ const (
 	// broadcast a revoked commitment, but then also immediately attempt to
 	// go to the second level to claim the HTLC.
 	HtlcSecondLevelRevoke WitnessType = 9
+
+	// CommitSpendNoDelayTweakless is similar to the CommitmentNoDelay
+	// witness type, but for outputs paying to a static remote key, which
+	// isn't tweaked by the commitment point.
+	CommitSpendNoDelayTweakless WitnessType = 10
+
+	// CommitmentToRemoteConfirmed is a witness that allows us to spend our
+	// output on the counterparty's commitment transaction of a channel
+	// using anchor outputs, once the commitment has confirmed.
+	CommitmentToRemoteConfirmed WitnessType = 11
+
+	// CommitmentAnchor is a witness that allows us to spend our anchor
+	// output on a commitment transaction, in order to bump its fee by
+	// attaching a child transaction.
+	CommitmentAnchor WitnessType = 12
+
+	// WitnessKeyHash is a witness that allows us to spend a regular p2wkh
+	// output controlled by the backing wallet.
+	WitnessKeyHash WitnessType = 13
 )
 
 // WitnessGenerator represents a function which is able to generate the final
@@ -94,7 +113,10 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 			return CommitSpendTimeout(signer, desc, tx)
 
 		case CommitmentNoDelay:
-			return CommitSpendNoDelay(signer, desc, tx)
+			return CommitSpendNoDelay(signer, desc, tx, false)
+
+		case CommitSpendNoDelayTweakless:
+			return CommitSpendNoDelay(signer, desc, tx, true)
 
 		case CommitmentRevoke:
 			return CommitSpendRevoke(signer, desc, tx)
@@ -120,6 +142,20 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 		case HtlcSecondLevelRevoke:
 			return htlcSpendRevoke(signer, desc, tx)
 
+		case CommitmentToRemoteConfirmed:
+			return CommitSpendToRemoteConfirmed(signer, desc, tx)
+
+		case CommitmentAnchor:
+			return CommitSpendAnchor(signer, desc, tx)
+
+		case WitnessKeyHash:
+			inputScript, err := signer.ComputeInputScript(tx, desc)
+			if err != nil {
+				return nil, err
+			}
+
+			return inputScript.Witness, nil
+
 		default:
 			return nil, fmt.Errorf("unknown witness type: %v", wt)
 		}
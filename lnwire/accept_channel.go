@@ -86,6 +86,12 @@ type AcceptChannel struct {
 	// base point in order to derive the revocation keys that are placed
 	// within the commitment transaction of the sender.
 	FirstCommitmentPoint *btcec.PublicKey
+
+	// UpfrontShutdownScript is the script to which the sender commits to
+	// pay its funds upon a cooperative close of the channel. If empty, the
+	// sender may pay its funds to any script. This is an optional field,
+	// which isn't included by peers unaware of it.
+	UpfrontShutdownScript DeliveryAddress
 }
 
 // A compile time check to ensure AcceptChannel implements the lnwire.Message
@@ -113,6 +119,7 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 		a.DelayedPaymentPoint,
 		a.HtlcPoint,
 		a.FirstCommitmentPoint,
+		a.UpfrontShutdownScript,
 	)
 }
 
@@ -122,7 +129,7 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		a.PendingChannelID[:],
 		&a.DustLimit,
 		&a.MaxValueInFlight,
@@ -138,6 +145,11 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		&a.HtlcPoint,
 		&a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	return readUpfrontShutdownScript(r, &a.UpfrontShutdownScript)
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -153,6 +165,6 @@ func (a *AcceptChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) MaxPayloadLength(uint32) uint32 {
-	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6)
-	return 270
+	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6) + 2 + 34
+	return 306
 }
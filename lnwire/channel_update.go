@@ -25,6 +25,20 @@ const (
 	ChanUpdateDisabled
 )
 
+const (
+	// ChanUpdateOptionMaxHtlc is a bit that indicates whether the
+	// optional htlc_maximum_msat field is present in this ChannelUpdate.
+	// It's the least-significant bit of the message_flags byte, which
+	// precedes the channel_flags byte on the wire.
+	ChanUpdateOptionMaxHtlc ChanUpdateFlag = 1 << 8
+)
+
+// HasMaxHtlc returns true if the htlc_maximum_msat option bit is set in the
+// flags.
+func (c ChanUpdateFlag) HasMaxHtlc() bool {
+	return c&ChanUpdateOptionMaxHtlc != 0
+}
+
 // ChannelUpdate message is used after channel has been initially announced.
 // Each side independently announces its fees and minimum expiry for HTLCs and
 // other parameters. Also this message is used to redeclare initially set
@@ -53,7 +67,8 @@ type ChannelUpdate struct {
 	// least-significant bit must be set to 0 if the creating node
 	// corresponds to the first node in the previously sent channel
 	// announcement and 1 otherwise. If the second bit is set, then the
-	// channel is set to be disabled.
+	// channel is set to be disabled. If the ninth bit is set, then the
+	// HtlcMaximumMsat field is present.
 	Flags ChanUpdateFlag
 
 	// TimeLockDelta is the minimum number of blocks this node requires to
@@ -75,6 +90,11 @@ type ChannelUpdate struct {
 	// satoshi.
 	FeeRate uint32
 
+	// HtlcMaximumMsat is the maximum HTLC value which will be accepted.
+	// This field is only encoded if the ChanUpdateOptionMaxHtlc flag is
+	// set.
+	HtlcMaximumMsat MilliSatoshi
+
 	// ExtraOpaqueData is the set of data that was appended to this
 	// message, some of which we may not actually know how to iterate or
 	// parse. By holding onto this data, we ensure that we're able to
@@ -108,6 +128,13 @@ func (a *ChannelUpdate) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
+	// Now check whether the max HTLC field is present and read it if so.
+	if a.Flags.HasMaxHtlc() {
+		if err := readElements(r, &a.HtlcMaximumMsat); err != nil {
+			return err
+		}
+	}
+
 	// Now that we've read out all the fields that we explicitly know of,
 	// we'll collect the remainder into the ExtraOpaqueData field. If there
 	// aren't any bytes, then we'll snip off the slice to avoid carrying
@@ -128,7 +155,7 @@ func (a *ChannelUpdate) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *ChannelUpdate) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		a.Signature,
 		a.ChainHash[:],
 		a.ShortChannelID,
@@ -138,8 +165,21 @@ func (a *ChannelUpdate) Encode(w io.Writer, pver uint32) error {
 		a.HtlcMinimumMsat,
 		a.BaseFee,
 		a.FeeRate,
-		a.ExtraOpaqueData,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Now append optional fields if they are set. Currently, the only
+	// optional field is max HTLC.
+	if a.Flags.HasMaxHtlc() {
+		if err := writeElements(w, a.HtlcMaximumMsat); err != nil {
+			return err
+		}
+	}
+
+	// Finally, append any extra opaque data.
+	return writeElements(w, a.ExtraOpaqueData)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -173,11 +213,23 @@ func (a *ChannelUpdate) DataToSign() ([]byte, error) {
 		a.HtlcMinimumMsat,
 		a.BaseFee,
 		a.FeeRate,
-		a.ExtraOpaqueData,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Now append optional fields if they are set. Currently, the only
+	// optional field is max HTLC.
+	if a.Flags.HasMaxHtlc() {
+		if err := writeElements(&w, a.HtlcMaximumMsat); err != nil {
+			return nil, err
+		}
+	}
+
+	// Finally, append any extra opaque data.
+	if err := writeElements(&w, a.ExtraOpaqueData); err != nil {
+		return nil, err
+	}
+
 	return w.Bytes(), nil
 }
@@ -35,6 +35,18 @@ const (
 	// connection is established.
 	InitialRoutingSync FeatureBit = 3
 
+	// UpfrontShutdownScriptRequired is a feature bit that indicates that
+	// the sending peer *requires* the receiving peer to enforce the
+	// shutdown script committed to within the OpenChannel and
+	// AcceptChannel messages upon a cooperative close.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit that
+	// signals that the sending peer is able to commit to a shutdown script
+	// when opening a channel, and will enforce the one committed to by the
+	// receiving peer upon a cooperative close.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// GossipQueriesRequired is a feature bit that indicates that the
 	// receiving peer MUST know of the set of features that allows nodes to
 	// more efficiently query the network view of peers on the network for
@@ -46,6 +58,55 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// StaticRemoteKeyRequired is a feature bit that indicates that the
+	// sending peer *requires* all new channels with the receiving peer to
+	// use commitments whose to_remote output pays to a static, untweaked
+	// payment base point.
+	StaticRemoteKeyRequired FeatureBit = 12
+
+	// StaticRemoteKeyOptional is an optional feature bit that signals that
+	// the sending peer is able to use commitments whose to_remote output
+	// pays to a static, untweaked payment base point. If both peers signal
+	// it, all new channels between them use this commitment format.
+	StaticRemoteKeyOptional FeatureBit = 13
+
+	// WumboChannelsRequired is a feature bit that indicates that the
+	// sending peer *requires* the receiving peer to accept channels larger
+	// than the soft-limit on channel size defined in BOLT-0002.
+	WumboChannelsRequired FeatureBit = 18
+
+	// WumboChannelsOptional is an optional feature bit that signals that
+	// the sending peer is willing to open and accept channels larger than
+	// the soft-limit on channel size defined in BOLT-0002. Within a channel
+	// announcement, the bit marks the announced channel as such a large
+	// channel.
+	WumboChannelsOptional FeatureBit = 19
+
+	// AnchorsRequired is a feature bit that indicates that the sending
+	// peer *requires* all new channels with the receiving peer to use
+	// commitments with anchor outputs, which allow either party to bump
+	// the fee of a force close using CPFP.
+	AnchorsRequired FeatureBit = 20
+
+	// AnchorsOptional is an optional feature bit that signals that the
+	// sending peer is able to use commitments with anchor outputs. If both
+	// peers signal it, all new channels between them use this commitment
+	// format.
+	AnchorsOptional FeatureBit = 21
+
+	// DualFundRequired is a feature bit that indicates that the sending
+	// peer *requires* the receiving peer to understand our experimental
+	// dual funding protocol, which allows both parties to contribute funds
+	// to a new channel. As the protocol isn't the one specified by BOLT 2,
+	// it uses a bit far outside of the range assigned by BOLT 9.
+	DualFundRequired FeatureBit = 2028
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sending peer is able to contribute funds to, and accept
+	// contributions for, a new channel via our experimental dual funding
+	// protocol.
+	DualFundOptional FeatureBit = 2029
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -67,11 +128,21 @@ const (
 // not advertised to the entire network. A full description of these feature
 // bits is provided in the BOLT-09 specification.
 var LocalFeatures = map[FeatureBit]string{
-	DataLossProtectRequired: "data-loss-protect-required",
-	DataLossProtectOptional: "data-loss-protect-optional",
-	InitialRoutingSync:      "initial-routing-sync",
-	GossipQueriesRequired:   "gossip-queries-required",
-	GossipQueriesOptional:   "gossip-queries-optional",
+	DataLossProtectRequired:       "data-loss-protect-required",
+	DataLossProtectOptional:       "data-loss-protect-optional",
+	InitialRoutingSync:            "initial-routing-sync",
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script-required",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script-optional",
+	GossipQueriesRequired:         "gossip-queries-required",
+	GossipQueriesOptional:         "gossip-queries-optional",
+	StaticRemoteKeyRequired:       "static-remote-key-required",
+	StaticRemoteKeyOptional:       "static-remote-key-optional",
+	WumboChannelsRequired:         "wumbo-channels-required",
+	WumboChannelsOptional:         "wumbo-channels-optional",
+	AnchorsRequired:               "anchors-required",
+	AnchorsOptional:               "anchors-optional",
+	DualFundRequired:              "dual-fund-required",
+	DualFundOptional:              "dual-fund-optional",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -0,0 +1,155 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// FundingInput is an input that one of the parties contributes to the funding
+// transaction of a dual funded channel. Along with the outpoint being spent,
+// the value and script of the previous output are included, allowing the
+// other party to verify the final signatures for the input.
+type FundingInput struct {
+	// OutPoint is the previous output spent by this input.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the previous output.
+	Value btcutil.Amount
+
+	// PkScript is the script of the previous output.
+	PkScript PkScript
+}
+
+// FundingOutput is a change output that one of the parties adds to the
+// funding transaction of a dual funded channel.
+type FundingOutput struct {
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// PkScript is the script the output pays to.
+	PkScript PkScript
+}
+
+// FundingContribution is sent by both parties of a dual funded channel to
+// present the inputs and change outputs they add to the shared funding
+// transaction. The responder sends its contribution right before the
+// AcceptChannel message, the initiator right before the FundingCreated
+// message. Once both contributions are known, each party is able to construct
+// the funding transaction, which is canonically ordered according to BIP-69.
+type FundingContribution struct {
+	// PendingChannelID serves to uniquely identify the future channel
+	// created by the initiated dual funder workflow.
+	PendingChannelID [32]byte
+
+	// FundingAmount is the amount the sender commits to the channel.
+	FundingAmount btcutil.Amount
+
+	// Inputs are the inputs the sender adds to the funding transaction.
+	// Their total value covers the funding amount, the change outputs, and
+	// the sender's share of the funding transaction fee.
+	Inputs []FundingInput
+
+	// ChangeOutputs are the change outputs the sender adds to the funding
+	// transaction.
+	ChangeOutputs []FundingOutput
+}
+
+// A compile time check to ensure FundingContribution implements the
+// lnwire.Message interface.
+var _ Message = (*FundingContribution)(nil)
+
+// Encode serializes the target FundingContribution into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) Encode(w io.Writer, pver uint32) error {
+	err := writeElements(
+		w, f.PendingChannelID[:], f.FundingAmount,
+		uint16(len(f.Inputs)),
+	)
+	if err != nil {
+		return err
+	}
+	for _, input := range f.Inputs {
+		err := writeElements(
+			w, input.OutPoint, input.Value, input.PkScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := writeElement(w, uint16(len(f.ChangeOutputs))); err != nil {
+		return err
+	}
+	for _, output := range f.ChangeOutputs {
+		if err := writeElements(w, output.Value, output.PkScript); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode deserializes the serialized FundingContribution stored in the passed
+// io.Reader into the target FundingContribution using the deserialization
+// rules defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) Decode(r io.Reader, pver uint32) error {
+	var numInputs uint16
+	err := readElements(
+		r, f.PendingChannelID[:], &f.FundingAmount, &numInputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	f.Inputs = nil
+	for i := uint16(0); i < numInputs; i++ {
+		var input FundingInput
+		err := readElements(
+			r, &input.OutPoint, &input.Value, &input.PkScript,
+		)
+		if err != nil {
+			return err
+		}
+		f.Inputs = append(f.Inputs, input)
+	}
+
+	var numOutputs uint16
+	if err := readElement(r, &numOutputs); err != nil {
+		return err
+	}
+
+	f.ChangeOutputs = nil
+	for i := uint16(0); i < numOutputs; i++ {
+		var output FundingOutput
+		err := readElements(r, &output.Value, &output.PkScript)
+		if err != nil {
+			return err
+		}
+		f.ChangeOutputs = append(f.ChangeOutputs, output)
+	}
+
+	return nil
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// FundingContribution on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) MsgType() MessageType {
+	return MsgFundingContribution
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// FundingContribution message.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,130 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// InputScript holds the script data required to redeem a single input of a
+// funding transaction. For native witness inputs only the witness is set,
+// while nested witness inputs also carry a signature script.
+type InputScript struct {
+	// ScriptSig is the signature script of the input.
+	ScriptSig []byte
+
+	// Witness is the witness stack of the input.
+	Witness [][]byte
+}
+
+// FundingInputSigs is sent from Bob (the responder) to Alice (the initiator)
+// of a dual funded channel right before the FundingSigned message. It carries
+// Bob's input scripts for all the inputs he contributed to the funding
+// transaction, in the BIP-69 order of the funding transaction. With them,
+// Alice is able to fully sign and broadcast the funding transaction. Once she
+// did, Alice sends her own input scripts to Bob in the same way, so he's able
+// to rebroadcast the funding transaction as well.
+type FundingInputSigs struct {
+	// ChanID is the permanent channel ID of the channel being funded,
+	// derived from the funding outpoint.
+	ChanID ChannelID
+
+	// InputScripts are the sender's input scripts for its inputs to the
+	// funding transaction.
+	InputScripts []InputScript
+}
+
+// A compile time check to ensure FundingInputSigs implements the
+// lnwire.Message interface.
+var _ Message = (*FundingInputSigs)(nil)
+
+// Encode serializes the target FundingInputSigs into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) Encode(w io.Writer, pver uint32) error {
+	err := writeElements(w, f.ChanID, uint16(len(f.InputScripts)))
+	if err != nil {
+		return err
+	}
+
+	for _, inputScript := range f.InputScripts {
+		if err := wire.WriteVarBytes(w, 0, inputScript.ScriptSig); err != nil {
+			return err
+		}
+
+		err := writeElement(w, uint16(len(inputScript.Witness)))
+		if err != nil {
+			return err
+		}
+		for _, item := range inputScript.Witness {
+			if err := wire.WriteVarBytes(w, 0, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Decode deserializes the serialized FundingInputSigs stored in the passed
+// io.Reader into the target FundingInputSigs using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) Decode(r io.Reader, pver uint32) error {
+	var numInputs uint16
+	if err := readElements(r, &f.ChanID, &numInputs); err != nil {
+		return err
+	}
+
+	f.InputScripts = nil
+	for i := uint16(0); i < numInputs; i++ {
+		var inputScript InputScript
+
+		scriptSig, err := wire.ReadVarBytes(
+			r, 0, MaxMessagePayload, "scriptsig",
+		)
+		if err != nil {
+			return err
+		}
+		if len(scriptSig) != 0 {
+			inputScript.ScriptSig = scriptSig
+		}
+
+		var numItems uint16
+		if err := readElement(r, &numItems); err != nil {
+			return err
+		}
+		for j := uint16(0); j < numItems; j++ {
+			item, err := wire.ReadVarBytes(
+				r, 0, MaxMessagePayload, "witness item",
+			)
+			if err != nil {
+				return err
+			}
+			inputScript.Witness = append(inputScript.Witness, item)
+		}
+
+		f.InputScripts = append(f.InputScripts, inputScript)
+	}
+
+	return nil
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// FundingInputSigs on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) MsgType() MessageType {
+	return MsgFundingInputSigs
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// FundingInputSigs message.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
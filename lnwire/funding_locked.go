@@ -19,6 +19,12 @@ type FundingLocked struct {
 	// NextPerCommitmentPoint is the secret that can be used to revoke the
 	// next commitment transaction for the channel.
 	NextPerCommitmentPoint *btcec.PublicKey
+
+	// AliasScid is an optional field holding the alias short channel ID
+	// the sender knows a zero-conf channel by until its funding
+	// transaction has confirmed. The receiver forwards HTLCs addressed to
+	// it over the channel, so the sender can hand it out in route hints.
+	AliasScid ShortChannelID
 }
 
 // NewFundingLocked creates a new FundingLocked message, populating it with the
@@ -40,9 +46,21 @@ var _ Message = (*FundingLocked)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		&c.ChanID,
 		&c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	// The alias is optional, so if we're at the EOF, then the field
+	// wasn't included and we can exit early.
+	err = readElement(r, &c.AliasScid)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
 
 // Encode serializes the target FundingLocked message into the passed io.Writer
@@ -51,9 +69,19 @@ func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		c.ChanID,
 		c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	// The alias is only included for zero-conf channels.
+	if c.AliasScid == (ShortChannelID{}) {
+		return nil
+	}
+
+	return writeElement(w, c.AliasScid)
 }
 
 // MsgType returns the uint32 code which uniquely identifies this message as a
@@ -78,6 +106,9 @@ func (c *FundingLocked) MaxPayloadLength(uint32) uint32 {
 	// NextPerCommitmentPoint - 33 bytes
 	length += 33
 
-	// 65 bytes
+	// AliasScid - 8 bytes
+	length += 8
+
+	// 73 bytes
 	return length
 }
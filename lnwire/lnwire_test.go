@@ -73,6 +73,22 @@ func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	return featureVec
 }
 
+// randUpfrontShutdownScript returns a random upfront shutdown script, which is
+// only set half of the time as the field is optional.
+func randUpfrontShutdownScript(r *rand.Rand) (DeliveryAddress, error) {
+	if r.Int31n(2) == 0 {
+		return nil, nil
+	}
+
+	// We'll use the length of a p2wpkh script.
+	script := make(DeliveryAddress, 22)
+	if _, err := r.Read(script); err != nil {
+		return nil, err
+	}
+
+	return script, nil
+}
+
 func randTCP4Addr(r *rand.Rand) (*net.TCPAddr, error) {
 	var ip [4]byte
 	if _, err := r.Read(ip[:]); err != nil {
@@ -261,6 +277,7 @@ func TestLightningWireProtocol(t *testing.T) {
 				CsvDelay:         uint16(r.Int31()),
 				MaxAcceptedHTLCs: uint16(r.Int31()),
 				ChannelFlags:     FundingFlag(uint8(r.Int31())),
+				DualFund:         r.Intn(2) == 0,
 			}
 
 			if _, err := r.Read(req.ChainHash[:]); err != nil {
@@ -304,6 +321,11 @@ func TestLightningWireProtocol(t *testing.T) {
 				t.Fatalf("unable to generate key: %v", err)
 				return
 			}
+			req.UpfrontShutdownScript, err = randUpfrontShutdownScript(r)
+			if err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
 
 			v[0] = reflect.ValueOf(req)
 		},
@@ -354,6 +376,11 @@ func TestLightningWireProtocol(t *testing.T) {
 				t.Fatalf("unable to generate key: %v", err)
 				return
 			}
+			req.UpfrontShutdownScript, err = randUpfrontShutdownScript(r)
+			if err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
 
 			v[0] = reflect.ValueOf(req)
 		},
@@ -399,6 +426,79 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgFundingContribution: func(v []reflect.Value, r *rand.Rand) {
+			req := FundingContribution{
+				FundingAmount: btcutil.Amount(r.Int63()),
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			numInputs := r.Intn(5)
+			for i := 0; i < numInputs; i++ {
+				input := FundingInput{
+					Value:    btcutil.Amount(r.Int63()),
+					PkScript: make([]byte, 22),
+				}
+				if _, err := r.Read(input.OutPoint.Hash[:]); err != nil {
+					t.Fatalf("unable to generate hash: %v", err)
+					return
+				}
+				input.OutPoint.Index = uint32(r.Int31()) % math.MaxUint16
+				if _, err := r.Read(input.PkScript); err != nil {
+					t.Fatalf("unable to generate pkscript: %v", err)
+					return
+				}
+				req.Inputs = append(req.Inputs, input)
+			}
+
+			numOutputs := r.Intn(3)
+			for i := 0; i < numOutputs; i++ {
+				output := FundingOutput{
+					Value:    btcutil.Amount(r.Int63()),
+					PkScript: make([]byte, 22),
+				}
+				if _, err := r.Read(output.PkScript); err != nil {
+					t.Fatalf("unable to generate pkscript: %v", err)
+					return
+				}
+				req.ChangeOutputs = append(req.ChangeOutputs, output)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgFundingInputSigs: func(v []reflect.Value, r *rand.Rand) {
+			req := FundingInputSigs{}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			numInputs := r.Intn(5)
+			for i := 0; i < numInputs; i++ {
+				var inputScript InputScript
+
+				// With a 50/50 chance, we'll generate a nested
+				// witness input that also carries a sig script.
+				if r.Int31()%2 == 0 {
+					inputScript.ScriptSig = make([]byte, 23)
+					r.Read(inputScript.ScriptSig)
+				}
+
+				sig := make([]byte, 72)
+				r.Read(sig)
+				pubKey := make([]byte, 33)
+				r.Read(pubKey)
+				inputScript.Witness = [][]byte{sig, pubKey}
+
+				req.InputScripts = append(req.InputScripts, inputScript)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgFundingLocked: func(v []reflect.Value, r *rand.Rand) {
 
 			var c [32]byte
@@ -415,6 +515,15 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			req := NewFundingLocked(ChannelID(c), pubKey)
 
+			// With a 50/50 probability, we'll include the optional
+			// alias of a zero-conf channel.
+			if r.Int()%2 == 0 {
+				req.AliasScid = NewShortChanIDFromInt(
+					uint64(r.Int63()),
+				)
+				req.AliasScid.BlockHeight = AliasStartBlockHeight
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
@@ -609,6 +718,9 @@ func TestLightningWireProtocol(t *testing.T) {
 				BaseFee:         uint32(r.Int31()),
 				FeeRate:         uint32(r.Int31()),
 			}
+			if req.Flags.HasMaxHtlc() {
+				req.HtlcMaximumMsat = MilliSatoshi(r.Int63())
+			}
 			req.Signature, err = NewSigFromSignature(testSig)
 			if err != nil {
 				t.Fatalf("unable to parse sig: %v", err)
@@ -808,6 +920,18 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgFundingContribution,
+			scenario: func(m FundingContribution) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgFundingInputSigs,
+			scenario: func(m FundingInputSigs) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgFundingLocked,
 			scenario: func(m FundingLocked) bool {
@@ -54,6 +54,12 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+
+	// The messages of our experimental dual funding protocol use types
+	// of the range BOLT 1 reserves for experimental messages, as they
+	// differ from those of the dual funding protocol being specified.
+	MsgFundingContribution = 32800
+	MsgFundingInputSigs    = 32801
 )
 
 // String return the string representation of message type.
@@ -71,6 +77,10 @@ func (t MessageType) String() string {
 		return "MsgFundingSigned"
 	case MsgFundingLocked:
 		return "FundingLocked"
+	case MsgFundingContribution:
+		return "FundingContribution"
+	case MsgFundingInputSigs:
+		return "FundingInputSigs"
 	case MsgShutdown:
 		return "Shutdown"
 	case MsgClosingSigned:
@@ -172,6 +182,10 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &FundingSigned{}
 	case MsgFundingLocked:
 		msg = &FundingLocked{}
+	case MsgFundingContribution:
+		msg = &FundingContribution{}
+	case MsgFundingInputSigs:
+		msg = &FundingInputSigs{}
 	case MsgShutdown:
 		msg = &Shutdown{}
 	case MsgClosingSigned:
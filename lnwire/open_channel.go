@@ -122,6 +122,18 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script to which the sender commits to
+	// pay its funds upon a cooperative close of the channel. If empty, the
+	// sender may pay its funds to any script. This is an optional field,
+	// which isn't included by peers unaware of it.
+	UpfrontShutdownScript DeliveryAddress
+
+	// DualFund indicates that the initiator invites the responder to
+	// contribute funds of its own to the channel. This is an optional
+	// field, which must only be included if the responder advertised the
+	// DualFundOptional feature bit.
+	DualFund bool
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -134,7 +146,7 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		o.FundingAmount,
@@ -153,7 +165,19 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.HtlcPoint,
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
+		o.UpfrontShutdownScript,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The invitation to dual fund the channel is only included if it's
+	// extended.
+	if !o.DualFund {
+		return nil
+	}
+
+	return writeElement(w, uint8(1))
 }
 
 // Decode deserializes the serialized OpenChannel stored in the passed
@@ -162,7 +186,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		&o.FundingAmount,
@@ -182,6 +206,52 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	err = readUpfrontShutdownScript(r, &o.UpfrontShutdownScript)
+	if err != nil {
+		return err
+	}
+
+	// The invitation to dual fund the channel is optional, so if we're at
+	// the EOF, then it wasn't extended.
+	var dualFund uint8
+	err = readElement(r, &dualFund)
+	switch {
+	case err == io.EOF:
+		return nil
+
+	case err != nil:
+		return err
+	}
+	o.DualFund = dualFund != 0
+
+	return nil
+}
+
+// readUpfrontShutdownScript reads the optional upfront shutdown script that
+// trails the OpenChannel and AcceptChannel messages. If the field wasn't
+// included, or is empty, the script is left unset.
+func readUpfrontShutdownScript(r io.Reader, script *DeliveryAddress) error {
+	var addr DeliveryAddress
+	err := readElement(r, &addr)
+	switch {
+	// If we're at the EOF, then the field wasn't included, so we can exit
+	// early.
+	case err == io.EOF:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	if len(addr) != 0 {
+		*script = addr
+	}
+
+	return nil
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -197,6 +267,6 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1 + 2 + 34 + 1
+	return 356
 }
@@ -4,6 +4,19 @@ import (
 	"fmt"
 )
 
+const (
+	// AliasStartBlockHeight is the lowest block height of the range
+	// reserved for alias short channel IDs. Such IDs are used to refer to
+	// a channel before its funding transaction has confirmed. The range
+	// lies far beyond the height the chain will reach for decades, so an
+	// alias can't collide with the real location of a funding output.
+	AliasStartBlockHeight uint32 = 16000000
+
+	// AliasEndBlockHeight is the block height at which the range reserved
+	// for alias short channel IDs ends (exclusive).
+	AliasEndBlockHeight uint32 = 16250000
+)
+
 // ShortChannelID represents the set of data which is needed to retrieve all
 // necessary data to validate the channel existence.
 type ShortChannelID struct {
@@ -42,6 +55,13 @@ func (c ShortChannelID) ToUint64() uint64 {
 		(uint64(c.TxPosition)))
 }
 
+// IsAlias returns true if the short channel ID lies within the range reserved
+// for aliases, rather than pointing to the location of a funding output.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasStartBlockHeight &&
+		c.BlockHeight < AliasEndBlockHeight
+}
+
 // String generates a human-readable representation of the channel ID.
 func (c ShortChannelID) String() string {
 	return fmt.Sprintf("%d:%d:%d", c.BlockHeight, c.TxIndex, c.TxPosition)
@@ -39,3 +39,46 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestShortChannelIDIsAlias tests that only short channel IDs within the
+// reserved range are considered aliases.
+func TestShortChannelIDIsAlias(t *testing.T) {
+	t.Parallel()
+
+	var testCases = []struct {
+		scid    ShortChannelID
+		isAlias bool
+	}{
+		{
+			scid:    ShortChannelID{BlockHeight: 600000},
+			isAlias: false,
+		},
+		{
+			scid:    ShortChannelID{BlockHeight: AliasStartBlockHeight - 1},
+			isAlias: false,
+		},
+		{
+			scid: ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+				TxIndex:     5,
+				TxPosition:  1,
+			},
+			isAlias: true,
+		},
+		{
+			scid:    ShortChannelID{BlockHeight: AliasEndBlockHeight - 1},
+			isAlias: true,
+		},
+		{
+			scid:    ShortChannelID{BlockHeight: AliasEndBlockHeight},
+			isAlias: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.scid.IsAlias() != testCase.isAlias {
+			t.Fatalf("expected IsAlias=%v for %v",
+				testCase.isAlias, testCase.scid)
+		}
+	}
+}
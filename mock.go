@@ -174,9 +174,15 @@ func (*mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
 	return activeNetParams.GenesisHash, fundingBroadcastHeight, nil
 }
 
-func (*mockChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
+// GetUtxo returns an unspent output of 10 BTC paying to the passed pkScript,
+// matching the outputs handed out by the mockWalletController.
+func (*mockChainIO) GetUtxo(op *wire.OutPoint, pkScript []byte,
 	heightHint uint32) (*wire.TxOut, error) {
-	return nil, nil
+
+	return &wire.TxOut{
+		Value:    int64(10 * btcutil.SatoshiPerBitcoin),
+		PkScript: pkScript,
+	}, nil
 }
 
 func (*mockChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
@@ -201,13 +207,34 @@ func (*mockWalletController) BackEnd() string {
 	return "mock"
 }
 
+// utxoHash returns the txid of the unspent outputs handed out by the mock
+// wallet, which is unique per root key so the outputs of different wallets
+// don't collide.
+func (m *mockWalletController) utxoHash() chainhash.Hash {
+	return chainhash.HashH(m.rootKey.PubKey().SerializeCompressed())
+}
+
+// utxoScript returns the p2wkh script of the unspent outputs handed out by the
+// mock wallet.
+func (m *mockWalletController) utxoScript() []byte {
+	pubKeyHash := btcutil.Hash160(m.rootKey.PubKey().SerializeCompressed())
+	script, _ := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pubKeyHash).Script()
+	return script
+}
+
 // FetchInputInfo will be called to get info about the inputs to the funding
 // transaction.
-func (*mockWalletController) FetchInputInfo(
+func (m *mockWalletController) FetchInputInfo(
 	prevOut *wire.OutPoint) (*wire.TxOut, error) {
+
+	if prevOut.Hash != m.utxoHash() {
+		return nil, lnwallet.ErrNotMine
+	}
+
 	txOut := &wire.TxOut{
 		Value:    int64(10 * btcutil.SatoshiPerBitcoin),
-		PkScript: []byte("dummy"),
+		PkScript: m.utxoScript(),
 	}
 	return txOut, nil
 }
@@ -239,9 +266,9 @@ func (m *mockWalletController) ListUnspentWitness(minconfirms,
 	utxo := &lnwallet.Utxo{
 		AddressType: lnwallet.WitnessPubKey,
 		Value:       btcutil.Amount(10 * btcutil.SatoshiPerBitcoin),
-		PkScript:    make([]byte, 22),
+		PkScript:    m.utxoScript(),
 		OutPoint: wire.OutPoint{
-			Hash:  chainhash.Hash{},
+			Hash:  m.utxoHash(),
 			Index: m.index,
 		},
 	}
@@ -262,6 +289,12 @@ func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
 func (*mockWalletController) SubscribeTransactions() (lnwallet.TransactionSubscription, error) {
 	return nil, nil
 }
+func (*mockWalletController) RecoveryInfo() (*lnwallet.RecoveryInfo, error) {
+	return &lnwallet.RecoveryInfo{}, nil
+}
+func (*mockWalletController) Rescan(startHeight int32, lookAhead uint32) error {
+	return nil
+}
 func (*mockWalletController) IsSynced() (bool, int64, error) {
 	return true, int64(0), nil
 }
@@ -474,8 +474,13 @@ func (ns *nurseryStore) PreschoolToKinder(kid *kidOutput) error {
 			// once it has fully matured.
 			//
 			// Compute the maturity height, by adding the output's
-			// CSV delay to its confirmation height.
+			// CSV delay to its confirmation height. Outputs that
+			// are encumbered by both a relative and an absolute
+			// time lock mature once both have expired.
 			maturityHeight = kid.ConfHeight() + kid.BlocksToMaturity()
+			if kid.absoluteMaturity > maturityHeight {
+				maturityHeight = kid.absoluteMaturity
+			}
 		}
 
 		// In the case of a Late Registration, we've already graduated
@@ -366,6 +366,14 @@ func (p *peer) initGossipSync() {
 	}
 }
 
+// RemoteLocalFeatures returns the local feature vector the remote peer sent
+// within its init message.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
 // QuitSignal is a method that should return a channel which will be sent upon
 // or closed once the backing peer exits. This allows callers using the
 // interface to cancel any processing in the event the backing implementation
@@ -452,6 +460,7 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		if selfPolicy != nil {
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
 				MinHTLC:       selfPolicy.MinHTLC,
+				MaxHTLC:       selfPolicy.MaxHTLC,
 				BaseFee:       selfPolicy.FeeBaseMSat,
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
@@ -563,6 +572,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		FeeEstimator:           p.server.cc.feeEstimator,
 		PreimageCache:          p.server.witnessBeacon,
 		ChainEvents:            chainEvents,
+		NotifyHtlcEvent:        p.server.htlcNotifier.NotifyHtlcEvent,
 		UpdateContractSignals: func(signals *contractcourt.ContractSignals) error {
 			return p.server.chainArb.UpdateContractSignals(
 				*chanPoint, signals,
@@ -570,9 +580,12 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		},
 		OnChannelFailure:    onChannelFailure,
 		SyncStates:          syncStates,
-		BatchTicker:         ticker.New(50 * time.Millisecond),
+		BatchTicker:         ticker.New(cfg.Batching.MinInterval),
 		FwdPkgGCTicker:      ticker.New(time.Minute),
-		BatchSize:           10,
+		BatchSize:           cfg.Batching.BatchSize,
+		MinBatchInterval:    cfg.Batching.MinInterval,
+		MaxBatchInterval:    cfg.Batching.MaxInterval,
+		LogCommitTimeout:    cfg.Batching.LogCommitTimeout,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
@@ -1021,8 +1034,12 @@ out:
 			p.server.fundingMgr.processFundingOpen(msg, p)
 		case *lnwire.AcceptChannel:
 			p.server.fundingMgr.processFundingAccept(msg, p)
+		case *lnwire.FundingContribution:
+			p.server.fundingMgr.processFundingContribution(msg, p)
 		case *lnwire.FundingCreated:
 			p.server.fundingMgr.processFundingCreated(msg, p)
+		case *lnwire.FundingInputSigs:
+			p.server.fundingMgr.processFundingInputSigs(msg, p)
 		case *lnwire.FundingSigned:
 			p.server.fundingMgr.processFundingSigned(msg, p)
 		case *lnwire.FundingLocked:
@@ -1063,6 +1080,12 @@ out:
 			case p.server.fundingMgr.IsPendingChannel(msg.ChanID, key):
 				p.server.fundingMgr.processFundingError(msg, key)
 
+			// The same goes for channels whose funding
+			// transaction was published but hasn't confirmed
+			// yet, which the initiator may have canceled.
+			case p.server.fundingMgr.IsPendingOpenChannel(msg.ChanID, key):
+				p.server.fundingMgr.processFundingError(msg, key)
+
 			// If not we hand the error to the channel link for
 			// this channel.
 			default:
@@ -1173,6 +1196,16 @@ func messageSummary(msg lnwire.Message) string {
 	case *lnwire.FundingSigned:
 		return fmt.Sprintf("chan_id=%v", msg.ChanID)
 
+	case *lnwire.FundingContribution:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v, num_inputs=%v, "+
+			"num_change_outputs=%v", msg.PendingChannelID[:],
+			msg.FundingAmount, len(msg.Inputs),
+			len(msg.ChangeOutputs))
+
+	case *lnwire.FundingInputSigs:
+		return fmt.Sprintf("chan_id=%v, num_input_scripts=%v",
+			msg.ChanID, len(msg.InputScripts))
+
 	case *lnwire.FundingLocked:
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
@@ -1540,6 +1573,32 @@ func (p *peer) genDeliveryScript() ([]byte, error) {
 	return txscript.PayToAddrScript(deliveryAddr)
 }
 
+// chooseDeliveryScript returns the script to send our funds to in the case of
+// a cooperative channel close negotiation. If we committed to an upfront
+// shutdown script, it must be used, and the requested script, if any, must
+// match it. Otherwise, the requested script is used if set, and a new one is
+// generated if not.
+func (p *peer) chooseDeliveryScript(upfront,
+	requested lnwire.DeliveryAddress) (lnwire.DeliveryAddress, error) {
+
+	switch {
+	case len(upfront) != 0 && len(requested) != 0 &&
+		!bytes.Equal(upfront, requested):
+
+		return nil, fmt.Errorf("delivery script %x does not match "+
+			"upfront shutdown script %x", []byte(requested),
+			[]byte(upfront))
+
+	case len(upfront) != 0:
+		return upfront, nil
+
+	case len(requested) != 0:
+		return requested, nil
+	}
+
+	return p.genDeliveryScript()
+}
+
 // channelManager is goroutine dedicated to handling all requests/signals
 // pertaining to the opening, cooperative closing, and force closing of all
 // channels maintained with the remote peer.
@@ -1588,6 +1647,16 @@ out:
 					continue
 				}
 
+				// With the revocation point known, the link
+				// may now be eligible to forward HTLCs.
+				link, err := p.server.htlcSwitch.GetLink(chanID)
+				if err == nil && link.EligibleToForward() {
+					notifier := p.server.channelNotifier
+					notifier.NotifyActiveChannelEvent(
+						*chanPoint,
+					)
+				}
+
 				continue
 			}
 
@@ -1719,6 +1788,7 @@ out:
 				closeMsg.msg,
 			)
 			if err != nil {
+				closeErr := err
 				err := fmt.Errorf("unable to process close "+
 					"msg: %v", err)
 				peerLog.Error(err)
@@ -1732,6 +1802,13 @@ out:
 					chanCloser.CloseRequest().Err <- err
 				}
 				delete(p.activeChanCloses, closeMsg.cid)
+
+				// If the remote party attempted to close to a
+				// script other than the one it committed to,
+				// we'll fail the connection.
+				if closeErr == ErrUpfrontShutdownScriptMismatch {
+					p.Disconnect(closeErr)
+				}
 				continue
 			}
 
@@ -1797,7 +1874,9 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 
 		// We'll create a valid closing state machine in order to
 		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
+		deliveryAddr, err := p.chooseDeliveryScript(
+			channel.State().LocalShutdownScript, nil,
+		)
 		if err != nil {
 			peerLog.Errorf("unable to gen delivery script: %v", err)
 
@@ -1824,12 +1903,13 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			chanCloseCfg{
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
+				broadcastTx:       p.server.cc.wallet.PublishLabelledTransaction,
 				disableChannel: func(op wire.OutPoint) error {
 					return p.server.announceChanStatus(op,
 						true)
 				},
-				quit: p.quit,
+				notifyClosing: p.server.channelNotifier.NotifyClosingChannelEvent,
+				quit:          p.quit,
 			},
 			deliveryAddr,
 			feePerKw,
@@ -1864,10 +1944,14 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
+		// First, we'll determine the delivery address that we'll use
 		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
+		// negotiation. Unless we committed to an upfront shutdown
+		// script, or the caller specified one, we'll fetch a fresh
+		// one.
+		deliveryAddr, err := p.chooseDeliveryScript(
+			channel.State().LocalShutdownScript, req.DeliveryScript,
+		)
 		if err != nil {
 			peerLog.Errorf(err.Error())
 			req.Err <- err
@@ -1887,12 +1971,13 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			chanCloseCfg{
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
+				broadcastTx:       p.server.cc.wallet.PublishLabelledTransaction,
 				disableChannel: func(op wire.OutPoint) error {
 					return p.server.announceChanStatus(op,
 						true)
 				},
-				quit: p.quit,
+				notifyClosing: p.server.channelNotifier.NotifyClosingChannelEvent,
+				quit:          p.quit,
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
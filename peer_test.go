@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -589,3 +590,89 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureUpfrontShutdown tests that the upfront shutdown
+// scripts committed to when opening a channel are enforced during a
+// cooperative close, both for our own delivery script and the remote party's.
+func TestPeerChannelClosureUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, _, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Both parties committed to an upfront shutdown script when opening
+	// the channel.
+	localScript := lnwire.DeliveryAddress(bytes.Repeat([]byte{1}, 22))
+	remoteScript := lnwire.DeliveryAddress(bytes.Repeat([]byte{2}, 22))
+	initiatorChan.State().LocalShutdownScript = localScript
+	initiatorChan.State().RemoteShutdownScript = remoteScript
+
+	// closeChannel requests a cooperative close of the channel, paying
+	// our funds to the passed delivery script.
+	closeChannel := func(deliveryScript lnwire.DeliveryAddress) chan error {
+		errChan := make(chan error, 1)
+		initiator.localCloseChanReqs <- &htlcswitch.ChanClose{
+			CloseType:      htlcswitch.CloseRegular,
+			ChanPoint:      initiatorChan.ChannelPoint(),
+			Updates:        make(chan *lnrpc.CloseStatusUpdate, 1),
+			TargetFeePerKw: 12500,
+			DeliveryScript: deliveryScript,
+			Err:            errChan,
+		}
+		return errChan
+	}
+
+	// Requesting a delivery script other than the one we committed to
+	// should fail.
+	errChan := closeChannel(remoteScript)
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("expected close with mismatched delivery " +
+				"script to fail")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("close with mismatched delivery script not failed")
+	}
+
+	// Without requesting a delivery script, the one we committed to
+	// should be used.
+	closeChannel(nil)
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, localScript) {
+		t.Fatalf("expected delivery script %x, got %x", localScript,
+			shutdownMsg.Address)
+	}
+
+	// Finally, the remote party attempting to close to a script other
+	// than the one it committed to should be refused.
+	chanCloser := initiator.activeChanCloses[shutdownMsg.ChannelID]
+	_, _, err = chanCloser.ProcessCloseMsg(
+		lnwire.NewShutdown(shutdownMsg.ChannelID, dummyDeliveryScript),
+	)
+	if err != ErrUpfrontShutdownScriptMismatch {
+		t.Fatalf("expected ErrUpfrontShutdownScriptMismatch, got: %v",
+			err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -144,3 +145,29 @@ func ValidateChannelUpdateAnn(pubKey *btcec.PublicKey,
 
 	return nil
 }
+
+// ValidateChannelUpdateFields validates the optional fields of a channel
+// update against the flags signalling their presence. A capacity of zero
+// skips the checks that depend on the capacity of the channel.
+func ValidateChannelUpdateFields(capacity btcutil.Amount,
+	a *lnwire.ChannelUpdate) error {
+
+	// The maximum HTLC, if present, must be non-zero, at least the
+	// minimum HTLC, and can't exceed the capacity of the channel.
+	if a.Flags.HasMaxHtlc() {
+		maxHtlc := a.HtlcMaximumMsat
+		if maxHtlc == 0 || maxHtlc < a.HtlcMinimumMsat {
+			return errors.Errorf("invalid max htlc %v for channel "+
+				"update %v", maxHtlc, a.ShortChannelID)
+		}
+
+		capacityMsat := lnwire.NewMSatFromSatoshis(capacity)
+		if capacity != 0 && maxHtlc > capacityMsat {
+			return errors.Errorf("max htlc %v exceeds capacity %v "+
+				"for channel update %v", maxHtlc, capacityMsat,
+				a.ShortChannelID)
+		}
+	}
+
+	return nil
+}
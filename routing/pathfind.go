@@ -582,6 +582,12 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 			return
 		}
 
+		// If the edge advertises a maximum HTLC amount and the
+		// amountToSend exceeds it, return.
+		if edge.Flags.HasMaxHtlc() && amountToSend > edge.MaxHTLC {
+			return
+		}
+
 		// Compute fee that fromNode is charging. It is based on the
 		// amount that needs to be sent to the next node in the route.
 		//
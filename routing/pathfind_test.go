@@ -286,6 +286,7 @@ func parseTestGraph(path string) (*testGraphInstance, error) {
 type testChannelPolicy struct {
 	Expiry      uint16
 	MinHTLC     lnwire.MilliSatoshi
+	MaxHTLC     lnwire.MilliSatoshi
 	FeeBaseMsat lnwire.MilliSatoshi
 	FeeRate     lnwire.MilliSatoshi
 }
@@ -484,9 +485,13 @@ func createTestGraphFromChannels(testChannels []*testChannel) (*testGraphInstanc
 			LastUpdate:                testTime,
 			TimeLockDelta:             testChannel.Node1.Expiry,
 			MinHTLC:                   testChannel.Node1.MinHTLC,
+			MaxHTLC:                   testChannel.Node1.MaxHTLC,
 			FeeBaseMSat:               testChannel.Node1.FeeBaseMsat,
 			FeeProportionalMillionths: testChannel.Node1.FeeRate,
 		}
+		if testChannel.Node1.MaxHTLC != 0 {
+			edgePolicy.Flags |= lnwire.ChanUpdateOptionMaxHtlc
+		}
 		if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 			return nil, err
 		}
@@ -498,9 +503,13 @@ func createTestGraphFromChannels(testChannels []*testChannel) (*testGraphInstanc
 			LastUpdate:                testTime,
 			TimeLockDelta:             testChannel.Node2.Expiry,
 			MinHTLC:                   testChannel.Node2.MinHTLC,
+			MaxHTLC:                   testChannel.Node2.MaxHTLC,
 			FeeBaseMSat:               testChannel.Node2.FeeBaseMsat,
 			FeeProportionalMillionths: testChannel.Node2.FeeRate,
 		}
+		if testChannel.Node2.MaxHTLC != 0 {
+			edgePolicy.Flags |= lnwire.ChanUpdateOptionMaxHtlc
+		}
 
 		if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 			return nil, err
@@ -1394,6 +1403,58 @@ func TestRouteFailMinHTLC(t *testing.T) {
 	}
 }
 
+// TestRouteFailMaxHTLC tests that if we attempt to route an HTLC which is
+// larger than the advertised max HTLC of an edge, then path finding fails.
+func TestRouteFailMaxHTLC(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with a single path from roasbeef to target,
+	// where the last channel only accepts HTLCs of up to 10k msat.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "first", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+		}),
+		symmetricTestChannel("first", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+			MaxHTLC: 10000,
+		}),
+	}
+
+	graph, err := createTestGraphFromChannels(testChannels)
+	defer graph.cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	ignoredEdges := make(map[uint64]struct{})
+	ignoredVertexes := make(map[Vertex]struct{})
+
+	// An HTLC within the max HTLC of the last channel can be routed.
+	target := graph.aliasMap["target"]
+	_, err = findPath(
+		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
+		ignoredEdges, 10000, noFeeLimit, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	// However, a larger HTLC can't.
+	_, err = findPath(
+		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
+		ignoredEdges, 10001, noFeeLimit, nil,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("graph shouldn't be able to support payment: %v", err)
+	}
+}
+
 // TestRouteFailDisabledEdge tests that if we attempt to route to an edge
 // that's disabled, then that edge is disqualified, and the routing attempt
 // will fail.
@@ -140,6 +140,16 @@ type ChannelPolicy struct {
 	// TimeLockDelta is the required HTLC timelock delta to be used
 	// when forwarding payments.
 	TimeLockDelta uint32
+
+	// MaxHTLC is the maximum HTLC size that will be forwarded, which is
+	// capped at the capacity of each channel. A value of zero leaves the
+	// maximum HTLC of the channels unchanged.
+	MaxHTLC lnwire.MilliSatoshi
+
+	// ClearMaxHTLC removes the maximum HTLC size of the channels, so that
+	// HTLCs up to their capacity are forwarded. It can't be combined with
+	// a MaxHTLC.
+	ClearMaxHTLC bool
 }
 
 // Config defines the configuration for the ChannelRouter. ALL elements within
@@ -1039,9 +1049,17 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 				"got %x", fundingPkScript, chanUtxo.PkScript)
 		}
 
+		// Channels above the soft-limit on channel size are added
+		// like any other. Whether to open such a large channel is
+		// negotiated between its parties when connecting, which isn't
+		// reflected in the announcement, so there's nothing for us to
+		// verify here. Our own channel size limits are enforced by the
+		// funding manager.
+		capacity := btcutil.Amount(chanUtxo.Value)
+
 		// TODO(roasbeef): this is a hack, needs to be removed
 		// after commitment fees are dynamic.
-		msg.Capacity = btcutil.Amount(chanUtxo.Value)
+		msg.Capacity = capacity
 		msg.ChannelPoint = *fundingPoint
 		if err := r.cfg.Graph.AddChannelEdge(msg); err != nil {
 			return errors.Errorf("unable to add edge: %v", err)
@@ -2057,17 +2075,27 @@ func (r *ChannelRouter) applyChannelUpdate(msg *lnwire.ChannelUpdate,
 		return nil
 	}
 
+	ch, _, _, err := r.GetChannelByID(msg.ShortChannelID)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve channel by id: %v", err)
+	}
+
+	if err := ValidateChannelUpdateFields(ch.Capacity, msg); err != nil {
+		return err
+	}
+
 	if err := ValidateChannelUpdateAnn(pubKey, msg); err != nil {
 		return err
 	}
 
-	err := r.UpdateEdge(&channeldb.ChannelEdgePolicy{
+	err = r.UpdateEdge(&channeldb.ChannelEdgePolicy{
 		SigBytes:                  msg.Signature.ToSignatureBytes(),
 		ChannelID:                 msg.ShortChannelID.ToUint64(),
 		LastUpdate:                time.Unix(int64(msg.Timestamp), 0),
 		Flags:                     msg.Flags,
 		TimeLockDelta:             msg.TimeLockDelta,
 		MinHTLC:                   msg.HtlcMinimumMsat,
+		MaxHTLC:                   msg.HtlcMaximumMsat,
 		FeeBaseMSat:               lnwire.MilliSatoshi(msg.BaseFee),
 		FeeProportionalMillionths: lnwire.MilliSatoshi(msg.FeeRate),
 	})
@@ -15,6 +15,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -2022,6 +2023,71 @@ func TestIsKnownEdge(t *testing.T) {
 	}
 }
 
+// TestAddWumboEdge tests that channels above the soft-limit on channel size
+// are added to the graph, as whether to open them is only negotiated between
+// their parties, and that they're never cached as rejected.
+func TestAddWumboEdge(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+
+	// The channel is just above the soft-limit of 2^24 - 1 satoshis.
+	capacity := btcutil.Amount(1 << 24)
+	fundingTx, _, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		capacity, 500)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	// The announcement doesn't carry any features, as none of them
+	// signal a channel's size.
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+		AuthProof:        nil,
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add wumbo edge: %v", err)
+	}
+
+	info, _, _, err := ctx.graph.FetchChannelEdgesByID(chanID.ToUint64())
+	if err != nil {
+		t.Fatalf("unable to fetch wumbo edge: %v", err)
+	}
+	if info.Capacity != capacity {
+		t.Fatalf("expected capacity %v, got %v", capacity,
+			info.Capacity)
+	}
+
+	ctx.router.rejectMtx.RLock()
+	_, rejected := ctx.router.rejectCache[chanID.ToUint64()]
+	ctx.router.rejectMtx.RUnlock()
+	if rejected {
+		t.Fatalf("wumbo edge shouldn't be cached as rejected")
+	}
+}
+
 // TestIsStaleEdgePolicy tests that the IsStaleEdgePolicy properly detects
 // stale channel edge update announcements.
 func TestIsStaleEdgePolicy(t *testing.T) {
@@ -25,11 +25,14 @@ import (
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -147,6 +150,31 @@ var (
 		},
 	}
 
+	// signerPermissions is a slice of all the entities that allows a user
+	// to only access the key derivation and signing calls of the Signer
+	// service. It intentionally doesn't grant the ability to export the
+	// node's identity private key.
+	signerPermissions = []bakery.Op{
+		{
+			Entity: "signer",
+			Action: "read",
+		},
+		{
+			Entity: "signer",
+			Action: "generate",
+		},
+	}
+
+	// remoteSignerPermissions is a slice of all the entities that allows
+	// a watch-only node to use this node as its remote signer. On top of
+	// the signer permissions, this includes exporting the node's identity
+	// private key, which the watch-only node needs to process onion
+	// packets.
+	remoteSignerPermissions = append([]bakery.Op{{
+		Entity: "signer",
+		Action: "export",
+	}}, signerPermissions...)
+
 	// permissions maps RPC calls to the permissions they require.
 	permissions = map[string][]bakery.Op{
 		"/lnrpc.Lightning/SendCoins": {{
@@ -184,6 +212,13 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ChannelAcceptor": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannelSync": {{
 			Entity: "onchain",
 			Action: "write",
@@ -206,6 +241,18 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetRecoveryInfo": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/Rescan": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/FeeEstimatorHealth": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListPeers": {{
 			Entity: "peers",
 			Action: "read",
@@ -222,6 +269,13 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/CancelPendingChannel": {{
+			Entity: "offchain",
+			Action: "write",
+		}, {
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListChannels": {{
 			Entity: "offchain",
 			Action: "read",
@@ -230,6 +284,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribeChannelEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -270,6 +328,10 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/LabelTransaction": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/DescribeGraph": {{
 			Entity: "info",
 			Action: "read",
@@ -322,10 +384,82 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/UpdateChanStatus": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/HtlcInterceptor": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ForwardingLimits": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/UpdateForwardingLimits": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ForwardingStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ListCircuits": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ListForwardingPackages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/FailStuckHtlc": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ReplayLogInfo": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/CompactReplayLog": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Signer/SignOutputRaw": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/lnrpc.Signer/ComputeInputScript": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/lnrpc.Signer/SignMessageWithKey": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/lnrpc.Signer/DeriveNextKey": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/lnrpc.Signer/DeriveKey": {{
+			Entity: "signer",
+			Action: "read",
+		}},
+		"/lnrpc.Signer/DerivePrivKey": {{
+			Entity: "signer",
+			Action: "export",
+		}},
+		"/lnrpc.Signer/DeriveSharedKey": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
 	}
 )
 
@@ -401,7 +535,11 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 // more addresses specified in the passed payment map. The payment map maps an
 // address to a specified output value to be sent to that address.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight, label string) (*chainhash.Hash, error) {
+
+	if len(label) > channeldb.MaxTxLabelLength {
+		return nil, channeldb.ErrTxLabelTooLong
+	}
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
@@ -413,8 +551,19 @@ func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
 		return nil, err
 	}
 
+	// The transaction has already been broadcast at this point, so a
+	// failure to persist its label is only logged.
 	txHash := tx.TxHash()
-	return &txHash, err
+	err = r.server.chanDB.PutTxLabel(txHash, &channeldb.TxLabel{
+		Category: channeldb.TxCategorySend,
+		Label:    label,
+	})
+	if err != nil {
+		rpcsLog.Errorf("Unable to store label for tx %v: %v", txHash,
+			err)
+	}
+
+	return &txHash, nil
 }
 
 // determineFeePerKw will determine the fee in sat/kw that should be paid given
@@ -481,7 +630,7 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		btcutil.Amount(in.Amount), int64(feePerKw))
 
 	paymentMap := map[string]int64{in.Addr: in.Amount}
-	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw, in.Label)
 	if err != nil {
 		return nil, err
 	}
@@ -508,7 +657,7 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
 		spew.Sdump(in.AddrToAmount), int64(feePerKw))
 
-	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw)
+	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw, in.Label)
 	if err != nil {
 		return nil, err
 	}
@@ -752,6 +901,61 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 	}
 }
 
+// extractOpenChannelLimits validates the optional channel limits within the
+// OpenChannelRequest and sets them on the passed openChanReq. Zero values are
+// left as is, signalling that the defaults should be used.
+func extractOpenChannelLimits(in *lnrpc.OpenChannelRequest,
+	req *openChanReq) error {
+
+	switch {
+	case in.MaxAcceptedHtlcs > lnwallet.MaxHTLCNumber/2:
+		return fmt.Errorf("max accepted htlcs must not exceed %v",
+			lnwallet.MaxHTLCNumber/2)
+
+	case in.MaxRemoteCsvDelay > math.MaxUint16:
+		return fmt.Errorf("max remote csv delay must not exceed %v",
+			math.MaxUint16)
+
+	case in.RemoteChanReserveSat < 0:
+		return errors.New("remote channel reserve must be a " +
+			"non-negative number")
+
+	case in.DustLimitSat < 0:
+		return errors.New("dust limit must be a non-negative number")
+	}
+
+	req.maxValueInFlight = lnwire.MilliSatoshi(in.MaxValueInFlightMsat)
+	req.maxHtlcs = uint16(in.MaxAcceptedHtlcs)
+	req.remoteChanReserve = btcutil.Amount(in.RemoteChanReserveSat)
+	req.dustLimit = btcutil.Amount(in.DustLimitSat)
+	req.maxRemoteCsvDelay = uint16(in.MaxRemoteCsvDelay)
+
+	if in.CloseAddress != "" {
+		script, err := parseDeliveryAddress(in.CloseAddress)
+		if err != nil {
+			return fmt.Errorf("invalid close address: %v", err)
+		}
+		req.shutdownScript = script
+	}
+
+	return nil
+}
+
+// parseDeliveryAddress decodes the passed address, and returns the script
+// paying to it for use as the delivery script of a cooperative close.
+func parseDeliveryAddress(addr string) (lnwire.DeliveryAddress, error) {
+	address, err := btcutil.DecodeAddress(addr, activeNetParams.Params)
+	if err != nil {
+		return nil, err
+	}
+	if !address.IsForNet(activeNetParams.Params) {
+		return nil, fmt.Errorf("address %v is not for the active "+
+			"network", addr)
+	}
+
+	return txscript.PayToAddrScript(address)
+}
+
 // OpenChannel attempts to open a singly funded channel specified in the
 // request to a remote peer.
 func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
@@ -781,12 +985,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 			"state must be below the local funding amount")
 	}
 
-	// Ensure that the user doesn't exceed the current soft-limit for
-	// channel size. If the funding amount is above the soft-limit, then
-	// we'll reject the request.
-	if localFundingAmt > maxFundingAmount {
+	// Ensure that the user doesn't exceed the configured maximum channel
+	// size. Whether a channel above the soft-limit for channel size can be
+	// opened also depends on the peer, which the funding manager checks.
+	maxChanSize := btcutil.Amount(cfg.MaxChanSize)
+	if localFundingAmt > maxChanSize {
 		return fmt.Errorf("funding amount is too large, the max "+
-			"channel size is: %v", maxFundingAmount)
+			"channel size is: %v", maxChanSize)
 	}
 
 	// Restrict the size of the channel we'll actually open. At a later
@@ -855,9 +1060,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		minHtlc:         minHtlc,
 		fundingFeePerKw: feeRate,
 		private:         in.Private,
+		dualFund:        in.DualFund,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
 	}
+	if err := extractOpenChannelLimits(in, req); err != nil {
+		return err
+	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
 
@@ -908,6 +1117,131 @@ out:
 	return nil
 }
 
+// ChannelAcceptor dispatches a bi-directional streaming RPC in which the
+// parameters of each inbound channel open request are sent to the client,
+// which responds with whether the channel should be accepted.
+func (r *rpcServer) ChannelAcceptor(
+	stream lnrpc.Lightning_ChannelAcceptorServer) error {
+
+	// send delivers a request to the client. The funding manager accepts
+	// inbound channels concurrently, but the RPCAcceptor serializes its
+	// calls to send, so the stream is never written to from more than one
+	// goroutine. Receiving from it below at the same time is safe.
+	send := func(req *chanacceptor.ChannelAcceptRequest) error {
+		msg := req.OpenChanMsg
+		return stream.Send(&lnrpc.ChannelAcceptRequest{
+			NodePubkey:       req.Node.SerializeCompressed(),
+			ChainHash:        msg.ChainHash[:],
+			PendingChanId:    msg.PendingChannelID[:],
+			FundingAmt:       uint64(msg.FundingAmount),
+			PushAmt:          uint64(msg.PushAmount),
+			DustLimit:        uint64(msg.DustLimit),
+			MaxValueInFlight: uint64(msg.MaxValueInFlight),
+			ChannelReserve:   uint64(msg.ChannelReserve),
+			MinHtlc:          uint64(msg.HtlcMinimum),
+			FeePerKw:         uint64(msg.FeePerKiloWeight),
+			CsvDelay:         uint32(msg.CsvDelay),
+			MaxAcceptedHtlcs: uint32(msg.MaxAcceptedHTLCs),
+			ChannelFlags:     uint32(msg.ChannelFlags),
+		})
+	}
+
+	// Once the client goes away, any request still awaiting its decision
+	// is failed, and the acceptor is removed from the chain.
+	quit := make(chan struct{})
+	defer close(quit)
+
+	acceptor := chanacceptor.NewRPCAcceptor(send, cfg.AcceptorTimeout, quit)
+	acceptorID := r.server.chanAcceptor.AddAcceptor(acceptor)
+	defer r.server.chanAcceptor.RemoveAcceptor(acceptorID)
+
+	rpcsLog.Infof("[channelacceptor] client connected")
+
+	for {
+		resp, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		var pendingChanID [32]byte
+		if len(resp.PendingChanId) != len(pendingChanID) {
+			return fmt.Errorf("pending channel ID must be %v bytes",
+				len(pendingChanID))
+		}
+		copy(pendingChanID[:], resp.PendingChanId)
+
+		// If the client accepted the channel with limits we can't
+		// apply, we'll reject it instead, as the client wouldn't want
+		// it opened with the defaults.
+		accept, reason := resp.Accept, resp.Error
+		var params *chanacceptor.ChannelParams
+		if accept {
+			params, err = extractChannelAcceptParams(resp)
+			if err != nil {
+				rpcsLog.Warnf("Rejecting channel %x with "+
+					"invalid params: %v", pendingChanID[:],
+					err)
+
+				accept, reason = false, ""
+			}
+		}
+
+		err = acceptor.HandleResponse(
+			pendingChanID, accept, reason, params,
+		)
+		if err != nil {
+			rpcsLog.Warnf("Unable to handle channel acceptor "+
+				"response: %v", err)
+		}
+	}
+}
+
+// extractChannelAcceptParams validates the optional channel limits within the
+// ChannelAcceptResponse, and returns the channel params to apply to the
+// accepted channel.
+func extractChannelAcceptParams(
+	resp *lnrpc.ChannelAcceptResponse) (*chanacceptor.ChannelParams, error) {
+
+	switch {
+	case resp.MaxAcceptedHtlcs > lnwallet.MaxHTLCNumber/2:
+		return nil, fmt.Errorf("max accepted htlcs must not exceed %v",
+			lnwallet.MaxHTLCNumber/2)
+
+	case resp.CsvDelay > math.MaxUint16:
+		return nil, fmt.Errorf("csv delay must not exceed %v",
+			math.MaxUint16)
+
+	case resp.MaxCsvDelay > math.MaxUint16:
+		return nil, fmt.Errorf("max csv delay must not exceed %v",
+			math.MaxUint16)
+	}
+
+	params := &chanacceptor.ChannelParams{
+		MaxValueInFlight: lnwire.MilliSatoshi(resp.MaxValueInFlight),
+		MaxAcceptedHTLCs: uint16(resp.MaxAcceptedHtlcs),
+		ChanReserve:      btcutil.Amount(resp.ChannelReserve),
+		DustLimit:        btcutil.Amount(resp.DustLimit),
+		MinHtlc:          lnwire.MilliSatoshi(resp.MinHtlc),
+		CsvDelay:         uint16(resp.CsvDelay),
+		MaxCsvDelay:      uint16(resp.MaxCsvDelay),
+		ZeroConf:         resp.ZeroConf,
+	}
+
+	if resp.UpfrontShutdown != "" {
+		script, err := parseDeliveryAddress(resp.UpfrontShutdown)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upfront shutdown "+
+				"address: %v", err)
+		}
+		params.UpfrontShutdown = script
+	}
+
+	return params, nil
+}
+
 // OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 // call is meant to be consumed by clients to the REST proxy. As with all other
 // sync calls, all byte slices are instead to be populated as hex encoded
@@ -999,9 +1333,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		minHtlc:         minHtlc,
 		fundingFeePerKw: feeRate,
 		private:         in.Private,
+		dualFund:        in.DualFund,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
 	}
+	if err := extractOpenChannelLimits(in, req); err != nil {
+		return nil, err
+	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
 	select {
@@ -1064,6 +1402,14 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	updateStream lnrpc.Lightning_CloseChannelServer) error {
 
 	force := in.Force
+
+	// Our funds are always swept to the wallet after a force close, so a
+	// delivery address can only be used for cooperative closes.
+	if force && in.DeliveryAddress != "" {
+		return fmt.Errorf("cannot set delivery address when force " +
+			"closing a channel")
+	}
+
 	index := in.ChannelPoint.OutputIndex
 	txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
 	if err != nil {
@@ -1194,9 +1540,24 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// Otherwise, the caller has requested a regular interactive
 		// cooperative channel closure. So we'll forward the request to
 		// the htlc switch which will handle the negotiation and
-		// broadcast details.
+		// broadcast details, along with the script of the address the
+		// caller wants our funds sent to, if any. Whether it's
+		// compatible with an upfront shutdown script we may have
+		// committed to is checked by the peer.
+		var deliveryScript lnwire.DeliveryAddress
+		if in.DeliveryAddress != "" {
+			deliveryScript, err = parseDeliveryAddress(
+				in.DeliveryAddress,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid delivery address: "+
+					"%v", err)
+			}
+		}
+
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			deliveryScript,
 		)
 	}
 out:
@@ -1422,6 +1783,124 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// GetRecoveryInfo returns information concerning the progress of the wallet
+// in recovering its funds, either after being restored from a seed with a
+// non-zero recovery window, or after a rescan was requested.
+func (r *rpcServer) GetRecoveryInfo(ctx context.Context,
+	in *lnrpc.GetRecoveryInfoRequest) (*lnrpc.GetRecoveryInfoResponse, error) {
+
+	info, err := r.server.cc.wallet.RecoveryInfo()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch recovery info: %v", err)
+	}
+
+	// The progress is the share of blocks scanned out of all the blocks
+	// between the start of the scan and the current best block.
+	progress := 1.0
+	if !info.Finished && info.TargetHeight > info.StartHeight {
+		progress = float64(info.CurrentHeight-info.StartHeight) /
+			float64(info.TargetHeight-info.StartHeight)
+		if progress < 0 {
+			progress = 0
+		}
+		if progress > 1 {
+			progress = 1
+		}
+	}
+
+	var rescanErr string
+	if info.RescanErr != nil {
+		rescanErr = info.RescanErr.Error()
+	}
+
+	return &lnrpc.GetRecoveryInfoResponse{
+		RecoveryMode:     info.RecoveryMode,
+		RecoveryFinished: info.Finished,
+		Progress:         progress,
+		CurrentHeight:    info.CurrentHeight,
+		TargetHeight:     info.TargetHeight,
+		AddressesDerived: info.AddressesDerived,
+		FundsFound:       int64(info.FundsFound),
+		RescanError:      rescanErr,
+	}, nil
+}
+
+// Rescan replays the chain starting at the target height, looking for
+// transactions that pay to or spend from the wallet.
+func (r *rpcServer) Rescan(ctx context.Context,
+	in *lnrpc.RescanRequest) (*lnrpc.RescanResponse, error) {
+
+	rpcsLog.Infof("[rescan] from_height=%v, lookahead=%v", in.FromHeight,
+		in.Lookahead)
+
+	err := r.server.cc.wallet.Rescan(in.FromHeight, in.Lookahead)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start rescan: %v", err)
+	}
+
+	return &lnrpc.RescanResponse{}, nil
+}
+
+// FeeEstimatorHealth returns the health of the sources used to estimate
+// on-chain fees.
+func (r *rpcServer) FeeEstimatorHealth(ctx context.Context,
+	in *lnrpc.FeeEstimatorHealthRequest) (*lnrpc.FeeEstimatorHealthResponse,
+	error) {
+
+	rpcsLog.Tracef("[feeestimatorhealth] request")
+
+	// If we aren't combining any live sources, then all estimates are
+	// served by the static estimator, so there's no health to report.
+	estimator, ok := r.server.cc.feeEstimator.(*lnwallet.CompositeFeeEstimator)
+	if !ok {
+		feePerKw, err := r.server.cc.feeEstimator.EstimateFeePerKW(6)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.FeeEstimatorHealthResponse{
+			FallbackFeePerKw: int64(feePerKw),
+		}, nil
+	}
+
+	// unixTime returns the unix timestamp of t, or zero if t isn't set.
+	unixTime := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+
+	health := estimator.Health()
+	resp := &lnrpc.FeeEstimatorHealthResponse{
+		Composite:        true,
+		Sources:          make([]*lnrpc.FeeSourceHealth, 0, len(health.Sources)),
+		MinFeePerKw:      int64(health.MinFeePerKW),
+		MaxFeePerKw:      int64(health.MaxFeePerKW),
+		FallbackFeePerKw: int64(health.FallbackFeePerKW),
+		NumFallbacks:     health.NumFallbacks,
+		LastFallback:     unixTime(health.LastFallback),
+	}
+	for _, source := range health.Sources {
+		var lastError string
+		if source.LastError != nil {
+			lastError = source.LastError.Error()
+		}
+
+		resp.Sources = append(resp.Sources, &lnrpc.FeeSourceHealth{
+			Name:                source.Name,
+			Healthy:             source.Healthy(),
+			LastSuccess:         unixTime(source.LastSuccess),
+			LastFeePerKw:        int64(source.LastFeePerKW),
+			LastError:           lastError,
+			LastFailure:         unixTime(source.LastFailure),
+			ConsecutiveFailures: source.ConsecutiveFailures,
+		})
+	}
+
+	return resp, nil
+}
+
 // ListPeers returns a verbose listing of all currently active peers.
 func (r *rpcServer) ListPeers(ctx context.Context,
 	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
@@ -1555,33 +2034,9 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	resp.PendingOpenChannels = make([]*lnrpc.PendingChannelsResponse_PendingOpenChannel,
 		len(pendingOpenChannels))
 	for i, pendingChan := range pendingOpenChannels {
-		pub := pendingChan.IdentityPub.SerializeCompressed()
-
-		// As this is required for display purposes, we'll calculate
-		// the weight of the commitment transaction. We also add on the
-		// estimated weight of the witness to calculate the weight of
-		// the transaction if it were to be immediately unilaterally
-		// broadcast.
-		// TODO(roasbeef): query for funding tx from wallet, display
-		// that also?
-		localCommitment := pendingChan.LocalCommitment
-		utx := btcutil.NewTx(localCommitment.CommitTx)
-		commitBaseWeight := blockchain.GetTransactionWeight(utx)
-		commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
-
-		resp.PendingOpenChannels[i] = &lnrpc.PendingChannelsResponse_PendingOpenChannel{
-			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
-				RemoteNodePub: hex.EncodeToString(pub),
-				ChannelPoint:  pendingChan.FundingOutpoint.String(),
-				Capacity:      int64(pendingChan.Capacity),
-				LocalBalance:  int64(localCommitment.LocalBalance.ToSatoshis()),
-				RemoteBalance: int64(localCommitment.RemoteBalance.ToSatoshis()),
-			},
-			CommitWeight: commitWeight,
-			CommitFee:    int64(localCommitment.CommitFee),
-			FeePerKw:     int64(localCommitment.FeePerKw),
-			// TODO(roasbeef): need to track confirmation height
-		}
+		resp.PendingOpenChannels[i] = createRPCPendingOpenChannel(
+			pendingChan,
+		)
 	}
 
 	_, currentHeight, err := r.server.cc.chainIO.GetBestBlock()
@@ -1730,6 +2185,87 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		resp.TotalLimboBalance += channel.LocalBalance
 	}
 
+	// Finally, we'll add the reservations whose funding transaction
+	// hasn't been published yet, which can only be referred to by their
+	// pending channel ID.
+	for _, reservation := range r.server.fundingMgr.PendingReservations() {
+		pub := reservation.identityPub.SerializeCompressed()
+		pendingChanID := reservation.pendingChanID
+		resp.PendingReservations = append(
+			resp.PendingReservations,
+			&lnrpc.PendingChannelsResponse_PendingReservation{
+				RemoteNodePub: hex.EncodeToString(pub),
+				PendingChanId: pendingChanID[:],
+				Capacity:      int64(reservation.capacity),
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// CancelPendingChannel cancels the funding flow of a pending channel. A
+// reservation whose funding transaction hasn't been published yet is torn
+// down, while the wallet's inputs to a published funding transaction are
+// double spent back to the wallet.
+func (r *rpcServer) CancelPendingChannel(ctx context.Context,
+	in *lnrpc.CancelPendingChannelRequest) (
+	*lnrpc.CancelPendingChannelResponse, error) {
+
+	var (
+		pendingChanID [32]byte
+		chanPoint     *wire.OutPoint
+		feeRate       lnwallet.SatPerKWeight
+	)
+	switch {
+	case in.ChannelPoint != nil && len(in.PendingChanId) != 0:
+		return nil, fmt.Errorf("either pending_chan_id or " +
+			"channel_point must be set, not both")
+
+	case in.ChannelPoint != nil:
+		txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
+		if err != nil {
+			return nil, err
+		}
+		txid, err := chainhash.NewHash(txidHash)
+		if err != nil {
+			return nil, err
+		}
+		chanPoint = wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+		// As the funding transaction was published, we'll double spend
+		// its inputs at the requested fee rate.
+		feeRate, err = determineFeePerKw(
+			r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	case len(in.PendingChanId) == len(pendingChanID):
+		copy(pendingChanID[:], in.PendingChanId)
+
+	default:
+		return nil, fmt.Errorf("a 32 byte pending_chan_id or a " +
+			"channel_point must be set")
+	}
+
+	rpcsLog.Infof("[cancelpendingchannel] pending_chan_id=%x, "+
+		"chan_point=%v, fee_rate=%v", in.PendingChanId, chanPoint,
+		int64(feeRate))
+
+	cancelTxid, err := r.server.fundingMgr.CancelPendingChannel(
+		pendingChanID, chanPoint, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.CancelPendingChannelResponse{}
+	if cancelTxid != nil {
+		resp.CancelTxid = cancelTxid.String()
+	}
+
 	return resp, nil
 }
 
@@ -1763,56 +2299,28 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			continue
 		}
 
-		nodePub := dbChannel.RemotePub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
-
-		var closeType lnrpc.ChannelCloseSummary_ClosureType
-		switch dbChannel.CloseType {
-		case channeldb.CooperativeClose:
-			if filterResults && !in.Cooperative {
-				continue
-			}
-			closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
-		case channeldb.LocalForceClose:
-			if filterResults && !in.LocalForce {
-				continue
-			}
-			closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
-		case channeldb.RemoteForceClose:
-			if filterResults && !in.RemoteForce {
-				continue
-			}
-			closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
-		case channeldb.BreachClose:
-			if filterResults && !in.Breach {
-				continue
-			}
-			closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
-		case channeldb.FundingCanceled:
-			if filterResults && !in.FundingCanceled {
-				continue
+		if filterResults {
+			var include bool
+			switch dbChannel.CloseType {
+			case channeldb.CooperativeClose:
+				include = in.Cooperative
+			case channeldb.LocalForceClose:
+				include = in.LocalForce
+			case channeldb.RemoteForceClose:
+				include = in.RemoteForce
+			case channeldb.BreachClose:
+				include = in.Breach
+			case channeldb.FundingCanceled:
+				include = in.FundingCanceled
+			case channeldb.Abandoned:
+				include = in.Abandoned
 			}
-			closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
-		case channeldb.Abandoned:
-			if filterResults && !in.Abandoned {
+			if !include {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_ABANDONED
-		}
-
-		channel := &lnrpc.ChannelCloseSummary{
-			Capacity:          int64(dbChannel.Capacity),
-			RemotePubkey:      nodeID,
-			CloseHeight:       dbChannel.CloseHeight,
-			CloseType:         closeType,
-			ChannelPoint:      dbChannel.ChanPoint.String(),
-			ChanId:            dbChannel.ShortChanID.ToUint64(),
-			SettledBalance:    int64(dbChannel.SettledBalance),
-			TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
-			ChainHash:         dbChannel.ChainHash.String(),
-			ClosingTxHash:     dbChannel.ClosingTXID.String(),
 		}
 
+		channel := createRPCClosedChannel(dbChannel)
 		resp.Channels = append(resp.Channels, channel)
 	}
 
@@ -1847,33 +2355,10 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 		len(dbChannels))
 
 	for _, dbChannel := range dbChannels {
-		nodePub := dbChannel.IdentityPub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
-		chanPoint := dbChannel.FundingOutpoint
-
-		// With the channel point known, retrieve the network channel
-		// ID from the database.
-		var chanID uint64
-		chanID, _ = graph.ChannelID(&chanPoint)
-
-		var peerOnline bool
-		if _, err := r.server.FindPeer(nodePub); err == nil {
-			peerOnline = true
-		}
-
-		channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
-		var linkActive bool
-		if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
-			// A channel is only considered active if it is known
-			// by the switch *and* able to forward
-			// incoming/outgoing payments.
-			linkActive = link.EligibleToForward()
-		}
-
-		// Next, we'll determine whether we should add this channel to
-		// our list depending on the type of channels requested to us.
-		isActive := peerOnline && linkActive
-		isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		// Next, we'll determine whether we should add this channel to
+		// our list depending on the type of channels requested to us.
+		isActive := r.isChannelActive(dbChannel)
+		isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
 
 		// We'll only skip returning this channel if we were requested
 		// for a specific kind and this channel doesn't satisfy it.
@@ -1888,60 +2373,18 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			continue
 		}
 
-		// As this is required for display purposes, we'll calculate
-		// the weight of the commitment transaction. We also add on the
-		// estimated weight of the witness to calculate the weight of
-		// the transaction if it were to be immediately unilaterally
-		// broadcast.
-		localCommit := dbChannel.LocalCommitment
-		utx := btcutil.NewTx(localCommit.CommitTx)
-		commitBaseWeight := blockchain.GetTransactionWeight(utx)
-		commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
-
-		localBalance := localCommit.LocalBalance
-		remoteBalance := localCommit.RemoteBalance
-
-		// As an artifact of our usage of mSAT internally, either party
-		// may end up in a state where they're holding a fractional
-		// amount of satoshis which can't be expressed within the
-		// actual commitment output. Since we round down when going
-		// from mSAT -> SAT, we may at any point be adding an
-		// additional SAT to miners fees. As a result, we display a
-		// commitment fee that accounts for this externally.
-		var sumOutputs btcutil.Amount
-		for _, txOut := range localCommit.CommitTx.TxOut {
-			sumOutputs += btcutil.Amount(txOut.Value)
-		}
-		externalCommitFee := dbChannel.Capacity - sumOutputs
-
-		channel := &lnrpc.Channel{
-			Active:                isActive,
-			Private:               !isPublic,
-			RemotePubkey:          nodeID,
-			ChannelPoint:          chanPoint.String(),
-			ChanId:                chanID,
-			Capacity:              int64(dbChannel.Capacity),
-			LocalBalance:          int64(localBalance.ToSatoshis()),
-			RemoteBalance:         int64(remoteBalance.ToSatoshis()),
-			CommitFee:             int64(externalCommitFee),
-			CommitWeight:          commitWeight,
-			FeePerKw:              int64(localCommit.FeePerKw),
-			TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
-			TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
-			NumUpdates:            localCommit.CommitHeight,
-			PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
-			CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
-		}
-
-		for i, htlc := range localCommit.Htlcs {
-			var rHash [32]byte
-			copy(rHash[:], htlc.RHash[:])
-			channel.PendingHtlcs[i] = &lnrpc.HTLC{
-				Incoming:         htlc.Incoming,
-				Amount:           int64(htlc.Amt.ToSatoshis()),
-				HashLock:         rHash[:],
-				ExpirationHeight: htlc.RefundTimeout,
-			}
+		channel := createRPCOpenChannel(graph, dbChannel, isActive)
+
+		// If the channel has a link, we'll also report the metrics of
+		// the commitments it signs.
+		chanID := lnwire.NewChanIDFromOutPoint(
+			&dbChannel.FundingOutpoint,
+		)
+		link, err := r.server.htlcSwitch.GetLink(chanID)
+		if err == nil {
+			channel.CommitStats = marshallCommitStats(
+				link.CommitStats(),
+			)
 		}
 
 		resp.Channels = append(resp.Channels, channel)
@@ -1950,6 +2393,328 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	return resp, nil
 }
 
+// marshallCommitStats converts the commitment metrics of a link into their
+// RPC representation.
+func marshallCommitStats(
+	stats htlcswitch.CommitStats) *lnrpc.ChannelCommitStats {
+
+	return &lnrpc.ChannelCommitStats{
+		BatchIntervalMs: uint64(
+			stats.BatchInterval / time.Millisecond,
+		),
+		PendingUpdates:    stats.PendingUpdates,
+		NumCommits:        stats.NumCommits,
+		NumBatchedUpdates: stats.NumBatchedUpdates,
+		AvgCommitLatencyUs: uint64(
+			stats.AvgCommitLatency / time.Microsecond,
+		),
+		AvgRevocationLatencyUs: uint64(
+			stats.AvgRevocationLatency / time.Microsecond,
+		),
+	}
+}
+
+// isChannelActive returns true if the passed channel is active, meaning its
+// peer is online and its link is able to forward payments.
+func (r *rpcServer) isChannelActive(dbChannel *channeldb.OpenChannel) bool {
+	if _, err := r.server.FindPeer(dbChannel.IdentityPub); err != nil {
+		return false
+	}
+
+	// A channel is only considered active if it is known by the switch
+	// *and* able to forward incoming/outgoing payments.
+	channelID := lnwire.NewChanIDFromOutPoint(&dbChannel.FundingOutpoint)
+	link, err := r.server.htlcSwitch.GetLink(channelID)
+	if err != nil {
+		return false
+	}
+
+	return link.EligibleToForward()
+}
+
+// createRPCOpenChannel creates an *lnrpc.Channel from the
+// *channeldb.OpenChannel.
+func createRPCOpenChannel(graph *channeldb.ChannelGraph,
+	dbChannel *channeldb.OpenChannel, isActive bool) *lnrpc.Channel {
+
+	nodePub := dbChannel.IdentityPub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+	chanPoint := dbChannel.FundingOutpoint
+	isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+	// With the channel point known, retrieve the network channel ID from
+	// the database.
+	var chanID uint64
+	chanID, _ = graph.ChannelID(&chanPoint)
+
+	// As this is required for display purposes, we'll calculate the
+	// weight of the commitment transaction. We also add on the estimated
+	// weight of the witness to calculate the weight of the transaction if
+	// it were to be immediately unilaterally broadcast.
+	localCommit := dbChannel.LocalCommitment
+	utx := btcutil.NewTx(localCommit.CommitTx)
+	commitBaseWeight := blockchain.GetTransactionWeight(utx)
+	commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+
+	localBalance := localCommit.LocalBalance
+	remoteBalance := localCommit.RemoteBalance
+
+	// As an artifact of our usage of mSAT internally, either party may
+	// end up in a state where they're holding a fractional amount of
+	// satoshis which can't be expressed within the actual commitment
+	// output. Since we round down when going from mSAT -> SAT, we may at
+	// any point be adding an additional SAT to miners fees. As a result,
+	// we display a commitment fee that accounts for this externally.
+	var sumOutputs btcutil.Amount
+	for _, txOut := range localCommit.CommitTx.TxOut {
+		sumOutputs += btcutil.Amount(txOut.Value)
+	}
+	externalCommitFee := dbChannel.Capacity - sumOutputs
+
+	channel := &lnrpc.Channel{
+		Active:                isActive,
+		Private:               !isPublic,
+		RemotePubkey:          nodeID,
+		ChannelPoint:          chanPoint.String(),
+		ChanId:                chanID,
+		Capacity:              int64(dbChannel.Capacity),
+		LocalBalance:          int64(localBalance.ToSatoshis()),
+		RemoteBalance:         int64(remoteBalance.ToSatoshis()),
+		CommitFee:             int64(externalCommitFee),
+		CommitWeight:          commitWeight,
+		FeePerKw:              int64(localCommit.FeePerKw),
+		TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
+		TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
+		NumUpdates:            localCommit.CommitHeight,
+		PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
+		CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
+		ZeroConf:              dbChannel.IsZeroConf(),
+	}
+
+	// Zero-conf channels are identified by an alias until their funding
+	// transaction has confirmed.
+	if dbChannel.ShortChanID().IsAlias() {
+		channel.AliasScid = dbChannel.ShortChanID().ToUint64()
+	}
+
+	for i, htlc := range localCommit.Htlcs {
+		var rHash [32]byte
+		copy(rHash[:], htlc.RHash[:])
+		channel.PendingHtlcs[i] = &lnrpc.HTLC{
+			Incoming:         htlc.Incoming,
+			Amount:           int64(htlc.Amt.ToSatoshis()),
+			HashLock:         rHash[:],
+			ExpirationHeight: htlc.RefundTimeout,
+		}
+	}
+
+	return channel
+}
+
+// createRPCPendingOpenChannel creates an
+// *lnrpc.PendingChannelsResponse_PendingOpenChannel from the pending
+// *channeldb.OpenChannel.
+func createRPCPendingOpenChannel(
+	pendingChan *channeldb.OpenChannel) *lnrpc.PendingChannelsResponse_PendingOpenChannel {
+
+	pub := pendingChan.IdentityPub.SerializeCompressed()
+
+	// As this is required for display purposes, we'll calculate the
+	// weight of the commitment transaction. We also add on the estimated
+	// weight of the witness to calculate the weight of the transaction if
+	// it were to be immediately unilaterally broadcast.
+	// TODO(roasbeef): query for funding tx from wallet, display that also?
+	localCommitment := pendingChan.LocalCommitment
+	utx := btcutil.NewTx(localCommitment.CommitTx)
+	commitBaseWeight := blockchain.GetTransactionWeight(utx)
+	commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+
+	return &lnrpc.PendingChannelsResponse_PendingOpenChannel{
+		Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+			RemoteNodePub: hex.EncodeToString(pub),
+			ChannelPoint:  pendingChan.FundingOutpoint.String(),
+			Capacity:      int64(pendingChan.Capacity),
+			LocalBalance:  int64(localCommitment.LocalBalance.ToSatoshis()),
+			RemoteBalance: int64(localCommitment.RemoteBalance.ToSatoshis()),
+		},
+		CommitWeight: commitWeight,
+		CommitFee:    int64(localCommitment.CommitFee),
+		FeePerKw:     int64(localCommitment.FeePerKw),
+		// TODO(roasbeef): need to track confirmation height
+	}
+}
+
+// createRPCClosedChannel creates an *lnrpc.ChannelCloseSummary from the
+// *channeldb.ChannelCloseSummary.
+func createRPCClosedChannel(
+	dbChannel *channeldb.ChannelCloseSummary) *lnrpc.ChannelCloseSummary {
+
+	nodePub := dbChannel.RemotePub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+
+	var closeType lnrpc.ChannelCloseSummary_ClosureType
+	switch dbChannel.CloseType {
+	case channeldb.CooperativeClose:
+		closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
+	case channeldb.LocalForceClose:
+		closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
+	case channeldb.RemoteForceClose:
+		closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
+	case channeldb.BreachClose:
+		closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
+	case channeldb.FundingCanceled:
+		closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
+	case channeldb.Abandoned:
+		closeType = lnrpc.ChannelCloseSummary_ABANDONED
+	}
+
+	return &lnrpc.ChannelCloseSummary{
+		Capacity:          int64(dbChannel.Capacity),
+		RemotePubkey:      nodeID,
+		CloseHeight:       dbChannel.CloseHeight,
+		CloseType:         closeType,
+		ChannelPoint:      dbChannel.ChanPoint.String(),
+		ChanId:            dbChannel.ShortChanID.ToUint64(),
+		SettledBalance:    int64(dbChannel.SettledBalance),
+		TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
+		ChainHash:         dbChannel.ChainHash.String(),
+		ClosingTxHash:     dbChannel.ClosingTXID.String(),
+	}
+}
+
+// SubscribeChannelEvents returns a uni-directional stream (server -> client)
+// for notifying the client of changes in the state of our channels: new
+// channels pending open, channels becoming open, active or inactive, closing
+// transactions being detected on-chain, and closed channels being fully
+// resolved.
+func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeChannelEventsServer) error {
+
+	channelEventSub, err := r.server.channelNotifier.SubscribeChannelEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer channelEventSub.Cancel()
+
+	graph := r.server.chanDB.ChannelGraph()
+
+	for {
+		select {
+		// A new update has been sent by the channel notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-channelEventSub.Updates:
+			update, err := r.marshallChannelEvent(graph, e)
+			if err != nil {
+				rpcsLog.Errorf("unable to marshall channel "+
+					"event: %v", err)
+				continue
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallChannelEvent performs a mapping from an event sent by the channel
+// notifier to the form of update expected by the gRPC service. Events that
+// only reference a channel by its outpoint are populated with the full
+// description of the channel as found within the database.
+func (r *rpcServer) marshallChannelEvent(graph *channeldb.ChannelGraph,
+	event interface{}) (*lnrpc.ChannelEventUpdate, error) {
+
+	switch e := event.(type) {
+	case channelnotifier.PendingOpenChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_PENDING_OPEN_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_PendingOpenChannel{
+				PendingOpenChannel: createRPCPendingOpenChannel(
+					e.PendingChannel,
+				),
+			},
+		}, nil
+
+	case channelnotifier.OpenChannelEvent:
+		channel := createRPCOpenChannel(
+			graph, e.Channel, r.isChannelActive(e.Channel),
+		)
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_OPEN_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_OpenChannel{
+				OpenChannel: channel,
+			},
+		}, nil
+
+	case channelnotifier.ActiveChannelEvent:
+		dbChannel, err := r.fetchOpenDbChannel(*e.ChannelPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		channel := createRPCOpenChannel(graph, dbChannel, true)
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_ActiveChannel{
+				ActiveChannel: channel,
+			},
+		}, nil
+
+	case channelnotifier.InactiveChannelEvent:
+		// A channel closed while it was active may already be gone from
+		// the set of open channels, in which case we'll only report its
+		// channel point.
+		var channel *lnrpc.Channel
+		dbChannel, err := r.fetchOpenDbChannel(*e.ChannelPoint)
+		if err == nil {
+			channel = createRPCOpenChannel(graph, dbChannel, false)
+		} else {
+			channel = &lnrpc.Channel{
+				ChannelPoint: e.ChannelPoint.String(),
+			}
+		}
+
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_INACTIVE_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_InactiveChannel{
+				InactiveChannel: channel,
+			},
+		}, nil
+
+	case channelnotifier.ClosingChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_CLOSING_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_ClosingChannel{
+				ClosingChannel: createRPCClosedChannel(
+					e.CloseSummary,
+				),
+			},
+		}, nil
+
+	case channelnotifier.ClosedChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_CLOSED_CHANNEL,
+			Channel: &lnrpc.ChannelEventUpdate_ClosedChannel{
+				ClosedChannel: createRPCClosedChannel(
+					e.CloseSummary,
+				),
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected channel event: %T", event)
+	}
+}
+
 // savePayment saves a successfully completed payment to the database for
 // historical record keeping.
 func (r *rpcServer) savePayment(route *routing.Route,
@@ -2801,8 +3566,21 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				continue
 			}
 
+			// Zero-conf channels are identified by an alias, so
+			// we'll need to use the location of their funding
+			// output instead. Their edge is only added to the graph
+			// once the funding transaction has confirmed, so until
+			// then there's no policy to build a hint from.
+			realScid := channel.RealShortChanID()
+			if realScid == (lnwire.ShortChannelID{}) {
+				rpcsLog.Debugf("Skipping channel %v due to its "+
+					"funding transaction being unconfirmed",
+					chanPoint)
+				continue
+			}
+
 			// Fetch the policies for each end of the channel.
-			chanID := channel.ShortChanID().ToUint64()
+			chanID := realScid.ToUint64()
 			info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
 			if err != nil {
 				rpcsLog.Errorf("Unable to fetch the routing "+
@@ -3162,20 +3940,75 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 }
 
 // GetTransactions returns a list of describing all the known transactions
-// relevant to the wallet.
+// relevant to the wallet, optionally filtered by category, block height range
+// and label.
 func (r *rpcServer) GetTransactions(ctx context.Context,
-	_ *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+	req *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, fmt.Errorf("end height %v is below start height %v",
+			req.EndHeight, req.StartHeight)
+	}
 
-	// TODO(roasbeef): add pagination support
 	transactions, err := r.server.cc.wallet.ListTransactionDetails()
 	if err != nil {
 		return nil, err
 	}
 
-	txDetails := &lnrpc.TransactionDetails{
-		Transactions: make([]*lnrpc.Transaction, len(transactions)),
+	labels, err := r.server.chanDB.FetchTxLabels()
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[channeldb.TxCategory]struct{})
+	for _, category := range req.Categories {
+		categories[channeldb.TxCategory(category)] = struct{}{}
 	}
-	for i, tx := range transactions {
+
+	// Order the transactions by ascending block height, with unconfirmed
+	// transactions last, so that the index offsets are stable across
+	// calls as long as no new transactions arrive.
+	sort.SliceStable(transactions, func(i, j int) bool {
+		hi, hj := transactions[i].BlockHeight, transactions[j].BlockHeight
+		switch {
+		case hi == 0:
+			return false
+		case hj == 0:
+			return true
+		default:
+			return hi < hj
+		}
+	})
+
+	var matches []*lnrpc.Transaction
+	for _, tx := range transactions {
+		confirmed := tx.NumConfirmations > 0
+		switch {
+		case req.StartHeight != 0 && confirmed &&
+			tx.BlockHeight < req.StartHeight:
+			continue
+
+		case req.EndHeight != 0 && !confirmed:
+			continue
+
+		case req.EndHeight != 0 && tx.BlockHeight > req.EndHeight:
+			continue
+		}
+
+		label := labels[tx.Hash]
+		if label == nil {
+			label = &channeldb.TxLabel{}
+		}
+
+		if len(categories) != 0 {
+			if _, ok := categories[label.Category]; !ok {
+				continue
+			}
+		}
+		if req.Label != "" && label.Label != req.Label {
+			continue
+		}
+
 		var destAddresses []string
 		for _, destAddress := range tx.DestAddresses {
 			destAddresses = append(destAddresses, destAddress.EncodeAddress())
@@ -3188,7 +4021,7 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			blockHash = tx.BlockHash.String()
 		}
 
-		txDetails.Transactions[i] = &lnrpc.Transaction{
+		matches = append(matches, &lnrpc.Transaction{
 			TxHash:           tx.Hash.String(),
 			Amount:           int64(tx.Value),
 			NumConfirmations: tx.NumConfirmations,
@@ -3197,10 +4030,79 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TimeStamp:        tx.Timestamp,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
+			Label:            label.Label,
+			Category:         lnrpc.TransactionCategory(label.Category),
+		})
+	}
+
+	// Finally, we'll apply the requested pagination to the set of matching
+	// transactions.
+	numMatches := uint32(len(matches))
+	firstIndex := req.IndexOffset
+	if firstIndex > numMatches {
+		firstIndex = numMatches
+	}
+	lastIndex := numMatches
+	if req.MaxTransactions != 0 &&
+		firstIndex+req.MaxTransactions < numMatches {
+
+		lastIndex = firstIndex + req.MaxTransactions
+	}
+
+	return &lnrpc.TransactionDetails{
+		Transactions:      matches[firstIndex:lastIndex],
+		LastIndexOffset:   lastIndex,
+		TotalTransactions: numMatches,
+	}, nil
+}
+
+// LabelTransaction sets the label of an on-chain transaction known to the
+// wallet, keeping its category.
+func (r *rpcServer) LabelTransaction(ctx context.Context,
+	req *lnrpc.LabelTransactionRequest) (*lnrpc.LabelTransactionResponse,
+	error) {
+
+	txid, err := chainhash.NewHashFromStr(req.Txid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse txid: %v", err)
+	}
+
+	rpcsLog.Debugf("[labeltransaction] txid=%v, label=%v", txid,
+		req.Label)
+
+	// Only transactions known to the wallet may be labelled, otherwise
+	// we'd store labels that never show up in its transaction history.
+	known, err := r.walletHasTx(*txid)
+	if err != nil {
+		return nil, err
+	}
+	if !known {
+		return nil, fmt.Errorf("transaction %v not found in wallet",
+			txid)
+	}
+
+	if err := r.server.chanDB.SetTxLabel(*txid, req.Label); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.LabelTransactionResponse{}, nil
+}
+
+// walletHasTx returns whether the transaction with the given txid is part of
+// the wallet's transaction history.
+func (r *rpcServer) walletHasTx(txid chainhash.Hash) (bool, error) {
+	transactions, err := r.server.cc.wallet.ListTransactionDetails()
+	if err != nil {
+		return false, err
+	}
+
+	for _, tx := range transactions {
+		if tx.Hash == txid {
+			return true, nil
 		}
 	}
 
-	return txDetails, nil
+	return false, nil
 }
 
 // DescribeGraph returns a description of the latest graph state from the PoV
@@ -3305,6 +4207,7 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			FeeBaseMsat:      int64(c1.FeeBaseMSat),
 			FeeRateMilliMsat: int64(c1.FeeProportionalMillionths),
 			Disabled:         c1.Flags&lnwire.ChanUpdateDisabled != 0,
+			MaxHtlcMsat:      uint64(c1.MaxHTLC),
 		}
 	}
 
@@ -3315,6 +4218,7 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			FeeBaseMsat:      int64(c2.FeeBaseMSat),
 			FeeRateMilliMsat: int64(c2.FeeProportionalMillionths),
 			Disabled:         c2.Flags&lnwire.ChanUpdateDisabled != 0,
+			MaxHtlcMsat:      uint64(c2.MaxHTLC),
 		}
 	}
 
@@ -3854,6 +4758,7 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 				FeeBaseMsat:      int64(channelUpdate.BaseFee),
 				FeeRateMilliMsat: int64(channelUpdate.FeeRate),
 				Disabled:         channelUpdate.Disabled,
+				MaxHtlcMsat:      uint64(channelUpdate.MaxHTLC),
 			},
 			AdvertisingNode: encodeKey(channelUpdate.AdvertisingNode),
 			ConnectingNode:  encodeKey(channelUpdate.ConnectingNode),
@@ -4199,6 +5104,23 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		return nil, fmt.Errorf("unknown scope: %v", scope)
 	}
 
+	// If the caller asked to hand the fees of the target channel(s) back to
+	// the fee policy manager, we'll only remove the record of their manual
+	// policy. The manager will pick them up on its next evaluation.
+	if req.AutoFees {
+		rpcsLog.Debugf("[updatechanpolicy] handing fees back to the fee "+
+			"policy manager, targets=%v", spew.Sdump(targetChans))
+
+		err := r.server.chanDB.PutFeePolicyOverrides(
+			false, targetChans...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.PolicyUpdateResponse{}, nil
+	}
+
 	// As a sanity check, we'll ensure that the passed fee rate is below
 	// 1e-6, or the lowest allowed fee rate, and that the passed timelock
 	// is large enough.
@@ -4213,6 +5135,10 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 			minTimeLockDelta)
 	}
 
+	if req.ClearMaxHtlc && req.MaxHtlcMsat != 0 {
+		return nil, fmt.Errorf("max htlc can't be both set and cleared")
+	}
+
 	// We'll also need to convert the floating point fee rate we accept
 	// over RPC to the fixed point rate that we use within the protocol. We
 	// do this by multiplying the passed fee rate by the fee base. This
@@ -4225,45 +5151,93 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		FeeRate: feeRateFixed,
 	}
 
+	maxHtlc := lnwire.MilliSatoshi(req.MaxHtlcMsat)
 	chanPolicy := routing.ChannelPolicy{
 		FeeSchema:     feeSchema,
 		TimeLockDelta: req.TimeLockDelta,
+		MaxHTLC:       maxHtlc,
+		ClearMaxHTLC:  req.ClearMaxHtlc,
 	}
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
-		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, targets=%v",
-		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
-		spew.Sdump(targetChans))
+		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
+		"max_htlc=%v, clear_max_htlc=%v, targets=%v", req.BaseFeeMsat,
+		req.FeeRate, feeRateFixed, req.TimeLockDelta, maxHtlc,
+		req.ClearMaxHtlc, spew.Sdump(targetChans))
+
+	// Before advertising the new policy, we'll record that it was set
+	// manually, so the fee policy manager won't overwrite it. A global
+	// update sets the fees of all channels that are currently open, so
+	// they're all recorded, while channels opened later are still managed.
+	err := r.server.chanDB.PutFeePolicyOverrides(true, targetChans...)
+	if err != nil {
+		return nil, err
+	}
 
 	// With the scope resolved, we'll now send this to the
 	// AuthenticatedGossiper so it can propagate the new policy for our
 	// target channel(s).
-	err := r.server.authGossiper.PropagateChanPolicyUpdate(
+	err = r.server.authGossiper.PropagateChanPolicyUpdate(
 		chanPolicy, targetChans...,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Finally, we'll apply the set of active links amongst the target
-	// channels.
-	//
-	// We create a partially policy as the logic won't overwrite a valid
-	// sub-policy with a "nil" one.
-	p := htlcswitch.ForwardingPolicy{
-		BaseFee:       baseFeeMsat,
-		FeeRate:       lnwire.MilliSatoshi(feeRateFixed),
-		TimeLockDelta: req.TimeLockDelta,
+	// Finally, we'll apply the policies we just advertised to the active
+	// links amongst the target channels. These hold the maximum HTLC
+	// capped at the capacity of each channel, and we'll apply them as a
+	// whole, so a fee of zero or a cleared maximum HTLC takes effect.
+	if err := r.server.setLinkPolicies(targetChans...); err != nil {
+		return nil, err
 	}
-	err = r.server.htlcSwitch.UpdateForwardingPolicies(p, targetChans...)
+
+	return &lnrpc.PolicyUpdateResponse{}, nil
+}
+
+// UpdateChanStatus manually sets the status of a channel, or hands it back to
+// automatic management.
+func (r *rpcServer) UpdateChanStatus(ctx context.Context,
+	req *lnrpc.UpdateChanStatusRequest) (*lnrpc.UpdateChanStatusResponse,
+	error) {
+
+	if req.ChanPoint == nil {
+		return nil, fmt.Errorf("chan_point must be specified")
+	}
+	txidHash, err := getChanPointFundingTxid(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
 	if err != nil {
-		// If we're unable update the fees due to the links not being
-		// online, then we don't need to fail the call. We'll simply
-		// log the failure.
-		rpcsLog.Warnf("Unable to update link fees: %v", err)
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChanPoint.OutputIndex,
 	}
 
-	return &lnrpc.PolicyUpdateResponse{}, nil
+	var status channeldb.ChanStatusOverride
+	switch req.Action {
+	case lnrpc.UpdateChanStatusRequest_ENABLE:
+		status = channeldb.ChanStatusEnabled
+
+	case lnrpc.UpdateChanStatusRequest_DISABLE:
+		status = channeldb.ChanStatusDisabled
+
+	case lnrpc.UpdateChanStatusRequest_AUTO:
+		status = channeldb.ChanStatusAuto
+
+	default:
+		return nil, fmt.Errorf("unknown channel status action %v",
+			req.Action)
+	}
+
+	if err := r.server.updateChanStatus(chanPoint, status); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.UpdateChanStatusResponse{}, nil
 }
 
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
@@ -4354,3 +5328,911 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	return resp, nil
 }
+
+// ForwardingStats returns the fees earned, the volume and the number of
+// forwards within the target time range, grouped by channel or peer, and split
+// into time buckets.
+func (r *rpcServer) ForwardingStats(ctx context.Context,
+	req *lnrpc.ForwardingStatsRequest) (*lnrpc.ForwardingStatsResponse,
+	error) {
+
+	rpcsLog.Debugf("[forwardingstats]")
+
+	// Before we perform the queries below, we'll instruct the switch to
+	// flush any pending events to disk. This ensure we get a complete
+	// snapshot at this particular time.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	// If the start and end time were not set, then we'll just return the
+	// stats over the past 24 hours.
+	var startTime, endTime time.Time
+	if req.StartTime == 0 && req.EndTime == 0 {
+		now := time.Now()
+		startTime = now.Add(-time.Hour * 24)
+		endTime = now
+	} else {
+		startTime = time.Unix(int64(req.StartTime), 0)
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	statsQuery := channeldb.ForwardingStatsQuery{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		BucketSize: time.Duration(req.BucketSize) * time.Second,
+	}
+	stats, err := r.server.chanDB.ForwardingLog().QueryStats(statsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query forwarding stats: %v",
+			err)
+	}
+
+	// When grouping by peer, we'll need to know the peer of each channel,
+	// including those that have been closed since.
+	var chanPeers map[lnwire.ShortChannelID]string
+	if req.GroupBy == lnrpc.ForwardingStatsRequest_PEER {
+		chanPeers, err = r.fetchChannelPeers()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The stats are sorted by their bucket start, so we'll aggregate the
+	// stats of each bucket into its groups as we go.
+	resp := &lnrpc.ForwardingStatsResponse{}
+	type groupKey struct {
+		chanID uint64
+		peer   string
+	}
+	var (
+		bucket *lnrpc.ForwardingStatsBucket
+		groups map[groupKey]*lnrpc.ForwardingStatsGroup
+	)
+	for _, s := range stats {
+		bucketStart := uint64(s.BucketStart.Unix())
+		if bucket == nil || bucket.StartTime != bucketStart {
+			bucket = &lnrpc.ForwardingStatsBucket{
+				StartTime: bucketStart,
+			}
+			resp.Buckets = append(resp.Buckets, bucket)
+			groups = make(map[groupKey]*lnrpc.ForwardingStatsGroup)
+		}
+
+		var key groupKey
+		switch req.GroupBy {
+		case lnrpc.ForwardingStatsRequest_OUTGOING_CHANNEL:
+			key.chanID = s.OutgoingChanID.ToUint64()
+
+		case lnrpc.ForwardingStatsRequest_INCOMING_CHANNEL:
+			key.chanID = s.IncomingChanID.ToUint64()
+
+		case lnrpc.ForwardingStatsRequest_PEER:
+			key.peer = chanPeers[s.OutgoingChanID]
+
+		default:
+			return nil, fmt.Errorf("unknown group by: %v",
+				req.GroupBy)
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			group = &lnrpc.ForwardingStatsGroup{
+				ChanId:     key.chanID,
+				PeerPubKey: key.peer,
+			}
+			groups[key] = group
+			bucket.Groups = append(bucket.Groups, group)
+		}
+
+		group.NumForwards += s.NumForwards
+		group.VolumeMsat += uint64(s.AmtOut)
+		group.FeeMsat += uint64(s.Fees())
+	}
+
+	// Finally, we'll sort the groups of each bucket, so the response is
+	// deterministic.
+	for _, bucket := range resp.Buckets {
+		groups := bucket.Groups
+		sort.Slice(groups, func(i, j int) bool {
+			if groups[i].ChanId != groups[j].ChanId {
+				return groups[i].ChanId < groups[j].ChanId
+			}
+			return groups[i].PeerPubKey < groups[j].PeerPubKey
+		})
+	}
+
+	return resp, nil
+}
+
+// fetchChannelPeers returns the public key of the peer of each open and closed
+// channel, keyed by the channel's short channel ID. Zero-conf channels are
+// keyed by both their alias, which their forwards are recorded under, and the
+// location of their funding output once it has confirmed.
+func (r *rpcServer) fetchChannelPeers() (map[lnwire.ShortChannelID]string,
+	error) {
+
+	chanPeers := make(map[lnwire.ShortChannelID]string)
+
+	openChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range openChannels {
+		peer := hex.EncodeToString(
+			channel.IdentityPub.SerializeCompressed(),
+		)
+		chanPeers[channel.ShortChanID()] = peer
+
+		realScid := channel.RealShortChanID()
+		if realScid != (lnwire.ShortChannelID{}) {
+			chanPeers[realScid] = peer
+		}
+	}
+
+	closedChannels, err := r.server.chanDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range closedChannels {
+		chanPeers[channel.ShortChanID] = hex.EncodeToString(
+			channel.RemotePub.SerializeCompressed(),
+		)
+	}
+
+	return chanPeers, nil
+}
+
+// ListCircuits returns the payment circuits currently held by the switch,
+// which includes both open and half-added circuits.
+func (r *rpcServer) ListCircuits(ctx context.Context,
+	req *lnrpc.ListCircuitsRequest) (*lnrpc.ListCircuitsResponse, error) {
+
+	rpcsLog.Debugf("[listcircuits]")
+
+	chanID := lnwire.NewShortChanIDFromInt(req.ChanId)
+
+	resp := &lnrpc.ListCircuitsResponse{}
+	for _, circuit := range r.server.htlcSwitch.ListCircuits() {
+		// If a channel was specified, skip the circuits that don't
+		// pass through it.
+		if req.ChanId != 0 && circuit.Incoming.ChanID != chanID &&
+			(circuit.Outgoing == nil ||
+				circuit.Outgoing.ChanID != chanID) {
+
+			continue
+		}
+
+		rpcCircuit := &lnrpc.PaymentCircuit{
+			IncomingKey: &lnrpc.CircuitKey{
+				ChanId: circuit.Incoming.ChanID.ToUint64(),
+				HtlcId: circuit.Incoming.HtlcID,
+			},
+			HasKeystone:     circuit.HasKeystone(),
+			PaymentHash:     circuit.PaymentHash[:],
+			IncomingAmtMsat: uint64(circuit.IncomingAmount),
+			OutgoingAmtMsat: uint64(circuit.OutgoingAmount),
+		}
+		if circuit.HasKeystone() {
+			rpcCircuit.OutgoingKey = &lnrpc.CircuitKey{
+				ChanId: circuit.Outgoing.ChanID.ToUint64(),
+				HtlcId: circuit.Outgoing.HtlcID,
+			}
+		}
+
+		resp.Circuits = append(resp.Circuits, rpcCircuit)
+	}
+
+	return resp, nil
+}
+
+// ListForwardingPackages returns the forwarding packages of each channel that
+// haven't been fully acked yet.
+func (r *rpcServer) ListForwardingPackages(ctx context.Context,
+	req *lnrpc.ListForwardingPackagesRequest) (
+	*lnrpc.ListForwardingPackagesResponse, error) {
+
+	rpcsLog.Debugf("[listfwdpkgs]")
+
+	channels, err := r.server.chanDB.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListForwardingPackagesResponse{}
+	for _, channel := range channels {
+		// The forwarding packages of zero-conf channels are stored
+		// under their alias, but we'll report them under the location
+		// of the funding output once it has confirmed, as that's the
+		// ID the channel is listed by. Either is accepted as a filter.
+		alias := channel.ShortChanID()
+		chanID := channel.RealShortChanID()
+		if chanID == (lnwire.ShortChannelID{}) {
+			chanID = alias
+		}
+		if req.ChanId != 0 && chanID.ToUint64() != req.ChanId &&
+			alias.ToUint64() != req.ChanId {
+
+			continue
+		}
+
+		fwdPkgs, err := channel.LoadFwdPkgs()
+		if err != nil {
+			return nil, err
+		}
+
+		var packages []*lnrpc.ForwardingPackage
+		for _, fwdPkg := range fwdPkgs {
+			// Completed packages have been fully acked, and are
+			// only waiting to be removed.
+			if fwdPkg.State == channeldb.FwdStateCompleted {
+				continue
+			}
+
+			packages = append(packages, marshallFwdPkg(fwdPkg))
+		}
+		if len(packages) == 0 {
+			continue
+		}
+
+		resp.Channels = append(
+			resp.Channels, &lnrpc.ChannelForwardingPackages{
+				ChanId:       chanID.ToUint64(),
+				ChannelPoint: channel.FundingOutpoint.String(),
+				Packages:     packages,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallFwdPkg converts a forwarding package into its RPC representation,
+// counting the entries of the package that have been forwarded and acked.
+func marshallFwdPkg(fwdPkg *channeldb.FwdPkg) *lnrpc.ForwardingPackage {
+	// countSet returns the number of the first n entries of the filter
+	// that are set. Filters that haven't been written yet are empty.
+	countSet := func(filter *channeldb.PkgFilter, n int) uint32 {
+		if filter == nil {
+			return 0
+		}
+
+		var count uint32
+		for i := 0; i < n; i++ {
+			if filter.Contains(uint16(i)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	var state lnrpc.ForwardingPackage_State
+	switch fwdPkg.State {
+	case channeldb.FwdStateLockedIn:
+		state = lnrpc.ForwardingPackage_LOCKED_IN
+	case channeldb.FwdStateProcessed:
+		state = lnrpc.ForwardingPackage_PROCESSED
+	case channeldb.FwdStateCompleted:
+		state = lnrpc.ForwardingPackage_COMPLETED
+	}
+
+	numAdds := len(fwdPkg.Adds)
+	numSettleFails := len(fwdPkg.SettleFails)
+
+	return &lnrpc.ForwardingPackage{
+		Height:           fwdPkg.Height,
+		State:            state,
+		NumAdds:          uint32(numAdds),
+		NumAddsForwarded: countSet(fwdPkg.FwdFilter, numAdds),
+		NumAddsAcked:     countSet(fwdPkg.AckFilter, numAdds),
+		NumSettleFails:   uint32(numSettleFails),
+		NumSettleFailsAcked: countSet(
+			fwdPkg.SettleFailFilter, numSettleFails,
+		),
+	}
+}
+
+// FailStuckHtlc fails back an incoming HTLC whose circuit is stuck after its
+// outgoing channel has been closed. To make sure the outgoing HTLC can't be
+// settled anymore, the outgoing channel must be fully resolved on-chain, and
+// the switch refuses to fail back HTLCs whose preimage is known.
+func (r *rpcServer) FailStuckHtlc(ctx context.Context,
+	req *lnrpc.FailStuckHtlcRequest) (*lnrpc.FailStuckHtlcResponse, error) {
+
+	if req.IncomingKey == nil {
+		return nil, fmt.Errorf("incoming_key must be specified")
+	}
+	inKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(req.IncomingKey.ChanId),
+		HtlcID: req.IncomingKey.HtlcId,
+	}
+
+	rpcsLog.Infof("[failstuckhtlc] incoming_key=%v", inKey)
+
+	var circuit *htlcswitch.PaymentCircuit
+	for _, c := range r.server.htlcSwitch.ListCircuits() {
+		if c.Incoming == inKey {
+			circuit = c
+			break
+		}
+	}
+	if circuit == nil {
+		return nil, htlcswitch.ErrUnknownCircuit
+	}
+	if !circuit.HasKeystone() {
+		return nil, htlcswitch.ErrCircuitNotForwarded
+	}
+	outChanID := circuit.Outgoing.ChanID
+
+	// The outgoing channel must have been closed, and all of its contracts
+	// resolved on-chain. Otherwise the outgoing HTLC may still be settled
+	// by the remote peer, in which case failing back the incoming HTLC
+	// would lose us the funds of the forward.
+	closedChannels, err := r.server.chanDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	var closeSummary *channeldb.ChannelCloseSummary
+	for _, channel := range closedChannels {
+		if channel.ShortChanID == outChanID {
+			closeSummary = channel
+			break
+		}
+	}
+	switch {
+	case closeSummary == nil:
+		return nil, fmt.Errorf("outgoing channel %v is not closed",
+			outChanID)
+
+	case closeSummary.IsPending:
+		return nil, fmt.Errorf("outgoing channel %v is not fully "+
+			"resolved on-chain", outChanID)
+	}
+
+	err = r.server.htlcSwitch.FailStuckCircuit(inKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.FailStuckHtlcResponse{}, nil
+}
+
+// ReplayLogInfo returns the number of entries in the sphinx replay log, the
+// lowest CLTV among them and the size of the log on disk.
+func (r *rpcServer) ReplayLogInfo(ctx context.Context,
+	req *lnrpc.ReplayLogInfoRequest) (*lnrpc.ReplayLogInfoResponse, error) {
+
+	stats, err := r.server.replayLog.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ReplayLogInfoResponse{
+		NumEntries: stats.NumEntries,
+		OldestCltv: stats.OldestCLTV,
+		NumBatches: stats.NumBatches,
+		DiskUsage:  stats.DiskUsage,
+	}, nil
+}
+
+// CompactReplayLog rewrites the database of the sphinx replay log to reclaim
+// the disk space freed by expired entries.
+func (r *rpcServer) CompactReplayLog(ctx context.Context,
+	req *lnrpc.CompactReplayLogRequest) (*lnrpc.CompactReplayLogResponse,
+	error) {
+
+	rpcsLog.Infof("[compactreplaylog] compacting sphinx replay log")
+
+	before, after, err := r.server.replayLog.Compact()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CompactReplayLogResponse{
+		SizeBefore: before,
+		SizeAfter:  after,
+	}, nil
+}
+
+// HtlcInterceptor dispatches a bi-directional streaming RPC in which every HTLC
+// the switch is asked to forward is held and sent to the client, which
+// responds with whether the HTLC should be resumed, settled or failed.
+func (r *rpcServer) HtlcInterceptor(
+	stream lnrpc.Lightning_HtlcInterceptorServer) error {
+
+	// The switch hands us held forwards while it processes HTLCs, so we
+	// queue them to send them to the client without blocking it.
+	fwdQueue := queue.NewConcurrentQueue(10)
+	fwdQueue.Start()
+	defer fwdQueue.Stop()
+
+	err := r.server.htlcSwitch.SetInterceptor(
+		func(pkt htlcswitch.InterceptedPacket) {
+			fwdQueue.ChanIn() <- pkt
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer r.server.htlcSwitch.RemoveInterceptor()
+
+	rpcsLog.Infof("[htlcinterceptor] client connected")
+
+	quit := make(chan struct{})
+	defer close(quit)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		for {
+			select {
+			case item := <-fwdQueue.ChanOut():
+				pkt := item.(htlcswitch.InterceptedPacket)
+				err := stream.Send(&lnrpc.ForwardHtlcInterceptRequest{
+					IncomingCircuitKey: &lnrpc.CircuitKey{
+						ChanId: pkt.IncomingCircuit.ChanID.ToUint64(),
+						HtlcId: pkt.IncomingCircuit.HtlcID,
+					},
+					IncomingAmountMsat:      uint64(pkt.IncomingAmount),
+					IncomingExpiry:          pkt.IncomingExpiry,
+					PaymentHash:             pkt.Hash[:],
+					OutgoingRequestedChanId: pkt.OutgoingChanID.ToUint64(),
+					OutgoingAmountMsat:      uint64(pkt.OutgoingAmount),
+					OutgoingExpiry:          pkt.OutgoingExpiry,
+					HoldDeadline:            pkt.Deadline.Unix(),
+				})
+				if err != nil {
+					rpcsLog.Errorf("[htlcinterceptor] unable to "+
+						"send held forward: %v", err)
+					return
+				}
+
+			case <-quit:
+				return
+
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if resp.IncomingCircuitKey == nil {
+			return errors.New("incoming circuit key must be set")
+		}
+		inKey := htlcswitch.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(
+				resp.IncomingCircuitKey.ChanId,
+			),
+			HtlcID: resp.IncomingCircuitKey.HtlcId,
+		}
+
+		err = r.resolveHeldForward(inKey, resp)
+		if err != nil {
+			rpcsLog.Warnf("[htlcinterceptor] unable to resolve "+
+				"held forward %v: %v", inKey, err)
+		}
+	}
+}
+
+// resolveHeldForward applies the interceptor's decision on the held forward
+// identified by the given incoming circuit key.
+func (r *rpcServer) resolveHeldForward(inKey htlcswitch.CircuitKey,
+	resp *lnrpc.ForwardHtlcInterceptResponse) error {
+
+	htlcSwitch := r.server.htlcSwitch
+
+	switch resp.Action {
+	case lnrpc.ForwardHtlcInterceptResponse_RESUME:
+		return htlcSwitch.ResumeHeldForward(inKey)
+
+	case lnrpc.ForwardHtlcInterceptResponse_SETTLE:
+		var preimage [32]byte
+		if len(resp.Preimage) != len(preimage) {
+			return fmt.Errorf("preimage must be %v bytes",
+				len(preimage))
+		}
+		copy(preimage[:], resp.Preimage)
+
+		return htlcSwitch.SettleHeldForward(inKey, preimage)
+
+	case lnrpc.ForwardHtlcInterceptResponse_FAIL:
+		pkt, err := htlcSwitch.HeldForward(inKey)
+		if err != nil {
+			return err
+		}
+
+		failure, err := r.interceptFailure(
+			resp.FailureCode, pkt.OutgoingChanID,
+		)
+		if err != nil {
+			return err
+		}
+
+		return htlcSwitch.FailHeldForward(inKey, failure)
+
+	default:
+		return fmt.Errorf("unknown resolve action %v", resp.Action)
+	}
+}
+
+// interceptFailure maps the failure code chosen by the interceptor to the
+// failure sent back to the sender of a held forward requested to be
+// forwarded over the given channel.
+func (r *rpcServer) interceptFailure(
+	code lnrpc.ForwardHtlcInterceptResponse_FailureCode,
+	outgoingChanID lnwire.ShortChannelID) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case lnrpc.ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE,
+		lnrpc.ForwardHtlcInterceptResponse_CHANNEL_DISABLED:
+
+		// Both failures carry our latest update of the outgoing
+		// channel. If we can't find it, we'll fall back to a failure
+		// that doesn't require it.
+		update, err := r.server.fetchLastChanUpdate()(outgoingChanID)
+		if err != nil {
+			return &lnwire.FailTemporaryNodeFailure{}, nil
+		}
+
+		if code == lnrpc.ForwardHtlcInterceptResponse_CHANNEL_DISABLED {
+			return lnwire.NewChannelDisabled(0, *update), nil
+		}
+		return lnwire.NewTemporaryChannelFailure(update), nil
+
+	case lnrpc.ForwardHtlcInterceptResponse_TEMPORARY_NODE_FAILURE:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnrpc.ForwardHtlcInterceptResponse_PERMANENT_NODE_FAILURE:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnrpc.ForwardHtlcInterceptResponse_PERMANENT_CHANNEL_FAILURE:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	case lnrpc.ForwardHtlcInterceptResponse_UNKNOWN_NEXT_PEER:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnrpc.ForwardHtlcInterceptResponse_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS:
+		return &lnwire.FailUnknownPaymentHash{}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown failure code %v", code)
+	}
+}
+
+// SubscribeHtlcEvents returns a uni-directional stream (server -> client) for
+// notifying the client of htlcs being forwarded, settled and failed by our
+// node, along with the reason each failed htlc was failed.
+func (r *rpcServer) SubscribeHtlcEvents(req *lnrpc.SubscribeHtlcEventsRequest,
+	updateStream lnrpc.Lightning_SubscribeHtlcEventsServer) error {
+
+	htlcEventSub, err := r.server.htlcNotifier.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer htlcEventSub.Cancel()
+
+	for {
+		select {
+		// A new event has been sent by the htlc notifier, we'll marshal
+		// it into the form expected by the gRPC client, then send it
+		// off to the client.
+		case e := <-htlcEventSub.Updates:
+			event, err := marshallHtlcEvent(e)
+			if err != nil {
+				rpcsLog.Errorf("unable to marshall htlc "+
+					"event: %v", err)
+				continue
+			}
+
+			if err := updateStream.Send(event); err != nil {
+				return err
+			}
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallHtlcEvent performs a mapping from an event sent by the htlc notifier
+// to the form of event expected by the gRPC service.
+func marshallHtlcEvent(event interface{}) (*lnrpc.HtlcEvent, error) {
+	var (
+		key       htlcswitch.HtlcKey
+		eventType htlcswitch.HtlcEventType
+		timestamp time.Time
+		rpcEvent  = &lnrpc.HtlcEvent{}
+	)
+
+	switch e := event.(type) {
+	case htlcswitch.ForwardingEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.Event = &lnrpc.HtlcEvent_ForwardEvent{
+			ForwardEvent: &lnrpc.ForwardEvent{
+				Info: marshallHtlcInfo(e.HtlcInfo),
+			},
+		}
+
+	case htlcswitch.ForwardingFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		failEvent := &lnrpc.ForwardFailEvent{
+			Info: marshallHtlcInfo(e.HtlcInfo),
+		}
+		if e.FailureMessage != nil {
+			failEvent.WireFailureCode = uint32(e.FailureMessage.Code())
+			failEvent.WireFailure = e.FailureMessage.Error()
+		}
+		rpcEvent.Event = &lnrpc.HtlcEvent_ForwardFailEvent{
+			ForwardFailEvent: failEvent,
+		}
+
+	case htlcswitch.SettleEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.Event = &lnrpc.HtlcEvent_SettleEvent{
+			SettleEvent: &lnrpc.SettleEvent{
+				Info: marshallHtlcInfo(e.HtlcInfo),
+			},
+		}
+
+	case htlcswitch.LinkFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		detail, err := marshallFailureDetail(e.LinkError.FailureDetail)
+		if err != nil {
+			return nil, err
+		}
+		wireMsg := e.LinkError.WireMessage()
+		rpcEvent.Event = &lnrpc.HtlcEvent_LinkFailEvent{
+			LinkFailEvent: &lnrpc.LinkFailEvent{
+				Info:            marshallHtlcInfo(e.HtlcInfo),
+				WireFailureCode: uint32(wireMsg.Code()),
+				WireFailure:     wireMsg.Error(),
+				FailureDetail:   detail,
+				FailureString:   e.LinkError.FailureDetail.String(),
+				Incoming:        e.Incoming,
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unexpected htlc event: %v", event)
+	}
+
+	switch eventType {
+	case htlcswitch.HtlcEventTypeSend:
+		rpcEvent.EventType = lnrpc.HtlcEvent_SEND
+
+	case htlcswitch.HtlcEventTypeReceive:
+		rpcEvent.EventType = lnrpc.HtlcEvent_RECEIVE
+
+	case htlcswitch.HtlcEventTypeForward:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FORWARD
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event type: %v", eventType)
+	}
+
+	rpcEvent.IncomingChannelId = key.IncomingCircuit.ChanID.ToUint64()
+	rpcEvent.IncomingHtlcId = key.IncomingCircuit.HtlcID
+	rpcEvent.OutgoingChannelId = key.OutgoingCircuit.ChanID.ToUint64()
+	rpcEvent.OutgoingHtlcId = key.OutgoingCircuit.HtlcID
+	rpcEvent.TimestampNs = uint64(timestamp.UnixNano())
+
+	return rpcEvent, nil
+}
+
+// marshallHtlcInfo converts the amounts and timelocks of an htlc event into
+// their rpc form.
+func marshallHtlcInfo(info htlcswitch.HtlcInfo) *lnrpc.HtlcInfo {
+	return &lnrpc.HtlcInfo{
+		IncomingTimelock: info.IncomingTimeLock,
+		OutgoingTimelock: info.OutgoingTimeLock,
+		IncomingAmtMsat:  uint64(info.IncomingAmt),
+		OutgoingAmtMsat:  uint64(info.OutgoingAmt),
+	}
+}
+
+// marshallFailureDetail converts the local reason an htlc was failed into its
+// rpc form.
+func marshallFailureDetail(detail htlcswitch.FailureDetail) (
+	lnrpc.LinkFailEvent_FailureDetail, error) {
+
+	switch detail {
+	case htlcswitch.FailureDetailNone:
+		return lnrpc.LinkFailEvent_NONE, nil
+
+	case htlcswitch.FailureDetailLinkNotEligible:
+		return lnrpc.LinkFailEvent_LINK_NOT_ELIGIBLE, nil
+
+	case htlcswitch.FailureDetailInsufficientBalance:
+		return lnrpc.LinkFailEvent_INSUFFICIENT_BALANCE, nil
+
+	case htlcswitch.FailureDetailMaxPendingAmount:
+		return lnrpc.LinkFailEvent_MAX_PENDING_AMOUNT, nil
+
+	case htlcswitch.FailureDetailBelowMinHtlc:
+		return lnrpc.LinkFailEvent_BELOW_MIN_HTLC, nil
+
+	case htlcswitch.FailureDetailHtlcAddFailed:
+		return lnrpc.LinkFailEvent_HTLC_ADD_FAILED, nil
+
+	case htlcswitch.FailureDetailIncompleteForward:
+		return lnrpc.LinkFailEvent_INCOMPLETE_FORWARD, nil
+
+	case htlcswitch.FailureDetailInvoiceNotFound:
+		return lnrpc.LinkFailEvent_INVOICE_NOT_FOUND, nil
+
+	case htlcswitch.FailureDetailOnionEncode:
+		return lnrpc.LinkFailEvent_ONION_ENCODE, nil
+
+	case htlcswitch.FailureDetailInterceptorTimeout:
+		return lnrpc.LinkFailEvent_INTERCEPTOR_TIMEOUT, nil
+
+	case htlcswitch.FailureDetailInterceptorFail:
+		return lnrpc.LinkFailEvent_INTERCEPTOR_FAIL, nil
+
+	case htlcswitch.FailureDetailChannelLimit:
+		return lnrpc.LinkFailEvent_CHANNEL_LIMIT, nil
+
+	case htlcswitch.FailureDetailPeerLimit:
+		return lnrpc.LinkFailEvent_PEER_LIMIT, nil
+
+	case htlcswitch.FailureDetailRateLimit:
+		return lnrpc.LinkFailEvent_RATE_LIMIT, nil
+
+	case htlcswitch.FailureDetailQueueTimeout:
+		return lnrpc.LinkFailEvent_QUEUE_TIMEOUT, nil
+
+	default:
+		return 0, fmt.Errorf("unknown failure detail: %v", detail)
+	}
+}
+
+// ForwardingLimits returns the limits on the forwards a single incoming
+// channel or peer may have in flight through the switch, along with the
+// current usage of each incoming channel and peer.
+func (r *rpcServer) ForwardingLimits(ctx context.Context,
+	req *lnrpc.ForwardingLimitsRequest) (*lnrpc.ForwardingLimitsResponse,
+	error) {
+
+	limits := r.server.htlcSwitch.ForwardingLimits()
+	channels, peers := r.server.htlcSwitch.ForwardingLimitUsage()
+
+	resp := &lnrpc.ForwardingLimitsResponse{
+		Limits:   marshallForwardingLimits(limits),
+		Channels: make([]*lnrpc.ChannelLimitUsage, 0, len(channels)),
+		Peers:    make([]*lnrpc.PeerLimitUsage, 0, len(peers)),
+	}
+
+	for chanID, usage := range channels {
+		resp.Channels = append(resp.Channels, &lnrpc.ChannelLimitUsage{
+			ChanId: chanID.ToUint64(),
+			Usage:  marshallLimitUsage(usage),
+		})
+	}
+	sort.Slice(resp.Channels, func(i, j int) bool {
+		return resp.Channels[i].ChanId < resp.Channels[j].ChanId
+	})
+
+	for peer, usage := range peers {
+		// Forwards whose incoming link couldn't be found are tracked
+		// under an empty public key.
+		var pubKey string
+		if peer != ([33]byte{}) {
+			pubKey = hex.EncodeToString(peer[:])
+		}
+
+		resp.Peers = append(resp.Peers, &lnrpc.PeerLimitUsage{
+			PubKey: pubKey,
+			Usage:  marshallLimitUsage(usage),
+		})
+	}
+	sort.Slice(resp.Peers, func(i, j int) bool {
+		return resp.Peers[i].PubKey < resp.Peers[j].PubKey
+	})
+
+	return resp, nil
+}
+
+// UpdateForwardingLimits replaces the limits on the forwards a single
+// incoming channel or peer may have in flight through the switch.
+func (r *rpcServer) UpdateForwardingLimits(ctx context.Context,
+	req *lnrpc.UpdateForwardingLimitsRequest) (
+	*lnrpc.UpdateForwardingLimitsResponse, error) {
+
+	if req.Limits == nil {
+		return nil, fmt.Errorf("limits must be specified")
+	}
+
+	var mode htlcswitch.LimitMode
+	switch req.Limits.Mode {
+	case lnrpc.ForwardLimits_FAIL:
+		mode = htlcswitch.LimitModeFail
+
+	case lnrpc.ForwardLimits_QUEUE:
+		mode = htlcswitch.LimitModeQueue
+
+	default:
+		return nil, fmt.Errorf("unknown limit mode %v",
+			req.Limits.Mode)
+	}
+
+	limits := htlcswitch.ForwardingLimits{
+		MaxChannelHtlcs: req.Limits.MaxChanHtlcs,
+		MaxChannelAmount: lnwire.MilliSatoshi(
+			req.Limits.MaxChanAmtMsat,
+		),
+		MaxPeerHtlcs:  req.Limits.MaxPeerHtlcs,
+		MaxPeerAmount: lnwire.MilliSatoshi(req.Limits.MaxPeerAmtMsat),
+		PeerRate:      req.Limits.PeerRate,
+		PeerBurst:     req.Limits.PeerBurst,
+		Mode:          mode,
+		QueueTimeout: time.Duration(req.Limits.QueueTimeoutMs) *
+			time.Millisecond,
+		MaxQueued: req.Limits.MaxQueued,
+	}
+
+	err := r.server.htlcSwitch.UpdateForwardingLimits(limits)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Updated forwarding limits: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(limits)
+		}))
+
+	return &lnrpc.UpdateForwardingLimitsResponse{}, nil
+}
+
+// marshallForwardingLimits converts the switch's forwarding limits into their
+// rpc form.
+func marshallForwardingLimits(
+	limits htlcswitch.ForwardingLimits) *lnrpc.ForwardLimits {
+
+	mode := lnrpc.ForwardLimits_FAIL
+	if limits.Mode == htlcswitch.LimitModeQueue {
+		mode = lnrpc.ForwardLimits_QUEUE
+	}
+
+	return &lnrpc.ForwardLimits{
+		MaxChanHtlcs:   limits.MaxChannelHtlcs,
+		MaxChanAmtMsat: uint64(limits.MaxChannelAmount),
+		MaxPeerHtlcs:   limits.MaxPeerHtlcs,
+		MaxPeerAmtMsat: uint64(limits.MaxPeerAmount),
+		PeerRate:       limits.PeerRate,
+		PeerBurst:      limits.PeerBurst,
+		Mode:           mode,
+		QueueTimeoutMs: uint64(
+			limits.QueueTimeout / time.Millisecond,
+		),
+		MaxQueued: limits.MaxQueued,
+	}
+}
+
+// marshallLimitUsage converts the usage of an incoming channel or peer into
+// its rpc form.
+func marshallLimitUsage(usage htlcswitch.LimitUsage) *lnrpc.LimitUsage {
+	return &lnrpc.LimitUsage{
+		PendingHtlcs:   usage.PendingHtlcs,
+		PendingAmtMsat: uint64(usage.PendingAmount),
+		Queued:         usage.Queued,
+		Admitted:       usage.Admitted,
+		Rejected:       usage.Rejected,
+		RateLimited:    usage.RateLimited,
+	}
+}
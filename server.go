@@ -28,9 +28,12 @@ import (
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
@@ -143,6 +146,10 @@ type server struct {
 
 	fundingMgr *fundingManager
 
+	// chanAcceptor holds the channel acceptors registered over RPC, which
+	// decide whether inbound channels are accepted.
+	chanAcceptor *chanacceptor.ChainedAcceptor
+
 	chanDB *channeldb.DB
 
 	htlcSwitch *htlcswitch.Switch
@@ -161,8 +168,20 @@ type server struct {
 
 	chainArb *contractcourt.ChainArbitrator
 
+	channelNotifier *channelnotifier.ChannelNotifier
+
+	htlcNotifier *htlcswitch.HtlcNotifier
+
+	// feePolicyMgr adjusts the fees of our channels, if automatic fee
+	// adjustment is enabled.
+	feePolicyMgr *feepolicy.Manager
+
 	sphinx *htlcswitch.OnionProcessor
 
+	// replayLog is the sphinx router's log of processed onion packets,
+	// which is exposed for monitoring and compaction.
+	replayLog htlcswitch.ReplayLog
+
 	connMgr *connmgr.ConnManager
 
 	// globalFeatures feature vector which affects HTLCs and thus are also
@@ -176,7 +195,13 @@ type server struct {
 
 	// sendDisabled is used to keep track of the disabled flag of the last
 	// sent ChannelUpdate from announceChanStatus.
-	sentDisabled    map[wire.OutPoint]bool
+	sentDisabled map[wire.OutPoint]bool
+
+	// chanStatusOverrides holds the statuses the user manually set for
+	// channels, which take precedence over their automatic status.
+	chanStatusOverrides map[wire.OutPoint]channeldb.ChanStatusOverride
+
+	// sentDisabledMtx guards sentDisabled and chanStatusOverrides.
 	sentDisabledMtx sync.Mutex
 
 	quit chan struct{}
@@ -258,13 +283,25 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	// the same directory as the channel graph database.
 	graphDir := chanDB.Path()
 	sharedSecretPath := filepath.Join(graphDir, "sphinxreplay.db")
-	replayLog := htlcswitch.NewDecayedLog(sharedSecretPath, cc.chainNotifier)
+	var replayLog htlcswitch.ReplayLog
+	switch cfg.SphinxReplay.Backend {
+	case "hybrid":
+		replayLog = htlcswitch.NewHybridReplayLog(
+			sharedSecretPath, cc.chainNotifier,
+		)
+	default:
+		replayLog = htlcswitch.NewDecayedLog(
+			sharedSecretPath, cc.chainNotifier,
+		)
+	}
 	sphinxRouter := sphinx.NewRouter(privKey, activeNetParams.Params, replayLog)
 
 	s := &server{
 		chanDB: chanDB,
 		cc:     cc,
 
+		replayLog: replayLog,
+
 		invoices: newInvoiceRegistry(chanDB),
 
 		identityPriv: privKey,
@@ -315,6 +352,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	s.channelNotifier = channelnotifier.New()
+	s.htlcNotifier = htlcswitch.NewHtlcNotifier()
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:      chanDB,
 		SelfKey: s.identityPriv.PubKey(),
@@ -349,11 +389,32 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		LimitQueueTicker: ticker.New(
+			htlcswitch.DefaultLimitQueueInterval),
+		NotifyActiveChannel:   s.channelNotifier.NotifyActiveChannelEvent,
+		NotifyInactiveChannel: s.channelNotifier.NotifyInactiveChannelEvent,
+		NotifyHtlcEvent:       s.htlcNotifier.NotifyHtlcEvent,
+		PreimageCache:         s.witnessBeacon,
+		InterceptorTimeout:    cfg.InterceptorTimeout,
+		ForwardingLimits:      cfg.ForwardLimits.forwardingLimits(),
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
 	}
 
+	// Restore the statuses the user set for channels, so that channels
+	// they disabled remain closed to new forwards across restarts.
+	s.chanStatusOverrides, err = chanDB.FetchChanStatusOverrides()
+	if err != nil {
+		return nil, err
+	}
+	for op, status := range s.chanStatusOverrides {
+		if status == channeldb.ChanStatusDisabled {
+			chanID := lnwire.NewChanIDFromOutPoint(&op)
+			s.htlcSwitch.SetLinkDisabled(chanID, true)
+		}
+	}
+
 	// If enabled, use either UPnP or NAT-PMP to automatically configure
 	// port forwarding for users behind a NAT.
 	if cfg.NAT {
@@ -598,7 +659,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		Signer: cc.wallet.Cfg.Signer,
+		Signer:             cc.wallet.Cfg.Signer,
+		ListUnspentWitness: cc.wallet.ListUnspentWitness,
+		LockOutpoint:       cc.wallet.LockOutpoint,
+		UnlockOutpoint:     cc.wallet.UnlockOutpoint,
+		FetchInputInfo:     cc.wallet.FetchInputInfo,
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
@@ -608,7 +673,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		FetchClosedChannels: chanDB.FetchClosedChannels,
 		FetchClosedChannel:  chanDB.FetchClosedChannel,
 		Notifier:            cc.chainNotifier,
-		PublishTransaction:  cc.wallet.PublishTransaction,
+		PublishTransaction:  cc.wallet.PublishLabelledTransaction,
 		Store:               utxnStore,
 		Sweeper:             sweeper,
 	})
@@ -618,7 +683,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -633,7 +698,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		NewSweepAddr: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		PublishTx: cc.wallet.PublishTransaction,
+		PublishTx: cc.wallet.PublishLabelledTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
 				err := s.htlcSwitch.ProcessContractResolution(msg)
@@ -702,7 +767,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
-		Sweeper: sweeper,
+		Sweeper:              sweeper,
+		NotifyClosingChannel: s.channelNotifier.NotifyClosingChannelEvent,
+		NotifyClosedChannel:  s.channelNotifier.NotifyClosedChannelEvent,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -712,11 +779,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		Notifier:           cc.chainNotifier,
-		PublishTransaction: cc.wallet.PublishTransaction,
-		ContractBreaches:   contractBreaches,
-		Signer:             cc.wallet.Cfg.Signer,
-		Store:              newRetributionStore(chanDB),
+		Notifier:            cc.chainNotifier,
+		PublishTransaction:  cc.wallet.PublishLabelledTransaction,
+		ContractBreaches:    contractBreaches,
+		Signer:              cc.wallet.Cfg.Signer,
+		Store:               newRetributionStore(chanDB),
+		NotifyClosedChannel: s.channelNotifier.NotifyClosedChannelEvent,
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -736,10 +804,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	if _, err := rand.Read(chanIDSeed[:]); err != nil {
 		return nil, err
 	}
+	s.chanAcceptor = chanacceptor.NewChainedAcceptor()
 	s.fundingMgr, err = newFundingManager(fundingConfig{
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
-		PublishTransaction: cc.wallet.PublishTransaction,
+		PublishTransaction: cc.wallet.PublishLabelledTransaction,
 		Notifier:           cc.chainNotifier,
 		FeeEstimator:       cc.feeEstimator,
 		SignMessage: func(pubKey *btcec.PublicKey,
@@ -862,6 +931,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		NotifyPendingOpenChannelEvent: s.channelNotifier.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        s.channelNotifier.NotifyOpenChannelEvent,
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -882,7 +953,19 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// utilize the full bandwidth of the channel, minus our
 			// required reserve.
 			reserve := lnwire.NewMSatFromSatoshis(chanAmt / 100)
-			return lnwire.NewMSatFromSatoshis(chanAmt) - reserve
+			maxValue := lnwire.NewMSatFromSatoshis(chanAmt) - reserve
+
+			// For wumbo channels, we'll limit the value in flight
+			// to what a channel at the soft-limit on channel size
+			// would allow, bounding our exposure to pending HTLCs.
+			maxFundingValue := lnwire.NewMSatFromSatoshis(
+				maxFundingAmount,
+			)
+			if maxValue > maxFundingValue {
+				maxValue = maxFundingValue
+			}
+
+			return maxValue
 		},
 		RequiredRemoteMaxHTLCs: func(chanAmt btcutil.Amount) uint16 {
 			// By default, we'll permit them to utilize the full
@@ -892,11 +975,44 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval: 1 * time.Minute,
 		ReservationTimeout:    10 * time.Minute,
 		MinChanSize:           btcutil.Amount(cfg.MinChanSize),
+		MaxDualFundAmt:        btcutil.Amount(cfg.MaxDualFundAmt),
+		MaxChanSize:           btcutil.Amount(cfg.MaxChanSize),
+		ChannelAcceptor:       s.chanAcceptor,
+		IsZeroConfPeer: func(pub *btcec.PublicKey) bool {
+			var key [33]byte
+			copy(key[:], pub.SerializeCompressed())
+			_, ok := cfg.zeroConfPeers[key]
+			return ok
+		},
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// If automatic fee adjustment is enabled, we'll create the fee policy
+	// manager, which scales the fee rate configured for our chain to the
+	// balance and forwarding volume of each channel.
+	if cfg.FeePolicy.Active {
+		s.feePolicyMgr = feepolicy.NewManager(&feepolicy.Config{
+			Strategy: &feepolicy.BalanceStrategy{
+				FeeRate:       uint32(s.cc.routingPolicy.FeeRate),
+				MinMultiplier: cfg.FeePolicy.MinMultiplier,
+				MaxMultiplier: cfg.FeePolicy.MaxMultiplier,
+				VolumeWeight:  cfg.FeePolicy.VolumeWeight,
+				MinFeeRate:    cfg.FeePolicy.MinFeeRate,
+				MaxFeeRate:    cfg.FeePolicy.MaxFeeRate,
+			},
+			FetchChannels:     s.fetchFeePolicyChannels,
+			QueryForwards:     chanDB.ForwardingLog().Query,
+			ApplyFeeSchema:    s.applyFeeSchema,
+			Ticker:            ticker.New(cfg.FeePolicy.Interval),
+			VolumeWindow:      cfg.FeePolicy.VolumeWindow,
+			MinUpdateInterval: cfg.FeePolicy.MinUpdateInterval,
+			MaxUpdates:        cfg.FeePolicy.MaxUpdates,
+			ChangeThreshold:   cfg.FeePolicy.ChangeThreshold,
+		})
+	}
+
 	// Create the connection manager which will be responsible for
 	// maintaining persistent outbound connections and also accepting new
 	// incoming connections
@@ -953,6 +1069,12 @@ func (s *server) Start() error {
 	if err := s.sphinx.Start(); err != nil {
 		return err
 	}
+	if err := s.channelNotifier.Start(); err != nil {
+		return err
+	}
+	if err := s.htlcNotifier.Start(); err != nil {
+		return err
+	}
 	if err := s.htlcSwitch.Start(); err != nil {
 		return err
 	}
@@ -979,6 +1101,11 @@ func (s *server) Start() error {
 	if err := s.invoices.Start(); err != nil {
 		return err
 	}
+	if s.feePolicyMgr != nil {
+		if err := s.feePolicyMgr.Start(); err != nil {
+			return err
+		}
+	}
 
 	// With all the relevant sub-systems started, we'll now attempt to
 	// establish persistent connections to our direct channel collaborators
@@ -1042,12 +1169,17 @@ func (s *server) Stop() error {
 	s.breachArbiter.Stop()
 	s.authGossiper.Stop()
 	s.chainArb.Stop()
+	s.channelNotifier.Stop()
+	s.htlcNotifier.Stop()
 	s.cc.wallet.Shutdown()
 	s.cc.chainView.Stop()
 	s.connMgr.Stop()
 	s.cc.feeEstimator.Stop()
 	s.invoices.Stop()
 	s.fundingMgr.Stop()
+	if s.feePolicyMgr != nil {
+		s.feePolicyMgr.Stop()
+	}
 
 	// Disconnect from each active peers to ensure that
 	// peerTerminationWatchers signal completion to each peer.
@@ -2306,9 +2438,22 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	localFeatures := lnwire.NewRawFeatureVector()
 
 	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
+	// that we support the new gossip query features, that we're able to
+	// take part in dual funded channels, that we enforce upfront
+	// shutdown scripts, and that we can use commitments with a static
+	// remote key or anchor outputs.
 	localFeatures.Set(lnwire.DataLossProtectOptional)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures.Set(lnwire.DualFundOptional)
+	localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	localFeatures.Set(lnwire.StaticRemoteKeyOptional)
+	localFeatures.Set(lnwire.AnchorsOptional)
+
+	// If we're willing to have channels above the soft-limit on channel
+	// size, we'll signal it as well.
+	if cfg.WumboChannels {
+		localFeatures.Set(lnwire.WumboChannelsOptional)
+	}
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
@@ -2618,16 +2763,46 @@ type openChanReq struct {
 
 	private bool
 
+	// dualFund indicates whether the remote peer should be invited to
+	// contribute funds of its own to the channel.
+	dualFund bool
+
 	minHtlc lnwire.MilliSatoshi
 
 	remoteCsvDelay uint16
 
+	// maxValueInFlight is the maximum amount of coins in millisatoshi that
+	// may be pending within the channel at the same time. If zero, the
+	// default derived from the channel capacity is used.
+	maxValueInFlight lnwire.MilliSatoshi
+
+	// maxHtlcs is the maximum number of HTLCs the remote party may offer
+	// us. If zero, the default is used.
+	maxHtlcs uint16
+
+	// remoteChanReserve is the channel reserve the remote party is required
+	// to maintain. If zero, the default derived from the channel capacity
+	// is used.
+	remoteChanReserve btcutil.Amount
+
+	// dustLimit is the dust limit we'll use for our commitment
+	// transaction. If zero, the chain's default dust limit is used.
+	dustLimit btcutil.Amount
+
+	// maxRemoteCsvDelay is the maximum CSV delay we'll accept the remote
+	// party requiring on our commitment outputs. If zero, the default is
+	// used.
+	maxRemoteCsvDelay uint16
+
+	// shutdownScript is the script we'll commit to paying our funds to
+	// upon a cooperative close of the channel. If empty, no script is
+	// committed to.
+	shutdownScript lnwire.DeliveryAddress
+
 	// minConfs indicates the minimum number of confirmations that each
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
-	// TODO(roasbeef): add ability to specify channel constraints as well
-
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
 }
@@ -2786,6 +2961,16 @@ func (s *server) OpenChannel(
 	}
 	s.mu.RUnlock()
 
+	// We can only invite the peer to dual fund the channel if it
+	// understands the dual funding protocol.
+	if req.dualFund &&
+		!peer.remoteLocalFeatures.HasFeature(lnwire.DualFundOptional) {
+
+		req.err <- fmt.Errorf("peer %x doesn't support dual funded "+
+			"channels", pubKeyBytes)
+		return req.updates, req.err
+	}
+
 	// If the fee rate wasn't specified, then we'll use a default
 	// confirmation target.
 	if req.fundingFeePerKw == 0 {
@@ -2892,6 +3077,14 @@ func (s *server) announceChanStatus(op wire.OutPoint, disabled bool) error {
 	s.sentDisabledMtx.Lock()
 	defer s.sentDisabledMtx.Unlock()
 
+	// Channels disabled by the user are only enabled again once the user
+	// enables them, or hands them back to automatic management.
+	override := s.chanStatusOverrides[op]
+	if !disabled && override == channeldb.ChanStatusDisabled {
+		srvrLog.Debugf("Not enabling channel(%v) disabled by user", op)
+		return nil
+	}
+
 	// If we have already sent out an update reflecting the current status,
 	// skip this channel.
 	alreadyDisabled, ok := s.sentDisabled[op]
@@ -2914,6 +3107,242 @@ func (s *server) announceChanStatus(op wire.OutPoint, disabled bool) error {
 		chanUpdate.Flags &= ^lnwire.ChanUpdateDisabled
 	}
 
+	srvrLog.Debugf("Announcing channel(%v) disabled=%v", op, disabled)
+
+	// We'll now sign the new update and send it to all of our peers.
+	if err := s.signAndApplyChanUpdate(chanUpdate); err != nil {
+		return err
+	}
+
+	// We'll keep track of the status set in the last update we sent, to
+	// avoid sending updates if nothing has changed.
+	s.sentDisabled[op] = disabled
+
+	return nil
+}
+
+// updateChanStatus manually sets the status of the channel with the given
+// funding outpoint. Disabled channels are announced as disabled, and no new
+// forwards are sent over them. Enabled channels are announced as enabled,
+// even while they're inactive. ChanStatusAuto hands the channel back to
+// automatic management, announcing its current status. The status is
+// persisted, so that it's kept across restarts.
+func (s *server) updateChanStatus(op wire.OutPoint,
+	status channeldb.ChanStatusOverride) error {
+
+	channels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	var found bool
+	for _, channel := range channels {
+		if channel.FundingOutpoint == op {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("unable to find open channel %v", op)
+	}
+
+	if err := s.chanDB.PutChanStatusOverride(op, status); err != nil {
+		return err
+	}
+
+	s.sentDisabledMtx.Lock()
+	if status == channeldb.ChanStatusAuto {
+		delete(s.chanStatusOverrides, op)
+	} else {
+		s.chanStatusOverrides[op] = status
+	}
+	s.sentDisabledMtx.Unlock()
+
+	chanID := lnwire.NewChanIDFromOutPoint(&op)
+	s.htlcSwitch.SetLinkDisabled(
+		chanID, status == channeldb.ChanStatusDisabled,
+	)
+
+	var disabled bool
+	switch status {
+	case channeldb.ChanStatusDisabled:
+		disabled = true
+
+	case channeldb.ChanStatusAuto:
+		disabled = !s.htlcSwitch.HasActiveLink(chanID)
+	}
+
+	srvrLog.Infof("Setting status of channel(%v) to %v", op, status)
+
+	// Channels that aren't in the graph yet will be announced with the
+	// new status once they are.
+	err = s.announceChanStatus(op, disabled)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// chanStatusOverride returns the status the user manually set for the channel
+// with the given funding outpoint.
+func (s *server) chanStatusOverride(
+	op wire.OutPoint) channeldb.ChanStatusOverride {
+
+	s.sentDisabledMtx.Lock()
+	defer s.sentDisabledMtx.Unlock()
+
+	return s.chanStatusOverrides[op]
+}
+
+// applyFeeSchema announces the given fees for our direction of a channel, and
+// applies them to the channel's link.
+func (s *server) applyFeeSchema(op wire.OutPoint,
+	schema routing.FeeSchema) error {
+
+	// We hold the same mutex as status updates, so that a concurrent status
+	// update can't overwrite the new fees with those of the update it
+	// started from.
+	s.sentDisabledMtx.Lock()
+	defer s.sentDisabledMtx.Unlock()
+
+	chanUpdate, err := s.fetchLastChanUpdateByOutPoint(op)
+	if err != nil {
+		return err
+	}
+
+	chanUpdate.BaseFee = uint32(schema.BaseFee)
+	chanUpdate.FeeRate = schema.FeeRate
+
+	srvrLog.Debugf("Announcing channel(%v) base_fee=%v, fee_rate=%v", op,
+		schema.BaseFee, schema.FeeRate)
+
+	if err := s.signAndApplyChanUpdate(chanUpdate); err != nil {
+		return err
+	}
+
+	// We'll hand the link the full policy we just announced, as a partial
+	// one would leave fees of zero unapplied.
+	err = s.htlcSwitch.SetForwardingPolicy(
+		op, forwardingPolicyFromUpdate(chanUpdate),
+	)
+	if err != nil {
+		// If the link isn't online, it will pick up the new fees from
+		// the graph once it's loaded, so we'll only log the failure.
+		srvrLog.Warnf("Unable to update link fees of channel(%v): %v",
+			op, err)
+	}
+
+	return nil
+}
+
+// forwardingPolicyFromUpdate returns the forwarding policy a link should
+// enforce for the given update of our direction of its channel.
+func forwardingPolicyFromUpdate(
+	chanUpdate *lnwire.ChannelUpdate) htlcswitch.ForwardingPolicy {
+
+	policy := htlcswitch.ForwardingPolicy{
+		MinHTLC:       chanUpdate.HtlcMinimumMsat,
+		BaseFee:       lnwire.MilliSatoshi(chanUpdate.BaseFee),
+		FeeRate:       lnwire.MilliSatoshi(chanUpdate.FeeRate),
+		TimeLockDelta: uint32(chanUpdate.TimeLockDelta),
+	}
+	if chanUpdate.Flags.HasMaxHtlc() {
+		policy.MaxHTLC = chanUpdate.HtlcMaximumMsat
+	}
+
+	return policy
+}
+
+// setLinkPolicies applies the policies we currently advertise for the given
+// channels, or for all open channels if none are given, to their links.
+// Channels without an active link are skipped, as their links load the
+// advertised policy once they're created.
+func (s *server) setLinkPolicies(chanPoints ...wire.OutPoint) error {
+	if len(chanPoints) == 0 {
+		dbChans, err := s.chanDB.FetchAllOpenChannels()
+		if err != nil {
+			return err
+		}
+		for _, dbChan := range dbChans {
+			chanPoints = append(chanPoints, dbChan.FundingOutpoint)
+		}
+	}
+
+	for _, chanPoint := range chanPoints {
+		chanUpdate, err := s.fetchLastChanUpdateByOutPoint(chanPoint)
+		if err != nil {
+			srvrLog.Warnf("Unable to fetch policy of "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+			continue
+		}
+
+		err = s.htlcSwitch.SetForwardingPolicy(
+			chanPoint, forwardingPolicyFromUpdate(chanUpdate),
+		)
+		if err != nil {
+			srvrLog.Debugf("Unable to set link policy of "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// fetchFeePolicyChannels returns the state of all open channels that have an
+// enabled policy of ours in the graph, for the fee policy manager to adjust
+// their fees. Channels whose policy was set manually by the user are marked,
+// so the manager leaves them alone.
+func (s *server) fetchFeePolicyChannels() ([]*feepolicy.ChannelState, error) {
+	dbChans, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.chanDB.FetchFeePolicyOverrides()
+	if err != nil {
+		return nil, err
+	}
+
+	ourPubKey := s.identityPriv.PubKey().SerializeCompressed()
+	graph := s.chanDB.ChannelGraph()
+
+	var channels []*feepolicy.ChannelState
+	for _, dbChan := range dbChans {
+		// Channels that aren't in the graph yet, or that we've
+		// disabled, are skipped.
+		info, edge1, edge2, err := graph.FetchChannelEdgesByOutpoint(
+			&dbChan.FundingOutpoint,
+		)
+		if err != nil {
+			continue
+		}
+		update, err := extractChannelUpdate(
+			ourPubKey, info, edge1, edge2,
+		)
+		if err != nil || update.Flags&lnwire.ChanUpdateDisabled != 0 {
+			continue
+		}
+
+		_, manual := overrides[dbChan.FundingOutpoint]
+		channels = append(channels, &feepolicy.ChannelState{
+			ChanPoint:    dbChan.FundingOutpoint,
+			ShortChanID:  dbChan.ShortChanID(),
+			Capacity:     dbChan.Capacity,
+			LocalBalance: dbChan.LocalCommitment.LocalBalance,
+			FeeSchema: routing.FeeSchema{
+				BaseFee: lnwire.MilliSatoshi(update.BaseFee),
+				FeeRate: update.FeeRate,
+			},
+			ManualPolicy: manual,
+		})
+	}
+
+	return channels, nil
+}
+
+// signAndApplyChanUpdate bumps the timestamp of the given update for our
+// direction of a channel, signs it, and sends it to all of our peers.
+func (s *server) signAndApplyChanUpdate(chanUpdate *lnwire.ChannelUpdate) error {
 	// We must now update the message's timestamp and generate a new
 	// signature.
 	newTimestamp := uint32(time.Now().Unix())
@@ -2938,18 +3367,8 @@ func (s *server) announceChanStatus(op wire.OutPoint, disabled bool) error {
 		return err
 	}
 
-	srvrLog.Debugf("Announcing channel(%v) disabled=%v", op, disabled)
-
 	// Once signed, we'll send the new update to all of our peers.
-	if err := s.applyChannelUpdate(chanUpdate); err != nil {
-		return err
-	}
-
-	// We'll keep track of the status set in the last update we sent, to
-	// avoid sending updates if nothing has changed.
-	s.sentDisabled[op] = disabled
-
-	return nil
+	return s.applyChannelUpdate(chanUpdate)
 }
 
 // fetchLastChanUpdateByOutPoint fetches the latest policy for our direction of
@@ -3033,6 +3452,7 @@ func createChannelUpdate(info *channeldb.ChannelEdgeInfo,
 		Flags:           policy.Flags,
 		TimeLockDelta:   policy.TimeLockDelta,
 		HtlcMinimumMsat: policy.MinHTLC,
+		HtlcMaximumMsat: policy.MaxHTLC,
 		BaseFee:         uint32(policy.FeeBaseMSat),
 		FeeRate:         uint32(policy.FeeProportionalMillionths),
 		ExtraOpaqueData: policy.ExtraOpaqueData,
@@ -3111,6 +3531,15 @@ func (s *server) watchChannelStatus() {
 					continue
 				}
 
+				// Channels whose status was set by the user
+				// aren't managed automatically.
+				override := s.chanStatusOverride(
+					c.FundingOutpoint,
+				)
+				if override != channeldb.ChanStatusAuto {
+					continue
+				}
+
 				chanID := lnwire.NewChanIDFromOutPoint(
 					&c.FundingOutpoint)
 
@@ -2,7 +2,12 @@
 
 package main
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
 
 func TestParseHexColor(t *testing.T) {
 	var colorTestCases = []struct {
@@ -41,3 +46,36 @@ func TestParseHexColor(t *testing.T) {
 		}
 	}
 }
+
+// TestForwardingPolicyFromUpdate tests that the forwarding policy of a link is
+// derived from our channel update as a whole, only enforcing a maximum HTLC if
+// one is advertised.
+func TestForwardingPolicyFromUpdate(t *testing.T) {
+	t.Parallel()
+
+	chanUpdate := &lnwire.ChannelUpdate{
+		TimeLockDelta:   40,
+		HtlcMinimumMsat: 1000,
+		HtlcMaximumMsat: 500000,
+		BaseFee:         0,
+		FeeRate:         100,
+	}
+
+	// Without the max htlc option, no maximum should be enforced.
+	policy := forwardingPolicyFromUpdate(chanUpdate)
+	expectedPolicy := htlcswitch.ForwardingPolicy{
+		MinHTLC:       1000,
+		FeeRate:       100,
+		TimeLockDelta: 40,
+	}
+	if policy != expectedPolicy {
+		t.Fatalf("expected policy %v, got %v", expectedPolicy, policy)
+	}
+
+	chanUpdate.Flags |= lnwire.ChanUpdateOptionMaxHtlc
+	policy = forwardingPolicyFromUpdate(chanUpdate)
+	expectedPolicy.MaxHTLC = 500000
+	if policy != expectedPolicy {
+		t.Fatalf("expected policy %v, got %v", expectedPolicy, policy)
+	}
+}
@@ -100,6 +100,34 @@ func (bi *BaseInput) BlocksToMaturity() uint32 {
 	return 0
 }
 
+// CsvInput contains all the information needed to sweep a basic output that
+// is encumbered by a relative time lock.
+type CsvInput struct {
+	BaseInput
+
+	blocksToMaturity uint32
+}
+
+// MakeCsvInput assembles a new CsvInput that can be used to construct a sweep
+// transaction. The output can only be spent once blocksToMaturity blocks have
+// been built on top of its confirmation height.
+func MakeCsvInput(outpoint *wire.OutPoint, witnessType lnwallet.WitnessType,
+	signDescriptor *lnwallet.SignDescriptor,
+	blocksToMaturity uint32) CsvInput {
+
+	return CsvInput{
+		BaseInput:        MakeBaseInput(outpoint, witnessType, signDescriptor),
+		blocksToMaturity: blocksToMaturity,
+	}
+}
+
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent.
+func (ci *CsvInput) BlocksToMaturity() uint32 {
+	return ci.blocksToMaturity
+}
+
 // HtlcSucceedInput constitutes a sweep input that needs a pre-image. The input
 // is expected to reside on the commitment tx of the remote party and should
 // not be a second level tx output.
@@ -107,13 +135,17 @@ type HtlcSucceedInput struct {
 	inputKit
 
 	preimage []byte
+
+	blocksToMaturity uint32
 }
 
 // MakeHtlcSucceedInput assembles a new redeem input that can be used to
-// construct a sweep transaction.
+// construct a sweep transaction. For channels using anchor outputs, the HTLC
+// can only be swept once the commitment has confirmed, which is expressed
+// through blocksToMaturity.
 func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
-	signDescriptor *lnwallet.SignDescriptor,
-	preimage []byte) HtlcSucceedInput {
+	signDescriptor *lnwallet.SignDescriptor, preimage []byte,
+	blocksToMaturity uint32) HtlcSucceedInput {
 
 	return HtlcSucceedInput{
 		inputKit: inputKit{
@@ -121,7 +153,8 @@ func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
 			witnessType: lnwallet.HtlcAcceptedRemoteSuccess,
 			signDesc:    *signDescriptor,
 		},
-		preimage: preimage,
+		preimage:         preimage,
+		blocksToMaturity: blocksToMaturity,
 	}
 }
 
@@ -145,10 +178,11 @@ func (h *HtlcSucceedInput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
 // must be built on top of the confirmation height before the output can be
 // spent.
 func (h *HtlcSucceedInput) BlocksToMaturity() uint32 {
-	return 0
+	return h.blocksToMaturity
 }
 
 // Compile-time constraints to ensure each input struct implement the Input
 // interface.
 var _ Input = (*BaseInput)(nil)
+var _ Input = (*CsvInput)(nil)
 var _ Input = (*HtlcSucceedInput)(nil)
@@ -1,6 +1,10 @@
 package sweep
 
 import (
+	"errors"
+	"math"
+	"sync"
+
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -13,6 +17,10 @@ import (
 // process by itself.
 type UtxoSweeper struct {
 	cfg *UtxoSweeperConfig
+
+	// cpfpMtx is held while selecting wallet outputs for CPFP
+	// transactions, so concurrent selections don't pick the same outputs.
+	cpfpMtx sync.Mutex
 }
 
 // UtxoSweeperConfig contains dependencies of UtxoSweeper.
@@ -29,8 +37,33 @@ type UtxoSweeperConfig struct {
 	// Signer is used by the sweeper to generate valid witnesses at the
 	// time the incubated outputs need to be spent.
 	Signer lnwallet.Signer
+
+	// ListUnspentWitness returns all unspent witness outputs of the
+	// backing wallet with a number of confirmations within the passed
+	// range. It's used to select the wallet inputs that fund CPFP
+	// transactions.
+	ListUnspentWitness func(minConfs, maxConfs int32) ([]*lnwallet.Utxo,
+		error)
+
+	// LockOutpoint marks a wallet output as locked, preventing it from
+	// being selected by the wallet for other transactions.
+	LockOutpoint func(o wire.OutPoint)
+
+	// UnlockOutpoint unlocks a wallet output previously locked with
+	// LockOutpoint.
+	UnlockOutpoint func(o wire.OutPoint)
+
+	// FetchInputInfo returns the output of the wallet spent by the passed
+	// outpoint. It's used to re-sign the wallet inputs of a CPFP
+	// transaction that is replaced.
+	FetchInputInfo func(prevOut *wire.OutPoint) (*wire.TxOut, error)
 }
 
+// ErrNotEnoughWalletFunds is returned when the wallet doesn't have enough
+// confirmed funds to pay for a CPFP transaction.
+var ErrNotEnoughWalletFunds = errors.New("not enough confirmed wallet " +
+	"funds to create cpfp transaction")
+
 // New returns a new UtxoSweeper instance.
 func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 	return &UtxoSweeper{
@@ -143,6 +176,238 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []Input, confTarget uint32,
 	return sweepTx, nil
 }
 
+// CreateCPFPTx creates and signs a transaction that spends the passed anchor
+// input of an unconfirmed parent transaction, along with as many confirmed
+// p2wkh wallet outputs as needed, such that the fee rate of the package
+// formed by the parent and the child reaches the fee rate estimated for
+// confTarget. The parent's weight and the fee it already pays must be passed
+// in. All funds, minus the fee, are sent back to the wallet, and the selected
+// wallet outputs are locked until ReleaseCPFPTx is called.
+//
+// If a previous child transaction spending the anchor is passed, the new child
+// replaces it: it spends the same wallet outputs, and pays at least the fee
+// required by BIP 125 to replace it. If the previous child already pays the
+// fee required at the current estimate, it's returned as is.
+func (s *UtxoSweeper) CreateCPFPTx(anchor Input, parentWeight int64,
+	parentFee btcutil.Amount, confTarget uint32,
+	replaced *wire.MsgTx) (*wire.MsgTx, error) {
+
+	// Generate the receiving script to which the change will be sent.
+	pkScript, err := s.cfg.GenSweepScript()
+	if err != nil {
+		return nil, err
+	}
+
+	feePerKw, err := s.cfg.Estimator.EstimateFeePerKW(confTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Creating cpfp transaction for parent of weight %v paying "+
+		"%v using %v sat/kw", parentWeight, parentFee, int64(feePerKw))
+
+	s.cpfpMtx.Lock()
+	defer s.cpfpMtx.Unlock()
+
+	inputs := []Input{anchor}
+	totalIn := btcutil.Amount(anchor.SignDesc().Output.Value)
+
+	// requiredFee returns the fee the child must pay given its current
+	// inputs.
+	requiredFee := func() btcutil.Amount {
+		_, childWeight, _, _ := s.getWeightEstimate(inputs)
+		packageFee := feePerKw.FeeForWeight(parentWeight + childWeight)
+		childFee := packageFee - parentFee
+		if childFee < feePerKw.FeeForWeight(childWeight) {
+			childFee = feePerKw.FeeForWeight(childWeight)
+		}
+
+		return childFee
+	}
+
+	// If we're replacing a previous child, we'll start out with the
+	// wallet outputs it spends, which are still locked.
+	var minFee btcutil.Amount
+	if replaced != nil {
+		for _, txIn := range replaced.TxIn[1:] {
+			walletInput, err := s.walletInput(&txIn.PreviousOutPoint)
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, walletInput)
+			totalIn += btcutil.Amount(
+				walletInput.SignDesc().Output.Value,
+			)
+		}
+
+		var totalOut btcutil.Amount
+		for _, txOut := range replaced.TxOut {
+			totalOut += btcutil.Amount(txOut.Value)
+		}
+		replacedFee := totalIn - totalOut
+
+		if replacedFee >= requiredFee() {
+			log.Infof("Replaced cpfp transaction %v pays fee of "+
+				"%v, no need to bump", replaced.TxHash(),
+				replacedFee)
+			return replaced, nil
+		}
+
+		// BIP 125 requires the replacement to pay for its own
+		// bandwidth at the incremental relay fee rate, on top of the
+		// fee paid by the replaced transaction.
+		_, childWeight, _, _ := s.getWeightEstimate(inputs)
+		minFee = replacedFee + lnwallet.FeePerKwFloor.FeeForWeight(
+			childWeight,
+		)
+	}
+
+	// sign creates the child once the inputs cover the required fee,
+	// leaving a change output above the dust limit. It returns nil if more
+	// inputs are needed.
+	dustLimit := lnwallet.DefaultDustLimit()
+	sign := func() (*wire.MsgTx, error) {
+		childFee := requiredFee()
+		if childFee < minFee {
+			childFee = minFee
+		}
+		if totalIn-childFee < dustLimit {
+			return nil, nil
+		}
+
+		return s.signCPFPTx(inputs, pkScript, totalIn-childFee)
+	}
+
+	cpfpTx, err := sign()
+	if err != nil || cpfpTx != nil {
+		return cpfpTx, err
+	}
+
+	utxos, err := s.cfg.ListUnspentWitness(1, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll now select wallet outputs one at a time, until the total
+	// value of the inputs covers the fee required for the package, while
+	// still leaving a change output above the dust limit. Each selected
+	// output is locked right away, and unlocked again should we fail.
+	var selected []wire.OutPoint
+	unlockSelected := func() {
+		for _, outpoint := range selected {
+			s.cfg.UnlockOutpoint(outpoint)
+		}
+	}
+	for _, utxo := range utxos {
+		if utxo.AddressType != lnwallet.WitnessPubKey {
+			continue
+		}
+
+		s.cfg.LockOutpoint(utxo.OutPoint)
+		selected = append(selected, utxo.OutPoint)
+
+		walletInput := MakeBaseInput(
+			&utxo.OutPoint, lnwallet.WitnessKeyHash,
+			&lnwallet.SignDescriptor{
+				Output: &wire.TxOut{
+					PkScript: utxo.PkScript,
+					Value:    int64(utxo.Value),
+				},
+				HashType: txscript.SigHashAll,
+			},
+		)
+		inputs = append(inputs, &walletInput)
+		totalIn += utxo.Value
+
+		cpfpTx, err := sign()
+		if err != nil {
+			unlockSelected()
+			return nil, err
+		}
+		if cpfpTx != nil {
+			return cpfpTx, nil
+		}
+	}
+
+	unlockSelected()
+
+	return nil, ErrNotEnoughWalletFunds
+}
+
+// ReleaseCPFPTx unlocks the wallet outputs spent by the passed CPFP
+// transaction. This should be called once the transaction is no longer
+// needed, e.g. because its parent confirmed.
+func (s *UtxoSweeper) ReleaseCPFPTx(cpfpTx *wire.MsgTx) {
+	// The first input is the anchor, which doesn't belong to the wallet.
+	for _, txIn := range cpfpTx.TxIn[1:] {
+		s.cfg.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
+// LockCPFPTx locks the wallet outputs spent by the passed CPFP transaction
+// again. As locks don't survive a restart, this must be called for a CPFP
+// transaction created before the restart that may still be replaced.
+func (s *UtxoSweeper) LockCPFPTx(cpfpTx *wire.MsgTx) {
+	// The first input is the anchor, which doesn't belong to the wallet.
+	for _, txIn := range cpfpTx.TxIn[1:] {
+		s.cfg.LockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
+// walletInput returns the input spending the passed p2wkh wallet output.
+func (s *UtxoSweeper) walletInput(outpoint *wire.OutPoint) (Input, error) {
+	output, err := s.cfg.FetchInputInfo(outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	input := MakeBaseInput(
+		outpoint, lnwallet.WitnessKeyHash, &lnwallet.SignDescriptor{
+			Output:   output,
+			HashType: txscript.SigHashAll,
+		},
+	)
+
+	return &input, nil
+}
+
+// signCPFPTx assembles the CPFP transaction spending the passed inputs and
+// paying changeAmt to pkScript, and signs all of its inputs.
+func (s *UtxoSweeper) signCPFPTx(inputs []Input, pkScript []byte,
+	changeAmt btcutil.Amount) (*wire.MsgTx, error) {
+
+	cpfpTx := wire.NewMsgTx(2)
+	cpfpTx.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+		Value:    int64(changeAmt),
+	})
+	for _, input := range inputs {
+		cpfpTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.BlocksToMaturity(),
+		})
+	}
+
+	btx := btcutil.NewTx(cpfpTx)
+	if err := blockchain.CheckTransactionSanity(btx); err != nil {
+		return nil, err
+	}
+
+	hashCache := txscript.NewTxSigHashes(cpfpTx)
+	for i, input := range inputs {
+		witness, err := input.BuildWitness(
+			s.cfg.Signer, cpfpTx, hashCache, i,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cpfpTx.TxIn[i].Witness = witness
+	}
+
+	return cpfpTx, nil
+}
+
 // getWeightEstimate returns a weight estimate for the given inputs.
 // Additionally, it returns counts for the number of csv and cltv inputs.
 func (s *UtxoSweeper) getWeightEstimate(inputs []Input) ([]Input, int64, int, int) {
@@ -171,7 +436,31 @@ func (s *UtxoSweeper) getWeightEstimate(inputs []Input) ([]Input, int64, int, in
 
 		// Outputs on a remote commitment transaction that pay directly
 		// to us.
-		case lnwallet.CommitmentNoDelay:
+		case lnwallet.CommitmentNoDelay,
+			lnwallet.CommitSpendNoDelayTweakless:
+
+			weightEstimate.AddP2WKHInput()
+			sweepInputs = append(sweepInputs, input)
+
+		// Outputs on a remote commitment transaction of a channel
+		// using anchor outputs that pay to us once the commitment has
+		// confirmed.
+		case lnwallet.CommitmentToRemoteConfirmed:
+			weightEstimate.AddWitnessInput(
+				lnwallet.ToRemoteConfirmedWitnessSize,
+			)
+			sweepInputs = append(sweepInputs, input)
+			csvCount++
+
+		// Our anchor output on a commitment transaction.
+		case lnwallet.CommitmentAnchor:
+			weightEstimate.AddWitnessInput(
+				lnwallet.AnchorWitnessSize,
+			)
+			sweepInputs = append(sweepInputs, input)
+
+		// Regular p2wkh outputs controlled by the backing wallet.
+		case lnwallet.WitnessKeyHash:
 			weightEstimate.AddP2WKHInput()
 			sweepInputs = append(sweepInputs, input)
 
@@ -0,0 +1,442 @@
+package sweep
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	testPubKey, _ = btcec.ParsePubKey([]byte{
+		0x02, 0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55,
+		0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07, 0x02, 0x9b, 0xfc,
+		0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16,
+		0xf8, 0x17, 0x98,
+	}, btcec.S256())
+
+	testSweepScript = append([]byte{0x00, 0x14}, bytes.Repeat(
+		[]byte{0x01}, 20,
+	)...)
+
+	testWalletScript = append([]byte{0x00, 0x14}, bytes.Repeat(
+		[]byte{0x02}, 20,
+	)...)
+)
+
+// mockSigner is a Signer that returns a dummy signature for every input.
+type mockSigner struct{}
+
+func (m *mockSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	return bytes.Repeat([]byte{0x30}, 71), nil
+}
+
+func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{
+		Witness: wire.TxWitness{
+			bytes.Repeat([]byte{0x30}, 72),
+			testPubKey.SerializeCompressed(),
+		},
+	}, nil
+}
+
+// mockWallet is the part of the wallet used by the sweeper to fund CPFP
+// transactions. It keeps track of the outputs that are locked.
+type mockWallet struct {
+	sync.Mutex
+
+	utxos  []*lnwallet.Utxo
+	locked map[wire.OutPoint]struct{}
+}
+
+func newMockWallet(values ...btcutil.Amount) *mockWallet {
+	w := &mockWallet{
+		locked: make(map[wire.OutPoint]struct{}),
+	}
+	for i, value := range values {
+		w.utxos = append(w.utxos, &lnwallet.Utxo{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       value,
+			PkScript:    testWalletScript,
+			OutPoint: wire.OutPoint{
+				Hash:  [32]byte{0x01},
+				Index: uint32(i),
+			},
+		})
+	}
+
+	return w
+}
+
+func (w *mockWallet) ListUnspentWitness(minConfs,
+	maxConfs int32) ([]*lnwallet.Utxo, error) {
+
+	w.Lock()
+	defer w.Unlock()
+
+	var utxos []*lnwallet.Utxo
+	for _, utxo := range w.utxos {
+		if _, ok := w.locked[utxo.OutPoint]; ok {
+			continue
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	return utxos, nil
+}
+
+func (w *mockWallet) LockOutpoint(o wire.OutPoint) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.locked[o] = struct{}{}
+}
+
+func (w *mockWallet) UnlockOutpoint(o wire.OutPoint) {
+	w.Lock()
+	defer w.Unlock()
+
+	delete(w.locked, o)
+}
+
+func (w *mockWallet) FetchInputInfo(prevOut *wire.OutPoint) (*wire.TxOut,
+	error) {
+
+	w.Lock()
+	defer w.Unlock()
+
+	for _, utxo := range w.utxos {
+		if utxo.OutPoint == *prevOut {
+			return &wire.TxOut{
+				PkScript: utxo.PkScript,
+				Value:    int64(utxo.Value),
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown output %v", prevOut)
+}
+
+func (w *mockWallet) numLocked() int {
+	w.Lock()
+	defer w.Unlock()
+
+	return len(w.locked)
+}
+
+// newTestSweeper creates a sweeper funding CPFP transactions from the passed
+// wallet, using the fee rate of the passed estimator.
+func newTestSweeper(wallet *mockWallet,
+	estimator *lnwallet.StaticFeeEstimator) *UtxoSweeper {
+
+	return New(&UtxoSweeperConfig{
+		GenSweepScript: func() ([]byte, error) {
+			return testSweepScript, nil
+		},
+		Estimator:          estimator,
+		Signer:             &mockSigner{},
+		ListUnspentWitness: wallet.ListUnspentWitness,
+		LockOutpoint:       wallet.LockOutpoint,
+		UnlockOutpoint:     wallet.UnlockOutpoint,
+		FetchInputInfo:     wallet.FetchInputInfo,
+	})
+}
+
+// newTestAnchor returns an anchor input of a commitment transaction.
+func newTestAnchor() Input {
+	anchor := MakeBaseInput(
+		&wire.OutPoint{Hash: [32]byte{0xaa}, Index: 2},
+		lnwallet.CommitmentAnchor, &lnwallet.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: testPubKey,
+			},
+			WitnessScript: bytes.Repeat([]byte{0x51}, 40),
+			Output: &wire.TxOut{
+				Value: 330,
+			},
+			HashType: txscript.SigHashAll,
+		},
+	)
+
+	return &anchor
+}
+
+// cpfpWeight returns the estimated weight of a CPFP transaction spending an
+// anchor along with numWalletInputs wallet outputs.
+func cpfpWeight(numWalletInputs int) int64 {
+	var weightEstimate lnwallet.TxWeightEstimator
+	weightEstimate.AddP2WKHOutput()
+	weightEstimate.AddWitnessInput(lnwallet.AnchorWitnessSize)
+	for i := 0; i < numWalletInputs; i++ {
+		weightEstimate.AddP2WKHInput()
+	}
+
+	return int64(weightEstimate.Weight())
+}
+
+// cpfpFee returns the fee paid by the passed CPFP transaction, given the
+// values of the wallet outputs it spends.
+func cpfpFee(t *testing.T, wallet *mockWallet, tx *wire.MsgTx) btcutil.Amount {
+	t.Helper()
+
+	totalIn := btcutil.Amount(330)
+	for _, txIn := range tx.TxIn[1:] {
+		output, err := wallet.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch input: %v", err)
+		}
+		totalIn += btcutil.Amount(output.Value)
+	}
+
+	var totalOut btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+
+	return totalIn - totalOut
+}
+
+// TestCreateCPFPTxFee tests that the CPFP transaction pays enough fee for the
+// package of parent and child to reach the estimated fee rate, and at least
+// enough to pay for its own weight.
+func TestCreateCPFPTxFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		feeRate      = lnwallet.SatPerKWeight(10000)
+		parentWeight = int64(1000)
+	)
+
+	wallet := newMockWallet(100000)
+	estimator := &lnwallet.StaticFeeEstimator{FeePerKW: feeRate}
+	sweeper := newTestSweeper(wallet, estimator)
+
+	childWeight := cpfpWeight(1)
+	packageFee := feeRate.FeeForWeight(parentWeight + childWeight)
+
+	testCases := []struct {
+		name        string
+		parentFee   btcutil.Amount
+		expectedFee btcutil.Amount
+	}{
+		{
+			// The parent doesn't pay any fee, so the child
+			// pays for the whole package.
+			name:        "parent pays no fee",
+			parentFee:   0,
+			expectedFee: packageFee,
+		},
+		{
+			// The child makes up the difference to the package
+			// fee.
+			name:        "parent pays part of the fee",
+			parentFee:   3000,
+			expectedFee: packageFee - 3000,
+		},
+		{
+			// The parent already pays more than enough, but the
+			// child must still pay for itself.
+			name:        "parent pays enough",
+			parentFee:   packageFee,
+			expectedFee: feeRate.FeeForWeight(childWeight),
+		},
+	}
+
+	for _, test := range testCases {
+		cpfpTx, err := sweeper.CreateCPFPTx(
+			newTestAnchor(), parentWeight, test.parentFee, 6, nil,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to create cpfp tx: %v", test.name,
+				err)
+		}
+
+		if len(cpfpTx.TxIn) != 2 {
+			t.Fatalf("%v: expected 2 inputs, got %v", test.name,
+				len(cpfpTx.TxIn))
+		}
+		anchorOutPoint := newTestAnchor().OutPoint()
+		if cpfpTx.TxIn[0].PreviousOutPoint != *anchorOutPoint {
+			t.Fatalf("%v: expected anchor as first input",
+				test.name)
+		}
+		pkScript := cpfpTx.TxOut[0].PkScript
+		if len(cpfpTx.TxOut) != 1 ||
+			!bytes.Equal(pkScript, testSweepScript) {
+
+			t.Fatalf("%v: expected single output to the sweep "+
+				"script", test.name)
+		}
+
+		fee := cpfpFee(t, wallet, cpfpTx)
+		if fee != test.expectedFee {
+			t.Fatalf("%v: expected fee %v, got %v", test.name,
+				test.expectedFee, fee)
+		}
+
+		sweeper.ReleaseCPFPTx(cpfpTx)
+	}
+}
+
+// TestCreateCPFPTxLocking tests that the wallet outputs selected for a CPFP
+// transaction are locked until the transaction is released, and that they're
+// unlocked again if not enough funds are available.
+func TestCreateCPFPTxLocking(t *testing.T) {
+	t.Parallel()
+
+	estimator := &lnwallet.StaticFeeEstimator{FeePerKW: 10000}
+
+	// The wallet has an output too small to pay the fee on its own, an
+	// output of a type that can't be used, and a larger one.
+	wallet := newMockWallet(1000, 50000, 50000)
+	wallet.utxos[1].AddressType = lnwallet.NestedWitnessPubKey
+	sweeper := newTestSweeper(wallet, estimator)
+
+	cpfpTx, err := sweeper.CreateCPFPTx(newTestAnchor(), 1000, 0, 6, nil)
+	if err != nil {
+		t.Fatalf("unable to create cpfp tx: %v", err)
+	}
+
+	// Both usable outputs are needed, while the nested one is skipped.
+	if len(cpfpTx.TxIn) != 3 {
+		t.Fatalf("expected 3 inputs, got %v", len(cpfpTx.TxIn))
+	}
+	for _, txIn := range cpfpTx.TxIn[1:] {
+		if txIn.PreviousOutPoint == wallet.utxos[1].OutPoint {
+			t.Fatalf("nested output shouldn't be spent")
+		}
+	}
+	if wallet.numLocked() != 2 {
+		t.Fatalf("expected 2 locked outputs, got %v",
+			wallet.numLocked())
+	}
+
+	// A second CPFP transaction must not select the locked outputs, so
+	// it fails, and leaves nothing else locked.
+	_, err = sweeper.CreateCPFPTx(newTestAnchor(), 1000, 0, 6, nil)
+	if err != ErrNotEnoughWalletFunds {
+		t.Fatalf("expected ErrNotEnoughWalletFunds, got %v", err)
+	}
+	if wallet.numLocked() != 2 {
+		t.Fatalf("expected 2 locked outputs, got %v",
+			wallet.numLocked())
+	}
+
+	// Once released, the outputs are unlocked again.
+	sweeper.ReleaseCPFPTx(cpfpTx)
+	if wallet.numLocked() != 0 {
+		t.Fatalf("expected no locked outputs, got %v",
+			wallet.numLocked())
+	}
+
+	// If the fee can't be paid even with all outputs, all of them are
+	// unlocked again.
+	estimator.FeePerKW = 1000000
+	_, err = sweeper.CreateCPFPTx(newTestAnchor(), 1000, 0, 6, nil)
+	if err != ErrNotEnoughWalletFunds {
+		t.Fatalf("expected ErrNotEnoughWalletFunds, got %v", err)
+	}
+	if wallet.numLocked() != 0 {
+		t.Fatalf("expected no locked outputs, got %v",
+			wallet.numLocked())
+	}
+}
+
+// TestCreateCPFPTxReplacement tests that a CPFP transaction is only replaced
+// if its fee no longer suffices, and that the replacement spends the same
+// wallet outputs while paying the fee required by BIP 125.
+func TestCreateCPFPTxReplacement(t *testing.T) {
+	t.Parallel()
+
+	const parentWeight = int64(1000)
+
+	wallet := newMockWallet(100000, 100000)
+	estimator := &lnwallet.StaticFeeEstimator{FeePerKW: 2000}
+	sweeper := newTestSweeper(wallet, estimator)
+
+	cpfpTx, err := sweeper.CreateCPFPTx(
+		newTestAnchor(), parentWeight, 0, 6, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create cpfp tx: %v", err)
+	}
+	fee := cpfpFee(t, wallet, cpfpTx)
+
+	// As long as the fee estimate doesn't change, the transaction is
+	// returned as is.
+	replacement, err := sweeper.CreateCPFPTx(
+		newTestAnchor(), parentWeight, 0, 6, cpfpTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to replace cpfp tx: %v", err)
+	}
+	if replacement.TxHash() != cpfpTx.TxHash() {
+		t.Fatalf("expected cpfp tx not to be replaced")
+	}
+
+	// A slightly higher fee rate requires a replacement, which must pay
+	// for its own bandwidth on top of the replaced fee, even though that
+	// exceeds the estimated fee rate.
+	childWeight := cpfpWeight(1)
+	estimator.FeePerKW = 2100
+	replacement, err = sweeper.CreateCPFPTx(
+		newTestAnchor(), parentWeight, 0, 6, cpfpTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to replace cpfp tx: %v", err)
+	}
+	minFee := fee + lnwallet.FeePerKwFloor.FeeForWeight(childWeight)
+	replacementFee := cpfpFee(t, wallet, replacement)
+	if replacementFee != minFee {
+		t.Fatalf("expected replacement fee %v, got %v", minFee,
+			replacementFee)
+	}
+
+	// The replacement spends the same wallet output, which stays locked,
+	// rather than selecting the other one.
+	if len(replacement.TxIn) != 2 ||
+		replacement.TxIn[1].PreviousOutPoint !=
+			cpfpTx.TxIn[1].PreviousOutPoint {
+
+		t.Fatalf("expected replacement to spend the same wallet " +
+			"output")
+	}
+	if wallet.numLocked() != 1 {
+		t.Fatalf("expected 1 locked output, got %v",
+			wallet.numLocked())
+	}
+
+	// A much higher fee rate is paid in full at the estimate.
+	estimator.FeePerKW = 20000
+	replacement, err = sweeper.CreateCPFPTx(
+		newTestAnchor(), parentWeight, 0, 6, replacement,
+	)
+	if err != nil {
+		t.Fatalf("unable to replace cpfp tx: %v", err)
+	}
+	expectedFee := estimator.FeePerKW.FeeForWeight(
+		parentWeight + childWeight,
+	)
+	replacementFee = cpfpFee(t, wallet, replacement)
+	if replacementFee != expectedFee {
+		t.Fatalf("expected replacement fee %v, got %v", expectedFee,
+			replacementFee)
+	}
+
+	sweeper.ReleaseCPFPTx(replacement)
+	if wallet.numLocked() != 0 {
+		t.Fatalf("expected no locked outputs, got %v",
+			wallet.numLocked())
+	}
+}
@@ -185,7 +185,7 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, channeldb.SingleFunder)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -317,6 +317,9 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 			rootKey:               aliceKeyPriv,
 			publishedTransactions: publTx,
 		},
+		Cfg: lnwallet.Config{
+			Database: dbAlice,
+		},
 	}
 	cc := &chainControl{
 		feeEstimator:  estimator,
@@ -329,8 +332,10 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	chainArb := contractcourt.NewChainArbitrator(
 		contractcourt.ChainArbitratorConfig{
-			Notifier: notifier,
-			ChainIO:  chainIO,
+			Notifier:             notifier,
+			ChainIO:              chainIO,
+			NotifyClosingChannel: func(*channeldb.ChannelCloseSummary) {},
+			NotifyClosedChannel:  func(*channeldb.ChannelCloseSummary) {},
 		}, dbAlice,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
@@ -355,6 +360,11 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		LimitQueueTicker: ticker.New(
+			htlcswitch.DefaultLimitQueueInterval),
+		NotifyActiveChannel:   func(wire.OutPoint) {},
+		NotifyInactiveChannel: func(wire.OutPoint) {},
+		NotifyHtlcEvent:       func(interface{}) {},
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -203,8 +203,9 @@ type NurseryConfig struct {
 	Notifier chainntnfs.ChainNotifier
 
 	// PublishTransaction facilitates the process of broadcasting a signed
-	// transaction to the appropriate network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the appropriate network. The passed label is
+	// persisted along with the transaction.
+	PublishTransaction func(*wire.MsgTx, *channeldb.TxLabel) error
 
 	// Store provides access to and modification of the persistent state
 	// maintained about the utxo nursery's incubating outputs.
@@ -437,10 +438,12 @@ func (u *utxoNursery) IncubateOutputs(chanPoint wire.OutPoint,
 
 		// Otherwise, this is actually a kid output as we can sweep it
 		// once the commitment transaction confirms, and the absolute
-		// CLTV lock has expired. We set the CSV delay to zero to
-		// indicate this is actually a CLTV output.
+		// CLTV lock has expired. The CSV delay is zero to indicate
+		// this is actually a CLTV output, unless the channel uses
+		// anchor outputs, in which case the HTLC output also carries a
+		// one block CSV delay.
 		htlcOutput := makeKidOutput(
-			&htlcRes.ClaimOutpoint, &chanPoint, 0,
+			&htlcRes.ClaimOutpoint, &chanPoint, htlcRes.CsvDelay,
 			lnwallet.HtlcOfferedRemoteTimeout,
 			&htlcRes.SweepSignDesc, htlcRes.Expiry,
 		)
@@ -957,7 +960,11 @@ func (u *utxoNursery) sweepMatureOutputs(classHeight uint32, finalTx *wire.MsgTx
 	// With the sweep transaction fully signed, broadcast the transaction
 	// to the network. Additionally, we can stop tracking these outputs as
 	// they've just been swept.
-	err := u.cfg.PublishTransaction(finalTx)
+	err := u.cfg.PublishTransaction(finalTx, &channeldb.TxLabel{
+		Category: channeldb.TxCategorySweep,
+		Label: fmt.Sprintf("Sweep of %v CSV-delayed outputs",
+			len(kgtnOutputs)),
+	})
 	if err != nil && err != lnwallet.ErrDoubleSpend {
 		utxnLog.Errorf("unable to broadcast sweep tx: %v, %v",
 			err, spew.Sdump(finalTx))
@@ -1066,7 +1073,11 @@ func (u *utxoNursery) sweepCribOutput(classHeight uint32, baby *babyOutput) erro
 
 	// We'll now broadcast the HTLC transaction, then wait for it to be
 	// confirmed before transitioning it to kindergarten.
-	err := u.cfg.PublishTransaction(baby.timeoutTx)
+	err := u.cfg.PublishTransaction(baby.timeoutTx, &channeldb.TxLabel{
+		Category: channeldb.TxCategorySweep,
+		Label: fmt.Sprintf("HTLC timeout for ChannelPoint(%v)",
+			baby.OriginChanPoint()),
+	})
 	if err != nil && err != lnwallet.ErrDoubleSpend {
 		utxnLog.Errorf("Unable to broadcast baby tx: "+
 			"%v, %v", err, spew.Sdump(baby.timeoutTx))
@@ -438,6 +438,12 @@ func createNurseryTestContext(t *testing.T,
 		},
 		Estimator: &lnwallet.StaticFeeEstimator{},
 		Signer:    &nurseryMockSigner{},
+		ListUnspentWitness: func(int32, int32) ([]*lnwallet.Utxo,
+			error) {
+
+			return nil, nil
+		},
+		LockOutpoint: func(wire.OutPoint) {},
 	})
 
 	cfg := NurseryConfig{
@@ -458,7 +464,9 @@ func createNurseryTestContext(t *testing.T,
 	}
 
 	publishChan := make(chan wire.MsgTx, 1)
-	cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+	cfg.PublishTransaction = func(tx *wire.MsgTx,
+		_ *channeldb.TxLabel) error {
+
 		t.Logf("Publishing tx %v", tx.TxHash())
 		publishChan <- *tx
 		return nil
@@ -574,7 +582,6 @@ func createOutgoingRes(onLocalCommitment bool) *lnwallet.OutgoingHtlcResolution
 				Value: 10000,
 			},
 		},
-		CsvDelay: 2,
 	}
 
 	if onLocalCommitment {
@@ -591,6 +598,7 @@ func createOutgoingRes(onLocalCommitment bool) *lnwallet.OutgoingHtlcResolution
 		}
 
 		outgoingRes.SignedTimeoutTx = timeoutTx
+		outgoingRes.CsvDelay = 2
 	} else {
 		outgoingRes.ClaimOutpoint = htlcOp
 	}
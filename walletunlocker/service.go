@@ -17,6 +17,13 @@ import (
 	"golang.org/x/net/context"
 )
 
+// ErrWatchOnly is returned when a seed is requested or provided while lnd runs
+// with a remote signer, as its watch-only wallet must not hold any private
+// keys.
+var ErrWatchOnly = errors.New("a remote signer is used, the wallet must be " +
+	"a watch-only copy of the signer's wallet rather than created from a " +
+	"seed")
+
 // WalletInitMsg is a message sent by the UnlockerService when a user wishes to
 // set up the internal wallet for the first time. The user MUST provide a
 // passphrase, but is also able to provide their own source of entropy. If
@@ -77,11 +84,16 @@ type UnlockerService struct {
 	chainDir      string
 	netParams     *chaincfg.Params
 	macaroonFiles []string
+
+	// watchOnly is true if lnd runs with a remote signer, in which case
+	// the wallet must not hold any private keys, and can't be created
+	// from a seed.
+	watchOnly bool
 }
 
 // New creates and returns a new UnlockerService.
-func New(chainDir string, params *chaincfg.Params,
-	macaroonFiles []string) *UnlockerService {
+func New(chainDir string, params *chaincfg.Params, macaroonFiles []string,
+	watchOnly bool) *UnlockerService {
 
 	return &UnlockerService{
 		InitMsgs:      make(chan *WalletInitMsg, 1),
@@ -89,6 +101,7 @@ func New(chainDir string, params *chaincfg.Params,
 		chainDir:      chainDir,
 		netParams:     params,
 		macaroonFiles: macaroonFiles,
+		watchOnly:     watchOnly,
 	}
 }
 
@@ -103,6 +116,11 @@ func New(chainDir string, params *chaincfg.Params,
 func (u *UnlockerService) GenSeed(ctx context.Context,
 	in *lnrpc.GenSeedRequest) (*lnrpc.GenSeedResponse, error) {
 
+	// A watch-only wallet never holds a seed.
+	if u.watchOnly {
+		return nil, ErrWatchOnly
+	}
+
 	// Before we start, we'll ensure that the wallet hasn't already created
 	// so we don't show a *new* seed to the user if one already exists.
 	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
@@ -182,6 +200,11 @@ func (u *UnlockerService) GenSeed(ctx context.Context,
 func (u *UnlockerService) InitWallet(ctx context.Context,
 	in *lnrpc.InitWalletRequest) (*lnrpc.InitWalletResponse, error) {
 
+	// A watch-only wallet can't be created from a seed.
+	if u.watchOnly {
+		return nil, ErrWatchOnly
+	}
+
 	// Make sure the password meets our constraints.
 	password := in.WalletPassword
 	if err := validatePassword(password); err != nil {
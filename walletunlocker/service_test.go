@@ -66,7 +66,7 @@ func TestGenSeed(t *testing.T) {
 	}
 	defer os.RemoveAll(testDir)
 
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase.
@@ -92,6 +92,56 @@ func TestGenSeed(t *testing.T) {
 	}
 }
 
+// TestGenSeedWatchOnly tests that neither a seed can be generated, nor a wallet
+// be created from one, when the wallet must be watch-only.
+func TestGenSeedWatchOnly(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testcreate")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer func() {
+		os.RemoveAll(testDir)
+	}()
+	service := walletunlocker.New(testDir, testNetParams, nil, true)
+
+	ctx := context.Background()
+	_, err = service.GenSeed(ctx, &lnrpc.GenSeedRequest{})
+	if err != walletunlocker.ErrWatchOnly {
+		t.Fatalf("expected ErrWatchOnly, got %v", err)
+	}
+
+	cipherSeed, err := aezeed.New(
+		keychain.KeyDerivationVersion, &testEntropy, time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	pass := []byte("test")
+	mnemonic, err := cipherSeed.ToMnemonic(pass)
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:     testPassword,
+		CipherSeedMnemonic: []string(mnemonic[:]),
+		AezeedPassphrase:   pass,
+	}
+	_, err = service.InitWallet(ctx, req)
+	if err != walletunlocker.ErrWatchOnly {
+		t.Fatalf("expected ErrWatchOnly, got %v", err)
+	}
+
+	// No wallet should have been created.
+	select {
+	case <-service.InitMsgs:
+		t.Fatalf("wallet initialized from seed")
+	default:
+	}
+}
+
 // TestGenSeedInvalidEntropy tests that the gen seed method generates a valid
 // cipher seed mnemonic pass phrase even when the user doesn't provide its own
 // source of entropy.
@@ -107,7 +157,7 @@ func TestGenSeedGenerateEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. Note that we don't actually
@@ -147,7 +197,7 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. However, we'll be using an
@@ -185,7 +235,7 @@ func TestInitWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	// Once we have the unlocker service created, we'll now instantiate a
 	// new cipher seed instance.
@@ -286,7 +336,7 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	// We'll attempt to init the wallet with an invalid cipher seed and
 	// passphrase.
@@ -319,7 +369,7 @@ func TestUnlockWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, false)
 
 	ctx := context.Background()
 	req := &lnrpc.UnlockWalletRequest{
@@ -393,7 +443,7 @@ func TestChangeWalletPassword(t *testing.T) {
 	}
 
 	// Create a new UnlockerService with our temp files.
-	service := walletunlocker.New(testDir, testNetParams, tempFiles)
+	service := walletunlocker.New(testDir, testNetParams, tempFiles, false)
 
 	ctx := context.Background()
 	newPassword := []byte("hunter2???")
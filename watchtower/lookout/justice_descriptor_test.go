@@ -272,7 +272,7 @@ func TestJusticeDescriptor(t *testing.T) {
 	// DER-encoded signature under the to-remote pubkey. The sighash flag is
 	// also present, so we trim it.
 	toRemoteWitness, err := lnwallet.CommitSpendNoDelay(
-		signer, toRemoteSignDesc, justiceTxn,
+		signer, toRemoteSignDesc, justiceTxn, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to sign to-remote input: %v", err)